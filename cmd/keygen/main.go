@@ -7,6 +7,7 @@ import (
 	"os"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	"github.com/spf13/cobra"
 
 	"github.com/hiromaily/go-crypto-wallet/internal/di"
@@ -14,6 +15,7 @@ import (
 	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/config/account"
 	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/keygen"
+	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/migrate"
 	wallets "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet"
 	"github.com/hiromaily/go-crypto-wallet/pkg/config"
 )
@@ -44,12 +46,8 @@ var (
 	container di.Container
 )
 
-func initializeWallet() error {
-	// validate coinTypeCode
-	if !domainCoin.IsCoinTypeCode(coinTypeCode) {
-		return errors.New("coin args is invalid. `btc`, `bch`, `eth`, `xrp`, `hyt` is allowed")
-	}
-
+// initializeWallet creates container, wallet is created only when createWallet is true
+func initializeWallet(createWallet bool) error {
 	// set config path if environment variable is existing
 	if confPath == "" {
 		setConfigPathFromEnv()
@@ -66,6 +64,13 @@ func initializeWallet() error {
 		return fmt.Errorf("failed to load wallet config: %w", err)
 	}
 
+	// validate coinTypeCode, coin of EVM chain in ethereum.networks is registered by config
+	if !domainCoin.IsCoinTypeCode(coinTypeCode) {
+		return errors.New("coin args is invalid. " +
+			"`btc`, `bch`, `ltc`, `doge`, `eth`, `pol`, `bnb`, `arb`, `base`, `xrp`, `sol`, `trx`, `hyt` " +
+			"and coin of ethereum.networks in config is allowed")
+	}
+
 	// account config
 	accountConf := &account.AccountRoot{}
 	if accountConfPath != "" {
@@ -86,6 +91,9 @@ func initializeWallet() error {
 
 	// create wallet
 	container = di.NewContainer(conf, accountConf, walletType)
+	if !createWallet {
+		return nil
+	}
 	walleter = container.NewKeygener()
 
 	return nil
@@ -97,10 +105,26 @@ func setConfigPathFromEnv() {
 		confPath = os.Getenv("BTC_KEYGEN_WALLET_CONF")
 	case coinTypeCode == domainCoin.BCH.String():
 		confPath = os.Getenv("BCH_KEYGEN_WALLET_CONF")
+	case coinTypeCode == domainCoin.LTC.String():
+		confPath = os.Getenv("LTC_KEYGEN_WALLET_CONF")
+	case coinTypeCode == domainCoin.DOGE.String():
+		confPath = os.Getenv("DOGE_KEYGEN_WALLET_CONF")
+	case coinTypeCode == domainCoin.POL.String():
+		confPath = os.Getenv("POL_KEYGEN_WALLET_CONF")
+	case coinTypeCode == domainCoin.BNB.String():
+		confPath = os.Getenv("BNB_KEYGEN_WALLET_CONF")
+	case coinTypeCode == domainCoin.ARB.String():
+		confPath = os.Getenv("ARB_KEYGEN_WALLET_CONF")
+	case coinTypeCode == domainCoin.BASE.String():
+		confPath = os.Getenv("BASE_KEYGEN_WALLET_CONF")
 	case domainCoin.IsETHGroup(domainCoin.CoinTypeCode(coinTypeCode)):
 		confPath = os.Getenv("ETH_KEYGEN_WALLET_CONF")
 	case coinTypeCode == domainCoin.XRP.String():
 		confPath = os.Getenv("XRP_KEYGEN_WALLET_CONF")
+	case coinTypeCode == domainCoin.SOL.String():
+		confPath = os.Getenv("SOL_KEYGEN_WALLET_CONF")
+	case coinTypeCode == domainCoin.TRX.String():
+		confPath = os.Getenv("TRX_KEYGEN_WALLET_CONF")
 	}
 }
 
@@ -110,6 +134,10 @@ func setAccountConfPathFromEnv() {
 		accountConfPath = os.Getenv("BTC_ACCOUNT_CONF")
 	case domainCoin.BCH.String():
 		accountConfPath = os.Getenv("BCH_ACCOUNT_CONF")
+	case domainCoin.LTC.String():
+		accountConfPath = os.Getenv("LTC_ACCOUNT_CONF")
+	case domainCoin.DOGE.String():
+		accountConfPath = os.Getenv("DOGE_ACCOUNT_CONF")
 	}
 }
 
@@ -123,7 +151,8 @@ func main() {
 			if cmd.Name() == "help" {
 				return nil
 			}
-			return initializeWallet()
+			// migrate command works before schema is up to date
+			return initializeWallet(!migrate.IsCommand(cmd))
 		},
 		PersistentPostRun: func(cmd *cobra.Command, args []string) {
 			if walleter != nil {
@@ -135,11 +164,12 @@ func main() {
 	// Global flags
 	rootCmd.PersistentFlags().StringVarP(&confPath, "conf", "c", "", "config file path")
 	rootCmd.PersistentFlags().StringVar(&coinTypeCode, "coin", "btc",
-		"coin type code `btc`, `bch`, `eth`, `xrp`, `hyt`")
+		"coin type code `btc`, `bch`, `ltc`, `doge`, `eth`, `pol`, `bnb`, `arb`, `base`, `xrp`, `sol`, `trx`, `hyt`")
 	rootCmd.PersistentFlags().StringVarP(&btcWallet, "wallet", "w", "", "specify wallet.dat in bitcoin core")
 
 	// Add subcommands
 	keygen.AddCommands(rootCmd, &walleter, container, appVersion)
+	rootCmd.AddCommand(migrate.AddCommand(&container))
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -7,12 +7,14 @@ import (
 	"os"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	"github.com/spf13/cobra"
 
 	"github.com/hiromaily/go-crypto-wallet/internal/di"
 	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
 	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/config/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/migrate"
 	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/sign"
 	wallets "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet"
 	"github.com/hiromaily/go-crypto-wallet/pkg/config"
@@ -46,12 +48,8 @@ var (
 	container di.Container
 )
 
-func initializeWallet() error {
-	// validate coinTypeCode
-	if !domainCoin.IsCoinTypeCode(coinTypeCode) {
-		return errors.New("coin args is invalid. `btc`, `bch` is allowed")
-	}
-
+// initializeWallet creates container, wallet is created only when createWallet is true
+func initializeWallet(createWallet bool) error {
 	// set config path if environment variable is existing
 	if confPath == "" {
 		setConfigPathFromEnv()
@@ -68,6 +66,12 @@ func initializeWallet() error {
 		return fmt.Errorf("failed to load wallet config: %w", err)
 	}
 
+	// validate coinTypeCode, coin of EVM chain in ethereum.networks is registered by config
+	if !domainCoin.IsCoinTypeCode(coinTypeCode) {
+		return errors.New("coin args is invalid. `btc`, `bch`, `ltc`, `doge`, `eth`, `xrp` " +
+			"and coin of ethereum.networks in config is allowed")
+	}
+
 	accountConf := &account.AccountRoot{}
 	if accountConfPath != "" {
 		accountConf, err = account.NewAccount(accountConfPath)
@@ -87,6 +91,9 @@ func initializeWallet() error {
 
 	// create wallet
 	container = di.NewContainer(conf, accountConf, walletType)
+	if !createWallet {
+		return nil
+	}
 	walleter = container.NewSigner(authName)
 
 	return nil
@@ -98,6 +105,14 @@ func setConfigPathFromEnv() {
 		confPath = os.Getenv("BTC_SIGN_WALLET_CONF")
 	case domainCoin.BCH.String():
 		confPath = os.Getenv("BCH_SIGN_WALLET_CONF")
+	case domainCoin.LTC.String():
+		confPath = os.Getenv("LTC_SIGN_WALLET_CONF")
+	case domainCoin.DOGE.String():
+		confPath = os.Getenv("DOGE_SIGN_WALLET_CONF")
+	case domainCoin.ETH.String():
+		confPath = os.Getenv("ETH_SIGN_WALLET_CONF")
+	case domainCoin.XRP.String():
+		confPath = os.Getenv("XRP_SIGN_WALLET_CONF")
 	}
 }
 
@@ -107,6 +122,14 @@ func setAccountConfPathFromEnv() {
 		accountConfPath = os.Getenv("BTC_ACCOUNT_CONF")
 	case domainCoin.BCH.String():
 		accountConfPath = os.Getenv("BCH_ACCOUNT_CONF")
+	case domainCoin.LTC.String():
+		accountConfPath = os.Getenv("LTC_ACCOUNT_CONF")
+	case domainCoin.DOGE.String():
+		accountConfPath = os.Getenv("DOGE_ACCOUNT_CONF")
+	case domainCoin.ETH.String():
+		accountConfPath = os.Getenv("ETH_ACCOUNT_CONF")
+	case domainCoin.XRP.String():
+		accountConfPath = os.Getenv("XRP_ACCOUNT_CONF")
 	}
 }
 
@@ -120,7 +143,8 @@ func main() {
 			if cmd.Name() == "help" {
 				return nil
 			}
-			return initializeWallet()
+			// migrate command works before schema is up to date
+			return initializeWallet(!migrate.IsCommand(cmd))
 		},
 		PersistentPostRun: func(cmd *cobra.Command, args []string) {
 			if walleter != nil {
@@ -131,11 +155,12 @@ func main() {
 
 	// Global flags
 	rootCmd.PersistentFlags().StringVarP(&confPath, "conf", "c", "", "config file path")
-	rootCmd.PersistentFlags().StringVar(&coinTypeCode, "coin", "btc", "coin type code `btc`, `bch`")
+	rootCmd.PersistentFlags().StringVar(&coinTypeCode, "coin", "btc", "coin type code `btc`, `bch`, `ltc`, `doge`, `eth`, `xrp`")
 	rootCmd.PersistentFlags().StringVarP(&btcWallet, "wallet", "w", "", "specify wallet.dat in bitcoin core")
 
 	// Add subcommands
 	sign.AddCommands(rootCmd, &walleter, container, appVersion)
+	rootCmd.AddCommand(migrate.AddCommand(&container))
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
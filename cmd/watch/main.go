@@ -1,21 +1,25 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"os"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	"github.com/spf13/cobra"
 
 	"github.com/hiromaily/go-crypto-wallet/internal/di"
 	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
 	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/config/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/migrate"
 	wcmd "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/watch"
 	wallets "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet"
 	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 // watch as watch only wallet
@@ -48,12 +52,8 @@ var (
 	container di.Container
 )
 
-func initializeWallet() error {
-	// validate coinTypeCode
-	if !domainCoin.IsCoinTypeCode(coinTypeCode) && !domainCoin.IsERC20Token(coinTypeCode) {
-		return errors.New("coin args is invalid. `btc`, `bch`, `eth`, `xrp`, `hyt` is allowed")
-	}
-
+// initializeWallet creates container, wallet is created only when createWallet is true
+func initializeWallet(createWallet bool) error {
 	// set config path if environment variable is existing
 	if confPath == "" {
 		setConfigPathFromEnv()
@@ -72,6 +72,13 @@ func initializeWallet() error {
 		return fmt.Errorf("failed to load wallet config: %w", err)
 	}
 
+	// validate coinTypeCode, coin of EVM chain in ethereum.networks is registered by config
+	if !domainCoin.IsCoinTypeCode(coinTypeCode) && !domainCoin.IsERC20Token(coinTypeCode) {
+		return errors.New("coin args is invalid. " +
+			"`btc`, `bch`, `ltc`, `doge`, `eth`, `pol`, `bnb`, `arb`, `base`, `xrp`, `sol`, `trx`, `hyt` " +
+			"and coin of ethereum.networks in config is allowed")
+	}
+
 	accountConf := &account.AccountRoot{}
 	if accountConfPath != "" {
 		accountConf, err = account.NewAccount(accountConfPath)
@@ -97,6 +104,9 @@ func initializeWallet() error {
 
 	// create wallet
 	container = di.NewContainer(conf, accountConf, walletType)
+	if !createWallet {
+		return nil
+	}
 	walleter = container.NewWalleter()
 
 	return nil
@@ -108,10 +118,26 @@ func setConfigPathFromEnv() {
 		confPath = os.Getenv("BTC_WATCH_WALLET_CONF")
 	case coinTypeCode == domainCoin.BCH.String():
 		confPath = os.Getenv("BCH_WATCH_WALLET_CONF")
+	case coinTypeCode == domainCoin.LTC.String():
+		confPath = os.Getenv("LTC_WATCH_WALLET_CONF")
+	case coinTypeCode == domainCoin.DOGE.String():
+		confPath = os.Getenv("DOGE_WATCH_WALLET_CONF")
+	case coinTypeCode == domainCoin.POL.String():
+		confPath = os.Getenv("POL_WATCH_WALLET_CONF")
+	case coinTypeCode == domainCoin.BNB.String():
+		confPath = os.Getenv("BNB_WATCH_WALLET_CONF")
+	case coinTypeCode == domainCoin.ARB.String():
+		confPath = os.Getenv("ARB_WATCH_WALLET_CONF")
+	case coinTypeCode == domainCoin.BASE.String():
+		confPath = os.Getenv("BASE_WATCH_WALLET_CONF")
 	case domainCoin.IsETHGroup(domainCoin.CoinTypeCode(coinTypeCode)):
 		confPath = os.Getenv("ETH_WATCH_WALLET_CONF")
 	case coinTypeCode == domainCoin.XRP.String():
 		confPath = os.Getenv("XRP_WATCH_WALLET_CONF")
+	case coinTypeCode == domainCoin.SOL.String():
+		confPath = os.Getenv("SOL_WATCH_WALLET_CONF")
+	case coinTypeCode == domainCoin.TRX.String():
+		confPath = os.Getenv("TRX_WATCH_WALLET_CONF")
 	}
 }
 
@@ -121,10 +147,18 @@ func setAccountConfPathFromEnv() {
 		accountConfPath = os.Getenv("BTC_ACCOUNT_CONF")
 	case coinTypeCode == domainCoin.BCH.String():
 		accountConfPath = os.Getenv("BCH_ACCOUNT_CONF")
+	case coinTypeCode == domainCoin.LTC.String():
+		accountConfPath = os.Getenv("LTC_ACCOUNT_CONF")
+	case coinTypeCode == domainCoin.DOGE.String():
+		accountConfPath = os.Getenv("DOGE_ACCOUNT_CONF")
 	case domainCoin.IsETHGroup(domainCoin.CoinTypeCode(coinTypeCode)):
 		accountConfPath = os.Getenv("ETH_ACCOUNT_CONF")
 	case coinTypeCode == domainCoin.XRP.String():
 		accountConfPath = os.Getenv("XRP_ACCOUNT_CONF")
+	case coinTypeCode == domainCoin.SOL.String():
+		accountConfPath = os.Getenv("SOL_ACCOUNT_CONF")
+	case coinTypeCode == domainCoin.TRX.String():
+		accountConfPath = os.Getenv("TRX_ACCOUNT_CONF")
 	}
 }
 
@@ -138,23 +172,29 @@ func main() {
 			if cmd.Name() == "help" {
 				return nil
 			}
-			return initializeWallet()
+			// migrate command works before schema is up to date
+			return initializeWallet(!migrate.IsCommand(cmd))
 		},
 		PersistentPostRun: func(cmd *cobra.Command, args []string) {
 			if walleter != nil {
 				walleter.Done()
 			}
+			// flush remaining spans
+			if err := tracer.Shutdown(context.Background()); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
 		},
 	}
 
 	// Global flags
 	rootCmd.PersistentFlags().StringVarP(&confPath, "conf", "c", "", "config file path")
 	rootCmd.PersistentFlags().StringVar(&coinTypeCode, "coin", "btc",
-		"coin type code `btc`, `bch`, `eth`, `xrp`, `hyt`")
+		"coin type code `btc`, `bch`, `ltc`, `doge`, `eth`, `pol`, `bnb`, `arb`, `base`, `xrp`, `sol`, `trx`, `hyt`")
 	rootCmd.PersistentFlags().StringVarP(&btcWallet, "wallet", "w", "", "specify wallet.dat in bitcoin core")
 
 	// Add subcommands
 	wcmd.AddCommands(rootCmd, &walleter, container, appVersion, conf)
+	rootCmd.AddCommand(migrate.AddCommand(&container))
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -0,0 +1,19 @@
+#!/bin/bash
+#
+# Consolidated Database Initialization Script
+# Creates all three databases (watch, keygen, sign) in a single PostgreSQL instance
+# tables are created by migrations embedded into each wallet binary
+#
+set -euo pipefail
+
+create_db() {
+  local db=$1
+  psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname postgres <<-SQL
+    DROP DATABASE IF EXISTS ${db};
+    CREATE DATABASE ${db} OWNER ${POSTGRES_USER};
+SQL
+}
+
+create_db watch
+create_db keygen
+create_db sign
@@ -6,145 +6,265 @@
 package persistence
 
 import (
+	"context"
+	"database/sql"
+
+	"github.com/guregu/null/v6"
+
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
 	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
 	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+	"github.com/hiromaily/go-crypto-wallet/pkg/scheduler"
 )
 
 // Repository interfaces for cold wallet (keygen and sign wallets)
 
 // SeedRepositorier is SeedRepository interface
 type SeedRepositorier interface {
-	GetOne() (*models.Seed, error)
-	Insert(strSeed string) error
+	GetOne(ctx context.Context) (*models.Seed, error)
+	Insert(ctx context.Context, strSeed string) error
 }
 
 // AccountKeyRepositorier is AccountKeyRepository interface
 type AccountKeyRepositorier interface {
-	GetMaxIndex(accountType domainAccount.AccountType) (int64, error)
-	GetOneMaxID(accountType domainAccount.AccountType) (*models.AccountKey, error)
-	GetAllAddrStatus(accountType domainAccount.AccountType, addrStatus address.AddrStatus) ([]*models.AccountKey, error)
-	GetAllMultiAddr(accountType domainAccount.AccountType, addrs []string) ([]*models.AccountKey, error)
-	InsertBulk(items []*models.AccountKey) error
+	GetMaxIndex(ctx context.Context, accountType domainAccount.AccountType) (int64, error)
+	GetOneMaxID(ctx context.Context, accountType domainAccount.AccountType) (*models.AccountKey, error)
+	GetAllAddrStatus(
+		ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus,
+	) ([]*models.AccountKey, error)
+	GetAllMultiAddr(
+		ctx context.Context, accountType domainAccount.AccountType, addrs []string,
+	) ([]*models.AccountKey, error)
+	InsertBulk(ctx context.Context, items []*models.AccountKey) error
 	UpdateAddr(
-		accountType domainAccount.AccountType, addr, keyAddress string,
+		ctx context.Context, accountType domainAccount.AccountType, addr, keyAddress string,
 	) (int64, error)
 	UpdateAddrStatus(
-		accountType domainAccount.AccountType, addrStatus address.AddrStatus, strWIFs []string,
+		ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus, strWIFs []string,
+	) (int64, error)
+	UpdateMultisigAddr(ctx context.Context, accountType domainAccount.AccountType, item *models.AccountKey) (int64, error)
+	UpdateMultisigAddrs(
+		ctx context.Context, accountType domainAccount.AccountType, items []*models.AccountKey,
 	) (int64, error)
-	UpdateMultisigAddr(accountType domainAccount.AccountType, item *models.AccountKey) (int64, error)
-	UpdateMultisigAddrs(accountType domainAccount.AccountType, items []*models.AccountKey) (int64, error)
 }
 
 // XRPAccountKeyRepositorier is XRPAccountKeyRepository interface
 type XRPAccountKeyRepositorier interface {
 	GetAllAddrStatus(
-		accountType domainAccount.AccountType, addrStatus address.AddrStatus,
+		ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus,
 	) ([]*models.XRPAccountKey, error)
-	GetSecret(accountType domainAccount.AccountType, addr string) (string, error)
-	InsertBulk(items []*models.XRPAccountKey) error
+	GetOne(ctx context.Context, accountType domainAccount.AccountType, accountID string) (*models.XRPAccountKey, error)
+	InsertBulk(ctx context.Context, items []*models.XRPAccountKey) error
 	UpdateAddrStatus(
-		accountType domainAccount.AccountType, addrStatus address.AddrStatus, strWIFs []string,
+		ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus, strWIFs []string,
 	) (int64, error)
+	UpdatePendingRegularKey(ctx context.Context, accountID, regularKeyAccountID, regularKeySeed string) (int64, error)
+	ActivateRegularKey(ctx context.Context, accountID, pendingAccountID string) (int64, error)
 }
 
 // AuthFullPubkeyRepositorier is AuthFullPubkeyRepository interface
 type AuthFullPubkeyRepositorier interface {
-	GetOne(authType domainAccount.AuthType) (*models.AuthFullpubkey, error)
-	Insert(authType domainAccount.AuthType, fullPubKey string) error
-	InsertBulk(items []*models.AuthFullpubkey) error
+	GetOne(ctx context.Context, authType domainAccount.AuthType) (*models.AuthFullpubkey, error)
+	Insert(ctx context.Context, authType domainAccount.AuthType, fullPubKey string) error
+	InsertBulk(ctx context.Context, items []*models.AuthFullpubkey) error
 }
 
 // AuthAccountKeyRepositorier is AuthAccountKeyRepository interface
 type AuthAccountKeyRepositorier interface {
-	GetOne(authType domainAccount.AuthType) (*models.AuthAccountKey, error)
-	Insert(item *models.AuthAccountKey) error
-	UpdateAddrStatus(addrStatus address.AddrStatus, strWIF string) (int64, error)
+	GetOne(ctx context.Context, authType domainAccount.AuthType) (*models.AuthAccountKey, error)
+	Insert(ctx context.Context, item *models.AuthAccountKey) error
+	UpdateAddrStatus(ctx context.Context, addrStatus address.AddrStatus, strWIF string) (int64, error)
 }
 
 // Repository interfaces for watch wallet
 
 // AddressRepositorier is AddressRepository interface
 type AddressRepositorier interface {
-	GetAll(accountType domainAccount.AccountType) ([]*models.Address, error)
-	GetAllAddress(accountType domainAccount.AccountType) ([]string, error)
-	GetOneUnAllocated(accountType domainAccount.AccountType) (*models.Address, error)
-	InsertBulk(items []*models.Address) error
-	UpdateIsAllocated(isAllocated bool, Address string) (int64, error)
+	GetAll(ctx context.Context, accountType domainAccount.AccountType) ([]*models.Address, error)
+	GetAllAddress(ctx context.Context, accountType domainAccount.AccountType) ([]string, error)
+	GetOneUnAllocated(ctx context.Context, accountType domainAccount.AccountType) (*models.Address, error)
+	CountUnAllocated(ctx context.Context, accountType domainAccount.AccountType) (int64, error)
+	InsertBulk(ctx context.Context, items []*models.Address) error
+	UpdateIsAllocated(ctx context.Context, isAllocated bool, Address string) (int64, error)
 }
 
 // BTCTxRepositorier is BTCTxRepository interface
 type BTCTxRepositorier interface {
-	GetOne(id int64) (*models.BTCTX, error)
-	GetCountByUnsignedHex(actionType domainTx.ActionType, hex string) (int64, error)
-	GetTxIDBySentHash(actionType domainTx.ActionType, hash string) (int64, error)
-	GetSentHashTx(actionType domainTx.ActionType, txType domainTx.TxType) ([]string, error)
-	InsertUnsignedTx(actionType domainTx.ActionType, txItem *models.BTCTX) (int64, error)
-	Update(txItem *models.BTCTX) (int64, error)
-	UpdateAfterTxSent(txID int64, txType domainTx.TxType, signedHex, sentHashTx string) (int64, error)
-	UpdateTxType(id int64, txType domainTx.TxType) (int64, error)
-	UpdateTxTypeBySentHashTx(actionType domainTx.ActionType, txType domainTx.TxType, sentHashTx string) (int64, error)
-	DeleteAll() (int64, error)
+	GetOne(ctx context.Context, id int64) (*models.BTCTX, error)
+	GetCountByUnsignedHex(ctx context.Context, actionType domainTx.ActionType, hex string) (int64, error)
+	GetTxIDBySentHash(ctx context.Context, actionType domainTx.ActionType, hash string) (int64, error)
+	GetSentHashTx(ctx context.Context, actionType domainTx.ActionType, txType domainTx.TxType) ([]string, error)
+	GetConfirmedFromHeight(ctx context.Context, actionType domainTx.ActionType, blockHeight int64) ([]*models.BTCTX, error)
+	GetOldestUnsignedTime(ctx context.Context) (null.Time, error)
+	InsertUnsignedTx(ctx context.Context, actionType domainTx.ActionType, txItem *models.BTCTX) (int64, error)
+	Update(ctx context.Context, txItem *models.BTCTX) (int64, error)
+	UpdateAfterTxSent(ctx context.Context, txID int64, txType domainTx.TxType, signedHex, sentHashTx string) (int64, error)
+	UpdateBlock(ctx context.Context, id int64, blockHash string, blockHeight int64) (int64, error)
+	UpdateTxType(ctx context.Context, id int64, txType domainTx.TxType) (int64, error)
+	UpdateTxTypeBySentHashTx(
+		ctx context.Context, actionType domainTx.ActionType, txType domainTx.TxType, sentHashTx string,
+	) (int64, error)
+	RollbackToSent(ctx context.Context, id int64) (int64, error)
+	DeleteAll(ctx context.Context) (int64, error)
+	WithTx(dtx *sql.Tx) BTCTxRepositorier
 }
 
 // TxInputRepositorier is TxInputRepository interface
 type TxInputRepositorier interface {
-	GetOne(id int64) (*models.BTCTXInput, error)
-	GetAllByTxID(id int64) ([]*models.BTCTXInput, error)
-	Insert(txItem *models.BTCTXInput) error
-	InsertBulk(txItems []*models.BTCTXInput) error
+	GetOne(ctx context.Context, id int64) (*models.BTCTXInput, error)
+	GetAllByTxID(ctx context.Context, id int64) ([]*models.BTCTXInput, error)
+	Insert(ctx context.Context, txItem *models.BTCTXInput) error
+	InsertBulk(ctx context.Context, txItems []*models.BTCTXInput) error
 }
 
 // TxOutputRepositorier is TxOutputRepository interface
 type TxOutputRepositorier interface {
-	GetOne(id int64) (*models.BTCTXOutput, error)
-	GetAllByTxID(id int64) ([]*models.BTCTXOutput, error)
-	Insert(txItem *models.BTCTXOutput) error
-	InsertBulk(txItems []*models.BTCTXOutput) error
+	GetOne(ctx context.Context, id int64) (*models.BTCTXOutput, error)
+	GetAllByTxID(ctx context.Context, id int64) ([]*models.BTCTXOutput, error)
+	Insert(ctx context.Context, txItem *models.BTCTXOutput) error
+	InsertBulk(ctx context.Context, txItems []*models.BTCTXOutput) error
 }
 
 // TxRepositorier is TxRepository interface
 type TxRepositorier interface {
-	GetOne(id int64) (*models.TX, error)
-	GetMaxID(actionType domainTx.ActionType) (int64, error)
-	InsertUnsignedTx(actionType domainTx.ActionType) (int64, error)
-	Update(txItem *models.TX) (int64, error)
-	DeleteAll() (int64, error)
+	GetOne(ctx context.Context, id int64) (*models.TX, error)
+	GetMaxID(ctx context.Context, actionType domainTx.ActionType) (int64, error)
+	InsertUnsignedTx(ctx context.Context, actionType domainTx.ActionType) (int64, error)
+	Update(ctx context.Context, txItem *models.TX) (int64, error)
+	DeleteAll(ctx context.Context) (int64, error)
+	WithTx(dtx *sql.Tx) TxRepositorier
 }
 
 // PaymentRequestRepositorier is PaymentRequestRepository interface
 type PaymentRequestRepositorier interface {
-	GetAll() ([]*models.PaymentRequest, error)
-	GetAllByPaymentID(paymentID int64) ([]*models.PaymentRequest, error)
-	InsertBulk(items []*models.PaymentRequest) error
-	UpdatePaymentID(paymentID int64, ids []int64) (int64, error)
-	UpdateIsDone(paymentID int64) (int64, error)
-	DeleteAll() (int64, error)
+	GetAll(ctx context.Context) ([]*models.PaymentRequest, error)
+	CountPending(ctx context.Context) (int64, error)
+	GetAllByPaymentID(ctx context.Context, paymentID int64) ([]*models.PaymentRequest, error)
+	InsertBulk(ctx context.Context, items []*models.PaymentRequest) error
+	UpdatePaymentID(ctx context.Context, paymentID int64, ids []int64) (int64, error)
+	UpdateIsDone(ctx context.Context, paymentID int64) (int64, error)
+	ResetIsDone(ctx context.Context, paymentID int64) (int64, error)
+	ResetPaymentID(ctx context.Context, ids []int64) (int64, error)
+	DeleteAll(ctx context.Context) (int64, error)
+	WithTx(dtx *sql.Tx) PaymentRequestRepositorier
 }
 
 // EthDetailTxRepositorier is EthDetailTxRepository interface
 type EthDetailTxRepositorier interface {
-	GetOne(id int64) (*models.EthDetailTX, error)
-	GetAllByTxID(id int64) ([]*models.EthDetailTX, error)
-	GetSentHashTx(txType domainTx.TxType) ([]string, error)
-	Insert(txItem *models.EthDetailTX) error
-	InsertBulk(txItems []*models.EthDetailTX) error
-	UpdateAfterTxSent(uuid string, txType domainTx.TxType, signedHex, sentHashTx string) (int64, error)
-	UpdateTxType(id int64, txType domainTx.TxType) (int64, error)
-	UpdateTxTypeBySentHashTx(txType domainTx.TxType, sentHashTx string) (int64, error)
+	GetOne(ctx context.Context, id int64) (*models.EthDetailTX, error)
+	GetActionBySentHashTx(ctx context.Context, sentHashTx string) (domainTx.ActionType, error)
+	GetAllByTxID(ctx context.Context, id int64) ([]*models.EthDetailTX, error)
+	GetSentHashTx(ctx context.Context, txType domainTx.TxType) ([]string, error)
+	GetOldestUnsignedTime(ctx context.Context) (null.Time, error)
+	Insert(ctx context.Context, txItem *models.EthDetailTX) error
+	InsertBulk(ctx context.Context, txItems []*models.EthDetailTX) error
+	UpdateAfterTxSent(
+		ctx context.Context, uuid string, txType domainTx.TxType, signedHex, sentHashTx string,
+	) (int64, error)
+	UpdateTxType(ctx context.Context, id int64, txType domainTx.TxType) (int64, error)
+	UpdateTxTypeBySentHashTx(ctx context.Context, txType domainTx.TxType, sentHashTx string) (int64, error)
+}
+
+// EthDepositRepositorier is EthDepositRepository interface
+//   - tokenContracts narrows deposits to those detected by one scanner, empty string means native coin
+type EthDepositRepositorier interface {
+	GetAllUnconfirmed(ctx context.Context, tokenContracts []string) ([]*models.EthDeposit, error)
+	InsertBulk(ctx context.Context, items []*models.EthDeposit) (int64, error)
+	UpdateConfirmed(ctx context.Context, id int64) (int64, error)
+	DeleteUnconfirmedFromBlock(ctx context.Context, tokenContracts []string, blockNumber uint64) (int64, error)
+}
+
+// XrpDepositRepositorier is XrpDepositRepository interface
+type XrpDepositRepositorier interface {
+	GetOne(ctx context.Context, id int64) (*models.XRPDeposit, error)
+	GetAllQuarantined(ctx context.Context) ([]*models.XRPDeposit, error)
+	Insert(ctx context.Context, item *models.XRPDeposit) (int64, error)
+	ResolveQuarantine(ctx context.Context, id int64, clientAddress string) (int64, error)
 }
 
 // XrpDetailTxRepositorier is XrpDetailTxRepository interface
 type XrpDetailTxRepositorier interface {
-	GetOne(id int64) (*models.XRPDetailTX, error)
-	GetAllByTxID(id int64) ([]*models.XRPDetailTX, error)
-	GetSentHashTx(txType domainTx.TxType) ([]string, error)
-	Insert(txItem *models.XRPDetailTX) error
-	InsertBulk(txItems []*models.XRPDetailTX) error
+	GetOne(ctx context.Context, id int64) (*models.XRPDetailTX, error)
+	GetOneByUUID(ctx context.Context, uuid string) (*models.XRPDetailTX, error)
+	GetActionBySignedTxID(ctx context.Context, signedTxID string) (domainTx.ActionType, error)
+	GetAllByTxID(ctx context.Context, id int64) ([]*models.XRPDetailTX, error)
+	GetSentHashTx(ctx context.Context, txType domainTx.TxType) ([]string, error)
+	GetTicketSequences(ctx context.Context, senderAddress string) ([]uint64, error)
+	GetExpired(ctx context.Context, ledgerIndex uint64) ([]*models.XRPDetailTX, error)
+	GetOldestUnsignedTime(ctx context.Context) (null.Time, error)
+	Insert(ctx context.Context, txItem *models.XRPDetailTX) error
+	InsertBulk(ctx context.Context, txItems []*models.XRPDetailTX) error
 	UpdateAfterTxSent(
-		uuid string, txType domainTx.TxType, signedTxID, signedTxBlob string, earlistLedgerVersion uint64,
+		ctx context.Context,
+		uuid string,
+		txType domainTx.TxType,
+		signedTxID, signedTxBlob string,
+		earlistLedgerVersion uint64,
 	) (int64, error)
-	UpdateTxType(id int64, txType domainTx.TxType) (int64, error)
-	UpdateTxTypeBySentHashTx(txType domainTx.TxType, sentHashTx string) (int64, error)
+	UpdateTxType(ctx context.Context, id int64, txType domainTx.TxType) (int64, error)
+	UpdateTxTypeFrom(ctx context.Context, id int64, from, to domainTx.TxType) (int64, error)
+	UpdateTxTypeBySentHashTx(ctx context.Context, txType domainTx.TxType, sentHashTx string) (int64, error)
+	UpdateSentTxTypeBySignedTxID(ctx context.Context, txType domainTx.TxType, signedTxID string) (int64, error)
+	WithTx(dtx *sql.Tx) XrpDetailTxRepositorier
+}
+
+// XrpEscrowRepositorier is XrpEscrowRepository interface
+type XrpEscrowRepositorier interface {
+	GetAllOpen(ctx context.Context) ([]*models.XRPEscrow, error)
+	Insert(ctx context.Context, item *models.XRPEscrow) error
+	UpdateFinishUUID(ctx context.Context, id int64, finishUUID string) (int64, error)
+	UpdateStatus(ctx context.Context, id int64, status domainTx.EscrowStatus) (int64, error)
+	WithTx(dtx *sql.Tx) XrpEscrowRepositorier
+}
+
+// SolDetailTxRepositorier is SolDetailTxRepository interface
+type SolDetailTxRepositorier interface {
+	GetOne(ctx context.Context, id int64) (*models.SOLDetailTX, error)
+	GetActionBySentSignature(ctx context.Context, sentSignature string) (domainTx.ActionType, error)
+	GetAllByTxID(ctx context.Context, id int64) ([]*models.SOLDetailTX, error)
+	GetSentSignatures(ctx context.Context, txType domainTx.TxType) ([]string, error)
+	GetOldestUnsignedTime(ctx context.Context) (null.Time, error)
+	Insert(ctx context.Context, txItem *models.SOLDetailTX) error
+	InsertBulk(ctx context.Context, txItems []*models.SOLDetailTX) error
+	UpdateAfterTxSent(
+		ctx context.Context, uuid string, txType domainTx.TxType, signedTx, sentSignature string,
+	) (int64, error)
+	UpdateTxType(ctx context.Context, id int64, txType domainTx.TxType) (int64, error)
+	UpdateTxTypeBySentSignature(ctx context.Context, txType domainTx.TxType, sentSignature string) (int64, error)
+}
+
+// TrxDetailTxRepositorier is TrxDetailTxRepository interface
+type TrxDetailTxRepositorier interface {
+	GetOne(ctx context.Context, id int64) (*models.TRXDetailTX, error)
+	GetActionBySentHashTx(ctx context.Context, sentHashTx string) (domainTx.ActionType, error)
+	GetAllByTxID(ctx context.Context, id int64) ([]*models.TRXDetailTX, error)
+	GetSentHashTx(ctx context.Context, txType domainTx.TxType) ([]string, error)
+	GetOldestUnsignedTime(ctx context.Context) (null.Time, error)
+	Insert(ctx context.Context, txItem *models.TRXDetailTX) error
+	InsertBulk(ctx context.Context, txItems []*models.TRXDetailTX) error
+	UpdateAfterTxSent(
+		ctx context.Context, uuid string, txType domainTx.TxType, signedHex, sentHashTx string,
+	) (int64, error)
+	UpdateTxType(ctx context.Context, id int64, txType domainTx.TxType) (int64, error)
+	UpdateTxTypeBySentHashTx(ctx context.Context, txType domainTx.TxType, sentHashTx string) (int64, error)
+}
+
+// UnsignedTxRepositorier is implemented by transaction repository of each coin
+type UnsignedTxRepositorier interface {
+	GetOldestUnsignedTime(ctx context.Context) (null.Time, error)
+}
+
+// DaemonJobRepositorier is DaemonJobRepository interface
+type DaemonJobRepositorier interface {
+	GetAll(ctx context.Context) ([]*models.DaemonJob, error)
+	SaveStatus(ctx context.Context, status *scheduler.JobStatus) error
+}
+
+// StreamCursorRepositorier is StreamCursorRepository interface
+type StreamCursorRepositorier interface {
+	GetPosition(ctx context.Context, name string) (uint64, error)
+	UpdatePosition(ctx context.Context, name string, position uint64) error
+	GetBlock(ctx context.Context, name string) (uint64, string, error)
+	UpdateBlock(ctx context.Context, name string, position uint64, blockHash string) error
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type createMultisigAddressUseCase struct {
@@ -38,14 +39,17 @@ func NewCreateMultisigAddressUseCase(
 func (u *createMultisigAddressUseCase) Create(
 	ctx context.Context,
 	input keygenusecase.CreateMultisigAddressInput,
-) error {
-	logger.Debug("addmultisigaddress",
+) (err error) {
+	ctx, span := tracer.Start(ctx, "keygen.btc.CreateMultisigAddress.Create")
+	defer tracer.End(span, &err)
+
+	logger.DebugContext(ctx, "addmultisigaddress",
 		"account_type", input.AccountType.String(),
 	)
 
 	// Validate accountType
 	if !u.multisigAccount.IsMultisigAccount(input.AccountType) {
-		logger.Info("only multisig account is allowed")
+		logger.InfoContext(ctx, "only multisig account is allowed")
 		return nil
 	}
 
@@ -60,17 +64,17 @@ func (u *createMultisigAddressUseCase) Create(
 		requiredSig = sigCount
 		for _, authType := range authTypes {
 			// Get record from auth_fullpubkey table
-			fullPubKeyItem, err := u.authFullPubKeyRepo.GetOne(authType)
+			fullPubKeyItem, err := u.authFullPubKeyRepo.GetOne(ctx, authType)
 			if err != nil {
 				return fmt.Errorf("fail to call authFullPubKeyRepo.GetOne() %s: %w", authType.String(), err)
 			}
 			authFullPubKeys = append(authFullPubKeys, fullPubKeyItem.FullPublicKey)
 		}
-		logger.Debug("don't repeat again")
+		logger.DebugContext(ctx, "don't repeat again")
 	}
 
 	// Get target addresses from account_key table, addr_status=AddrStatusPrivKeyImported
-	accountKeyItems, err := u.accountKeyRepo.GetAllAddrStatus(input.AccountType, address.AddrStatusPrivKeyImported)
+	accountKeyItems, err := u.accountKeyRepo.GetAllAddrStatus(ctx, input.AccountType, address.AddrStatusPrivKeyImported)
 	if err != nil {
 		return fmt.Errorf("fail to call accountKeyRepo.GetAllAddrStatus(%s): %w", input.AccountType.String(), err)
 	}
@@ -83,6 +87,7 @@ func (u *createMultisigAddressUseCase) Create(
 
 		var resAddr *btc.AddMultisigAddressResult
 		resAddr, err = u.btc.AddMultisigAddress(
+			ctx,
 			requiredSig,
 			addrs,
 			fmt.Sprintf("multi_%s", input.AccountType), // this is not important
@@ -90,7 +95,8 @@ func (u *createMultisigAddressUseCase) Create(
 		)
 		if err != nil {
 			// [Error] -5: no full public key for address mkPmdpo59gpU7ZioGYwwoMTQJjh7MiqUvd
-			logger.Error(
+			logger.ErrorContext(
+				ctx,
 				"fail to call btc.AddMultisigAddress()",
 				"signature_count", requiredSig,
 				"full public key for accountType", item.FullPublicKey,
@@ -105,7 +111,7 @@ func (u *createMultisigAddressUseCase) Create(
 		item.RedeemScript = resAddr.RedeemScript
 		item.AddrStatus = address.AddrStatusMultisigAddressGenerated.Int8()
 
-		_, err = u.accountKeyRepo.UpdateMultisigAddr(input.AccountType, item)
+		_, err = u.accountKeyRepo.UpdateMultisigAddr(ctx, input.AccountType, item)
 		if err != nil {
 			return fmt.Errorf("fail to call accountKeyRepo.UpdateMultisigAddr(%s): %w", input.AccountType.String(), err)
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/fullpubkey"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type importFullPubkeyUseCase struct {
@@ -36,9 +37,12 @@ func NewImportFullPubkeyUseCase(
 func (u *importFullPubkeyUseCase) Import(
 	ctx context.Context,
 	input keygenusecase.ImportFullPubkeyInput,
-) error {
+) (err error) {
+	ctx, span := tracer.Start(ctx, "keygen.btc.ImportFullPubkey.Import")
+	defer tracer.End(span, &err)
+
 	// Read file for full public key
-	pubKeys, err := u.pubkeyFileRepo.ImportAddress(input.FileName)
+	pubKeys, err := u.pubkeyFileRepo.ImportAddress(ctx, input.FileName)
 	if err != nil {
 		return fmt.Errorf("fail to call pubkeyFileRepo.ImportAddress() fileName: %s: %w", input.FileName, err)
 	}
@@ -61,10 +65,10 @@ func (u *importFullPubkeyUseCase) Import(
 	}
 
 	// TODO: Upsert would be better to prevent error which occur when data is already inserted
-	err = u.authFullPubKeyRepo.InsertBulk(fullPubKeys)
+	err = u.authFullPubKeyRepo.InsertBulk(ctx, fullPubKeys)
 	if err != nil {
 		if strings.Contains(err.Error(), "1062: Duplicate entry") {
-			logger.Info("full-pubkey is already imported")
+			logger.InfoContext(ctx, "full-pubkey is already imported")
 		} else {
 			return fmt.Errorf("fail to call authFullPubKeyRepo.InsertBulk(): %w", err)
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type importPrivateKeyUseCase struct {
@@ -33,19 +34,23 @@ func NewImportPrivateKeyUseCase(
 func (u *importPrivateKeyUseCase) Import(
 	ctx context.Context,
 	input keygenusecase.ImportPrivateKeyInput,
-) error {
+) (err error) {
+	ctx, span := tracer.Start(ctx, "keygen.btc.ImportPrivateKey.Import")
+	defer tracer.End(span, &err)
+
 	// Retrieve records (private key) from account_key table with addr_status=0
-	accountKeyTable, err := u.accountKeyRepo.GetAllAddrStatus(input.AccountType, address.AddrStatusHDKeyGenerated)
+	accountKeyTable, err := u.accountKeyRepo.GetAllAddrStatus(ctx, input.AccountType, address.AddrStatusHDKeyGenerated)
 	if err != nil {
 		return fmt.Errorf("fail to call accountKeyRepo.GetAllAddrStatus(): %w", err)
 	}
 	if len(accountKeyTable) == 0 {
-		logger.Info("no unimported private key")
+		logger.InfoContext(ctx, "no unimported private key")
 		return nil
 	}
 
 	for _, record := range accountKeyTable {
-		logger.Debug(
+		logger.DebugContext(
+			ctx,
 			"target records",
 			"account_type", input.AccountType.String(),
 			"P2PKH_address", record.P2PKHAddress,
@@ -61,11 +66,12 @@ func (u *importPrivateKeyUseCase) Import(
 		}
 
 		// Import private key by WIF without rescan
-		err = u.btc.ImportPrivKeyWithoutReScan(wif, input.AccountType.String())
+		err = u.btc.ImportPrivKeyWithoutReScan(ctx, wif, input.AccountType.String())
 		if err != nil {
 			// Error would be returned sometimes according to condition of bitcoin core
 			// For now, it continues even if error occurred
-			logger.Warn(
+			logger.WarnContext(
+				ctx,
 				"fail to call btc.ImportPrivKeyWithoutReScan()",
 				"wif", record.WalletImportFormat,
 				"error", err)
@@ -74,9 +80,11 @@ func (u *importPrivateKeyUseCase) Import(
 
 		// Update DB
 		_, err = u.accountKeyRepo.UpdateAddrStatus(
+			ctx,
 			input.AccountType, address.AddrStatusPrivKeyImported, []string{record.WalletImportFormat})
 		if err != nil {
-			logger.Error(
+			logger.ErrorContext(
+				ctx,
 				"fail to call accountKeyRepo.UpdateAddrStatus(), but privKey import is done",
 				"target_table", "account_key_account",
 				"account_type", input.AccountType.String(),
@@ -86,7 +94,7 @@ func (u *importPrivateKeyUseCase) Import(
 		}
 
 		// Check address was stored in bitcoin core by importing private key
-		u.checkImportedAddress(record.P2PKHAddress, record.P2SHSegwitAddress, record.FullPublicKey)
+		u.checkImportedAddress(ctx, record.P2PKHAddress, record.P2SHSegwitAddress, record.FullPublicKey)
 	}
 
 	return nil
@@ -94,7 +102,9 @@ func (u *importPrivateKeyUseCase) Import(
 
 // checkImportedAddress checks if address was stored in bitcoin core by importing private key
 // Debug usage
-func (u *importPrivateKeyUseCase) checkImportedAddress(walletAddress, p2shSegwitAddress, fullPublicKey string) {
+func (u *importPrivateKeyUseCase) checkImportedAddress(
+	ctx context.Context, walletAddress, p2shSegwitAddress, fullPublicKey string,
+) {
 	// Note: GetAccount() calls GetAddressInfo() internally
 
 	var (
@@ -103,45 +113,53 @@ func (u *importPrivateKeyUseCase) checkImportedAddress(walletAddress, p2shSegwit
 	)
 
 	switch u.btc.CoinTypeCode() {
-	case domainCoin.BTC:
+	case domainCoin.BTC, domainCoin.LTC:
 		targetAddr = p2shSegwitAddress
 		addrType = address.AddrTypeP2shSegwit
 	case domainCoin.BCH:
 		targetAddr = walletAddress
 		addrType = address.AddrTypeBCHCashAddr
-	case domainCoin.LTC, domainCoin.ETH, domainCoin.XRP, domainCoin.ERC20, domainCoin.HYT:
-		logger.Warn("this coin type is not implemented in checkImportedAddress()",
+	case domainCoin.DOGE:
+		targetAddr = walletAddress
+		addrType = address.AddrTypeLegacy
+	case domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE, domainCoin.XRP,
+		domainCoin.SOL, domainCoin.TRX, domainCoin.ERC20, domainCoin.HYT:
+		logger.WarnContext(ctx, "this coin type is not implemented in checkImportedAddress()",
 			"coin_type_code", u.btc.CoinTypeCode().String())
 		return
 	default:
-		logger.Warn("this coin type is not implemented in checkImportedAddress()",
+		logger.WarnContext(ctx, "this coin type is not implemented in checkImportedAddress()",
 			"coin_type_code", u.btc.CoinTypeCode().String())
 		return
 	}
 
 	// Call `getaccount` by target_address
-	acnt, err := u.btc.GetAccount(targetAddr)
+	acnt, err := u.btc.GetAccount(ctx, targetAddr)
 	if err != nil {
-		logger.Warn(
+		logger.WarnContext(
+			ctx,
 			"fail to call btc.GetAccount()",
 			addrType.String(), targetAddr,
 			"error", err)
 		return
 	}
-	logger.Debug(
+	logger.DebugContext(
+		ctx,
 		"account is found",
 		"account", acnt,
 		addrType.String(), targetAddr)
 
 	// Call `getaddressinfo` by target_address
-	addrInfo, err := u.btc.GetAddressInfo(targetAddr)
+	addrInfo, err := u.btc.GetAddressInfo(ctx, targetAddr)
 	if err != nil {
-		logger.Warn(
+		logger.WarnContext(
+			ctx,
 			"fail to call btc.GetAddressInfo()",
 			addrType.String(), targetAddr,
 			"error", err)
 	} else if addrInfo.Pubkey != fullPublicKey {
-		logger.Warn(
+		logger.WarnContext(
+			ctx,
 			"pubkey is not matched",
 			"in_bitcoin_core", addrInfo.Pubkey,
 			"in_database", fullPublicKey)
@@ -13,6 +13,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type signTransactionUseCase struct {
@@ -40,7 +41,10 @@ func NewSignTransactionUseCase(
 func (u *signTransactionUseCase) Sign(
 	ctx context.Context,
 	input keygenusecase.SignTransactionInput,
-) (keygenusecase.SignTransactionOutput, error) {
+) (_ keygenusecase.SignTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "keygen.btc.SignTransaction.Sign")
+	defer tracer.End(span, &err)
+
 	// Get tx_deposit_id from tx file name
 	//  if payment_5_unsigned_0_1534466246366489473.psbt, 5 is target
 	actionType, _, txID, signedCount, err := u.txFileRepo.ValidateFilePath(input.FilePath, domainTx.TxTypeUnsigned)
@@ -49,13 +53,13 @@ func (u *signTransactionUseCase) Sign(
 	}
 
 	// Read PSBT from file
-	psbtBase64, err := u.txFileRepo.ReadPSBTFile(input.FilePath)
+	psbtBase64, err := u.txFileRepo.ReadPSBTFile(ctx, input.FilePath)
 	if err != nil {
 		return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to read PSBT file: %w", err)
 	}
 
 	// Sign PSBT (passing actionType to infer sender account)
-	signedPSBT, isSigned, err := u.sign(psbtBase64, actionType)
+	signedPSBT, isSigned, err := u.sign(ctx, psbtBase64, actionType)
 	if err != nil {
 		return keygenusecase.SignTransactionOutput{}, err
 	}
@@ -69,12 +73,12 @@ func (u *signTransactionUseCase) Sign(
 
 	// Write signed PSBT file
 	path := u.txFileRepo.CreateFilePath(actionType, txType, txID, signedCount)
-	generatedFileName, err := u.txFileRepo.WritePSBTFile(path, signedPSBT)
+	generatedFileName, err := u.txFileRepo.WritePSBTFile(ctx, path, signedPSBT)
 	if err != nil {
 		return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to write signed PSBT file: %w", err)
 	}
 
-	logger.Debug("signed PSBT",
+	logger.DebugContext(ctx, "signed PSBT",
 		"action", actionType.String(),
 		"txID", txID,
 		"signedCount", signedCount,
@@ -106,7 +110,7 @@ func (u *signTransactionUseCase) Sign(
 //
 // Note: This operates OFFLINE - no Bitcoin Core RPC required.
 func (u *signTransactionUseCase) sign(
-	psbtBase64 string,
+	ctx context.Context, psbtBase64 string,
 	actionType domainTx.ActionType,
 ) (string, bool, error) {
 	// Infer sender account from action type
@@ -117,12 +121,12 @@ func (u *signTransactionUseCase) sign(
 	}
 
 	// Sign PSBT with keys from sender account
-	signedPSBT, isSigned, err := u.signWithAccount(psbtBase64, senderAccount)
+	signedPSBT, isSigned, err := u.signWithAccount(ctx, psbtBase64, senderAccount)
 	if err != nil {
 		return "", false, err
 	}
 
-	logger.Debug("PSBT signing completed",
+	logger.DebugContext(ctx, "PSBT signing completed",
 		"action", actionType.String(),
 		"sender_account", senderAccount.String(),
 		"isSigned", isSigned,
@@ -161,12 +165,13 @@ func inferSenderAccount(actionType domainTx.ActionType) (domainAccount.AccountTy
 // 2. Extract WIFs from retrieved keys
 // 3. Pass all WIFs to SignPSBTWithKey - btcd will use only matching keys
 func (u *signTransactionUseCase) signWithAccount(
-	psbtBase64 string,
+	ctx context.Context, psbtBase64 string,
 	senderAccount domainAccount.AccountType,
 ) (string, bool, error) {
 	// Get all exported keys for this account
 	// Using AddrStatusAddressExported ensures keys are ready and have been exported to watch wallet
 	accountKeys, err := u.accountKeyRepo.GetAllAddrStatus(
+		ctx,
 		senderAccount,
 		address.AddrStatusAddressExported,
 	)
@@ -190,7 +195,7 @@ func (u *signTransactionUseCase) signWithAccount(
 		return "", false, fmt.Errorf("no valid WIFs found for account %s", senderAccount.String())
 	}
 
-	logger.Debug("signing PSBT with account keys",
+	logger.DebugContext(ctx, "signing PSBT with account keys",
 		"account", senderAccount.String(),
 		"key_count", len(accountKeys),
 		"wif_count", len(wifs),
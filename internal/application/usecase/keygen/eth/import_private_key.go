@@ -13,6 +13,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type importPrivateKeyUseCase struct {
@@ -34,24 +35,28 @@ func NewImportPrivateKeyUseCase(
 func (u *importPrivateKeyUseCase) Import(
 	ctx context.Context,
 	input keygenusecase.ImportPrivateKeyInput,
-) error {
+) (err error) {
+	ctx, span := tracer.Start(ctx, "keygen.eth.ImportPrivateKey.Import")
+	defer tracer.End(span, &err)
+
 	// Retrieve records (private key) from account_key table with addr_status=0
-	accountKeyTable, err := u.accountKeyRepo.GetAllAddrStatus(input.AccountType, address.AddrStatusHDKeyGenerated)
+	accountKeyTable, err := u.accountKeyRepo.GetAllAddrStatus(ctx, input.AccountType, address.AddrStatusHDKeyGenerated)
 	if err != nil {
 		return fmt.Errorf("fail to call accountKeyRepo.GetAllAddrStatus(): %w", err)
 	}
 	if len(accountKeyTable) == 0 {
-		logger.Info("no unimported private key")
+		logger.InfoContext(ctx, "no unimported private key")
 		return nil
 	}
 
 	// Keystore directory is linked to any APIs to get accounts
 	// So multiple directories are not good idea
-	logger.Debug("NewKeyStore", "key_dir", u.eth.GetKeyDir())
+	logger.DebugContext(ctx, "NewKeyStore", "key_dir", u.eth.GetKeyDir())
 	ks := keystore.NewKeyStore(u.eth.GetKeyDir(), keystore.StandardScryptN, keystore.StandardScryptP)
 
 	for _, record := range accountKeyTable {
-		logger.Debug(
+		logger.DebugContext(
+			ctx,
 			"target records",
 			"account_type", input.AccountType.String(),
 			"address", record.P2PKHAddress,
@@ -60,7 +65,8 @@ func (u *importPrivateKeyUseCase) Import(
 		// Convert private key to ECDSA
 		ecdsaKey, convertErr := u.eth.ToECDSA(record.WalletImportFormat)
 		if convertErr != nil {
-			logger.Warn(
+			logger.WarnContext(
+				ctx,
 				"fail to call eth.ToECDSA()",
 				"private key", record.WalletImportFormat,
 				"error", convertErr)
@@ -75,14 +81,15 @@ func (u *importPrivateKeyUseCase) Import(
 		if err != nil {
 			// It continues even if error occurred
 			// Because database stores status, import run again by same command for this key
-			logger.Warn(
+			logger.WarnContext(
+				ctx,
 				"fail to call ks.ImportECDSA()",
 				"private key", record.WalletImportFormat,
 				"error", err)
 			return fmt.Errorf("fail to call ks.ImportECDSA(): %w", err)
 		}
 
-		logger.Debug("key account is generated",
+		logger.DebugContext(ctx, "key account is generated",
 			"account.Address.Hex()", acct.Address.Hex(),
 			"account.Address.String()", acct.Address.String(),
 			"account.URL.String()", acct.URL.String(),
@@ -90,7 +97,7 @@ func (u *importPrivateKeyUseCase) Import(
 
 		// Check generated address
 		if acct.Address.Hex() != record.P2PKHAddress {
-			logger.Warn("inconsistency between generated address",
+			logger.WarnContext(ctx, "inconsistency between generated address",
 				"old_address", record.P2PKHAddress,
 				"new_address", acct.Address.Hex(),
 			)
@@ -98,9 +105,11 @@ func (u *importPrivateKeyUseCase) Import(
 
 		// Update DB
 		_, err = u.accountKeyRepo.UpdateAddrStatus(
+			ctx,
 			input.AccountType, address.AddrStatusPrivKeyImported, []string{record.WalletImportFormat})
 		if err != nil {
-			logger.Error(
+			logger.ErrorContext(
+				ctx,
 				"fail to call accountKeyRepo.UpdateAddrStatus(), but privKey import is done",
 				"target_table", "account_key_account",
 				"account_type", input.AccountType.String(),
@@ -12,6 +12,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/pkg/serial"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type signTransactionUseCase struct {
@@ -33,7 +34,10 @@ func NewSignTransactionUseCase(
 func (u *signTransactionUseCase) Sign(
 	ctx context.Context,
 	input keygenusecase.SignTransactionInput,
-) (keygenusecase.SignTransactionOutput, error) {
+) (_ keygenusecase.SignTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "keygen.eth.SignTransaction.Sign")
+	defer tracer.End(span, &err)
+
 	// Get tx_deposit_id from tx file name
 	actionType, _, txID, signedCount, err := u.txFileRepo.ValidateFilePath(input.FilePath, domainTx.TxTypeUnsigned)
 	if err != nil {
@@ -41,7 +45,7 @@ func (u *signTransactionUseCase) Sign(
 	}
 
 	// Get hex tx from file
-	data, err := u.txFileRepo.ReadFileSlice(input.FilePath)
+	data, err := u.txFileRepo.ReadFileSlice(ctx, input.FilePath)
 	if err != nil {
 		return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.ReadFileSlice(): %w", err)
 	}
@@ -68,7 +72,7 @@ func (u *signTransactionUseCase) Sign(
 
 	// Write file
 	path := u.txFileRepo.CreateFilePath(actionType, domainTx.TxTypeSigned, txID, signedCount+1)
-	generatedFileName, err := u.txFileRepo.WriteFileSlice(path, txHexs)
+	generatedFileName, err := u.txFileRepo.WriteFileSlice(ctx, path, txHexs)
 	if err != nil {
 		return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.WriteFileSlice(): %w", err)
 	}
@@ -43,6 +43,16 @@ type GenerateKeyUseCase interface {
 	Generate(ctx context.Context, input GenerateKeyInput) error
 }
 
+// CreateRegularKeyUseCase creates pending regular keys to set or rotate regular key (XRP only)
+type CreateRegularKeyUseCase interface {
+	Create(ctx context.Context, input CreateRegularKeyInput) (CreateRegularKeyOutput, error)
+}
+
+// ImportRegularKeyUseCase activates regular keys found in ledger by watch wallet (XRP only)
+type ImportRegularKeyUseCase interface {
+	Import(ctx context.Context, input ImportRegularKeyInput) error
+}
+
 // SignTransactionUseCase signs unsigned transactions (first signature for multisig)
 type SignTransactionUseCase interface {
 	Sign(ctx context.Context, input SignTransactionInput) (SignTransactionOutput, error)
@@ -103,6 +113,23 @@ type ImportFullPubkeyInput struct {
 	FileName string
 }
 
+// CreateRegularKeyInput represents input for creating pending regular keys (XRP)
+type CreateRegularKeyInput struct {
+	AccountType domainAccount.AccountType
+	// replace regular key which is already active
+	Rotate bool
+}
+
+// CreateRegularKeyOutput represents output from creating pending regular keys (XRP)
+type CreateRegularKeyOutput struct {
+	FileName string
+}
+
+// ImportRegularKeyInput represents input for importing regular keys found in ledger (XRP)
+type ImportRegularKeyInput struct {
+	FileName string
+}
+
 // GenerateKeyInput represents input for generating keys (XRP)
 type GenerateKeyInput struct {
 	AccountType domainAccount.AccountType
@@ -16,6 +16,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type exportAddressUseCase struct {
@@ -43,35 +44,43 @@ func NewExportAddressUseCase(
 func (u *exportAddressUseCase) Export(
 	ctx context.Context,
 	input keygenusecase.ExportAddressInput,
-) (keygenusecase.ExportAddressOutput, error) {
+) (_ keygenusecase.ExportAddressOutput, err error) {
+	ctx, span := tracer.Start(ctx, "keygen.ExportAddress.Export")
+	defer tracer.End(span, &err)
+
 	// Get target status for account based on coin type
 	var targetAddrStatus address.AddrStatus
 	switch u.coinTypeCode {
-	case domainCoin.BTC, domainCoin.BCH:
+	case domainCoin.BTC, domainCoin.BCH, domainCoin.LTC, domainCoin.DOGE:
 		if !u.multisigAccount.IsMultisigAccount(input.AccountType) {
 			// non-multisig account
 			targetAddrStatus = address.AddrStatusPrivKeyImported
 		} else {
 			targetAddrStatus = address.AddrStatusMultisigAddressGenerated
 		}
-	case domainCoin.ETH:
+	case domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE:
 		targetAddrStatus = address.AddrStatusPrivKeyImported
-	case domainCoin.XRP:
+	case domainCoin.XRP, domainCoin.SOL, domainCoin.TRX:
 		targetAddrStatus = address.AddrStatusHDKeyGenerated
-	case domainCoin.LTC, domainCoin.ERC20, domainCoin.HYT:
+	case domainCoin.ERC20, domainCoin.HYT:
 		return keygenusecase.ExportAddressOutput{}, fmt.Errorf("coinType[%s] is not implemented yet", u.coinTypeCode)
 	default:
+		// EVM chain defined in config
+		if domainCoin.IsEVMChain(u.coinTypeCode) {
+			targetAddrStatus = address.AddrStatusPrivKeyImported
+			break
+		}
 		return keygenusecase.ExportAddressOutput{}, fmt.Errorf("coinType[%s] is not implemented yet", u.coinTypeCode)
 	}
 
 	// Get account key
-	accountKeyTable, err := u.accountKeyRepo.GetAllAddrStatus(input.AccountType, targetAddrStatus)
+	accountKeyTable, err := u.accountKeyRepo.GetAllAddrStatus(ctx, input.AccountType, targetAddrStatus)
 	if err != nil {
 		return keygenusecase.ExportAddressOutput{},
 			fmt.Errorf("fail to call accountKeyRepo.GetAllAddrStatus(): %w", err)
 	}
 	if len(accountKeyTable) == 0 {
-		logger.Info("no records to export in account_key table")
+		logger.InfoContext(ctx, "no records to export in account_key table")
 		return keygenusecase.ExportAddressOutput{
 			FileName: "",
 		}, nil
@@ -88,7 +97,7 @@ func (u *exportAddressUseCase) Export(
 	for idx, record := range accountKeyTable {
 		updatedItems[idx] = record.WalletImportFormat
 	}
-	_, err = u.accountKeyRepo.UpdateAddrStatus(input.AccountType, address.AddrStatusAddressExported, updatedItems)
+	_, err = u.accountKeyRepo.UpdateAddrStatus(ctx, input.AccountType, address.AddrStatusAddressExported, updatedItems)
 	if err != nil {
 		return keygenusecase.ExportAddressOutput{},
 			fmt.Errorf("fail to call accountKeyRepo.UpdateAddrStatus(): %w", err)
@@ -11,6 +11,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/wallet/key"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type generateHDWalletUseCase struct {
@@ -35,18 +36,21 @@ func NewGenerateHDWalletUseCase(
 func (u *generateHDWalletUseCase) Generate(
 	ctx context.Context,
 	input keygenusecase.GenerateHDWalletInput,
-) (keygenusecase.GenerateHDWalletOutput, error) {
-	logger.Debug("generate HDWallet", "account_type", input.AccountType.String())
+) (_ keygenusecase.GenerateHDWalletOutput, err error) {
+	ctx, span := tracer.Start(ctx, "keygen.GenerateHDWallet.Generate")
+	defer tracer.End(span, &err)
+
+	logger.DebugContext(ctx, "generate HDWallet", "account_type", input.AccountType.String())
 
 	// Get latest index
-	idxFrom, err := u.repo.GetMaxIndex(input.AccountType)
+	idxFrom, err := u.repo.GetMaxIndex(ctx, input.AccountType)
 	if err != nil {
-		logger.Info(err.Error())
+		logger.InfoContext(ctx, err.Error())
 		return keygenusecase.GenerateHDWalletOutput{
 			GeneratedCount: 0,
 		}, nil
 	}
-	logger.Debug("max_index",
+	logger.DebugContext(ctx, "max_index",
 		"account_type", input.AccountType.String(),
 		"current_index", idxFrom,
 	)
@@ -58,7 +62,7 @@ func (u *generateHDWalletUseCase) Generate(
 	}
 
 	// Insert key information to account_key_table / auth_account_key_table
-	err = u.repo.Insert(walletKeys, idxFrom, u.coinTypeCode, input.AccountType, u.keygen.KeyType())
+	err = u.repo.Insert(ctx, walletKeys, idxFrom, u.coinTypeCode, input.AccountType, u.keygen.KeyType())
 	if err != nil {
 		return keygenusecase.GenerateHDWalletOutput{}, fmt.Errorf("fail to call repo.Insert(): %w", err)
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/wallet/key"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type generateSeedUseCase struct {
@@ -22,9 +23,12 @@ func NewGenerateSeedUseCase(seedRepo cold.SeedRepositorier) keygenusecase.Genera
 	}
 }
 
-func (u *generateSeedUseCase) Generate(ctx context.Context) (keygenusecase.GenerateSeedOutput, error) {
+func (u *generateSeedUseCase) Generate(ctx context.Context) (_ keygenusecase.GenerateSeedOutput, err error) {
+	ctx, span := tracer.Start(ctx, "keygen.GenerateSeed.Generate")
+	defer tracer.End(span, &err)
+
 	// Try to retrieve existing seed from database
-	bSeed, err := u.retrieveSeed()
+	bSeed, err := u.retrieveSeed(ctx)
 	if err == nil {
 		return keygenusecase.GenerateSeedOutput{
 			Seed: bSeed,
@@ -39,7 +43,7 @@ func (u *generateSeedUseCase) Generate(ctx context.Context) (keygenusecase.Gener
 	strSeed := key.SeedToString(bSeed)
 
 	// Insert seed in database
-	err = u.seedRepo.Insert(strSeed)
+	err = u.seedRepo.Insert(ctx, strSeed)
 	if err != nil {
 		return keygenusecase.GenerateSeedOutput{}, fmt.Errorf("fail to call seedRepo.Insert(): %w", err)
 	}
@@ -52,7 +56,10 @@ func (u *generateSeedUseCase) Generate(ctx context.Context) (keygenusecase.Gener
 func (u *generateSeedUseCase) Store(
 	ctx context.Context,
 	input keygenusecase.StoreSeedInput,
-) (keygenusecase.StoreSeedOutput, error) {
+) (_ keygenusecase.StoreSeedOutput, err error) {
+	ctx, span := tracer.Start(ctx, "keygen.GenerateSeed.Store")
+	defer tracer.End(span, &err)
+
 	// Convert seed string to bytes
 	bSeed, err := key.SeedToByte(input.Seed)
 	if err != nil {
@@ -60,7 +67,7 @@ func (u *generateSeedUseCase) Store(
 	}
 
 	// Insert seed in database
-	err = u.seedRepo.Insert(input.Seed)
+	err = u.seedRepo.Insert(ctx, input.Seed)
 	if err != nil {
 		return keygenusecase.StoreSeedOutput{}, fmt.Errorf("fail to call seedRepo.Insert(): %w", err)
 	}
@@ -71,11 +78,11 @@ func (u *generateSeedUseCase) Store(
 }
 
 // retrieveSeed retrieves seed from database
-func (u *generateSeedUseCase) retrieveSeed() ([]byte, error) {
+func (u *generateSeedUseCase) retrieveSeed(ctx context.Context) ([]byte, error) {
 	// Get seed from database, seed is expected to have only one record
-	seed, err := u.seedRepo.GetOne()
+	seed, err := u.seedRepo.GetOne(ctx)
 	if err == nil && seed.Seed != "" {
-		logger.Info("seed have already been generated")
+		logger.InfoContext(ctx, "seed have already been generated")
 		return key.SeedToByte(seed.Seed)
 	}
 	if err != nil {
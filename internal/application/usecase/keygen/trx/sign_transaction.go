@@ -0,0 +1,122 @@
+package trx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	keygenusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron/trx"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/serial"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type signTransactionUseCase struct {
+	trx            tron.Troner
+	accountKeyRepo cold.AccountKeyRepositorier
+	txFileRepo     file.TransactionFileRepositorier
+}
+
+// NewSignTransactionUseCase creates a new SignTransactionUseCase for TRX keygen
+//   - transaction is signed offline by private key stored in account_key table
+func NewSignTransactionUseCase(
+	trx tron.Troner,
+	accountKeyRepo cold.AccountKeyRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+) keygenusecase.SignTransactionUseCase {
+	return &signTransactionUseCase{
+		trx:            trx,
+		accountKeyRepo: accountKeyRepo,
+		txFileRepo:     txFileRepo,
+	}
+}
+
+func (u *signTransactionUseCase) Sign(
+	ctx context.Context,
+	input keygenusecase.SignTransactionInput,
+) (_ keygenusecase.SignTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "keygen.trx.SignTransaction.Sign")
+	defer tracer.End(span, &err)
+
+	// Get tx_deposit_id from tx file name
+	actionType, _, txID, signedCount, err := u.txFileRepo.ValidateFilePath(input.FilePath, domainTx.TxTypeUnsigned)
+	if err != nil {
+		return keygenusecase.SignTransactionOutput{}, err
+	}
+
+	// Get serialized tx from file
+	data, err := u.txFileRepo.ReadFileSlice(ctx, input.FilePath)
+	if err != nil {
+		return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.ReadFileSlice(): %w", err)
+	}
+	if len(data) <= 1 {
+		return keygenusecase.SignTransactionOutput{}, errors.New("file is invalid")
+	}
+	senderAccount := domainAccount.AccountType(data[0])
+	serializedTxs := data[1:]
+
+	// secret keys of sender account
+	secrets, err := u.getSecrets(ctx, senderAccount)
+	if err != nil {
+		return keygenusecase.SignTransactionOutput{}, err
+	}
+
+	signedTxs := make([]string, 0, len(serializedTxs))
+	for _, serializedTx := range serializedTxs {
+		var rawTx trx.RawTx
+		if err = serial.DecodeFromString(serializedTx, &rawTx); err != nil {
+			return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to call serial.DecodeFromString(): %w", err)
+		}
+		secret, ok := secrets[rawTx.From]
+		if !ok {
+			return keygenusecase.SignTransactionOutput{},
+				fmt.Errorf("private key of %s is not found in %s account", rawTx.From, senderAccount.String())
+		}
+
+		// Sign
+		var signedRawTx *trx.RawTx
+		signedRawTx, err = u.trx.SignRawTransaction(&rawTx, secret)
+		if err != nil {
+			return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to call trx.SignRawTransaction(): %w", err)
+		}
+		logger.DebugContext(ctx, "signed_tx",
+			"uuid", rawTx.UUID, "txid", signedRawTx.TxID, "expiration", rawTx.Expiration)
+		signedTxs = append(signedTxs, fmt.Sprintf("%s,%s", rawTx.UUID, signedRawTx.TxHex))
+	}
+
+	// Write file
+	path := u.txFileRepo.CreateFilePath(actionType, domainTx.TxTypeSigned, txID, signedCount+1)
+	generatedFileName, err := u.txFileRepo.WriteFileSlice(ctx, path, signedTxs)
+	if err != nil {
+		return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.WriteFileSlice(): %w", err)
+	}
+
+	return keygenusecase.SignTransactionOutput{
+		FilePath:      generatedFileName,
+		IsDone:        true,
+		SignedCount:   1, // TRX signs one transaction at a time
+		UnsignedCount: 0,
+	}, nil
+}
+
+// getSecrets returns map of address and secret key of exported addresses
+func (u *signTransactionUseCase) getSecrets(
+	ctx context.Context, accountType domainAccount.AccountType,
+) (map[string]string, error) {
+	accountKeys, err := u.accountKeyRepo.GetAllAddrStatus(ctx, accountType, address.AddrStatusAddressExported)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call accountKeyRepo.GetAllAddrStatus(): %w", err)
+	}
+	secrets := make(map[string]string, len(accountKeys))
+	for _, accountKey := range accountKeys {
+		secrets[accountKey.P2PKHAddress] = accountKey.WalletImportFormat
+	}
+	return secrets, nil
+}
@@ -0,0 +1,110 @@
+package xrp
+
+import (
+	"context"
+	"fmt"
+
+	keygenusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/regularkey"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type createRegularKeyUseCase struct {
+	xrp                ripple.Rippler
+	xrpAccountKeyRepo  cold.XRPAccountKeyRepositorier
+	regularKeyFileRepo file.RegularKeyFileRepositorier
+}
+
+// NewCreateRegularKeyUseCase creates a new CreateRegularKeyUseCase
+//   - new regular key is generated and kept as pending key until it's found in ledger by watch wallet
+//   - pending key of exported accounts is exported as csv file, watch wallet creates SetRegularKey from it
+//   - pending key is activated by `import regularkey`, then signing uses it
+func NewCreateRegularKeyUseCase(
+	xrpAPI ripple.Rippler,
+	xrpAccountKeyRepo cold.XRPAccountKeyRepositorier,
+	regularKeyFileRepo file.RegularKeyFileRepositorier,
+) keygenusecase.CreateRegularKeyUseCase {
+	return &createRegularKeyUseCase{
+		xrp:                xrpAPI,
+		xrpAccountKeyRepo:  xrpAccountKeyRepo,
+		regularKeyFileRepo: regularKeyFileRepo,
+	}
+}
+
+func (u *createRegularKeyUseCase) Create(
+	ctx context.Context,
+	input keygenusecase.CreateRegularKeyInput,
+) (_ keygenusecase.CreateRegularKeyOutput, err error) {
+	ctx, span := tracer.Start(ctx, "keygen.xrp.CreateRegularKey.Create")
+	defer tracer.End(span, &err)
+
+	// Get target accounts from xrp_account_key table
+	items, err := u.xrpAccountKeyRepo.GetAllAddrStatus(ctx, input.AccountType, address.AddrStatusAddressExported)
+	if err != nil {
+		return keygenusecase.CreateRegularKeyOutput{},
+			fmt.Errorf("fail to call xrpAccountKeyRepo.GetAllAddrStatus(%s): %w", input.AccountType.String(), err)
+	}
+
+	lines := make([]string, 0, len(items))
+	for _, item := range items {
+		var regularKey string
+		switch {
+		case item.PendingRegularKeyAccountID != "":
+			// previous SetRegularKey may still be in flight, the same key is used so that either one can be validated
+			regularKey = item.PendingRegularKeyAccountID
+		case domainKey.XRPActiveKey(item.ActiveKey) == domainKey.XRPActiveKeyRegular && !input.Rotate:
+			continue
+		default:
+			regularKey, err = u.generatePendingRegularKey(ctx, item)
+			if err != nil {
+				return keygenusecase.CreateRegularKeyOutput{}, err
+			}
+		}
+		lines = append(lines, regularkey.CreateLine(u.xrp.CoinTypeCode(), input.AccountType, item.AccountID, regularKey))
+	}
+	if len(lines) == 0 {
+		logger.InfoContext(ctx, "no account to set regular key", "account_type", input.AccountType.String())
+		return keygenusecase.CreateRegularKeyOutput{}, nil
+	}
+
+	fileName := u.regularKeyFileRepo.CreateFilePath(input.AccountType, false)
+	if err = u.regularKeyFileRepo.WriteFile(ctx, fileName, lines); err != nil {
+		return keygenusecase.CreateRegularKeyOutput{},
+			fmt.Errorf("fail to call regularKeyFileRepo.WriteFile(): %w", err)
+	}
+
+	logger.InfoContext(ctx, "pending regular keys are exported, create SetRegularKey by watch wallet",
+		"account_type", input.AccountType.String(),
+		"accounts", len(lines),
+		"file", fileName,
+	)
+	return keygenusecase.CreateRegularKeyOutput{FileName: fileName}, nil
+}
+
+// generatePendingRegularKey generates random key pair and stores it as pending regular key of account
+func (u *createRegularKeyUseCase) generatePendingRegularKey(
+	ctx context.Context, item *models.XRPAccountKey,
+) (string, error) {
+	// empty passphrase generates random seed
+	generatedKey, err := u.xrp.WalletPropose(ctx, "")
+	if err != nil {
+		return "", fmt.Errorf("fail to call xrp.WalletPropose(): %w", err)
+	}
+	if generatedKey.Error != "" {
+		return "", fmt.Errorf("fail to call xrp.WalletPropose() %s", generatedKey.Error)
+	}
+
+	_, err = u.xrpAccountKeyRepo.UpdatePendingRegularKey(
+		ctx, item.AccountID, generatedKey.Result.AccountID, generatedKey.Result.MasterSeed)
+	if err != nil {
+		return "", fmt.Errorf("fail to call xrpAccountKeyRepo.UpdatePendingRegularKey(): %w", err)
+	}
+	return generatedKey.Result.AccountID, nil
+}
@@ -14,6 +14,7 @@ import (
 	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type generateKeyUseCase struct {
@@ -41,14 +42,17 @@ func NewGenerateKeyUseCase(
 	}
 }
 
-func (u *generateKeyUseCase) Generate(ctx context.Context, input keygenusecase.GenerateKeyInput) error {
+func (u *generateKeyUseCase) Generate(ctx context.Context, input keygenusecase.GenerateKeyInput) (err error) {
+	ctx, span := tracer.Start(ctx, "keygen.xrp.GenerateKey.Generate")
+	defer tracer.End(span, &err)
+
 	// Convert interface{} to []domainKey.WalletKey
 	walletKeys, ok := input.WalletKeys.([]domainKey.WalletKey)
 	if !ok {
 		return errors.New("invalid wallet keys type")
 	}
 
-	logger.Debug("generate keys for XRP",
+	logger.DebugContext(ctx, "generate keys for XRP",
 		"account_type", input.AccountType.String(),
 		"len(keys)", len(walletKeys),
 	)
@@ -97,14 +101,14 @@ func (u *generateKeyUseCase) Generate(ctx context.Context, input keygenusecase.G
 		})
 
 		// Update account_key table for address as ripple address
-		_, err = u.accountKeyRepo.UpdateAddr(input.AccountType, generatedKey.Result.AccountID, v.P2SHSegWitAddr)
+		_, err = u.accountKeyRepo.UpdateAddr(ctx, input.AccountType, generatedKey.Result.AccountID, v.P2SHSegWitAddr)
 		if err != nil {
 			return fmt.Errorf("fail to call accountKeyRepo.UpdateAddr(): %w", err)
 		}
 	}
 
 	// Insert keys to DB
-	err = u.xrpAccountKeyRepo.InsertBulk(items)
+	err = u.xrpAccountKeyRepo.InsertBulk(ctx, items)
 	if err != nil {
 		return fmt.Errorf("fail to call xrpAccountKeyRepo.InsertBulk() for XRP: %w", err)
 	}
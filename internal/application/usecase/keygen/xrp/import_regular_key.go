@@ -0,0 +1,92 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	keygenusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/regularkey"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type importRegularKeyUseCase struct {
+	xrpAccountKeyRepo  cold.XRPAccountKeyRepositorier
+	regularKeyFileRepo file.RegularKeyFileRepositorier
+}
+
+// NewImportRegularKeyUseCase creates a new ImportRegularKeyUseCase
+//   - file is regular keys which watch wallet found in ledger, pending key is activated for signing
+//   - ledger isn't accessed, so keygen wallet can stay offline
+func NewImportRegularKeyUseCase(
+	xrpAccountKeyRepo cold.XRPAccountKeyRepositorier,
+	regularKeyFileRepo file.RegularKeyFileRepositorier,
+) keygenusecase.ImportRegularKeyUseCase {
+	return &importRegularKeyUseCase{
+		xrpAccountKeyRepo:  xrpAccountKeyRepo,
+		regularKeyFileRepo: regularKeyFileRepo,
+	}
+}
+
+func (u *importRegularKeyUseCase) Import(
+	ctx context.Context,
+	input keygenusecase.ImportRegularKeyInput,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "keygen.xrp.ImportRegularKey.Import")
+	defer tracer.End(span, &err)
+
+	lines, err := u.regularKeyFileRepo.ReadFile(ctx, input.FileName)
+	if err != nil {
+		return fmt.Errorf("fail to call regularKeyFileRepo.ReadFile(): %w", err)
+	}
+
+	var activated int
+	for _, line := range lines {
+		var rk *regularkey.RegularKeyFormat
+		rk, err = regularkey.ConvertLine(domainCoin.XRP, strings.Split(line, ","))
+		if err != nil {
+			return err
+		}
+		var item *models.XRPAccountKey
+		item, err = u.xrpAccountKeyRepo.GetOne(ctx, rk.AccountType, rk.Address)
+		if err != nil {
+			return fmt.Errorf("fail to call xrpAccountKeyRepo.GetOne(%s): %w", rk.Address, err)
+		}
+		if domainKey.XRPActiveKey(item.ActiveKey) == domainKey.XRPActiveKeyRegular &&
+			item.RegularKeyAccountID == rk.RegularKey {
+			// already imported
+			continue
+		}
+		if item.PendingRegularKeyAccountID != rk.RegularKey {
+			return fmt.Errorf("regular key %s of %s is not pending key in keygen wallet", rk.RegularKey, rk.Address)
+		}
+
+		var affected int64
+		affected, err = u.xrpAccountKeyRepo.ActivateRegularKey(ctx, item.AccountID, rk.RegularKey)
+		if err != nil {
+			return fmt.Errorf("fail to call xrpAccountKeyRepo.ActivateRegularKey(): %w", err)
+		}
+		if affected == 0 {
+			return errors.New("pending regular key is not found in xrp_account_key table")
+		}
+		activated++
+
+		logger.InfoContext(ctx, "regular key is activated",
+			"account", item.AccountID,
+			"regular_key", rk.RegularKey,
+		)
+	}
+
+	logger.InfoContext(ctx, "regular keys are imported",
+		"file", input.FileName,
+		"activated", activated,
+	)
+	return nil
+}
@@ -9,12 +9,15 @@ import (
 
 	keygenusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen"
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
 	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type signTransactionUseCase struct {
@@ -39,7 +42,10 @@ func NewSignTransactionUseCase(
 func (u *signTransactionUseCase) Sign(
 	ctx context.Context,
 	input keygenusecase.SignTransactionInput,
-) (keygenusecase.SignTransactionOutput, error) {
+) (_ keygenusecase.SignTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "keygen.xrp.SignTransaction.Sign")
+	defer tracer.End(span, &err)
+
 	// Get tx_deposit_id from tx file name
 	actionType, _, txID, signedCount, err := u.txFileRepo.ValidateFilePath(input.FilePath, domainTx.TxTypeUnsigned)
 	if err != nil {
@@ -49,7 +55,7 @@ func (u *signTransactionUseCase) Sign(
 	var senderAccount domainAccount.AccountType
 
 	// Get hex tx from file
-	data, err := u.txFileRepo.ReadFileSlice(input.FilePath)
+	data, err := u.txFileRepo.ReadFileSlice(ctx, input.FilePath)
 	if err != nil {
 		return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.ReadFileSlice(): %w", err)
 	}
@@ -62,6 +68,11 @@ func (u *signTransactionUseCase) Sign(
 
 	txHexs := make([]string, 0, len(serializedTxs))
 	for _, serializedTx := range serializedTxs {
+		// transaction of multisig account is serialized without comma, it must be signed by sign wallets
+		if !strings.Contains(serializedTx, ",") {
+			return keygenusecase.SignTransactionOutput{},
+				errors.New("transaction of multisig account must be signed by sign wallets")
+		}
 		// uuid, txJSON
 		tmp := strings.SplitAfterN(serializedTx, ",", 2)
 		if len(tmp) != 2 {
@@ -75,30 +86,34 @@ func (u *signTransactionUseCase) Sign(
 			return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to call json.Unmarshal(txJSON): %w", err)
 		}
 
-		// TODO: get secret from database by txInput.Account
-		// master_seed from xrp_account_key table
-		var secret string
-		secret, err = u.xrpAccountKeyRepo.GetSecret(senderAccount, txInput.Account)
+		// seed of active key from xrp_account_key table
+		var item *models.XRPAccountKey
+		item, err = u.xrpAccountKeyRepo.GetOne(ctx, senderAccount, txInput.Account)
 		if err != nil {
 			return keygenusecase.SignTransactionOutput{},
-				fmt.Errorf("fail to call xrpAccountKeyRepo.GetSecret(): %w", err)
+				fmt.Errorf("fail to call xrpAccountKeyRepo.GetOne(): %w", err)
+		}
+		// account can't sign any transaction if master key is disabled before regular key is imported
+		if isDisableMaster(&txInput) && domainKey.XRPActiveKey(item.ActiveKey) != domainKey.XRPActiveKeyRegular {
+			return keygenusecase.SignTransactionOutput{},
+				fmt.Errorf("regular key of %s is not active, run `import regularkey` first", txInput.Account)
 		}
 
 		// Sign
 		var signedTxID string
 		var txBlob string
-		signedTxID, txBlob, err = u.xrp.SignTransaction(ctx, &txInput, secret)
+		signedTxID, txBlob, err = u.xrp.SignTransaction(ctx, &txInput, activeSeed(item))
 		if err != nil {
 			return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to call xrp.SignTransaction(): %w", err)
 		}
-		logger.Debug("signed_tx",
+		logger.DebugContext(ctx, "signed_tx",
 			"uuid", uuid, "signed_tx_id", signedTxID, "signed_tx_blob", txBlob)
 		txHexs = append(txHexs, fmt.Sprintf("%s,%s,%s", uuid, signedTxID, txBlob))
 	}
 
 	// Write file
 	path := u.txFileRepo.CreateFilePath(actionType, domainTx.TxTypeSigned, txID, signedCount+1)
-	generatedFileName, err := u.txFileRepo.WriteFileSlice(path, txHexs)
+	generatedFileName, err := u.txFileRepo.WriteFileSlice(ctx, path, txHexs)
 	if err != nil {
 		return keygenusecase.SignTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.WriteFileSlice(): %w", err)
 	}
@@ -110,3 +125,17 @@ func (u *signTransactionUseCase) Sign(
 		UnsignedCount: 0,
 	}, nil
 }
+
+// activeSeed returns seed of key which signs transaction of account
+//   - regular key is used once SetRegularKey is validated, master key may be disabled after that
+func activeSeed(item *models.XRPAccountKey) string {
+	if domainKey.XRPActiveKey(item.ActiveKey) == domainKey.XRPActiveKeyRegular {
+		return item.RegularKeySeed
+	}
+	return item.MasterSeed
+}
+
+// isDisableMaster returns true if transaction disables master key
+func isDisableMaster(txInput *xrp.TxInput) bool {
+	return txInput.TransactionType == "AccountSet" && txInput.SetFlag == xrp.AsfDisableMaster
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/fullpubkey"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type exportFullPubkeyUseCase struct {
@@ -41,9 +42,12 @@ func NewExportFullPubkeyUseCase(
 	}
 }
 
-func (u *exportFullPubkeyUseCase) Export(ctx context.Context) (signusecase.ExportFullPubkeyOutput, error) {
+func (u *exportFullPubkeyUseCase) Export(ctx context.Context) (_ signusecase.ExportFullPubkeyOutput, err error) {
+	ctx, span := tracer.Start(ctx, "sign.btc.ExportFullPubkey.Export")
+	defer tracer.End(span, &err)
+
 	// get account key
-	authKeyTable, err := u.authKeyRepo.GetOne(u.authType)
+	authKeyTable, err := u.authKeyRepo.GetOne(ctx, u.authType)
 	if err != nil {
 		return signusecase.ExportFullPubkeyOutput{},
 			fmt.Errorf("fail to call authKeyRepo.GetOne(%s): %w", u.authType.String(), err)
@@ -14,6 +14,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type importPrivateKeyUseCase struct {
@@ -38,18 +39,22 @@ func NewImportPrivateKeyUseCase(
 	}
 }
 
-func (u *importPrivateKeyUseCase) Import(ctx context.Context, input signusecase.ImportPrivateKeyInput) error {
+func (u *importPrivateKeyUseCase) Import(ctx context.Context, input signusecase.ImportPrivateKeyInput) (err error) {
+	ctx, span := tracer.Start(ctx, "sign.btc.ImportPrivateKey.Import")
+	defer tracer.End(span, &err)
+
 	// 1. retrieve records(private key) from account_key table
-	authKeyItem, err := u.authKeyRepo.GetOne(u.authType)
+	authKeyItem, err := u.authKeyRepo.GetOne(ctx, u.authType)
 	if err != nil {
 		return fmt.Errorf("fail to call authKeyRepo.GetOne(): %w", err)
 	}
 	if authKeyItem.AddrStatus != address.AddrStatusHDKeyGenerated.Int8() {
-		logger.Info("no unimported private key")
+		logger.InfoContext(ctx, "no unimported private key")
 		return nil
 	}
 
-	logger.Debug(
+	logger.DebugContext(
+		ctx,
 		"target records",
 		"auth_type", u.authType.String(),
 		"P2PKH_address", authKeyItem.P2PKHAddress,
@@ -65,11 +70,12 @@ func (u *importPrivateKeyUseCase) Import(ctx context.Context, input signusecase.
 	}
 
 	// import private key by wif without rescan
-	err = u.btc.ImportPrivKeyWithoutReScan(wif, u.authType.String())
+	err = u.btc.ImportPrivKeyWithoutReScan(ctx, wif, u.authType.String())
 	if err != nil {
 		// error would be returned sometimes according to condition of bitcoin core
 		// for now, it continues even if error occurred
-		logger.Warn(
+		logger.WarnContext(
+			ctx,
 			"fail to call btc.ImportPrivKeyWithoutReScan()",
 			"wif", authKeyItem.WalletImportFormat,
 			"error", err)
@@ -77,9 +83,10 @@ func (u *importPrivateKeyUseCase) Import(ctx context.Context, input signusecase.
 	}
 
 	// update DB
-	_, err = u.authKeyRepo.UpdateAddrStatus(address.AddrStatusPrivKeyImported, authKeyItem.WalletImportFormat)
+	_, err = u.authKeyRepo.UpdateAddrStatus(ctx, address.AddrStatusPrivKeyImported, authKeyItem.WalletImportFormat)
 	if err != nil {
-		logger.Error(
+		logger.ErrorContext(
+			ctx,
 			"fail to call authKeyRepo.UpdateAddrStatus()",
 			"target_table", "auth_account_key",
 			"auth_type", u.authType.String(),
@@ -88,14 +95,16 @@ func (u *importPrivateKeyUseCase) Import(ctx context.Context, input signusecase.
 	}
 
 	// check address was stored in bitcoin core by importing private key
-	u.checkImportedAddress(authKeyItem.P2PKHAddress, authKeyItem.P2SHSegwitAddress, authKeyItem.FullPublicKey)
+	u.checkImportedAddress(ctx, authKeyItem.P2PKHAddress, authKeyItem.P2SHSegwitAddress, authKeyItem.FullPublicKey)
 
 	return nil
 }
 
 // checkImportedAddress check address was stored in bitcoin core by importing private key
 // debug use
-func (u *importPrivateKeyUseCase) checkImportedAddress(walletAddress, p2shSegwitAddress, fullPublicKey string) {
+func (u *importPrivateKeyUseCase) checkImportedAddress(
+	ctx context.Context, walletAddress, p2shSegwitAddress, fullPublicKey string,
+) {
 	// Note,
 	// GetAccount() calls GetAddressInfo() internally
 
@@ -105,46 +114,54 @@ func (u *importPrivateKeyUseCase) checkImportedAddress(walletAddress, p2shSegwit
 	)
 
 	switch u.btc.CoinTypeCode() {
-	case domainCoin.BTC:
+	case domainCoin.BTC, domainCoin.LTC:
 		targetAddr = p2shSegwitAddress
 		addrType = address.AddrTypeP2shSegwit
 	case domainCoin.BCH:
 		targetAddr = walletAddress
 		addrType = address.AddrTypeBCHCashAddr
-	case domainCoin.LTC, domainCoin.ETH, domainCoin.XRP, domainCoin.ERC20, domainCoin.HYT:
-		logger.Warn("this coin type is not implemented in checkImportedAddress()",
+	case domainCoin.DOGE:
+		targetAddr = walletAddress
+		addrType = address.AddrTypeLegacy
+	case domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE, domainCoin.XRP,
+		domainCoin.SOL, domainCoin.TRX, domainCoin.ERC20, domainCoin.HYT:
+		logger.WarnContext(ctx, "this coin type is not implemented in checkImportedAddress()",
 			"coin_type_code", u.btc.CoinTypeCode().String())
 		return
 	default:
-		logger.Warn("this coin type is not implemented in checkImportedAddress()",
+		logger.WarnContext(ctx, "this coin type is not implemented in checkImportedAddress()",
 			"coin_type_code", u.btc.CoinTypeCode().String())
 		return
 	}
 
 	// 1.call `getaccount` by target_address
 	// FIXME: error occurred in BCH
-	acnt, err := u.btc.GetAccount(targetAddr)
+	acnt, err := u.btc.GetAccount(ctx, targetAddr)
 	if err != nil {
-		logger.Warn(
+		logger.WarnContext(
+			ctx,
 			"fail to call btc.GetAccount()",
 			addrType.String(), targetAddr,
 			"error", err)
 		return
 	}
-	logger.Debug(
+	logger.DebugContext(
+		ctx,
 		"account is found",
 		"account", acnt,
 		addrType.String(), targetAddr)
 
 	// 2.call `getaddressinfo` by target_address
-	addrInfo, err := u.btc.GetAddressInfo(targetAddr)
+	addrInfo, err := u.btc.GetAddressInfo(ctx, targetAddr)
 	if err != nil {
-		logger.Warn(
+		logger.WarnContext(
+			ctx,
 			"fail to call btc.GetAddressInfo()",
 			addrType.String(), targetAddr,
 			"error", err)
 	} else if addrInfo.Pubkey != fullPublicKey {
-		logger.Warn(
+		logger.WarnContext(
+			ctx,
 			"pubkey is not matched",
 			"in_bitcoin_core", addrInfo.Pubkey,
 			"in_database", fullPublicKey)
@@ -13,6 +13,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type signTransactionUseCase struct {
@@ -49,7 +50,10 @@ func NewSignTransactionUseCase(
 func (u *signTransactionUseCase) Sign(
 	ctx context.Context,
 	input signusecase.SignTransactionInput,
-) (signusecase.SignTransactionOutput, error) {
+) (_ signusecase.SignTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "sign.btc.SignTransaction.Sign")
+	defer tracer.End(span, &err)
+
 	// Get tx_deposit_id from tx file name
 	//  if payment_5_unsigned_1_1534466246366489473.psbt, 5 is target
 	actionType, _, txID, signedCount, err := u.txFileRepo.ValidateFilePath(input.FilePath, domainTx.TxTypeUnsigned)
@@ -58,13 +62,13 @@ func (u *signTransactionUseCase) Sign(
 	}
 
 	// Read PSBT from file
-	psbtBase64, err := u.txFileRepo.ReadPSBTFile(input.FilePath)
+	psbtBase64, err := u.txFileRepo.ReadPSBTFile(ctx, input.FilePath)
 	if err != nil {
 		return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to read PSBT file: %w", err)
 	}
 
 	// Sign PSBT (add second signature for multisig)
-	signedPSBT, isSigned, err := u.sign(psbtBase64, actionType)
+	signedPSBT, isSigned, err := u.sign(ctx, psbtBase64, actionType)
 	if err != nil {
 		return signusecase.SignTransactionOutput{}, err
 	}
@@ -78,12 +82,12 @@ func (u *signTransactionUseCase) Sign(
 
 	// Write signed PSBT file
 	path := u.txFileRepo.CreateFilePath(actionType, txType, txID, signedCount)
-	generatedFileName, err := u.txFileRepo.WritePSBTFile(path, signedPSBT)
+	generatedFileName, err := u.txFileRepo.WritePSBTFile(ctx, path, signedPSBT)
 	if err != nil {
 		return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to write signed PSBT file: %w", err)
 	}
 
-	logger.Debug("signed PSBT",
+	logger.DebugContext(ctx, "signed PSBT",
 		"action", actionType.String(),
 		"txID", txID,
 		"signedCount", signedCount,
@@ -117,17 +121,17 @@ func (u *signTransactionUseCase) Sign(
 //
 // Note: This operates OFFLINE - no Bitcoin Core RPC required.
 func (u *signTransactionUseCase) sign(
-	psbtBase64 string,
+	ctx context.Context, psbtBase64 string,
 	actionType domainTx.ActionType,
 ) (string, bool, error) {
 	// Sign wallet always signs multisig transactions
 	// Add second signature to PSBT using auth key
-	signedPSBT, isSigned, err := u.signMultisigPSBT(psbtBase64)
+	signedPSBT, isSigned, err := u.signMultisigPSBT(ctx, psbtBase64)
 	if err != nil {
 		return "", false, err
 	}
 
-	logger.Debug("PSBT signing completed",
+	logger.DebugContext(ctx, "PSBT signing completed",
 		"action", actionType.String(),
 		"wallet_type", u.wtype.String(),
 		"isSigned", isSigned,
@@ -147,16 +151,16 @@ func (u *signTransactionUseCase) sign(
 // For 2-of-2 multisig, this signature typically completes the transaction.
 // For 2-of-N multisig (N>2), the transaction is complete once 2 signatures are present.
 func (u *signTransactionUseCase) signMultisigPSBT(
-	psbtBase64 string,
+	ctx context.Context, psbtBase64 string,
 ) (string, bool, error) {
 	// Get auth key from auth_account_key table (Sign wallet's key)
 	// Using explicit authType from configuration for robust key selection
-	authKey, err := u.authKeyRepo.GetOne(u.authType)
+	authKey, err := u.authKeyRepo.GetOne(ctx, u.authType)
 	if err != nil {
 		return "", false, fmt.Errorf("fail to get auth key for authType %s: %w", u.authType, err)
 	}
 
-	logger.Debug("signing PSBT with auth key",
+	logger.DebugContext(ctx, "signing PSBT with auth key",
 		"wallet_type", u.wtype.String(),
 	)
 
@@ -0,0 +1,73 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	signusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/sign"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type importPrivateKeyUseCase struct {
+	authKeyRepo cold.AuthAccountKeyRepositorier
+	authType    domainAccount.AuthType
+	wtype       domainWallet.WalletType
+}
+
+// NewImportPrivateKeyUseCase creates a new ImportPrivateKeyUseCase for sign wallet
+//   - private key isn't imported to node because signature of Safe is created offline by key in database
+func NewImportPrivateKeyUseCase(
+	authKeyRepo cold.AuthAccountKeyRepositorier,
+	authType domainAccount.AuthType,
+	wtype domainWallet.WalletType,
+) signusecase.ImportPrivateKeyUseCase {
+	return &importPrivateKeyUseCase{
+		authKeyRepo: authKeyRepo,
+		authType:    authType,
+		wtype:       wtype,
+	}
+}
+
+func (u *importPrivateKeyUseCase) Import(ctx context.Context, _ signusecase.ImportPrivateKeyInput) (err error) {
+	ctx, span := tracer.Start(ctx, "sign.eth.ImportPrivateKey.Import")
+	defer tracer.End(span, &err)
+
+	// retrieve record(private key) from auth_account_key table
+	authKeyItem, err := u.authKeyRepo.GetOne(ctx, u.authType)
+	if err != nil {
+		return fmt.Errorf("fail to call authKeyRepo.GetOne(): %w", err)
+	}
+	if authKeyItem.AddrStatus != address.AddrStatusHDKeyGenerated.Int8() {
+		logger.InfoContext(ctx, "no unimported private key")
+		return nil
+	}
+
+	// validate private key is for owner address of Safe
+	privKey, err := crypto.HexToECDSA(strings.TrimPrefix(authKeyItem.WalletImportFormat, "0x"))
+	if err != nil {
+		return fmt.Errorf("fail to call crypto.HexToECDSA(): %w", err)
+	}
+	if addr := crypto.PubkeyToAddress(privKey.PublicKey).Hex(); addr != authKeyItem.P2PKHAddress {
+		return fmt.Errorf("private key is for %s, but address is %s", addr, authKeyItem.P2PKHAddress)
+	}
+
+	// update DB
+	_, err = u.authKeyRepo.UpdateAddrStatus(ctx, address.AddrStatusPrivKeyImported, authKeyItem.WalletImportFormat)
+	if err != nil {
+		return fmt.Errorf("fail to call authKeyRepo.UpdateAddrStatus(): %w", err)
+	}
+	logger.DebugContext(ctx, "private key is validated",
+		"auth_type", u.authType.String(),
+		"address", authKeyItem.P2PKHAddress,
+		"wallet_type", u.wtype.String(),
+	)
+	return nil
+}
@@ -6,80 +6,119 @@ import (
 	"fmt"
 
 	signusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/sign"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
 	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
 	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum"
-	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
 	"github.com/hiromaily/go-crypto-wallet/pkg/serial"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type signTransactionUseCase struct {
-	eth        ethereum.Ethereumer
-	txFileRepo file.TransactionFileRepositorier
-	wtype      domainWallet.WalletType
+	safe        ethereum.Safer
+	authKeyRepo cold.AuthAccountKeyRepositorier
+	txFileRepo  file.TransactionFileRepositorier
+	authType    domainAccount.AuthType
+	wtype       domainWallet.WalletType
 }
 
 // NewSignTransactionUseCase creates a new SignTransactionUseCase for sign wallet
+//   - auth key of sign wallet is owner of Safe, it signs on safeTxHash offline
 func NewSignTransactionUseCase(
-	ethAPI ethereum.Ethereumer,
+	safe ethereum.Safer,
+	authKeyRepo cold.AuthAccountKeyRepositorier,
 	txFileRepo file.TransactionFileRepositorier,
+	authType domainAccount.AuthType,
 	wtype domainWallet.WalletType,
 ) signusecase.SignTransactionUseCase {
 	return &signTransactionUseCase{
-		eth:        ethAPI,
-		txFileRepo: txFileRepo,
-		wtype:      wtype,
+		safe:        safe,
+		authKeyRepo: authKeyRepo,
+		txFileRepo:  txFileRepo,
+		authType:    authType,
+		wtype:       wtype,
 	}
 }
 
 func (u *signTransactionUseCase) Sign(
 	ctx context.Context,
 	input signusecase.SignTransactionInput,
-) (signusecase.SignTransactionOutput, error) {
+) (_ signusecase.SignTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "sign.eth.SignTransaction.Sign")
+	defer tracer.End(span, &err)
+
 	// get tx_deposit_id from tx file name
 	actionType, _, txID, signedCount, err := u.txFileRepo.ValidateFilePath(input.FilePath, domainTx.TxTypeUnsigned)
 	if err != nil {
 		return signusecase.SignTransactionOutput{}, err
 	}
 
-	// get hex tx from file
-	data, err := u.txFileRepo.ReadFileSlice(input.FilePath)
+	// get serialized Safe transactions from file, first line is sender account
+	data, err := u.txFileRepo.ReadFileSlice(ctx, input.FilePath)
 	if err != nil {
 		return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.ReadFileSlice(): %w", err)
 	}
 	if len(data) <= 1 {
 		return signusecase.SignTransactionOutput{}, errors.New("file is invalid")
 	}
-	serializedTxs := data[1:]
 
-	txHexs := make([]string, 0, len(serializedTxs))
-	for _, serializedTx := range serializedTxs {
+	// get auth key which is owner of Safe
+	authKey, err := u.authKeyRepo.GetOne(ctx, u.authType)
+	if err != nil {
+		return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to get auth key for authType %s: %w", u.authType, err)
+	}
+
+	isSigned := true
+	serializedTxs := []string{data[0]}
+	for _, serializedTx := range data[1:] {
 		var rawTx ethtx.RawTx
 		if err = serial.DecodeFromString(serializedTx, &rawTx); err != nil {
 			return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call serial.DecodeFromString(): %w", err)
 		}
-		// sign
-		var signedRawTx *ethtx.RawTx
-		signedRawTx, err = u.eth.SignOnRawTransaction(&rawTx, eth.Password)
+		var isTxSigned bool
+		isTxSigned, err = u.safe.SignTransaction(&rawTx, authKey.WalletImportFormat)
+		if err != nil {
+			return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call safe.SignTransaction(): %w", err)
+		}
+		isSigned = isSigned && isTxSigned
+
+		serializedTx, err = serial.EncodeToString(rawTx)
 		if err != nil {
-			return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call eth.SignOnRawTransaction(): %w", err)
+			return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call serial.EncodeToString(rawTx): %w", err)
 		}
-		txHexs = append(txHexs, fmt.Sprintf("%s,%s", rawTx.UUID, signedRawTx.TxHex))
+		serializedTxs = append(serializedTxs, serializedTx)
+	}
+
+	// If sign is not finished because threshold of Safe isn't reached, signedCount should be increment
+	txType := domainTx.TxTypeSigned
+	if !isSigned {
+		txType = domainTx.TxTypeUnsigned
+		signedCount++
 	}
 
 	// write file
-	path := u.txFileRepo.CreateFilePath(actionType, domainTx.TxTypeSigned, txID, signedCount+1)
-	generatedFileName, err := u.txFileRepo.WriteFileSlice(path, txHexs)
+	path := u.txFileRepo.CreateFilePath(actionType, txType, txID, signedCount)
+	generatedFileName, err := u.txFileRepo.WriteFileSlice(ctx, path, serializedTxs)
 	if err != nil {
 		return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.WriteFileSlice(): %w", err)
 	}
 
-	// return hexTx, isSigned, generatedFileName, nil
+	logger.DebugContext(ctx, "sign Safe transaction",
+		"action", actionType.String(),
+		"txID", txID,
+		"signedCount", signedCount,
+		"isSigned", isSigned,
+		"fileName", generatedFileName,
+		"wallet_type", u.wtype.String(),
+	)
+
 	return signusecase.SignTransactionOutput{
 		SignedData:   "",
-		IsComplete:   true,
+		IsComplete:   isSigned,
 		NextFilePath: generatedFileName,
 	}, nil
 }
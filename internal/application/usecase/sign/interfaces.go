@@ -16,7 +16,7 @@ type ImportPrivateKeyUseCase interface {
 	Import(ctx context.Context, input ImportPrivateKeyInput) error
 }
 
-// ExportFullPubkeyUseCase exports full public keys (BTC only)
+// ExportFullPubkeyUseCase exports full public keys (BTC and XRP)
 type ExportFullPubkeyUseCase interface {
 	Export(ctx context.Context) (ExportFullPubkeyOutput, error)
 }
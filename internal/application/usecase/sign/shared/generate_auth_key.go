@@ -11,6 +11,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/wallet/key"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type generateAuthKeyUseCase struct {
@@ -35,19 +36,22 @@ func NewGenerateAuthKeyUseCase(
 func (u *generateAuthKeyUseCase) Generate(
 	ctx context.Context,
 	input signusecase.GenerateAuthKeyInput,
-) (signusecase.GenerateAuthKeyOutput, error) {
+) (_ signusecase.GenerateAuthKeyOutput, err error) {
+	ctx, span := tracer.Start(ctx, "sign.GenerateAuthKey.Generate")
+	defer tracer.End(span, &err)
+
 	accountType := input.AuthType.AccountType()
-	logger.Debug("generate HDWallet", "account_type", accountType.String())
+	logger.DebugContext(ctx, "generate HDWallet", "account_type", accountType.String())
 
 	// Get latest index
-	idxFrom, err := u.repo.GetMaxIndex(accountType)
+	idxFrom, err := u.repo.GetMaxIndex(ctx, accountType)
 	if err != nil {
-		logger.Info(err.Error())
+		logger.InfoContext(ctx, err.Error())
 		return signusecase.GenerateAuthKeyOutput{
 			GeneratedCount: 0,
 		}, nil
 	}
-	logger.Debug("max_index",
+	logger.DebugContext(ctx, "max_index",
 		"account_type", accountType.String(),
 		"current_index", idxFrom,
 	)
@@ -59,7 +63,7 @@ func (u *generateAuthKeyUseCase) Generate(
 	}
 
 	// Insert key information to auth_account_key_table
-	err = u.repo.Insert(walletKeys, idxFrom, u.coinTypeCode, accountType, u.keygen.KeyType())
+	err = u.repo.Insert(ctx, walletKeys, idxFrom, u.coinTypeCode, accountType, u.keygen.KeyType())
 	if err != nil {
 		return signusecase.GenerateAuthKeyOutput{}, fmt.Errorf("fail to call repo.Insert(): %w", err)
 	}
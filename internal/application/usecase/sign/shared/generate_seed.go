@@ -9,6 +9,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/wallet/key"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type generateSeedUseCase struct {
@@ -22,9 +23,12 @@ func NewGenerateSeedUseCase(seedRepo cold.SeedRepositorier) signusecase.Generate
 	}
 }
 
-func (u *generateSeedUseCase) Generate(ctx context.Context) (signusecase.GenerateSeedOutput, error) {
+func (u *generateSeedUseCase) Generate(ctx context.Context) (_ signusecase.GenerateSeedOutput, err error) {
+	ctx, span := tracer.Start(ctx, "sign.GenerateSeed.Generate")
+	defer tracer.End(span, &err)
+
 	// Try to retrieve existing seed from database
-	bSeed, err := u.retrieveSeed()
+	bSeed, err := u.retrieveSeed(ctx)
 	if err == nil {
 		return signusecase.GenerateSeedOutput{
 			Seed: bSeed,
@@ -39,7 +43,7 @@ func (u *generateSeedUseCase) Generate(ctx context.Context) (signusecase.Generat
 	strSeed := key.SeedToString(bSeed)
 
 	// Insert seed in database
-	err = u.seedRepo.Insert(strSeed)
+	err = u.seedRepo.Insert(ctx, strSeed)
 	if err != nil {
 		return signusecase.GenerateSeedOutput{}, fmt.Errorf("fail to call seedRepo.Insert(): %w", err)
 	}
@@ -50,11 +54,11 @@ func (u *generateSeedUseCase) Generate(ctx context.Context) (signusecase.Generat
 }
 
 // retrieveSeed retrieves seed from database
-func (u *generateSeedUseCase) retrieveSeed() ([]byte, error) {
+func (u *generateSeedUseCase) retrieveSeed(ctx context.Context) ([]byte, error) {
 	// Get seed from database, seed is expected to have only one record
-	seed, err := u.seedRepo.GetOne()
+	seed, err := u.seedRepo.GetOne(ctx)
 	if err == nil && seed.Seed != "" {
-		logger.Info("seed have already been generated")
+		logger.InfoContext(ctx, "seed have already been generated")
 		return key.SeedToByte(seed.Seed)
 	}
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	signusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/sign"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/wallet/key"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type storeSeedUseCase struct {
@@ -23,7 +24,10 @@ func NewStoreSeedUseCase(seedRepo cold.SeedRepositorier) signusecase.StoreSeedUs
 func (u *storeSeedUseCase) Store(
 	ctx context.Context,
 	input signusecase.StoreSeedInput,
-) (signusecase.StoreSeedOutput, error) {
+) (_ signusecase.StoreSeedOutput, err error) {
+	ctx, span := tracer.Start(ctx, "sign.StoreSeed.Store")
+	defer tracer.End(span, &err)
+
 	// Convert seed string to bytes
 	bSeed, err := key.SeedToByte(input.Seed)
 	if err != nil {
@@ -31,7 +35,7 @@ func (u *storeSeedUseCase) Store(
 	}
 
 	// Insert seed in database
-	err = u.seedRepo.Insert(input.Seed)
+	err = u.seedRepo.Insert(ctx, input.Seed)
 	if err != nil {
 		return signusecase.StoreSeedOutput{}, fmt.Errorf("fail to call seedRepo.Insert(): %w", err)
 	}
@@ -0,0 +1,100 @@
+package xrp
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	signusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/sign"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/fullpubkey"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type exportFullPubkeyUseCase struct {
+	xrpAccountKeyRepo cold.XRPAccountKeyRepositorier
+	pubkeyFileRepo    file.AddressFileRepositorier
+	coinTypeCode      domainCoin.CoinTypeCode
+	authType          domainAccount.AuthType
+	wtype             domainWallet.WalletType
+}
+
+// NewExportFullPubkeyUseCase creates a new ExportFullPubkeyUseCase for sign wallet
+//   - XRP account of signer is exported instead of full public key, it is registered in SignerList
+func NewExportFullPubkeyUseCase(
+	xrpAccountKeyRepo cold.XRPAccountKeyRepositorier,
+	pubkeyFileRepo file.AddressFileRepositorier,
+	coinTypeCode domainCoin.CoinTypeCode,
+	authType domainAccount.AuthType,
+	wtype domainWallet.WalletType,
+) signusecase.ExportFullPubkeyUseCase {
+	return &exportFullPubkeyUseCase{
+		xrpAccountKeyRepo: xrpAccountKeyRepo,
+		pubkeyFileRepo:    pubkeyFileRepo,
+		coinTypeCode:      coinTypeCode,
+		authType:          authType,
+		wtype:             wtype,
+	}
+}
+
+func (u *exportFullPubkeyUseCase) Export(ctx context.Context) (_ signusecase.ExportFullPubkeyOutput, err error) {
+	ctx, span := tracer.Start(ctx, "sign.xrp.ExportFullPubkey.Export")
+	defer tracer.End(span, &err)
+
+	// get xrp key of signer
+	items, err := u.xrpAccountKeyRepo.GetAllAddrStatus(
+		ctx, u.authType.AccountType(), address.AddrStatusPrivKeyImported)
+	if err != nil {
+		return signusecase.ExportFullPubkeyOutput{},
+			fmt.Errorf("fail to call xrpAccountKeyRepo.GetAllAddrStatus(%s): %w", u.authType.String(), err)
+	}
+	if len(items) == 0 {
+		return signusecase.ExportFullPubkeyOutput{}, errors.New("xrp key of signer is not found, import key first")
+	}
+
+	// export csv file
+	fileName, err := u.exportAccount(items[0].AccountID)
+	if err != nil {
+		return signusecase.ExportFullPubkeyOutput{}, err
+	}
+
+	return signusecase.ExportFullPubkeyOutput{
+		FileName: fileName,
+	}, nil
+}
+
+// exportAccount exports XRP account of signer as csv file
+func (u *exportFullPubkeyUseCase) exportAccount(accountID string) (_ string, err error) {
+	// create fileName
+	fileName := u.pubkeyFileRepo.CreateFilePath(u.authType.AccountType())
+
+	file, err := os.Create(fileName) //nolint:gosec
+	if err != nil {
+		return "", fmt.Errorf("fail to call os.Create(%s): %w", fileName, err)
+	}
+
+	defer func() {
+		if cerr := file.Close(); cerr != nil {
+			err = fmt.Errorf("failed to close file: %w", cerr)
+		}
+	}()
+
+	writer := bufio.NewWriter(file)
+
+	// output: coinType, authType, account
+	_, err = writer.WriteString(fullpubkey.CreateLine(u.coinTypeCode, u.authType, accountID))
+	if err != nil {
+		return "", fmt.Errorf("fail to call writer.WriteString(%s): %w", fileName, err)
+	}
+	if err = writer.Flush(); err != nil {
+		return "", fmt.Errorf("fail to call writer.Flush(%s): %w", fileName, err)
+	}
+	return fileName, nil
+}
@@ -0,0 +1,96 @@
+package xrp
+
+import (
+	"context"
+	"fmt"
+
+	signusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/sign"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type importPrivateKeyUseCase struct {
+	xrp               ripple.Rippler
+	authKeyRepo       cold.AuthAccountKeyRepositorier
+	xrpAccountKeyRepo cold.XRPAccountKeyRepositorier
+	authType          domainAccount.AuthType
+	wtype             domainWallet.WalletType
+}
+
+// NewImportPrivateKeyUseCase creates a new ImportPrivateKeyUseCase for sign wallet
+//   - XRP key of auth account is derived from auth key like keygen wallet and stored in xrp_account_key table
+//   - the key is used as one of signers in SignerList of multisig account
+func NewImportPrivateKeyUseCase(
+	xrpAPI ripple.Rippler,
+	authKeyRepo cold.AuthAccountKeyRepositorier,
+	xrpAccountKeyRepo cold.XRPAccountKeyRepositorier,
+	authType domainAccount.AuthType,
+	wtype domainWallet.WalletType,
+) signusecase.ImportPrivateKeyUseCase {
+	return &importPrivateKeyUseCase{
+		xrp:               xrpAPI,
+		authKeyRepo:       authKeyRepo,
+		xrpAccountKeyRepo: xrpAccountKeyRepo,
+		authType:          authType,
+		wtype:             wtype,
+	}
+}
+
+func (u *importPrivateKeyUseCase) Import(ctx context.Context, _ signusecase.ImportPrivateKeyInput) (err error) {
+	ctx, span := tracer.Start(ctx, "sign.xrp.ImportPrivateKey.Import")
+	defer tracer.End(span, &err)
+
+	// retrieve record(private key) from auth_account_key table
+	authKeyItem, err := u.authKeyRepo.GetOne(ctx, u.authType)
+	if err != nil {
+		return fmt.Errorf("fail to call authKeyRepo.GetOne(): %w", err)
+	}
+	if authKeyItem.AddrStatus != address.AddrStatusHDKeyGenerated.Int8() {
+		logger.InfoContext(ctx, "no unimported private key")
+		return nil
+	}
+
+	// generate XRP key from auth key in the same way as keygen wallet
+	generatedKey, err := u.xrp.WalletPropose(ctx, authKeyItem.P2SHSegwitAddress)
+	if err != nil {
+		return fmt.Errorf("fail to call xrp.WalletPropose(): %w", err)
+	}
+	if generatedKey.Status == xrp.StatusCodeError.String() {
+		return fmt.Errorf("fail to call xrp.WalletPropose() %s", generatedKey.Error)
+	}
+
+	err = u.xrpAccountKeyRepo.InsertBulk(ctx, []*models.XRPAccountKey{{
+		Coin:          authKeyItem.Coin,
+		Account:       u.authType.AccountType().String(),
+		AccountID:     generatedKey.Result.AccountID,
+		KeyType:       xrp.GetXRPKeyTypeValue(generatedKey.Result.KeyType),
+		MasterKey:     generatedKey.Result.MasterKey,
+		MasterSeed:    generatedKey.Result.MasterSeed,
+		MasterSeedHex: generatedKey.Result.MasterSeedHex,
+		PublicKey:     generatedKey.Result.PublicKey,
+		PublicKeyHex:  generatedKey.Result.PublicKeyHex,
+		AddrStatus:    address.AddrStatusPrivKeyImported.Int8(),
+	}})
+	if err != nil {
+		return fmt.Errorf("fail to call xrpAccountKeyRepo.InsertBulk(): %w", err)
+	}
+
+	// update DB
+	_, err = u.authKeyRepo.UpdateAddrStatus(ctx, address.AddrStatusPrivKeyImported, authKeyItem.WalletImportFormat)
+	if err != nil {
+		return fmt.Errorf("fail to call authKeyRepo.UpdateAddrStatus(): %w", err)
+	}
+	logger.DebugContext(ctx, "xrp key is generated for signer",
+		"auth_type", u.authType.String(),
+		"account_id", generatedKey.Result.AccountID,
+		"wallet_type", u.wtype.String(),
+	)
+	return nil
+}
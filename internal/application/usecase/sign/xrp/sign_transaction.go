@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strings"
 
 	signusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/sign"
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
@@ -15,27 +14,34 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/serial"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type signTransactionUseCase struct {
 	xrp               ripple.Rippler
 	xrpAccountKeyRepo cold.XRPAccountKeyRepositorier
 	txFileRepo        file.TransactionFileRepositorier
+	authType          domainAccount.AuthType
 	wtype             domainWallet.WalletType
 }
 
 // NewSignTransactionUseCase creates a new SignTransactionUseCase for sign wallet
+//   - XRP key of auth account is one of signers of multisig account, it signs with signAs offline
 func NewSignTransactionUseCase(
 	xrpAPI ripple.Rippler,
 	xrpAccountKeyRepo cold.XRPAccountKeyRepositorier,
 	txFileRepo file.TransactionFileRepositorier,
+	authType domainAccount.AuthType,
 	wtype domainWallet.WalletType,
 ) signusecase.SignTransactionUseCase {
 	return &signTransactionUseCase{
 		xrp:               xrpAPI,
 		xrpAccountKeyRepo: xrpAccountKeyRepo,
 		txFileRepo:        txFileRepo,
+		authType:          authType,
 		wtype:             wtype,
 	}
 }
@@ -43,73 +49,99 @@ func NewSignTransactionUseCase(
 func (u *signTransactionUseCase) Sign(
 	ctx context.Context,
 	input signusecase.SignTransactionInput,
-) (signusecase.SignTransactionOutput, error) {
+) (_ signusecase.SignTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "sign.xrp.SignTransaction.Sign")
+	defer tracer.End(span, &err)
+
 	// get tx_deposit_id from tx file name
 	actionType, _, txID, signedCount, err := u.txFileRepo.ValidateFilePath(input.FilePath, domainTx.TxTypeUnsigned)
 	if err != nil {
 		return signusecase.SignTransactionOutput{}, err
 	}
 
-	var senderAccount domainAccount.AccountType
-
-	// get hex tx from file
-	data, err := u.txFileRepo.ReadFileSlice(input.FilePath)
+	// get serialized multisig transactions from file, first line is sender account
+	data, err := u.txFileRepo.ReadFileSlice(ctx, input.FilePath)
 	if err != nil {
 		return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.ReadFileSlice(): %w", err)
 	}
-	if len(data) > 1 {
-		senderAccount = domainAccount.AccountType(data[0])
-	} else {
+	if len(data) <= 1 {
 		return signusecase.SignTransactionOutput{}, errors.New("file is invalid")
 	}
-	serializedTxs := data[1:]
-
-	txHexs := make([]string, 0, len(serializedTxs))
-	for _, serializedTx := range serializedTxs {
-		// uid, txJSON
-		tmp := strings.SplitAfterN(serializedTx, ",", 2)
-		if len(tmp) != 2 {
-			return signusecase.SignTransactionOutput{}, errors.New("data format is invalid in file")
+
+	// get xrp key of signer
+	signerKeys, err := u.xrpAccountKeyRepo.GetAllAddrStatus(
+		ctx, u.authType.AccountType(), address.AddrStatusPrivKeyImported)
+	if err != nil {
+		return signusecase.SignTransactionOutput{},
+			fmt.Errorf("fail to call xrpAccountKeyRepo.GetAllAddrStatus(%s): %w", u.authType.String(), err)
+	}
+	if len(signerKeys) == 0 {
+		return signusecase.SignTransactionOutput{}, errors.New("xrp key of signer is not found, import key first")
+	}
+	signer := signerKeys[0]
+
+	isSigned := true
+	serializedTxs := []string{data[0]}
+	for _, serializedTx := range data[1:] {
+		var multisigTx xrp.MultisigTx
+		if err = serial.DecodeFromString(serializedTx, &multisigTx); err != nil {
+			return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call serial.DecodeFromString(): %w", err)
+		}
+		if multisigTx.IsSignedBy(signer.AccountID) {
+			return signusecase.SignTransactionOutput{},
+				fmt.Errorf("transaction %s is already signed by %s", multisigTx.UUID, u.authType.String())
 		}
-		uuid := strings.TrimRight(tmp[0], ",")
-		txJSON := tmp[1]
 
 		var txInput xrp.TxInput
-		if err = json.Unmarshal([]byte(txJSON), &txInput); err != nil {
+		if err = json.Unmarshal([]byte(multisigTx.TxJSON), &txInput); err != nil {
 			return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call json.Unmarshal(txJSON): %w", err)
 		}
-		// TODO: get secret from database by txInput.Account
-		// master_seed from xrp_account_key table
-		var secret string
-		secret, err = u.xrpAccountKeyRepo.GetSecret(senderAccount, txInput.Account)
-		if err != nil {
-			return signusecase.SignTransactionOutput{},
-				fmt.Errorf("fail to call xrpAccountKeyRepo.GetSecret(): %w", err)
-		}
 
 		// sign
-		var signedTxID string
 		var txBlob string
-		signedTxID, txBlob, err = u.xrp.SignTransaction(ctx, &txInput, secret)
+		_, txBlob, err = u.xrp.MultiSignTransaction(ctx, &txInput, signer.MasterSeed, signer.AccountID)
 		if err != nil {
-			return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call xrp.SignTransaction(): %w", err)
+			return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call xrp.MultiSignTransaction(): %w", err)
+		}
+		if err = multisigTx.AddSignature(signer.AccountID, txBlob); err != nil {
+			return signusecase.SignTransactionOutput{}, err
 		}
-		logger.Debug("signed_tx",
-			"uuid", uuid, "signed_tx_id", signedTxID, "signed_tx_blob", txBlob)
-		txHexs = append(txHexs, fmt.Sprintf("%s,%s,%s", uuid, signedTxID, txBlob))
+		isSigned = isSigned && multisigTx.IsSigned()
+
+		serializedTx, err = serial.EncodeToString(multisigTx)
+		if err != nil {
+			return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call serial.EncodeToString(multisigTx): %w", err)
+		}
+		serializedTxs = append(serializedTxs, serializedTx)
+	}
+
+	// If sign is not finished because quorum of SignerList isn't reached, signedCount should be increment
+	txType := domainTx.TxTypeSigned
+	if !isSigned {
+		txType = domainTx.TxTypeUnsigned
+		signedCount++
 	}
 
 	// write file
-	path := u.txFileRepo.CreateFilePath(actionType, domainTx.TxTypeSigned, txID, signedCount+1)
-	generatedFileName, err := u.txFileRepo.WriteFileSlice(path, txHexs)
+	path := u.txFileRepo.CreateFilePath(actionType, txType, txID, signedCount)
+	generatedFileName, err := u.txFileRepo.WriteFileSlice(ctx, path, serializedTxs)
 	if err != nil {
 		return signusecase.SignTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.WriteFileSlice(): %w", err)
 	}
 
-	// return hexTx, isSigned, generatedFileName, nil
+	logger.DebugContext(ctx, "multisign transaction",
+		"action", actionType.String(),
+		"txID", txID,
+		"signedCount", signedCount,
+		"isSigned", isSigned,
+		"signer", signer.AccountID,
+		"fileName", generatedFileName,
+		"wallet_type", u.wtype.String(),
+	)
+
 	return signusecase.SignTransactionOutput{
 		SignedData:   "",
-		IsComplete:   true,
+		IsComplete:   isSigned,
 		NextFilePath: generatedFileName,
 	}, nil
 }
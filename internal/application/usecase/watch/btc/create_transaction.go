@@ -21,6 +21,8 @@ import (
 	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type createTransactionUseCase struct {
@@ -69,7 +71,10 @@ func NewCreateTransactionUseCase(
 func (u *createTransactionUseCase) Execute(
 	ctx context.Context,
 	input watchusecase.CreateTransactionInput,
-) (watchusecase.CreateTransactionOutput, error) {
+) (_ watchusecase.CreateTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.btc.CreateTransaction.Execute")
+	defer tracer.End(span, &err)
+
 	// Convert action type string to domain type
 	actionType := domainTx.ActionType(input.ActionType)
 	if !domainTx.ValidateActionType(input.ActionType) {
@@ -81,11 +86,12 @@ func (u *createTransactionUseCase) Execute(
 
 	switch actionType {
 	case domainTx.ActionTypeDeposit:
-		hex, fileName, execErr = u.createDepositTx(input.AdjustmentFee)
+		hex, fileName, execErr = u.createDepositTx(ctx, input.AdjustmentFee)
 	case domainTx.ActionTypePayment:
-		hex, fileName, execErr = u.createPaymentTx(input.AdjustmentFee)
+		hex, fileName, execErr = u.createPaymentTx(ctx, input.AdjustmentFee)
 	case domainTx.ActionTypeTransfer:
 		hex, fileName, execErr = u.createTransferTx(
+			ctx,
 			input.SenderAccount,
 			input.ReceiverAccount,
 			input.Amount,
@@ -109,11 +115,11 @@ func (u *createTransactionUseCase) Execute(
 // createDepositTx creates unsigned tx if client accounts have coins
 // - sender: client, receiver: deposit
 // - receiver account covers fee, but should be flexible
-func (u *createTransactionUseCase) createDepositTx(adjustmentFee float64) (string, string, error) {
+func (u *createTransactionUseCase) createDepositTx(ctx context.Context, adjustmentFee float64) (string, string, error) {
 	sender := domainAccount.AccountTypeClient
 	receiver := u.depositReceiver
 	targetAction := domainTx.ActionTypeDeposit
-	logger.Debug("account",
+	logger.DebugContext(ctx, "account",
 		"sender", sender.String(),
 		"receiver", receiver.String(),
 	)
@@ -124,28 +130,28 @@ func (u *createTransactionUseCase) createDepositTx(adjustmentFee float64) (strin
 	}
 
 	// create deposit transaction
-	return u.createTx(sender, receiver, targetAction, requiredAmount, adjustmentFee, nil, nil)
+	return u.createTx(ctx, sender, receiver, targetAction, requiredAmount, adjustmentFee, nil, nil)
 }
 
 // createPaymentTx creates unsigned tx for user (anonymous addresses)
 // sender: payment, receiver: addresses coming from payment_request table
 // - sender account (payment) covers fee, but should be flexible
-func (u *createTransactionUseCase) createPaymentTx(adjustmentFee float64) (string, string, error) {
+func (u *createTransactionUseCase) createPaymentTx(ctx context.Context, adjustmentFee float64) (string, string, error) {
 	sender := u.paymentSender
 	receiver := domainAccount.AccountTypeAnonymous
 	targetAction := domainTx.ActionTypePayment
-	logger.Debug("account",
+	logger.DebugContext(ctx, "account",
 		"sender", sender.String(),
 		"receiver", receiver.String(),
 	)
 
 	// get payment data from payment_request
-	userPayments, paymentRequestIds, err := u.createUserPayment()
+	userPayments, paymentRequestIds, err := u.createUserPayment(ctx)
 	if err != nil {
 		return "", "", err
 	}
 	if len(userPayments) == 0 {
-		logger.Debug("no data in userPayments")
+		logger.DebugContext(ctx, "no data in userPayments")
 		// no data
 		return "", "", nil
 	}
@@ -157,30 +163,30 @@ func (u *createTransactionUseCase) createPaymentTx(adjustmentFee float64) (strin
 	}
 
 	// get balance for payment account
-	balance, err := u.btcClient.GetBalanceByAccount(domainAccount.AccountTypePayment, u.btcClient.ConfirmationBlock())
+	balance, err := u.btcClient.GetBalanceByAccount(ctx, domainAccount.AccountTypePayment, u.btcClient.ConfirmationBlock())
 	if err != nil {
 		return "", "", err
 	}
 	if balance <= requiredAmount {
 		// balance is short
-		logger.Info("balance for payment account is insufficient",
+		logger.InfoContext(ctx, "balance for payment account is insufficient",
 			"payment_balance", balance.ToBTC(),
 			"required_amount", requiredAmount.ToBTC(),
 		)
 		return "", "", nil
 	}
-	logger.Debug("payment balance and userTotal",
+	logger.DebugContext(ctx, "payment balance and userTotal",
 		"balance", balance,
 		"userTotal", requiredAmount)
 
 	// create payment transaction
-	return u.createTx(sender, receiver, targetAction, requiredAmount, adjustmentFee, paymentRequestIds, userPayments)
+	return u.createTx(ctx, sender, receiver, targetAction, requiredAmount, adjustmentFee, paymentRequestIds, userPayments)
 }
 
 // createTransferTx creates unsigned tx for transfer coin among internal accounts except client, authorization
 // FIXME: for now, receiver account covers fee, but should be flexible
 func (u *createTransactionUseCase) createTransferTx(
-	sender, receiver domainAccount.AccountType, floatAmount, adjustmentFee float64,
+	ctx context.Context, sender, receiver domainAccount.AccountType, floatAmount, adjustmentFee float64,
 ) (string, string, error) {
 	targetAction := domainTx.ActionTypeTransfer
 
@@ -199,7 +205,7 @@ func (u *createTransactionUseCase) createTransferTx(
 	}
 
 	// check balance for sender
-	balance, err := u.btcClient.GetBalanceByAccount(sender, u.btcClient.ConfirmationBlock())
+	balance, err := u.btcClient.GetBalanceByAccount(ctx, sender, u.btcClient.ConfirmationBlock())
 	if err != nil {
 		return "", "", err
 	}
@@ -209,7 +215,7 @@ func (u *createTransactionUseCase) createTransferTx(
 	}
 
 	// create transfer transaction
-	return u.createTx(sender, receiver, targetAction, requiredAmount, adjustmentFee, nil, nil)
+	return u.createTx(ctx, sender, receiver, targetAction, requiredAmount, adjustmentFee, nil, nil)
 }
 
 type parsedTx struct {
@@ -233,7 +239,7 @@ type userPayment struct {
 //
 //nolint:gocyclo
 func (u *createTransactionUseCase) createTx(
-	sender,
+	ctx context.Context, sender,
 	receiver domainAccount.AccountType,
 	targetAction domainTx.ActionType,
 	requiredAmount btcutil.Amount,
@@ -241,7 +247,7 @@ func (u *createTransactionUseCase) createTx(
 	paymentRequestIds []int64,
 	userPayments []userPayment,
 ) (string, string, error) {
-	logger.Debug("createTx()",
+	logger.DebugContext(ctx, "createTx()",
 		"sender_account", sender.String(),
 		"receiver_account", receiver.String(),
 		"target_action", targetAction.String(),
@@ -249,26 +255,26 @@ func (u *createTransactionUseCase) createTx(
 		"adjustmentFee", adjustmentFee)
 
 	// get listUnspent
-	unspentList, unspentAddrs, err := u.getUnspentList(sender)
+	unspentList, unspentAddrs, err := u.getUnspentList(ctx, sender)
 	if err != nil {
 		return "", "", fmt.Errorf("fail to call getUnspentList(): %w", err)
 	}
 	if len(unspentList) == 0 {
-		logger.Info("no listunspent")
+		logger.InfoContext(ctx, "no listunspent")
 		return "", "", nil
 	}
 
 	// parse listUnspent
 	parsedTx, inputTotal, isDone := u.parseListUnspentTx(unspentList, requiredAmount)
 	if len(parsedTx.txInputs) == 0 {
-		logger.Info("no input tx in listUnspent")
+		logger.InfoContext(ctx, "no input tx in listUnspent")
 		return "", "", nil
 	}
 	if !isDone {
 		return "", "", errors.New("sender account can't meet amount to send")
 	}
 	if requiredAmount != 0 {
-		logger.Debug("amount", "expected_change", inputTotal-requiredAmount)
+		logger.DebugContext(ctx, "amount", "expected_change", inputTotal-requiredAmount)
 	}
 
 	// create txOutputs
@@ -279,7 +285,7 @@ func (u *createTransactionUseCase) createTx(
 		if requiredAmount != 0 {
 			isChange = true
 		}
-		txPrevOutputs, err = u.createTxOutputs(receiver, requiredAmount, inputTotal, unspentAddrs[0], isChange)
+		txPrevOutputs, err = u.createTxOutputs(ctx, receiver, requiredAmount, inputTotal, unspentAddrs[0], isChange)
 		if err != nil {
 			return "", "", fmt.Errorf("fail to call createTxOutputs(): %w", err)
 		}
@@ -287,7 +293,7 @@ func (u *createTransactionUseCase) createTx(
 		changeAddr := unspentAddrs[0] // this is actually sender's address because it's for change
 		changeAmount := inputTotal - requiredAmount
 		txPrevOutputs = u.createPaymentTxOutputs(userPayments, changeAddr, changeAmount)
-		logger.Debug("before createPaymentOutputs()",
+		logger.DebugContext(ctx, "before createPaymentOutputs()",
 			"change_addr", changeAddr,
 			"change_amount", changeAmount,
 			"len(txPrevOutputs)", len(txPrevOutputs),
@@ -298,7 +304,7 @@ func (u *createTransactionUseCase) createTx(
 
 	// create raw transaction as temporary use
 	//  - later calculate by tx size
-	msgTx, err := u.btcClient.CreateRawTransaction(parsedTx.txInputs, txPrevOutputs)
+	msgTx, err := u.btcClient.CreateRawTransaction(ctx, parsedTx.txInputs, txPrevOutputs)
 	if err != nil {
 		return "", "", fmt.Errorf("fail to call btc.CreateRawTransaction(): %w", err)
 	}
@@ -307,13 +313,14 @@ func (u *createTransactionUseCase) createTx(
 	//  - adjust outputTotal by fee and re-run CreateRawTransaction
 	//  - this logic would be different from payment
 	outputTotal, fee, txOutputs, txRepoTxOutputs, err := u.calculateOutputTotal(
+		ctx,
 		sender, receiver, msgTx, adjustmentFee, inputTotal, txPrevOutputs)
 	if err != nil {
 		return "", "", err
 	}
 
 	// re call CreateRawTransaction
-	msgTx, err = u.btcClient.CreateRawTransaction(parsedTx.txInputs, txOutputs)
+	msgTx, err = u.btcClient.CreateRawTransaction(ctx, parsedTx.txInputs, txOutputs)
 	if err != nil {
 		return "", "", fmt.Errorf("fail to call btc.CreateRawTransaction(): %w", err)
 	}
@@ -327,6 +334,7 @@ func (u *createTransactionUseCase) createTx(
 	// insert to tx_table for unsigned tx
 	//  - txID would be 0 if record is already existing then csv file is not created
 	txID, err := u.insertTxTableForUnsigned(
+		ctx,
 		targetAction,
 		hex,
 		inputTotal,
@@ -351,13 +359,13 @@ func (u *createTransactionUseCase) createTx(
 	// - inserted data in database must be deleted to generate PSBT file
 	var generatedFileName string
 	if txID != 0 {
-		generatedFileName, err = u.generatePSBTFile(targetAction, msgTx, previousTxs, txID)
+		generatedFileName, err = u.generatePSBTFile(ctx, targetAction, msgTx, previousTxs, txID)
 		if err != nil {
 			return "", "", fmt.Errorf("fail to call generatePSBTFile(): %w", err)
 		}
 	}
 
-	logger.Debug("createTx completed",
+	logger.DebugContext(ctx, "createTx completed",
 		"unspentList", unspentList,
 		"unspentAddrs", unspentAddrs,
 		"requiredAmount", requiredAmount,
@@ -377,10 +385,10 @@ func (u *createTransactionUseCase) createTx(
 // call API `listunspent`
 // this func returns no result, no error possibly, so caller should check both returned value
 func (u *createTransactionUseCase) getUnspentList(
-	accountType domainAccount.AccountType,
+	ctx context.Context, accountType domainAccount.AccountType,
 ) ([]btc.ListUnspentResult, []string, error) {
 	// get listUnspent
-	unspentList, err := u.btcClient.ListUnspentByAccount(accountType, u.btcClient.ConfirmationBlock())
+	unspentList, err := u.btcClient.ListUnspentByAccount(ctx, accountType, u.btcClient.ConfirmationBlock())
 	if err != nil {
 		return nil, nil, fmt.Errorf("fail to call btc.ListUnspentByAccount(): %w", err)
 	}
@@ -479,7 +487,7 @@ func (u *createTransactionUseCase) parseListUnspentTx(
 // address format stored in the database. The underlying btcsuite library and Bitcoin Core
 // RPC handle creating the appropriate scriptPubKey for each address type.
 func (u *createTransactionUseCase) createTxOutputs(
-	receiver domainAccount.AccountType,
+	ctx context.Context, receiver domainAccount.AccountType,
 	requiredAmount btcutil.Amount,
 	inputTotal btcutil.Amount,
 	senderAddr string,
@@ -487,7 +495,7 @@ func (u *createTransactionUseCase) createTxOutputs(
 ) (map[btcutil.Address]btcutil.Amount, error) {
 	// get unallocated address for receiver
 	// - deposit/transfer
-	pubkeyTable, err := u.addrRepo.GetOneUnAllocated(receiver)
+	pubkeyTable, err := u.addrRepo.GetOneUnAllocated(ctx, receiver)
 	if err != nil {
 		return nil, fmt.Errorf("fail to call pubkeyRepo.GetOneUnAllocated(): %w", err)
 	}
@@ -505,13 +513,13 @@ func (u *createTransactionUseCase) createTxOutputs(
 	} else {
 		txPrevOutputs[receiverDecodedAddr] = inputTotal // satoshi
 	}
-	logger.Debug("receiver txOutput",
+	logger.DebugContext(ctx, "receiver txOutput",
 		"receiverAddr", receiverAddr,
 		"receivedAmount", txPrevOutputs[receiverDecodedAddr])
 
 	// if change is required
 	if isChange {
-		logger.Debug("change is required")
+		logger.DebugContext(ctx, "change is required")
 		senderDecodedAddr, decodeErr := btcutil.DecodeAddress(senderAddr, u.btcClient.GetChainConf())
 		if decodeErr != nil {
 			return nil, fmt.Errorf("fail to call btcutil.DecodeAddress(%s): %w", receiverAddr, decodeErr)
@@ -522,7 +530,7 @@ func (u *createTransactionUseCase) createTxOutputs(
 		//  fee can not be paid from txOutput for change
 		txPrevOutputs[senderDecodedAddr] = inputTotal - requiredAmount
 
-		logger.Debug("change(sender) txOutput",
+		logger.DebugContext(ctx, "change(sender) txOutput",
 			"senderAddr", senderAddr,
 			"inputTotal - requiredAmount", inputTotal-requiredAmount)
 	}
@@ -576,7 +584,7 @@ func (u *createTransactionUseCase) createPaymentTxOutputs(
 }
 
 func (u *createTransactionUseCase) calculateOutputTotal(
-	sender domainAccount.AccountType,
+	ctx context.Context, sender domainAccount.AccountType,
 	receiver domainAccount.AccountType,
 	msgTx *wire.MsgTx,
 	adjustmentFee float64,
@@ -584,7 +592,7 @@ func (u *createTransactionUseCase) calculateOutputTotal(
 	txPrevOutputs map[btcutil.Address]btcutil.Amount,
 ) (btcutil.Amount, btcutil.Amount, map[btcutil.Address]btcutil.Amount, []*models.BTCTXOutput, error) {
 	// get fee
-	fee, err := u.btcClient.GetFee(msgTx, adjustmentFee)
+	fee, err := u.btcClient.GetFee(ctx, msgTx, adjustmentFee)
 	if err != nil {
 		return 0, 0, nil, nil, fmt.Errorf("fail to call btc.GetFee(): %w", err)
 	}
@@ -612,8 +620,8 @@ func (u *createTransactionUseCase) calculateOutputTotal(
 			break
 		}
 
-		if acnt, _ := u.btcClient.GetAccount(addr.String()); acnt == sender.String() {
-			logger.Debug("detect sender account in calculateOutputTotal")
+		if acnt, _ := u.btcClient.GetAccount(ctx, addr.String()); acnt == sender.String() {
+			logger.DebugContext(ctx, "detect sender account in calculateOutputTotal")
 			// address is used for change
 			txPrevOutputs[addr] -= fee
 			outputAmount, err := u.btcClient.AmountToDecimal(amt - fee)
@@ -642,7 +650,7 @@ func (u *createTransactionUseCase) calculateOutputTotal(
 		}
 		outputTotal += amt
 	}
-	logger.Debug("calculateOutputTotal",
+	logger.DebugContext(ctx, "calculateOutputTotal",
 		"fee", fee,
 		"outputTotal (before fee adjustment)", outputTotal,
 		"outputTotal by (inputTotal - fee)", inputTotal-fee,
@@ -653,7 +661,8 @@ func (u *createTransactionUseCase) calculateOutputTotal(
 	outputTotal = inputTotal - fee
 
 	if outputTotal <= 0 {
-		logger.Debug(
+		logger.DebugContext(
+			ctx,
 			"inputTotal is short of coin to pay fee",
 			"amount of inputTotal", inputTotal,
 			"fee", fee)
@@ -664,7 +673,7 @@ func (u *createTransactionUseCase) calculateOutputTotal(
 }
 
 func (u *createTransactionUseCase) insertTxTableForUnsigned(
-	actionType domainTx.ActionType,
+	ctx context.Context, actionType domainTx.ActionType,
 	hex string,
 	inputTotal,
 	outputTotal,
@@ -674,7 +683,7 @@ func (u *createTransactionUseCase) insertTxTableForUnsigned(
 	paymentRequestIds []int64,
 ) (int64, error) {
 	// skip if same hex is already stored
-	count, err := u.txRepo.GetCountByUnsignedHex(actionType, hex)
+	count, err := u.txRepo.GetCountByUnsignedHex(ctx, actionType, hex)
 	if err != nil {
 		return 0, fmt.Errorf("fail to call repo.Tx().GetCountByUnsignedHex(): %w", err)
 	}
@@ -717,7 +726,7 @@ func (u *createTransactionUseCase) insertTxTableForUnsigned(
 		}
 	}()
 
-	txID, err := u.txRepo.InsertUnsignedTx(actionType, txItem)
+	txID, err := u.txRepo.InsertUnsignedTx(ctx, actionType, txItem)
 	if err != nil {
 		return 0, fmt.Errorf("fail to call repo.Tx().InsertUnsignedTx(): %w", err)
 	}
@@ -727,7 +736,7 @@ func (u *createTransactionUseCase) insertTxTableForUnsigned(
 	for idx := range txInputs {
 		txInputs[idx].TXID = txID
 	}
-	err = u.txInputRepo.InsertBulk(txInputs)
+	err = u.txInputRepo.InsertBulk(ctx, txInputs)
 	if err != nil {
 		return 0, fmt.Errorf("fail to call txInRepo.InsertBulk(): %w", err)
 	}
@@ -737,18 +746,19 @@ func (u *createTransactionUseCase) insertTxTableForUnsigned(
 	for idx := range txOutputs {
 		txOutputs[idx].TXID = txID
 	}
-	err = u.txOutputRepo.InsertBulk(txOutputs)
+	err = u.txOutputRepo.InsertBulk(ctx, txOutputs)
 	if err != nil {
 		return 0, fmt.Errorf("fail to call repo.TxOutput().InsertBulk(): %w", err)
 	}
 
 	// update payment_id in payment_request table for only domainTx.ActionTypePayment
 	if actionType == domainTx.ActionTypePayment {
-		_, err = u.payReqRepo.UpdatePaymentID(txID, paymentRequestIds)
+		_, err = u.payReqRepo.UpdatePaymentID(ctx, txID, paymentRequestIds)
 		if err != nil {
 			return 0, fmt.Errorf("fail to call repo.PayReq().UpdatePaymentID(txID, paymentRequestIds): %w", err)
 		}
 	}
+	metrics.IncTx(u.btcClient.CoinTypeCode().String(), actionType.String(), metrics.TxStatusCreated)
 
 	return txID, nil
 }
@@ -768,7 +778,7 @@ func (u *createTransactionUseCase) insertTxTableForUnsigned(
 //   - Sign wallet (multisig second signature)
 //   - Hardware wallets (via BIP32 derivation paths)
 func (u *createTransactionUseCase) generatePSBTFile(
-	actionType domainTx.ActionType,
+	ctx context.Context, actionType domainTx.ActionType,
 	msgTx *wire.MsgTx,
 	previousTxs btc.PreviousTxs,
 	id int64,
@@ -784,12 +794,12 @@ func (u *createTransactionUseCase) generatePSBTFile(
 	path := u.txFileRepo.CreateFilePath(actionType, domainTx.TxTypeUnsigned, id, 0)
 
 	// Write PSBT file
-	generatedFileName, err := u.txFileRepo.WritePSBTFile(path, psbtBase64)
+	generatedFileName, err := u.txFileRepo.WritePSBTFile(ctx, path, psbtBase64)
 	if err != nil {
 		return "", fmt.Errorf("fail to write PSBT file: %w", err)
 	}
 
-	logger.Debug("generated PSBT file",
+	logger.DebugContext(ctx, "generated PSBT file",
 		"action", actionType.String(),
 		"txID", id,
 		"fileName", generatedFileName,
@@ -801,14 +811,14 @@ func (u *createTransactionUseCase) generatePSBTFile(
 }
 
 // createUserPayment gets payment data from payment_request table
-func (u *createTransactionUseCase) createUserPayment() ([]userPayment, []int64, error) {
+func (u *createTransactionUseCase) createUserPayment(ctx context.Context) ([]userPayment, []int64, error) {
 	// get payment_request
-	paymentRequests, err := u.payReqRepo.GetAll()
+	paymentRequests, err := u.payReqRepo.GetAll(ctx)
 	if err != nil {
 		return nil, nil, fmt.Errorf("fail to call repo.GetPaymentRequestAll(): %w", err)
 	}
 	if len(paymentRequests) == 0 {
-		logger.Debug("no data in payment_request")
+		logger.DebugContext(ctx, "no data in payment_request")
 		return nil, nil, nil
 	}
 
@@ -824,7 +834,7 @@ func (u *createTransactionUseCase) createUserPayment() ([]userPayment, []int64,
 		amt, parseErr := strconv.ParseFloat(val.Amount.String(), 64)
 		if parseErr != nil {
 			// fatal error because table includes invalid data
-			logger.Error("payment_request table includes invalid amount field")
+			logger.ErrorContext(ctx, "payment_request table includes invalid amount field")
 			return nil, nil, errors.New("payment_request table includes invalid amount field")
 		}
 		userPayments[idx].amount = amt
@@ -834,7 +844,7 @@ func (u *createTransactionUseCase) createUserPayment() ([]userPayment, []int64,
 		userPayments[idx].validRecAddr, err = u.btcClient.DecodeAddress(userPayments[idx].receiverAddr)
 		if err != nil {
 			// fatal error
-			logger.Error("unexpected error occurred converting receiverAddr from string type to address type")
+			logger.ErrorContext(ctx, "unexpected error occurred converting receiverAddr from string type to address type")
 			return nil, nil, errors.New(
 				"unexpected error occurred converting receiverAddr from string type to address type",
 			)
@@ -844,7 +854,7 @@ func (u *createTransactionUseCase) createUserPayment() ([]userPayment, []int64,
 		userPayments[idx].validAmount, err = u.btcClient.FloatToAmount(userPayments[idx].amount)
 		if err != nil {
 			// fatal error
-			logger.Error("unexpected error occurred converting amount from float64 type to Amount type")
+			logger.ErrorContext(ctx, "unexpected error occurred converting amount from float64 type to Amount type")
 			return nil, nil, errors.New("unexpected error occurred converting amount from float64 type to Amount type")
 		}
 	}
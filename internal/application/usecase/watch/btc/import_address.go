@@ -15,6 +15,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 // ImportAddressUseCase handles BTC address imports with rescan support
@@ -48,9 +49,12 @@ func NewImportAddressUseCase(
 }
 
 // Execute imports addresses from a file with optional rescan
-func (u *importAddressUseCase) Execute(ctx context.Context, input watchusecase.ImportAddressInput) error {
+func (u *importAddressUseCase) Execute(ctx context.Context, input watchusecase.ImportAddressInput) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.btc.ImportAddress.Execute")
+	defer tracer.End(span, &err)
+
 	// Read addresses from file
-	pubKeys, err := u.addrFileRepo.ImportAddress(input.FileName)
+	pubKeys, err := u.addrFileRepo.ImportAddress(ctx, input.FileName)
 	if err != nil {
 		return fmt.Errorf("failed to import addresses from file: %w", err)
 	}
@@ -73,10 +77,11 @@ func (u *importAddressUseCase) Execute(ctx context.Context, input watchusecase.I
 		}
 
 		// Import address into Bitcoin Core
-		err = u.btcClient.ImportAddressWithLabel(targetAddr, addrFmt.AccountType.String(), input.Rescan)
+		err = u.btcClient.ImportAddressWithLabel(ctx, targetAddr, addrFmt.AccountType.String(), input.Rescan)
 		if err != nil {
 			// Warning: address may already exist, continue with other addresses
-			logger.Warn(
+			logger.WarnContext(
+				ctx,
 				"failed to import address but continuing",
 				"address", targetAddr,
 				"account_type", addrFmt.AccountType.String(),
@@ -92,12 +97,12 @@ func (u *importAddressUseCase) Execute(ctx context.Context, input watchusecase.I
 		})
 
 		// Verify address was imported correctly
-		u.verifyImportedAddress(targetAddr)
+		u.verifyImportedAddress(ctx, targetAddr)
 	}
 
 	// Insert all addresses into database
 	if len(pubKeyData) > 0 {
-		if err := u.addrRepo.InsertBulk(pubKeyData); err != nil {
+		if err := u.addrRepo.InsertBulk(ctx, pubKeyData); err != nil {
 			return fmt.Errorf("failed to insert addresses into database: %w", err)
 		}
 	}
@@ -110,7 +115,7 @@ func (u *importAddressUseCase) selectTargetAddress(addrFmt *address.AddressForma
 	// For client accounts, use specific address format
 	if addrFmt.AccountType == domainAccount.AccountTypeClient {
 		switch u.btcClient.CoinTypeCode() {
-		case domainCoin.BTC:
+		case domainCoin.BTC, domainCoin.LTC:
 			switch u.addrType {
 			case address.AddrTypeBech32:
 				return addrFmt.Bech32Address, nil
@@ -127,9 +132,10 @@ func (u *importAddressUseCase) selectTargetAddress(addrFmt *address.AddressForma
 			default:
 				return addrFmt.P2SHSegwitAddress, nil
 			}
-		case domainCoin.BCH:
+		case domainCoin.BCH, domainCoin.DOGE:
 			return addrFmt.P2PKHAddress, nil
-		case domainCoin.LTC, domainCoin.ETH, domainCoin.XRP, domainCoin.ERC20, domainCoin.HYT:
+		case domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE, domainCoin.XRP,
+			domainCoin.SOL, domainCoin.TRX, domainCoin.ERC20, domainCoin.HYT:
 			return "", fmt.Errorf("unsupported coin type: %s", u.btcClient.CoinTypeCode().String())
 		default:
 			return "", fmt.Errorf("unknown coin type: %s", u.btcClient.CoinTypeCode().String())
@@ -141,23 +147,24 @@ func (u *importAddressUseCase) selectTargetAddress(addrFmt *address.AddressForma
 }
 
 // verifyImportedAddress confirms the address was imported correctly as watch-only
-func (u *importAddressUseCase) verifyImportedAddress(addr string) {
-	addrInfo, err := u.btcClient.GetAddressInfo(addr)
+func (u *importAddressUseCase) verifyImportedAddress(ctx context.Context, addr string) {
+	addrInfo, err := u.btcClient.GetAddressInfo(ctx, addr)
 	if err != nil {
-		logger.Error(
+		logger.ErrorContext(
+			ctx,
 			"failed to verify imported address",
 			"address", addr,
 			"error", err)
 		return
 	}
 
-	logger.Debug("address verified",
+	logger.DebugContext(ctx, "address verified",
 		"account", addrInfo.GetLabelName(),
 		"address", addr)
 
 	// Warn if not watch-only (should always be watch-only for watch wallets)
 	if !addrInfo.Iswatchonly {
-		logger.Warn("address should be watch-only",
+		logger.WarnContext(ctx, "address should be watch-only",
 			"address", addr)
 	}
 }
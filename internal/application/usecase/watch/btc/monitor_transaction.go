@@ -10,8 +10,12 @@ import (
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
 	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
 	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type monitorTransactionUseCase struct {
@@ -39,23 +43,33 @@ func NewMonitorTransactionUseCase(
 	}
 }
 
-func (u *monitorTransactionUseCase) UpdateTxStatus(ctx context.Context) error {
+func (u *monitorTransactionUseCase) UpdateTxStatus(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.btc.MonitorTransaction.UpdateTxStatus")
+	defer tracer.End(span, &err)
+
 	types := []domainTx.ActionType{
 		domainTx.ActionTypeDeposit,
 		domainTx.ActionTypePayment,
 		domainTx.ActionTypeTransfer,
 	}
 
-	// 1. Update transactions from Sent → Done (when confirmations meet threshold)
+	// 1. Roll back confirmed transactions whose block is no longer on the best chain
+	for _, actionType := range types {
+		if err := u.rollbackReorganizedTx(ctx, actionType); err != nil {
+			return fmt.Errorf("failed to check chain reorganization for %s: %w", actionType, err)
+		}
+	}
+
+	// 2. Update transactions from Sent → Done (when confirmations meet threshold)
 	for _, actionType := range types {
-		if err := u.updateStatusFromSentToDone(actionType); err != nil {
+		if err := u.updateStatusFromSentToDone(ctx, actionType); err != nil {
 			return fmt.Errorf("failed to update status to done for %s: %w", actionType, err)
 		}
 	}
 
-	// 2. Update transactions from Done → Notified (notify users and mark as notified)
+	// 3. Update transactions from Done → Notified (notify users and mark as notified)
 	for _, actionType := range types {
-		if err := u.updateStatusFromDoneToNotified(actionType); err != nil {
+		if err := u.updateStatusFromDoneToNotified(ctx, actionType); err != nil {
 			return fmt.Errorf("failed to update status to notified for %s: %w", actionType, err)
 		}
 	}
@@ -66,7 +80,10 @@ func (u *monitorTransactionUseCase) UpdateTxStatus(ctx context.Context) error {
 func (u *monitorTransactionUseCase) MonitorBalance(
 	ctx context.Context,
 	input watchusecase.MonitorBalanceInput,
-) error {
+) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.btc.MonitorTransaction.MonitorBalance")
+	defer tracer.End(span, &err)
+
 	targetAccounts := []domainAccount.AccountType{
 		domainAccount.AccountTypeClient,
 		domainAccount.AccountTypeDeposit,
@@ -75,12 +92,13 @@ func (u *monitorTransactionUseCase) MonitorBalance(
 	}
 
 	for _, account := range targetAccounts {
-		balance, err := u.btcClient.GetBalanceByAccount(account, input.ConfirmationNum)
+		balance, err := u.btcClient.GetBalanceByAccount(ctx, account, input.ConfirmationNum)
 		if err != nil {
 			return fmt.Errorf("failed to get balance for %s: %w", account, err)
 		}
 
-		logger.Info("account balance",
+		metrics.SetBalance(u.btcClient.CoinTypeCode().String(), account.String(), balance.ToBTC())
+		logger.InfoContext(ctx, "account balance",
 			"account", account.String(),
 			"balance", balance.String(),
 			"confirmations", input.ConfirmationNum)
@@ -90,18 +108,20 @@ func (u *monitorTransactionUseCase) MonitorBalance(
 }
 
 // updateStatusFromSentToDone updates transactions from Sent to Done when confirmations are met
-func (u *monitorTransactionUseCase) updateStatusFromSentToDone(actionType domainTx.ActionType) error {
+func (u *monitorTransactionUseCase) updateStatusFromSentToDone(
+	ctx context.Context, actionType domainTx.ActionType,
+) error {
 	// Get transactions with Sent status
-	hashes, err := u.txRepo.GetSentHashTx(actionType, domainTx.TxTypeSent)
+	hashes, err := u.txRepo.GetSentHashTx(ctx, actionType, domainTx.TxTypeSent)
 	if err != nil {
 		return fmt.Errorf("failed to get sent transactions: %w", err)
 	}
 
 	// Check confirmation for each transaction
 	for _, hash := range hashes {
-		isDone, err := u.checkTransactionConfirmation(hash, actionType)
+		tx, isDone, err := u.checkTransactionConfirmation(ctx, hash, actionType)
 		if err != nil {
-			logger.Error("failed to check transaction confirmation",
+			logger.ErrorContext(ctx, "failed to check transaction confirmation",
 				"action_type", actionType.String(),
 				"hash", hash,
 				"error", err)
@@ -109,14 +129,27 @@ func (u *monitorTransactionUseCase) updateStatusFromSentToDone(actionType domain
 		}
 
 		if isDone {
+			// Record block to detect chain reorganization later
+			txID, err := u.txRepo.GetTxIDBySentHash(ctx, actionType, hash)
+			if err != nil {
+				return fmt.Errorf("failed to get transaction ID: %w", err)
+			}
+			_, err = u.txRepo.UpdateBlock(ctx, txID, tx.Blockhash, int64(tx.Blockheight))
+			if err != nil {
+				return fmt.Errorf("failed to update block of tx: %w", err)
+			}
+
 			// Update status to Done
-			_, err = u.txRepo.UpdateTxTypeBySentHashTx(actionType, domainTx.TxTypeDone, hash)
+			_, err = u.txRepo.UpdateTxTypeBySentHashTx(ctx, actionType, domainTx.TxTypeDone, hash)
 			if err != nil {
 				return fmt.Errorf("failed to update tx to done status: %w", err)
 			}
-			logger.Info("transaction status updated to done",
+			metrics.IncTx(u.btcClient.CoinTypeCode().String(), actionType.String(), metrics.TxStatusConfirmed)
+			logger.InfoContext(ctx, "transaction status updated to done",
 				"action_type", actionType.String(),
-				"hash", hash)
+				"hash", hash,
+				"block_hash", tx.Blockhash,
+				"block_height", tx.Blockheight)
 		}
 	}
 
@@ -124,22 +157,24 @@ func (u *monitorTransactionUseCase) updateStatusFromSentToDone(actionType domain
 }
 
 // updateStatusFromDoneToNotified notifies users and updates status from Done to Notified
-func (u *monitorTransactionUseCase) updateStatusFromDoneToNotified(actionType domainTx.ActionType) error {
+func (u *monitorTransactionUseCase) updateStatusFromDoneToNotified(
+	ctx context.Context, actionType domainTx.ActionType,
+) error {
 	// Get transactions with Done status
-	hashes, err := u.txRepo.GetSentHashTx(actionType, domainTx.TxTypeDone)
+	hashes, err := u.txRepo.GetSentHashTx(ctx, actionType, domainTx.TxTypeDone)
 	if err != nil {
 		return fmt.Errorf("failed to get done transactions: %w", err)
 	}
 
-	logger.Debug("checking done transactions",
+	logger.DebugContext(ctx, "checking done transactions",
 		"action_type", actionType.String(),
 		"count", len(hashes))
 
 	// Notify for each transaction
 	for _, hash := range hashes {
-		txID, err := u.notifyTransactionDone(hash, actionType)
+		txID, err := u.notifyTransactionDone(ctx, hash, actionType)
 		if err != nil {
-			logger.Error("failed to notify transaction done",
+			logger.ErrorContext(ctx, "failed to notify transaction done",
 				"action_type", actionType.String(),
 				"hash", hash,
 				"error", err)
@@ -152,15 +187,15 @@ func (u *monitorTransactionUseCase) updateStatusFromDoneToNotified(actionType do
 		}
 
 		// Update status to Notified
-		if err := u.updateToNotifiedStatus(txID, actionType); err != nil {
-			logger.Error("failed to update to notified status",
+		if err := u.updateToNotifiedStatus(ctx, txID, actionType); err != nil {
+			logger.ErrorContext(ctx, "failed to update to notified status",
 				"action_type", actionType.String(),
 				"tx_id", txID,
 				"error", err)
 			continue
 		}
 
-		logger.Info("transaction notified",
+		logger.InfoContext(ctx, "transaction notified",
 			"action_type", actionType.String(),
 			"tx_id", txID,
 			"hash", hash)
@@ -171,16 +206,16 @@ func (u *monitorTransactionUseCase) updateStatusFromDoneToNotified(actionType do
 
 // checkTransactionConfirmation checks if transaction has enough confirmations
 func (u *monitorTransactionUseCase) checkTransactionConfirmation(
-	hash string,
+	ctx context.Context, hash string,
 	actionType domainTx.ActionType,
-) (bool, error) {
+) (*btc.GetTransactionResult, bool, error) {
 	// Get transaction details from Bitcoin network
-	tx, err := u.btcClient.GetTransactionByTxID(hash)
+	tx, err := u.btcClient.GetTransactionByTxID(ctx, hash)
 	if err != nil {
-		return false, fmt.Errorf("failed to get transaction details: %w", err)
+		return nil, false, fmt.Errorf("failed to get transaction details: %w", err)
 	}
 
-	logger.Debug("transaction confirmation status",
+	logger.DebugContext(ctx, "transaction confirmation status",
 		"action_type", actionType.String(),
 		"hash", hash,
 		"confirmations", tx.Confirmations,
@@ -188,36 +223,174 @@ func (u *monitorTransactionUseCase) checkTransactionConfirmation(
 
 	// Check if confirmations meet threshold
 	if tx.Confirmations >= u.btcClient.ConfirmationBlock() {
-		return true, nil
+		return tx, true, nil
 	}
 
 	// Not enough confirmations yet
-	logger.Info("waiting for more confirmations",
+	logger.InfoContext(ctx, "waiting for more confirmations",
 		"hash", hash,
 		"current", tx.Confirmations,
 		"required", u.btcClient.ConfirmationBlock())
 
-	return false, nil
+	return tx, false, nil
+}
+
+// rollbackReorganizedTx rolls back confirmed transactions included in recent blocks
+// which are no longer on the best chain
+func (u *monitorTransactionUseCase) rollbackReorganizedTx(ctx context.Context, actionType domainTx.ActionType) error {
+	tipHeight, err := u.btcClient.GetBlockCount(ctx)
+	if err != nil {
+		// confirmation update should go on, reorganization is checked again on next run
+		logger.ErrorContext(ctx, "failed to get block count, chain reorganization check is skipped",
+			"action_type", actionType.String(),
+			"error", err)
+		return nil
+	}
+	fromHeight := max(tipHeight-int64(u.btcClient.ReorgDepth()), 0)
+
+	txs, err := u.txRepo.GetConfirmedFromHeight(ctx, actionType, fromHeight)
+	if err != nil {
+		return fmt.Errorf("failed to get confirmed transactions: %w", err)
+	}
+
+	for _, tx := range txs {
+		// block is unknown for transactions confirmed before block was recorded
+		if tx.BlockHash == "" {
+			continue
+		}
+
+		// best chain can be shorter than stored height right after reorganization
+		isInBestChain := false
+		if tx.BlockHeight <= tipHeight {
+			isInBestChain, err = u.btcClient.IsBlockInBestChain(ctx, tx.BlockHash, tx.BlockHeight)
+			if err != nil {
+				logger.ErrorContext(ctx, "failed to check block on best chain",
+					"action_type", actionType.String(),
+					"tx_id", tx.ID,
+					"block_hash", tx.BlockHash,
+					"error", err)
+				continue
+			}
+		}
+		if isInBestChain {
+			continue
+		}
+
+		if err := u.rollbackTransaction(ctx, tx, actionType); err != nil {
+			return fmt.Errorf("failed to roll back transaction %d: %w", tx.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// rollbackTransaction moves transaction back to sent, reverses payment requests or deposit marked done
+// by its confirmation and rebroadcasts it when it is no longer included in any block
+func (u *monitorTransactionUseCase) rollbackTransaction(
+	ctx context.Context, tx *models.BTCTX, actionType domainTx.ActionType,
+) error {
+	logger.ErrorContext(ctx, "ALERT: chain reorganization detected, confirmed transaction is rolled back",
+		"action_type", actionType.String(),
+		"tx_id", tx.ID,
+		"hash", tx.SentHashTX,
+		"block_hash", tx.BlockHash,
+		"block_height", tx.BlockHeight,
+		"tx_type", tx.CurrentTXType)
+
+	if err := u.rollbackToSentStatus(ctx, tx.ID, actionType); err != nil {
+		return err
+	}
+
+	if actionType == domainTx.ActionTypeDeposit {
+		// deposit is credited again when transaction is confirmed and notified again
+		txInputs, err := u.txInputRepo.GetAllByTxID(ctx, tx.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get transaction inputs: %w", err)
+		}
+		for _, input := range txInputs {
+			logger.ErrorContext(ctx, "ALERT: deposit is reversed by chain reorganization",
+				"tx_id", tx.ID,
+				"address", input.InputAddress,
+				"amount", input.InputAmount.String())
+		}
+	}
+
+	// rebroadcast if transaction has been dropped from the best chain
+	// transaction included in another block is confirmed again by Sent → Done step
+	txResult, err := u.btcClient.GetTransactionByTxID(ctx, tx.SentHashTX)
+	if err == nil && txResult.Confirmations > 0 {
+		return nil
+	}
+	if tx.SignedHexTX == "" {
+		logger.WarnContext(ctx, "signed transaction is not stored, it can't be rebroadcast",
+			"tx_id", tx.ID,
+			"hash", tx.SentHashTX)
+		return nil
+	}
+	if _, err := u.btcClient.SendTransactionByHex(ctx, tx.SignedHexTX); err != nil {
+		// e.g. transaction may be still in mempool
+		logger.WarnContext(ctx, "failed to rebroadcast transaction",
+			"tx_id", tx.ID,
+			"hash", tx.SentHashTX,
+			"error", err)
+		return nil
+	}
+	logger.InfoContext(ctx, "transaction is rebroadcast after chain reorganization",
+		"tx_id", tx.ID,
+		"hash", tx.SentHashTX)
+
+	return nil
+}
+
+// rollbackToSentStatus updates transaction status to Sent in database transaction
+//   - payment: is_done of linked payment requests is reset
+//   - deposit: deposit is no longer done or notified, so it's credited again after confirmation
+func (u *monitorTransactionUseCase) rollbackToSentStatus(
+	ctx context.Context, txID int64, actionType domainTx.ActionType,
+) (err error) {
+	dtx, err := u.dbConn.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = dtx.Rollback()
+			return
+		}
+		if err = dtx.Commit(); err != nil {
+			err = fmt.Errorf("failed to commit transaction: %w", err)
+		}
+	}()
+
+	if _, err = u.txRepo.WithTx(dtx).RollbackToSent(ctx, txID); err != nil {
+		return fmt.Errorf("failed to roll back tx to sent status: %w", err)
+	}
+	if actionType == domainTx.ActionTypePayment {
+		if _, err = u.payReqRepo.WithTx(dtx).ResetIsDone(ctx, txID); err != nil {
+			return fmt.Errorf("failed to reset payment request: %w", err)
+		}
+	}
+	return nil
 }
 
 // notifyTransactionDone notifies relevant parties that transaction is confirmed
 func (u *monitorTransactionUseCase) notifyTransactionDone(
-	hash string,
+	ctx context.Context, hash string,
 	actionType domainTx.ActionType,
 ) (int64, error) {
 	// Get transaction ID
-	txID, err := u.txRepo.GetTxIDBySentHash(actionType, hash)
+	txID, err := u.txRepo.GetTxIDBySentHash(ctx, actionType, hash)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get transaction ID: %w", err)
 	}
 
 	switch actionType {
 	case domainTx.ActionTypeDeposit:
-		return u.notifyDepositTransaction(txID)
+		return u.notifyDepositTransaction(ctx, txID)
 	case domainTx.ActionTypePayment:
-		return u.notifyPaymentTransaction(txID)
+		return u.notifyPaymentTransaction(ctx, txID)
 	case domainTx.ActionTypeTransfer:
-		logger.Warn("transfer notification not implemented yet")
+		logger.WarnContext(ctx, "transfer notification not implemented yet")
 		return 0, errors.New("transfer transaction notification not implemented")
 	default:
 		return 0, fmt.Errorf("unknown action type: %s", actionType)
@@ -225,21 +398,21 @@ func (u *monitorTransactionUseCase) notifyTransactionDone(
 }
 
 // notifyDepositTransaction notifies about deposit transaction
-func (u *monitorTransactionUseCase) notifyDepositTransaction(txID int64) (int64, error) {
+func (u *monitorTransactionUseCase) notifyDepositTransaction(ctx context.Context, txID int64) (int64, error) {
 	// Get transaction inputs
-	txInputs, err := u.txInputRepo.GetAllByTxID(txID)
+	txInputs, err := u.txInputRepo.GetAllByTxID(ctx, txID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get transaction inputs: %w", err)
 	}
 
 	if len(txInputs) == 0 {
-		logger.Debug("no transaction inputs found", "tx_id", txID)
+		logger.DebugContext(ctx, "no transaction inputs found", "tx_id", txID)
 		return 0, nil
 	}
 
 	// Notify affected addresses (TODO: implement actual notification mechanism)
 	for _, input := range txInputs {
-		logger.Debug("deposit transaction input address",
+		logger.DebugContext(ctx, "deposit transaction input address",
 			"tx_id", txID,
 			"address", input.InputAddress)
 		// TODO: Send notification to address owner
@@ -249,21 +422,21 @@ func (u *monitorTransactionUseCase) notifyDepositTransaction(txID int64) (int64,
 }
 
 // notifyPaymentTransaction notifies about payment transaction
-func (u *monitorTransactionUseCase) notifyPaymentTransaction(txID int64) (int64, error) {
+func (u *monitorTransactionUseCase) notifyPaymentTransaction(ctx context.Context, txID int64) (int64, error) {
 	// Get payment requests
-	paymentRequests, err := u.payReqRepo.GetAllByPaymentID(txID)
+	paymentRequests, err := u.payReqRepo.GetAllByPaymentID(ctx, txID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get payment requests: %w", err)
 	}
 
 	if len(paymentRequests) == 0 {
-		logger.Debug("no payment requests found", "tx_id", txID)
+		logger.DebugContext(ctx, "no payment requests found", "tx_id", txID)
 		return 0, nil
 	}
 
 	// Notify payment recipients (TODO: implement actual notification mechanism)
 	for _, req := range paymentRequests {
-		logger.Debug("payment transaction recipient",
+		logger.DebugContext(ctx, "payment transaction recipient",
 			"tx_id", txID,
 			"sender_address", req.SenderAddress)
 		// TODO: Send notification to payment recipient
@@ -273,10 +446,12 @@ func (u *monitorTransactionUseCase) notifyPaymentTransaction(txID int64) (int64,
 }
 
 // updateToNotifiedStatus updates transaction status to Notified
-func (u *monitorTransactionUseCase) updateToNotifiedStatus(txID int64, actionType domainTx.ActionType) error {
+func (u *monitorTransactionUseCase) updateToNotifiedStatus(
+	ctx context.Context, txID int64, actionType domainTx.ActionType,
+) error {
 	switch actionType {
 	case domainTx.ActionTypeDeposit:
-		_, err := u.txRepo.UpdateTxType(txID, domainTx.TxTypeNotified)
+		_, err := u.txRepo.UpdateTxType(ctx, txID, domainTx.TxTypeNotified)
 		if err != nil {
 			return fmt.Errorf("failed to update tx type to notified: %w", err)
 		}
@@ -296,19 +471,19 @@ func (u *monitorTransactionUseCase) updateToNotifiedStatus(txID int64, actionTyp
 		}()
 
 		// Update transaction type
-		_, err = u.txRepo.UpdateTxType(txID, domainTx.TxTypeNotified)
+		_, err = u.txRepo.WithTx(dtx).UpdateTxType(ctx, txID, domainTx.TxTypeNotified)
 		if err != nil {
 			return fmt.Errorf("failed to update tx type to notified: %w", err)
 		}
 
 		// Mark payment request as done
-		_, err = u.payReqRepo.UpdateIsDone(txID)
+		_, err = u.payReqRepo.WithTx(dtx).UpdateIsDone(ctx, txID)
 		if err != nil {
 			return fmt.Errorf("failed to update payment request: %w", err)
 		}
 
 	case domainTx.ActionTypeTransfer:
-		logger.Warn("transfer status update not implemented yet")
+		logger.WarnContext(ctx, "transfer status update not implemented yet")
 		return errors.New("transfer transaction status update not implemented")
 
 	default:
@@ -0,0 +1,282 @@
+package btc
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed" // SQLite compiled to wasm, no cgo is required
+	"github.com/ncruces/go-sqlite3/vfs/memdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+const (
+	reorgTxID   = 10
+	reorgHash   = "reorg-tx-hash"
+	reorgBlock  = "orphaned-block-hash"
+	reorgHeight = 100
+)
+
+// fakeReorgBitcoiner returns best chain after reorganization, other methods aren't implemented
+type fakeReorgBitcoiner struct {
+	bitcoin.Bitcoiner
+	tipHeight int64
+	// inBestChain is block hashes on the best chain
+	inBestChain map[string]bool
+	// confirmations of transaction in the best chain
+	confirmations uint64
+	rebroadcast   []string
+}
+
+func (b *fakeReorgBitcoiner) GetBlockCount(_ context.Context) (int64, error) {
+	return b.tipHeight, nil
+}
+
+func (b *fakeReorgBitcoiner) ReorgDepth() uint64 {
+	return 6
+}
+
+func (b *fakeReorgBitcoiner) IsBlockInBestChain(_ context.Context, blockHash string, _ int64) (bool, error) {
+	return b.inBestChain[blockHash], nil
+}
+
+func (b *fakeReorgBitcoiner) GetTransactionByTxID(_ context.Context, _ string) (*btc.GetTransactionResult, error) {
+	return &btc.GetTransactionResult{Confirmations: b.confirmations}, nil
+}
+
+func (b *fakeReorgBitcoiner) SendTransactionByHex(_ context.Context, hex string) (*chainhash.Hash, error) {
+	b.rebroadcast = append(b.rebroadcast, hex)
+	return &chainhash.Hash{}, nil
+}
+
+// fakeReorgTxRepo returns confirmed transaction, it's rolled back by repository on database
+type fakeReorgTxRepo struct {
+	watchrepo.BTCTxRepositorier
+	tx *models.BTCTX
+}
+
+func (r *fakeReorgTxRepo) GetConfirmedFromHeight(
+	_ context.Context, _ domainTx.ActionType, _ int64,
+) ([]*models.BTCTX, error) {
+	return []*models.BTCTX{r.tx}, nil
+}
+
+func (r *fakeReorgTxRepo) WithTx(dtx *sql.Tx) watchrepo.BTCTxRepositorier {
+	return &fakeReorgTxRepo{BTCTxRepositorier: r.BTCTxRepositorier.WithTx(dtx), tx: r.tx}
+}
+
+// fakeReorgTxInputRepo returns input of deposit transaction
+type fakeReorgTxInputRepo struct {
+	watchrepo.TxInputRepositorier
+}
+
+func (r *fakeReorgTxInputRepo) GetAllByTxID(_ context.Context, id int64) ([]*models.BTCTXInput, error) {
+	return []*models.BTCTXInput{{TXID: id, InputAddress: "client-address"}}, nil
+}
+
+// alertLogger records error logs
+type alertLogger struct {
+	logger.NoopLogger
+	alerts []string
+}
+
+func (l *alertLogger) Error(msg string, _ ...any) {
+	l.alerts = append(l.alerts, msg)
+}
+
+// newReorgDB returns in-memory sqlite with btc_tx and payment_request used by rollback
+//   - payment_request isn't created when withPaymentRequest is false, so that rollback fails
+func newReorgDB(t *testing.T, withPaymentRequest bool) *sql.DB {
+	t.Helper()
+
+	dbConn, err := driver.Open(memdb.TestDB(t))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = dbConn.Close() })
+
+	queries := []string{
+		`CREATE TABLE btc_tx (
+			id INTEGER PRIMARY KEY,
+			current_tx_type INTEGER NOT NULL,
+			block_hash TEXT NOT NULL,
+			block_height INTEGER NOT NULL
+		)`,
+		`INSERT INTO btc_tx VALUES (10, 4, 'orphaned-block-hash', 100)`,
+	}
+	if withPaymentRequest {
+		queries = append(queries,
+			`CREATE TABLE payment_request (
+				id INTEGER PRIMARY KEY,
+				coin TEXT NOT NULL,
+				payment_id INTEGER,
+				is_done BOOLEAN NOT NULL
+			)`,
+			`INSERT INTO payment_request VALUES (1, 'btc', 10, true)`,
+		)
+	}
+	for _, query := range queries {
+		_, err = dbConn.Exec(query)
+		require.NoError(t, err)
+	}
+	return dbConn
+}
+
+// TestRollbackReorganizedTx is test for confirmed transaction whose block is no longer on the best chain
+func TestRollbackReorganizedTx(t *testing.T) {
+	tests := []struct {
+		name        string
+		actionType  domainTx.ActionType
+		tipHeight   int64
+		inBestChain map[string]bool
+		// confirmations of transaction in the best chain
+		confirmations      uint64
+		withPaymentRequest bool
+		wantErr            bool
+		wantTxType         domainTx.TxType
+		wantBlockHash      string
+		wantIsDone         bool
+		wantRebroadcast    bool
+		wantAlerts         []string
+	}{
+		{
+			name:               "block is still on the best chain",
+			actionType:         domainTx.ActionTypePayment,
+			tipHeight:          reorgHeight + 1,
+			inBestChain:        map[string]bool{reorgBlock: true},
+			withPaymentRequest: true,
+			wantTxType:         domainTx.TxTypeDone,
+			wantBlockHash:      reorgBlock,
+			wantIsDone:         true,
+		},
+		{
+			name:               "payment is rolled back and rebroadcast",
+			actionType:         domainTx.ActionTypePayment,
+			tipHeight:          reorgHeight + 1,
+			withPaymentRequest: true,
+			wantTxType:         domainTx.TxTypeSent,
+			wantRebroadcast:    true,
+			wantAlerts:         []string{"ALERT: chain reorganization detected, confirmed transaction is rolled back"},
+		},
+		{
+			name:               "payment included in another block isn't rebroadcast",
+			actionType:         domainTx.ActionTypePayment,
+			tipHeight:          reorgHeight + 1,
+			confirmations:      1,
+			withPaymentRequest: true,
+			wantTxType:         domainTx.TxTypeSent,
+			wantAlerts:         []string{"ALERT: chain reorganization detected, confirmed transaction is rolled back"},
+		},
+		{
+			name:               "best chain is shorter than block of transaction",
+			actionType:         domainTx.ActionTypePayment,
+			tipHeight:          reorgHeight - 1,
+			inBestChain:        map[string]bool{reorgBlock: true},
+			withPaymentRequest: true,
+			wantTxType:         domainTx.TxTypeSent,
+			wantRebroadcast:    true,
+			wantAlerts:         []string{"ALERT: chain reorganization detected, confirmed transaction is rolled back"},
+		},
+		{
+			name:            "deposit is reversed",
+			actionType:      domainTx.ActionTypeDeposit,
+			tipHeight:       reorgHeight + 1,
+			wantTxType:      domainTx.TxTypeSent,
+			wantRebroadcast: true,
+			wantAlerts: []string{
+				"ALERT: chain reorganization detected, confirmed transaction is rolled back",
+				"ALERT: deposit is reversed by chain reorganization",
+			},
+		},
+		{
+			name:          "tx isn't rolled back without payment requests rolled back",
+			actionType:    domainTx.ActionTypePayment,
+			tipHeight:     reorgHeight + 1,
+			wantErr:       true,
+			wantTxType:    domainTx.TxTypeDone,
+			wantBlockHash: reorgBlock,
+			wantAlerts:    []string{"ALERT: chain reorganization detected, confirmed transaction is rolled back"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts := &alertLogger{}
+			logger.SetGlobal(alerts)
+			t.Cleanup(func() { logger.SetGlobal(logger.NewNoopLogger()) })
+
+			ctx := context.Background()
+			dbConn := newReorgDB(t, tt.withPaymentRequest)
+			bitcoiner := &fakeReorgBitcoiner{
+				tipHeight:     tt.tipHeight,
+				inBestChain:   tt.inBestChain,
+				confirmations: tt.confirmations,
+			}
+			u := &monitorTransactionUseCase{
+				btcClient: bitcoiner,
+				dbConn:    dbConn,
+				txRepo: &fakeReorgTxRepo{
+					BTCTxRepositorier: watchrepo.NewBTCTxRepositorySqlc(dbConn, domainCoin.BTC),
+					tx: &models.BTCTX{
+						ID:            reorgTxID,
+						SignedHexTX:   "signed-hex",
+						SentHashTX:    reorgHash,
+						CurrentTXType: domainTx.TxTypeDone.Int8(),
+						BlockHash:     reorgBlock,
+						BlockHeight:   reorgHeight,
+					},
+				},
+				txInputRepo: &fakeReorgTxInputRepo{},
+				payReqRepo:  watchrepo.NewPaymentRequestRepositorySqlc(dbConn, domainCoin.BTC),
+			}
+
+			err := u.rollbackReorganizedTx(ctx, tt.actionType)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantAlerts, alerts.alerts)
+			assert.Equal(t, tt.wantRebroadcast, len(bitcoiner.rebroadcast) == 1)
+
+			var (
+				txType    int8
+				blockHash string
+			)
+			require.NoError(t, dbConn.QueryRowContext(ctx,
+				"SELECT current_tx_type, block_hash FROM btc_tx WHERE id = ?", reorgTxID).Scan(&txType, &blockHash))
+			assert.Equal(t, tt.wantTxType.Int8(), txType)
+			assert.Equal(t, tt.wantBlockHash, blockHash)
+
+			if tt.withPaymentRequest {
+				var isDone bool
+				require.NoError(t, dbConn.QueryRowContext(ctx,
+					"SELECT is_done FROM payment_request WHERE id = 1").Scan(&isDone))
+				assert.Equal(t, tt.wantIsDone, isDone)
+			}
+		})
+	}
+}
+
+// TestRollbackReorganizedTxSkipsUnknownBlock is test for transaction confirmed before block was recorded
+func TestRollbackReorganizedTxSkipsUnknownBlock(t *testing.T) {
+	bitcoiner := &fakeReorgBitcoiner{tipHeight: reorgHeight + 1}
+	u := &monitorTransactionUseCase{
+		btcClient: bitcoiner,
+		txRepo: &fakeReorgTxRepo{tx: &models.BTCTX{
+			ID:            reorgTxID,
+			CurrentTXType: domainTx.TxTypeDone.Int8(),
+		}},
+	}
+
+	require.NoError(t, u.rollbackReorganizedTx(context.Background(), domainTx.ActionTypePayment))
+	assert.Empty(t, bitcoiner.rebroadcast)
+}
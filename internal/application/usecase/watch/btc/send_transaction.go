@@ -12,6 +12,8 @@ import (
 	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type sendTransactionUseCase struct {
@@ -42,48 +44,52 @@ func NewSendTransactionUseCase(
 func (u *sendTransactionUseCase) Execute(
 	ctx context.Context,
 	input watchusecase.SendTransactionInput,
-) (watchusecase.SendTransactionOutput, error) {
+) (_ watchusecase.SendTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.btc.SendTransaction.Execute")
+	defer tracer.End(span, &err)
+
 	// Validate file path and extract transaction metadata
 	actionType, _, txID, _, err := u.txFileRepo.ValidateFilePath(input.FilePath, domainTx.TxTypeSigned)
 	if err != nil {
 		return watchusecase.SendTransactionOutput{}, fmt.Errorf("invalid file path: %w", err)
 	}
 
-	logger.Debug("sending transaction", "action_type", actionType.String(), "tx_id", txID)
+	logger.DebugContext(ctx, "sending transaction", "action_type", actionType.String(), "tx_id", txID)
 
 	// Determine file format based on extension and read accordingly
 	var signedHex string
 	if isPSBTFile(input.FilePath) {
 		// PSBT flow: finalize → extract → convert to hex
-		signedHex, err = u.processPSBTFile(input.FilePath)
+		signedHex, err = u.processPSBTFile(ctx, input.FilePath)
 		if err != nil {
 			return watchusecase.SendTransactionOutput{}, fmt.Errorf("failed to process PSBT file: %w", err)
 		}
 	} else {
 		// Legacy flow: read hex directly from file
-		signedHex, err = u.txFileRepo.ReadFile(input.FilePath)
+		signedHex, err = u.txFileRepo.ReadFile(ctx, input.FilePath)
 		if err != nil {
 			return watchusecase.SendTransactionOutput{}, fmt.Errorf("failed to read transaction file: %w", err)
 		}
 	}
 
 	// Broadcast transaction to Bitcoin network
-	hash, err := u.btcClient.SendTransactionByHex(signedHex)
+	hash, err := u.btcClient.SendTransactionByHex(ctx, signedHex)
 	if err != nil {
 		return watchusecase.SendTransactionOutput{}, fmt.Errorf("failed to broadcast transaction: %w", err)
 	}
 
 	// Check if transaction was already sent
 	if hash == nil {
-		logger.Info("transaction already sent", "tx_id", txID)
+		logger.InfoContext(ctx, "transaction already sent", "tx_id", txID)
 		return watchusecase.SendTransactionOutput{TxID: ""}, nil
 	}
 
 	// Update transaction status in database
-	affectedNum, err := u.txRepo.UpdateAfterTxSent(txID, domainTx.TxTypeSent, signedHex, hash.String())
+	affectedNum, err := u.txRepo.UpdateAfterTxSent(ctx, txID, domainTx.TxTypeSent, signedHex, hash.String())
 	if err != nil {
 		// Critical: transaction is broadcasted but database update failed
-		logger.Warn(
+		logger.WarnContext(
+			ctx,
 			"transaction sent but database update failed - manual correction required",
 			"tx_id", txID,
 			"tx_type", domainTx.TxTypeSent.String(),
@@ -95,17 +101,19 @@ func (u *sendTransactionUseCase) Execute(
 	}
 
 	if affectedNum == 0 {
-		logger.Info("no records updated",
+		logger.InfoContext(ctx, "no records updated",
 			"tx_id", txID,
 			"tx_hash", hash.String())
 		return watchusecase.SendTransactionOutput{TxID: hash.String()}, nil
 	}
+	metrics.IncTx(u.btcClient.CoinTypeCode().String(), actionType.String(), metrics.TxStatusSent)
 
 	// Update address allocation status (skip for payment transactions with anonymous receivers)
 	if actionType != domainTx.ActionTypePayment {
-		if err := u.updateAddressAllocation(txID); err != nil {
+		if err := u.updateAddressAllocation(ctx, txID); err != nil {
 			// Critical: transaction sent and DB updated, but address allocation failed
-			logger.Error(
+			logger.ErrorContext(
+				ctx,
 				"transaction sent but address allocation update failed - manual correction required",
 				"tx_id", txID,
 				"error", err)
@@ -119,9 +127,9 @@ func (u *sendTransactionUseCase) Execute(
 }
 
 // processPSBTFile processes a PSBT file: validates, finalizes, extracts, and converts to hex
-func (u *sendTransactionUseCase) processPSBTFile(filePath string) (string, error) {
+func (u *sendTransactionUseCase) processPSBTFile(ctx context.Context, filePath string) (string, error) {
 	// Read PSBT from file
-	psbtBase64, err := u.txFileRepo.ReadPSBTFile(filePath)
+	psbtBase64, err := u.txFileRepo.ReadPSBTFile(ctx, filePath)
 	if err != nil {
 		return "", fmt.Errorf("failed to read PSBT file: %w", err)
 	}
@@ -135,7 +143,7 @@ func (u *sendTransactionUseCase) processPSBTFile(filePath string) (string, error
 		return "", errors.New("PSBT is not fully signed - cannot finalize incomplete PSBT")
 	}
 
-	logger.Debug("PSBT validation passed", "is_complete", isComplete)
+	logger.DebugContext(ctx, "PSBT validation passed", "is_complete", isComplete)
 
 	// Finalize PSBT (combine signatures into final scriptSig/witness)
 	finalizedPSBT, err := u.btcClient.FinalizePSBT(psbtBase64)
@@ -143,7 +151,7 @@ func (u *sendTransactionUseCase) processPSBTFile(filePath string) (string, error
 		return "", fmt.Errorf("failed to finalize PSBT: %w", err)
 	}
 
-	logger.Debug("PSBT finalized successfully")
+	logger.DebugContext(ctx, "PSBT finalized successfully")
 
 	// Extract final transaction from PSBT
 	msgTx, err := u.btcClient.ExtractTransaction(finalizedPSBT)
@@ -157,7 +165,7 @@ func (u *sendTransactionUseCase) processPSBTFile(filePath string) (string, error
 		return "", fmt.Errorf("failed to convert transaction to hex: %w", err)
 	}
 
-	logger.Debug("transaction extracted from PSBT", "hex_length", len(hexTx))
+	logger.DebugContext(ctx, "transaction extracted from PSBT", "hex_length", len(hexTx))
 
 	return hexTx, nil
 }
@@ -168,9 +176,9 @@ func isPSBTFile(filePath string) bool {
 }
 
 // updateAddressAllocation marks the receiver address as allocated
-func (u *sendTransactionUseCase) updateAddressAllocation(txID int64) error {
+func (u *sendTransactionUseCase) updateAddressAllocation(ctx context.Context, txID int64) error {
 	// Get transaction outputs
-	txOutputs, err := u.txOutputRepo.GetAllByTxID(txID)
+	txOutputs, err := u.txOutputRepo.GetAllByTxID(ctx, txID)
 	if err != nil {
 		return fmt.Errorf("failed to get transaction outputs: %w", err)
 	}
@@ -180,7 +188,7 @@ func (u *sendTransactionUseCase) updateAddressAllocation(txID int64) error {
 	}
 
 	// Mark first output address as allocated
-	_, err = u.addrRepo.UpdateIsAllocated(true, txOutputs[0].OutputAddress)
+	_, err = u.addrRepo.UpdateIsAllocated(ctx, true, txOutputs[0].OutputAddress)
 	if err != nil {
 		return fmt.Errorf("failed to update address allocation status: %w", err)
 	}
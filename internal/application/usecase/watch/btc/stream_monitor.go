@@ -0,0 +1,317 @@
+package btc
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// DefaultPollInterval is interval of polling when no block is notified
+const DefaultPollInterval = time.Minute
+
+// streamCursorName is name of cursor to store last block height scanned for deposits
+const streamCursorName = "block"
+
+type streamMonitorUseCase struct {
+	btcClient    bitcoin.Bitcoiner
+	notifier     bitcoin.BlockNotifier
+	monitor      watchusecase.MonitorTransactionUseCase
+	deposit      watchusecase.CreateTransactionUseCase
+	addrRepo     watchrepo.AddressRepositorier
+	cursorRepo   watchrepo.StreamCursorRepositorier
+	pollInterval time.Duration
+
+	// pkScript hex of client addresses -> address
+	clientScripts map[string]string
+	// last block height scanned for deposits
+	lastHeight int64
+	// blocks notified by rawblock, block hash -> block
+	rawBlocks map[string]*wire.MsgBlock
+	// heights of blocks including deposits which are waiting for confirmations
+	depositHeights map[int64]struct{}
+}
+
+// NewStreamMonitorUseCase creates a new StreamMonitorUseCase
+//
+// notifier can be nil, then transactions are only polled every pollInterval
+//   - deposit creates deposit transaction same as `create deposit` once detected deposits are confirmed
+func NewStreamMonitorUseCase(
+	btcClient bitcoin.Bitcoiner,
+	notifier bitcoin.BlockNotifier,
+	monitor watchusecase.MonitorTransactionUseCase,
+	deposit watchusecase.CreateTransactionUseCase,
+	addrRepo watchrepo.AddressRepositorier,
+	cursorRepo watchrepo.StreamCursorRepositorier,
+	pollInterval time.Duration,
+) watchusecase.StreamMonitorUseCase {
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	return &streamMonitorUseCase{
+		btcClient:      btcClient,
+		notifier:       notifier,
+		monitor:        monitor,
+		deposit:        deposit,
+		addrRepo:       addrRepo,
+		cursorRepo:     cursorRepo,
+		pollInterval:   pollInterval,
+		clientScripts:  make(map[string]string),
+		rawBlocks:      make(map[string]*wire.MsgBlock),
+		depositHeights: make(map[int64]struct{}),
+	}
+}
+
+// Run updates confirmation of sent transactions and detects deposits as blocks arrive
+// - block notification: sent transactions are checked and the new blocks are scanned for deposits
+// - transaction notification: outputs to client addresses are detected before confirmation
+// - no block is notified within poll interval: same as block notification (polling fallback)
+// - notification gap: addresses are reloaded and missed blocks are scanned (catch-up)
+// - restart: blocks from last scanned height stored in stream_cursor are scanned (catch-up)
+func (u *streamMonitorUseCase) Run(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.btc.StreamMonitor.Run")
+	defer tracer.End(span, &err)
+
+	if err := u.loadClientAddresses(ctx); err != nil {
+		return err
+	}
+	if err := u.loadLastHeight(ctx); err != nil {
+		return err
+	}
+
+	// transactions confirmed and blocks mined while process was stopped
+	u.catchUp(ctx)
+
+	var events <-chan btc.ZMQEvent
+	errCh := make(chan error, 1)
+	if u.notifier != nil {
+		events = u.notifier.Events()
+		go func() {
+			errCh <- u.notifier.Start(ctx)
+		}()
+		logger.InfoContext(
+			ctx, "stream monitor started", "topics", u.notifier.Topics(), "poll_interval", u.pollInterval.String(),
+		)
+	} else {
+		logger.InfoContext(ctx, "stream monitor started without notification", "poll_interval", u.pollInterval.String())
+	}
+
+	timer := time.NewTimer(u.pollInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.InfoContext(ctx, "stream monitor stopped")
+			return nil
+		case err := <-errCh:
+			if err != nil {
+				return fmt.Errorf("notification subscriber stopped: %w", err)
+			}
+			errCh = nil
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			switch event.Type {
+			case btc.ZMQEventBlock:
+				logger.DebugContext(ctx, "block is notified", "block_hash", event.BlockHash)
+				if event.Block != nil {
+					u.rawBlocks[event.BlockHash] = event.Block
+				}
+				u.catchUp(ctx)
+				timer.Reset(u.pollInterval)
+			case btc.ZMQEventTx:
+				u.detectDeposit(event.Tx, 0)
+			case btc.ZMQEventGap:
+				logger.WarnContext(ctx, "notifications were missed, catching up", "topic", event.Topic)
+				if err := u.loadClientAddresses(ctx); err != nil {
+					logger.ErrorContext(ctx, "failed to reload client addresses", "error", err)
+				}
+				u.catchUp(ctx)
+				timer.Reset(u.pollInterval)
+			}
+		case <-timer.C:
+			logger.DebugContext(ctx, "no block is notified within poll interval, polling")
+			u.catchUp(ctx)
+			timer.Reset(u.pollInterval)
+		}
+	}
+}
+
+// loadLastHeight loads last scanned height from stream_cursor, first run starts from the tip
+func (u *streamMonitorUseCase) loadLastHeight(ctx context.Context) error {
+	position, err := u.cursorRepo.GetPosition(ctx, streamCursorName)
+	if err != nil {
+		return fmt.Errorf("failed to get last scanned block: %w", err)
+	}
+	if position != 0 {
+		u.lastHeight = int64(position)
+		// deposits in blocks scanned before stop may not be confirmed yet,
+		// deposit transaction is created once the last scanned block is confirmed
+		u.depositHeights[u.lastHeight] = struct{}{}
+		logger.InfoContext(ctx, "blocks are scanned from last scanned block", "block_height", u.lastHeight)
+		return nil
+	}
+
+	tipHeight, err := u.btcClient.GetBlockCount(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get block count: %w", err)
+	}
+	u.lastHeight = tipHeight
+	if err := u.cursorRepo.UpdatePosition(ctx, streamCursorName, uint64(tipHeight)); err != nil {
+		return fmt.Errorf("failed to save last scanned block: %w", err)
+	}
+	return nil
+}
+
+// catchUp updates status of sent transactions, scans blocks not scanned yet
+// and creates deposit transaction for confirmed deposits
+func (u *streamMonitorUseCase) catchUp(ctx context.Context) {
+	if err := u.monitor.UpdateTxStatus(ctx); err != nil {
+		logger.ErrorContext(ctx, "failed to update transaction status", "error", err)
+	}
+	if err := u.scanBlocks(ctx); err != nil {
+		logger.ErrorContext(ctx, "failed to scan blocks for deposits", "error", err)
+	}
+	if err := u.createDepositTx(ctx); err != nil {
+		logger.ErrorContext(ctx, "failed to create deposit transaction", "error", err)
+	}
+}
+
+// scanBlocks scans blocks from last scanned height to the tip for deposits
+func (u *streamMonitorUseCase) scanBlocks(ctx context.Context) error {
+	defer clear(u.rawBlocks)
+
+	tipHeight, err := u.btcClient.GetBlockCount(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get block count: %w", err)
+	}
+	// best chain can be shorter right after chain reorganization
+	if tipHeight < u.lastHeight {
+		if err := u.cursorRepo.UpdatePosition(ctx, streamCursorName, uint64(tipHeight)); err != nil {
+			return fmt.Errorf("failed to save last scanned block: %w", err)
+		}
+		u.lastHeight = tipHeight
+		return nil
+	}
+
+	for height := u.lastHeight + 1; height <= tipHeight; height++ {
+		hash, err := u.btcClient.GetBlockHash(ctx, height)
+		if err != nil {
+			return fmt.Errorf("failed to get block hash: %w", err)
+		}
+		block, ok := u.rawBlocks[hash]
+		if !ok {
+			block, err = u.btcClient.GetBlock(ctx, hash)
+			if err != nil {
+				return fmt.Errorf("failed to get block: %w", err)
+			}
+		}
+		for _, tx := range block.Transactions {
+			if u.detectDeposit(tx, height) {
+				u.depositHeights[height] = struct{}{}
+			}
+		}
+		if err := u.cursorRepo.UpdatePosition(ctx, streamCursorName, uint64(height)); err != nil {
+			return fmt.Errorf("failed to save last scanned block: %w", err)
+		}
+		u.lastHeight = height
+	}
+
+	return nil
+}
+
+// createDepositTx creates deposit transaction when blocks including deposits reach confirmations
+//   - deposit transaction collects all confirmed outputs of client addresses by `listunspent`,
+//     so it's same as `create deposit` run by command or daemon
+func (u *streamMonitorUseCase) createDepositTx(ctx context.Context) error {
+	confirmed := make([]int64, 0, len(u.depositHeights))
+	for height := range u.depositHeights {
+		if u.lastHeight-height+1 >= int64(u.btcClient.ConfirmationBlock()) {
+			confirmed = append(confirmed, height)
+		}
+	}
+	if len(confirmed) == 0 {
+		return nil
+	}
+
+	output, err := u.deposit.Execute(ctx, watchusecase.CreateTransactionInput{
+		ActionType: domainTx.ActionTypeDeposit.String(),
+	})
+	if err != nil {
+		// retried on next block
+		return fmt.Errorf("failed to call deposit.Execute(): %w", err)
+	}
+	for _, height := range confirmed {
+		delete(u.depositHeights, height)
+	}
+	if output.FileName != "" {
+		logger.InfoContext(ctx, "unsigned transaction file is created",
+			"action", domainTx.ActionTypeDeposit.String(),
+			"file", output.FileName)
+	}
+	return nil
+}
+
+// detectDeposit logs outputs to client addresses and returns true if any is found,
+// blockHeight is 0 for unconfirmed transaction
+func (u *streamMonitorUseCase) detectDeposit(tx *wire.MsgTx, blockHeight int64) bool {
+	var found bool
+	for i, txOut := range tx.TxOut {
+		addr, ok := u.clientScripts[hex.EncodeToString(txOut.PkScript)]
+		if !ok {
+			continue
+		}
+		found = true
+		logger.Info("deposit is detected",
+			"txid", tx.TxHash().String(),
+			"vout", i,
+			"address", addr,
+			"amount", u.btcClient.AmountString(btcutil.Amount(txOut.Value)),
+			"confirmed", blockHeight != 0,
+			"block_height", blockHeight)
+	}
+	return found
+}
+
+// loadClientAddresses loads client addresses to match with transaction outputs
+func (u *streamMonitorUseCase) loadClientAddresses(ctx context.Context) error {
+	addrs, err := u.addrRepo.GetAllAddress(ctx, domainAccount.AccountTypeClient)
+	if err != nil {
+		return fmt.Errorf("failed to get client addresses: %w", err)
+	}
+
+	scripts := make(map[string]string, len(addrs))
+	for _, addr := range addrs {
+		decoded, err := u.btcClient.DecodeAddress(addr)
+		if err != nil {
+			logger.WarnContext(ctx, "client address can't be decoded", "address", addr, "error", err)
+			continue
+		}
+		script, err := txscript.PayToAddrScript(decoded)
+		if err != nil {
+			logger.WarnContext(ctx, "failed to create script of client address", "address", addr, "error", err)
+			continue
+		}
+		scripts[hex.EncodeToString(script)] = addr
+	}
+	u.clientScripts = scripts
+	logger.DebugContext(ctx, "client addresses are loaded", "count", len(scripts))
+
+	return nil
+}
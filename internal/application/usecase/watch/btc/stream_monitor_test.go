@@ -0,0 +1,234 @@
+package btc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+)
+
+const (
+	streamCursorHeight = 100
+	streamDepositAt    = 102
+	streamTipHeight    = 103
+)
+
+// fakeStreamBitcoiner returns blocks up to tip, block at streamDepositAt pays to deposit script
+type fakeStreamBitcoiner struct {
+	bitcoin.Bitcoiner
+	tip           atomic.Int64
+	depositScript []byte
+
+	mu      sync.Mutex
+	fetched []int64
+}
+
+func (b *fakeStreamBitcoiner) GetBlockCount(_ context.Context) (int64, error) {
+	return b.tip.Load(), nil
+}
+
+func (b *fakeStreamBitcoiner) GetBlockHash(_ context.Context, blockHeight int64) (string, error) {
+	return fmt.Sprintf("%d", blockHeight), nil
+}
+
+func (b *fakeStreamBitcoiner) GetBlock(_ context.Context, blockHash string) (*wire.MsgBlock, error) {
+	var height int64
+	if _, err := fmt.Sscanf(blockHash, "%d", &height); err != nil {
+		return nil, err
+	}
+	b.mu.Lock()
+	b.fetched = append(b.fetched, height)
+	b.mu.Unlock()
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	if height == streamDepositAt {
+		tx.AddTxOut(wire.NewTxOut(1000, b.depositScript))
+	}
+	return &wire.MsgBlock{Transactions: []*wire.MsgTx{tx}}, nil
+}
+
+func (b *fakeStreamBitcoiner) ConfirmationBlock() uint64 {
+	return 3
+}
+
+func (b *fakeStreamBitcoiner) AmountString(amt btcutil.Amount) string {
+	return amt.String()
+}
+
+func (b *fakeStreamBitcoiner) DecodeAddress(addr string) (btcutil.Address, error) {
+	return btcutil.DecodeAddress(addr, &chaincfg.RegressionNetParams)
+}
+
+func (b *fakeStreamBitcoiner) fetchedHeights() []int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]int64(nil), b.fetched...)
+}
+
+// fakeStreamNotifier moves tip forward, then sends events
+type fakeStreamNotifier struct {
+	btcClient *fakeStreamBitcoiner
+	events    chan btc.ZMQEvent
+	sent      []btc.ZMQEvent
+}
+
+func (n *fakeStreamNotifier) Start(ctx context.Context) error {
+	n.btcClient.tip.Store(streamTipHeight)
+	for _, event := range n.sent {
+		n.events <- event
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (n *fakeStreamNotifier) Events() <-chan btc.ZMQEvent {
+	return n.events
+}
+
+func (n *fakeStreamNotifier) Topics() []string {
+	return []string{"hashblock"}
+}
+
+// fakeStreamAddrRepo returns client address from importedAt-th call
+type fakeStreamAddrRepo struct {
+	watchrepo.AddressRepositorier
+	addr       string
+	importedAt int32
+	calls      atomic.Int32
+}
+
+func (r *fakeStreamAddrRepo) GetAllAddress(_ context.Context, _ domainAccount.AccountType) ([]string, error) {
+	if r.calls.Add(1) < r.importedAt {
+		return nil, nil
+	}
+	return []string{r.addr}, nil
+}
+
+// fakeStreamCursorRepo keeps position of stream_cursor
+type fakeStreamCursorRepo struct {
+	watchrepo.StreamCursorRepositorier
+	position atomic.Uint64
+}
+
+func (r *fakeStreamCursorRepo) GetPosition(_ context.Context, _ string) (uint64, error) {
+	return r.position.Load(), nil
+}
+
+func (r *fakeStreamCursorRepo) UpdatePosition(_ context.Context, _ string, position uint64) error {
+	r.position.Store(position)
+	return nil
+}
+
+type fakeStreamMonitor struct {
+	watchusecase.MonitorTransactionUseCase
+}
+
+func (m *fakeStreamMonitor) UpdateTxStatus(_ context.Context) error {
+	return nil
+}
+
+// fakeStreamDeposit counts deposit transactions
+type fakeStreamDeposit struct {
+	calls atomic.Int32
+}
+
+func (d *fakeStreamDeposit) Execute(
+	_ context.Context, _ watchusecase.CreateTransactionInput,
+) (watchusecase.CreateTransactionOutput, error) {
+	d.calls.Add(1)
+	return watchusecase.CreateTransactionOutput{}, nil
+}
+
+func TestStreamMonitorRun(t *testing.T) {
+	clientAddr, err := btcutil.NewAddressPubKeyHash(make([]byte, 20), &chaincfg.RegressionNetParams)
+	require.NoError(t, err)
+	depositScript, err := txscript.PayToAddrScript(clientAddr)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		// events is nil when notification isn't configured
+		events []btc.ZMQEvent
+		// startTip is tip when monitor starts, notifier moves it to streamTipHeight
+		startTip int64
+		// importedAt is call of GetAllAddress from which client address is returned
+		importedAt  int32
+		wantFetched []int64
+		// wantDetected is true if deposit at streamDepositAt is waiting for confirmations
+		wantDetected bool
+	}{
+		{
+			name:         "restart scans blocks from stream_cursor",
+			startTip:     streamTipHeight,
+			importedAt:   1,
+			wantFetched:  []int64{101, 102, 103},
+			wantDetected: true,
+		},
+		{
+			name:         "sequence gap reloads addresses and rescans missed blocks",
+			events:       []btc.ZMQEvent{{Type: btc.ZMQEventGap, Topic: "hashblock"}},
+			startTip:     streamCursorHeight,
+			importedAt:   2,
+			wantFetched:  []int64{101, 102, 103},
+			wantDetected: true,
+		},
+		{
+			name:        "block notification scans new blocks without reloading addresses",
+			events:      []btc.ZMQEvent{{Type: btc.ZMQEventBlock, BlockHash: "103"}},
+			startTip:    streamCursorHeight,
+			importedAt:  2,
+			wantFetched: []int64{101, 102, 103},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			btcClient := &fakeStreamBitcoiner{depositScript: depositScript}
+			btcClient.tip.Store(tt.startTip)
+			var notifier bitcoin.BlockNotifier
+			if tt.events != nil {
+				notifier = &fakeStreamNotifier{btcClient: btcClient, events: make(chan btc.ZMQEvent), sent: tt.events}
+			}
+			cursorRepo := &fakeStreamCursorRepo{}
+			cursorRepo.position.Store(streamCursorHeight)
+			deposit := &fakeStreamDeposit{}
+
+			u := NewStreamMonitorUseCase(
+				btcClient, notifier, &fakeStreamMonitor{}, deposit,
+				&fakeStreamAddrRepo{addr: clientAddr.EncodeAddress(), importedAt: tt.importedAt}, cursorRepo, time.Hour,
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error, 1)
+			go func() {
+				done <- u.Run(ctx)
+			}()
+			require.Eventually(t, func() bool {
+				return cursorRepo.position.Load() == streamTipHeight
+			}, 5*time.Second, 10*time.Millisecond)
+			cancel()
+			require.NoError(t, <-done)
+
+			assert.Equal(t, tt.wantFetched, btcClient.fetchedHeights())
+			// deposits in blocks scanned before restart are confirmed at streamTipHeight
+			assert.Equal(t, int32(1), deposit.calls.Load())
+			// deposit at streamDepositAt doesn't reach confirmations at streamTipHeight
+			_, isDetected := u.(*streamMonitorUseCase).depositHeights[streamDepositAt]
+			assert.Equal(t, tt.wantDetected, isDetected)
+		})
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 
 	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
 	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
@@ -18,11 +20,15 @@ import (
 	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
 	"github.com/hiromaily/go-crypto-wallet/pkg/serial"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type createTransactionUseCase struct {
 	ethClient       ethereum.EtherTxCreator
+	safe            ethereum.Safer
+	forwarder       ethereum.Forwarder
 	dbConn          *sql.DB
 	addrRepo        watchrepo.AddressRepositorier
 	txRepo          watchrepo.TxRepositorier
@@ -31,11 +37,16 @@ type createTransactionUseCase struct {
 	txFileRepo      file.TransactionFileRepositorier
 	depositReceiver domainAccount.AccountType
 	paymentSender   domainAccount.AccountType
+	coinTypeCode    domainCoin.CoinTypeCode
 }
 
 // NewCreateTransactionUseCase creates a new CreateTransactionUseCase
+//   - safe is nil when Safe isn't available such as ERC-20 token
+//   - forwarder is nil when client addresses are not CREATE2 forwarders
 func NewCreateTransactionUseCase(
 	ethClient ethereum.EtherTxCreator,
+	safe ethereum.Safer,
+	forwarder ethereum.Forwarder,
 	dbConn *sql.DB,
 	addrRepo watchrepo.AddressRepositorier,
 	txRepo watchrepo.TxRepositorier,
@@ -44,9 +55,12 @@ func NewCreateTransactionUseCase(
 	txFileRepo file.TransactionFileRepositorier,
 	depositReceiver domainAccount.AccountType,
 	paymentSender domainAccount.AccountType,
+	coinTypeCode domainCoin.CoinTypeCode,
 ) watchusecase.CreateTransactionUseCase {
 	return &createTransactionUseCase{
 		ethClient:       ethClient,
+		safe:            safe,
+		forwarder:       forwarder,
 		dbConn:          dbConn,
 		addrRepo:        addrRepo,
 		txRepo:          txRepo,
@@ -55,13 +69,17 @@ func NewCreateTransactionUseCase(
 		txFileRepo:      txFileRepo,
 		depositReceiver: depositReceiver,
 		paymentSender:   paymentSender,
+		coinTypeCode:    coinTypeCode,
 	}
 }
 
 func (u *createTransactionUseCase) Execute(
 	ctx context.Context,
 	input watchusecase.CreateTransactionInput,
-) (watchusecase.CreateTransactionOutput, error) {
+) (_ watchusecase.CreateTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.eth.CreateTransaction.Execute")
+	defer tracer.End(span, &err)
+
 	// Convert action type string to domain type
 	actionType := domainTx.ActionType(input.ActionType)
 	if !domainTx.ValidateActionType(input.ActionType) {
@@ -98,30 +116,30 @@ func (u *createTransactionUseCase) createDepositTx(ctx context.Context) (string,
 	sender := domainAccount.AccountTypeClient
 	receiver := u.depositReceiver
 	targetAction := domainTx.ActionTypeDeposit
-	logger.Debug("account",
+	logger.DebugContext(ctx, "account",
 		"sender", sender.String(),
 		"receiver", receiver.String(),
 	)
 
-	userAmounts, err := u.getUserAmounts(ctx, sender)
-	if err != nil {
-		return "", err
+	var serializedTxs []string
+	var txDetailItems []*models.EthDetailTX
+	var err error
+	if u.forwarder != nil {
+		// forwarders of client account are swept by one transaction
+		serializedTxs, txDetailItems, err = u.createSweepRawTransactions(ctx, sender, receiver)
+	} else {
+		serializedTxs, txDetailItems, err = u.createDepositRawTransactions(ctx, sender, receiver)
 	}
-	if len(userAmounts) == 0 {
-		logger.Info("no data")
-		return "", nil
-	}
-
-	serializedTxs, txDetailItems, err := u.createDepositRawTransactions(ctx, sender, receiver, userAmounts)
 	if err != nil {
 		return "", err
 	}
 	if len(txDetailItems) == 0 {
+		logger.InfoContext(ctx, "no data")
 		return "", nil
 	}
 
-	txID, err := u.updateDB(targetAction, txDetailItems, nil)
-	logger.Debug("update result",
+	txID, err := u.updateDB(ctx, targetAction, txDetailItems, nil)
+	logger.DebugContext(ctx, "update result",
 		"txID", txID,
 		"error", err,
 	)
@@ -132,7 +150,7 @@ func (u *createTransactionUseCase) createDepositTx(ctx context.Context) (string,
 	// save transaction result to file
 	var generatedFileName string
 	if len(serializedTxs) != 0 {
-		generatedFileName, err = u.generateHexFile(targetAction, sender, txID, serializedTxs)
+		generatedFileName, err = u.generateHexFile(ctx, targetAction, sender, txID, serializedTxs)
 		if err != nil {
 			return "", fmt.Errorf("fail to call generateHexFile(): %w", err)
 		}
@@ -148,26 +166,26 @@ func (u *createTransactionUseCase) createPaymentTx(ctx context.Context) (string,
 	sender := u.paymentSender
 	receiver := domainAccount.AccountTypeAnonymous
 	targetAction := domainTx.ActionTypePayment
-	logger.Debug("account",
+	logger.DebugContext(ctx, "account",
 		"sender", sender.String(),
 		"receiver", receiver.String(),
 	)
 
 	// get payment data from payment_request
-	userPayments, totalAmount, paymentRequestIds, err := u.createUserPayment()
+	userPayments, totalAmount, paymentRequestIds, err := u.createUserPayment(ctx)
 	if err != nil {
 		return "", err
 	}
 	if len(userPayments) == 0 {
-		logger.Debug("no data in userPayments")
+		logger.DebugContext(ctx, "no data in userPayments")
 		// no data
 		return "", nil
 	}
 
 	// get sender address
-	senderAddr, err := u.addrRepo.GetOneUnAllocated(sender)
+	senderAddr, err := u.getAddress(ctx, sender)
 	if err != nil {
-		return "", fmt.Errorf("fail to call addrRepo.GetAll(domainAccount.AccountTypeClient): %w", err)
+		return "", fmt.Errorf("fail to call getAddress(%s): %w", sender.String(), err)
 	}
 	err = u.validateAmount(ctx, senderAddr, totalAmount)
 	if err != nil {
@@ -183,7 +201,7 @@ func (u *createTransactionUseCase) createPaymentTx(ctx context.Context) (string,
 		return "", nil
 	}
 
-	txID, err := u.updateDB(targetAction, txDetailItems, paymentRequestIds)
+	txID, err := u.updateDB(ctx, targetAction, txDetailItems, paymentRequestIds)
 	if err != nil {
 		return "", err
 	}
@@ -191,7 +209,7 @@ func (u *createTransactionUseCase) createPaymentTx(ctx context.Context) (string,
 	// save transaction result to file
 	var generatedFileName string
 	if len(serializedTxs) != 0 {
-		generatedFileName, err = u.generateHexFile(targetAction, sender, txID, serializedTxs)
+		generatedFileName, err = u.generateHexFile(ctx, targetAction, sender, txID, serializedTxs)
 		if err != nil {
 			return "", fmt.Errorf("fail to call generateHexFile(): %w", err)
 		}
@@ -220,11 +238,11 @@ func (u *createTransactionUseCase) createTransferTx(
 	}
 
 	// check sender's balance
-	senderAddr, err := u.addrRepo.GetOneUnAllocated(sender)
+	senderAddr, err := u.getAddress(ctx, sender)
 	if err != nil {
-		return "", fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(sender): %w", err)
+		return "", fmt.Errorf("fail to call getAddress(sender): %w", err)
 	}
-	senderBalance, err := u.ethClient.GetBalance(ctx, senderAddr.WalletAddress, eth.QuantityTagLatest)
+	senderBalance, err := u.ethClient.GetBalance(ctx, senderAddr, eth.QuantityTagLatest)
 	if err != nil {
 		return "", fmt.Errorf("fail to call eth.GetBalance(sender): %w", err)
 	}
@@ -237,29 +255,29 @@ func (u *createTransactionUseCase) createTransferTx(
 	if floatValue != 0 && (senderBalance.Uint64() <= requiredValue.Uint64()) {
 		return "", errors.New("sender balance is insufficient to send")
 	}
-	logger.Debug("amount",
+	logger.DebugContext(ctx, "amount",
 		"floatValue(Ether)", floatValue,
 		"requiredValue(Ether)", requiredValue.Uint64(),
 		"senderBalance", senderBalance.Uint64(),
 	)
 
 	// get receiver address
-	receiverAddr, err := u.addrRepo.GetOneUnAllocated(receiver)
+	receiverAddr, err := u.getAddress(ctx, receiver)
 	if err != nil {
-		return "", fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(receiver): %w", err)
+		return "", fmt.Errorf("fail to call getAddress(receiver): %w", err)
 	}
 
 	// call CreateRawTransaction
-	rawTx, txDetailItem, err := u.ethClient.CreateRawTransaction(ctx,
-		senderAddr.WalletAddress, receiverAddr.WalletAddress, requiredValue.Uint64(), 0)
+	rawTx, txDetailItem, err := u.createRawTransaction(ctx,
+		sender, senderAddr, receiverAddr, requiredValue.Uint64(), 0)
 	if err != nil {
 		return "", fmt.Errorf(
-			"fail to call eth.CreateRawTransaction(), sender address: %s: %w",
-			senderAddr.WalletAddress, err)
+			"fail to call createRawTransaction(), sender address: %s: %w",
+			senderAddr, err)
 	}
 
 	rawTxHex := rawTx.TxHex
-	logger.Debug("rawTxHex", "rawTxHex", rawTxHex)
+	logger.DebugContext(ctx, "rawTxHex", "rawTxHex", rawTxHex)
 
 	serializedTx, err := serial.EncodeToString(rawTx)
 	if err != nil {
@@ -272,7 +290,7 @@ func (u *createTransactionUseCase) createTransferTx(
 	txDetailItem.ReceiverAccount = receiver.String()
 	txDetailItems := []*models.EthDetailTX{txDetailItem}
 
-	txID, err := u.updateDB(targetAction, txDetailItems, nil)
+	txID, err := u.updateDB(ctx, targetAction, txDetailItems, nil)
 	if err != nil {
 		return "", err
 	}
@@ -280,7 +298,7 @@ func (u *createTransactionUseCase) createTransferTx(
 	// save transaction result to file
 	var generatedFileName string
 	if len(serializedTxs) != 0 {
-		generatedFileName, err = u.generateHexFile(targetAction, sender, txID, serializedTxs)
+		generatedFileName, err = u.generateHexFile(ctx, targetAction, sender, txID, serializedTxs)
 		if err != nil {
 			return "", fmt.Errorf("fail to call generateHexFile(): %w", err)
 		}
@@ -302,7 +320,7 @@ func (u *createTransactionUseCase) getUserAmounts(
 	sender domainAccount.AccountType,
 ) ([]eth.UserAmount, error) {
 	// get addresses for client account
-	addrs, err := u.addrRepo.GetAll(sender)
+	addrs, err := u.addrRepo.GetAll(ctx, sender)
 	if err != nil {
 		return nil, fmt.Errorf("fail to call addrRepo.GetAll(domainAccount.AccountTypeClient): %w", err)
 	}
@@ -316,7 +334,7 @@ func (u *createTransactionUseCase) getUserAmounts(
 		var balance *big.Int
 		balance, err = u.ethClient.GetBalance(ctx, addr.WalletAddress, eth.QuantityTagLatest)
 		if err != nil {
-			logger.Warn("fail to call .GetBalance()",
+			logger.WarnContext(ctx, "fail to call .GetBalance()",
 				"address", addr.WalletAddress,
 				"error", err,
 			)
@@ -331,14 +349,19 @@ func (u *createTransactionUseCase) getUserAmounts(
 func (u *createTransactionUseCase) createDepositRawTransactions(
 	ctx context.Context,
 	sender, receiver domainAccount.AccountType,
-	userAmounts []eth.UserAmount,
 ) ([]string, []*models.EthDetailTX, error) {
+	userAmounts, err := u.getUserAmounts(ctx, sender)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(userAmounts) == 0 {
+		return nil, nil, nil
+	}
+
 	// get address for deposit account
-	depositAddr, err := u.addrRepo.GetOneUnAllocated(receiver)
+	depositAddr, err := u.getAddress(ctx, receiver)
 	if err != nil {
-		return nil, nil, fmt.Errorf(
-			"fail to call addrRepo.GetOneUnAllocated(domainAccount.AccountTypeDeposit): %w", err,
-		)
+		return nil, nil, fmt.Errorf("fail to call getAddress(%s): %w", receiver.String(), err)
 	}
 
 	// create raw transaction each address
@@ -349,7 +372,7 @@ func (u *createTransactionUseCase) createDepositRawTransactions(
 		var rawTx *ethtx.RawTx
 		var txDetailItem *models.EthDetailTX
 		rawTx, txDetailItem, err = u.ethClient.CreateRawTransaction(
-			ctx, val.Address, depositAddr.WalletAddress, 0, 0)
+			ctx, val.Address, depositAddr, 0, 0)
 		if err != nil {
 			return nil, nil, fmt.Errorf(
 				"fail to call addrRepo.CreateRawTransaction(), sender address: %s: %w",
@@ -357,7 +380,7 @@ func (u *createTransactionUseCase) createDepositRawTransactions(
 		}
 
 		rawTxHex := rawTx.TxHex
-		logger.Debug("rawTxHex", "rawTxHex", rawTxHex)
+		logger.DebugContext(ctx, "rawTxHex", "rawTxHex", rawTxHex)
 
 		var serializedTx string
 		serializedTx, err = serial.EncodeToString(rawTx)
@@ -374,14 +397,90 @@ func (u *createTransactionUseCase) createDepositRawTransactions(
 	return serializedTxs, txDetailItems, nil
 }
 
-func (u *createTransactionUseCase) createUserPayment() ([]userPayment, *big.Int, []int64, error) {
+// createSweepRawTransactions creates a transaction which sweeps forwarders having coin or token
+//   - salt of forwarder is index of client address which is generated by GenerateForwarderAddressUseCase
+//   - key of receiver account sends transaction and pays fee, it is signed on keygen wallet
+func (u *createTransactionUseCase) createSweepRawTransactions(
+	ctx context.Context,
+	sender, receiver domainAccount.AccountType,
+) ([]string, []*models.EthDetailTX, error) {
+	addrs, err := u.addrRepo.GetAll(ctx, sender)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call addrRepo.GetAll(%s): %w", sender.String(), err)
+	}
+	saltByAddr := make(map[string]uint64, len(addrs))
+	for salt := range uint64(len(addrs)) {
+		saltByAddr[u.forwarder.Address(salt)] = salt
+	}
+
+	var salts []uint64
+	for _, addr := range addrs {
+		salt, ok := saltByAddr[addr.WalletAddress]
+		if !ok {
+			logger.WarnContext(ctx, "address is not forwarder of factory",
+				"address", addr.WalletAddress,
+				"factory", u.forwarder.Factory(),
+			)
+			continue
+		}
+		var hasBalance bool
+		hasBalance, err = u.forwarder.HasBalance(ctx, addr.WalletAddress)
+		if err != nil {
+			logger.WarnContext(ctx, "fail to call forwarder.HasBalance()",
+				"address", addr.WalletAddress,
+				"error", err,
+			)
+		} else if hasBalance {
+			salts = append(salts, salt)
+		}
+	}
+	if len(salts) == 0 {
+		return nil, nil, nil
+	}
+
+	// coin and tokens must be swept to deposit account
+	depositAddr, err := u.getAddress(ctx, receiver)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call getAddress(%s): %w", receiver.String(), err)
+	}
+	destination, err := u.forwarder.Destination(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call forwarder.Destination(): %w", err)
+	}
+	if !strings.EqualFold(destination, depositAddr) {
+		return nil, nil, fmt.Errorf("destination %s of factory is not %s address %s",
+			destination, receiver.String(), depositAddr)
+	}
+	feePayer, err := u.addrRepo.GetOneUnAllocated(ctx, receiver)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(): %w", err)
+	}
+
+	rawTx, txDetailItem, err := u.forwarder.CreateSweepTransaction(ctx, feePayer.WalletAddress, salts, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call forwarder.CreateSweepTransaction(): %w", err)
+	}
+	logger.DebugContext(ctx, "rawTxHex", "rawTxHex", rawTx.TxHex)
+
+	serializedTx, err := serial.EncodeToString(rawTx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call serial.EncodeToString(rawTx): %w", err)
+	}
+
+	// create insert data for　eth_detail_tx
+	txDetailItem.SenderAccount = sender.String()
+	txDetailItem.ReceiverAccount = receiver.String()
+	return []string{serializedTx}, []*models.EthDetailTX{txDetailItem}, nil
+}
+
+func (u *createTransactionUseCase) createUserPayment(ctx context.Context) ([]userPayment, *big.Int, []int64, error) {
 	// get payment_request
-	paymentRequests, err := u.payReqRepo.GetAll()
+	paymentRequests, err := u.payReqRepo.GetAll(ctx)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("fail to call repo.GetPaymentRequestAll(): %w", err)
 	}
 	if len(paymentRequests) == 0 {
-		logger.Debug("no data in payment_request")
+		logger.DebugContext(ctx, "no data in payment_request")
 		return nil, nil, nil, nil
 	}
 
@@ -399,7 +498,7 @@ func (u *createTransactionUseCase) createUserPayment() ([]userPayment, *big.Int,
 		amt, err = strconv.ParseFloat(val.Amount.String(), 64)
 		if err != nil {
 			// fatal error because table includes invalid data
-			logger.Error("payment_request table includes invalid amount field")
+			logger.ErrorContext(ctx, "payment_request table includes invalid amount field")
 			return nil, nil, nil, errors.New("payment_request table includes invalid amount field")
 		}
 		userPayments[idx].floatAmount = amt
@@ -407,7 +506,7 @@ func (u *createTransactionUseCase) createUserPayment() ([]userPayment, *big.Int,
 		// validate address
 		if err = u.ethClient.ValidateAddr(userPayments[idx].receiverAddr); err != nil {
 			// fatal error
-			logger.Error("fail to call ValidationAddr",
+			logger.ErrorContext(ctx, "fail to call ValidationAddr",
 				"address", userPayments[idx].receiverAddr,
 				"error", err,
 			)
@@ -424,11 +523,11 @@ func (u *createTransactionUseCase) createUserPayment() ([]userPayment, *big.Int,
 
 func (u *createTransactionUseCase) validateAmount(
 	ctx context.Context,
-	senderAddr *models.Address,
+	senderAddr string,
 	totalAmount *big.Int,
 ) error {
 	// check sender's total balance
-	senderBalance, err := u.ethClient.GetBalance(ctx, senderAddr.WalletAddress, eth.QuantityTagPending)
+	senderBalance, err := u.ethClient.GetBalance(ctx, senderAddr, eth.QuantityTagPending)
 	if err != nil {
 		return fmt.Errorf("fail to call eth.GetBalance(): %w", err)
 	}
@@ -443,24 +542,24 @@ func (u *createTransactionUseCase) createPaymentRawTransactions(
 	ctx context.Context,
 	sender, receiver domainAccount.AccountType,
 	userPayments []userPayment,
-	senderAddr *models.Address,
+	senderAddr string,
 ) ([]string, []*models.EthDetailTX, error) {
 	serializedTxs := make([]string, 0, len(userPayments))
 	txDetailItems := make([]*models.EthDetailTX, 0, len(userPayments))
 	additionalNonce := 0
 	for _, userPayment := range userPayments {
 		// call CreateRawTransaction
-		rawTx, txDetailItem, err := u.ethClient.CreateRawTransaction(ctx,
-			senderAddr.WalletAddress, userPayment.receiverAddr, userPayment.amount.Uint64(), additionalNonce)
+		rawTx, txDetailItem, err := u.createRawTransaction(ctx,
+			sender, senderAddr, userPayment.receiverAddr, userPayment.amount.Uint64(), additionalNonce)
 		if err != nil {
 			return nil, nil, fmt.Errorf(
-				"fail to call addrRepo.CreateRawTransaction(), sender address: %s: %w",
-				senderAddr.WalletAddress, err)
+				"fail to call createRawTransaction(), sender address: %s: %w",
+				senderAddr, err)
 		}
 		additionalNonce++
 
 		rawTxHex := rawTx.TxHex
-		logger.Debug("rawTxHex", "rawTxHex", rawTxHex)
+		logger.DebugContext(ctx, "rawTxHex", "rawTxHex", rawTxHex)
 
 		serializedTx, err := serial.EncodeToString(rawTx)
 		if err != nil {
@@ -476,8 +575,32 @@ func (u *createTransactionUseCase) createPaymentRawTransactions(
 	return serializedTxs, txDetailItems, nil
 }
 
+// getAddress returns address of account, Safe address is used if account is held by Safe
+func (u *createTransactionUseCase) getAddress(ctx context.Context, accountType domainAccount.AccountType) (string, error) {
+	if u.safe != nil {
+		if safeAddr := u.safe.Address(accountType); safeAddr != "" {
+			return safeAddr, nil
+		}
+	}
+	addr, err := u.addrRepo.GetOneUnAllocated(ctx, accountType)
+	if err != nil {
+		return "", fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(): %w", err)
+	}
+	return addr.WalletAddress, nil
+}
+
+// createRawTransaction creates Safe transaction which is signed by owners if sender is held by Safe
+func (u *createTransactionUseCase) createRawTransaction(
+	ctx context.Context, sender domainAccount.AccountType, fromAddr, toAddr string, amount uint64, additionalNonce int,
+) (*ethtx.RawTx, *models.EthDetailTX, error) {
+	if u.safe != nil && u.safe.Address(sender) != "" {
+		return u.safe.CreateTransaction(ctx, fromAddr, toAddr, amount, additionalNonce)
+	}
+	return u.ethClient.CreateRawTransaction(ctx, fromAddr, toAddr, amount, additionalNonce)
+}
+
 func (u *createTransactionUseCase) updateDB(
-	targetAction domainTx.ActionType,
+	ctx context.Context, targetAction domainTx.ActionType,
 	txDetailItems []*models.EthDetailTX,
 	paymentRequestIds []int64,
 ) (int64, error) {
@@ -495,7 +618,7 @@ func (u *createTransactionUseCase) updateDB(
 	}()
 
 	// Insert eth_tx
-	txID, err := u.txRepo.InsertUnsignedTx(targetAction)
+	txID, err := u.txRepo.InsertUnsignedTx(ctx, targetAction)
 	if err != nil {
 		return 0, fmt.Errorf("fail to call txRepo.InsertUnsignedTx(): %w", err)
 	}
@@ -503,29 +626,34 @@ func (u *createTransactionUseCase) updateDB(
 	for idx := range txDetailItems {
 		txDetailItems[idx].TXID = txID
 	}
-	if err = u.txDetailRepo.InsertBulk(txDetailItems); err != nil {
+	if err = u.txDetailRepo.InsertBulk(ctx, txDetailItems); err != nil {
 		return 0, fmt.Errorf("fail to call txDetailRepo.InsertBulk(): %w", err)
 	}
 
 	if targetAction == domainTx.ActionTypePayment {
-		_, err = u.payReqRepo.UpdatePaymentID(txID, paymentRequestIds)
+		_, err = u.payReqRepo.UpdatePaymentID(ctx, txID, paymentRequestIds)
 		if err != nil {
 			return 0, fmt.Errorf("fail to call repo.PayReq().UpdatePaymentID(txID, paymentRequestIds): %w", err)
 		}
 	}
+	metrics.IncTx(u.coinTypeCode.String(), targetAction.String(), metrics.TxStatusCreated)
 	return txID, nil
 }
 
 // generateHexFile generates file for hex txID and encoded previous addresses
 func (u *createTransactionUseCase) generateHexFile(
-	actionType domainTx.ActionType, senderAccount domainAccount.AccountType, txID int64, serializedTxs []string,
+	ctx context.Context,
+	actionType domainTx.ActionType,
+	senderAccount domainAccount.AccountType,
+	txID int64,
+	serializedTxs []string,
 ) (string, error) {
 	// add senderAccount to first line
 	serializedTxs = append([]string{senderAccount.String()}, serializedTxs...)
 
 	// create file
 	path := u.txFileRepo.CreateFilePath(actionType, domainTx.TxTypeUnsigned, txID, 0)
-	generatedFileName, err := u.txFileRepo.WriteFileSlice(path, serializedTxs)
+	generatedFileName, err := u.txFileRepo.WriteFileSlice(ctx, path, serializedTxs)
 	if err != nil {
 		return "", fmt.Errorf("fail to call txFileRepo.WriteFile(): %w", err)
 	}
@@ -0,0 +1,81 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type generateForwarderAddressUseCase struct {
+	forwarder    ethereum.Forwarder
+	addrRepo     watchrepo.AddressRepositorier
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewGenerateForwarderAddressUseCase creates a new GenerateForwarderAddressUseCase
+//   - salt of forwarder is index of client address, so keygen wallet isn't needed for client account
+func NewGenerateForwarderAddressUseCase(
+	forwarder ethereum.Forwarder,
+	addrRepo watchrepo.AddressRepositorier,
+	coinTypeCode domainCoin.CoinTypeCode,
+) watchusecase.GenerateForwarderAddressUseCase {
+	return &generateForwarderAddressUseCase{
+		forwarder:    forwarder,
+		addrRepo:     addrRepo,
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+func (u *generateForwarderAddressUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.GenerateForwarderAddressInput,
+) (_ watchusecase.GenerateForwarderAddressOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.eth.GenerateForwarderAddress.Execute")
+	defer tracer.End(span, &err)
+
+	if u.forwarder.Factory() == "" {
+		return watchusecase.GenerateForwarderAddressOutput{}, errors.New(
+			"factory of ethereum.forwarder is required in toml file")
+	}
+	if input.Count == 0 {
+		return watchusecase.GenerateForwarderAddressOutput{}, errors.New("count is required")
+	}
+
+	// next salt is the number of client addresses
+	addrs, err := u.addrRepo.GetAll(ctx, domainAccount.AccountTypeClient)
+	if err != nil {
+		return watchusecase.GenerateForwarderAddressOutput{}, fmt.Errorf("fail to call addrRepo.GetAll(): %w", err)
+	}
+	startSalt := uint64(len(addrs))
+
+	addresses := make([]string, 0, input.Count)
+	items := make([]*models.Address, 0, input.Count)
+	for i := range uint64(input.Count) {
+		addr := u.forwarder.Address(startSalt + i)
+		addresses = append(addresses, addr)
+		items = append(items, &models.Address{
+			Coin:          u.coinTypeCode.String(),
+			Account:       domainAccount.AccountTypeClient.String(),
+			WalletAddress: addr,
+		})
+	}
+	if err = u.addrRepo.InsertBulk(ctx, items); err != nil {
+		return watchusecase.GenerateForwarderAddressOutput{}, fmt.Errorf("fail to call addrRepo.InsertBulk(): %w", err)
+	}
+	logger.DebugContext(ctx, "forwarder addresses are generated",
+		"factory", u.forwarder.Factory(),
+		"start_salt", startSalt,
+		"count", input.Count,
+	)
+
+	return watchusecase.GenerateForwarderAddressOutput{Addresses: addresses}, nil
+}
@@ -3,6 +3,9 @@ package eth
 import (
 	"context"
 	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/params"
 
 	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
@@ -10,6 +13,8 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum"
 	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type monitorTransactionUseCase struct {
@@ -34,9 +39,12 @@ func NewMonitorTransactionUseCase(
 	}
 }
 
-func (u *monitorTransactionUseCase) UpdateTxStatus(ctx context.Context) error {
+func (u *monitorTransactionUseCase) UpdateTxStatus(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.eth.MonitorTransaction.UpdateTxStatus")
+	defer tracer.End(span, &err)
+
 	// update tx_type for TxTypeSent
-	err := u.updateStatusTxTypeSent(ctx)
+	err = u.updateStatusTxTypeSent(ctx)
 	if err != nil {
 		return fmt.Errorf("fail to call updateStatusTxTypeSent(): %w", err)
 	}
@@ -55,7 +63,10 @@ func (u *monitorTransactionUseCase) UpdateTxStatus(ctx context.Context) error {
 func (u *monitorTransactionUseCase) MonitorBalance(
 	ctx context.Context,
 	input watchusecase.MonitorBalanceInput,
-) error {
+) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.eth.MonitorTransaction.MonitorBalance")
+	defer tracer.End(span, &err)
+
 	targetAccounts := []domainAccount.AccountType{
 		domainAccount.AccountTypeClient,
 		domainAccount.AccountTypeDeposit,
@@ -64,12 +75,14 @@ func (u *monitorTransactionUseCase) MonitorBalance(
 	}
 
 	for _, acnt := range targetAccounts {
-		addrs, err := u.addrRepo.GetAllAddress(acnt)
+		addrs, err := u.addrRepo.GetAllAddress(ctx, acnt)
 		if err != nil {
 			return fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
 		}
 		total, _ := u.ethClient.GetTotalBalance(ctx, addrs)
-		logger.Info("total balance",
+		ether, _ := new(big.Float).Quo(new(big.Float).SetInt(total), big.NewFloat(params.Ether)).Float64()
+		metrics.SetBalance(u.ethClient.CoinTypeCode().String(), acnt.String(), ether)
+		logger.InfoContext(ctx, "total balance",
 			"account", acnt.String(),
 			"balance", total.Uint64())
 	}
@@ -80,7 +93,7 @@ func (u *monitorTransactionUseCase) MonitorBalance(
 // update TxTypeSent to TxTypeDone if confirmation is 6 or more
 func (u *monitorTransactionUseCase) updateStatusTxTypeSent(ctx context.Context) error {
 	// get records whose status is TxTypeSent
-	hashes, err := u.txDetailRepo.GetSentHashTx(domainTx.TxTypeSent)
+	hashes, err := u.txDetailRepo.GetSentHashTx(ctx, domainTx.TxTypeSent)
 	if err != nil {
 		return fmt.Errorf("fail to call txDetailRepo.GetSentHashTx(TxTypeSent): %w", err)
 	}
@@ -93,19 +106,34 @@ func (u *monitorTransactionUseCase) updateStatusTxTypeSent(ctx context.Context)
 		if err != nil {
 			return fmt.Errorf("fail to call eth.GetConfirmation() sentHash: %s: %w", sentHash, err)
 		}
-		logger.Info("confirmation",
+		logger.InfoContext(ctx, "confirmation",
 			"sentHash", sentHash,
 			"confirmation num", confirmNum)
 		if confirmNum < u.confirmNum {
 			continue
 		}
 		// update status
-		_, err = u.txDetailRepo.UpdateTxTypeBySentHashTx(domainTx.TxTypeDone, sentHash)
+		_, err = u.txDetailRepo.UpdateTxTypeBySentHashTx(ctx, domainTx.TxTypeDone, sentHash)
 		if err != nil {
-			logger.Warn("failed to call txDetailRepo.UpdateTxTypeBySentHashTx()",
+			logger.WarnContext(ctx, "failed to call txDetailRepo.UpdateTxTypeBySentHashTx()",
 				"error", err,
 			)
+			continue
 		}
+		u.incConfirmedTx(ctx, sentHash)
 	}
 	return nil
 }
+
+// incConfirmedTx counts confirmed transaction by action of tx which it belongs to
+func (u *monitorTransactionUseCase) incConfirmedTx(ctx context.Context, sentHash string) {
+	action, err := u.txDetailRepo.GetActionBySentHashTx(ctx, sentHash)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to call txDetailRepo.GetActionBySentHashTx()",
+			"hash", sentHash,
+			"error", err,
+		)
+		return
+	}
+	metrics.IncTx(u.ethClient.CoinTypeCode().String(), action.String(), metrics.TxStatusConfirmed)
+}
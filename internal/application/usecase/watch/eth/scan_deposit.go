@@ -0,0 +1,247 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// defaultScanBlockRange is number of blocks which are scanned at once if it isn't configured
+const defaultScanBlockRange = 500
+
+type scanDepositUseCase struct {
+	scanners    []ethereum.DepositScanner
+	depositRepo watchrepo.EthDepositRepositorier
+	addrRepo    watchrepo.AddressRepositorier
+	cursorRepo  watchrepo.StreamCursorRepositorier
+	blockRange  uint64
+}
+
+// NewScanDepositUseCase creates a new ScanDepositUseCase
+//   - each scanner has own cursor, e.g. native coin by blocks and ERC-20 tokens by event logs
+//   - blockRange is number of blocks which are scanned at once, node may limit it
+func NewScanDepositUseCase(
+	scanners []ethereum.DepositScanner,
+	depositRepo watchrepo.EthDepositRepositorier,
+	addrRepo watchrepo.AddressRepositorier,
+	cursorRepo watchrepo.StreamCursorRepositorier,
+	blockRange uint64,
+) watchusecase.ScanDepositUseCase {
+	if blockRange == 0 {
+		blockRange = defaultScanBlockRange
+	}
+	return &scanDepositUseCase{
+		scanners:    scanners,
+		depositRepo: depositRepo,
+		addrRepo:    addrRepo,
+		cursorRepo:  cursorRepo,
+		blockRange:  blockRange,
+	}
+}
+
+// Execute scans blocks after cursor of each scanner up to latest block,
+// then confirms deposits which reach confirmation depth
+// - scanning starts from latest block at first run, so deposits before that are not detected
+// - block hash of cursor and deposits are compared with canonical chain to detect reorg,
+// then unconfirmed deposits from reorganized block are deleted and those blocks are scanned again
+func (u *scanDepositUseCase) Execute(ctx context.Context, input watchusecase.ScanDepositInput) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.eth.ScanDeposit.Execute")
+	defer tracer.End(span, &err)
+
+	addrs, err := u.addrRepo.GetAllAddress(ctx, domainAccount.AccountTypeClient)
+	if err != nil {
+		return fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+	}
+	if len(addrs) == 0 {
+		return errors.New("no client address to scan")
+	}
+
+	// failure of a scanner doesn't stop others
+	var errs []error
+	for _, scanner := range u.scanners {
+		if err := u.execute(ctx, scanner, addrs, input.ConfirmationNum); err != nil {
+			errs = append(errs, fmt.Errorf("scanner %s: %w", scanner.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (u *scanDepositUseCase) execute(
+	ctx context.Context, scanner ethereum.DepositScanner, addrs []string, confirmationNum uint64,
+) error {
+	latest, err := scanner.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	position, err := u.loadCursor(ctx, scanner, latest, confirmationNum)
+	if err != nil {
+		return err
+	}
+
+	for from := position + 1; from <= latest; from += u.blockRange {
+		to := min(from+u.blockRange-1, latest)
+		if err = u.scan(ctx, scanner, from, to, addrs); err != nil {
+			return err
+		}
+	}
+
+	return u.confirm(ctx, scanner, latest, confirmationNum)
+}
+
+// loadCursor returns last processed block, cursor is rewound when the block is reorganized
+func (u *scanDepositUseCase) loadCursor(
+	ctx context.Context, scanner ethereum.DepositScanner, latest, confirmationNum uint64,
+) (uint64, error) {
+	position, blockHash, err := u.cursorRepo.GetBlock(ctx, scanner.Name())
+	if err != nil {
+		return 0, fmt.Errorf("fail to call cursorRepo.GetBlock(): %w", err)
+	}
+	if position == 0 {
+		if latest == 0 {
+			return 0, nil
+		}
+		return latest - 1, nil
+	}
+	if position > latest {
+		// node may be behind of node which scanned last time
+		logger.WarnContext(ctx, "last processed block is ahead of latest block",
+			"scanner", scanner.Name(),
+			"position", position,
+			"latest", latest)
+		return latest, nil
+	}
+
+	hash, err := scanner.BlockHash(ctx, position)
+	if err != nil {
+		return 0, err
+	}
+	if hash == blockHash {
+		return position, nil
+	}
+
+	// fork point is unknown, blocks within confirmation depth are scanned again
+	depth := max(confirmationNum, 1)
+	from := uint64(1)
+	if position > depth {
+		from = position - depth + 1
+	}
+	logger.WarnContext(ctx, "last processed block is reorganized",
+		"scanner", scanner.Name(),
+		"position", position,
+		"stored_hash", blockHash,
+		"canonical_hash", hash,
+		"rescan_from", from)
+	return u.rewind(ctx, scanner, from)
+}
+
+// scan stores deposits between from and to, then moves cursor to block of to
+func (u *scanDepositUseCase) scan(
+	ctx context.Context, scanner ethereum.DepositScanner, from, to uint64, addrs []string,
+) error {
+	deposits, err := scanner.Scan(ctx, from, to, addrs)
+	if err != nil {
+		return err
+	}
+	for _, deposit := range deposits {
+		deposit.ReceiverAccount = domainAccount.AccountTypeClient.String()
+	}
+	inserted, err := u.depositRepo.InsertBulk(ctx, deposits)
+	if err != nil {
+		return fmt.Errorf("fail to call depositRepo.InsertBulk(): %w", err)
+	}
+	if inserted != 0 {
+		logger.InfoContext(ctx, "deposits are detected",
+			"scanner", scanner.Name(),
+			"from", from,
+			"to", to,
+			"count", inserted)
+	}
+
+	hash, err := scanner.BlockHash(ctx, to)
+	if err != nil {
+		return err
+	}
+	if err = u.cursorRepo.UpdateBlock(ctx, scanner.Name(), to, hash); err != nil {
+		return fmt.Errorf("fail to call cursorRepo.UpdateBlock(): %w", err)
+	}
+	return nil
+}
+
+// confirm updates deposits to confirmed when block reaches confirmation depth and it's still canonical
+func (u *scanDepositUseCase) confirm(
+	ctx context.Context, scanner ethereum.DepositScanner, latest, confirmationNum uint64,
+) error {
+	deposits, err := u.depositRepo.GetAllUnconfirmed(ctx, scanner.TokenContracts())
+	if err != nil {
+		return fmt.Errorf("fail to call depositRepo.GetAllUnconfirmed(): %w", err)
+	}
+
+	blockHashes := make(map[uint64]string)
+	for _, deposit := range deposits {
+		// deposits are sorted by block number
+		if deposit.BlockNumber > latest || latest-deposit.BlockNumber+1 < confirmationNum {
+			break
+		}
+		hash, ok := blockHashes[deposit.BlockNumber]
+		if !ok {
+			hash, err = scanner.BlockHash(ctx, deposit.BlockNumber)
+			if err != nil {
+				return err
+			}
+			blockHashes[deposit.BlockNumber] = hash
+		}
+		if hash != deposit.BlockHash {
+			logger.WarnContext(ctx, "block of deposit is reorganized",
+				"scanner", scanner.Name(),
+				"tx_hash", deposit.TXHash,
+				"block_number", deposit.BlockNumber,
+				"stored_hash", deposit.BlockHash,
+				"canonical_hash", hash)
+			_, err = u.rewind(ctx, scanner, deposit.BlockNumber)
+			return err
+		}
+
+		if _, err = u.depositRepo.UpdateConfirmed(ctx, deposit.ID); err != nil {
+			return fmt.Errorf("fail to call depositRepo.UpdateConfirmed(): %w", err)
+		}
+		logger.InfoContext(ctx, "deposit is confirmed",
+			"tx_hash", deposit.TXHash,
+			"token_contract", deposit.TokenContract,
+			"from", deposit.SenderAddress,
+			"to", deposit.ReceiverAddress,
+			"amount", deposit.Amount,
+			"block_number", deposit.BlockNumber,
+			"block_time", deposit.BlockTime.Time)
+	}
+	return nil
+}
+
+// rewind deletes unconfirmed deposits from block and moves cursor to previous block
+func (u *scanDepositUseCase) rewind(
+	ctx context.Context, scanner ethereum.DepositScanner, fromBlock uint64,
+) (uint64, error) {
+	deleted, err := u.depositRepo.DeleteUnconfirmedFromBlock(ctx, scanner.TokenContracts(), fromBlock)
+	if err != nil {
+		return 0, fmt.Errorf("fail to call depositRepo.DeleteUnconfirmedFromBlock(): %w", err)
+	}
+	position := fromBlock - 1
+	hash, err := scanner.BlockHash(ctx, position)
+	if err != nil {
+		return 0, err
+	}
+	if err = u.cursorRepo.UpdateBlock(ctx, scanner.Name(), position, hash); err != nil {
+		return 0, fmt.Errorf("fail to call cursorRepo.UpdateBlock(): %w", err)
+	}
+	logger.InfoContext(ctx, "scanner is rewound",
+		"scanner", scanner.Name(),
+		"position", position,
+		"deleted_deposits", deleted)
+	return position, nil
+}
@@ -9,13 +9,19 @@ import (
 	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
 	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
 	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/serial"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type sendTransactionUseCase struct {
 	ethClient    ethereum.Ethereumer
+	safe         ethereum.Safer
 	txDetailRepo watchrepo.EthDetailTxRepositorier
 	txFileRepo   file.TransactionFileRepositorier
 }
@@ -23,11 +29,13 @@ type sendTransactionUseCase struct {
 // NewSendTransactionUseCase creates a new SendTransactionUseCase
 func NewSendTransactionUseCase(
 	ethClient ethereum.Ethereumer,
+	safe ethereum.Safer,
 	txDetailRepo watchrepo.EthDetailTxRepositorier,
 	txFileRepo file.TransactionFileRepositorier,
 ) watchusecase.SendTransactionUseCase {
 	return &sendTransactionUseCase{
 		ethClient:    ethClient,
+		safe:         safe,
 		txDetailRepo: txDetailRepo,
 		txFileRepo:   txFileRepo,
 	}
@@ -36,21 +44,34 @@ func NewSendTransactionUseCase(
 func (u *sendTransactionUseCase) Execute(
 	ctx context.Context,
 	input watchusecase.SendTransactionInput,
-) (watchusecase.SendTransactionOutput, error) {
+) (_ watchusecase.SendTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.eth.SendTransaction.Execute")
+	defer tracer.End(span, &err)
+
 	// Validate file path and extract transaction metadata
 	actionType, _, txID, _, err := u.txFileRepo.ValidateFilePath(input.FilePath, domainTx.TxTypeSigned)
 	if err != nil {
 		return watchusecase.SendTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.ValidateFilePath(): %w", err)
 	}
 
-	logger.Debug("send_tx", "action_type", actionType.String())
+	logger.DebugContext(ctx, "send_tx", "action_type", actionType.String())
 
 	// Read hex from file
-	data, err := u.txFileRepo.ReadFileSlice(input.FilePath)
+	data, err := u.txFileRepo.ReadFileSlice(ctx, input.FilePath)
 	if err != nil {
 		return watchusecase.SendTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.ReadFile(): %w", err)
 	}
 
+	// Safe transaction file has sender account on first line, then serialized transactions with signatures
+	if len(data) != 0 && !strings.Contains(data[0], ",") {
+		if err = u.execSafeTransactions(ctx, actionType, txID, data[1:]); err != nil {
+			return watchusecase.SendTransactionOutput{}, err
+		}
+		return watchusecase.SendTransactionOutput{
+			TxID: "",
+		}, nil
+	}
+
 	// Process each signed transaction from the file
 	for _, txHex := range data {
 		// data is csv [rawTx.TxHex, signedRawTx.TxHex]
@@ -66,44 +87,19 @@ func (u *sendTransactionUseCase) Execute(
 		var sentTx string
 		sentTx, err = u.ethClient.SendSignedRawTransaction(ctx, signedTx)
 		if err != nil {
-			logger.Warn("fail to call eth.SendSignedRawTransaction()",
+			logger.WarnContext(ctx, "fail to call eth.SendSignedRawTransaction()",
 				"error", err,
 			)
 			continue
 		}
 		if sentTx == "" {
-			logger.Warn("no sentTx by calling eth.SendSignedRawTransaction()",
+			logger.WarnContext(ctx, "no sentTx by calling eth.SendSignedRawTransaction()",
 				"error", err,
 			)
 			continue
 		}
 
-		// Update eth_detail_tx table
-		var affectedNum int64
-		affectedNum, err = u.txDetailRepo.UpdateAfterTxSent(uuid, domainTx.TxTypeSent, signedTx, sentTx)
-		if err != nil {
-			// TODO: even if error occurred, tx is already sent. so db should be corrected manually
-			logger.Warn(
-				"fail to call repo.Tx().UpdateAfterTxSent() but tx is already sent. "+
-					"So database should be updated manually",
-				"tx_id", txID,
-				"tx_type", domainTx.TxTypeSent.String(),
-				"tx_type_value", domainTx.TxTypeSent.Int8(),
-				"signed_hex_tx", signedTx,
-				"sent_hash_tx", sentTx,
-			)
-			continue
-		}
-		if affectedNum == 0 {
-			logger.Info("no records to update tx_table",
-				"tx_id", txID,
-				"tx_type", domainTx.TxTypeSent.String(),
-				"tx_type_value", domainTx.TxTypeSent.Int8(),
-				"signed_hex_tx", signedTx,
-				"sent_hash_tx", sentTx,
-			)
-			continue
-		}
+		u.updateAfterTxSent(ctx, actionType, txID, uuid, signedTx, sentTx)
 	}
 
 	// TODO: update is_allocated in account_pubkey_table
@@ -112,3 +108,67 @@ func (u *sendTransactionUseCase) Execute(
 		TxID: "",
 	}, nil
 }
+
+// execSafeTransactions sends execTransaction of Safe by executor
+//   - transactions are sent in order of Safe nonce, so later transactions fail when former one fails
+func (u *sendTransactionUseCase) execSafeTransactions(
+	ctx context.Context, actionType domainTx.ActionType, txID int64, serializedTxs []string,
+) error {
+	if u.safe == nil || u.safe.Executor() == "" {
+		return errors.New("executor of ethereum.safe is required in toml file to send Safe transaction")
+	}
+	executorKey, err := u.ethClient.GetPrivKey(u.safe.Executor(), eth.Password)
+	if err != nil {
+		return fmt.Errorf("fail to call eth.GetPrivKey(executor): %w", err)
+	}
+
+	for _, serializedTx := range serializedTxs {
+		var rawTx ethtx.RawTx
+		if err = serial.DecodeFromString(serializedTx, &rawTx); err != nil {
+			return fmt.Errorf("fail to call serial.DecodeFromString(): %w", err)
+		}
+		signedTx, sentTx, execErr := u.safe.ExecTransaction(ctx, &rawTx, executorKey.PrivateKey)
+		if execErr != nil {
+			logger.WarnContext(ctx, "fail to call safe.ExecTransaction()",
+				"uuid", rawTx.UUID,
+				"safe_tx_hash", rawTx.Hash,
+				"error", execErr,
+			)
+			continue
+		}
+		u.updateAfterTxSent(ctx, actionType, txID, rawTx.UUID, signedTx, sentTx)
+	}
+	return nil
+}
+
+// updateAfterTxSent updates eth_detail_tx table after transaction is sent
+func (u *sendTransactionUseCase) updateAfterTxSent(
+	ctx context.Context, actionType domainTx.ActionType, txID int64, uuid, signedTx, sentTx string,
+) {
+	affectedNum, err := u.txDetailRepo.UpdateAfterTxSent(ctx, uuid, domainTx.TxTypeSent, signedTx, sentTx)
+	if err != nil {
+		// TODO: even if error occurred, tx is already sent. so db should be corrected manually
+		logger.WarnContext(
+			ctx,
+			"fail to call repo.Tx().UpdateAfterTxSent() but tx is already sent. "+
+				"So database should be updated manually",
+			"tx_id", txID,
+			"tx_type", domainTx.TxTypeSent.String(),
+			"tx_type_value", domainTx.TxTypeSent.Int8(),
+			"signed_hex_tx", signedTx,
+			"sent_hash_tx", sentTx,
+		)
+		return
+	}
+	if affectedNum == 0 {
+		logger.InfoContext(ctx, "no records to update tx_table",
+			"tx_id", txID,
+			"tx_type", domainTx.TxTypeSent.String(),
+			"tx_type_value", domainTx.TxTypeSent.Int8(),
+			"signed_hex_tx", signedTx,
+			"sent_hash_tx", sentTx,
+		)
+		return
+	}
+	metrics.IncTx(u.ethClient.CoinTypeCode().String(), actionType.String(), metrics.TxStatusSent)
+}
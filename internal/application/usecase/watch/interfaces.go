@@ -2,6 +2,7 @@ package watch
 
 import (
 	"context"
+	"time"
 
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
 )
@@ -17,6 +18,17 @@ type MonitorTransactionUseCase interface {
 	MonitorBalance(ctx context.Context, input MonitorBalanceInput) error
 }
 
+// StreamMonitorUseCase monitors transactions by notifications pushed from node
+// instead of polling, it blocks until ctx is canceled
+type StreamMonitorUseCase interface {
+	Run(ctx context.Context) error
+}
+
+// ScanDepositUseCase detects deposits into client addresses by scanning blocks
+type ScanDepositUseCase interface {
+	Execute(ctx context.Context, input ScanDepositInput) error
+}
+
 // SendTransactionUseCase sends signed transactions to the network
 type SendTransactionUseCase interface {
 	Execute(ctx context.Context, input SendTransactionInput) (SendTransactionOutput, error)
@@ -27,11 +39,77 @@ type ImportAddressUseCase interface {
 	Execute(ctx context.Context, input ImportAddressInput) error
 }
 
+// GenerateForwarderAddressUseCase generates CREATE2 forwarder addresses for client account
+type GenerateForwarderAddressUseCase interface {
+	Execute(ctx context.Context, input GenerateForwarderAddressInput) (GenerateForwarderAddressOutput, error)
+}
+
+// GenerateDepositTagAddressUseCase generates X-addresses with destination tag for client account (XRP only)
+type GenerateDepositTagAddressUseCase interface {
+	Execute(ctx context.Context, input GenerateDepositTagAddressInput) (GenerateDepositTagAddressOutput, error)
+}
+
+// QuarantinedDepositUseCase lists and resolves deposits whose client is not identified (XRP only)
+type QuarantinedDepositUseCase interface {
+	List(ctx context.Context) (ListQuarantinedDepositOutput, error)
+	Resolve(ctx context.Context, input ResolveQuarantinedDepositInput) error
+}
+
+// CreateTicketUseCase creates unsigned TicketCreate transaction to reserve tickets (XRP only)
+type CreateTicketUseCase interface {
+	Execute(ctx context.Context, input CreateTicketInput) (CreateTicketOutput, error)
+}
+
+// CreateSignerListUseCase creates unsigned SignerListSet transactions to make account multisig account (XRP only)
+type CreateSignerListUseCase interface {
+	Execute(ctx context.Context, input CreateSignerListInput) (CreateSignerListOutput, error)
+}
+
+// CreateRequireDestUseCase creates unsigned AccountSet transactions to require destination tag (XRP only)
+type CreateRequireDestUseCase interface {
+	Execute(ctx context.Context, input CreateRequireDestInput) (CreateRequireDestOutput, error)
+}
+
+// CreateTrustLineUseCase creates unsigned TrustSet transactions to hold issued currency (XRP only)
+type CreateTrustLineUseCase interface {
+	Execute(ctx context.Context, input CreateTrustLineInput) (CreateTrustLineOutput, error)
+}
+
+// CreateRegularKeyUseCase creates unsigned SetRegularKey transactions for pending regular keys of keygen (XRP only)
+type CreateRegularKeyUseCase interface {
+	Execute(ctx context.Context, input CreateRegularKeyInput) (CreateRegularKeyOutput, error)
+}
+
+// CreateDisableMasterUseCase creates unsigned AccountSet transactions to disable master key (XRP only)
+type CreateDisableMasterUseCase interface {
+	Execute(ctx context.Context, input CreateDisableMasterInput) (CreateDisableMasterOutput, error)
+}
+
+// CreateAccountDeleteUseCase creates unsigned AccountDelete transaction for retired accounts (XRP only)
+type CreateAccountDeleteUseCase interface {
+	Execute(ctx context.Context, input CreateAccountDeleteInput) (CreateAccountDeleteOutput, error)
+}
+
+// CreateEscrowUseCase creates unsigned EscrowCreate transaction to lock funds of stored account (XRP only)
+type CreateEscrowUseCase interface {
+	Execute(ctx context.Context, input CreateEscrowInput) (CreateEscrowOutput, error)
+}
+
+// FinishEscrowUseCase creates unsigned EscrowFinish or EscrowCancel transactions for matured escrows (XRP only)
+type FinishEscrowUseCase interface {
+	Execute(ctx context.Context) (FinishEscrowOutput, error)
+}
+
 // CreatePaymentRequestUseCase creates payment requests
 type CreatePaymentRequestUseCase interface {
 	Execute(ctx context.Context, input CreatePaymentRequestInput) error
 }
 
+// RefreshMetricsUseCase updates metrics which are read from database
+type RefreshMetricsUseCase interface {
+	Execute(ctx context.Context) error
+}
+
 // Input/Output DTOs
 
 // CreateTransactionInput represents input for creating a transaction
@@ -55,6 +133,11 @@ type MonitorBalanceInput struct {
 	ConfirmationNum uint64
 }
 
+// ScanDepositInput represents input for scanning deposits
+type ScanDepositInput struct {
+	ConfirmationNum uint64
+}
+
 // SendTransactionInput represents input for sending a transaction
 type SendTransactionInput struct {
 	FilePath string
@@ -71,6 +154,146 @@ type ImportAddressInput struct {
 	Rescan   bool
 }
 
+// GenerateForwarderAddressInput represents input for generating forwarder addresses
+type GenerateForwarderAddressInput struct {
+	Count uint32
+}
+
+// GenerateForwarderAddressOutput represents output from generating forwarder addresses
+type GenerateForwarderAddressOutput struct {
+	Addresses []string
+}
+
+// GenerateDepositTagAddressInput represents input for generating X-addresses with destination tag
+type GenerateDepositTagAddressInput struct {
+	Count uint32
+}
+
+// GenerateDepositTagAddressOutput represents output from generating X-addresses with destination tag
+type GenerateDepositTagAddressOutput struct {
+	Addresses []string
+}
+
+// QuarantinedDeposit represents deposit whose client is not identified
+type QuarantinedDeposit struct {
+	ID          int64
+	TxHash      string
+	LedgerIndex uint64
+	Sender      string
+	// nil if destination tag is not given
+	DestinationTag *int64
+	Amount         string
+	// empty for XRP
+	Currency string
+	Issuer   string
+}
+
+// ListQuarantinedDepositOutput represents output from listing quarantined deposits
+type ListQuarantinedDepositOutput struct {
+	Deposits []QuarantinedDeposit
+}
+
+// ResolveQuarantinedDepositInput represents input for attributing quarantined deposit to client
+type ResolveQuarantinedDepositInput struct {
+	ID            int64
+	ClientAddress string
+}
+
+// CreateTicketInput represents input for creating tickets
+type CreateTicketInput struct {
+	AccountType domainAccount.AccountType
+	Count       uint32
+}
+
+// CreateTicketOutput represents output from creating tickets
+type CreateTicketOutput struct {
+	FileName string
+}
+
+// CreateSignerListInput represents input for setting signer list
+type CreateSignerListInput struct {
+	AccountType domainAccount.AccountType
+	// full-pubkey files exported by sign wallets
+	FileNames []string
+}
+
+// CreateSignerListOutput represents output from setting signer list
+type CreateSignerListOutput struct {
+	FileName string
+}
+
+// CreateRequireDestInput represents input for requiring destination tag
+type CreateRequireDestInput struct {
+	AccountType domainAccount.AccountType
+}
+
+// CreateRequireDestOutput represents output from requiring destination tag
+type CreateRequireDestOutput struct {
+	FileName string
+}
+
+// CreateTrustLineInput represents input for creating trust line
+type CreateTrustLineInput struct {
+	AccountType domainAccount.AccountType
+}
+
+// CreateTrustLineOutput represents output from creating trust line
+type CreateTrustLineOutput struct {
+	FileName string
+}
+
+// CreateRegularKeyInput represents input for setting regular key
+type CreateRegularKeyInput struct {
+	// regular key file exported by keygen wallet
+	FileName string
+}
+
+// CreateRegularKeyOutput represents output from setting regular key
+type CreateRegularKeyOutput struct {
+	FileName string
+	// regular keys found in ledger, it's imported by keygen wallet
+	ValidatedFileName string
+}
+
+// CreateDisableMasterInput represents input for disabling master key
+type CreateDisableMasterInput struct {
+	AccountType domainAccount.AccountType
+}
+
+// CreateDisableMasterOutput represents output from disabling master key
+type CreateDisableMasterOutput struct {
+	FileName string
+}
+
+// CreateAccountDeleteInput represents input for deleting accounts
+type CreateAccountDeleteInput struct {
+	Addresses []string
+}
+
+// CreateAccountDeleteOutput represents output from deleting accounts
+type CreateAccountDeleteOutput struct {
+	FileName string
+}
+
+// CreateEscrowInput represents input for creating escrow
+type CreateEscrowInput struct {
+	Amount      float64
+	FinishAfter time.Time
+	// zero means escrow never expires
+	CancelAfter time.Time
+}
+
+// CreateEscrowOutput represents output from creating escrow
+type CreateEscrowOutput struct {
+	FileName string
+}
+
+// FinishEscrowOutput represents output from finishing escrows
+type FinishEscrowOutput struct {
+	// empty if there is no matured escrow
+	FileName string
+}
+
 // CreatePaymentRequestInput represents input for creating payment requests
 type CreatePaymentRequestInput struct {
 	AmountList []float64
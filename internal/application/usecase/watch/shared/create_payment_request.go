@@ -15,6 +15,7 @@ import (
 	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
 	"github.com/hiromaily/go-crypto-wallet/pkg/converter"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type createPaymentRequestUseCase struct {
@@ -45,9 +46,14 @@ func NewCreatePaymentRequestUseCase(
 	}
 }
 
-func (u *createPaymentRequestUseCase) Execute(ctx context.Context, input watchusecase.CreatePaymentRequestInput) error {
+func (u *createPaymentRequestUseCase) Execute(
+	ctx context.Context, input watchusecase.CreatePaymentRequestInput,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.CreatePaymentRequest.Execute")
+	defer tracer.End(span, &err)
+
 	// get client pubkeys
-	pubkeyItems, err := u.addrRepo.GetAll(domainAccount.AccountTypeClient)
+	pubkeyItems, err := u.addrRepo.GetAll(ctx, domainAccount.AccountTypeClient)
 	if err != nil {
 		return fmt.Errorf("fail to call addrRepo.GetAll(): %w", err)
 	}
@@ -69,7 +75,7 @@ func (u *createPaymentRequestUseCase) Execute(ctx context.Context, input watchus
 	}()
 
 	// delete payment request
-	_, err = u.payReqRepo.DeleteAll()
+	_, err = u.payReqRepo.DeleteAll(ctx)
 	if err != nil {
 		return fmt.Errorf("fail to call payReqRepo.DeleteAll(): %w", err)
 	}
@@ -94,7 +100,7 @@ func (u *createPaymentRequestUseCase) Execute(ctx context.Context, input watchus
 		})
 		idx++
 	}
-	if err = u.payReqRepo.InsertBulk(payReqItems); err != nil {
+	if err = u.payReqRepo.InsertBulk(ctx, payReqItems); err != nil {
 		return fmt.Errorf("fail to call payReqRepo.InsertBulk(): %w", err)
 	}
 	return nil
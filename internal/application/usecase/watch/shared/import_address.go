@@ -12,6 +12,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type importAddressUseCase struct {
@@ -39,9 +40,12 @@ func NewImportAddressUseCase(
 	}
 }
 
-func (u *importAddressUseCase) Execute(ctx context.Context, input watchusecase.ImportAddressInput) error {
+func (u *importAddressUseCase) Execute(ctx context.Context, input watchusecase.ImportAddressInput) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.ImportAddress.Execute")
+	defer tracer.End(span, &err)
+
 	// read file for public key
-	pubKeys, err := u.addrFileRepo.ImportAddress(input.FileName)
+	pubKeys, err := u.addrFileRepo.ImportAddress(ctx, input.FileName)
 	if err != nil {
 		return fmt.Errorf("fail to call addrFileRepo.ImportAddress(): %w", err)
 	}
@@ -65,7 +69,7 @@ func (u *importAddressUseCase) Execute(ctx context.Context, input watchusecase.I
 	}
 
 	// insert imported pubKey
-	err = u.addrRepo.InsertBulk(pubKeyData)
+	err = u.addrRepo.InsertBulk(ctx, pubKeyData)
 	if err != nil {
 		return fmt.Errorf("fail to call addrRepo.InsertBulk(): %w", err)
 		// TODO:What if this inserting is failed, how it can be recovered to keep consistancy
@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type refreshMetricsUseCase struct {
+	addrRepo       watch.AddressRepositorier
+	payReqRepo     watch.PaymentRequestRepositorier
+	unsignedTxRepo watch.UnsignedTxRepositorier
+	coinTypeCode   domainCoin.CoinTypeCode
+}
+
+// NewRefreshMetricsUseCase creates a new RefreshMetricsUseCase for watch wallet
+func NewRefreshMetricsUseCase(
+	addrRepo watch.AddressRepositorier,
+	payReqRepo watch.PaymentRequestRepositorier,
+	unsignedTxRepo watch.UnsignedTxRepositorier,
+	coinTypeCode domainCoin.CoinTypeCode,
+) watchusecase.RefreshMetricsUseCase {
+	return &refreshMetricsUseCase{
+		addrRepo:       addrRepo,
+		payReqRepo:     payReqRepo,
+		unsignedTxRepo: unsignedTxRepo,
+		coinTypeCode:   coinTypeCode,
+	}
+}
+
+// Execute updates pending payment requests, unallocated client address pool
+// and age of the oldest unsigned transaction
+func (u *refreshMetricsUseCase) Execute(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.RefreshMetrics.Execute")
+	defer tracer.End(span, &err)
+
+	coin := u.coinTypeCode.String()
+
+	pending, err := u.payReqRepo.CountPending(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count pending payment requests: %w", err)
+	}
+	metrics.SetPendingPaymentRequests(coin, pending)
+
+	unallocated, err := u.addrRepo.CountUnAllocated(ctx, domainAccount.AccountTypeClient)
+	if err != nil {
+		return fmt.Errorf("failed to count unallocated addresses: %w", err)
+	}
+	metrics.SetUnallocatedAddresses(coin, domainAccount.AccountTypeClient.String(), unallocated)
+
+	oldest, err := u.unsignedTxRepo.GetOldestUnsignedTime(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get oldest unsigned transaction: %w", err)
+	}
+	var age time.Duration
+	if oldest.Valid {
+		age = max(time.Since(oldest.Time), 0)
+	}
+	metrics.SetOldestUnsignedTxAge(coin, age)
+
+	return nil
+}
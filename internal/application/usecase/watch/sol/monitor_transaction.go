@@ -0,0 +1,166 @@
+package sol
+
+import (
+	"context"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/solana"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/solana/sol"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// maxSignatureStatuses is max number of signatures per getSignatureStatuses request
+const maxSignatureStatuses = 256
+
+type monitorTransactionUseCase struct {
+	solClient    solana.Solanaer
+	addrRepo     watchrepo.AddressRepositorier
+	txDetailRepo watchrepo.SolDetailTxRepositorier
+	commitment   sol.Commitment
+}
+
+// NewMonitorTransactionUseCase creates a new MonitorTransactionUseCase
+//   - transaction is done when it reaches commitment level, confirmed or finalized
+func NewMonitorTransactionUseCase(
+	solClient solana.Solanaer,
+	addrRepo watchrepo.AddressRepositorier,
+	txDetailRepo watchrepo.SolDetailTxRepositorier,
+	commitment sol.Commitment,
+) watchusecase.MonitorTransactionUseCase {
+	if commitment != sol.CommitmentConfirmed {
+		commitment = sol.CommitmentFinalized
+	}
+	return &monitorTransactionUseCase{
+		solClient:    solClient,
+		addrRepo:     addrRepo,
+		txDetailRepo: txDetailRepo,
+		commitment:   commitment,
+	}
+}
+
+func (u *monitorTransactionUseCase) UpdateTxStatus(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.sol.MonitorTransaction.UpdateTxStatus")
+	defer tracer.End(span, &err)
+
+	// update tx_type for TxTypeSent
+	err = u.updateStatusTxTypeSent(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to call updateStatusTxTypeSent(): %w", err)
+	}
+	return nil
+}
+
+func (u *monitorTransactionUseCase) MonitorBalance(
+	ctx context.Context,
+	input watchusecase.MonitorBalanceInput,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.sol.MonitorTransaction.MonitorBalance")
+	defer tracer.End(span, &err)
+
+	targetAccounts := []domainAccount.AccountType{
+		domainAccount.AccountTypeClient,
+		domainAccount.AccountTypeDeposit,
+		domainAccount.AccountTypePayment,
+		domainAccount.AccountTypeStored,
+	}
+
+	for _, acnt := range targetAccounts {
+		addrs, err := u.addrRepo.GetAllAddress(ctx, acnt)
+		if err != nil {
+			return fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+		}
+		total, _ := u.solClient.GetTotalBalance(ctx, addrs)
+		metrics.SetBalance(u.solClient.CoinTypeCode().String(), acnt.String(), u.solClient.AmountToFloat(total))
+		logger.InfoContext(ctx, "total balance",
+			"account", acnt.String(),
+			"balance", total)
+	}
+
+	return nil
+}
+
+// update TxTypeSent to TxTypeDone if transaction reaches commitment level
+func (u *monitorTransactionUseCase) updateStatusTxTypeSent(ctx context.Context) error {
+	// get records whose status is TxTypeSent
+	signatures, err := u.txDetailRepo.GetSentSignatures(ctx, domainTx.TxTypeSent)
+	if err != nil {
+		return fmt.Errorf("fail to call txDetailRepo.GetSentSignatures(TxTypeSent): %w", err)
+	}
+
+	for start := 0; start < len(signatures); start += maxSignatureStatuses {
+		end := min(start+maxSignatureStatuses, len(signatures))
+		targets := signatures[start:end]
+
+		var statuses []*sol.SignatureStatus
+		statuses, err = u.solClient.GetSignatureStatuses(ctx, targets)
+		if err != nil {
+			return fmt.Errorf("fail to call sol.GetSignatureStatuses(): %w", err)
+		}
+		for idx, status := range statuses {
+			if idx >= len(targets) {
+				break
+			}
+			signature := targets[idx]
+			if !u.isDone(ctx, signature, status) {
+				continue
+			}
+			// update status
+			_, err = u.txDetailRepo.UpdateTxTypeBySentSignature(ctx, domainTx.TxTypeDone, signature)
+			if err != nil {
+				logger.WarnContext(ctx, "failed to call txDetailRepo.UpdateTxTypeBySentSignature()",
+					"error", err,
+				)
+				continue
+			}
+			u.incConfirmedTx(ctx, signature)
+		}
+	}
+	return nil
+}
+
+// isDone returns true if transaction reaches commitment level
+func (u *monitorTransactionUseCase) isDone(ctx context.Context, signature string, status *sol.SignatureStatus) bool {
+	if status == nil {
+		logger.InfoContext(ctx, "signature is not found yet", "signature", signature)
+		return false
+	}
+	logger.InfoContext(ctx, "confirmation",
+		"signature", signature,
+		"confirmation_status", status.ConfirmationStatus.String())
+	if status.IsFailed() {
+		// fee was charged and nonce was advanced, so transaction must be created again
+		logger.WarnContext(ctx, "transaction failed",
+			"signature", signature,
+			"error", string(status.Err))
+		return false
+	}
+	switch status.ConfirmationStatus {
+	case sol.CommitmentFinalized:
+		return true
+	case sol.CommitmentConfirmed:
+		return u.commitment == sol.CommitmentConfirmed
+	case sol.CommitmentProcessed:
+		return false
+	default:
+		return false
+	}
+}
+
+// incConfirmedTx counts confirmed transaction by action of tx which it belongs to
+func (u *monitorTransactionUseCase) incConfirmedTx(ctx context.Context, signature string) {
+	action, err := u.txDetailRepo.GetActionBySentSignature(ctx, signature)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to call txDetailRepo.GetActionBySentSignature()",
+			"signature", signature,
+			"error", err,
+		)
+		return
+	}
+	metrics.IncTx(u.solClient.CoinTypeCode().String(), action.String(), metrics.TxStatusConfirmed)
+}
@@ -0,0 +1,550 @@
+package trx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron/trx"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/serial"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type createTransactionUseCase struct {
+	trxClient       tron.TrxTxCreator
+	dbConn          *sql.DB
+	addrRepo        watchrepo.AddressRepositorier
+	txRepo          watchrepo.TxRepositorier
+	txDetailRepo    watchrepo.TrxDetailTxRepositorier
+	payReqRepo      watchrepo.PaymentRequestRepositorier
+	txFileRepo      file.TransactionFileRepositorier
+	depositReceiver domainAccount.AccountType
+	paymentSender   domainAccount.AccountType
+	coinTypeCode    domainCoin.CoinTypeCode
+}
+
+// NewCreateTransactionUseCase creates a new CreateTransactionUseCase
+func NewCreateTransactionUseCase(
+	trxClient tron.TrxTxCreator,
+	dbConn *sql.DB,
+	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.TrxDetailTxRepositorier,
+	payReqRepo watchrepo.PaymentRequestRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+	depositReceiver domainAccount.AccountType,
+	paymentSender domainAccount.AccountType,
+	coinTypeCode domainCoin.CoinTypeCode,
+) watchusecase.CreateTransactionUseCase {
+	return &createTransactionUseCase{
+		trxClient:       trxClient,
+		dbConn:          dbConn,
+		addrRepo:        addrRepo,
+		txRepo:          txRepo,
+		txDetailRepo:    txDetailRepo,
+		payReqRepo:      payReqRepo,
+		txFileRepo:      txFileRepo,
+		depositReceiver: depositReceiver,
+		paymentSender:   paymentSender,
+		coinTypeCode:    coinTypeCode,
+	}
+}
+
+func (u *createTransactionUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.CreateTransactionInput,
+) (_ watchusecase.CreateTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.trx.CreateTransaction.Execute")
+	defer tracer.End(span, &err)
+
+	// Convert action type string to domain type
+	actionType := domainTx.ActionType(input.ActionType)
+	if !domainTx.ValidateActionType(input.ActionType) {
+		return watchusecase.CreateTransactionOutput{}, fmt.Errorf("invalid action type: %s", input.ActionType)
+	}
+
+	var fileName string
+	var execErr error
+
+	switch actionType {
+	case domainTx.ActionTypeDeposit:
+		fileName, execErr = u.createDepositTx(ctx)
+	case domainTx.ActionTypePayment:
+		fileName, execErr = u.createPaymentTx(ctx)
+	case domainTx.ActionTypeTransfer:
+		fileName, execErr = u.createTransferTx(ctx, input.SenderAccount, input.ReceiverAccount, input.Amount)
+	default:
+		return watchusecase.CreateTransactionOutput{}, fmt.Errorf("unsupported action type: %s", input.ActionType)
+	}
+
+	if execErr != nil {
+		return watchusecase.CreateTransactionOutput{}, fmt.Errorf("failed to create transaction: %w", execErr)
+	}
+
+	return watchusecase.CreateTransactionOutput{
+		TransactionHex: "",
+		FileName:       fileName,
+	}, nil
+}
+
+// createDepositTx creates unsigned tx if client accounts have coins
+// - sender: client, receiver: deposit
+func (u *createTransactionUseCase) createDepositTx(ctx context.Context) (string, error) {
+	sender := domainAccount.AccountTypeClient
+	receiver := u.depositReceiver
+	targetAction := domainTx.ActionTypeDeposit
+	logger.DebugContext(ctx, "account",
+		"sender", sender.String(),
+		"receiver", receiver.String(),
+	)
+
+	userAmounts, err := u.getUserAmounts(ctx, sender)
+	if err != nil {
+		return "", err
+	}
+	if len(userAmounts) == 0 {
+		logger.InfoContext(ctx, "no data")
+		return "", nil
+	}
+
+	serializedTxs, txDetailItems, err := u.createDepositRawTransactions(ctx, sender, receiver, userAmounts)
+	if err != nil {
+		return "", err
+	}
+	if len(txDetailItems) == 0 {
+		return "", nil
+	}
+
+	txID, err := u.updateDB(ctx, targetAction, txDetailItems, nil)
+	logger.DebugContext(ctx, "update result",
+		"txID", txID,
+		"error", err,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	// save transaction result to file
+	var generatedFileName string
+	if len(serializedTxs) != 0 {
+		generatedFileName, err = u.generateHexFile(ctx, targetAction, sender, txID, serializedTxs)
+		if err != nil {
+			return "", fmt.Errorf("fail to call generateHexFile(): %w", err)
+		}
+	}
+
+	return generatedFileName, nil
+}
+
+// createPaymentTx creates unsigned tx for user (anonymous addresses)
+// sender: payment, receiver: addresses coming from payment_request table
+// Note: only one address of sender should afford to send coin to all payment request users
+func (u *createTransactionUseCase) createPaymentTx(ctx context.Context) (string, error) {
+	sender := u.paymentSender
+	receiver := domainAccount.AccountTypeAnonymous
+	targetAction := domainTx.ActionTypePayment
+	logger.DebugContext(ctx, "account",
+		"sender", sender.String(),
+		"receiver", receiver.String(),
+	)
+
+	// get payment data from payment_request
+	userPayments, totalAmount, paymentRequestIds, err := u.createUserPayment(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(userPayments) == 0 {
+		logger.DebugContext(ctx, "no data in userPayments")
+		// no data
+		return "", nil
+	}
+
+	// get sender address
+	senderAddr, err := u.addrRepo.GetOneUnAllocated(ctx, sender)
+	if err != nil {
+		return "", fmt.Errorf("fail to call addrRepo.GetAll(domainAccount.AccountTypeClient): %w", err)
+	}
+	err = u.validateAmount(ctx, senderAddr, totalAmount)
+	if err != nil {
+		return "", err
+	}
+
+	// create raw transaction each address
+	serializedTxs, txDetailItems, err := u.createPaymentRawTransactions(ctx, sender, receiver, userPayments, senderAddr)
+	if err != nil {
+		return "", err
+	}
+	if len(txDetailItems) == 0 {
+		return "", nil
+	}
+
+	txID, err := u.updateDB(ctx, targetAction, txDetailItems, paymentRequestIds)
+	if err != nil {
+		return "", err
+	}
+
+	// save transaction result to file
+	var generatedFileName string
+	if len(serializedTxs) != 0 {
+		generatedFileName, err = u.generateHexFile(ctx, targetAction, sender, txID, serializedTxs)
+		if err != nil {
+			return "", fmt.Errorf("fail to call generateHexFile(): %w", err)
+		}
+	}
+
+	return generatedFileName, nil
+}
+
+// createTransferTx creates unsigned tx for transfer coin among internal accounts except client, authorization
+// FIXME: for now, receiver account covers fee, but should be flexible
+// - sender pays fee
+// - any internal account should have only one address in Tron because no utxo
+func (u *createTransactionUseCase) createTransferTx(
+	ctx context.Context,
+	sender, receiver domainAccount.AccountType,
+	floatValue float64,
+) (string, error) {
+	targetAction := domainTx.ActionTypeTransfer
+
+	// validation account
+	if receiver == domainAccount.AccountTypeClient || receiver == domainAccount.AccountTypeAuthorization {
+		return "", errors.New("invalid receiver account. client, authorization account is not allowed as receiver")
+	}
+	if sender == receiver {
+		return "", errors.New("invalid account. sender and receiver is same")
+	}
+
+	// check sender's balance
+	senderAddr, err := u.addrRepo.GetOneUnAllocated(ctx, sender)
+	if err != nil {
+		return "", fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(sender): %w", err)
+	}
+	senderBalance, err := u.trxClient.GetAccountBalance(ctx, senderAddr.WalletAddress)
+	if err != nil {
+		return "", fmt.Errorf("fail to call trx.GetAccountBalance(sender): %w", err)
+	}
+
+	if senderBalance == 0 {
+		return "", errors.New("sender has no balance")
+	}
+
+	requiredValue := u.trxClient.FloatToAmount(floatValue)
+	if floatValue != 0 && (senderBalance <= requiredValue) {
+		return "", errors.New("sender balance is insufficient to send")
+	}
+	logger.DebugContext(ctx, "amount",
+		"floatValue", floatValue,
+		"requiredValue", requiredValue,
+		"senderBalance", senderBalance,
+	)
+
+	// get receiver address
+	receiverAddr, err := u.addrRepo.GetOneUnAllocated(ctx, receiver)
+	if err != nil {
+		return "", fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(receiver): %w", err)
+	}
+
+	// call CreateRawTransaction
+	rawTx, txDetailItem, err := u.trxClient.CreateRawTransaction(ctx,
+		senderAddr.WalletAddress, receiverAddr.WalletAddress, requiredValue)
+	if err != nil {
+		return "", fmt.Errorf(
+			"fail to call trx.CreateRawTransaction(), sender address: %s: %w",
+			senderAddr.WalletAddress, err)
+	}
+
+	logger.DebugContext(ctx, "rawTx", "tx", rawTx.TxHex, "txid", rawTx.TxID)
+
+	serializedTx, err := serial.EncodeToString(rawTx)
+	if err != nil {
+		return "", fmt.Errorf("fail to call serial.EncodeToString(rawTx): %w", err)
+	}
+	serializedTxs := []string{serializedTx}
+
+	// create insert data for　trx_detail_tx
+	txDetailItem.SenderAccount = sender.String()
+	txDetailItem.ReceiverAccount = receiver.String()
+	txDetailItems := []*models.TRXDetailTX{txDetailItem}
+
+	txID, err := u.updateDB(ctx, targetAction, txDetailItems, nil)
+	if err != nil {
+		return "", err
+	}
+
+	// save transaction result to file
+	var generatedFileName string
+	if len(serializedTxs) != 0 {
+		generatedFileName, err = u.generateHexFile(ctx, targetAction, sender, txID, serializedTxs)
+		if err != nil {
+			return "", fmt.Errorf("fail to call generateHexFile(): %w", err)
+		}
+	}
+
+	return generatedFileName, nil
+}
+
+// userPayment represents user's payment address and amount
+type userPayment struct {
+	senderAddr   string  // sender address for just checking
+	receiverAddr string  // receiver address
+	floatAmount  float64 // float amount (TRX or token)
+	amount       uint64  // amount (sun or base unit of token)
+}
+
+func (u *createTransactionUseCase) getUserAmounts(
+	ctx context.Context,
+	sender domainAccount.AccountType,
+) ([]trx.UserAmount, error) {
+	// get addresses for client account
+	addrs, err := u.addrRepo.GetAll(ctx, sender)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call addrRepo.GetAll(domainAccount.AccountTypeClient): %w", err)
+	}
+
+	// target addresses
+	var userAmounts []trx.UserAmount
+
+	// address list for client
+	for _, addr := range addrs {
+		// TODO: if previous tx is not done, wrong amount is returned. how to manage it??
+		var balance uint64
+		balance, err = u.trxClient.GetAccountBalance(ctx, addr.WalletAddress)
+		if err != nil {
+			logger.WarnContext(ctx, "fail to call .GetAccountBalance()",
+				"address", addr.WalletAddress,
+				"error", err,
+			)
+		} else if balance != 0 {
+			userAmounts = append(userAmounts, trx.UserAmount{Address: addr.WalletAddress, Amount: balance})
+		}
+	}
+
+	return userAmounts, nil
+}
+
+func (u *createTransactionUseCase) createDepositRawTransactions(
+	ctx context.Context,
+	sender, receiver domainAccount.AccountType,
+	userAmounts []trx.UserAmount,
+) ([]string, []*models.TRXDetailTX, error) {
+	// get address for deposit account
+	depositAddr, err := u.addrRepo.GetOneUnAllocated(ctx, receiver)
+	if err != nil {
+		return nil, nil, fmt.Errorf(
+			"fail to call addrRepo.GetOneUnAllocated(domainAccount.AccountTypeDeposit): %w", err,
+		)
+	}
+
+	// create raw transaction each address
+	serializedTxs := make([]string, 0, len(userAmounts))
+	txDetailItems := make([]*models.TRXDetailTX, 0, len(userAmounts))
+	for _, val := range userAmounts {
+		// call CreateRawTransaction
+		var rawTx *trx.RawTx
+		var txDetailItem *models.TRXDetailTX
+		rawTx, txDetailItem, err = u.trxClient.CreateRawTransaction(
+			ctx, val.Address, depositAddr.WalletAddress, 0)
+		if errors.Is(err, trx.ErrAccountNotActivated) || errors.Is(err, trx.ErrInsufficientFee) {
+			// token can be received by address which isn't activated or has no TRX for fee,
+			// it's swept after TRX is sent to the address
+			logger.WarnContext(ctx, "skip address which can't send transaction",
+				"address", val.Address,
+				"error", err,
+			)
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf(
+				"fail to call addrRepo.CreateRawTransaction(), sender address: %s: %w",
+				val.Address, err)
+		}
+
+		logger.DebugContext(ctx, "rawTx", "tx", rawTx.TxHex, "txid", rawTx.TxID)
+
+		var serializedTx string
+		serializedTx, err = serial.EncodeToString(rawTx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to call serial.EncodeToString(rawTx): %w", err)
+		}
+		serializedTxs = append(serializedTxs, serializedTx)
+
+		// create insert data for　trx_detail_tx
+		txDetailItem.SenderAccount = sender.String()
+		txDetailItem.ReceiverAccount = receiver.String()
+		txDetailItems = append(txDetailItems, txDetailItem)
+	}
+	return serializedTxs, txDetailItems, nil
+}
+
+func (u *createTransactionUseCase) createUserPayment(ctx context.Context) ([]userPayment, uint64, []int64, error) {
+	// get payment_request
+	paymentRequests, err := u.payReqRepo.GetAll(ctx)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("fail to call repo.GetPaymentRequestAll(): %w", err)
+	}
+	if len(paymentRequests) == 0 {
+		logger.DebugContext(ctx, "no data in payment_request")
+		return nil, 0, nil, nil
+	}
+
+	userPayments := make([]userPayment, len(paymentRequests))
+	paymentRequestIds := make([]int64, len(paymentRequests))
+	var totalAmount uint64
+
+	// store `id` separately for key updating
+	for idx, val := range paymentRequests {
+		paymentRequestIds[idx] = val.ID
+
+		userPayments[idx].senderAddr = val.SenderAddress
+		userPayments[idx].receiverAddr = val.ReceiverAddress
+		var amt float64
+		amt, err = strconv.ParseFloat(val.Amount.String(), 64)
+		if err != nil {
+			// fatal error because table includes invalid data
+			logger.ErrorContext(ctx, "payment_request table includes invalid amount field")
+			return nil, 0, nil, errors.New("payment_request table includes invalid amount field")
+		}
+		userPayments[idx].floatAmount = amt
+
+		// validate address
+		if err = u.trxClient.ValidateAddr(userPayments[idx].receiverAddr); err != nil {
+			// fatal error
+			logger.ErrorContext(ctx, "fail to call ValidationAddr",
+				"address", userPayments[idx].receiverAddr,
+				"error", err,
+			)
+			return nil, 0, nil, fmt.Errorf("fail to call trx.ValidateAddr(): %w", err)
+		}
+
+		// amount
+		userPayments[idx].amount = u.trxClient.FloatToAmount(userPayments[idx].floatAmount)
+		totalAmount += userPayments[idx].amount
+	}
+
+	return userPayments, totalAmount, paymentRequestIds, nil
+}
+
+func (u *createTransactionUseCase) validateAmount(
+	ctx context.Context,
+	senderAddr *models.Address,
+	totalAmount uint64,
+) error {
+	// check sender's total balance
+	senderBalance, err := u.trxClient.GetAccountBalance(ctx, senderAddr.WalletAddress)
+	if err != nil {
+		return fmt.Errorf("fail to call trx.GetAccountBalance(): %w", err)
+	}
+
+	if senderBalance < totalAmount {
+		return errors.New("sender balance is insufficient to send")
+	}
+	return nil
+}
+
+func (u *createTransactionUseCase) createPaymentRawTransactions(
+	ctx context.Context,
+	sender, receiver domainAccount.AccountType,
+	userPayments []userPayment,
+	senderAddr *models.Address,
+) ([]string, []*models.TRXDetailTX, error) {
+	serializedTxs := make([]string, 0, len(userPayments))
+	txDetailItems := make([]*models.TRXDetailTX, 0, len(userPayments))
+	for _, userPayment := range userPayments {
+		// call CreateRawTransaction
+		rawTx, txDetailItem, err := u.trxClient.CreateRawTransaction(ctx,
+			senderAddr.WalletAddress, userPayment.receiverAddr, userPayment.amount)
+		if err != nil {
+			return nil, nil, fmt.Errorf(
+				"fail to call addrRepo.CreateRawTransaction(), sender address: %s: %w",
+				senderAddr.WalletAddress, err)
+		}
+
+		logger.DebugContext(ctx, "rawTx", "tx", rawTx.TxHex, "txid", rawTx.TxID)
+
+		serializedTx, err := serial.EncodeToString(rawTx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to call serial.EncodeToString(rawTx): %w", err)
+		}
+		serializedTxs = append(serializedTxs, serializedTx)
+
+		// create insert data for　trx_detail_tx
+		txDetailItem.SenderAccount = sender.String()
+		txDetailItem.ReceiverAccount = receiver.String()
+		txDetailItems = append(txDetailItems, txDetailItem)
+	}
+	return serializedTxs, txDetailItems, nil
+}
+
+func (u *createTransactionUseCase) updateDB(
+	ctx context.Context, targetAction domainTx.ActionType,
+	txDetailItems []*models.TRXDetailTX,
+	paymentRequestIds []int64,
+) (int64, error) {
+	// start transaction
+	dtx, err := u.dbConn.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("fail to start transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = dtx.Rollback() // Error already being handled
+		} else {
+			_ = dtx.Commit() // Error already being handled
+		}
+	}()
+
+	// Insert tx
+	txID, err := u.txRepo.InsertUnsignedTx(ctx, targetAction)
+	if err != nil {
+		return 0, fmt.Errorf("fail to call txRepo.InsertUnsignedTx(): %w", err)
+	}
+	// Insert to trx_detail_tx
+	for idx := range txDetailItems {
+		txDetailItems[idx].TXID = txID
+	}
+	if err = u.txDetailRepo.InsertBulk(ctx, txDetailItems); err != nil {
+		return 0, fmt.Errorf("fail to call txDetailRepo.InsertBulk(): %w", err)
+	}
+
+	if targetAction == domainTx.ActionTypePayment {
+		_, err = u.payReqRepo.UpdatePaymentID(ctx, txID, paymentRequestIds)
+		if err != nil {
+			return 0, fmt.Errorf("fail to call repo.PayReq().UpdatePaymentID(txID, paymentRequestIds): %w", err)
+		}
+	}
+	metrics.IncTx(u.coinTypeCode.String(), targetAction.String(), metrics.TxStatusCreated)
+	return txID, nil
+}
+
+// generateHexFile generates file for hex txID and encoded previous addresses
+func (u *createTransactionUseCase) generateHexFile(
+	ctx context.Context,
+	actionType domainTx.ActionType,
+	senderAccount domainAccount.AccountType,
+	txID int64,
+	serializedTxs []string,
+) (string, error) {
+	// add senderAccount to first line
+	serializedTxs = append([]string{senderAccount.String()}, serializedTxs...)
+
+	// create file
+	path := u.txFileRepo.CreateFilePath(actionType, domainTx.TxTypeUnsigned, txID, 0)
+	generatedFileName, err := u.txFileRepo.WriteFileSlice(ctx, path, serializedTxs)
+	if err != nil {
+		return "", fmt.Errorf("fail to call txFileRepo.WriteFile(): %w", err)
+	}
+
+	return generatedFileName, nil
+}
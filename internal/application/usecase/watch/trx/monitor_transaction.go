@@ -0,0 +1,135 @@
+package trx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron/trx"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type monitorTransactionUseCase struct {
+	trxClient    tron.Troner
+	addrRepo     watchrepo.AddressRepositorier
+	txDetailRepo watchrepo.TrxDetailTxRepositorier
+	confirmNum   uint64
+}
+
+// NewMonitorTransactionUseCase creates a new MonitorTransactionUseCase
+func NewMonitorTransactionUseCase(
+	trxClient tron.Troner,
+	addrRepo watchrepo.AddressRepositorier,
+	txDetailRepo watchrepo.TrxDetailTxRepositorier,
+	confirmNum uint64,
+) watchusecase.MonitorTransactionUseCase {
+	return &monitorTransactionUseCase{
+		trxClient:    trxClient,
+		addrRepo:     addrRepo,
+		txDetailRepo: txDetailRepo,
+		confirmNum:   confirmNum,
+	}
+}
+
+func (u *monitorTransactionUseCase) UpdateTxStatus(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.trx.MonitorTransaction.UpdateTxStatus")
+	defer tracer.End(span, &err)
+
+	// update tx_type for TxTypeSent
+	err = u.updateStatusTxTypeSent(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to call updateStatusTxTypeSent(): %w", err)
+	}
+	return nil
+}
+
+func (u *monitorTransactionUseCase) MonitorBalance(
+	ctx context.Context,
+	input watchusecase.MonitorBalanceInput,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.trx.MonitorTransaction.MonitorBalance")
+	defer tracer.End(span, &err)
+
+	targetAccounts := []domainAccount.AccountType{
+		domainAccount.AccountTypeClient,
+		domainAccount.AccountTypeDeposit,
+		domainAccount.AccountTypePayment,
+		domainAccount.AccountTypeStored,
+	}
+
+	for _, acnt := range targetAccounts {
+		addrs, err := u.addrRepo.GetAllAddress(ctx, acnt)
+		if err != nil {
+			return fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+		}
+		total, _ := u.trxClient.GetTotalBalance(ctx, addrs)
+		metrics.SetBalance(u.trxClient.CoinTypeCode().String(), acnt.String(), u.trxClient.AmountToFloat(total))
+		logger.InfoContext(ctx, "total balance",
+			"account", acnt.String(),
+			"balance", total)
+	}
+
+	return nil
+}
+
+// update TxTypeSent to TxTypeDone if confirmation is more than expected
+func (u *monitorTransactionUseCase) updateStatusTxTypeSent(ctx context.Context) error {
+	// get records whose status is TxTypeSent
+	hashes, err := u.txDetailRepo.GetSentHashTx(ctx, domainTx.TxTypeSent)
+	if err != nil {
+		return fmt.Errorf("fail to call txDetailRepo.GetSentHashTx(TxTypeSent): %w", err)
+	}
+
+	// get hash in detail and check confirmation
+	for _, sentHash := range hashes {
+		// check confirmation
+		var confirmNum uint64
+		confirmNum, err = u.trxClient.GetConfirmation(ctx, sentHash)
+		if errors.Is(err, trx.ErrTransactionFailed) {
+			// fee was burned, so transaction must be created again
+			logger.WarnContext(ctx, "transaction failed",
+				"sentHash", sentHash,
+				"error", err)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("fail to call trx.GetConfirmation() sentHash: %s: %w", sentHash, err)
+		}
+		logger.InfoContext(ctx, "confirmation",
+			"sentHash", sentHash,
+			"confirmation num", confirmNum)
+		if confirmNum < u.confirmNum {
+			continue
+		}
+		// update status
+		_, err = u.txDetailRepo.UpdateTxTypeBySentHashTx(ctx, domainTx.TxTypeDone, sentHash)
+		if err != nil {
+			logger.WarnContext(ctx, "failed to call txDetailRepo.UpdateTxTypeBySentHashTx()",
+				"error", err,
+			)
+			continue
+		}
+		u.incConfirmedTx(ctx, sentHash)
+	}
+	return nil
+}
+
+// incConfirmedTx counts confirmed transaction by action of tx which it belongs to
+func (u *monitorTransactionUseCase) incConfirmedTx(ctx context.Context, sentHash string) {
+	action, err := u.txDetailRepo.GetActionBySentHashTx(ctx, sentHash)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to call txDetailRepo.GetActionBySentHashTx()",
+			"hash", sentHash,
+			"error", err,
+		)
+		return
+	}
+	metrics.IncTx(u.trxClient.CoinTypeCode().String(), action.String(), metrics.TxStatusConfirmed)
+}
@@ -0,0 +1,116 @@
+package trx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type sendTransactionUseCase struct {
+	trxClient    tron.Troner
+	txDetailRepo watchrepo.TrxDetailTxRepositorier
+	txFileRepo   file.TransactionFileRepositorier
+}
+
+// NewSendTransactionUseCase creates a new SendTransactionUseCase
+func NewSendTransactionUseCase(
+	trxClient tron.Troner,
+	txDetailRepo watchrepo.TrxDetailTxRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+) watchusecase.SendTransactionUseCase {
+	return &sendTransactionUseCase{
+		trxClient:    trxClient,
+		txDetailRepo: txDetailRepo,
+		txFileRepo:   txFileRepo,
+	}
+}
+
+func (u *sendTransactionUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.SendTransactionInput,
+) (_ watchusecase.SendTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.trx.SendTransaction.Execute")
+	defer tracer.End(span, &err)
+
+	// Validate file path and extract transaction metadata
+	actionType, _, txID, _, err := u.txFileRepo.ValidateFilePath(input.FilePath, domainTx.TxTypeSigned)
+	if err != nil {
+		return watchusecase.SendTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.ValidateFilePath(): %w", err)
+	}
+
+	logger.DebugContext(ctx, "send_tx", "action_type", actionType.String())
+
+	// Read signed transactions from file
+	data, err := u.txFileRepo.ReadFileSlice(ctx, input.FilePath)
+	if err != nil {
+		return watchusecase.SendTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.ReadFile(): %w", err)
+	}
+
+	// Process each signed transaction from the file
+	for _, line := range data {
+		// data is csv [rawTx.UUID, signedRawTx.TxHex]
+		// rawTx.UUID is used to record status by updating database
+		tmp := strings.Split(line, ",")
+		if len(tmp) != 2 {
+			return watchusecase.SendTransactionOutput{}, errors.New("data format is invalid in file")
+		}
+		uuid := tmp[0]
+		signedTx := tmp[1]
+
+		// Send signed transaction to Tron network
+		// transaction is rejected if it's expired, expiration is decided when it's created
+		var sentTx string
+		sentTx, err = u.trxClient.SendSignedTransaction(ctx, signedTx)
+		if err != nil {
+			logger.WarnContext(ctx, "fail to call trx.SendSignedTransaction()",
+				"uuid", uuid,
+				"error", err,
+			)
+			continue
+		}
+
+		// Update trx_detail_tx table
+		var affectedNum int64
+		affectedNum, err = u.txDetailRepo.UpdateAfterTxSent(ctx, uuid, domainTx.TxTypeSent, signedTx, sentTx)
+		if err != nil {
+			// TODO: even if error occurred, tx is already sent. so db should be corrected manually
+			logger.WarnContext(
+				ctx,
+				"fail to call repo.Tx().UpdateAfterTxSent() but tx is already sent. "+
+					"So database should be updated manually",
+				"tx_id", txID,
+				"tx_type", domainTx.TxTypeSent.String(),
+				"tx_type_value", domainTx.TxTypeSent.Int8(),
+				"signed_tx", signedTx,
+				"sent_hash_tx", sentTx,
+			)
+			continue
+		}
+		if affectedNum == 0 {
+			logger.InfoContext(ctx, "no records to update tx_table",
+				"tx_id", txID,
+				"tx_type", domainTx.TxTypeSent.String(),
+				"tx_type_value", domainTx.TxTypeSent.Int8(),
+				"signed_tx", signedTx,
+				"sent_hash_tx", sentTx,
+			)
+			continue
+		}
+		metrics.IncTx(u.trxClient.CoinTypeCode().String(), actionType.String(), metrics.TxStatusSent)
+	}
+
+	// Tron uses same address because no utxo
+	return watchusecase.SendTransactionOutput{
+		TxID: "",
+	}, nil
+}
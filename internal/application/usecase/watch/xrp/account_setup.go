@@ -0,0 +1,113 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// accountSetup creates unsigned transactions which change settings of addresses of account
+//   - settings and sequence are read from ledger by watch wallet, keygen wallet only signs them offline
+//   - transaction is recorded in xrp_detail_tx and signed by active key of account in keygen wallet
+type accountSetup struct {
+	// tx shares recording of xrp_detail_tx and writing of unsigned transaction file
+	tx *createTransactionUseCase
+}
+
+func newAccountSetup(
+	rippler ripple.Rippler,
+	dbConn *sql.DB,
+	uuidHandler uuid.UUIDHandler,
+	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+) *accountSetup {
+	return &accountSetup{
+		tx: &createTransactionUseCase{
+			rippler:      rippler,
+			dbConn:       dbConn,
+			uuidHandler:  uuidHandler,
+			addrRepo:     addrRepo,
+			txRepo:       txRepo,
+			txDetailRepo: txDetailRepo,
+			txFileRepo:   txFileRepo,
+		},
+	}
+}
+
+// setupBuilder returns prepared transaction of address, nil txJSON means address doesn't need it
+type setupBuilder func(ctx context.Context, address string) (*xrp.TxInput, string, error)
+
+// create creates unsigned transactions built by build for addresses of account
+//   - it returns empty file name when no address needs transaction
+func (s *accountSetup) create(
+	ctx context.Context,
+	accountType domainAccount.AccountType,
+	addrs []string,
+	build setupBuilder,
+) (string, error) {
+	serializedTxs := make([]string, 0, len(addrs))
+	txDetailItems := make([]*models.XRPDetailTX, 0, len(addrs))
+	for _, addr := range addrs {
+		txJSON, rawTxString, err := build(ctx, addr)
+		if err != nil {
+			return "", err
+		}
+		if txJSON == nil {
+			continue
+		}
+		logger.DebugContext(ctx, "txJSON", "txJSON", txJSON)
+
+		uid, err := s.tx.uuidHandler.GenerateV7()
+		if err != nil {
+			return "", fmt.Errorf("fail to call uuidHandler.GenerateV7(): %w", err)
+		}
+		// setting is changed by key of account itself, so it's always signed by keygen wallet
+		serializedTx, err := serializeTx(uid.String(), rawTxString, nil)
+		if err != nil {
+			return "", err
+		}
+		serializedTxs = append(serializedTxs, serializedTx)
+
+		// account sends transaction to itself without amount
+		txDetailItems = append(txDetailItems, &models.XRPDetailTX{
+			UUID:               uid.String(),
+			CurrentTXType:      domainTx.TxTypeUnsigned.Int8(),
+			SenderAccount:      accountType.String(),
+			SenderAddress:      addr,
+			ReceiverAccount:    accountType.String(),
+			ReceiverAddress:    addr,
+			Amount:             "0",
+			XRPTXType:          txJSON.TransactionType,
+			Fee:                txJSON.Fee,
+			Flags:              txJSON.Flags,
+			LastLedgerSequence: txJSON.LastLedgerSequence,
+			Sequence:           txJSON.Sequence,
+		})
+	}
+	if len(txDetailItems) == 0 {
+		return "", nil
+	}
+
+	txID, err := s.tx.updateDB(ctx, domainTx.ActionTypeTransfer, txDetailItems, nil)
+	if err != nil {
+		return "", err
+	}
+	generatedFileName, err := s.tx.generateHexFile(
+		ctx, domainTx.ActionTypeTransfer, accountType, txID, serializedTxs)
+	if err != nil {
+		return "", fmt.Errorf("fail to call generateHexFile(): %w", err)
+	}
+	return generatedFileName, nil
+}
@@ -0,0 +1,182 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+type createAccountDeleteUseCase struct {
+	rippler ripple.Rippler
+	// tx shares recording of xrp_detail_tx and writing of unsigned transaction file
+	tx *createTransactionUseCase
+}
+
+// NewCreateAccountDeleteUseCase creates a new CreateAccountDeleteUseCase
+//   - AccountDelete reclaims base reserve of retired client account, rest of balance is sent to deposit account
+//   - transaction is signed by active key of account in keygen wallet
+func NewCreateAccountDeleteUseCase(
+	rippler ripple.Rippler,
+	dbConn *sql.DB,
+	uuidHandler uuid.UUIDHandler,
+	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+) watchusecase.CreateAccountDeleteUseCase {
+	return &createAccountDeleteUseCase{
+		rippler: rippler,
+		tx: &createTransactionUseCase{
+			rippler:      rippler,
+			dbConn:       dbConn,
+			uuidHandler:  uuidHandler,
+			addrRepo:     addrRepo,
+			txRepo:       txRepo,
+			txDetailRepo: txDetailRepo,
+			txFileRepo:   txFileRepo,
+		},
+	}
+}
+
+func (u *createAccountDeleteUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.CreateAccountDeleteInput,
+) (_ watchusecase.CreateAccountDeleteOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.CreateAccountDelete.Execute")
+	defer tracer.End(span, &err)
+
+	if len(input.Addresses) == 0 {
+		return watchusecase.CreateAccountDeleteOutput{}, errors.New("addresses are required")
+	}
+
+	// only client account is retired
+	sender := domainAccount.AccountTypeClient
+	receiver := domainAccount.AccountTypeDeposit
+	clientAddrs, err := u.tx.addrRepo.GetAllAddress(ctx, sender)
+	if err != nil {
+		return watchusecase.CreateAccountDeleteOutput{}, fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+	}
+	receiverAddr, err := u.tx.addrRepo.GetOneUnAllocated(ctx, receiver)
+	if err != nil {
+		return watchusecase.CreateAccountDeleteOutput{},
+			fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(receiver): %w", err)
+	}
+
+	serializedTxs := make([]string, 0, len(input.Addresses))
+	txDetailItems := make([]*models.XRPDetailTX, 0, len(input.Addresses))
+	for _, addr := range input.Addresses {
+		// X-address shares account with classic address
+		if xrp.IsXAddress(addr) {
+			return watchusecase.CreateAccountDeleteOutput{}, fmt.Errorf("%s is X-address, use classic address", addr)
+		}
+		if !slices.Contains(clientAddrs, addr) {
+			return watchusecase.CreateAccountDeleteOutput{}, fmt.Errorf("%s is not address of client account", addr)
+		}
+		if err = u.rippler.CheckAccountDeletable(ctx, addr); err != nil {
+			return watchusecase.CreateAccountDeleteOutput{}, fmt.Errorf("%s can't be deleted: %w", addr, err)
+		}
+
+		var txDetailItem *models.XRPDetailTX
+		var serializedTx string
+		txDetailItem, serializedTx, err = u.createAccountDeleteTx(ctx, sender, receiver, addr, receiverAddr.WalletAddress)
+		if err != nil {
+			return watchusecase.CreateAccountDeleteOutput{}, err
+		}
+		serializedTxs = append(serializedTxs, serializedTx)
+		txDetailItems = append(txDetailItems, txDetailItem)
+	}
+
+	txID, err := u.tx.updateDB(ctx, domainTx.ActionTypeTransfer, txDetailItems, nil)
+	if err != nil {
+		return watchusecase.CreateAccountDeleteOutput{}, err
+	}
+
+	generatedFileName, err := u.tx.generateHexFile(ctx, domainTx.ActionTypeTransfer, sender, txID, serializedTxs)
+	if err != nil {
+		return watchusecase.CreateAccountDeleteOutput{}, fmt.Errorf("fail to call generateHexFile(): %w", err)
+	}
+
+	logger.InfoContext(ctx, "AccountDelete transactions are created",
+		"accounts", len(txDetailItems),
+		"destination", receiverAddr.WalletAddress,
+		"file", generatedFileName,
+	)
+	return watchusecase.CreateAccountDeleteOutput{FileName: generatedFileName}, nil
+}
+
+// createAccountDeleteTx creates AccountDelete transaction and record of xrp_detail_tx
+//   - amount is balance minus fee in drops, which is delivered to destination
+func (u *createAccountDeleteUseCase) createAccountDeleteTx(
+	ctx context.Context,
+	sender, receiver domainAccount.AccountType,
+	senderAddr, receiverAddr string,
+) (*models.XRPDetailTX, string, error) {
+	accountInfo, err := u.rippler.AccountInfo(ctx, senderAddr)
+	if err != nil {
+		return nil, "", fmt.Errorf("fail to call rippler.AccountInfo(): %w", err)
+	}
+	if accountInfo.Error != "" {
+		return nil, "", fmt.Errorf("fail to call rippler.AccountInfo(): %s", accountInfo.Error)
+	}
+
+	// account owning signer list can't be deleted, so it's always signed by keygen wallet
+	txJSON, rawTxString, err := u.rippler.CreateAccountDeleteTransaction(
+		ctx, senderAddr, receiverAddr, newInstructions(nil))
+	if err != nil {
+		return nil, "", fmt.Errorf(
+			"fail to call rippler.CreateAccountDeleteTransaction(), address: %s: %w", senderAddr, err)
+	}
+	logger.DebugContext(ctx, "txJSON", "txJSON", txJSON)
+
+	balance, err := strconv.ParseUint(accountInfo.Result.AccountData.Balance, 10, 64)
+	if err != nil {
+		return nil, "", fmt.Errorf("fail to parse balance %s: %w", accountInfo.Result.AccountData.Balance, err)
+	}
+	fee, err := strconv.ParseUint(txJSON.Fee, 10, 64)
+	if err != nil {
+		return nil, "", fmt.Errorf("fail to parse fee %s: %w", txJSON.Fee, err)
+	}
+	if balance <= fee {
+		return nil, "", fmt.Errorf("balance of %s is insufficient to pay fee of AccountDelete", senderAddr)
+	}
+
+	uid, err := u.tx.uuidHandler.GenerateV7()
+	if err != nil {
+		return nil, "", fmt.Errorf("fail to call uuidHandler.GenerateV7(): %w", err)
+	}
+	serializedTx, err := serializeTx(uid.String(), rawTxString, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	txDetailItem := &models.XRPDetailTX{
+		UUID:               uid.String(),
+		CurrentTXType:      domainTx.TxTypeUnsigned.Int8(),
+		SenderAccount:      sender.String(),
+		SenderAddress:      senderAddr,
+		ReceiverAccount:    receiver.String(),
+		ReceiverAddress:    receiverAddr,
+		Amount:             strconv.FormatUint(balance-fee, 10),
+		XRPTXType:          txJSON.TransactionType,
+		Fee:                txJSON.Fee,
+		Flags:              txJSON.Flags,
+		LastLedgerSequence: txJSON.LastLedgerSequence,
+		Sequence:           txJSON.Sequence,
+	}
+	return txDetailItem, serializedTx, nil
+}
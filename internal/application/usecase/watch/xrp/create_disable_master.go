@@ -0,0 +1,94 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+type createDisableMasterUseCase struct {
+	rippler ripple.Rippler
+	setup   *accountSetup
+}
+
+// NewCreateDisableMasterUseCase creates a new CreateDisableMasterUseCase
+//   - AccountSet transaction with asfDisableMaster is created for addresses whose master key is enabled
+//   - address without regular key in ledger is skipped, otherwise it can't sign any transaction
+//   - keygen wallet signs it by regular key, so regular key must be imported to keygen wallet beforehand
+func NewCreateDisableMasterUseCase(
+	rippler ripple.Rippler,
+	dbConn *sql.DB,
+	uuidHandler uuid.UUIDHandler,
+	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+) watchusecase.CreateDisableMasterUseCase {
+	return &createDisableMasterUseCase{
+		rippler: rippler,
+		setup:   newAccountSetup(rippler, dbConn, uuidHandler, addrRepo, txRepo, txDetailRepo, txFileRepo),
+	}
+}
+
+func (u *createDisableMasterUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.CreateDisableMasterInput,
+) (_ watchusecase.CreateDisableMasterOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.CreateDisableMaster.Execute")
+	defer tracer.End(span, &err)
+
+	addrs, err := u.setup.tx.addrRepo.GetAllAddress(ctx, input.AccountType)
+	if err != nil {
+		return watchusecase.CreateDisableMasterOutput{}, fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+	}
+
+	generatedFileName, err := u.setup.create(ctx, input.AccountType, addrs,
+		func(ctx context.Context, addr string) (*xrp.TxInput, string, error) {
+			isDisabled, err := u.rippler.IsMasterDisabled(ctx, addr)
+			if err != nil {
+				// account which isn't funded yet is not found
+				logger.WarnContext(ctx, "fail to call rippler.IsMasterDisabled()", "address", addr, "error", err)
+				return nil, "", nil
+			}
+			if isDisabled {
+				return nil, "", nil
+			}
+			regularKey, err := u.rippler.GetRegularKey(ctx, addr)
+			if err != nil {
+				return nil, "", fmt.Errorf("fail to call rippler.GetRegularKey(), address: %s: %w", addr, err)
+			}
+			if regularKey == "" {
+				logger.WarnContext(ctx, "regular key is not set, run `create regularkey` first", "address", addr)
+				return nil, "", nil
+			}
+			txJSON, rawTxString, err := u.rippler.CreateAccountSetTransaction(
+				ctx, addr, xrp.AsfDisableMaster, newInstructions(nil))
+			if err != nil {
+				return nil, "", fmt.Errorf(
+					"fail to call rippler.CreateAccountSetTransaction(), address: %s: %w", addr, err)
+			}
+			return txJSON, rawTxString, nil
+		})
+	if err != nil {
+		return watchusecase.CreateDisableMasterOutput{}, err
+	}
+	if generatedFileName == "" {
+		logger.InfoContext(ctx, "no address to disable master key", "account_type", input.AccountType.String())
+		return watchusecase.CreateDisableMasterOutput{}, nil
+	}
+
+	logger.InfoContext(ctx, "AccountSet transactions to disable master key are created",
+		"account_type", input.AccountType.String(),
+		"file", generatedFileName,
+	)
+	return watchusecase.CreateDisableMasterOutput{FileName: generatedFileName}, nil
+}
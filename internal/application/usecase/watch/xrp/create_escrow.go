@@ -0,0 +1,176 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null/v6"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+type createEscrowUseCase struct {
+	rippler    ripple.Rippler
+	escrowRepo watchrepo.XrpEscrowRepositorier
+	// tx shares recording of xrp_detail_tx and writing of unsigned transaction file
+	tx *createTransactionUseCase
+}
+
+// NewCreateEscrowUseCase creates a new CreateEscrowUseCase
+//   - funds of stored account are escrowed to itself, they can't be withdrawn until escrow is finished
+//   - EscrowCreate goes through the same offline signing as transfer, multisig account is signed by sign wallets
+//   - escrow is recorded in xrp_escrow table, FinishEscrowUseCase finishes it once it matures
+func NewCreateEscrowUseCase(
+	rippler ripple.Rippler,
+	dbConn *sql.DB,
+	uuidHandler uuid.UUIDHandler,
+	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	escrowRepo watchrepo.XrpEscrowRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+) watchusecase.CreateEscrowUseCase {
+	return &createEscrowUseCase{
+		rippler:    rippler,
+		escrowRepo: escrowRepo,
+		tx: &createTransactionUseCase{
+			rippler:      rippler,
+			dbConn:       dbConn,
+			uuidHandler:  uuidHandler,
+			addrRepo:     addrRepo,
+			txRepo:       txRepo,
+			txDetailRepo: txDetailRepo,
+			txFileRepo:   txFileRepo,
+		},
+	}
+}
+
+func (u *createEscrowUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.CreateEscrowInput,
+) (_ watchusecase.CreateEscrowOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.CreateEscrow.Execute")
+	defer tracer.End(span, &err)
+
+	if u.rippler.IssuedCurrency() != nil {
+		return watchusecase.CreateEscrowOutput{}, errors.New("escrow supports only XRP")
+	}
+	if input.Amount <= 0 {
+		return watchusecase.CreateEscrowOutput{}, errors.New("amount is required")
+	}
+	if !input.FinishAfter.After(time.Now()) {
+		return watchusecase.CreateEscrowOutput{}, errors.New("finishAfter must be future time")
+	}
+	if !input.CancelAfter.IsZero() && !input.CancelAfter.After(input.FinishAfter) {
+		return watchusecase.CreateEscrowOutput{}, errors.New("cancelAfter must be later than finishAfter")
+	}
+
+	account := domainAccount.AccountTypeStored
+	addr, err := u.tx.addrRepo.GetOneUnAllocated(ctx, account)
+	if err != nil {
+		return watchusecase.CreateEscrowOutput{}, fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(): %w", err)
+	}
+
+	// reserve of XRP can't be escrowed
+	balance, err := u.rippler.GetBalance(ctx, addr.WalletAddress)
+	if err != nil {
+		return watchusecase.CreateEscrowOutput{}, fmt.Errorf("fail to call rippler.GetBalance(): %w", err)
+	}
+	if balance-xrp.MinimumReserve <= input.Amount {
+		return watchusecase.CreateEscrowOutput{}, errors.New("stored balance is insufficient to escrow")
+	}
+
+	signerList, err := u.rippler.GetSignerList(ctx, addr.WalletAddress)
+	if err != nil {
+		return watchusecase.CreateEscrowOutput{}, fmt.Errorf("fail to call rippler.GetSignerList(): %w", err)
+	}
+
+	txJSON, rawTxString, err := u.rippler.CreateEscrowCreateTransaction(
+		ctx, addr.WalletAddress, addr.WalletAddress, input.Amount,
+		input.FinishAfter, input.CancelAfter, newInstructions(signerList))
+	if err != nil {
+		return watchusecase.CreateEscrowOutput{}, fmt.Errorf(
+			"fail to call rippler.CreateEscrowCreateTransaction(), address: %s: %w", addr.WalletAddress, err)
+	}
+	logger.DebugContext(ctx, "txJSON", "txJSON", txJSON)
+
+	uid, err := u.tx.uuidHandler.GenerateV7()
+	if err != nil {
+		return watchusecase.CreateEscrowOutput{}, fmt.Errorf("fail to call uuidHandler.GenerateV7(): %w", err)
+	}
+	serializedTx, err := serializeTx(uid.String(), rawTxString, signerList)
+	if err != nil {
+		return watchusecase.CreateEscrowOutput{}, err
+	}
+
+	txDetailItem := &models.XRPDetailTX{
+		UUID:               uid.String(),
+		CurrentTXType:      domainTx.TxTypeUnsigned.Int8(),
+		SenderAccount:      account.String(),
+		SenderAddress:      addr.WalletAddress,
+		ReceiverAccount:    account.String(),
+		ReceiverAddress:    addr.WalletAddress,
+		Amount:             txJSON.Amount.Value,
+		XRPTXType:          txJSON.TransactionType,
+		Fee:                txJSON.Fee,
+		Flags:              txJSON.Flags,
+		LastLedgerSequence: txJSON.LastLedgerSequence,
+		Sequence:           txJSON.Sequence,
+		TicketSequence:     txJSON.TicketSequence,
+	}
+	// escrow is identified by ticket instead of sequence if ticket is used
+	offerSequence := txJSON.Sequence
+	if txJSON.TicketSequence != 0 {
+		offerSequence = txJSON.TicketSequence
+	}
+	escrowItem := &models.XRPEscrow{
+		OwnerAddress:       addr.WalletAddress,
+		DestinationAddress: addr.WalletAddress,
+		Amount:             txJSON.Amount.Value,
+		OfferSequence:      offerSequence,
+		FinishAfter:        input.FinishAfter,
+		CreateUUID:         uid.String(),
+	}
+	if !input.CancelAfter.IsZero() {
+		escrowItem.CancelAfter = null.TimeFrom(input.CancelAfter)
+	}
+	// escrow is inserted in the same transaction as EscrowCreate, neither is left without the other
+	txID, err := u.tx.updateDBWith(ctx, domainTx.ActionTypeTransfer, []*models.XRPDetailTX{txDetailItem}, nil,
+		func(dtx *sql.Tx) error {
+			if insertErr := u.escrowRepo.WithTx(dtx).Insert(ctx, escrowItem); insertErr != nil {
+				return fmt.Errorf("fail to call escrowRepo.Insert(): %w", insertErr)
+			}
+			return nil
+		})
+	if err != nil {
+		return watchusecase.CreateEscrowOutput{}, err
+	}
+
+	generatedFileName, err := u.tx.generateHexFile(
+		ctx, domainTx.ActionTypeTransfer, account, txID, []string{serializedTx})
+	if err != nil {
+		return watchusecase.CreateEscrowOutput{}, fmt.Errorf("fail to call generateHexFile(): %w", err)
+	}
+
+	logger.InfoContext(ctx, "EscrowCreate transaction is created",
+		"address", addr.WalletAddress,
+		"amount", input.Amount,
+		"finish_after", input.FinishAfter,
+		"cancel_after", input.CancelAfter,
+		"file", generatedFileName,
+	)
+	return watchusecase.CreateEscrowOutput{FileName: generatedFileName}, nil
+}
@@ -0,0 +1,70 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// fakeEscrowAddrRepo returns address of stored account
+type fakeEscrowAddrRepo struct {
+	watchrepo.AddressRepositorier
+}
+
+func (r *fakeEscrowAddrRepo) GetOneUnAllocated(
+	_ context.Context, accountType domainAccount.AccountType,
+) (*models.Address, error) {
+	return &models.Address{Account: accountType.String(), WalletAddress: escrowOwner}, nil
+}
+
+// TestCreateEscrowExecute is test for escrow recorded together with EscrowCreate
+func TestCreateEscrowExecute(t *testing.T) {
+	input := watchusecase.CreateEscrowInput{
+		Amount:      100,
+		FinishAfter: time.Now().Add(time.Hour),
+	}
+
+	t.Run("escrow is recorded", func(t *testing.T) {
+		detailRepo := &fakeEscrowDetailRepo{}
+		escrowRepo := &fakeEscrowRepo{}
+		fileRepo := &fakeTxFileRepo{}
+		u := NewCreateEscrowUseCase(
+			&fakeEscrowRippler{}, newTestDB(t), uuid.NewGoogleUUIDHandler(), &fakeEscrowAddrRepo{},
+			&fakeTxRepo{action: domainTx.ActionTypeTransfer}, detailRepo, escrowRepo, fileRepo,
+		)
+
+		output, err := u.Execute(context.Background(), input)
+		require.NoError(t, err)
+		assert.NotEmpty(t, output.FileName)
+		require.Len(t, detailRepo.inserted, 1)
+		require.Len(t, escrowRepo.inserted, 1)
+		assert.Equal(t, detailRepo.inserted[0].UUID, escrowRepo.inserted[0].CreateUUID)
+		assert.Equal(t, uint64(10), escrowRepo.inserted[0].OfferSequence)
+		assert.Equal(t, 1, fileRepo.written)
+	})
+
+	t.Run("unsigned file isn't created when escrow can't be recorded", func(t *testing.T) {
+		escrowRepo := &fakeEscrowRepo{insertErr: errors.New("duplicate entry")}
+		fileRepo := &fakeTxFileRepo{}
+		u := NewCreateEscrowUseCase(
+			&fakeEscrowRippler{}, newTestDB(t), uuid.NewGoogleUUIDHandler(), &fakeEscrowAddrRepo{},
+			&fakeTxRepo{action: domainTx.ActionTypeTransfer}, &fakeEscrowDetailRepo{}, escrowRepo, fileRepo,
+		)
+
+		_, err := u.Execute(context.Background(), input)
+		require.Error(t, err)
+		assert.Empty(t, escrowRepo.inserted)
+		assert.Equal(t, 0, fileRepo.written)
+	})
+}
@@ -0,0 +1,153 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/regularkey"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+type createRegularKeyUseCase struct {
+	rippler            ripple.Rippler
+	regularKeyFileRepo file.RegularKeyFileRepositorier
+	setup              *accountSetup
+}
+
+// NewCreateRegularKeyUseCase creates a new CreateRegularKeyUseCase
+//   - file is pending regular keys exported by keygen wallet, SetRegularKey is created for address of each line
+//   - regular key which is already found in ledger is written to validated file instead,
+//     keygen wallet imports it to sign by regular key
+func NewCreateRegularKeyUseCase(
+	rippler ripple.Rippler,
+	dbConn *sql.DB,
+	uuidHandler uuid.UUIDHandler,
+	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+	regularKeyFileRepo file.RegularKeyFileRepositorier,
+) watchusecase.CreateRegularKeyUseCase {
+	return &createRegularKeyUseCase{
+		rippler:            rippler,
+		regularKeyFileRepo: regularKeyFileRepo,
+		setup:              newAccountSetup(rippler, dbConn, uuidHandler, addrRepo, txRepo, txDetailRepo, txFileRepo),
+	}
+}
+
+func (u *createRegularKeyUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.CreateRegularKeyInput,
+) (_ watchusecase.CreateRegularKeyOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.CreateRegularKey.Execute")
+	defer tracer.End(span, &err)
+
+	accountType, regularKeys, err := u.readRegularKeys(ctx, input.FileName)
+	if err != nil {
+		return watchusecase.CreateRegularKeyOutput{}, err
+	}
+
+	accountAddrs, err := u.setup.tx.addrRepo.GetAllAddress(ctx, accountType)
+	if err != nil {
+		return watchusecase.CreateRegularKeyOutput{}, fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+	}
+	addrs := slices.Sorted(maps.Keys(regularKeys))
+	for _, addr := range addrs {
+		if !slices.Contains(accountAddrs, addr) {
+			return watchusecase.CreateRegularKeyOutput{},
+				fmt.Errorf("%s is not address of %s account", addr, accountType.String())
+		}
+	}
+
+	var validatedLines []string
+	generatedFileName, err := u.setup.create(ctx, accountType, addrs,
+		func(ctx context.Context, addr string) (*xrp.TxInput, string, error) {
+			regularKey, err := u.rippler.GetRegularKey(ctx, addr)
+			if err != nil {
+				// account which isn't funded yet is not found
+				logger.WarnContext(ctx, "fail to call rippler.GetRegularKey()", "address", addr, "error", err)
+				return nil, "", nil
+			}
+			if regularKey == regularKeys[addr] {
+				validatedLines = append(validatedLines,
+					regularkey.CreateLine(u.rippler.CoinTypeCode(), accountType, addr, regularKey))
+				return nil, "", nil
+			}
+			txJSON, rawTxString, err := u.rippler.CreateSetRegularKeyTransaction(
+				ctx, addr, regularKeys[addr], newInstructions(nil))
+			if err != nil {
+				return nil, "", fmt.Errorf(
+					"fail to call rippler.CreateSetRegularKeyTransaction(), address: %s: %w", addr, err)
+			}
+			return txJSON, rawTxString, nil
+		})
+	if err != nil {
+		return watchusecase.CreateRegularKeyOutput{}, err
+	}
+
+	var validatedFileName string
+	if len(validatedLines) != 0 {
+		validatedFileName = u.regularKeyFileRepo.CreateFilePath(accountType, true)
+		if err = u.regularKeyFileRepo.WriteFile(ctx, validatedFileName, validatedLines); err != nil {
+			return watchusecase.CreateRegularKeyOutput{},
+				fmt.Errorf("fail to call regularKeyFileRepo.WriteFile(): %w", err)
+		}
+	}
+
+	logger.InfoContext(ctx, "regular keys are processed",
+		"account_type", accountType.String(),
+		"file", generatedFileName,
+		"validated_file", validatedFileName,
+	)
+	return watchusecase.CreateRegularKeyOutput{
+		FileName:          generatedFileName,
+		ValidatedFileName: validatedFileName,
+	}, nil
+}
+
+// readRegularKeys returns account and regular key of each address from file
+//   - all addresses must belong to same account because unsigned file has one sender account
+func (u *createRegularKeyUseCase) readRegularKeys(
+	ctx context.Context, fileName string,
+) (domainAccount.AccountType, map[string]string, error) {
+	lines, err := u.regularKeyFileRepo.ReadFile(ctx, fileName)
+	if err != nil {
+		return "", nil, fmt.Errorf("fail to call regularKeyFileRepo.ReadFile(): %w", err)
+	}
+	if len(lines) == 0 {
+		return "", nil, errors.New("regular key file is empty")
+	}
+
+	var accountType domainAccount.AccountType
+	regularKeys := make(map[string]string, len(lines))
+	for _, line := range lines {
+		rk, err := regularkey.ConvertLine(u.rippler.CoinTypeCode(), strings.Split(line, ","))
+		if err != nil {
+			return "", nil, err
+		}
+		if accountType == "" {
+			accountType = rk.AccountType
+		}
+		if rk.AccountType != accountType {
+			return "", nil, fmt.Errorf("account of file must be one, got %s and %s", accountType, rk.AccountType)
+		}
+		if !xrp.ValidateAddress(rk.RegularKey) {
+			return "", nil, fmt.Errorf("regular key of %s is invalid: %s", rk.Address, rk.RegularKey)
+		}
+		regularKeys[rk.Address] = rk.RegularKey
+	}
+	return accountType, regularKeys, nil
+}
@@ -0,0 +1,191 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// fakeRegularKeyRippler returns regular key and master key flag of address in ledger
+type fakeRegularKeyRippler struct {
+	ripple.Rippler
+	// regularKeys is regular key of address, address which isn't funded isn't in it
+	regularKeys map[string]string
+	// masterDisabled is address whose master key is disabled
+	masterDisabled map[string]bool
+}
+
+func (r *fakeRegularKeyRippler) CoinTypeCode() domainCoin.CoinTypeCode {
+	return domainCoin.XRP
+}
+
+func (r *fakeRegularKeyRippler) GetRegularKey(_ context.Context, addr string) (string, error) {
+	regularKey, ok := r.regularKeys[addr]
+	if !ok {
+		return "", errors.New("actNotFound")
+	}
+	return regularKey, nil
+}
+
+func (r *fakeRegularKeyRippler) IsMasterDisabled(_ context.Context, addr string) (bool, error) {
+	if _, ok := r.regularKeys[addr]; !ok {
+		return false, errors.New("actNotFound")
+	}
+	return r.masterDisabled[addr], nil
+}
+
+func (r *fakeRegularKeyRippler) CreateSetRegularKeyTransaction(
+	_ context.Context, account, regularKey string, _ *xrp.Instructions,
+) (*xrp.TxInput, string, error) {
+	return &xrp.TxInput{
+		TransactionType: "SetRegularKey", Account: account, RegularKey: regularKey, Sequence: 10,
+	}, "{}", nil
+}
+
+func (r *fakeRegularKeyRippler) CreateAccountSetTransaction(
+	_ context.Context, account string, setFlag uint32, _ *xrp.Instructions,
+) (*xrp.TxInput, string, error) {
+	return &xrp.TxInput{TransactionType: "AccountSet", Account: account, SetFlag: setFlag, Sequence: 10}, "{}", nil
+}
+
+// fakeRegularKeyFileRepo keeps regular key files in memory
+type fakeRegularKeyFileRepo struct {
+	file.RegularKeyFileRepositorier
+	files map[string][]string
+}
+
+func (r *fakeRegularKeyFileRepo) CreateFilePath(accountType domainAccount.AccountType, isValidated bool) string {
+	if isValidated {
+		return "regularkey_validated_" + accountType.String()
+	}
+	return "regularkey_" + accountType.String()
+}
+
+func (r *fakeRegularKeyFileRepo) WriteFile(_ context.Context, fileName string, lines []string) error {
+	r.files[fileName] = lines
+	return nil
+}
+
+func (r *fakeRegularKeyFileRepo) ReadFile(_ context.Context, fileName string) ([]string, error) {
+	lines, ok := r.files[fileName]
+	if !ok {
+		return nil, errors.New("file is not found")
+	}
+	return lines, nil
+}
+
+// TestCreateRegularKeyExecute is test for SetRegularKey created only for pending key which isn't in ledger
+func TestCreateRegularKeyExecute(t *testing.T) {
+	rippler := &fakeRegularKeyRippler{regularKeys: map[string]string{
+		"rValidated": signer1,
+		"rNotSet":    "",
+	}}
+
+	tests := []struct {
+		name          string
+		lines         []string
+		wantErr       bool
+		wantCreated   []string
+		wantValidated []string
+	}{
+		{
+			name: "pending key in ledger is validated, others are set",
+			lines: []string{
+				"xrp,payment,rValidated," + signer1,
+				"xrp,payment,rNotSet," + signer2,
+				"xrp,payment,rUnfunded," + signer2,
+			},
+			wantCreated:   []string{"rNotSet"},
+			wantValidated: []string{"xrp,payment,rValidated," + signer1},
+		},
+		{
+			name:        "key which differs from ledger is set again",
+			lines:       []string{"xrp,payment,rValidated," + signer2},
+			wantCreated: []string{"rValidated"},
+		},
+		{
+			name:    "address isn't address of account",
+			lines:   []string{"xrp,payment,rOther," + signer1},
+			wantErr: true,
+		},
+		{
+			name: "addresses of different accounts",
+			lines: []string{
+				"xrp,payment,rNotSet," + signer1,
+				"xrp,deposit,rValidated," + signer1,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detailRepo := &fakeEscrowDetailRepo{}
+			fileRepo := &fakeTxFileRepo{}
+			regularKeyFileRepo := &fakeRegularKeyFileRepo{files: map[string][]string{"pending.csv": tt.lines}}
+			u := NewCreateRegularKeyUseCase(
+				rippler, newTestDB(t), uuid.NewGoogleUUIDHandler(),
+				&fakeSetupAddrRepo{addrs: []string{"rValidated", "rNotSet", "rUnfunded"}},
+				&fakeTxRepo{action: domainTx.ActionTypeTransfer}, detailRepo, fileRepo, regularKeyFileRepo,
+			)
+
+			output, err := u.Execute(context.Background(), watchusecase.CreateRegularKeyInput{FileName: "pending.csv"})
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, 0, fileRepo.written)
+				return
+			}
+			require.NoError(t, err)
+
+			created := make([]string, 0, len(detailRepo.inserted))
+			for _, item := range detailRepo.inserted {
+				assert.Equal(t, "SetRegularKey", item.XRPTXType)
+				created = append(created, item.SenderAddress)
+			}
+			assert.ElementsMatch(t, tt.wantCreated, created)
+			assert.Equal(t, len(tt.wantCreated) != 0, output.FileName != "")
+			assert.Equal(t, len(tt.wantValidated) != 0, output.ValidatedFileName != "")
+			assert.Equal(t, tt.wantValidated, regularKeyFileRepo.files[output.ValidatedFileName])
+		})
+	}
+}
+
+// TestCreateDisableMasterExecute is test for AccountSet created only for address which has regular key
+func TestCreateDisableMasterExecute(t *testing.T) {
+	rippler := &fakeRegularKeyRippler{
+		regularKeys: map[string]string{
+			"rDisabled":  signer1,
+			"rRegular":   signer1,
+			"rNoRegular": "",
+		},
+		masterDisabled: map[string]bool{"rDisabled": true},
+	}
+
+	detailRepo := &fakeEscrowDetailRepo{}
+	fileRepo := &fakeTxFileRepo{}
+	u := NewCreateDisableMasterUseCase(
+		rippler, newTestDB(t), uuid.NewGoogleUUIDHandler(),
+		&fakeSetupAddrRepo{addrs: []string{"rDisabled", "rRegular", "rNoRegular", "rUnfunded"}},
+		&fakeTxRepo{action: domainTx.ActionTypeTransfer}, detailRepo, fileRepo,
+	)
+
+	output, err := u.Execute(context.Background(), watchusecase.CreateDisableMasterInput{
+		AccountType: domainAccount.AccountTypePayment,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, output.FileName)
+	require.Len(t, detailRepo.inserted, 1)
+	assert.Equal(t, "rRegular", detailRepo.inserted[0].SenderAddress)
+	assert.Equal(t, "AccountSet", detailRepo.inserted[0].XRPTXType)
+}
@@ -0,0 +1,85 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+type createRequireDestUseCase struct {
+	rippler ripple.Rippler
+	setup   *accountSetup
+}
+
+// NewCreateRequireDestUseCase creates a new CreateRequireDestUseCase
+//   - AccountSet transaction with asfRequireDest is created for addresses which don't require destination tag yet
+//   - once it's sent, payment without destination tag is rejected by ledger
+func NewCreateRequireDestUseCase(
+	rippler ripple.Rippler,
+	dbConn *sql.DB,
+	uuidHandler uuid.UUIDHandler,
+	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+) watchusecase.CreateRequireDestUseCase {
+	return &createRequireDestUseCase{
+		rippler: rippler,
+		setup:   newAccountSetup(rippler, dbConn, uuidHandler, addrRepo, txRepo, txDetailRepo, txFileRepo),
+	}
+}
+
+func (u *createRequireDestUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.CreateRequireDestInput,
+) (_ watchusecase.CreateRequireDestOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.CreateRequireDest.Execute")
+	defer tracer.End(span, &err)
+
+	addrs, err := u.setup.tx.addrRepo.GetAllAddress(ctx, input.AccountType)
+	if err != nil {
+		return watchusecase.CreateRequireDestOutput{}, fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+	}
+
+	generatedFileName, err := u.setup.create(ctx, input.AccountType, addrs,
+		func(ctx context.Context, addr string) (*xrp.TxInput, string, error) {
+			isRequireDest, err := u.rippler.IsRequireDest(ctx, addr)
+			if err != nil {
+				// account which isn't funded yet is not found
+				logger.WarnContext(ctx, "fail to call rippler.IsRequireDest()", "address", addr, "error", err)
+				return nil, "", nil
+			}
+			if isRequireDest {
+				return nil, "", nil
+			}
+			txJSON, rawTxString, err := u.rippler.CreateAccountSetTransaction(
+				ctx, addr, xrp.AsfRequireDest, newInstructions(nil))
+			if err != nil {
+				return nil, "", fmt.Errorf(
+					"fail to call rippler.CreateAccountSetTransaction(), address: %s: %w", addr, err)
+			}
+			return txJSON, rawTxString, nil
+		})
+	if err != nil {
+		return watchusecase.CreateRequireDestOutput{}, err
+	}
+	if generatedFileName == "" {
+		logger.InfoContext(ctx, "no address to require destination tag", "account_type", input.AccountType.String())
+		return watchusecase.CreateRequireDestOutput{}, nil
+	}
+
+	logger.InfoContext(ctx, "AccountSet transactions are created",
+		"account_type", input.AccountType.String(),
+		"file", generatedFileName,
+	)
+	return watchusecase.CreateRequireDestOutput{FileName: generatedFileName}, nil
+}
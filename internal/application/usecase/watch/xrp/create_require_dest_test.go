@@ -0,0 +1,91 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// fakeRequireDestRippler returns asfRequireDest flag of address in ledger
+type fakeRequireDestRippler struct {
+	ripple.Rippler
+	// requireDest is flag of address, address which isn't funded isn't in it
+	requireDest map[string]bool
+}
+
+func (r *fakeRequireDestRippler) CoinTypeCode() domainCoin.CoinTypeCode {
+	return domainCoin.XRP
+}
+
+func (r *fakeRequireDestRippler) IsRequireDest(_ context.Context, addr string) (bool, error) {
+	isRequireDest, ok := r.requireDest[addr]
+	if !ok {
+		return false, errors.New("actNotFound")
+	}
+	return isRequireDest, nil
+}
+
+func (r *fakeRequireDestRippler) CreateAccountSetTransaction(
+	_ context.Context, account string, _ uint32, _ *xrp.Instructions,
+) (*xrp.TxInput, string, error) {
+	return &xrp.TxInput{TransactionType: "AccountSet", Account: account, Sequence: 10}, "{}", nil
+}
+
+// TestCreateRequireDestExecute is test for AccountSet created only for address which doesn't require destination tag
+func TestCreateRequireDestExecute(t *testing.T) {
+	rippler := &fakeRequireDestRippler{requireDest: map[string]bool{
+		"rRequired":    true,
+		"rNotRequired": false,
+	}}
+
+	tests := []struct {
+		name        string
+		addrs       []string
+		wantCreated []string
+	}{
+		{
+			name:        "address which doesn't require destination tag is set",
+			addrs:       []string{"rRequired", "rNotRequired", "rUnfunded"},
+			wantCreated: []string{"rNotRequired"},
+		},
+		{
+			name:  "nothing is created when destination tag is already required",
+			addrs: []string{"rRequired", "rUnfunded"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detailRepo := &fakeEscrowDetailRepo{}
+			fileRepo := &fakeTxFileRepo{}
+			u := NewCreateRequireDestUseCase(
+				rippler, newTestDB(t), uuid.NewGoogleUUIDHandler(), &fakeSetupAddrRepo{addrs: tt.addrs},
+				&fakeTxRepo{action: domainTx.ActionTypeTransfer}, detailRepo, fileRepo,
+			)
+
+			output, err := u.Execute(context.Background(), watchusecase.CreateRequireDestInput{
+				AccountType: domainAccount.AccountTypeDeposit,
+			})
+			require.NoError(t, err)
+
+			created := make([]string, 0, len(detailRepo.inserted))
+			for _, item := range detailRepo.inserted {
+				assert.Equal(t, "AccountSet", item.XRPTXType)
+				created = append(created, item.SenderAddress)
+			}
+			assert.ElementsMatch(t, tt.wantCreated, created)
+			assert.Equal(t, len(tt.wantCreated) != 0, output.FileName != "")
+			assert.Equal(t, min(len(tt.wantCreated), 1), fileRepo.written)
+		})
+	}
+}
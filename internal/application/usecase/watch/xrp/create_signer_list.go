@@ -0,0 +1,166 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/config/account"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/fullpubkey"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+type createSignerListUseCase struct {
+	rippler         ripple.Rippler
+	pubkeyFileRepo  file.AddressFileRepositorier
+	multisigAccount account.MultisigAccounter
+	setup           *accountSetup
+}
+
+// NewCreateSignerListUseCase creates a new CreateSignerListUseCase
+//   - address itself is not changed on XRP, SignerListSet makes addresses of account multisig account
+//   - signers are auth accounts in full-pubkey files exported by sign wallets, quorum is from account config
+//   - address whose SignerList in ledger is already same is skipped, address must be funded beforehand
+func NewCreateSignerListUseCase(
+	rippler ripple.Rippler,
+	dbConn *sql.DB,
+	uuidHandler uuid.UUIDHandler,
+	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+	pubkeyFileRepo file.AddressFileRepositorier,
+	multisigAccount account.MultisigAccounter,
+) watchusecase.CreateSignerListUseCase {
+	return &createSignerListUseCase{
+		rippler:         rippler,
+		pubkeyFileRepo:  pubkeyFileRepo,
+		multisigAccount: multisigAccount,
+		setup:           newAccountSetup(rippler, dbConn, uuidHandler, addrRepo, txRepo, txDetailRepo, txFileRepo),
+	}
+}
+
+func (u *createSignerListUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.CreateSignerListInput,
+) (_ watchusecase.CreateSignerListOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.CreateSignerList.Execute")
+	defer tracer.End(span, &err)
+
+	if !u.multisigAccount.IsMultisigAccount(input.AccountType) {
+		return watchusecase.CreateSignerListOutput{},
+			fmt.Errorf("%s is not multisig account in account config", input.AccountType.String())
+	}
+
+	signerAccounts, err := u.readSigners(ctx, input.FileNames)
+	if err != nil {
+		return watchusecase.CreateSignerListOutput{}, err
+	}
+
+	// quorum and signers of account
+	var quorum uint32
+	var signers []string
+	for sigCount, authTypes := range u.multisigAccount.MultiAccounts()[input.AccountType] {
+		quorum = uint32(sigCount)
+		for _, authType := range authTypes {
+			signer, ok := signerAccounts[authType]
+			if !ok {
+				return watchusecase.CreateSignerListOutput{},
+					fmt.Errorf("full-pubkey file of %s is required", authType.String())
+			}
+			signers = append(signers, signer)
+		}
+	}
+
+	addrs, err := u.setup.tx.addrRepo.GetAllAddress(ctx, input.AccountType)
+	if err != nil {
+		return watchusecase.CreateSignerListOutput{}, fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+	}
+
+	generatedFileName, err := u.setup.create(ctx, input.AccountType, addrs,
+		func(ctx context.Context, addr string) (*xrp.TxInput, string, error) {
+			signerList, err := u.rippler.GetSignerList(ctx, addr)
+			if err != nil {
+				// account which isn't funded yet is not found
+				logger.WarnContext(ctx, "fail to call rippler.GetSignerList()", "address", addr, "error", err)
+				return nil, "", nil
+			}
+			if isSameSignerList(signerList, quorum, signers) {
+				return nil, "", nil
+			}
+			txJSON, rawTxString, err := u.rippler.CreateSignerListSetTransaction(
+				ctx, addr, quorum, signers, newInstructions(nil))
+			if err != nil {
+				return nil, "", fmt.Errorf(
+					"fail to call rippler.CreateSignerListSetTransaction(), address: %s: %w", addr, err)
+			}
+			return txJSON, rawTxString, nil
+		})
+	if err != nil {
+		return watchusecase.CreateSignerListOutput{}, err
+	}
+	if generatedFileName == "" {
+		logger.InfoContext(ctx, "no address to set signer list", "account_type", input.AccountType.String())
+		return watchusecase.CreateSignerListOutput{}, nil
+	}
+
+	logger.InfoContext(ctx, "SignerListSet transactions are created",
+		"account_type", input.AccountType.String(),
+		"quorum", quorum,
+		"signers", len(signers),
+		"file", generatedFileName,
+	)
+	return watchusecase.CreateSignerListOutput{FileName: generatedFileName}, nil
+}
+
+// readSigners returns XRP account of each auth account from full-pubkey files
+func (u *createSignerListUseCase) readSigners(
+	ctx context.Context, fileNames []string,
+) (map[domainAccount.AuthType]string, error) {
+	if len(fileNames) == 0 {
+		return nil, errors.New("full-pubkey files of signers are required")
+	}
+
+	signerAccounts := make(map[domainAccount.AuthType]string)
+	for _, fileName := range fileNames {
+		lines, err := u.pubkeyFileRepo.ImportAddress(ctx, fileName)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call pubkeyFileRepo.ImportAddress() fileName: %s: %w", fileName, err)
+		}
+		for _, line := range lines {
+			fpk, err := fullpubkey.ConvertLine(u.rippler.CoinTypeCode(), strings.Split(line, ","))
+			if err != nil {
+				return nil, err
+			}
+			if !xrp.ValidateAddress(fpk.FullPubKey) {
+				return nil, fmt.Errorf("account of %s is invalid: %s", fpk.AuthType.String(), fpk.FullPubKey)
+			}
+			signerAccounts[fpk.AuthType] = fpk.FullPubKey
+		}
+	}
+	return signerAccounts, nil
+}
+
+// isSameSignerList returns true if SignerList in ledger has same quorum and signers whose weight is 1
+func isSameSignerList(signerList *xrp.SignerList, quorum uint32, signers []string) bool {
+	if signerList == nil || signerList.SignerQuorum != quorum || len(signerList.SignerEntries) != len(signers) {
+		return false
+	}
+	for signer, weight := range signerList.Weights() {
+		if weight != 1 || !slices.Contains(signers, signer) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,228 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/config/account"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+const (
+	signer1 = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	signer2 = "rf1BiGeXwwQoi8Z2ueFYTEXSwuJYfV2Jpn"
+)
+
+// fakeSignerListRippler returns SignerList of address in ledger
+type fakeSignerListRippler struct {
+	ripple.Rippler
+	// signerLists is SignerList of address, address which isn't funded isn't in it
+	signerLists map[string]*xrp.SignerList
+}
+
+func (r *fakeSignerListRippler) CoinTypeCode() domainCoin.CoinTypeCode {
+	return domainCoin.XRP
+}
+
+func (r *fakeSignerListRippler) GetSignerList(_ context.Context, addr string) (*xrp.SignerList, error) {
+	signerList, ok := r.signerLists[addr]
+	if !ok {
+		return nil, errors.New("actNotFound")
+	}
+	return signerList, nil
+}
+
+func (r *fakeSignerListRippler) CreateSignerListSetTransaction(
+	_ context.Context, account string, _ uint32, _ []string, _ *xrp.Instructions,
+) (*xrp.TxInput, string, error) {
+	return &xrp.TxInput{TransactionType: "SignerListSet", Account: account, Sequence: 10}, "{}", nil
+}
+
+// fakeSetupAddrRepo returns addresses of account
+type fakeSetupAddrRepo struct {
+	watchrepo.AddressRepositorier
+	addrs []string
+}
+
+func (r *fakeSetupAddrRepo) GetAllAddress(_ context.Context, _ domainAccount.AccountType) ([]string, error) {
+	return r.addrs, nil
+}
+
+// fakePubkeyFileRepo returns lines of full-pubkey file
+type fakePubkeyFileRepo struct {
+	file.AddressFileRepositorier
+	lines map[string][]string
+}
+
+func (r *fakePubkeyFileRepo) ImportAddress(_ context.Context, fileName string) ([]string, error) {
+	lines, ok := r.lines[fileName]
+	if !ok {
+		return nil, errors.New("file is not found")
+	}
+	return lines, nil
+}
+
+func newSignerList(quorum uint32, weights map[string]uint16) *xrp.SignerList {
+	signerList := &xrp.SignerList{SignerQuorum: quorum}
+	for signer, weight := range weights {
+		signerList.SignerEntries = append(signerList.SignerEntries, xrp.SignerEntry{
+			SignerEntry: xrp.SignerEntryData{Account: signer, SignerWeight: weight},
+		})
+	}
+	return signerList
+}
+
+func TestIsSameSignerList(t *testing.T) {
+	signers := []string{signer1, signer2}
+
+	tests := []struct {
+		name       string
+		signerList *xrp.SignerList
+		want       bool
+	}{
+		{
+			name:       "no signer list",
+			signerList: nil,
+			want:       false,
+		},
+		{
+			name:       "same signers and quorum",
+			signerList: newSignerList(2, map[string]uint16{signer2: 1, signer1: 1}),
+			want:       true,
+		},
+		{
+			name:       "different quorum",
+			signerList: newSignerList(1, map[string]uint16{signer1: 1, signer2: 1}),
+			want:       false,
+		},
+		{
+			name:       "different signer",
+			signerList: newSignerList(2, map[string]uint16{signer1: 1, escrowOwner: 1}),
+			want:       false,
+		},
+		{
+			name:       "different weight",
+			signerList: newSignerList(2, map[string]uint16{signer1: 2, signer2: 1}),
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isSameSignerList(tt.signerList, 2, signers))
+		})
+	}
+}
+
+// TestCreateSignerListExecute is test for SignerListSet created only for address which needs it
+func TestCreateSignerListExecute(t *testing.T) {
+	const (
+		sameAddr     = "rSame"
+		changedAddr  = "rChanged"
+		unfundedAddr = "rUnfunded"
+	)
+	multisigAccount := account.NewMultisigAccounts([]account.AccountMultisig{
+		{
+			Type:      domainAccount.AccountTypePayment,
+			Required:  2,
+			AuthUsers: []domainAccount.AuthType{domainAccount.AuthType1, domainAccount.AuthType2},
+		},
+	})
+	pubkeyFileRepo := &fakePubkeyFileRepo{lines: map[string][]string{
+		"auth1.csv": {"xrp,auth1," + signer1},
+		"auth2.csv": {"xrp,auth2," + signer2},
+	}}
+	rippler := &fakeSignerListRippler{signerLists: map[string]*xrp.SignerList{
+		sameAddr:    newSignerList(2, map[string]uint16{signer1: 1, signer2: 1}),
+		changedAddr: newSignerList(1, map[string]uint16{signer1: 1}),
+	}}
+
+	tests := []struct {
+		name        string
+		input       watchusecase.CreateSignerListInput
+		addrs       []string
+		wantErr     bool
+		wantCreated []string
+	}{
+		{
+			name: "address whose signer list differs is set",
+			input: watchusecase.CreateSignerListInput{
+				AccountType: domainAccount.AccountTypePayment,
+				FileNames:   []string{"auth1.csv", "auth2.csv"},
+			},
+			addrs:       []string{sameAddr, changedAddr, unfundedAddr},
+			wantCreated: []string{changedAddr},
+		},
+		{
+			name: "nothing is created when signer lists are same",
+			input: watchusecase.CreateSignerListInput{
+				AccountType: domainAccount.AccountTypePayment,
+				FileNames:   []string{"auth1.csv", "auth2.csv"},
+			},
+			addrs: []string{sameAddr},
+		},
+		{
+			name: "full-pubkey file of auth account is missing",
+			input: watchusecase.CreateSignerListInput{
+				AccountType: domainAccount.AccountTypePayment,
+				FileNames:   []string{"auth1.csv"},
+			},
+			addrs:   []string{changedAddr},
+			wantErr: true,
+		},
+		{
+			name: "account isn't multisig",
+			input: watchusecase.CreateSignerListInput{
+				AccountType: domainAccount.AccountTypeDeposit,
+				FileNames:   []string{"auth1.csv", "auth2.csv"},
+			},
+			addrs:   []string{changedAddr},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detailRepo := &fakeEscrowDetailRepo{}
+			fileRepo := &fakeTxFileRepo{}
+			u := NewCreateSignerListUseCase(
+				rippler, newTestDB(t), uuid.NewGoogleUUIDHandler(), &fakeSetupAddrRepo{addrs: tt.addrs},
+				&fakeTxRepo{action: domainTx.ActionTypeTransfer}, detailRepo, fileRepo,
+				pubkeyFileRepo, multisigAccount,
+			)
+
+			output, err := u.Execute(context.Background(), tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, 0, fileRepo.written)
+				return
+			}
+			require.NoError(t, err)
+
+			created := make([]string, 0, len(detailRepo.inserted))
+			for _, item := range detailRepo.inserted {
+				assert.Equal(t, "SignerListSet", item.XRPTXType)
+				assert.Equal(t, item.SenderAddress, item.ReceiverAddress)
+				created = append(created, item.SenderAddress)
+			}
+			assert.ElementsMatch(t, tt.wantCreated, created)
+			if len(tt.wantCreated) == 0 {
+				assert.Empty(t, output.FileName)
+				assert.Equal(t, 0, fileRepo.written)
+				return
+			}
+			assert.NotEmpty(t, output.FileName)
+			assert.Equal(t, 1, fileRepo.written)
+		})
+	}
+}
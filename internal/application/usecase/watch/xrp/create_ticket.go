@@ -0,0 +1,141 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+type createTicketUseCase struct {
+	rippler ripple.Rippler
+	// tx shares recording of xrp_detail_tx and writing of unsigned transaction file
+	tx *createTransactionUseCase
+}
+
+// NewCreateTicketUseCase creates a new CreateTicketUseCase
+//   - TicketCreate goes through the same offline signing as transfer, multisig account is signed by sign wallets
+//   - tickets are assigned to payment transactions by CreateTransactionUseCase once TicketCreate is validated
+func NewCreateTicketUseCase(
+	rippler ripple.Rippler,
+	dbConn *sql.DB,
+	uuidHandler uuid.UUIDHandler,
+	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+) watchusecase.CreateTicketUseCase {
+	return &createTicketUseCase{
+		rippler: rippler,
+		tx: &createTransactionUseCase{
+			rippler:      rippler,
+			dbConn:       dbConn,
+			uuidHandler:  uuidHandler,
+			addrRepo:     addrRepo,
+			txRepo:       txRepo,
+			txDetailRepo: txDetailRepo,
+			txFileRepo:   txFileRepo,
+		},
+	}
+}
+
+func (u *createTicketUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.CreateTicketInput,
+) (_ watchusecase.CreateTicketOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.CreateTicket.Execute")
+	defer tracer.End(span, &err)
+
+	if input.AccountType == domainAccount.AccountTypeClient ||
+		input.AccountType == domainAccount.AccountTypeAuthorization {
+		return watchusecase.CreateTicketOutput{}, errors.New("client, authorization account is not allowed")
+	}
+	if input.Count == 0 {
+		return watchusecase.CreateTicketOutput{}, errors.New("count is required")
+	}
+
+	addr, err := u.tx.addrRepo.GetOneUnAllocated(ctx, input.AccountType)
+	if err != nil {
+		return watchusecase.CreateTicketOutput{}, fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(): %w", err)
+	}
+
+	// account can hold up to 250 tickets including unused ones
+	tickets, err := u.rippler.GetTickets(ctx, addr.WalletAddress)
+	if err != nil {
+		return watchusecase.CreateTicketOutput{}, fmt.Errorf("fail to call rippler.GetTickets(): %w", err)
+	}
+	if len(tickets)+int(input.Count) > xrp.MaxTicketCount {
+		return watchusecase.CreateTicketOutput{}, fmt.Errorf(
+			"%s already holds %d tickets, up to %d tickets can be held",
+			addr.WalletAddress, len(tickets), xrp.MaxTicketCount)
+	}
+
+	signerList, err := u.rippler.GetSignerList(ctx, addr.WalletAddress)
+	if err != nil {
+		return watchusecase.CreateTicketOutput{}, fmt.Errorf("fail to call rippler.GetSignerList(): %w", err)
+	}
+
+	// TicketCreate itself consumes sequence
+	txJSON, rawTxString, err := u.rippler.CreateTicketCreateTransaction(
+		ctx, addr.WalletAddress, input.Count, newInstructions(signerList))
+	if err != nil {
+		return watchusecase.CreateTicketOutput{}, fmt.Errorf(
+			"fail to call rippler.CreateTicketCreateTransaction(), address: %s: %w", addr.WalletAddress, err)
+	}
+	logger.DebugContext(ctx, "txJSON", "txJSON", txJSON)
+
+	uid, err := u.tx.uuidHandler.GenerateV7()
+	if err != nil {
+		return watchusecase.CreateTicketOutput{}, fmt.Errorf("fail to call uuidHandler.GenerateV7(): %w", err)
+	}
+	serializedTx, err := serializeTx(uid.String(), rawTxString, signerList)
+	if err != nil {
+		return watchusecase.CreateTicketOutput{}, err
+	}
+
+	// account sends TicketCreate to itself without amount
+	txDetailItem := &models.XRPDetailTX{
+		UUID:               uid.String(),
+		CurrentTXType:      domainTx.TxTypeUnsigned.Int8(),
+		SenderAccount:      input.AccountType.String(),
+		SenderAddress:      addr.WalletAddress,
+		ReceiverAccount:    input.AccountType.String(),
+		ReceiverAddress:    addr.WalletAddress,
+		Amount:             "0",
+		XRPTXType:          txJSON.TransactionType,
+		Fee:                txJSON.Fee,
+		Flags:              txJSON.Flags,
+		LastLedgerSequence: txJSON.LastLedgerSequence,
+		Sequence:           txJSON.Sequence,
+	}
+	txID, err := u.tx.updateDB(ctx, domainTx.ActionTypeTransfer, []*models.XRPDetailTX{txDetailItem}, nil)
+	if err != nil {
+		return watchusecase.CreateTicketOutput{}, err
+	}
+
+	generatedFileName, err := u.tx.generateHexFile(
+		ctx, domainTx.ActionTypeTransfer, input.AccountType, txID, []string{serializedTx})
+	if err != nil {
+		return watchusecase.CreateTicketOutput{}, fmt.Errorf("fail to call generateHexFile(): %w", err)
+	}
+
+	logger.InfoContext(ctx, "TicketCreate transaction is created",
+		"account_type", input.AccountType.String(),
+		"address", addr.WalletAddress,
+		"count", input.Count,
+		"file", generatedFileName,
+	)
+	return watchusecase.CreateTicketOutput{FileName: generatedFileName}, nil
+}
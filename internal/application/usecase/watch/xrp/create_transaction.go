@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 
 	"github.com/bookerzzz/grok"
@@ -18,6 +19,9 @@ import (
 	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/serial"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
 )
 
@@ -64,7 +68,10 @@ func NewCreateTransactionUseCase(
 func (u *createTransactionUseCase) Execute(
 	ctx context.Context,
 	input watchusecase.CreateTransactionInput,
-) (watchusecase.CreateTransactionOutput, error) {
+) (_ watchusecase.CreateTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.CreateTransaction.Execute")
+	defer tracer.End(span, &err)
+
 	// Convert action type string to domain type
 	actionType := domainTx.ActionType(input.ActionType)
 	if !domainTx.ValidateActionType(input.ActionType) {
@@ -102,7 +109,7 @@ func (u *createTransactionUseCase) createDepositTx(ctx context.Context) (string,
 	sender := domainAccount.AccountTypeClient
 	receiver := u.depositReceiver
 	targetAction := domainTx.ActionTypeDeposit
-	logger.Debug("account",
+	logger.DebugContext(ctx, "account",
 		"sender", sender.String(),
 		"receiver", receiver.String(),
 	)
@@ -112,7 +119,7 @@ func (u *createTransactionUseCase) createDepositTx(ctx context.Context) (string,
 		return "", err
 	}
 	if len(userAmounts) == 0 {
-		logger.Info("no data")
+		logger.InfoContext(ctx, "no data")
 		return "", nil
 	}
 
@@ -124,7 +131,7 @@ func (u *createTransactionUseCase) createDepositTx(ctx context.Context) (string,
 		return "", nil
 	}
 
-	txID, err := u.updateDB(targetAction, txDetailItems, nil)
+	txID, err := u.updateDB(ctx, targetAction, txDetailItems, nil)
 	if err != nil {
 		return "", err
 	}
@@ -132,7 +139,7 @@ func (u *createTransactionUseCase) createDepositTx(ctx context.Context) (string,
 	// save transaction result to file
 	var generatedFileName string
 	if len(serializedTxs) != 0 {
-		generatedFileName, err = u.generateHexFile(targetAction, sender, txID, serializedTxs)
+		generatedFileName, err = u.generateHexFile(ctx, targetAction, sender, txID, serializedTxs)
 		if err != nil {
 			return "", fmt.Errorf("fail to call generateHexFile(): %w", err)
 		}
@@ -151,38 +158,48 @@ func (u *createTransactionUseCase) createPaymentTx(ctx context.Context) (string,
 	sender := u.paymentSender
 	receiver := domainAccount.AccountTypeAnonymous
 	targetAction := domainTx.ActionTypePayment
-	logger.Debug("account",
+	logger.DebugContext(ctx, "account",
 		"sender", sender.String(),
 		"receiver", receiver.String(),
 	)
 
 	// get payment data from payment_request
-	userPayments, totalAmount, paymentRequestIds, err := u.createUserPayment()
+	userPayments, totalAmount, err := u.createUserPayment(ctx)
 	if err != nil {
 		return "", err
 	}
 	if len(userPayments) == 0 {
-		logger.Debug("no userPayments")
+		logger.DebugContext(ctx, "no userPayments")
 		// no data
 		return "", nil
 	}
 
 	// check sender's total balance
-	senderAddr, err := u.addrRepo.GetOneUnAllocated(sender)
+	senderAddr, err := u.addrRepo.GetOneUnAllocated(ctx, sender)
 	if err != nil {
 		return "", fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(): %w", err)
 	}
 	if err = u.validateAmount(ctx, senderAddr, totalAmount); err != nil {
 		return "", nil
 	}
+	signerList, err := u.rippler.GetSignerList(ctx, senderAddr.WalletAddress)
+	if err != nil {
+		return "", fmt.Errorf("fail to call rippler.GetSignerList(): %w", err)
+	}
+	tickets, err := u.getAvailableTickets(ctx, senderAddr.WalletAddress)
+	if err != nil {
+		return "", err
+	}
 
 	// create raw transaction for each address
-	serializedTxs, txDetailItems := u.createPaymentRawTransactions(ctx, sender, receiver, userPayments, senderAddr)
+	serializedTxs, txDetailItems, paymentRequestIds := u.createPaymentRawTransactions(
+		ctx, sender, receiver, userPayments, senderAddr, signerList, tickets)
 	if len(txDetailItems) == 0 {
 		return "", nil
 	}
 
-	txID, err := u.updateDB(targetAction, txDetailItems, paymentRequestIds)
+	// payment_request whose transaction couldn't be created is left for next time
+	txID, err := u.updateDB(ctx, targetAction, txDetailItems, paymentRequestIds)
 	if err != nil {
 		return "", err
 	}
@@ -190,7 +207,7 @@ func (u *createTransactionUseCase) createPaymentTx(ctx context.Context) (string,
 	// save transaction result to file
 	var generatedFileName string
 	if len(serializedTxs) != 0 {
-		generatedFileName, err = u.generateHexFile(targetAction, sender, txID, serializedTxs)
+		generatedFileName, err = u.generateHexFile(ctx, targetAction, sender, txID, serializedTxs)
 		if err != nil {
 			return "", fmt.Errorf("fail to call generateHexFile(): %w", err)
 		}
@@ -219,7 +236,7 @@ func (u *createTransactionUseCase) createTransferTx(
 	}
 
 	// check sender's balance
-	senderAddr, err := u.addrRepo.GetOneUnAllocated(sender)
+	senderAddr, err := u.addrRepo.GetOneUnAllocated(ctx, sender)
 	if err != nil {
 		return "", fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(sender): %w", err)
 	}
@@ -227,28 +244,32 @@ func (u *createTransactionUseCase) createTransferTx(
 	if err != nil {
 		return "", fmt.Errorf("fail to call rippler.GetBalance(): %w", err)
 	}
-	if senderBalance <= 20 {
+	// reserve of XRP can't be sent, but issued currency has no reserve
+	if u.rippler.IssuedCurrency() == nil && senderBalance <= 20 {
 		return "", errors.New("sender balance is insufficient to send")
 	}
 	if floatValue != 0 && senderBalance <= floatValue {
 		return "", errors.New("sender balance is insufficient to send")
 	}
 
-	logger.Debug("amount",
+	logger.DebugContext(ctx, "amount",
 		"floatValue", floatValue,
 		"senderBalance", senderBalance,
 	)
 
 	// get receiver address
-	receiverAddr, err := u.addrRepo.GetOneUnAllocated(receiver)
+	receiverAddr, err := u.addrRepo.GetOneUnAllocated(ctx, receiver)
 	if err != nil {
 		return "", fmt.Errorf("fail to call addrRepo.GetOneUnAllocated(receiver): %w", err)
 	}
 
-	// call CreateRawTransaction
-	instructions := &xrp.Instructions{
-		MaxLedgerVersionOffset: xrp.MaxLedgerVersionOffset,
+	signerList, err := u.rippler.GetSignerList(ctx, senderAddr.WalletAddress)
+	if err != nil {
+		return "", fmt.Errorf("fail to call rippler.GetSignerList(): %w", err)
 	}
+
+	// call CreateRawTransaction
+	instructions := newInstructions(signerList)
 	txJSON, rawTxString, err := u.rippler.CreateRawTransaction(
 		ctx, senderAddr.WalletAddress, receiverAddr.WalletAddress, floatValue, instructions)
 	if err != nil {
@@ -256,7 +277,7 @@ func (u *createTransactionUseCase) createTransferTx(
 			"fail to call rippler.CreateRawTransaction(), sender address: %s: %w",
 			senderAddr.WalletAddress, err)
 	}
-	logger.Debug("txJSON", "txJSON", txJSON)
+	logger.DebugContext(ctx, "txJSON", "txJSON", txJSON)
 	grok.Value(txJSON)
 
 	// generate UUID to trace transaction because unsignedTx is not unique
@@ -265,7 +286,11 @@ func (u *createTransactionUseCase) createTransferTx(
 		return "", fmt.Errorf("fail to call uuidHandler.GenerateV7(): %w", err)
 	}
 
-	serializedTxs := []string{fmt.Sprintf("%s,%s", uid, rawTxString)}
+	serializedTx, err := serializeTx(uid.String(), rawTxString, signerList)
+	if err != nil {
+		return "", err
+	}
+	serializedTxs := []string{serializedTx}
 
 	// create insert data for xrp_detail_tx
 	txDetailItem := &models.XRPDetailTX{
@@ -275,7 +300,9 @@ func (u *createTransactionUseCase) createTransferTx(
 		SenderAddress:      senderAddr.WalletAddress,
 		ReceiverAccount:    receiver.String(),
 		ReceiverAddress:    receiverAddr.WalletAddress,
-		Amount:             txJSON.Amount,
+		Amount:             txJSON.Amount.Value,
+		Currency:           txJSON.Amount.Currency,
+		Issuer:             txJSON.Amount.Issuer,
 		XRPTXType:          txJSON.TransactionType,
 		Fee:                txJSON.Fee,
 		Flags:              txJSON.Flags,
@@ -284,7 +311,7 @@ func (u *createTransactionUseCase) createTransferTx(
 	}
 	txDetailItems := []*models.XRPDetailTX{txDetailItem}
 
-	txID, err := u.updateDB(targetAction, txDetailItems, nil)
+	txID, err := u.updateDB(ctx, targetAction, txDetailItems, nil)
 	if err != nil {
 		return "", err
 	}
@@ -292,7 +319,7 @@ func (u *createTransactionUseCase) createTransferTx(
 	// save transaction result to file
 	var generatedFileName string
 	if len(serializedTxs) != 0 {
-		generatedFileName, err = u.generateHexFile(targetAction, sender, txID, serializedTxs)
+		generatedFileName, err = u.generateHexFile(ctx, targetAction, sender, txID, serializedTxs)
 		if err != nil {
 			return "", fmt.Errorf("fail to call generateHexFile(): %w", err)
 		}
@@ -307,7 +334,7 @@ func (u *createTransactionUseCase) getUserAmounts(
 	sender domainAccount.AccountType,
 ) ([]xrp.UserAmount, error) {
 	// get addresses for sender account
-	addrs, err := u.addrRepo.GetAll(sender)
+	addrs, err := u.addrRepo.GetAll(ctx, sender)
 	if err != nil {
 		return nil, fmt.Errorf("fail to call addrRepo.GetAll(): %w", err)
 	}
@@ -316,15 +343,19 @@ func (u *createTransactionUseCase) getUserAmounts(
 	var userAmounts []xrp.UserAmount
 	// address list for sender
 	for _, addr := range addrs {
+		// X-address of client is paid to shared deposit address directly, so nothing is swept
+		if xrp.IsXAddress(addr.WalletAddress) {
+			continue
+		}
 		// TODO: if previous tx is not done, wrong amount is returned. how to manage it??
 		var balance float64
 		balance, err = u.rippler.GetBalance(ctx, addr.WalletAddress)
 		if err != nil {
-			logger.Warn("fail to call rippler.GetBalance()",
+			logger.WarnContext(ctx, "fail to call rippler.GetBalance()",
 				"address", addr.WalletAddress,
 			)
 		} else {
-			logger.Debug("account_info",
+			logger.DebugContext(ctx, "account_info",
 				"address", addr.WalletAddress, "balance", balance)
 			if balance != 0 {
 				userAmounts = append(userAmounts, xrp.UserAmount{Address: addr.WalletAddress, Amount: balance})
@@ -341,7 +372,7 @@ func (u *createTransactionUseCase) createDepositRawTransactions(
 	userAmounts []xrp.UserAmount,
 ) ([]string, []*models.XRPDetailTX, error) {
 	// get address for deposit account
-	depositAddr, err := u.addrRepo.GetOneUnAllocated(receiver)
+	depositAddr, err := u.addrRepo.GetOneUnAllocated(ctx, receiver)
 	if err != nil {
 		return nil, nil, fmt.Errorf(
 			"fail to call addrRepo.GetOneUnAllocated(): %w", err,
@@ -366,10 +397,10 @@ func (u *createTransactionUseCase) createDepositRawTransactions(
 		txJSON, rawTxString, err = u.rippler.CreateRawTransaction(
 			ctx, val.Address, depositAddr.WalletAddress, 0, instructions)
 		if err != nil {
-			logger.Warn("fail to call rippler.CreateRawTransaction()", "error", err)
+			logger.WarnContext(ctx, "fail to call rippler.CreateRawTransaction()", "error", err)
 			continue
 		}
-		logger.Debug("txJSON", "txJSON", txJSON)
+		logger.DebugContext(ctx, "txJSON", "txJSON", txJSON)
 		grok.Value(txJSON)
 
 		// sequence for next rawTransaction
@@ -391,7 +422,9 @@ func (u *createTransactionUseCase) createDepositRawTransactions(
 			SenderAddress:      val.Address,
 			ReceiverAccount:    receiver.String(),
 			ReceiverAddress:    depositAddr.WalletAddress,
-			Amount:             txJSON.Amount,
+			Amount:             txJSON.Amount.Value,
+			Currency:           txJSON.Amount.Currency,
+			Issuer:             txJSON.Amount.Issuer,
 			XRPTXType:          txJSON.TransactionType,
 			Fee:                txJSON.Fee,
 			Flags:              txJSON.Flags,
@@ -406,57 +439,57 @@ func (u *createTransactionUseCase) createDepositRawTransactions(
 
 // userPayment represents user's payment address and amount
 type userPayment struct {
-	senderAddr   string  // sender address for just checking
-	receiverAddr string  // receiver address
-	floatAmount  float64 // float amount (XRP)
+	paymentRequestID int64   // id of payment_request
+	senderAddr       string  // sender address for just checking
+	receiverAddr     string  // receiver address
+	floatAmount      float64 // float amount (XRP)
+	retryCount       uint32  // number of resubmissions after expiration
 }
 
 // createUserPayment gets payment data from payment_request table
-func (u *createTransactionUseCase) createUserPayment() ([]userPayment, float64, []int64, error) {
+func (u *createTransactionUseCase) createUserPayment(ctx context.Context) ([]userPayment, float64, error) {
 	// get payment_request
-	paymentRequests, err := u.payReqRepo.GetAll()
+	paymentRequests, err := u.payReqRepo.GetAll(ctx)
 	if err != nil {
-		return nil, 0, nil, fmt.Errorf("fail to call payReqRepo.GetAll(): %w", err)
+		return nil, 0, fmt.Errorf("fail to call payReqRepo.GetAll(): %w", err)
 	}
 	if len(paymentRequests) == 0 {
-		logger.Debug("no data in payment_request")
-		return nil, 0, nil, nil
+		logger.DebugContext(ctx, "no data in payment_request")
+		return nil, 0, nil
 	}
 
 	userPayments := make([]userPayment, len(paymentRequests))
-	paymentRequestIds := make([]int64, len(paymentRequests))
 	var totalAmount float64
 
-	// store `id` separately for key updating
+	// store `id` for key updating
 	for idx, val := range paymentRequests {
-		paymentRequestIds[idx] = val.ID
-
+		userPayments[idx].paymentRequestID = val.ID
 		userPayments[idx].senderAddr = val.SenderAddress
 		userPayments[idx].receiverAddr = val.ReceiverAddress
 		var amt float64
 		amt, err = strconv.ParseFloat(val.Amount.String(), 64)
 		if err != nil {
 			// fatal error because table includes invalid data
-			logger.Error("payment_request table includes invalid amount field")
-			return nil, 0, nil, errors.New("payment_request table includes invalid amount field")
+			logger.ErrorContext(ctx, "payment_request table includes invalid amount field")
+			return nil, 0, errors.New("payment_request table includes invalid amount field")
 		}
 		userPayments[idx].floatAmount = amt
 
 		// validate address
 		if !xrp.ValidateAddress(userPayments[idx].receiverAddr) {
 			// fatal error
-			logger.Error("address is invalid",
+			logger.ErrorContext(ctx, "address is invalid",
 				"address", userPayments[idx].receiverAddr,
 				"error", err,
 			)
-			return nil, 0, nil, fmt.Errorf("address is invalid: %s: %w", userPayments[idx].receiverAddr, err)
+			return nil, 0, fmt.Errorf("address is invalid: %s: %w", userPayments[idx].receiverAddr, err)
 		}
 
 		// total amount
 		totalAmount += amt
 	}
 
-	return userPayments, totalAmount, paymentRequestIds, nil
+	return userPayments, totalAmount, nil
 }
 
 // validateAmount validates that sender has sufficient balance
@@ -476,46 +509,104 @@ func (u *createTransactionUseCase) validateAmount(
 	return nil
 }
 
+// getAvailableTickets returns tickets of address which aren't assigned to any transaction yet
+func (u *createTransactionUseCase) getAvailableTickets(ctx context.Context, address string) ([]uint64, error) {
+	tickets, err := u.rippler.GetTickets(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call rippler.GetTickets(): %w", err)
+	}
+	if len(tickets) == 0 {
+		return nil, nil
+	}
+	// tickets of canceled or expired transactions are not included, they can be assigned again
+	assigned, err := u.txDetailRepo.GetTicketSequences(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call txDetailRepo.GetTicketSequences(): %w", err)
+	}
+	return excludeTickets(tickets, assigned), nil
+}
+
+// excludeTickets returns tickets which are not included in assigned
+func excludeTickets(tickets, assigned []uint64) []uint64 {
+	return slices.DeleteFunc(tickets, func(ticket uint64) bool {
+		return slices.Contains(assigned, ticket)
+	})
+}
+
+// sequencer assigns ticket or sequence to consecutive transactions of one sender
+//   - tickets are assigned first, then consecutive sequences are used once tickets run out
+//   - sequence 0 lets rippled fill current sequence of account
+type sequencer struct {
+	tickets  []uint64
+	sequence uint64
+}
+
+// assign sets ticket or sequence of next transaction to instructions
+func (s *sequencer) assign(instructions *xrp.Instructions) {
+	if len(s.tickets) != 0 {
+		instructions.TicketSequence = s.tickets[0]
+	} else if s.sequence != 0 {
+		instructions.Sequence = s.sequence
+	}
+}
+
+// next consumes assigned ticket or moves to next sequence once transaction is created
+//   - txSequence is Sequence of created transaction, which is 0 for transaction using ticket
+func (s *sequencer) next(instructions *xrp.Instructions, txSequence uint64) {
+	if instructions.TicketSequence != 0 {
+		s.tickets = s.tickets[1:]
+		return
+	}
+	s.sequence = txSequence + 1
+}
+
 // createPaymentRawTransactions creates raw transactions for payment
+//   - available ticket is assigned to each transaction, so transactions can be signed and sent in any order
+//   - consecutive sequences are used once tickets run out
+//   - ids of payment_request whose transaction is created are returned
 func (u *createTransactionUseCase) createPaymentRawTransactions(
 	ctx context.Context,
 	sender, receiver domainAccount.AccountType,
 	userPayments []userPayment,
 	senderAddr *models.Address,
-) ([]string, []*models.XRPDetailTX) {
+	signerList *xrp.SignerList,
+	tickets []uint64,
+) ([]string, []*models.XRPDetailTX, []int64) {
 	serializedTxs := make([]string, 0, len(userPayments))
 	txDetailItems := make([]*models.XRPDetailTX, 0, len(userPayments))
-	var sequence uint64
+	paymentRequestIds := make([]int64, 0, len(userPayments))
+	seq := &sequencer{tickets: tickets}
 	for _, userPayment := range userPayments {
 		// call CreateRawTransaction
-		instructions := &xrp.Instructions{
-			MaxLedgerVersionOffset: xrp.MaxLedgerVersionOffset,
-		}
-		if sequence != 0 {
-			instructions.Sequence = sequence
-		}
+		instructions := newInstructions(signerList)
+		seq.assign(instructions)
 		txJSON, rawTxString, err := u.rippler.CreateRawTransaction(
 			ctx, senderAddr.WalletAddress, userPayment.receiverAddr, userPayment.floatAmount, instructions)
 		if err != nil {
 			// TODO: which is better to return err or continue?
 			// return error in ethereum logic
-			logger.Warn("fail to call rippler.CreateRawTransaction()", "error", err)
+			logger.WarnContext(ctx, "fail to call rippler.CreateRawTransaction()", "error", err)
 			continue
 		}
-		logger.Debug("txJSON", "txJSON", txJSON)
+		logger.DebugContext(ctx, "txJSON", "txJSON", txJSON)
 		grok.Value(txJSON)
 
-		// sequence for next rawTransaction
-		sequence = txJSON.Sequence + 1
+		// ticket or sequence for next rawTransaction
+		seq.next(instructions, txJSON.Sequence)
 
 		// generate UUID to trace transaction because unsignedTx is not unique
 		uid, err := u.uuidHandler.GenerateV7()
 		if err != nil {
-			logger.Warn("fail to call uuidHandler.GenerateV7()", "error", err)
+			logger.WarnContext(ctx, "fail to call uuidHandler.GenerateV7()", "error", err)
 			continue
 		}
 
-		serializedTxs = append(serializedTxs, fmt.Sprintf("%s,%s", uid, rawTxString))
+		serializedTx, err := serializeTx(uid.String(), rawTxString, signerList)
+		if err != nil {
+			logger.WarnContext(ctx, "fail to call serializeTx()", "error", err)
+			continue
+		}
+		serializedTxs = append(serializedTxs, serializedTx)
 
 		// create insert data for xrp_detail_tx
 		txDetailItem := &models.XRPDetailTX{
@@ -525,24 +616,74 @@ func (u *createTransactionUseCase) createPaymentRawTransactions(
 			SenderAddress:      senderAddr.WalletAddress,
 			ReceiverAccount:    receiver.String(),
 			ReceiverAddress:    userPayment.receiverAddr,
-			Amount:             txJSON.Amount,
+			Amount:             txJSON.Amount.Value,
+			Currency:           txJSON.Amount.Currency,
+			Issuer:             txJSON.Amount.Issuer,
 			XRPTXType:          txJSON.TransactionType,
 			Fee:                txJSON.Fee,
 			Flags:              txJSON.Flags,
 			LastLedgerSequence: txJSON.LastLedgerSequence,
 			Sequence:           txJSON.Sequence,
+			TicketSequence:     txJSON.TicketSequence,
+			RetryCount:         userPayment.retryCount,
+			PaymentRequestID:   userPayment.paymentRequestID,
 		}
 		txDetailItems = append(txDetailItems, txDetailItem)
+		paymentRequestIds = append(paymentRequestIds, userPayment.paymentRequestID)
 	}
-	return serializedTxs, txDetailItems
+	return serializedTxs, txDetailItems, paymentRequestIds
+}
+
+// newInstructions returns instructions for CreateRawTransaction()
+//   - fee of multisigned transaction depends on number of signers
+func newInstructions(signerList *xrp.SignerList) *xrp.Instructions {
+	instructions := &xrp.Instructions{
+		MaxLedgerVersionOffset: xrp.MaxLedgerVersionOffset,
+	}
+	if signerList != nil {
+		instructions.SignersCount = uint64(len(signerList.SignerEntries))
+	}
+	return instructions
+}
+
+// serializeTx returns line of unsigned transaction file
+//   - `uuid,txJSON` for single signing account, it is signed by keygen wallet
+//   - serialized xrp.MultisigTx for multisig account, it is signed by sign wallets
+func serializeTx(uid, rawTxString string, signerList *xrp.SignerList) (string, error) {
+	if signerList == nil {
+		return fmt.Sprintf("%s,%s", uid, rawTxString), nil
+	}
+	serializedTx, err := serial.EncodeToString(xrp.MultisigTx{
+		UUID:    uid,
+		TxJSON:  rawTxString,
+		Quorum:  signerList.SignerQuorum,
+		Signers: signerList.Signers(),
+		Weights: signerList.Weights(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("fail to call serial.EncodeToString(multisigTx): %w", err)
+	}
+	return serializedTx, nil
 }
 
 // updateDB updates database in a transaction
 func (u *createTransactionUseCase) updateDB(
-	targetAction domainTx.ActionType,
+	ctx context.Context, targetAction domainTx.ActionType,
 	txDetailItems []*models.XRPDetailTX,
 	paymentRequestIds []int64,
 ) (int64, error) {
+	return u.updateDBWith(ctx, targetAction, txDetailItems, paymentRequestIds, nil)
+}
+
+// updateDBWith updates database in a transaction
+//   - updateRelated updates records related to created transaction such as xrp_escrow by repositories bound to dtx,
+//     they are rolled back together with tx and xrp_detail_tx
+func (u *createTransactionUseCase) updateDBWith(
+	ctx context.Context, targetAction domainTx.ActionType,
+	txDetailItems []*models.XRPDetailTX,
+	paymentRequestIds []int64,
+	updateRelated func(dtx *sql.Tx) error,
+) (txID int64, err error) {
 	// start transaction
 	dtx, err := u.dbConn.Begin()
 	if err != nil {
@@ -551,13 +692,16 @@ func (u *createTransactionUseCase) updateDB(
 	defer func() {
 		if err != nil {
 			_ = dtx.Rollback() // Error already being handled
-		} else {
-			_ = dtx.Commit() // Error already being handled
+			return
+		}
+		if err = dtx.Commit(); err != nil {
+			txID = 0
+			err = fmt.Errorf("fail to commit transaction: %w", err)
 		}
 	}()
 
 	// Insert tx
-	txID, err := u.txRepo.InsertUnsignedTx(targetAction)
+	txID, err = u.txRepo.WithTx(dtx).InsertUnsignedTx(ctx, targetAction)
 	if err != nil {
 		return 0, fmt.Errorf("fail to call txRepo.InsertUnsignedTx(): %w", err)
 	}
@@ -565,29 +709,39 @@ func (u *createTransactionUseCase) updateDB(
 	for idx := range txDetailItems {
 		txDetailItems[idx].TXID = txID
 	}
-	if err = u.txDetailRepo.InsertBulk(txDetailItems); err != nil {
+	if err = u.txDetailRepo.WithTx(dtx).InsertBulk(ctx, txDetailItems); err != nil {
 		return 0, fmt.Errorf("fail to call txDetailRepo.InsertBulk(): %w", err)
 	}
 
 	if targetAction == domainTx.ActionTypePayment {
-		_, err = u.payReqRepo.UpdatePaymentID(txID, paymentRequestIds)
+		_, err = u.payReqRepo.WithTx(dtx).UpdatePaymentID(ctx, txID, paymentRequestIds)
 		if err != nil {
 			return 0, fmt.Errorf("fail to call payReqRepo.UpdatePaymentID(): %w", err)
 		}
 	}
+	if updateRelated != nil {
+		if err = updateRelated(dtx); err != nil {
+			return 0, err
+		}
+	}
+	metrics.IncTx(u.rippler.CoinTypeCode().String(), targetAction.String(), metrics.TxStatusCreated)
 	return txID, nil
 }
 
 // generateHexFile generates file for hex txID and encoded previous addresses
 func (u *createTransactionUseCase) generateHexFile(
-	actionType domainTx.ActionType, senderAccount domainAccount.AccountType, txID int64, serializedTxs []string,
+	ctx context.Context,
+	actionType domainTx.ActionType,
+	senderAccount domainAccount.AccountType,
+	txID int64,
+	serializedTxs []string,
 ) (string, error) {
 	// add senderAccount to first line
 	serializedTxs = append([]string{senderAccount.String()}, serializedTxs...)
 
 	// create file
 	path := u.txFileRepo.CreateFilePath(actionType, domainTx.TxTypeUnsigned, txID, 0)
-	generatedFileName, err := u.txFileRepo.WriteFileSlice(path, serializedTxs)
+	generatedFileName, err := u.txFileRepo.WriteFileSlice(ctx, path, serializedTxs)
 	if err != nil {
 		return "", fmt.Errorf("fail to call txFileRepo.WriteFileSlice(): %w", err)
 	}
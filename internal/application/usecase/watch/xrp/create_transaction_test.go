@@ -0,0 +1,218 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+)
+
+// fakeTicketRippler returns fixed tickets, other methods aren't implemented
+type fakeTicketRippler struct {
+	ripple.Rippler
+	tickets []uint64
+	err     error
+}
+
+func (r *fakeTicketRippler) GetTickets(_ context.Context, _ string) ([]uint64, error) {
+	return r.tickets, r.err
+}
+
+// fakeTicketRepo returns fixed assigned tickets, other methods aren't implemented
+//   - assigned is expected to be tickets of transactions which are not canceled or expired
+type fakeTicketRepo struct {
+	watchrepo.XrpDetailTxRepositorier
+	assigned []uint64
+	called   bool
+}
+
+func (r *fakeTicketRepo) GetTicketSequences(_ context.Context, _ string) ([]uint64, error) {
+	r.called = true
+	return r.assigned, nil
+}
+
+// TestGetAvailableTickets is test for tickets which can be assigned to new transaction
+func TestGetAvailableTickets(t *testing.T) {
+	tests := []struct {
+		name       string
+		tickets    []uint64
+		assigned   []uint64
+		want       []uint64
+		wantCalled bool
+	}{
+		{
+			name:       "no ticket",
+			tickets:    nil,
+			assigned:   []uint64{10},
+			want:       nil,
+			wantCalled: false,
+		},
+		{
+			name:       "no assigned ticket",
+			tickets:    []uint64{10, 11, 12},
+			assigned:   nil,
+			want:       []uint64{10, 11, 12},
+			wantCalled: true,
+		},
+		{
+			name:       "assigned tickets are excluded",
+			tickets:    []uint64{10, 11, 12},
+			assigned:   []uint64{11, 12},
+			want:       []uint64{10},
+			wantCalled: true,
+		},
+		{
+			name: "ticket of canceled or expired transaction is available again",
+			// 11 was assigned to canceled transaction, so repository doesn't return it
+			tickets:    []uint64{10, 11},
+			assigned:   []uint64{10},
+			want:       []uint64{11},
+			wantCalled: true,
+		},
+		{
+			name:       "all tickets are assigned",
+			tickets:    []uint64{10, 11},
+			assigned:   []uint64{10, 11, 9},
+			want:       []uint64{},
+			wantCalled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeTicketRepo{assigned: tt.assigned}
+			u := &createTransactionUseCase{
+				rippler:      &fakeTicketRippler{tickets: tt.tickets},
+				txDetailRepo: repo,
+			}
+			tickets, err := u.getAvailableTickets(context.Background(), "rSender")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tickets)
+			assert.Equal(t, tt.wantCalled, repo.called)
+		})
+	}
+
+	t.Run("error of GetTickets", func(t *testing.T) {
+		u := &createTransactionUseCase{
+			rippler:      &fakeTicketRippler{err: errors.New("connection refused")},
+			txDetailRepo: &fakeTicketRepo{},
+		}
+		_, err := u.getAvailableTickets(context.Background(), "rSender")
+		require.Error(t, err)
+	})
+}
+
+// TestSequencer is test for ticket then sequence assignment of consecutive transactions
+func TestSequencer(t *testing.T) {
+	tests := []struct {
+		name string
+		// tickets, sequence are initial values of sequencer
+		tickets  []uint64
+		sequence uint64
+		// txSequences are Sequence of created transactions
+		txSequences     []uint64
+		wantTickets     []uint64
+		wantSequences   []uint64
+		wantNextTickets []uint64
+	}{
+		{
+			name:            "no ticket, sequence is filled by rippled then incremented",
+			tickets:         nil,
+			txSequences:     []uint64{100, 101, 102},
+			wantTickets:     []uint64{0, 0, 0},
+			wantSequences:   []uint64{0, 101, 102},
+			wantNextTickets: nil,
+		},
+		{
+			name:            "enough tickets",
+			tickets:         []uint64{10, 11},
+			txSequences:     []uint64{0, 0},
+			wantTickets:     []uint64{10, 11},
+			wantSequences:   []uint64{0, 0},
+			wantNextTickets: []uint64{},
+		},
+		{
+			name:            "sequence is used once tickets run out",
+			tickets:         []uint64{10},
+			txSequences:     []uint64{0, 100, 101},
+			wantTickets:     []uint64{10, 0, 0},
+			wantSequences:   []uint64{0, 0, 101},
+			wantNextTickets: []uint64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seq := &sequencer{tickets: tt.tickets, sequence: tt.sequence}
+			for i, txSequence := range tt.txSequences {
+				instructions := &xrp.Instructions{}
+				seq.assign(instructions)
+				assert.Equal(t, tt.wantTickets[i], instructions.TicketSequence, "ticket of tx[%d]", i)
+				assert.Equal(t, tt.wantSequences[i], instructions.Sequence, "sequence of tx[%d]", i)
+				seq.next(instructions, txSequence)
+			}
+			assert.Equal(t, tt.wantNextTickets, seq.tickets)
+		})
+	}
+}
+
+// TestUpdateDBWith is test for tx rolled back together with related records
+func TestUpdateDBWith(t *testing.T) {
+	tests := []struct {
+		name          string
+		updateRelated func(dtx *sql.Tx) error
+		wantErr       bool
+		wantCount     int
+	}{
+		{
+			name:          "tx is committed",
+			updateRelated: func(_ *sql.Tx) error { return nil },
+			wantCount:     1,
+		},
+		{
+			name:          "tx is rolled back when related record can't be updated",
+			updateRelated: func(_ *sql.Tx) error { return errors.New("duplicate entry") },
+			wantErr:       true,
+			wantCount:     0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			dbConn := newTestDB(t)
+			_, err := dbConn.ExecContext(ctx, `CREATE TABLE tx (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				coin TEXT NOT NULL,
+				action TEXT NOT NULL,
+				updated_at TIMESTAMP
+			)`)
+			require.NoError(t, err)
+
+			u := &createTransactionUseCase{
+				rippler:      &fakeEscrowRippler{},
+				dbConn:       dbConn,
+				txRepo:       watchrepo.NewTxRepositorySqlc(dbConn, domainCoin.XRP),
+				txDetailRepo: &fakeEscrowDetailRepo{},
+			}
+			txDetailItems := []*models.XRPDetailTX{{UUID: "uuid"}}
+			_, err = u.updateDBWith(ctx, domainTx.ActionTypeTransfer, txDetailItems, nil, tt.updateRelated)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var count int
+			require.NoError(t, dbConn.QueryRowContext(ctx, "SELECT COUNT(*) FROM tx").Scan(&count))
+			assert.Equal(t, tt.wantCount, count)
+		})
+	}
+}
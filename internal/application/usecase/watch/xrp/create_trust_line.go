@@ -0,0 +1,92 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+type createTrustLineUseCase struct {
+	rippler ripple.Rippler
+	setup   *accountSetup
+}
+
+// NewCreateTrustLineUseCase creates a new CreateTrustLineUseCase
+//   - TrustSet transaction for issued currency in config is created for addresses which don't hold trust line yet
+//   - addresses must be funded to afford owner reserve of trust line beforehand
+func NewCreateTrustLineUseCase(
+	rippler ripple.Rippler,
+	dbConn *sql.DB,
+	uuidHandler uuid.UUIDHandler,
+	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+) watchusecase.CreateTrustLineUseCase {
+	return &createTrustLineUseCase{
+		rippler: rippler,
+		setup:   newAccountSetup(rippler, dbConn, uuidHandler, addrRepo, txRepo, txDetailRepo, txFileRepo),
+	}
+}
+
+func (u *createTrustLineUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.CreateTrustLineInput,
+) (_ watchusecase.CreateTrustLineOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.CreateTrustLine.Execute")
+	defer tracer.End(span, &err)
+
+	issuedCurrency := u.rippler.IssuedCurrency()
+	if issuedCurrency == nil {
+		return watchusecase.CreateTrustLineOutput{}, errors.New("issued_currency is not set in config")
+	}
+
+	addrs, err := u.setup.tx.addrRepo.GetAllAddress(ctx, input.AccountType)
+	if err != nil {
+		return watchusecase.CreateTrustLineOutput{}, fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+	}
+
+	generatedFileName, err := u.setup.create(ctx, input.AccountType, addrs,
+		func(ctx context.Context, addr string) (*xrp.TxInput, string, error) {
+			hasTrustLine, err := u.rippler.HasTrustLine(ctx, addr)
+			if err != nil {
+				// account which isn't funded yet is not found
+				logger.WarnContext(ctx, "fail to call rippler.HasTrustLine()", "address", addr, "error", err)
+				return nil, "", nil
+			}
+			if hasTrustLine {
+				return nil, "", nil
+			}
+			txJSON, rawTxString, err := u.rippler.CreateTrustSetTransaction(ctx, addr, newInstructions(nil))
+			if err != nil {
+				return nil, "", fmt.Errorf(
+					"fail to call rippler.CreateTrustSetTransaction(), address: %s: %w", addr, err)
+			}
+			return txJSON, rawTxString, nil
+		})
+	if err != nil {
+		return watchusecase.CreateTrustLineOutput{}, err
+	}
+	if generatedFileName == "" {
+		logger.InfoContext(ctx, "no address to create trust line", "account_type", input.AccountType.String())
+		return watchusecase.CreateTrustLineOutput{}, nil
+	}
+
+	logger.InfoContext(ctx, "TrustSet transactions are created",
+		"account_type", input.AccountType.String(),
+		"currency", issuedCurrency.Currency,
+		"issuer", issuedCurrency.Issuer,
+		"file", generatedFileName,
+	)
+	return watchusecase.CreateTrustLineOutput{FileName: generatedFileName}, nil
+}
@@ -0,0 +1,112 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// fakeTrustLineRippler returns trust line of address in ledger
+type fakeTrustLineRippler struct {
+	ripple.Rippler
+	issuedCurrency *xrp.IssuedCurrency
+	// trustLines is whether address holds trust line, address which isn't funded isn't in it
+	trustLines map[string]bool
+}
+
+func (r *fakeTrustLineRippler) CoinTypeCode() domainCoin.CoinTypeCode {
+	return domainCoin.XRP
+}
+
+func (r *fakeTrustLineRippler) IssuedCurrency() *xrp.IssuedCurrency {
+	return r.issuedCurrency
+}
+
+func (r *fakeTrustLineRippler) HasTrustLine(_ context.Context, addr string) (bool, error) {
+	hasTrustLine, ok := r.trustLines[addr]
+	if !ok {
+		return false, errors.New("actNotFound")
+	}
+	return hasTrustLine, nil
+}
+
+func (r *fakeTrustLineRippler) CreateTrustSetTransaction(
+	_ context.Context, account string, _ *xrp.Instructions,
+) (*xrp.TxInput, string, error) {
+	return &xrp.TxInput{TransactionType: "TrustSet", Account: account, Sequence: 10}, "{}", nil
+}
+
+// TestCreateTrustLineExecute is test for TrustSet created only for address which doesn't hold trust line
+func TestCreateTrustLineExecute(t *testing.T) {
+	usd := &xrp.IssuedCurrency{Currency: "USD", Issuer: "rIssuer"}
+	trustLines := map[string]bool{
+		"rHeld":    true,
+		"rNotHeld": false,
+	}
+
+	tests := []struct {
+		name           string
+		issuedCurrency *xrp.IssuedCurrency
+		addrs          []string
+		wantErr        bool
+		wantCreated    []string
+	}{
+		{
+			name:           "address which doesn't hold trust line is set",
+			issuedCurrency: usd,
+			addrs:          []string{"rHeld", "rNotHeld", "rUnfunded"},
+			wantCreated:    []string{"rNotHeld"},
+		},
+		{
+			name:           "nothing is created when trust line is already held",
+			issuedCurrency: usd,
+			addrs:          []string{"rHeld"},
+		},
+		{
+			name:    "issued currency isn't set",
+			addrs:   []string{"rNotHeld"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detailRepo := &fakeEscrowDetailRepo{}
+			fileRepo := &fakeTxFileRepo{}
+			u := NewCreateTrustLineUseCase(
+				&fakeTrustLineRippler{issuedCurrency: tt.issuedCurrency, trustLines: trustLines},
+				newTestDB(t), uuid.NewGoogleUUIDHandler(), &fakeSetupAddrRepo{addrs: tt.addrs},
+				&fakeTxRepo{action: domainTx.ActionTypeTransfer}, detailRepo, fileRepo,
+			)
+
+			output, err := u.Execute(context.Background(), watchusecase.CreateTrustLineInput{
+				AccountType: domainAccount.AccountTypePayment,
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, 0, fileRepo.written)
+				return
+			}
+			require.NoError(t, err)
+
+			created := make([]string, 0, len(detailRepo.inserted))
+			for _, item := range detailRepo.inserted {
+				assert.Equal(t, "TrustSet", item.XRPTXType)
+				created = append(created, item.SenderAddress)
+			}
+			assert.ElementsMatch(t, tt.wantCreated, created)
+			assert.Equal(t, len(tt.wantCreated) != 0, output.FileName != "")
+			assert.Equal(t, min(len(tt.wantCreated), 1), fileRepo.written)
+		})
+	}
+}
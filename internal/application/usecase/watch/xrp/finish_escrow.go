@@ -0,0 +1,227 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+type finishEscrowUseCase struct {
+	rippler    ripple.Rippler
+	escrowRepo watchrepo.XrpEscrowRepositorier
+	// tx shares recording of xrp_detail_tx and writing of unsigned transaction file
+	tx *createTransactionUseCase
+}
+
+// NewFinishEscrowUseCase creates a new FinishEscrowUseCase
+//   - EscrowFinish is created for escrow whose FinishAfter has passed
+//   - EscrowCancel is created instead for escrow whose CancelAfter has passed, it can't be finished any more
+//   - status of escrow is updated by state of EscrowCreate and EscrowFinish in xrp_detail_tx,
+//     escrow whose EscrowFinish is expired or canceled is finished again
+func NewFinishEscrowUseCase(
+	rippler ripple.Rippler,
+	dbConn *sql.DB,
+	uuidHandler uuid.UUIDHandler,
+	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	escrowRepo watchrepo.XrpEscrowRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+) watchusecase.FinishEscrowUseCase {
+	return &finishEscrowUseCase{
+		rippler:    rippler,
+		escrowRepo: escrowRepo,
+		tx: &createTransactionUseCase{
+			rippler:      rippler,
+			dbConn:       dbConn,
+			uuidHandler:  uuidHandler,
+			addrRepo:     addrRepo,
+			txRepo:       txRepo,
+			txDetailRepo: txDetailRepo,
+			txFileRepo:   txFileRepo,
+		},
+	}
+}
+
+func (u *finishEscrowUseCase) Execute(ctx context.Context) (_ watchusecase.FinishEscrowOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.FinishEscrow.Execute")
+	defer tracer.End(span, &err)
+
+	escrows, err := u.escrowRepo.GetAllOpen(ctx)
+	if err != nil {
+		return watchusecase.FinishEscrowOutput{}, fmt.Errorf("fail to call escrowRepo.GetAllOpen(): %w", err)
+	}
+
+	account := domainAccount.AccountTypeStored
+	now := time.Now()
+	serializedTxs := make([]string, 0, len(escrows))
+	txDetailItems := make([]*models.XRPDetailTX, 0, len(escrows))
+	finishingEscrows := make([]*models.XRPEscrow, 0, len(escrows))
+	// consecutive sequences are used for transactions of the same owner
+	sequences := make(map[string]uint64)
+	for _, escrow := range escrows {
+		var isReady bool
+		isReady, err = u.updateStatus(ctx, escrow)
+		if err != nil {
+			return watchusecase.FinishEscrowOutput{}, err
+		}
+		if !isReady || now.Before(escrow.FinishAfter) {
+			continue
+		}
+
+		var txDetailItem *models.XRPDetailTX
+		var serializedTx string
+		txDetailItem, serializedTx, err = u.createFinishTx(ctx, account, escrow, sequences[escrow.OwnerAddress], now)
+		if err != nil {
+			return watchusecase.FinishEscrowOutput{}, err
+		}
+		sequences[escrow.OwnerAddress] = txDetailItem.Sequence + 1
+		serializedTxs = append(serializedTxs, serializedTx)
+		txDetailItems = append(txDetailItems, txDetailItem)
+		finishingEscrows = append(finishingEscrows, escrow)
+	}
+	if len(txDetailItems) == 0 {
+		logger.InfoContext(ctx, "no matured escrow to finish")
+		return watchusecase.FinishEscrowOutput{}, nil
+	}
+
+	// escrow becomes finishing in the same transaction as EscrowFinish
+	txID, err := u.tx.updateDBWith(ctx, domainTx.ActionTypeTransfer, txDetailItems, nil, func(dtx *sql.Tx) error {
+		escrowRepo := u.escrowRepo.WithTx(dtx)
+		for i, escrow := range finishingEscrows {
+			if _, updateErr := escrowRepo.UpdateFinishUUID(ctx, escrow.ID, txDetailItems[i].UUID); updateErr != nil {
+				return fmt.Errorf("fail to call escrowRepo.UpdateFinishUUID(): %w", updateErr)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return watchusecase.FinishEscrowOutput{}, err
+	}
+
+	generatedFileName, err := u.tx.generateHexFile(ctx, domainTx.ActionTypeTransfer, account, txID, serializedTxs)
+	if err != nil {
+		return watchusecase.FinishEscrowOutput{}, fmt.Errorf("fail to call generateHexFile(): %w", err)
+	}
+
+	logger.InfoContext(ctx, "transactions to finish escrow are created",
+		"escrows", len(finishingEscrows),
+		"file", generatedFileName,
+	)
+	return watchusecase.FinishEscrowOutput{FileName: generatedFileName}, nil
+}
+
+// updateStatus updates status of escrow by state of its transactions in xrp_detail_tx
+//   - it returns true if escrow exists in ledger and no transaction to finish it is in progress
+func (u *finishEscrowUseCase) updateStatus(ctx context.Context, escrow *models.XRPEscrow) (bool, error) {
+	targetUUID := escrow.CreateUUID
+	if escrow.Status == domainTx.EscrowStatusFinishing.Int8() {
+		targetUUID = escrow.FinishUUID
+	}
+	txDetail, err := u.tx.txDetailRepo.GetOneByUUID(ctx, targetUUID)
+	if err != nil {
+		return false, fmt.Errorf("fail to call txDetailRepo.GetOneByUUID(%s): %w", targetUUID, err)
+	}
+
+	var status domainTx.EscrowStatus
+	switch txDetail.CurrentTXType {
+	case domainTx.TxTypeDone.Int8(), domainTx.TxTypeNotified.Int8():
+		if escrow.Status == domainTx.EscrowStatusCreated.Int8() {
+			return true, nil
+		}
+		status = domainTx.EscrowStatusFinished
+		if txDetail.XRPTXType == "EscrowCancel" {
+			status = domainTx.EscrowStatusCanceled
+		}
+	case domainTx.TxTypeExpired.Int8(), domainTx.TxTypeCancel.Int8():
+		if escrow.Status == domainTx.EscrowStatusFinishing.Int8() {
+			// escrow still exists in ledger, it's finished again
+			return true, nil
+		}
+		status = domainTx.EscrowStatusFailed
+	default:
+		// transaction is in progress
+		return false, nil
+	}
+
+	if _, err = u.escrowRepo.UpdateStatus(ctx, escrow.ID, status); err != nil {
+		return false, fmt.Errorf("fail to call escrowRepo.UpdateStatus(): %w", err)
+	}
+	logger.InfoContext(ctx, "escrow is closed",
+		"escrow_id", escrow.ID,
+		"owner_address", escrow.OwnerAddress,
+		"offer_sequence", escrow.OfferSequence,
+		"status", status.String(),
+	)
+	return false, nil
+}
+
+// createFinishTx creates EscrowFinish, or EscrowCancel if escrow is expired, and record of xrp_detail_tx
+//   - sequence of account is used if sequence is 0
+func (u *finishEscrowUseCase) createFinishTx(
+	ctx context.Context, account domainAccount.AccountType, escrow *models.XRPEscrow, sequence uint64, now time.Time,
+) (*models.XRPDetailTX, string, error) {
+	signerList, err := u.rippler.GetSignerList(ctx, escrow.OwnerAddress)
+	if err != nil {
+		return nil, "", fmt.Errorf("fail to call rippler.GetSignerList(): %w", err)
+	}
+	instructions := newInstructions(signerList)
+	instructions.Sequence = sequence
+
+	var txJSON *xrp.TxInput
+	var rawTxString string
+	receiverAddress := escrow.DestinationAddress
+	if escrow.CancelAfter.Valid && !now.Before(escrow.CancelAfter.Time) {
+		logger.WarnContext(ctx, "escrow is expired, it's canceled instead",
+			"escrow_id", escrow.ID,
+			"cancel_after", escrow.CancelAfter.Time)
+		receiverAddress = escrow.OwnerAddress
+		txJSON, rawTxString, err = u.rippler.CreateEscrowCancelTransaction(
+			ctx, escrow.OwnerAddress, escrow.OwnerAddress, escrow.OfferSequence, instructions)
+	} else {
+		txJSON, rawTxString, err = u.rippler.CreateEscrowFinishTransaction(
+			ctx, escrow.OwnerAddress, escrow.OwnerAddress, escrow.OfferSequence, instructions)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("fail to create transaction to finish escrow, id: %d: %w", escrow.ID, err)
+	}
+	logger.DebugContext(ctx, "txJSON", "txJSON", txJSON)
+
+	uid, err := u.tx.uuidHandler.GenerateV7()
+	if err != nil {
+		return nil, "", fmt.Errorf("fail to call uuidHandler.GenerateV7(): %w", err)
+	}
+	serializedTx, err := serializeTx(uid.String(), rawTxString, signerList)
+	if err != nil {
+		return nil, "", err
+	}
+
+	txDetailItem := &models.XRPDetailTX{
+		UUID:               uid.String(),
+		CurrentTXType:      domainTx.TxTypeUnsigned.Int8(),
+		SenderAccount:      account.String(),
+		SenderAddress:      escrow.OwnerAddress,
+		ReceiverAccount:    account.String(),
+		ReceiverAddress:    receiverAddress,
+		Amount:             escrow.Amount,
+		XRPTXType:          txJSON.TransactionType,
+		Fee:                txJSON.Fee,
+		Flags:              txJSON.Flags,
+		LastLedgerSequence: txJSON.LastLedgerSequence,
+		Sequence:           txJSON.Sequence,
+	}
+	return txDetailItem, serializedTx, nil
+}
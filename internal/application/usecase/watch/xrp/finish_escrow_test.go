@@ -0,0 +1,307 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/guregu/null/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+const escrowOwner = "rStored"
+
+// fakeEscrowRippler creates escrow transactions, other methods aren't implemented
+type fakeEscrowRippler struct {
+	ripple.Rippler
+	// created is TransactionType of created transactions
+	created []string
+}
+
+func (r *fakeEscrowRippler) CoinTypeCode() domainCoin.CoinTypeCode {
+	return domainCoin.XRP
+}
+
+func (r *fakeEscrowRippler) IssuedCurrency() *xrp.IssuedCurrency {
+	return nil
+}
+
+func (r *fakeEscrowRippler) GetBalance(_ context.Context, _ string) (float64, error) {
+	return 1000, nil
+}
+
+func (r *fakeEscrowRippler) GetSignerList(_ context.Context, _ string) (*xrp.SignerList, error) {
+	return nil, nil
+}
+
+func (r *fakeEscrowRippler) CreateEscrowCreateTransaction(
+	_ context.Context, account, _ string, _ float64, _, _ time.Time, _ *xrp.Instructions,
+) (*xrp.TxInput, string, error) {
+	return r.create("EscrowCreate", account, 10)
+}
+
+func (r *fakeEscrowRippler) CreateEscrowFinishTransaction(
+	_ context.Context, account, _ string, _ uint64, instructions *xrp.Instructions,
+) (*xrp.TxInput, string, error) {
+	return r.create("EscrowFinish", account, instructions.Sequence)
+}
+
+func (r *fakeEscrowRippler) CreateEscrowCancelTransaction(
+	_ context.Context, account, _ string, _ uint64, instructions *xrp.Instructions,
+) (*xrp.TxInput, string, error) {
+	return r.create("EscrowCancel", account, instructions.Sequence)
+}
+
+func (r *fakeEscrowRippler) create(txType, account string, sequence uint64) (*xrp.TxInput, string, error) {
+	r.created = append(r.created, txType)
+	if sequence == 0 {
+		sequence = 20
+	}
+	return &xrp.TxInput{
+		TransactionType: txType,
+		Account:         account,
+		Amount:          &xrp.CurrencyAmount{Value: "100000000"},
+		Sequence:        sequence,
+	}, "{}", nil
+}
+
+// fakeEscrowDetailRepo returns xrp_detail_tx by uuid
+type fakeEscrowDetailRepo struct {
+	watchrepo.XrpDetailTxRepositorier
+	txs      map[string]*models.XRPDetailTX
+	inserted []*models.XRPDetailTX
+}
+
+func (r *fakeEscrowDetailRepo) WithTx(_ *sql.Tx) watchrepo.XrpDetailTxRepositorier {
+	return r
+}
+
+func (r *fakeEscrowDetailRepo) GetOneByUUID(_ context.Context, uid string) (*models.XRPDetailTX, error) {
+	txDetail, ok := r.txs[uid]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return txDetail, nil
+}
+
+func (r *fakeEscrowDetailRepo) InsertBulk(_ context.Context, txItems []*models.XRPDetailTX) error {
+	r.inserted = append(r.inserted, txItems...)
+	return nil
+}
+
+// fakeEscrowRepo records status of escrow
+type fakeEscrowRepo struct {
+	watchrepo.XrpEscrowRepositorier
+	escrows   []*models.XRPEscrow
+	insertErr error
+	inserted  []*models.XRPEscrow
+	// statuses is updated status by escrow id
+	statuses map[int64]domainTx.EscrowStatus
+}
+
+func (r *fakeEscrowRepo) WithTx(_ *sql.Tx) watchrepo.XrpEscrowRepositorier {
+	return r
+}
+
+func (r *fakeEscrowRepo) GetAllOpen(_ context.Context) ([]*models.XRPEscrow, error) {
+	return r.escrows, nil
+}
+
+func (r *fakeEscrowRepo) Insert(_ context.Context, item *models.XRPEscrow) error {
+	if r.insertErr != nil {
+		return r.insertErr
+	}
+	r.inserted = append(r.inserted, item)
+	return nil
+}
+
+func (r *fakeEscrowRepo) UpdateFinishUUID(_ context.Context, id int64, _ string) (int64, error) {
+	r.setStatus(id, domainTx.EscrowStatusFinishing)
+	return 1, nil
+}
+
+func (r *fakeEscrowRepo) UpdateStatus(_ context.Context, id int64, status domainTx.EscrowStatus) (int64, error) {
+	r.setStatus(id, status)
+	return 1, nil
+}
+
+func (r *fakeEscrowRepo) setStatus(id int64, status domainTx.EscrowStatus) {
+	if r.statuses == nil {
+		r.statuses = make(map[int64]domainTx.EscrowStatus)
+	}
+	r.statuses[id] = status
+}
+
+func newFinishEscrowUseCase(
+	t *testing.T, rippler *fakeEscrowRippler, detailRepo *fakeEscrowDetailRepo, escrowRepo *fakeEscrowRepo,
+) *finishEscrowUseCase {
+	t.Helper()
+
+	return NewFinishEscrowUseCase(
+		rippler,
+		newTestDB(t),
+		uuid.NewGoogleUUIDHandler(),
+		nil,
+		&fakeTxRepo{action: domainTx.ActionTypeTransfer},
+		detailRepo,
+		escrowRepo,
+		&fakeTxFileRepo{},
+	).(*finishEscrowUseCase)
+}
+
+func newEscrow(id int64, status domainTx.EscrowStatus, finishAfter time.Time, cancelAfter null.Time) *models.XRPEscrow {
+	return &models.XRPEscrow{
+		ID:                 id,
+		OwnerAddress:       escrowOwner,
+		DestinationAddress: escrowOwner,
+		Amount:             "100000000",
+		OfferSequence:      10,
+		FinishAfter:        finishAfter,
+		CancelAfter:        cancelAfter,
+		CreateUUID:         "create-uuid",
+		FinishUUID:         "finish-uuid",
+		Status:             status.Int8(),
+	}
+}
+
+// TestFinishEscrowUpdateStatus is test for status of escrow by state of its transactions
+func TestFinishEscrowUpdateStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		// status of escrow
+		status domainTx.EscrowStatus
+		// txType is state of EscrowCreate for created escrow, EscrowFinish or EscrowCancel for finishing escrow
+		txType     domainTx.TxType
+		xrpTxType  string
+		wantReady  bool
+		wantStatus domainTx.EscrowStatus
+	}{
+		{
+			name:      "EscrowCreate is in progress",
+			status:    domainTx.EscrowStatusCreated,
+			txType:    domainTx.TxTypeSent,
+			xrpTxType: "EscrowCreate",
+		},
+		{
+			name:      "EscrowCreate is validated, escrow can be finished",
+			status:    domainTx.EscrowStatusCreated,
+			txType:    domainTx.TxTypeDone,
+			xrpTxType: "EscrowCreate",
+			wantReady: true,
+		},
+		{
+			name:       "EscrowCreate is expired",
+			status:     domainTx.EscrowStatusCreated,
+			txType:     domainTx.TxTypeExpired,
+			xrpTxType:  "EscrowCreate",
+			wantStatus: domainTx.EscrowStatusFailed,
+		},
+		{
+			name:       "EscrowCreate is canceled",
+			status:     domainTx.EscrowStatusCreated,
+			txType:     domainTx.TxTypeCancel,
+			xrpTxType:  "EscrowCreate",
+			wantStatus: domainTx.EscrowStatusFailed,
+		},
+		{
+			name:      "EscrowFinish is in progress",
+			status:    domainTx.EscrowStatusFinishing,
+			txType:    domainTx.TxTypeUnsigned,
+			xrpTxType: "EscrowFinish",
+		},
+		{
+			name:       "EscrowFinish is validated",
+			status:     domainTx.EscrowStatusFinishing,
+			txType:     domainTx.TxTypeDone,
+			xrpTxType:  "EscrowFinish",
+			wantStatus: domainTx.EscrowStatusFinished,
+		},
+		{
+			name:       "EscrowFinish is notified",
+			status:     domainTx.EscrowStatusFinishing,
+			txType:     domainTx.TxTypeNotified,
+			xrpTxType:  "EscrowFinish",
+			wantStatus: domainTx.EscrowStatusFinished,
+		},
+		{
+			name:       "EscrowCancel is validated",
+			status:     domainTx.EscrowStatusFinishing,
+			txType:     domainTx.TxTypeDone,
+			xrpTxType:  "EscrowCancel",
+			wantStatus: domainTx.EscrowStatusCanceled,
+		},
+		{
+			name:      "EscrowFinish is expired, escrow is finished again",
+			status:    domainTx.EscrowStatusFinishing,
+			txType:    domainTx.TxTypeExpired,
+			xrpTxType: "EscrowFinish",
+			wantReady: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targetUUID := "create-uuid"
+			if tt.status == domainTx.EscrowStatusFinishing {
+				targetUUID = "finish-uuid"
+			}
+			detailRepo := &fakeEscrowDetailRepo{txs: map[string]*models.XRPDetailTX{
+				targetUUID: {UUID: targetUUID, CurrentTXType: tt.txType.Int8(), XRPTXType: tt.xrpTxType},
+			}}
+			escrowRepo := &fakeEscrowRepo{}
+			u := newFinishEscrowUseCase(t, &fakeEscrowRippler{}, detailRepo, escrowRepo)
+
+			isReady, err := u.updateStatus(context.Background(), newEscrow(1, tt.status, time.Now(), null.Time{}))
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReady, isReady)
+			assert.Equal(t, tt.wantStatus, escrowRepo.statuses[1])
+		})
+	}
+}
+
+// TestFinishEscrowExecute is test for transition of matured escrow from created to finishing
+func TestFinishEscrowExecute(t *testing.T) {
+	now := time.Now()
+	escrowRepo := &fakeEscrowRepo{escrows: []*models.XRPEscrow{
+		// matured
+		newEscrow(1, domainTx.EscrowStatusCreated, now.Add(-time.Hour), null.Time{}),
+		// not matured yet
+		newEscrow(2, domainTx.EscrowStatusCreated, now.Add(time.Hour), null.Time{}),
+		// CancelAfter has passed, it's canceled instead
+		newEscrow(3, domainTx.EscrowStatusCreated, now.Add(-2*time.Hour), null.TimeFrom(now.Add(-time.Hour))),
+		// EscrowFinish is expired, it's finished again
+		newEscrow(4, domainTx.EscrowStatusFinishing, now.Add(-time.Hour), null.TimeFrom(now.Add(time.Hour))),
+	}}
+	detailRepo := &fakeEscrowDetailRepo{txs: map[string]*models.XRPDetailTX{
+		"create-uuid": {UUID: "create-uuid", CurrentTXType: domainTx.TxTypeDone.Int8(), XRPTXType: "EscrowCreate"},
+		"finish-uuid": {UUID: "finish-uuid", CurrentTXType: domainTx.TxTypeExpired.Int8(), XRPTXType: "EscrowFinish"},
+	}}
+	rippler := &fakeEscrowRippler{}
+	u := newFinishEscrowUseCase(t, rippler, detailRepo, escrowRepo)
+
+	output, err := u.Execute(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, output.FileName)
+	assert.Equal(t, []string{"EscrowFinish", "EscrowCancel", "EscrowFinish"}, rippler.created)
+	assert.Equal(t, map[int64]domainTx.EscrowStatus{
+		1: domainTx.EscrowStatusFinishing,
+		3: domainTx.EscrowStatusFinishing,
+		4: domainTx.EscrowStatusFinishing,
+	}, escrowRepo.statuses)
+
+	// consecutive sequences are used for transactions of the same owner
+	require.Len(t, detailRepo.inserted, 3)
+	assert.Equal(t, uint64(20), detailRepo.inserted[0].Sequence)
+	assert.Equal(t, uint64(21), detailRepo.inserted[1].Sequence)
+	assert.Equal(t, uint64(22), detailRepo.inserted[2].Sequence)
+}
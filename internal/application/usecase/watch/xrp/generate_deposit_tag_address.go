@@ -0,0 +1,123 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type generateDepositTagAddressUseCase struct {
+	rippler        ripple.Rippler
+	addrRepo       watchrepo.AddressRepositorier
+	depositAddress string
+	isTestNet      bool
+}
+
+// NewGenerateDepositTagAddressUseCase creates a new GenerateDepositTagAddressUseCase
+//   - client address is X-address of shared deposit address and destination tag, so keygen wallet isn't needed
+func NewGenerateDepositTagAddressUseCase(
+	rippler ripple.Rippler,
+	addrRepo watchrepo.AddressRepositorier,
+	depositAddress string,
+	isTestNet bool,
+) watchusecase.GenerateDepositTagAddressUseCase {
+	return &generateDepositTagAddressUseCase{
+		rippler:        rippler,
+		addrRepo:       addrRepo,
+		depositAddress: depositAddress,
+		isTestNet:      isTestNet,
+	}
+}
+
+func (u *generateDepositTagAddressUseCase) Execute(
+	ctx context.Context,
+	input watchusecase.GenerateDepositTagAddressInput,
+) (_ watchusecase.GenerateDepositTagAddressOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.GenerateDepositTagAddress.Execute")
+	defer tracer.End(span, &err)
+
+	if u.depositAddress == "" {
+		return watchusecase.GenerateDepositTagAddressOutput{}, errors.New(
+			"address of ripple.deposit_tag is required in toml file")
+	}
+	if input.Count == 0 {
+		return watchusecase.GenerateDepositTagAddressOutput{}, errors.New("count is required")
+	}
+	if err = u.validateDepositAddress(ctx); err != nil {
+		return watchusecase.GenerateDepositTagAddressOutput{}, err
+	}
+
+	// next tag is the largest tag on shared deposit address + 1, tag starts from 1
+	clientAddrs, err := u.addrRepo.GetAllAddress(ctx, domainAccount.AccountTypeClient)
+	if err != nil {
+		return watchusecase.GenerateDepositTagAddressOutput{}, fmt.Errorf(
+			"fail to call addrRepo.GetAllAddress(): %w", err)
+	}
+	var lastTag uint32
+	for _, clientAddr := range clientAddrs {
+		addr, tag, hasTag, err := xrp.DecodeXAddress(clientAddr)
+		if err != nil || !hasTag || addr != u.depositAddress {
+			continue
+		}
+		lastTag = max(lastTag, tag)
+	}
+	if uint64(lastTag)+uint64(input.Count) > uint64(^uint32(0)) {
+		return watchusecase.GenerateDepositTagAddressOutput{}, errors.New("destination tag is exhausted")
+	}
+
+	addresses := make([]string, 0, input.Count)
+	items := make([]*models.Address, 0, input.Count)
+	for i := range input.Count {
+		addr, err := xrp.EncodeXAddress(u.depositAddress, lastTag+i+1, u.isTestNet)
+		if err != nil {
+			return watchusecase.GenerateDepositTagAddressOutput{}, fmt.Errorf(
+				"fail to call xrp.EncodeXAddress(): %w", err)
+		}
+		addresses = append(addresses, addr)
+		items = append(items, &models.Address{
+			Coin:          u.rippler.CoinTypeCode().String(),
+			Account:       domainAccount.AccountTypeClient.String(),
+			WalletAddress: addr,
+		})
+	}
+	if err = u.addrRepo.InsertBulk(ctx, items); err != nil {
+		return watchusecase.GenerateDepositTagAddressOutput{}, fmt.Errorf(
+			"fail to call addrRepo.InsertBulk(): %w", err)
+	}
+	logger.DebugContext(ctx, "X-addresses with destination tag are generated",
+		"deposit_address", u.depositAddress,
+		"start_tag", lastTag+1,
+		"count", input.Count,
+	)
+
+	return watchusecase.GenerateDepositTagAddressOutput{Addresses: addresses}, nil
+}
+
+// validateDepositAddress validates shared deposit address is address of deposit account which requires destination tag
+func (u *generateDepositTagAddressUseCase) validateDepositAddress(ctx context.Context) error {
+	depositAddrs, err := u.addrRepo.GetAllAddress(ctx, domainAccount.AccountTypeDeposit)
+	if err != nil {
+		return fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+	}
+	if !slices.Contains(depositAddrs, u.depositAddress) {
+		return fmt.Errorf("%s is not address of deposit account", u.depositAddress)
+	}
+	isRequireDest, err := u.rippler.IsRequireDest(ctx, u.depositAddress)
+	if err != nil {
+		return fmt.Errorf("fail to call rippler.IsRequireDest(): %w", err)
+	}
+	if !isRequireDest {
+		return fmt.Errorf("destination tag is not required by %s, send AccountSet transaction first", u.depositAddress)
+	}
+	return nil
+}
@@ -2,43 +2,286 @@ package xrp
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 
 	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
 	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
 )
 
 type monitorTransactionUseCase struct {
 	rippler  ripple.Rippler
 	addrRepo watchrepo.AddressRepositorier
+	// tx shares creation of replacement payment with CreateTransactionUseCase
+	tx       *createTransactionUseCase
+	maxRetry uint32
 }
 
 // NewMonitorTransactionUseCase creates a new MonitorTransactionUseCase
+//   - maxRetry is number of times expired payment is replaced, 0 disables replacement
 func NewMonitorTransactionUseCase(
 	rippler ripple.Rippler,
+	dbConn *sql.DB,
+	uuidHandler uuid.UUIDHandler,
 	addrRepo watchrepo.AddressRepositorier,
+	txRepo watchrepo.TxRepositorier,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	payReqRepo watchrepo.PaymentRequestRepositorier,
+	txFileRepo file.TransactionFileRepositorier,
+	maxRetry uint32,
 ) watchusecase.MonitorTransactionUseCase {
 	return &monitorTransactionUseCase{
 		rippler:  rippler,
 		addrRepo: addrRepo,
+		tx: &createTransactionUseCase{
+			rippler:      rippler,
+			dbConn:       dbConn,
+			uuidHandler:  uuidHandler,
+			addrRepo:     addrRepo,
+			txRepo:       txRepo,
+			txDetailRepo: txDetailRepo,
+			payReqRepo:   payReqRepo,
+			txFileRepo:   txFileRepo,
+		},
+		maxRetry: maxRetry,
 	}
 }
 
-// UpdateTxStatus updates transaction status
-// Note: For XRP, UpdateTxStatus is a no-op (returns nil)
-func (*monitorTransactionUseCase) UpdateTxStatus(ctx context.Context) error {
-	// No need for XRP - transactions are validated immediately upon submission
+// UpdateTxStatus detects transactions expired by LastLedgerSequence
+//   - validated transaction is marked done by StreamMonitorUseCase
+//   - transaction stays unsigned when WaitValidation gives up
+//   - it's expired once validated ledger passes LastLedgerSequence
+//   - expired payment is replaced by new unsigned transaction for next signing round
+func (u *monitorTransactionUseCase) UpdateTxStatus(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.MonitorTransaction.UpdateTxStatus")
+	defer tracer.End(span, &err)
+
+	ledgerIndex, err := u.rippler.GetValidatedLedgerIndex(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to call rippler.GetValidatedLedgerIndex(): %w", err)
+	}
+	items, err := u.tx.txDetailRepo.GetExpired(ctx, ledgerIndex)
+	if err != nil {
+		return fmt.Errorf("fail to call txDetailRepo.GetExpired(): %w", err)
+	}
+	if len(items) == 0 {
+		logger.DebugContext(ctx, "no expired transaction", "ledger_index", ledgerIndex)
+		return nil
+	}
+
+	// group by tx_id because replacement is created per transaction
+	var txIDs []int64
+	itemsByTxID := make(map[int64][]*models.XRPDetailTX)
+	for _, item := range items {
+		if _, ok := itemsByTxID[item.TXID]; !ok {
+			txIDs = append(txIDs, item.TXID)
+		}
+		itemsByTxID[item.TXID] = append(itemsByTxID[item.TXID], item)
+	}
+	for _, txID := range txIDs {
+		if err = u.expireTx(ctx, txID, itemsByTxID[txID]); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// expireTx marks transactions expired and replaces expired payments
+//   - transaction whose sequence or ticket is already consumed may be validated, so it's left for investigation
+//   - expired is set only if transaction is still unsigned, another daemon replica can't replace it twice
+func (u *monitorTransactionUseCase) expireTx(ctx context.Context, txID int64, items []*models.XRPDetailTX) error {
+	txItem, err := u.tx.txRepo.GetOne(ctx, txID)
+	if err != nil {
+		return fmt.Errorf("fail to call txRepo.GetOne(%d): %w", txID, err)
+	}
+	action := domainTx.ActionType(txItem.Action)
+
+	expiredItems := make([]*models.XRPDetailTX, 0, len(items))
+	for _, item := range items {
+		var isUsed bool
+		isUsed, err = u.rippler.IsSequenceUsed(ctx, item.SenderAddress, item.Sequence, item.TicketSequence)
+		if err != nil {
+			return fmt.Errorf("fail to call rippler.IsSequenceUsed(): %w", err)
+		}
+		if isUsed {
+			logger.ErrorContext(ctx, "ALERT: sequence of expired transaction is already used, it may be validated",
+				"tx_id", txID,
+				"uuid", item.UUID,
+				"sender_address", item.SenderAddress,
+				"sequence", item.Sequence,
+				"ticket_sequence", item.TicketSequence)
+			continue
+		}
+
+		var affected int64
+		affected, err = u.tx.txDetailRepo.UpdateTxTypeFrom(ctx, item.ID, domainTx.TxTypeUnsigned, domainTx.TxTypeExpired)
+		if err != nil {
+			return fmt.Errorf("fail to call txDetailRepo.UpdateTxTypeFrom(): %w", err)
+		}
+		if affected == 0 {
+			continue
+		}
+		metrics.IncTx(u.rippler.CoinTypeCode().String(), action.String(), metrics.TxStatusExpired)
+		logger.WarnContext(ctx, "transaction is expired",
+			"tx_id", txID,
+			"uuid", item.UUID,
+			"action", action.String(),
+			"last_ledger_sequence", item.LastLedgerSequence,
+			"retry_count", item.RetryCount)
+		expiredItems = append(expiredItems, item)
+	}
+	if len(expiredItems) == 0 {
+		return nil
+	}
+
+	// deposit is swept again by next deposit transaction, transfer is created by operator
+	if action != domainTx.ActionTypePayment {
+		logger.ErrorContext(ctx, "ALERT: expired transaction is not resubmitted automatically",
+			"tx_id", txID,
+			"action", action.String(),
+			"count", len(expiredItems))
+		return nil
+	}
+	return u.replacePayment(ctx, txID, expiredItems)
+}
+
+// replacePayment creates new unsigned payment for expired payment
+//   - payment_request is matched with expired transaction by payment_request_id
+//   - payment_request whose replacement isn't created is released from expired transaction,
+//     so it's sent by next payment transaction
+//   - payment which reached max retry isn't replaced automatically and is released as well
+func (u *monitorTransactionUseCase) replacePayment(
+	ctx context.Context, txID int64, expiredItems []*models.XRPDetailTX,
+) error {
+	paymentRequests, err := u.tx.payReqRepo.GetAllByPaymentID(ctx, txID)
+	if err != nil {
+		return fmt.Errorf("fail to call payReqRepo.GetAllByPaymentID(): %w", err)
+	}
+
+	userPayments := make([]userPayment, 0, len(expiredItems))
+	var releasedIDs []int64
+	for _, item := range expiredItems {
+		idx := slices.IndexFunc(paymentRequests, func(req *models.PaymentRequest) bool {
+			return req.ID == item.PaymentRequestID
+		})
+		if idx == -1 {
+			logger.ErrorContext(ctx, "ALERT: payment_request of expired payment is not found",
+				"tx_id", txID,
+				"uuid", item.UUID,
+				"payment_request_id", item.PaymentRequestID)
+			continue
+		}
+		req := paymentRequests[idx]
+		if item.RetryCount >= u.maxRetry {
+			logger.ErrorContext(ctx, "ALERT: expired payment reached max retry",
+				"tx_id", txID,
+				"uuid", item.UUID,
+				"payment_request_id", req.ID,
+				"retry_count", item.RetryCount)
+			releasedIDs = append(releasedIDs, req.ID)
+			continue
+		}
+
+		var amt float64
+		amt, err = strconv.ParseFloat(req.Amount.String(), 64)
+		if err != nil {
+			return fmt.Errorf("payment_request table includes invalid amount field: %w", err)
+		}
+		userPayments = append(userPayments, userPayment{
+			paymentRequestID: req.ID,
+			senderAddr:       req.SenderAddress,
+			receiverAddr:     req.ReceiverAddress,
+			floatAmount:      amt,
+			retryCount:       item.RetryCount + 1,
+		})
+	}
+
+	linkedIDs, err := u.createReplacement(ctx, txID, expiredItems[0], userPayments)
+	for _, payment := range userPayments {
+		if !slices.Contains(linkedIDs, payment.paymentRequestID) {
+			releasedIDs = append(releasedIDs, payment.paymentRequestID)
+		}
+	}
+	if len(releasedIDs) != 0 {
+		if _, resetErr := u.tx.payReqRepo.ResetPaymentID(ctx, releasedIDs); resetErr != nil {
+			return errors.Join(err, fmt.Errorf("fail to call payReqRepo.ResetPaymentID(): %w", resetErr))
+		}
+		logger.WarnContext(ctx, "payment_request of expired payment is released for next payment transaction",
+			"expired_tx_id", txID,
+			"payment_request_ids", releasedIDs)
+	}
+	return err
+}
+
+// createReplacement creates new unsigned transaction for payments and links payment_request to it
+//   - ids of payment_request linked to new transaction are returned
+//   - payment whose raw transaction can't be created isn't linked
+func (u *monitorTransactionUseCase) createReplacement(
+	ctx context.Context, txID int64, expiredItem *models.XRPDetailTX, userPayments []userPayment,
+) ([]int64, error) {
+	if len(userPayments) == 0 {
+		return nil, nil
+	}
+
+	// payment is sent from only one address of payment account
+	sender := domainAccount.AccountType(expiredItem.SenderAccount)
+	receiver := domainAccount.AccountType(expiredItem.ReceiverAccount)
+	senderAddr := &models.Address{WalletAddress: expiredItem.SenderAddress}
+
+	signerList, err := u.rippler.GetSignerList(ctx, senderAddr.WalletAddress)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call rippler.GetSignerList(): %w", err)
+	}
+	tickets, err := u.tx.getAvailableTickets(ctx, senderAddr.WalletAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	serializedTxs, txDetailItems, paymentRequestIds := u.tx.createPaymentRawTransactions(
+		ctx, sender, receiver, userPayments, senderAddr, signerList, tickets)
+	if len(txDetailItems) == 0 {
+		logger.ErrorContext(ctx, "ALERT: fail to create replacement of expired payment", "tx_id", txID)
+		return nil, nil
+	}
+
+	newTxID, err := u.tx.updateDB(ctx, domainTx.ActionTypePayment, txDetailItems, paymentRequestIds)
+	if err != nil {
+		return nil, err
+	}
+	// payment_request is already linked to new transaction, so it is not released even if file is not generated
+	generatedFileName, err := u.tx.generateHexFile(ctx, domainTx.ActionTypePayment, sender, newTxID, serializedTxs)
+	if err != nil {
+		return paymentRequestIds, fmt.Errorf("fail to call generateHexFile(): %w", err)
+	}
+
+	logger.InfoContext(ctx, "expired payment is replaced, sign it in next signing round",
+		"expired_tx_id", txID,
+		"tx_id", newTxID,
+		"count", len(txDetailItems),
+		"file", generatedFileName)
+	return paymentRequestIds, nil
+}
+
 // MonitorBalance monitors balance across all account types
 func (u *monitorTransactionUseCase) MonitorBalance(
 	ctx context.Context,
 	input watchusecase.MonitorBalanceInput,
-) error {
+) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.MonitorTransaction.MonitorBalance")
+	defer tracer.End(span, &err)
+
 	targetAccounts := []domainAccount.AccountType{
 		domainAccount.AccountTypeClient,
 		domainAccount.AccountTypeDeposit,
@@ -47,12 +290,13 @@ func (u *monitorTransactionUseCase) MonitorBalance(
 	}
 
 	for _, acnt := range targetAccounts {
-		addrs, err := u.addrRepo.GetAllAddress(acnt)
+		addrs, err := u.addrRepo.GetAllAddress(ctx, acnt)
 		if err != nil {
 			return fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
 		}
 		total := u.rippler.GetTotalBalance(ctx, addrs)
-		logger.Info("total balance",
+		metrics.SetBalance(u.rippler.CoinTypeCode().String(), acnt.String(), total)
+		logger.InfoContext(ctx, "total balance",
 			"account", acnt.String(),
 			"balance", total)
 	}
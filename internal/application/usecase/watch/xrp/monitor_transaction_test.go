@@ -0,0 +1,423 @@
+package xrp
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed" // SQLite compiled to wasm, no cgo is required
+	"github.com/ncruces/go-sqlite3/vfs/memdb"
+	"github.com/quagmt/udecimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+const (
+	expiredTxID     int64 = 100
+	replacementTxID int64 = 200
+)
+
+// fakeResubmitRippler is Rippler for expired transaction, other methods aren't implemented
+type fakeResubmitRippler struct {
+	ripple.Rippler
+	// usedSequences are sequences which are already consumed by account
+	usedSequences []uint64
+	// failReceivers are receivers whose raw transaction can't be created
+	failReceivers  []string
+	signerListErr  error
+	createdAmounts map[string][]string
+}
+
+func (r *fakeResubmitRippler) CoinTypeCode() domainCoin.CoinTypeCode {
+	return domainCoin.XRP
+}
+
+func (r *fakeResubmitRippler) IsSequenceUsed(_ context.Context, _ string, sequence, _ uint64) (bool, error) {
+	return slices.Contains(r.usedSequences, sequence), nil
+}
+
+func (r *fakeResubmitRippler) GetSignerList(_ context.Context, _ string) (*xrp.SignerList, error) {
+	return nil, r.signerListErr
+}
+
+func (r *fakeResubmitRippler) GetTickets(_ context.Context, _ string) ([]uint64, error) {
+	return nil, nil
+}
+
+func (r *fakeResubmitRippler) CreateRawTransaction(
+	_ context.Context, senderAccount, receiverAccount string, amount float64, _ *xrp.Instructions,
+) (*xrp.TxInput, string, error) {
+	if slices.Contains(r.failReceivers, receiverAccount) {
+		return nil, "", errors.New("tecNO_DST")
+	}
+	value := strconv.FormatFloat(amount, 'f', -1, 64)
+	if r.createdAmounts == nil {
+		r.createdAmounts = make(map[string][]string)
+	}
+	r.createdAmounts[receiverAccount] = append(r.createdAmounts[receiverAccount], value)
+	return &xrp.TxInput{
+		TransactionType: "Payment",
+		Account:         senderAccount,
+		Destination:     receiverAccount,
+		Amount:          &xrp.CurrencyAmount{Value: value},
+	}, "{}", nil
+}
+
+// fakeTxRepo returns fixed action of transaction, new transaction is always replacementTxID
+type fakeTxRepo struct {
+	watchrepo.TxRepositorier
+	action domainTx.ActionType
+}
+
+func (r *fakeTxRepo) WithTx(_ *sql.Tx) watchrepo.TxRepositorier {
+	return r
+}
+
+func (r *fakeTxRepo) GetOne(_ context.Context, id int64) (*models.TX, error) {
+	return &models.TX{ID: id, Action: r.action.String()}, nil
+}
+
+func (r *fakeTxRepo) InsertUnsignedTx(_ context.Context, _ domainTx.ActionType) (int64, error) {
+	return replacementTxID, nil
+}
+
+// fakeResubmitDetailRepo records expired and inserted xrp_detail_tx
+type fakeResubmitDetailRepo struct {
+	watchrepo.XrpDetailTxRepositorier
+	// expiredByOther are ids already expired by another replica
+	expiredByOther []int64
+	expired        []int64
+	inserted       []*models.XRPDetailTX
+}
+
+func (r *fakeResubmitDetailRepo) WithTx(_ *sql.Tx) watchrepo.XrpDetailTxRepositorier {
+	return r
+}
+
+func (r *fakeResubmitDetailRepo) UpdateTxTypeFrom(
+	_ context.Context, id int64, from, to domainTx.TxType,
+) (int64, error) {
+	if from != domainTx.TxTypeUnsigned || to != domainTx.TxTypeExpired {
+		return 0, errors.New("unexpected tx type")
+	}
+	if slices.Contains(r.expiredByOther, id) {
+		return 0, nil
+	}
+	r.expired = append(r.expired, id)
+	return 1, nil
+}
+
+func (r *fakeResubmitDetailRepo) GetTicketSequences(_ context.Context, _ string) ([]uint64, error) {
+	return nil, nil
+}
+
+func (r *fakeResubmitDetailRepo) InsertBulk(_ context.Context, txItems []*models.XRPDetailTX) error {
+	r.inserted = append(r.inserted, txItems...)
+	return nil
+}
+
+// fakeResubmitPayReqRepo records payment_request linked to replacement or released
+type fakeResubmitPayReqRepo struct {
+	watchrepo.PaymentRequestRepositorier
+	requests []*models.PaymentRequest
+	linked   []int64
+	released []int64
+}
+
+func (r *fakeResubmitPayReqRepo) WithTx(_ *sql.Tx) watchrepo.PaymentRequestRepositorier {
+	return r
+}
+
+func (r *fakeResubmitPayReqRepo) GetAllByPaymentID(_ context.Context, paymentID int64) ([]*models.PaymentRequest, error) {
+	if paymentID != expiredTxID {
+		return nil, nil
+	}
+	return r.requests, nil
+}
+
+func (r *fakeResubmitPayReqRepo) UpdatePaymentID(_ context.Context, paymentID int64, ids []int64) (int64, error) {
+	if paymentID != replacementTxID {
+		return 0, errors.New("unexpected payment id")
+	}
+	r.linked = append(r.linked, ids...)
+	return int64(len(ids)), nil
+}
+
+func (r *fakeResubmitPayReqRepo) ResetPaymentID(_ context.Context, ids []int64) (int64, error) {
+	r.released = append(r.released, ids...)
+	return int64(len(ids)), nil
+}
+
+// fakeTxFileRepo records written unsigned file
+type fakeTxFileRepo struct {
+	file.TransactionFileRepositorier
+	written int
+}
+
+func (r *fakeTxFileRepo) CreateFilePath(_ domainTx.ActionType, _ domainTx.TxType, _ int64, _ int) string {
+	return "payment_200_unsigned_0"
+}
+
+func (r *fakeTxFileRepo) WriteFileSlice(_ context.Context, path string, _ []string) (string, error) {
+	r.written++
+	return path, nil
+}
+
+// newTestDB returns in-memory sqlite which runs database transaction of updateDB
+//   - database is shared by connections, so tables created by test are seen in and out of the transaction
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dbConn, err := driver.Open(memdb.TestDB(t))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = dbConn.Close() })
+	return dbConn
+}
+
+type resubmitFakes struct {
+	rippler    *fakeResubmitRippler
+	detailRepo *fakeResubmitDetailRepo
+	payReqRepo *fakeResubmitPayReqRepo
+	fileRepo   *fakeTxFileRepo
+}
+
+func newResubmitUseCase(
+	t *testing.T, action domainTx.ActionType, fakes *resubmitFakes,
+) *monitorTransactionUseCase {
+	t.Helper()
+
+	if fakes.rippler == nil {
+		fakes.rippler = &fakeResubmitRippler{}
+	}
+	if fakes.detailRepo == nil {
+		fakes.detailRepo = &fakeResubmitDetailRepo{}
+	}
+	if fakes.payReqRepo == nil {
+		fakes.payReqRepo = &fakeResubmitPayReqRepo{}
+	}
+	fakes.fileRepo = &fakeTxFileRepo{}
+
+	return NewMonitorTransactionUseCase(
+		fakes.rippler,
+		newTestDB(t),
+		uuid.NewGoogleUUIDHandler(),
+		nil,
+		&fakeTxRepo{action: action},
+		fakes.detailRepo,
+		fakes.payReqRepo,
+		fakes.fileRepo,
+		2,
+	).(*monitorTransactionUseCase)
+}
+
+func newExpiredItem(id, paymentRequestID int64, receiver string, sequence uint64, retryCount uint32) *models.XRPDetailTX {
+	return &models.XRPDetailTX{
+		ID:                 id,
+		TXID:               expiredTxID,
+		UUID:               "uuid-" + strconv.FormatInt(id, 10),
+		CurrentTXType:      domainTx.TxTypeUnsigned.Int8(),
+		SenderAccount:      "payment",
+		SenderAddress:      "rPayment",
+		ReceiverAccount:    "client",
+		ReceiverAddress:    receiver,
+		Sequence:           sequence,
+		LastLedgerSequence: 1000,
+		RetryCount:         retryCount,
+		PaymentRequestID:   paymentRequestID,
+	}
+}
+
+func newPaymentRequest(id int64, receiver, amount string) *models.PaymentRequest {
+	return &models.PaymentRequest{
+		ID:              id,
+		SenderAddress:   "rPayment",
+		ReceiverAddress: receiver,
+		Amount:          udecimal.MustParse(amount),
+	}
+}
+
+// TestExpireTx is test for expiration of unsigned transactions passed LastLedgerSequence
+func TestExpireTx(t *testing.T) {
+	t.Run("used sequence is left and others are replaced", func(t *testing.T) {
+		fakes := &resubmitFakes{
+			rippler: &fakeResubmitRippler{usedSequences: []uint64{10}},
+			payReqRepo: &fakeResubmitPayReqRepo{requests: []*models.PaymentRequest{
+				newPaymentRequest(1, "rReceiverA", "1.5"),
+				newPaymentRequest(2, "rReceiverB", "2.5"),
+			}},
+		}
+		u := newResubmitUseCase(t, domainTx.ActionTypePayment, fakes)
+		err := u.expireTx(context.Background(), expiredTxID, []*models.XRPDetailTX{
+			newExpiredItem(11, 1, "rReceiverA", 10, 0),
+			newExpiredItem(12, 2, "rReceiverB", 11, 0),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{12}, fakes.detailRepo.expired)
+		assert.Equal(t, []int64{2}, fakes.payReqRepo.linked)
+		assert.Empty(t, fakes.payReqRepo.released)
+		assert.Equal(t, 1, fakes.fileRepo.written)
+	})
+
+	t.Run("transaction expired by another replica isn't replaced", func(t *testing.T) {
+		fakes := &resubmitFakes{
+			detailRepo: &fakeResubmitDetailRepo{expiredByOther: []int64{11}},
+			payReqRepo: &fakeResubmitPayReqRepo{requests: []*models.PaymentRequest{
+				newPaymentRequest(1, "rReceiverA", "1.5"),
+			}},
+		}
+		u := newResubmitUseCase(t, domainTx.ActionTypePayment, fakes)
+		err := u.expireTx(context.Background(), expiredTxID, []*models.XRPDetailTX{
+			newExpiredItem(11, 1, "rReceiverA", 10, 0),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, fakes.detailRepo.expired)
+		assert.Empty(t, fakes.payReqRepo.linked)
+		assert.Empty(t, fakes.payReqRepo.released)
+	})
+
+	t.Run("deposit is expired but not replaced", func(t *testing.T) {
+		fakes := &resubmitFakes{}
+		u := newResubmitUseCase(t, domainTx.ActionTypeDeposit, fakes)
+		err := u.expireTx(context.Background(), expiredTxID, []*models.XRPDetailTX{
+			newExpiredItem(11, 0, "rDeposit", 10, 0),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{11}, fakes.detailRepo.expired)
+		assert.Empty(t, fakes.detailRepo.inserted)
+		assert.Empty(t, fakes.payReqRepo.released)
+	})
+}
+
+// TestReplacePayment is test for replacement of expired payment and release of payment_request
+func TestReplacePayment(t *testing.T) {
+	tests := []struct {
+		name         string
+		rippler      *fakeResubmitRippler
+		items        []*models.XRPDetailTX
+		wantErr      bool
+		wantLinked   []int64
+		wantReleased []int64
+		wantRetry    []uint32
+	}{
+		{
+			name:    "all payments are replaced",
+			rippler: &fakeResubmitRippler{},
+			items: []*models.XRPDetailTX{
+				newExpiredItem(11, 1, "rReceiverA", 10, 0),
+				newExpiredItem(12, 2, "rReceiverB", 11, 1),
+			},
+			wantLinked: []int64{1, 2},
+			wantRetry:  []uint32{1, 2},
+		},
+		{
+			name:    "payment reached max retry is released",
+			rippler: &fakeResubmitRippler{},
+			items: []*models.XRPDetailTX{
+				newExpiredItem(11, 1, "rReceiverA", 10, 2),
+				newExpiredItem(12, 2, "rReceiverB", 11, 0),
+			},
+			wantLinked:   []int64{2},
+			wantReleased: []int64{1},
+			wantRetry:    []uint32{1},
+		},
+		{
+			name:    "payment whose raw transaction fails is released",
+			rippler: &fakeResubmitRippler{failReceivers: []string{"rReceiverB"}},
+			items: []*models.XRPDetailTX{
+				newExpiredItem(11, 1, "rReceiverA", 10, 0),
+				newExpiredItem(12, 2, "rReceiverB", 11, 0),
+			},
+			wantLinked:   []int64{1},
+			wantReleased: []int64{2},
+			wantRetry:    []uint32{1},
+		},
+		{
+			name:    "all payments are released when no replacement is created",
+			rippler: &fakeResubmitRippler{failReceivers: []string{"rReceiverA", "rReceiverB"}},
+			items: []*models.XRPDetailTX{
+				newExpiredItem(11, 1, "rReceiverA", 10, 0),
+				newExpiredItem(12, 2, "rReceiverB", 11, 0),
+			},
+			wantReleased: []int64{1, 2},
+		},
+		{
+			name:    "all payments are released when replacement fails",
+			rippler: &fakeResubmitRippler{signerListErr: errors.New("connection refused")},
+			items: []*models.XRPDetailTX{
+				newExpiredItem(11, 1, "rReceiverA", 10, 0),
+			},
+			wantErr:      true,
+			wantReleased: []int64{1},
+		},
+		{
+			name:    "payment_request isn't found",
+			rippler: &fakeResubmitRippler{},
+			items: []*models.XRPDetailTX{
+				newExpiredItem(11, 9, "rReceiverA", 10, 0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakes := &resubmitFakes{
+				rippler: tt.rippler,
+				payReqRepo: &fakeResubmitPayReqRepo{requests: []*models.PaymentRequest{
+					newPaymentRequest(1, "rReceiverA", "1.5"),
+					newPaymentRequest(2, "rReceiverB", "2.5"),
+				}},
+			}
+			u := newResubmitUseCase(t, domainTx.ActionTypePayment, fakes)
+			err := u.replacePayment(context.Background(), expiredTxID, tt.items)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantLinked, fakes.payReqRepo.linked)
+			assert.Equal(t, tt.wantReleased, fakes.payReqRepo.released)
+
+			retryCounts := make([]uint32, 0, len(fakes.detailRepo.inserted))
+			for _, inserted := range fakes.detailRepo.inserted {
+				assert.Equal(t, replacementTxID, inserted.TXID)
+				retryCounts = append(retryCounts, inserted.RetryCount)
+			}
+			if len(tt.wantRetry) == 0 {
+				assert.Empty(t, retryCounts)
+			} else {
+				assert.Equal(t, tt.wantRetry, retryCounts)
+			}
+		})
+	}
+
+	t.Run("payment_request is matched by id, not by receiver address", func(t *testing.T) {
+		fakes := &resubmitFakes{
+			payReqRepo: &fakeResubmitPayReqRepo{requests: []*models.PaymentRequest{
+				newPaymentRequest(1, "rReceiverA", "1.5"),
+				newPaymentRequest(2, "rReceiverA", "2.5"),
+			}},
+		}
+		u := newResubmitUseCase(t, domainTx.ActionTypePayment, fakes)
+		// only payment of second payment_request expired
+		err := u.replacePayment(context.Background(), expiredTxID, []*models.XRPDetailTX{
+			newExpiredItem(12, 2, "rReceiverA", 11, 0),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2}, fakes.payReqRepo.linked)
+		assert.Equal(t, []string{"2.5"}, fakes.rippler.createdAmounts["rReceiverA"])
+		require.Len(t, fakes.detailRepo.inserted, 1)
+		assert.Equal(t, int64(2), fakes.detailRepo.inserted[0].PaymentRequestID)
+	})
+}
@@ -0,0 +1,114 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+type quarantinedDepositUseCase struct {
+	addrRepo    watchrepo.AddressRepositorier
+	depositRepo watchrepo.XrpDepositRepositorier
+}
+
+// NewQuarantinedDepositUseCase creates a new QuarantinedDepositUseCase
+//   - deposit to shared deposit address without known destination tag or currency is quarantined by stream monitor
+func NewQuarantinedDepositUseCase(
+	addrRepo watchrepo.AddressRepositorier,
+	depositRepo watchrepo.XrpDepositRepositorier,
+) watchusecase.QuarantinedDepositUseCase {
+	return &quarantinedDepositUseCase{
+		addrRepo:    addrRepo,
+		depositRepo: depositRepo,
+	}
+}
+
+// List returns all quarantined deposits
+func (u *quarantinedDepositUseCase) List(ctx context.Context) (_ watchusecase.ListQuarantinedDepositOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.QuarantinedDeposit.List")
+	defer tracer.End(span, &err)
+
+	deposits, err := u.depositRepo.GetAllQuarantined(ctx)
+	if err != nil {
+		return watchusecase.ListQuarantinedDepositOutput{}, fmt.Errorf(
+			"fail to call depositRepo.GetAllQuarantined(): %w", err)
+	}
+
+	output := watchusecase.ListQuarantinedDepositOutput{
+		Deposits: make([]watchusecase.QuarantinedDeposit, 0, len(deposits)),
+	}
+	for _, deposit := range deposits {
+		item := watchusecase.QuarantinedDeposit{
+			ID:          deposit.ID,
+			TxHash:      deposit.TXHash,
+			LedgerIndex: deposit.LedgerIndex,
+			Sender:      deposit.SenderAddress,
+			Amount:      deposit.Amount,
+			Currency:    deposit.Currency,
+			Issuer:      deposit.Issuer,
+		}
+		if deposit.DestinationTag.Valid {
+			item.DestinationTag = &deposit.DestinationTag.Int64
+		}
+		output.Deposits = append(output.Deposits, item)
+	}
+	return output, nil
+}
+
+// Resolve attributes quarantined deposit to client
+//   - client address must be X-address of client on shared deposit address which received the deposit
+func (u *quarantinedDepositUseCase) Resolve(
+	ctx context.Context, input watchusecase.ResolveQuarantinedDepositInput,
+) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.QuarantinedDeposit.Resolve")
+	defer tracer.End(span, &err)
+
+	if input.ID == 0 {
+		return errors.New("id is required")
+	}
+	if input.ClientAddress == "" {
+		return errors.New("client address is required")
+	}
+
+	deposit, err := u.depositRepo.GetOne(ctx, input.ID)
+	if err != nil {
+		return fmt.Errorf("fail to call depositRepo.GetOne(): %w", err)
+	}
+	if !deposit.IsQuarantined {
+		return fmt.Errorf("deposit %d is not quarantined", input.ID)
+	}
+	classicAddr, _, hasTag, err := xrp.DecodeXAddress(input.ClientAddress)
+	if err != nil || !hasTag || classicAddr != deposit.ReceiverAddress {
+		return fmt.Errorf("%s is not X-address on shared deposit address %s",
+			input.ClientAddress, deposit.ReceiverAddress)
+	}
+	clientAddrs, err := u.addrRepo.GetAllAddress(ctx, domainAccount.AccountTypeClient)
+	if err != nil {
+		return fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+	}
+	if !slices.Contains(clientAddrs, input.ClientAddress) {
+		return fmt.Errorf("%s is not address of client account", input.ClientAddress)
+	}
+
+	affected, err := u.depositRepo.ResolveQuarantine(ctx, input.ID, input.ClientAddress)
+	if err != nil {
+		return fmt.Errorf("fail to call depositRepo.ResolveQuarantine(): %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("deposit %d is not quarantined", input.ID)
+	}
+	logger.InfoContext(ctx, "quarantined deposit is attributed to client",
+		"id", input.ID,
+		"hash", deposit.TXHash,
+		"client", input.ClientAddress)
+
+	return nil
+}
@@ -2,6 +2,7 @@ package xrp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -9,12 +10,16 @@ import (
 	"github.com/bookerzzz/grok"
 
 	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
 	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
 	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/serial"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 type sendTransactionUseCase struct {
@@ -52,17 +57,20 @@ func NewSendTransactionUseCase(
 func (u *sendTransactionUseCase) Execute(
 	ctx context.Context,
 	input watchusecase.SendTransactionInput,
-) (watchusecase.SendTransactionOutput, error) {
+) (_ watchusecase.SendTransactionOutput, err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.SendTransaction.Execute")
+	defer tracer.End(span, &err)
+
 	// Validate file path and extract transaction metadata
 	actionType, _, txID, _, err := u.txFileRepo.ValidateFilePath(input.FilePath, domainTx.TxTypeSigned)
 	if err != nil {
 		return watchusecase.SendTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.ValidateFilePath(): %w", err)
 	}
 
-	logger.Debug("send_tx", "action_type", actionType.String())
+	logger.DebugContext(ctx, "send_tx", "action_type", actionType.String())
 
 	// Read hex from file
-	data, err := u.txFileRepo.ReadFileSlice(input.FilePath)
+	data, err := u.txFileRepo.ReadFileSlice(ctx, input.FilePath)
 	if err != nil {
 		return watchusecase.SendTransactionOutput{}, fmt.Errorf("fail to call txFileRepo.ReadFile(): %w", err)
 	}
@@ -70,122 +78,22 @@ func (u *sendTransactionUseCase) Execute(
 	// Process each signed transaction concurrently
 	var wg sync.WaitGroup
 
-	for _, txHex := range data {
+	for i, line := range data {
+		// first line is sender account when transaction is multisigned by sign wallets
+		if i == 0 && domainAccount.ValidateAccountType(line) {
+			continue
+		}
+		uuid, signedTxID, txBlob, err := u.parseSignedTx(ctx, line)
+		if err != nil {
+			logger.WarnContext(ctx, "fail to call parseSignedTx()", "tx_id", txID, "error", err)
+			continue
+		}
+
 		wg.Add(1)
-		go func(line string) {
+		go func() {
 			defer wg.Done()
-
-			// Parse transaction data: uuid, signedTxID, txBlob
-			tmp := strings.Split(line, ",")
-			if len(tmp) != 3 {
-				logger.Warn("data format is invalid in file")
-				return
-			}
-			uuid := tmp[0]
-			signedTxID := tmp[1]
-			txBlob := tmp[2]
-
-			// Submit transaction to XRP network
-			var sentTx *xrp.SentTx
-			var earlistLedgerVersion uint64
-			sentTx, earlistLedgerVersion, err = u.rippler.SubmitTransaction(ctx, txBlob)
-			if err != nil {
-				logger.Warn("fail to call xrp.SubmitTransaction()",
-					"tx_id", txID,
-					"uuid", uuid,
-					"signed_tx_id", signedTxID,
-					"error", err,
-					// https://xrpl.org/tef-codes.html
-					// https://xrpl.org/finality-of-results.html
-					// tefMAX_LEDGER / Ledger sequence too high
-					//  - The error message Ledger sequence too high occurs if you've waited too long to confirm
-					//    a transaction in Ledger Live.
-					// tefPAST_SEQ / This sequence number has already passed
-				)
-				return
-			}
-			if !strings.Contains(sentTx.ResultCode, "tesSUCCESS") {
-				logger.Warn("fail to call SubmitTransaction",
-					"tx_id", txID,
-					"uuid", uuid,
-					"signed_tx_id", signedTxID,
-					"result_code", sentTx.ResultCode,
-					"result_message", sentTx.ResultMessage,
-				)
-				return
-			}
-			// txBlob and sentTx.TxBlob is same
-
-			// Debug ledger version info
-			logger.Debug("ledger version",
-				"earlistLedgerVersion", earlistLedgerVersion,
-				"sentTx.TxJSON.LastLedgerSequence", sentTx.TxJSON.LastLedgerSequence,
-			)
-
-			// Wait for transaction validation
-			var ledgerVer uint64
-			ledgerVer, err = u.rippler.WaitValidation(ctx, sentTx.TxJSON.LastLedgerSequence)
-			if err != nil {
-				logger.Warn("fail to call xrp.WaitValidation()",
-					"tx_id", txID,
-					"uuid", uuid,
-					"signed_tx_id", signedTxID,
-					"lastLedgerSequence", sentTx.TxJSON.LastLedgerSequence,
-					"ledgerVer", ledgerVer,
-					"error", err,
-					// Transaction has not been validated yet; try again later
-				)
-				return
-			}
-
-			// Get transaction info for verification
-			var txInfo *xrp.TxInfo
-			txInfo, err = u.rippler.GetTransaction(ctx, sentTx.TxJSON.Hash, earlistLedgerVersion)
-			if err != nil {
-				logger.Warn("fail to call xrp.GetTransaction()",
-					"tx_id", txID,
-					"uuid", uuid,
-					"signed_tx_id", signedTxID,
-					"hash", sentTx.TxJSON.Hash,
-					"earlistLedgerVersion", earlistLedgerVersion,
-					"error", err,
-				)
-				return
-			}
-			// for debug (should be removed later)
-			grok.Value(txInfo)
-
-			// Update xrp_detail_tx table
-			var affectedNum int64
-			affectedNum, err = u.txDetailRepo.UpdateAfterTxSent(
-				uuid, domainTx.TxTypeSent, signedTxID, txBlob, earlistLedgerVersion)
-			if err != nil {
-				// TODO: even if error occurred, tx is already sent. so db should be corrected manually
-				logger.Warn(
-					"fail to call txDetailRepo.UpdateAfterTxSent() but tx is already sent. "+
-						"So database should be updated manually",
-					"tx_id", txID,
-					"uuid", uuid,
-					"signed_tx_id", signedTxID,
-					"tx_type", domainTx.TxTypeSent.String(),
-					"tx_type_value", domainTx.TxTypeSent.Int8(),
-					"error", err,
-				)
-				// "error":"models: unable to update all for xrp_detail_tx: Error 1406:
-				// Data too long for column 'signed_tx_blob' at row 1"
-				return
-			}
-			if affectedNum == 0 {
-				logger.Info("no records to update tx_table",
-					"tx_id", txID,
-					"uuid", uuid,
-					"signed_tx_id", signedTxID,
-					"tx_type", domainTx.TxTypeSent.String(),
-					"tx_type_value", domainTx.TxTypeSent.Int8(),
-				)
-				return
-			}
-		}(txHex)
+			u.sendTx(ctx, actionType, txID, uuid, signedTxID, txBlob)
+		}()
 	}
 	wg.Wait()
 
@@ -195,3 +103,137 @@ func (u *sendTransactionUseCase) Execute(
 		TxID: "",
 	}, nil
 }
+
+// parseSignedTx returns uuid, signedTxID and txBlob from line of signed transaction file
+//   - `uuid,signedTxID,txBlob` is signed by keygen wallet
+//   - serialized xrp.MultisigTx is signed by sign wallets, signatures are combined here
+func (u *sendTransactionUseCase) parseSignedTx(ctx context.Context, line string) (string, string, string, error) {
+	if strings.Contains(line, ",") {
+		tmp := strings.Split(line, ",")
+		if len(tmp) != 3 {
+			return "", "", "", errors.New("data format is invalid in file")
+		}
+		return tmp[0], tmp[1], tmp[2], nil
+	}
+
+	var multisigTx xrp.MultisigTx
+	if err := serial.DecodeFromString(line, &multisigTx); err != nil {
+		return "", "", "", fmt.Errorf("fail to call serial.DecodeFromString(): %w", err)
+	}
+	if !multisigTx.IsSigned() {
+		return "", "", "", fmt.Errorf("transaction %s doesn't reach quorum: %d/%d",
+			multisigTx.UUID, multisigTx.SignedWeight(), multisigTx.Quorum)
+	}
+	signedTxID, txBlob, err := u.rippler.CombineTransaction(ctx, multisigTx.SignedTxs)
+	if err != nil {
+		return "", "", "", fmt.Errorf("fail to call rippler.CombineTransaction(): %w", err)
+	}
+	return multisigTx.UUID, signedTxID, txBlob, nil
+}
+
+// sendTx submits signed transaction and updates xrp_detail_tx table after validation
+func (u *sendTransactionUseCase) sendTx(
+	ctx context.Context,
+	actionType domainTx.ActionType,
+	txID int64,
+	uuid, signedTxID, txBlob string,
+) {
+	// Submit transaction to XRP network
+	sentTx, earlistLedgerVersion, err := u.rippler.SubmitTransaction(ctx, txBlob)
+	if err != nil {
+		logger.WarnContext(ctx, "fail to call xrp.SubmitTransaction()",
+			"tx_id", txID,
+			"uuid", uuid,
+			"signed_tx_id", signedTxID,
+			"error", err,
+			// https://xrpl.org/tef-codes.html
+			// https://xrpl.org/finality-of-results.html
+			// tefMAX_LEDGER / Ledger sequence too high
+			//  - The error message Ledger sequence too high occurs if you've waited too long to confirm
+			//    a transaction in Ledger Live.
+			// tefPAST_SEQ / This sequence number has already passed
+		)
+		return
+	}
+	if !strings.Contains(sentTx.ResultCode, "tesSUCCESS") {
+		logger.WarnContext(ctx, "fail to call SubmitTransaction",
+			"tx_id", txID,
+			"uuid", uuid,
+			"signed_tx_id", signedTxID,
+			"result_code", sentTx.ResultCode,
+			"result_message", sentTx.ResultMessage,
+		)
+		return
+	}
+	// txBlob and sentTx.TxBlob is same
+
+	// Debug ledger version info
+	logger.DebugContext(ctx, "ledger version",
+		"earlistLedgerVersion", earlistLedgerVersion,
+		"sentTx.TxJSON.LastLedgerSequence", sentTx.TxJSON.LastLedgerSequence,
+	)
+
+	// Wait for transaction validation
+	ledgerVer, err := u.rippler.WaitValidation(ctx, sentTx.TxJSON.LastLedgerSequence)
+	if err != nil {
+		logger.WarnContext(ctx, "fail to call xrp.WaitValidation()",
+			"tx_id", txID,
+			"uuid", uuid,
+			"signed_tx_id", signedTxID,
+			"lastLedgerSequence", sentTx.TxJSON.LastLedgerSequence,
+			"ledgerVer", ledgerVer,
+			"error", err,
+			// Transaction has not been validated yet; try again later
+		)
+		return
+	}
+
+	// Get transaction info for verification
+	txInfo, err := u.rippler.GetTransaction(ctx, sentTx.TxJSON.Hash, earlistLedgerVersion)
+	if err != nil {
+		logger.WarnContext(ctx, "fail to call xrp.GetTransaction()",
+			"tx_id", txID,
+			"uuid", uuid,
+			"signed_tx_id", signedTxID,
+			"hash", sentTx.TxJSON.Hash,
+			"earlistLedgerVersion", earlistLedgerVersion,
+			"error", err,
+		)
+		return
+	}
+	// for debug (should be removed later)
+	grok.Value(txInfo)
+
+	// Update xrp_detail_tx table
+	affectedNum, err := u.txDetailRepo.UpdateAfterTxSent(
+		ctx,
+		uuid, domainTx.TxTypeSent, signedTxID, txBlob, earlistLedgerVersion)
+	if err != nil {
+		// TODO: even if error occurred, tx is already sent. so db should be corrected manually
+		logger.WarnContext(
+			ctx,
+			"fail to call txDetailRepo.UpdateAfterTxSent() but tx is already sent. "+
+				"So database should be updated manually",
+			"tx_id", txID,
+			"uuid", uuid,
+			"signed_tx_id", signedTxID,
+			"tx_type", domainTx.TxTypeSent.String(),
+			"tx_type_value", domainTx.TxTypeSent.Int8(),
+			"error", err,
+		)
+		// "error":"models: unable to update all for xrp_detail_tx: Error 1406:
+		// Data too long for column 'signed_tx_blob' at row 1"
+		return
+	}
+	if affectedNum == 0 {
+		logger.InfoContext(ctx, "no records to update tx_table",
+			"tx_id", txID,
+			"uuid", uuid,
+			"signed_tx_id", signedTxID,
+			"tx_type", domainTx.TxTypeSent.String(),
+			"tx_type_value", domainTx.TxTypeSent.Int8(),
+		)
+		return
+	}
+	metrics.IncTx(u.rippler.CoinTypeCode().String(), actionType.String(), metrics.TxStatusSent)
+}
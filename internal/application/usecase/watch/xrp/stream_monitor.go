@@ -0,0 +1,414 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/guregu/null/v6"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// streamCursorName is name of cursor to store last processed ledger index
+const streamCursorName = "ledger"
+
+// engine result of successful transaction
+const resultSuccess = "tesSUCCESS"
+
+type streamMonitorUseCase struct {
+	rippler      ripple.Rippler
+	subscriber   ripple.LedgerSubscriber
+	txDetailRepo watchrepo.XrpDetailTxRepositorier
+	addrRepo     watchrepo.AddressRepositorier
+	cursorRepo   watchrepo.StreamCursorRepositorier
+	depositRepo  watchrepo.XrpDepositRepositorier
+
+	// shared deposit address whose destination tag identifies client, empty if it isn't used
+	depositAddress string
+	// address -> account type
+	accounts map[string]domainAccount.AccountType
+	// destination tag -> X-address of client on shared deposit address
+	clientTags map[uint32]string
+	// last processed ledger index
+	lastLedger uint64
+	// false until transactions missed before subscription are caught up
+	caughtUp bool
+}
+
+// NewStreamMonitorUseCase creates a new StreamMonitorUseCase
+//   - depositAddress is shared deposit address of client whose address is X-address with destination tag
+func NewStreamMonitorUseCase(
+	rippler ripple.Rippler,
+	subscriber ripple.LedgerSubscriber,
+	txDetailRepo watchrepo.XrpDetailTxRepositorier,
+	addrRepo watchrepo.AddressRepositorier,
+	cursorRepo watchrepo.StreamCursorRepositorier,
+	depositRepo watchrepo.XrpDepositRepositorier,
+	depositAddress string,
+) watchusecase.StreamMonitorUseCase {
+	return &streamMonitorUseCase{
+		rippler:        rippler,
+		subscriber:     subscriber,
+		txDetailRepo:   txDetailRepo,
+		addrRepo:       addrRepo,
+		cursorRepo:     cursorRepo,
+		depositRepo:    depositRepo,
+		depositAddress: depositAddress,
+		accounts:       make(map[string]domainAccount.AccountType),
+		clientTags:     make(map[uint32]string),
+	}
+}
+
+// Run subscribes `ledger` stream and transactions of our addresses
+// - transaction sent by us is updated to done in xrp_detail_tx when it's validated
+// - payment to our address is detected as incoming payment
+// - payment to shared deposit address is recorded as deposit of client identified by destination tag
+// - every time subscription (re)starts, transactions from last processed ledger are caught up by account_tx
+func (u *streamMonitorUseCase) Run(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "watch.xrp.StreamMonitor.Run")
+	defer tracer.End(span, &err)
+
+	if err := u.loadAccounts(ctx); err != nil {
+		return err
+	}
+	if len(u.accounts) == 0 {
+		return errors.New("no address to monitor")
+	}
+
+	lastLedger, err := u.cursorRepo.GetPosition(ctx, streamCursorName)
+	if err != nil {
+		return fmt.Errorf("failed to get last processed ledger: %w", err)
+	}
+	u.lastLedger = lastLedger
+
+	addrs := make([]string, 0, len(u.accounts))
+	for addr := range u.accounts {
+		addrs = append(addrs, addr)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- u.subscriber.Start(ctx, addrs)
+	}()
+	logger.InfoContext(ctx, "stream monitor started", "addresses", len(addrs), "last_ledger", u.lastLedger)
+
+	events := u.subscriber.Events()
+	for {
+		select {
+		case <-ctx.Done():
+			logger.InfoContext(ctx, "stream monitor stopped", "last_ledger", u.lastLedger)
+			return nil
+		case err := <-errCh:
+			if err != nil {
+				return fmt.Errorf("subscriber stopped: %w", err)
+			}
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			u.handleEvent(ctx, &event)
+		}
+	}
+}
+
+func (u *streamMonitorUseCase) handleEvent(ctx context.Context, event *xrp.StreamEvent) {
+	switch event.Type {
+	case xrp.StreamEventConnected:
+		u.caughtUp = false
+		u.tryCatchUp(ctx, event.LedgerIndex)
+	case xrp.StreamEventTransaction:
+		tx := event.Transaction
+		if !tx.Validated {
+			return
+		}
+		if err := u.handleTransaction(ctx, &tx.Transaction, &tx.Meta, tx.LedgerIndex); err != nil {
+			logger.ErrorContext(ctx, "failed to handle transaction, it's retried by catch-up",
+				"hash", tx.Transaction.Hash,
+				"ledger_index", tx.LedgerIndex,
+				"error", err)
+			// cursor isn't moved until transactions from last processed ledger are caught up
+			u.caughtUp = false
+			// first run has no cursor, catch-up starts from ledger of the failed transaction
+			if u.lastLedger == 0 && tx.LedgerIndex != 0 {
+				u.lastLedger = tx.LedgerIndex - 1
+			}
+		}
+	case xrp.StreamEventLedger:
+		// transactions of this ledger may be still in stream, so previous ledger is the last processed
+		if event.LedgerIndex == 0 {
+			return
+		}
+		if !u.caughtUp {
+			u.tryCatchUp(ctx, event.LedgerIndex-1)
+			return
+		}
+		u.saveCursor(ctx, event.LedgerIndex-1)
+	}
+}
+
+// tryCatchUp catches up transactions and moves cursor, cursor is kept on failure to retry later
+func (u *streamMonitorUseCase) tryCatchUp(ctx context.Context, toLedger uint64) {
+	if err := u.catchUp(ctx, toLedger); err != nil {
+		logger.ErrorContext(ctx, "failed to catch up transactions", "from", u.lastLedger+1, "to", toLedger, "error", err)
+		return
+	}
+	u.caughtUp = true
+	u.saveCursor(ctx, toLedger)
+}
+
+// catchUp processes transactions of our addresses validated after last processed ledger
+func (u *streamMonitorUseCase) catchUp(ctx context.Context, toLedger uint64) error {
+	// nothing to catch up at first run
+	if u.lastLedger == 0 || u.lastLedger >= toLedger {
+		return nil
+	}
+	logger.InfoContext(ctx, "catching up transactions", "from", u.lastLedger+1, "to", toLedger)
+
+	for addr := range u.accounts {
+		var marker any
+		for {
+			res, err := u.rippler.AccountTx(ctx, addr, int64(u.lastLedger+1), int64(toLedger), marker)
+			if err != nil {
+				return fmt.Errorf("failed to call AccountTx(%s): %w", addr, err)
+			}
+			for i := range res.Result.Transactions {
+				tx := &res.Result.Transactions[i]
+				if !tx.Validated {
+					continue
+				}
+				if err := u.handleTransaction(ctx, &tx.Tx, &tx.Meta, tx.Tx.LedgerIndex); err != nil {
+					return fmt.Errorf("failed to handle transaction %s: %w", tx.Tx.Hash, err)
+				}
+			}
+			if res.Result.Marker == nil {
+				break
+			}
+			marker = res.Result.Marker
+		}
+	}
+
+	return nil
+}
+
+// handleTransaction handles validated transaction, it must be idempotent
+// because the same transaction can be received by both stream and catch-up
+//   - error means transaction must be handled again, so cursor must not be moved beyond its ledger
+func (u *streamMonitorUseCase) handleTransaction(
+	ctx context.Context, tx *xrp.Transaction, meta *xrp.TxMeta, ledgerIndex uint64,
+) error {
+	result := meta.TransactionResult
+	// transaction sent by us
+	if _, ok := u.accounts[tx.Account]; ok {
+		if result != resultSuccess {
+			logger.ErrorContext(ctx, "ALERT: sent transaction is validated with failure result",
+				"hash", tx.Hash,
+				"account", tx.Account,
+				"result", result,
+				"ledger_index", ledgerIndex)
+		} else {
+			affected, err := u.txDetailRepo.UpdateSentTxTypeBySignedTxID(ctx, domainTx.TxTypeDone, tx.Hash)
+			if err != nil {
+				return fmt.Errorf("fail to call txDetailRepo.UpdateSentTxTypeBySignedTxID(): %w", err)
+			}
+			if affected != 0 {
+				u.incConfirmedTx(ctx, tx.Hash)
+				logger.InfoContext(ctx, "transaction is validated",
+					"hash", tx.Hash,
+					"account", tx.Account,
+					"ledger_index", ledgerIndex)
+			}
+		}
+	}
+
+	// payment to our address
+	accountType, ok := u.accounts[tx.Destination]
+	if !ok || tx.TransactionType != "Payment" || result != resultSuccess {
+		return nil
+	}
+	if _, isInternal := u.accounts[tx.Account]; tx.Destination == u.depositAddress && !isInternal {
+		return u.handleDeposit(ctx, tx, meta, ledgerIndex)
+	}
+	logger.InfoContext(ctx, "incoming payment is detected",
+		"hash", tx.Hash,
+		"from", tx.Account,
+		"to", tx.Destination,
+		"account", accountType.String(),
+		"amount", xrp.DeliveredAmount(tx, meta),
+		"ledger_index", ledgerIndex)
+	return nil
+}
+
+// handleDeposit records payment to shared deposit address, client is identified by destination tag
+//   - delivered amount is recorded instead of Amount, which is more than delivered by partial payment
+//   - payment without destination tag or with unknown tag is quarantined to be handled manually
+//   - payment of currency other than XRP and issued currency in config is quarantined as well
+//   - quarantined deposit is listed and attributed to client by `monitor quarantine`
+func (u *streamMonitorUseCase) handleDeposit(
+	ctx context.Context, tx *xrp.Transaction, meta *xrp.TxMeta, ledgerIndex uint64,
+) error {
+	item := &models.XRPDeposit{
+		TXHash:          tx.Hash,
+		LedgerIndex:     ledgerIndex,
+		SenderAddress:   tx.Account,
+		ReceiverAddress: tx.Destination,
+	}
+	amount := xrp.DeliveredAmount(tx, meta)
+	if amount != nil {
+		item.Amount = amount.Value
+		item.Currency = amount.Currency
+		item.Issuer = amount.Issuer
+	}
+	if tx.DestinationTag != nil {
+		item.DestinationTag = null.IntFrom(int64(*tx.DestinationTag))
+		item.ClientAddress = u.getClientAddress(ctx, *tx.DestinationTag)
+	}
+
+	var reason string
+	switch {
+	case amount == nil:
+		reason = "delivered amount is unknown"
+	case !u.isAcceptedCurrency(amount):
+		reason = "currency is not accepted"
+	case item.ClientAddress == "":
+		reason = "destination tag is unknown"
+	}
+	item.IsQuarantined = reason != ""
+
+	inserted, err := u.depositRepo.Insert(ctx, item)
+	if err != nil {
+		return fmt.Errorf("fail to call depositRepo.Insert(): %w", err)
+	}
+	// already recorded by stream or catch-up
+	if inserted == 0 {
+		return nil
+	}
+	if item.IsQuarantined {
+		logger.ErrorContext(ctx, "ALERT: deposit is quarantined",
+			"reason", reason,
+			"hash", tx.Hash,
+			"from", tx.Account,
+			"destination_tag", item.DestinationTag,
+			"amount", item.Amount,
+			"currency", item.Currency,
+			"issuer", item.Issuer,
+			"ledger_index", ledgerIndex)
+		return nil
+	}
+	logger.InfoContext(ctx, "deposit is detected",
+		"hash", tx.Hash,
+		"from", tx.Account,
+		"client", item.ClientAddress,
+		"destination_tag", *tx.DestinationTag,
+		"amount", item.Amount,
+		"currency", item.Currency,
+		"ledger_index", ledgerIndex)
+	return nil
+}
+
+// isAcceptedCurrency returns true if amount is XRP or issued currency in config
+//   - the same currency code issued by other issuer is worthless for us
+func (u *streamMonitorUseCase) isAcceptedCurrency(amount *xrp.CurrencyAmount) bool {
+	if amount.IsXRP() {
+		return true
+	}
+	issuedCurrency := u.rippler.IssuedCurrency()
+	return issuedCurrency != nil && issuedCurrency.IsSameCurrency(amount)
+}
+
+// getClientAddress returns X-address of client by destination tag, empty if tag isn't allocated
+//   - client addresses are loaded again for tag which is generated after monitor started
+func (u *streamMonitorUseCase) getClientAddress(ctx context.Context, tag uint32) string {
+	if clientAddr, ok := u.clientTags[tag]; ok {
+		return clientAddr
+	}
+	if err := u.loadClientTags(ctx); err != nil {
+		logger.ErrorContext(ctx, "failed to load client addresses", "error", err)
+	}
+	return u.clientTags[tag]
+}
+
+func (u *streamMonitorUseCase) saveCursor(ctx context.Context, ledgerIndex uint64) {
+	if ledgerIndex <= u.lastLedger {
+		return
+	}
+	if err := u.cursorRepo.UpdatePosition(ctx, streamCursorName, ledgerIndex); err != nil {
+		logger.ErrorContext(ctx, "failed to save last processed ledger", "ledger_index", ledgerIndex, "error", err)
+		return
+	}
+	u.lastLedger = ledgerIndex
+}
+
+// loadAccounts loads addresses of all accounts to subscribe
+func (u *streamMonitorUseCase) loadAccounts(ctx context.Context) error {
+	targetAccounts := []domainAccount.AccountType{
+		domainAccount.AccountTypeClient,
+		domainAccount.AccountTypeDeposit,
+		domainAccount.AccountTypePayment,
+		domainAccount.AccountTypeStored,
+	}
+
+	for _, acnt := range targetAccounts {
+		addrs, err := u.addrRepo.GetAllAddress(ctx, acnt)
+		if err != nil {
+			return fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+		}
+		for _, addr := range addrs {
+			// X-address of client isn't subscribed, shared deposit address is subscribed instead
+			if xrp.IsXAddress(addr) {
+				continue
+			}
+			u.accounts[addr] = acnt
+		}
+	}
+
+	return u.loadClientTags(ctx)
+}
+
+// loadClientTags loads X-addresses of client on shared deposit address
+func (u *streamMonitorUseCase) loadClientTags(ctx context.Context) error {
+	if u.depositAddress == "" {
+		return nil
+	}
+	if _, ok := u.accounts[u.depositAddress]; !ok {
+		return fmt.Errorf("shared deposit address %s is not address of deposit account", u.depositAddress)
+	}
+
+	addrs, err := u.addrRepo.GetAllAddress(ctx, domainAccount.AccountTypeClient)
+	if err != nil {
+		return fmt.Errorf("fail to call addrRepo.GetAllAddress(): %w", err)
+	}
+	for _, addr := range addrs {
+		classicAddr, tag, hasTag, err := xrp.DecodeXAddress(addr)
+		if err != nil || !hasTag || classicAddr != u.depositAddress {
+			continue
+		}
+		u.clientTags[tag] = addr
+	}
+
+	return nil
+}
+
+// incConfirmedTx counts confirmed transaction by action of tx which it belongs to
+func (u *streamMonitorUseCase) incConfirmedTx(ctx context.Context, hash string) {
+	action, err := u.txDetailRepo.GetActionBySignedTxID(ctx, hash)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to call txDetailRepo.GetActionBySignedTxID()",
+			"hash", hash,
+			"error", err,
+		)
+		return
+	}
+	metrics.IncTx(u.rippler.CoinTypeCode().String(), action.String(), metrics.TxStatusConfirmed)
+}
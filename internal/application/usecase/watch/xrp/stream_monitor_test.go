@@ -0,0 +1,446 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	watchrepo "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
+)
+
+const (
+	// streamPaymentAddr is address of payment account which sends transactions
+	streamPaymentAddr = signer2
+	// streamSenderAddr is address of sender outside of wallet
+	streamSenderAddr = "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"
+	// streamIssuerAddr is issuer of issued currency in config
+	streamIssuerAddr = "rrrrrrrrrrrrrrrrrrrrBZbvji"
+	// streamClientTag is destination tag allocated to client on shared deposit address
+	streamClientTag uint32 = 7
+)
+
+// fakeStreamRippler returns validated transactions of ledger by account_tx, one transaction per page
+type fakeStreamRippler struct {
+	ripple.Rippler
+	ledgerTxs      []*xrp.Transaction
+	issuedCurrency *xrp.IssuedCurrency
+	// ranges are ledger ranges requested by account_tx,
+	// the same range requested for each address in a row is recorded once
+	ranges []string
+}
+
+func (r *fakeStreamRippler) CoinTypeCode() domainCoin.CoinTypeCode {
+	return domainCoin.XRP
+}
+
+func (r *fakeStreamRippler) IssuedCurrency() *xrp.IssuedCurrency {
+	return r.issuedCurrency
+}
+
+func (r *fakeStreamRippler) AccountTx(
+	_ context.Context, address string, ledgerIndexMin, ledgerIndexMax int64, marker any,
+) (*xrp.ResponseAccountTx, error) {
+	if marker == nil {
+		ledgerRange := fmt.Sprintf("%d-%d", ledgerIndexMin, ledgerIndexMax)
+		if len(r.ranges) == 0 || r.ranges[len(r.ranges)-1] != ledgerRange {
+			r.ranges = append(r.ranges, ledgerRange)
+		}
+	}
+
+	var txs []*xrp.Transaction
+	for _, tx := range r.ledgerTxs {
+		if tx.Account != address && tx.Destination != address {
+			continue
+		}
+		if int64(tx.LedgerIndex) < ledgerIndexMin || int64(tx.LedgerIndex) > ledgerIndexMax {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+
+	var page int
+	if marker != nil {
+		page = marker.(int)
+	}
+	res := &xrp.ResponseAccountTx{}
+	if page >= len(txs) {
+		return res, nil
+	}
+	res.Result.Transactions = slices.Grow(res.Result.Transactions, 1)[:1]
+	res.Result.Transactions[0].Tx = *txs[page]
+	res.Result.Transactions[0].Meta = xrp.TxMeta{TransactionResult: resultSuccess}
+	res.Result.Transactions[0].Validated = true
+	if page+1 < len(txs) {
+		res.Result.Marker = page + 1
+	}
+	return res, nil
+}
+
+// fakeLedgerSubscriber sends events, then stops
+type fakeLedgerSubscriber struct {
+	sent     []xrp.StreamEvent
+	events   chan xrp.StreamEvent
+	accounts []string
+}
+
+func (s *fakeLedgerSubscriber) Start(ctx context.Context, accounts []string) error {
+	s.accounts = slices.Sorted(slices.Values(accounts))
+	for _, event := range s.sent {
+		select {
+		case s.events <- event:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+	return nil
+}
+
+func (s *fakeLedgerSubscriber) Events() <-chan xrp.StreamEvent {
+	return s.events
+}
+
+// fakeStreamAddrRepo returns addresses of each account
+type fakeStreamAddrRepo struct {
+	watchrepo.AddressRepositorier
+	addrs map[domainAccount.AccountType][]string
+}
+
+func (r *fakeStreamAddrRepo) GetAllAddress(_ context.Context, accountType domainAccount.AccountType) ([]string, error) {
+	return r.addrs[accountType], nil
+}
+
+// fakeStreamCursorRepo records every position of stream_cursor
+type fakeStreamCursorRepo struct {
+	watchrepo.StreamCursorRepositorier
+	positions []uint64
+}
+
+func (r *fakeStreamCursorRepo) GetPosition(_ context.Context, _ string) (uint64, error) {
+	if len(r.positions) == 0 {
+		return 0, nil
+	}
+	return r.positions[len(r.positions)-1], nil
+}
+
+func (r *fakeStreamCursorRepo) UpdatePosition(_ context.Context, _ string, position uint64) error {
+	r.positions = append(r.positions, position)
+	return nil
+}
+
+// fakeStreamDetailRepo records hash of sent transactions updated to done
+type fakeStreamDetailRepo struct {
+	watchrepo.XrpDetailTxRepositorier
+	done []string
+}
+
+func (r *fakeStreamDetailRepo) UpdateSentTxTypeBySignedTxID(
+	_ context.Context, txType domainTx.TxType, signedTxID string,
+) (int64, error) {
+	if txType != domainTx.TxTypeDone || slices.Contains(r.done, signedTxID) {
+		return 0, nil
+	}
+	r.done = append(r.done, signedTxID)
+	return 1, nil
+}
+
+func (r *fakeStreamDetailRepo) GetActionBySignedTxID(_ context.Context, _ string) (domainTx.ActionType, error) {
+	return domainTx.ActionTypePayment, nil
+}
+
+// fakeStreamDepositRepo records deposits once for each hash, Insert fails failInserts times at first
+type fakeStreamDepositRepo struct {
+	watchrepo.XrpDepositRepositorier
+	failInserts int
+	inserted    []*models.XRPDeposit
+}
+
+func (r *fakeStreamDepositRepo) Insert(_ context.Context, item *models.XRPDeposit) (int64, error) {
+	if r.failInserts > 0 {
+		r.failInserts--
+		return 0, errors.New("database is locked")
+	}
+	for _, deposit := range r.inserted {
+		if deposit.TXHash == item.TXHash {
+			return 0, nil
+		}
+	}
+	r.inserted = append(r.inserted, item)
+	return 1, nil
+}
+
+func newStreamPayment(hash string, ledgerIndex uint64) *xrp.Transaction {
+	return &xrp.Transaction{
+		Account:         streamPaymentAddr,
+		Amount:          &xrp.CurrencyAmount{Value: "1000000"},
+		Destination:     signer1,
+		TransactionType: "Payment",
+		Hash:            hash,
+		LedgerIndex:     ledgerIndex,
+	}
+}
+
+// newStreamDeposit returns payment to shared deposit address, tag is nil for payment without destination tag
+func newStreamDeposit(hash string, ledgerIndex uint64, tag *uint32, amount *xrp.CurrencyAmount) *xrp.Transaction {
+	return &xrp.Transaction{
+		Account:         streamSenderAddr,
+		Amount:          amount,
+		Destination:     signer1,
+		DestinationTag:  tag,
+		TransactionType: "Payment",
+		Hash:            hash,
+		LedgerIndex:     ledgerIndex,
+	}
+}
+
+func newStreamTxEvent(tx *xrp.Transaction) xrp.StreamEvent {
+	return xrp.StreamEvent{
+		Type:        xrp.StreamEventTransaction,
+		LedgerIndex: tx.LedgerIndex,
+		Transaction: &xrp.StreamTransaction{
+			LedgerIndex: tx.LedgerIndex,
+			Meta:        xrp.TxMeta{TransactionResult: resultSuccess},
+			Transaction: *tx,
+			Validated:   true,
+		},
+	}
+}
+
+func TestStreamMonitorRun(t *testing.T) {
+	tests := []struct {
+		name string
+		// cursor is stream_cursor when monitor starts, 0 means first run
+		cursor    uint64
+		ledgerTxs []*xrp.Transaction
+		events    []xrp.StreamEvent
+		// wantRanges are ledger ranges caught up by account_tx
+		wantRanges    []string
+		wantPositions []uint64
+		wantDone      []string
+	}{
+		{
+			name:   "restart catches up transactions from stream_cursor",
+			cursor: 100,
+			ledgerTxs: []*xrp.Transaction{
+				newStreamPayment("sent1", 102),
+				newStreamPayment("sent2", 104),
+				newStreamPayment("sent3", 106),
+			},
+			events: []xrp.StreamEvent{
+				{Type: xrp.StreamEventConnected, LedgerIndex: 105},
+			},
+			wantRanges:    []string{"101-105"},
+			wantPositions: []uint64{100, 105},
+			wantDone:      []string{"sent1", "sent2"},
+		},
+		{
+			name:   "reconnection catches up transactions missed while disconnected",
+			cursor: 100,
+			ledgerTxs: []*xrp.Transaction{
+				newStreamPayment("sent1", 103),
+			},
+			events: []xrp.StreamEvent{
+				{Type: xrp.StreamEventConnected, LedgerIndex: 101},
+				{Type: xrp.StreamEventLedger, LedgerIndex: 103},
+				{Type: xrp.StreamEventConnected, LedgerIndex: 105},
+			},
+			wantRanges:    []string{"101-101", "103-105"},
+			wantPositions: []uint64{100, 101, 102, 105},
+			wantDone:      []string{"sent1"},
+		},
+		{
+			name:   "first run starts from stream without catch-up",
+			cursor: 0,
+			ledgerTxs: []*xrp.Transaction{
+				newStreamPayment("sent1", 102),
+			},
+			events: []xrp.StreamEvent{
+				{Type: xrp.StreamEventConnected, LedgerIndex: 105},
+				newStreamTxEvent(newStreamPayment("sent2", 106)),
+				{Type: xrp.StreamEventLedger, LedgerIndex: 107},
+			},
+			wantPositions: []uint64{105, 106},
+			wantDone:      []string{"sent2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rippler := &fakeStreamRippler{ledgerTxs: tt.ledgerTxs}
+			subscriber := &fakeLedgerSubscriber{sent: tt.events, events: make(chan xrp.StreamEvent)}
+			detailRepo := &fakeStreamDetailRepo{}
+			cursorRepo := &fakeStreamCursorRepo{}
+			if tt.cursor != 0 {
+				cursorRepo.positions = []uint64{tt.cursor}
+			}
+			addrRepo := &fakeStreamAddrRepo{addrs: map[domainAccount.AccountType][]string{
+				domainAccount.AccountTypeDeposit: {signer1},
+				domainAccount.AccountTypePayment: {streamPaymentAddr},
+			}}
+
+			u := NewStreamMonitorUseCase(rippler, subscriber, detailRepo, addrRepo, cursorRepo, nil, "")
+			// subscriber stops after all events are handled
+			require.NoError(t, u.Run(context.Background()))
+
+			assert.Equal(t, []string{signer1, streamPaymentAddr}, subscriber.accounts)
+			assert.Equal(t, tt.wantRanges, rippler.ranges)
+			assert.Equal(t, tt.wantPositions, cursorRepo.positions)
+			assert.Equal(t, tt.wantDone, detailRepo.done)
+		})
+	}
+}
+
+func TestStreamMonitorDeposit(t *testing.T) {
+	clientAddr, err := xrp.EncodeXAddress(signer1, streamClientTag, true)
+	require.NoError(t, err)
+	clientTag := streamClientTag
+	unknownTag := streamClientTag + 1
+	drops := &xrp.CurrencyAmount{Value: "1000000"}
+
+	type deposit struct {
+		hash          string
+		clientAddress string
+		isQuarantined bool
+	}
+	tests := []struct {
+		name      string
+		ledgerTxs []*xrp.Transaction
+		events    []xrp.StreamEvent
+		// failInserts is number of deposits which fail to be recorded at first
+		failInserts   int
+		wantRanges    []string
+		wantPositions []uint64
+		wantDeposits  []deposit
+	}{
+		{
+			name: "deposit is attributed to client by destination tag",
+			ledgerTxs: []*xrp.Transaction{
+				newStreamDeposit("dep1", 102, &clientTag, drops),
+			},
+			events: []xrp.StreamEvent{
+				{Type: xrp.StreamEventConnected, LedgerIndex: 105},
+			},
+			wantRanges:    []string{"101-105"},
+			wantPositions: []uint64{100, 105},
+			wantDeposits:  []deposit{{hash: "dep1", clientAddress: clientAddr}},
+		},
+		{
+			name: "deposit received by both stream and catch-up is recorded once",
+			ledgerTxs: []*xrp.Transaction{
+				newStreamDeposit("dep1", 102, &clientTag, drops),
+			},
+			events: []xrp.StreamEvent{
+				{Type: xrp.StreamEventConnected, LedgerIndex: 100},
+				newStreamTxEvent(newStreamDeposit("dep1", 102, &clientTag, drops)),
+				{Type: xrp.StreamEventConnected, LedgerIndex: 105},
+			},
+			wantRanges:    []string{"101-105"},
+			wantPositions: []uint64{100, 105},
+			wantDeposits:  []deposit{{hash: "dep1", clientAddress: clientAddr}},
+		},
+		{
+			name: "failed deposit keeps stream_cursor until catch-up succeeds",
+			ledgerTxs: []*xrp.Transaction{
+				newStreamDeposit("dep1", 102, &clientTag, drops),
+			},
+			events: []xrp.StreamEvent{
+				{Type: xrp.StreamEventConnected, LedgerIndex: 100},
+				newStreamTxEvent(newStreamDeposit("dep1", 102, &clientTag, drops)),
+				// catch-up fails again, cursor stays at 100 instead of moving to 102
+				{Type: xrp.StreamEventLedger, LedgerIndex: 103},
+				{Type: xrp.StreamEventLedger, LedgerIndex: 104},
+			},
+			failInserts:   2,
+			wantRanges:    []string{"101-102", "101-103"},
+			wantPositions: []uint64{100, 103},
+			wantDeposits:  []deposit{{hash: "dep1", clientAddress: clientAddr}},
+		},
+		{
+			name: "deposit with unknown destination tag is quarantined",
+			ledgerTxs: []*xrp.Transaction{
+				newStreamDeposit("dep1", 102, &unknownTag, drops),
+			},
+			events: []xrp.StreamEvent{
+				{Type: xrp.StreamEventConnected, LedgerIndex: 105},
+			},
+			wantRanges:    []string{"101-105"},
+			wantPositions: []uint64{100, 105},
+			wantDeposits:  []deposit{{hash: "dep1", isQuarantined: true}},
+		},
+		{
+			name: "deposit without destination tag is quarantined",
+			ledgerTxs: []*xrp.Transaction{
+				newStreamDeposit("dep1", 102, nil, drops),
+			},
+			events: []xrp.StreamEvent{
+				{Type: xrp.StreamEventConnected, LedgerIndex: 105},
+			},
+			wantRanges:    []string{"101-105"},
+			wantPositions: []uint64{100, 105},
+			wantDeposits:  []deposit{{hash: "dep1", isQuarantined: true}},
+		},
+		{
+			name: "deposit of currency issued by other issuer is quarantined",
+			ledgerTxs: []*xrp.Transaction{
+				newStreamDeposit("dep1", 102, &clientTag,
+					&xrp.CurrencyAmount{Currency: "USD", Issuer: streamSenderAddr, Value: "10"}),
+				newStreamDeposit("dep2", 103, &clientTag,
+					&xrp.CurrencyAmount{Currency: "USD", Issuer: streamIssuerAddr, Value: "10"}),
+			},
+			events: []xrp.StreamEvent{
+				{Type: xrp.StreamEventConnected, LedgerIndex: 105},
+			},
+			wantRanges:    []string{"101-105"},
+			wantPositions: []uint64{100, 105},
+			wantDeposits: []deposit{
+				{hash: "dep1", clientAddress: clientAddr, isQuarantined: true},
+				{hash: "dep2", clientAddress: clientAddr},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rippler := &fakeStreamRippler{
+				ledgerTxs:      tt.ledgerTxs,
+				issuedCurrency: &xrp.IssuedCurrency{Currency: "USD", Issuer: streamIssuerAddr},
+			}
+			subscriber := &fakeLedgerSubscriber{sent: tt.events, events: make(chan xrp.StreamEvent)}
+			cursorRepo := &fakeStreamCursorRepo{positions: []uint64{100}}
+			depositRepo := &fakeStreamDepositRepo{failInserts: tt.failInserts}
+			addrRepo := &fakeStreamAddrRepo{addrs: map[domainAccount.AccountType][]string{
+				domainAccount.AccountTypeClient:  {clientAddr},
+				domainAccount.AccountTypeDeposit: {signer1},
+				domainAccount.AccountTypePayment: {streamPaymentAddr},
+			}}
+
+			u := NewStreamMonitorUseCase(
+				rippler, subscriber, &fakeStreamDetailRepo{}, addrRepo, cursorRepo, depositRepo, signer1)
+			// subscriber stops after all events are handled
+			require.NoError(t, u.Run(context.Background()))
+
+			// X-address of client isn't subscribed
+			assert.Equal(t, []string{signer1, streamPaymentAddr}, subscriber.accounts)
+			assert.Equal(t, tt.wantRanges, rippler.ranges)
+			assert.Equal(t, tt.wantPositions, cursorRepo.positions)
+			deposits := make([]deposit, 0, len(depositRepo.inserted))
+			for _, item := range depositRepo.inserted {
+				assert.Equal(t, streamSenderAddr, item.SenderAddress)
+				deposits = append(deposits, deposit{
+					hash:          item.TXHash,
+					clientAddress: item.ClientAddress,
+					isQuarantined: item.IsQuarantined,
+				})
+			}
+			assert.Equal(t, tt.wantDeposits, deposits)
+		})
+	}
+}
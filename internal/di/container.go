@@ -7,8 +7,10 @@
 package di
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"net/http"
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/rpcclient"
@@ -21,13 +23,23 @@ import (
 	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
 	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/erc20"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/forwarder"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/safe"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/scanner"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/solana"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/solana/sol"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/config/account"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/contract"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/migration"
 	mysql "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/mysql"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/postgres"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlite"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/network/websocket"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/cold"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
@@ -37,10 +49,15 @@ import (
 	wallets "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet"
 	btcwallet "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet/btc"
 	ethwallet "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet/eth"
+	solwallet "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet/sol"
+	trxwallet "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet/trx"
 	xrpwallet "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet/xrp"
 	"github.com/hiromaily/go-crypto-wallet/pkg/config"
 	"github.com/hiromaily/go-crypto-wallet/pkg/converter"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/scheduler"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
 
 	// Use case imports
@@ -48,6 +65,8 @@ import (
 	keygenusecasebtc "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen/btc"
 	keygenusecaseeth "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen/eth"
 	keygenusecaseshared "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen/shared"
+	keygenusecasesol "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen/sol"
+	keygenusecasetrx "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen/trx"
 	keygenusecasexrp "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen/xrp"
 	signusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/sign"
 	signusecasebtc "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/sign/btc"
@@ -58,6 +77,8 @@ import (
 	watchusecasebtc "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch/btc"
 	watchusecaseeth "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch/eth"
 	watchusecaseshared "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch/shared"
+	watchusecasesol "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch/sol"
+	watchusecasetrx "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch/trx"
 	watchusecasexrp "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch/xrp"
 )
 
@@ -71,8 +92,28 @@ type Container interface {
 	NewWatchCreateTransactionUseCase() any
 	NewWatchMonitorTransactionUseCase() any
 	NewWatchSendTransactionUseCase() any
+	NewWatchStreamMonitorUseCase() watchusecase.StreamMonitorUseCase
 	NewWatchImportAddressUseCase() watchusecase.ImportAddressUseCase
+	NewWatchGenerateForwarderAddressUseCase() watchusecase.GenerateForwarderAddressUseCase
+	NewWatchGenerateDepositTagAddressUseCase() watchusecase.GenerateDepositTagAddressUseCase
+	NewWatchCreateTicketUseCase() watchusecase.CreateTicketUseCase
+	NewWatchCreateSignerListUseCase() watchusecase.CreateSignerListUseCase
+	NewWatchCreateRequireDestUseCase() watchusecase.CreateRequireDestUseCase
+	NewWatchCreateTrustLineUseCase() watchusecase.CreateTrustLineUseCase
+	NewWatchCreateRegularKeyUseCase() watchusecase.CreateRegularKeyUseCase
+	NewWatchCreateDisableMasterUseCase() watchusecase.CreateDisableMasterUseCase
+	NewWatchCreateAccountDeleteUseCase() watchusecase.CreateAccountDeleteUseCase
+	NewWatchQuarantinedDepositUseCase() watchusecase.QuarantinedDepositUseCase
+	NewWatchCreateEscrowUseCase() watchusecase.CreateEscrowUseCase
+	NewWatchFinishEscrowUseCase() watchusecase.FinishEscrowUseCase
+	NewWatchScanDepositUseCase() watchusecase.ScanDepositUseCase
 	NewWatchCreatePaymentRequestUseCase() watchusecase.CreatePaymentRequestUseCase
+	NewWatchRefreshMetricsUseCase() watchusecase.RefreshMetricsUseCase
+
+	// Watch Daemon
+	NewWatchScheduler() *scheduler.Scheduler
+	NewWatchDaemonJobRepo() watch.DaemonJobRepositorier
+	NewWatchMetricsServer() *metrics.Server
 
 	// Keygen Use Cases
 	NewKeygenGenerateHDWalletUseCase() keygenusecase.GenerateHDWalletUseCase
@@ -82,6 +123,8 @@ type Container interface {
 	NewKeygenCreateMultisigAddressUseCase() keygenusecase.CreateMultisigAddressUseCase
 	NewKeygenImportFullPubkeyUseCase() keygenusecase.ImportFullPubkeyUseCase
 	NewKeygenGenerateKeyUseCase() keygenusecase.GenerateKeyUseCase
+	NewKeygenCreateRegularKeyUseCase() keygenusecase.CreateRegularKeyUseCase
+	NewKeygenImportRegularKeyUseCase() keygenusecase.ImportRegularKeyUseCase
 	NewKeygenSignTransactionUseCase() keygenusecase.SignTransactionUseCase
 
 	// Sign Use Cases
@@ -92,6 +135,12 @@ type Container interface {
 	NewSignStoreSeedUseCase() signusecase.StoreSeedUseCase
 	NewSignGenerateAuthKeyUseCase() signusecase.GenerateAuthKeyUseCase
 
+	// Database
+	NewMigrator() (*migration.Migrator, error)
+
+	// Cold wallet storage
+	NewSQLiteDumpImporter() (*sqlite.DumpImporter, error)
+
 	// Auth accessors
 	AuthName() string
 	AuthType() domainAccount.AuthType
@@ -105,7 +154,8 @@ type container struct {
 	conf        *config.WalletRoot
 	accountConf *account.AccountRoot
 	// db
-	mysqlClient *sql.DB
+	dbClient      *sql.DB
+	schemaChecked bool
 	// utility
 	uuidHandler uuid.UUIDHandler
 	// wallet
@@ -113,14 +163,20 @@ type container struct {
 	btc        bitcoin.Bitcoiner
 	eth        ethereum.Ethereumer
 	erc20      ethereum.ERC20er
+	safe       ethereum.Safer
+	forwarder  ethereum.Forwarder
 	xrp        ripple.Rippler
+	sol        solana.Solanaer
+	trx        tron.Troner
 	// client
-	rpcClient    *rpcclient.Client
-	rpcEthClient *ethrpc.Client
-	wsXrpPublic  *websocket.WS
-	wsXrpAdmin   *websocket.WS
-	grpcConn     *grpc.ClientConn
-	rippleAPI    *xrp.RippleAPI
+	rpcClient     *rpcclient.Client
+	rpcEthClient  *ethrpc.Client
+	rpcSolClient  *ethrpc.Client
+	httpTrxClient *http.Client
+	wsXrpPublic   *websocket.WS
+	wsXrpAdmin    *websocket.WS
+	grpcConn      *grpc.ClientConn
+	rippleAPI     *xrp.RippleAPI
 	// keygen specific
 	multisig account.MultisigAccounter
 	// sign specific
@@ -156,6 +212,10 @@ func (c *container) NewKeygener() wallets.Keygener {
 		return c.newETHKeygener()
 	case c.conf.CoinTypeCode == domainCoin.XRP:
 		return c.newXRPKeygener()
+	case c.conf.CoinTypeCode == domainCoin.SOL:
+		return c.newSOLKeygener()
+	case c.conf.CoinTypeCode == domainCoin.TRX:
+		return c.newTRXKeygener()
 	default:
 		panic(fmt.Sprintf("coinType[%s] is not implemented yet.", c.conf.CoinTypeCode))
 	}
@@ -164,7 +224,7 @@ func (c *container) NewKeygener() wallets.Keygener {
 func (c *container) newBTCKeygener() wallets.Keygener {
 	return btcwallet.NewBTCKeygen(
 		c.newBTC(),
-		c.newMySQLClient(),
+		c.newDBClient(),
 		c.conf.AddressType,
 		c.newKeygenGenerateSeedUseCase(),
 		c.newKeygenGenerateHDWalletUseCase(),
@@ -180,7 +240,7 @@ func (c *container) newBTCKeygener() wallets.Keygener {
 func (c *container) newETHKeygener() wallets.Keygener {
 	return ethwallet.NewETHKeygen(
 		c.newETH(),
-		c.newMySQLClient(),
+		c.newDBClient(),
 		c.walletType,
 		c.newKeygenGenerateSeedUseCase(),
 		c.newKeygenGenerateHDWalletUseCase(),
@@ -193,7 +253,7 @@ func (c *container) newETHKeygener() wallets.Keygener {
 func (c *container) newXRPKeygener() wallets.Keygener {
 	return xrpwallet.NewXRPKeygen(
 		c.newXRP(),
-		c.newMySQLClient(),
+		c.newDBClient(),
 		c.walletType,
 		c.newKeygenGenerateSeedUseCase(),
 		c.newKeygenGenerateHDWalletUseCase(),
@@ -203,10 +263,38 @@ func (c *container) newXRPKeygener() wallets.Keygener {
 	)
 }
 
+func (c *container) newSOLKeygener() wallets.Keygener {
+	return solwallet.NewSOLKeygen(
+		c.newSOL(),
+		c.newDBClient(),
+		c.walletType,
+		c.newKeygenGenerateSeedUseCase(),
+		c.newKeygenGenerateHDWalletUseCase(),
+		c.newKeygenExportAddressUseCase(),
+		c.newSOLKeygenSignTransactionUseCase(),
+	)
+}
+
+func (c *container) newTRXKeygener() wallets.Keygener {
+	return trxwallet.NewTRXKeygen(
+		c.newTRX(),
+		c.newDBClient(),
+		c.walletType,
+		c.newKeygenGenerateSeedUseCase(),
+		c.newKeygenGenerateHDWalletUseCase(),
+		c.newKeygenExportAddressUseCase(),
+		c.newTRXKeygenSignTransactionUseCase(),
+	)
+}
+
 // NewWalleter is to register for walleter interface
 func (c *container) NewWalleter() wallets.Watcher {
 	// set global logger
 	logger.SetGlobal(logger.NewSlogFromConfig(c.conf.Logger.Env, c.conf.Logger.Level, c.conf.Logger.Service))
+	// set global tracer provider, tracing is disabled on failure
+	if err := tracer.Setup(&c.conf.Tracer); err != nil {
+		logger.Error("fail to set up tracer", "error", err)
+	}
 
 	switch {
 	case domainCoin.IsBTCGroup(c.conf.CoinTypeCode):
@@ -215,6 +303,10 @@ func (c *container) NewWalleter() wallets.Watcher {
 		return c.newETHWalleter()
 	case c.conf.CoinTypeCode == domainCoin.XRP:
 		return c.newXRPWalleter()
+	case c.conf.CoinTypeCode == domainCoin.SOL:
+		return c.newSOLWalleter()
+	case c.conf.CoinTypeCode == domainCoin.TRX:
+		return c.newTRXWalleter()
 	default:
 		panic(fmt.Sprintf("coinType[%s] is not implemented yet.", c.conf.CoinTypeCode))
 	}
@@ -236,11 +328,19 @@ func (c *container) NewSigner(authName string) wallets.Signer {
 	authType := domainAccount.AuthTypeMap[authName]
 
 	switch c.conf.CoinTypeCode {
-	case domainCoin.BTC, domainCoin.BCH:
+	case domainCoin.BTC, domainCoin.BCH, domainCoin.LTC, domainCoin.DOGE:
 		return c.newBTCSigner(authType)
-	case domainCoin.LTC, domainCoin.ETH, domainCoin.XRP, domainCoin.ERC20, domainCoin.HYT:
+	case domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE:
+		return c.newETHSigner(authType)
+	case domainCoin.XRP:
+		return c.newXRPSigner(authType)
+	case domainCoin.SOL, domainCoin.TRX, domainCoin.ERC20, domainCoin.HYT:
 		panic(fmt.Sprintf("coinType[%s] is not implemented yet.", c.conf.CoinTypeCode))
 	default:
+		// EVM chain defined in config
+		if domainCoin.IsEVMChain(c.conf.CoinTypeCode) {
+			return c.newETHSigner(authType)
+		}
 		panic(fmt.Sprintf("coinType[%s] is not implemented yet.", c.conf.CoinTypeCode))
 	}
 }
@@ -266,7 +366,7 @@ func (c *container) AddressType() address.AddrType {
 func (c *container) newBTCSigner(authType domainAccount.AuthType) wallets.Signer {
 	return btcwallet.NewBTCSign(
 		c.newBTC(),
-		c.newMySQLClient(),
+		c.newDBClient(),
 		authType,
 		c.conf.AddressType,
 		c.NewSignGenerateSeedUseCase(),
@@ -279,10 +379,39 @@ func (c *container) newBTCSigner(authType domainAccount.AuthType) wallets.Signer
 	)
 }
 
+func (c *container) newETHSigner(authType domainAccount.AuthType) wallets.Signer {
+	return ethwallet.NewETHSign(
+		c.newDBClient(),
+		authType,
+		c.NewSignGenerateSeedUseCase(),
+		c.NewSignStoreSeedUseCase(),
+		c.NewSignGenerateAuthKeyUseCase(),
+		c.NewSignImportPrivateKeyUseCase(authType),
+		c.NewSignExportFullPubkeyUseCase(authType),
+		c.newETHSignTransactionUseCase(),
+		c.walletType,
+	)
+}
+
+func (c *container) newXRPSigner(authType domainAccount.AuthType) wallets.Signer {
+	return xrpwallet.NewXRPSign(
+		c.newXRP(),
+		c.newDBClient(),
+		authType,
+		c.NewSignGenerateSeedUseCase(),
+		c.NewSignStoreSeedUseCase(),
+		c.NewSignGenerateAuthKeyUseCase(),
+		c.NewSignImportPrivateKeyUseCase(authType),
+		c.NewSignExportFullPubkeyUseCase(authType),
+		c.newXRPSignTransactionUseCase(),
+		c.walletType,
+	)
+}
+
 func (c *container) newBTCWalleter() wallets.Watcher {
 	return btcwallet.NewBTCWatch(
 		c.newBTC(),
-		c.newMySQLClient(),
+		c.newDBClient(),
 		c.conf.AddressType,
 		c.newBTCWatchCreateTransactionUseCase(),
 		c.newBTCWatchMonitorTransactionUseCase(),
@@ -296,7 +425,9 @@ func (c *container) newBTCWalleter() wallets.Watcher {
 func (c *container) newETHWalleter() wallets.Watcher {
 	return ethwallet.NewETHWatch(
 		c.newETH(),
-		c.newMySQLClient(),
+		c.newSafe(),
+		c.newForwarder(),
+		c.newDBClient(),
 		c.newETHWatchCreateTransactionUseCase(),
 		c.newETHWatchMonitorTransactionUseCase(),
 		c.newETHWatchSendTransactionUseCase(),
@@ -309,7 +440,7 @@ func (c *container) newETHWalleter() wallets.Watcher {
 func (c *container) newXRPWalleter() wallets.Watcher {
 	return xrpwallet.NewXRPWatch(
 		c.newXRP(),
-		c.newMySQLClient(),
+		c.newDBClient(),
 		c.newXRPWatchCreateTransactionUseCase(),
 		c.newXRPWatchMonitorTransactionUseCase(),
 		c.newXRPWatchSendTransactionUseCase(),
@@ -319,11 +450,38 @@ func (c *container) newXRPWalleter() wallets.Watcher {
 	)
 }
 
+func (c *container) newSOLWalleter() wallets.Watcher {
+	return solwallet.NewSOLWatch(
+		c.newSOL(),
+		c.newDBClient(),
+		c.newSOLWatchCreateTransactionUseCase(),
+		c.newSOLWatchMonitorTransactionUseCase(),
+		c.newSOLWatchSendTransactionUseCase(),
+		c.newWatchImportAddressUseCase(),
+		c.newWatchCreatePaymentRequestUseCase(),
+		c.walletType,
+	)
+}
+
+func (c *container) newTRXWalleter() wallets.Watcher {
+	return trxwallet.NewTRXWatch(
+		c.newTRX(),
+		c.newDBClient(),
+		c.newTRXWatchCreateTransactionUseCase(),
+		c.newTRXWatchMonitorTransactionUseCase(),
+		c.newTRXWatchSendTransactionUseCase(),
+		c.newWatchImportAddressUseCase(),
+		c.newWatchCreatePaymentRequestUseCase(),
+		c.walletType,
+	)
+}
+
 func (c *container) newConverter(coinTypeCode domainCoin.CoinTypeCode) converter.Converter {
 	switch coinTypeCode {
-	case domainCoin.BTC:
+	case domainCoin.BTC, domainCoin.LTC, domainCoin.DOGE:
 		return c.newBTC()
-	case domainCoin.BCH, domainCoin.LTC, domainCoin.ETH, domainCoin.XRP, domainCoin.ERC20, domainCoin.HYT:
+	case domainCoin.BCH, domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE,
+		domainCoin.XRP, domainCoin.SOL, domainCoin.TRX, domainCoin.ERC20, domainCoin.HYT:
 		return converter.NewConverter()
 	default:
 		return converter.NewConverter()
@@ -356,6 +514,30 @@ func (c *container) newEthRPCClient() *ethrpc.Client {
 	return c.rpcEthClient
 }
 
+// newSolRPCClient returns nil for keygen wallet which signs transaction offline
+func (c *container) newSolRPCClient() *ethrpc.Client {
+	if c.rpcSolClient == nil && c.walletType == domainWallet.WalletTypeWatchOnly {
+		var err error
+		c.rpcSolClient, err = solana.NewRPCClient(&c.conf.Solana)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return c.rpcSolClient
+}
+
+// newTrxHTTPClient returns nil for keygen wallet which signs transaction offline
+func (c *container) newTrxHTTPClient() *http.Client {
+	if c.httpTrxClient == nil && c.walletType == domainWallet.WalletTypeWatchOnly {
+		var err error
+		c.httpTrxClient, err = tron.NewHTTPClient(&c.conf.Tron)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return c.httpTrxClient
+}
+
 func (c *container) newXRPWSClient() (*websocket.WS, *websocket.WS) {
 	if c.wsXrpPublic == nil {
 		var err error
@@ -382,6 +564,11 @@ func (c *container) newGRPCConn() *grpc.ClientConn {
 // Wallet API
 //
 
+// isInstrumented returns true when RPC clients should record metrics or spans
+func (c *container) isInstrumented() bool {
+	return c.conf.Metrics.Enabled || tracer.Enabled()
+}
+
 func (c *container) newBTC() bitcoin.Bitcoiner {
 	if c.btc == nil {
 		var err error
@@ -393,10 +580,25 @@ func (c *container) newBTC() bitcoin.Bitcoiner {
 		if err != nil {
 			panic(err)
 		}
+		if c.isInstrumented() {
+			c.btc = bitcoin.NewInstrumentedBitcoiner(c.btc)
+		}
 	}
 	return c.btc
 }
 
+// newBTCBlockNotifier returns nil when ZMQ is disabled
+func (c *container) newBTCBlockNotifier() bitcoin.BlockNotifier {
+	if !c.conf.Bitcoin.ZMQ.Enabled {
+		return nil
+	}
+	subscriber, err := btc.NewZMQSubscriber(&c.conf.Bitcoin.ZMQ)
+	if err != nil {
+		panic(err)
+	}
+	return subscriber
+}
+
 func (c *container) newETH() ethereum.Ethereumer {
 	if c.eth == nil {
 		var err error
@@ -409,6 +611,9 @@ func (c *container) newETH() ethereum.Ethereumer {
 		if err != nil {
 			panic(err)
 		}
+		if c.isInstrumented() {
+			c.eth = ethereum.NewInstrumentedEthereumer(c.eth)
+		}
 	}
 	return c.eth
 }
@@ -430,6 +635,8 @@ func (c *container) newERC20() ethereum.ERC20er {
 			tokenClient,
 			conf.ERC20Token,
 			c.newUUIDHandler(),
+			conf.ChainID,
+			conf.FeeModel,
 			conf.ERC20s[conf.ERC20Token].Name,
 			conf.ERC20s[conf.ERC20Token].ContractAddress,
 			conf.ERC20s[conf.ERC20Token].MasterAddress,
@@ -439,6 +646,66 @@ func (c *container) newERC20() ethereum.ERC20er {
 	return c.erc20
 }
 
+// newSafe returns Safe without client for sign wallet which signs safeTxHash offline
+func (c *container) newSafe() ethereum.Safer {
+	if c.safe == nil {
+		var client safe.Backend
+		if c.walletType == domainWallet.WalletTypeWatchOnly {
+			client = ethclient.NewClient(c.newEthRPCClient())
+		}
+		conf := c.conf.Ethereum
+		var err error
+		c.safe, err = safe.NewSafe(
+			client,
+			c.newUUIDHandler(),
+			conf.ChainID,
+			conf.FeeModel,
+			&conf.Safe,
+		)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return c.safe
+}
+
+// newForwarder returns Forwarder, coin and all tokens of erc20s are swept from forwarders
+func (c *container) newForwarder() ethereum.Forwarder {
+	if c.forwarder == nil {
+		conf := c.conf.Ethereum
+		tokens := make([]string, 0, len(conf.ERC20s))
+		for _, erc20 := range conf.ERC20s {
+			tokens = append(tokens, erc20.ContractAddress)
+		}
+		var err error
+		c.forwarder, err = forwarder.NewForwarder(
+			ethclient.NewClient(c.newEthRPCClient()),
+			c.newUUIDHandler(),
+			conf.ChainID,
+			conf.FeeModel,
+			&conf.Forwarder,
+			tokens,
+		)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return c.forwarder
+}
+
+// newTransferScanner returns TransferScanner, transfers of all tokens of erc20s are scanned
+func (c *container) newTransferScanner() ethereum.DepositScanner {
+	tokens := make([]string, 0, len(c.conf.Ethereum.ERC20s))
+	for _, erc20 := range c.conf.Ethereum.ERC20s {
+		tokens = append(tokens, erc20.ContractAddress)
+	}
+	transferScanner, err := scanner.NewTransferScanner(ethclient.NewClient(c.newEthRPCClient()), tokens)
+	if err != nil {
+		panic(err)
+	}
+	return transferScanner
+}
+
 func (c *container) newXRP() ripple.Rippler {
 	if c.xrp == nil {
 		var err error
@@ -453,10 +720,51 @@ func (c *container) newXRP() ripple.Rippler {
 		if err != nil {
 			panic(err)
 		}
+		if c.isInstrumented() {
+			c.xrp = ripple.NewInstrumentedRippler(c.xrp)
+		}
 	}
 	return c.xrp
 }
 
+func (c *container) newSOL() solana.Solanaer {
+	if c.sol == nil {
+		var err error
+		c.sol, err = solana.NewSolana(
+			c.newSolRPCClient(),
+			&c.conf.Solana,
+			c.conf.CoinTypeCode,
+			c.newUUIDHandler(),
+		)
+		if err != nil {
+			panic(err)
+		}
+		if c.isInstrumented() {
+			c.sol = solana.NewInstrumentedSolanaer(c.sol)
+		}
+	}
+	return c.sol
+}
+
+func (c *container) newTRX() tron.Troner {
+	if c.trx == nil {
+		var err error
+		c.trx, err = tron.NewTron(
+			c.newTrxHTTPClient(),
+			&c.conf.Tron,
+			c.conf.CoinTypeCode,
+			c.newUUIDHandler(),
+		)
+		if err != nil {
+			panic(err)
+		}
+		if c.isInstrumented() {
+			c.trx = tron.NewInstrumentedTroner(c.trx)
+		}
+	}
+	return c.trx
+}
+
 func (c *container) newRippleAPI() *xrp.RippleAPI {
 	if c.rippleAPI == nil {
 		c.rippleAPI = xrp.NewRippleAPI(c.newGRPCConn())
@@ -479,15 +787,82 @@ func (c *container) newUUIDHandler() uuid.UUIDHandler {
 // DB
 //
 
-func (c *container) newMySQLClient() *sql.DB {
-	if c.mysqlClient == nil {
-		dbConn, err := mysql.NewMySQL(&c.conf.MySQL)
+// newDBClient returns connection whose schema is up to date
+func (c *container) newDBClient() *sql.DB {
+	dbConn := c.newDBConn()
+	if !c.schemaChecked {
+		c.prepareSchema()
+		c.schemaChecked = true
+	}
+	return dbConn
+}
+
+// prepareSchema applies pending migrations when auto_migrate is enabled,
+// it panics when database can't be used by this binary
+func (c *container) prepareSchema() {
+	migrator, err := c.NewMigrator()
+	if err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+	if c.conf.Database.AutoMigrate {
+		if _, err = migrator.Up(ctx); err != nil {
+			panic(err)
+		}
+		return
+	}
+	if err = migrator.Check(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// NewMigrator returns migrator of database for wallet type
+func (c *container) NewMigrator() (*migration.Migrator, error) {
+	set := migration.SetCold
+	if c.walletType == domainWallet.WalletTypeWatchOnly {
+		set = migration.SetWatch
+	}
+	return migration.NewMigrator(c.newDBConn(), c.conf.Database.Driver, set)
+}
+
+// newDBConn returns connection without checking schema
+func (c *container) newDBConn() *sql.DB {
+	if c.dbClient == nil {
+		var (
+			dbConn *sql.DB
+			err    error
+		)
+		switch c.conf.Database.Driver {
+		case config.DriverPostgres:
+			dbConn, err = postgres.NewPostgres(&c.conf.Postgres)
+		case config.DriverSQLite:
+			dbConn, err = sqlite.NewSQLite(&c.conf.SQLite)
+		default:
+			dbConn, err = mysql.NewMySQL(&c.conf.MySQL)
+		}
 		if err != nil {
 			panic(err)
 		}
-		c.mysqlClient = dbConn
+		c.dbClient = dbConn
+	}
+	return c.dbClient
+}
+
+// NewSQLiteDumpImporter returns importer of MySQL dump into SQLite database file of cold wallet
+func (c *container) NewSQLiteDumpImporter() (*sqlite.DumpImporter, error) {
+	if c.conf.Database.Driver != config.DriverSQLite {
+		return nil, fmt.Errorf("database driver must be %s to import dump, but %q", config.DriverSQLite, c.conf.Database.Driver)
+	}
+	return sqlite.NewDumpImporter(c.newDBClient()), nil
+}
+
+func (c *container) newLeaderLocker() scheduler.Locker {
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return postgres.NewLocker(c.newDBClient())
+	default:
+		return mysql.NewLocker(c.newDBClient())
 	}
-	return c.mysqlClient
 }
 
 //
@@ -495,59 +870,233 @@ func (c *container) newMySQLClient() *sql.DB {
 //
 
 func (c *container) newBTCTxRepo() watch.BTCTxRepositorier {
-	return watch.NewBTCTxRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewBTCTxRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewBTCTxRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 func (c *container) newBTCTxInputRepo() watch.TxInputRepositorier {
-	return watch.NewBTCTxInputRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewBTCTxInputRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewBTCTxInputRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 func (c *container) newBTCTxOutputRepo() watch.TxOutputRepositorier {
-	return watch.NewBTCTxOutputRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewBTCTxOutputRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewBTCTxOutputRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 func (c *container) newTxRepo() watch.TxRepositorier {
-	return watch.NewTxRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewTxRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewTxRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 func (c *container) newETHTxDetailRepo() watch.EthDetailTxRepositorier {
-	return watch.NewEthDetailTxInputRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewEthDetailTxInputRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewEthDetailTxInputRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 func (c *container) newXRPTxDetailRepo() watch.XrpDetailTxRepositorier {
-	return watch.NewXrpDetailTxInputRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewXrpDetailTxInputRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewXrpDetailTxInputRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
+}
+
+func (c *container) newXRPDepositRepo() watch.XrpDepositRepositorier {
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewXrpDepositRepositoryPostgres(c.newDBClient())
+	default:
+		return watch.NewXrpDepositRepositorySqlc(c.newDBClient())
+	}
+}
+
+func (c *container) newXRPEscrowRepo() watch.XrpEscrowRepositorier {
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewXrpEscrowRepositoryPostgres(c.newDBClient())
+	default:
+		return watch.NewXrpEscrowRepositorySqlc(c.newDBClient())
+	}
+}
+
+func (c *container) newSOLTxDetailRepo() watch.SolDetailTxRepositorier {
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewSolDetailTxRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewSolDetailTxRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
+}
+
+func (c *container) newTRXTxDetailRepo() watch.TrxDetailTxRepositorier {
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewTrxDetailTxRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewTrxDetailTxRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
+}
+
+func (c *container) newUnsignedTxRepo() watch.UnsignedTxRepositorier {
+	switch {
+	case domainCoin.IsBTCGroup(c.conf.CoinTypeCode):
+		return c.newBTCTxRepo()
+	case domainCoin.IsETHGroup(c.conf.CoinTypeCode):
+		return c.newETHTxDetailRepo()
+	case c.conf.CoinTypeCode == domainCoin.XRP:
+		return c.newXRPTxDetailRepo()
+	case c.conf.CoinTypeCode == domainCoin.SOL:
+		return c.newSOLTxDetailRepo()
+	case c.conf.CoinTypeCode == domainCoin.TRX:
+		return c.newTRXTxDetailRepo()
+	default:
+		panic(fmt.Sprintf("coinType[%s] is not implemented yet.", c.conf.CoinTypeCode))
+	}
 }
 
 func (c *container) newPaymentRequestRepo() watch.PaymentRequestRepositorier {
-	return watch.NewPaymentRequestRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewPaymentRequestRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewPaymentRequestRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
+}
+
+func (c *container) newDaemonJobRepo() watch.DaemonJobRepositorier {
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewDaemonJobRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewDaemonJobRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
+}
+
+func (c *container) newStreamCursorRepo() watch.StreamCursorRepositorier {
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewStreamCursorRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewStreamCursorRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
+}
+
+func (c *container) newEthDepositRepo() watch.EthDepositRepositorier {
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewEthDepositRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewEthDepositRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 func (c *container) newAddressRepo() watch.AddressRepositorier {
-	return watch.NewAddressRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return watch.NewAddressRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return watch.NewAddressRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 func (c *container) newAddressFileRepo() file.AddressFileRepositorier {
@@ -556,6 +1105,12 @@ func (c *container) newAddressFileRepo() file.AddressFileRepositorier {
 	)
 }
 
+func (c *container) newRegularKeyFileRepo() file.RegularKeyFileRepositorier {
+	return file.NewRegularKeyFileRepository(
+		c.conf.FilePath.Address,
+	)
+}
+
 func (c *container) newTxFileRepo() file.TransactionFileRepositorier {
 	return file.NewTransactionFileRepository(
 		c.conf.FilePath.Tx,
@@ -592,9 +1147,17 @@ func (c *container) newKeyGenerator() key.Generator {
 	case domainCoin.IsBTCGroup(c.conf.CoinTypeCode):
 		chainConf = c.newBTC().GetChainConf()
 	case domainCoin.IsETHGroup(c.conf.CoinTypeCode):
-		chainConf = c.newETH().GetChainConf()
+		// same as GetChainConf() of Ethereumer without connecting node because sign wallet is offline
+		chainConf = &chaincfg.TestNet3Params
+		if c.conf.Ethereum.IsMainnet() {
+			chainConf = &chaincfg.MainNetParams
+		}
 	case c.conf.CoinTypeCode == domainCoin.XRP:
 		chainConf = c.newXRP().GetChainConf()
+	case c.conf.CoinTypeCode == domainCoin.SOL:
+		chainConf = c.newSOL().GetChainConf()
+	case c.conf.CoinTypeCode == domainCoin.TRX:
+		chainConf = c.newTRX().GetChainConf()
 	default:
 		panic(fmt.Sprintf("coinType[%s] is not implemented yet.", c.conf.CoinTypeCode))
 	}
@@ -633,38 +1196,103 @@ func (c *container) newMultiAccount() account.MultisigAccounter {
 //
 
 func (c *container) newSeedRepo() cold.SeedRepositorier {
-	return cold.NewSeedRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return cold.NewSeedRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	case config.DriverSQLite:
+		return cold.NewSeedRepositorySQLite(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return cold.NewSeedRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 func (c *container) newAccountKeyRepo() cold.AccountKeyRepositorier {
-	return cold.NewAccountKeyRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return cold.NewAccountKeyRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	case config.DriverSQLite:
+		return cold.NewAccountKeyRepositorySQLite(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return cold.NewAccountKeyRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 func (c *container) newXRPAccountKeyRepo() cold.XRPAccountKeyRepositorier {
-	return cold.NewXRPAccountKeyRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return cold.NewXRPAccountKeyRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	case config.DriverSQLite:
+		return cold.NewXRPAccountKeyRepositorySQLite(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return cold.NewXRPAccountKeyRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 func (c *container) newAuthFullPubKeyRepo() cold.AuthFullPubkeyRepositorier {
-	return cold.NewAuthFullPubkeyRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return cold.NewAuthFullPubkeyRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	case config.DriverSQLite:
+		return cold.NewAuthFullPubkeyRepositorySQLite(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return cold.NewAuthFullPubkeyRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 func (c *container) newAuthKeyRepo() cold.AuthAccountKeyRepositorier {
-	return cold.NewAuthAccountKeyRepositorySqlc(
-		c.newMySQLClient(),
-		c.conf.CoinTypeCode,
-	)
+	switch c.conf.Database.Driver {
+	case config.DriverPostgres:
+		return cold.NewAuthAccountKeyRepositoryPostgres(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	case config.DriverSQLite:
+		return cold.NewAuthAccountKeyRepositorySQLite(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	default:
+		return cold.NewAuthAccountKeyRepositorySqlc(
+			c.newDBClient(),
+			c.conf.CoinTypeCode,
+		)
+	}
 }
 
 //
@@ -708,6 +1336,10 @@ func (c *container) NewWatchCreateTransactionUseCase() any {
 		return c.newETHWatchCreateTransactionUseCase()
 	case c.conf.CoinTypeCode == domainCoin.XRP:
 		return c.newXRPWatchCreateTransactionUseCase()
+	case c.conf.CoinTypeCode == domainCoin.SOL:
+		return c.newSOLWatchCreateTransactionUseCase()
+	case c.conf.CoinTypeCode == domainCoin.TRX:
+		return c.newTRXWatchCreateTransactionUseCase()
 	default:
 		panic(fmt.Sprintf("coinType[%s] is not implemented yet.", c.conf.CoinTypeCode))
 	}
@@ -721,6 +1353,10 @@ func (c *container) NewWatchMonitorTransactionUseCase() any {
 		return c.newETHWatchMonitorTransactionUseCase()
 	case c.conf.CoinTypeCode == domainCoin.XRP:
 		return c.newXRPWatchMonitorTransactionUseCase()
+	case c.conf.CoinTypeCode == domainCoin.SOL:
+		return c.newSOLWatchMonitorTransactionUseCase()
+	case c.conf.CoinTypeCode == domainCoin.TRX:
+		return c.newTRXWatchMonitorTransactionUseCase()
 	default:
 		panic(fmt.Sprintf("coinType[%s] is not implemented yet.", c.conf.CoinTypeCode))
 	}
@@ -734,6 +1370,21 @@ func (c *container) NewWatchSendTransactionUseCase() any {
 		return c.newETHWatchSendTransactionUseCase()
 	case c.conf.CoinTypeCode == domainCoin.XRP:
 		return c.newXRPWatchSendTransactionUseCase()
+	case c.conf.CoinTypeCode == domainCoin.SOL:
+		return c.newSOLWatchSendTransactionUseCase()
+	case c.conf.CoinTypeCode == domainCoin.TRX:
+		return c.newTRXWatchSendTransactionUseCase()
+	default:
+		panic(fmt.Sprintf("coinType[%s] is not implemented yet.", c.conf.CoinTypeCode))
+	}
+}
+
+func (c *container) NewWatchStreamMonitorUseCase() watchusecase.StreamMonitorUseCase {
+	switch {
+	case domainCoin.IsBTCGroup(c.conf.CoinTypeCode):
+		return c.newBTCWatchStreamMonitorUseCase()
+	case c.conf.CoinTypeCode == domainCoin.XRP:
+		return c.newXRPWatchStreamMonitorUseCase()
 	default:
 		panic(fmt.Sprintf("coinType[%s] is not implemented yet.", c.conf.CoinTypeCode))
 	}
@@ -743,10 +1394,238 @@ func (c *container) NewWatchImportAddressUseCase() watchusecase.ImportAddressUse
 	return c.newWatchImportAddressUseCase()
 }
 
+func (c *container) NewWatchGenerateForwarderAddressUseCase() watchusecase.GenerateForwarderAddressUseCase {
+	if !domainCoin.IsEVMChain(c.conf.CoinTypeCode) {
+		panic(fmt.Sprintf("coinType[%s] doesn't support forwarder", c.conf.CoinTypeCode))
+	}
+	return watchusecaseeth.NewGenerateForwarderAddressUseCase(
+		c.newForwarder(),
+		c.newAddressRepo(),
+		c.conf.CoinTypeCode,
+	)
+}
+
+func (c *container) NewWatchGenerateDepositTagAddressUseCase() watchusecase.GenerateDepositTagAddressUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support destination tag", c.conf.CoinTypeCode))
+	}
+	return watchusecasexrp.NewGenerateDepositTagAddressUseCase(
+		c.newXRP(),
+		c.newAddressRepo(),
+		c.conf.Ripple.DepositTag.Address,
+		c.conf.Ripple.NetworkType != xrp.NetworkTypeXRPMainNet.String(),
+	)
+}
+
+func (c *container) NewWatchCreateTicketUseCase() watchusecase.CreateTicketUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support ticket", c.conf.CoinTypeCode))
+	}
+	return watchusecasexrp.NewCreateTicketUseCase(
+		c.newXRP(),
+		c.newDBClient(),
+		c.newUUIDHandler(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newXRPTxDetailRepo(),
+		c.newTxFileRepo(),
+	)
+}
+
+func (c *container) NewWatchCreateSignerListUseCase() watchusecase.CreateSignerListUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support signer list", c.conf.CoinTypeCode))
+	}
+	return watchusecasexrp.NewCreateSignerListUseCase(
+		c.newXRP(),
+		c.newDBClient(),
+		c.newUUIDHandler(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newXRPTxDetailRepo(),
+		c.newTxFileRepo(),
+		c.newAddressFileRepo(),
+		c.newMultiAccount(),
+	)
+}
+
+func (c *container) NewWatchCreateRequireDestUseCase() watchusecase.CreateRequireDestUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support destination tag", c.conf.CoinTypeCode))
+	}
+	return watchusecasexrp.NewCreateRequireDestUseCase(
+		c.newXRP(),
+		c.newDBClient(),
+		c.newUUIDHandler(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newXRPTxDetailRepo(),
+		c.newTxFileRepo(),
+	)
+}
+
+func (c *container) NewWatchCreateTrustLineUseCase() watchusecase.CreateTrustLineUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support trust line", c.conf.CoinTypeCode))
+	}
+	return watchusecasexrp.NewCreateTrustLineUseCase(
+		c.newXRP(),
+		c.newDBClient(),
+		c.newUUIDHandler(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newXRPTxDetailRepo(),
+		c.newTxFileRepo(),
+	)
+}
+
+func (c *container) NewWatchCreateRegularKeyUseCase() watchusecase.CreateRegularKeyUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support regular key", c.conf.CoinTypeCode))
+	}
+	return watchusecasexrp.NewCreateRegularKeyUseCase(
+		c.newXRP(),
+		c.newDBClient(),
+		c.newUUIDHandler(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newXRPTxDetailRepo(),
+		c.newTxFileRepo(),
+		c.newRegularKeyFileRepo(),
+	)
+}
+
+func (c *container) NewWatchCreateDisableMasterUseCase() watchusecase.CreateDisableMasterUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support disabling master key", c.conf.CoinTypeCode))
+	}
+	return watchusecasexrp.NewCreateDisableMasterUseCase(
+		c.newXRP(),
+		c.newDBClient(),
+		c.newUUIDHandler(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newXRPTxDetailRepo(),
+		c.newTxFileRepo(),
+	)
+}
+
+func (c *container) NewWatchCreateAccountDeleteUseCase() watchusecase.CreateAccountDeleteUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support account deletion", c.conf.CoinTypeCode))
+	}
+	return watchusecasexrp.NewCreateAccountDeleteUseCase(
+		c.newXRP(),
+		c.newDBClient(),
+		c.newUUIDHandler(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newXRPTxDetailRepo(),
+		c.newTxFileRepo(),
+	)
+}
+
+func (c *container) NewWatchQuarantinedDepositUseCase() watchusecase.QuarantinedDepositUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support destination tag", c.conf.CoinTypeCode))
+	}
+	return watchusecasexrp.NewQuarantinedDepositUseCase(
+		c.newAddressRepo(),
+		c.newXRPDepositRepo(),
+	)
+}
+
+func (c *container) NewWatchCreateEscrowUseCase() watchusecase.CreateEscrowUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support escrow", c.conf.CoinTypeCode))
+	}
+	return watchusecasexrp.NewCreateEscrowUseCase(
+		c.newXRP(),
+		c.newDBClient(),
+		c.newUUIDHandler(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newXRPTxDetailRepo(),
+		c.newXRPEscrowRepo(),
+		c.newTxFileRepo(),
+	)
+}
+
+func (c *container) NewWatchFinishEscrowUseCase() watchusecase.FinishEscrowUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support escrow", c.conf.CoinTypeCode))
+	}
+	return watchusecasexrp.NewFinishEscrowUseCase(
+		c.newXRP(),
+		c.newDBClient(),
+		c.newUUIDHandler(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newXRPTxDetailRepo(),
+		c.newXRPEscrowRepo(),
+		c.newTxFileRepo(),
+	)
+}
+
+func (c *container) NewWatchScanDepositUseCase() watchusecase.ScanDepositUseCase {
+	if !domainCoin.IsETHGroup(c.conf.CoinTypeCode) {
+		panic(fmt.Sprintf("coinType[%s] doesn't support deposit scanner", c.conf.CoinTypeCode))
+	}
+	// native coin is scanned only by wallet of EVM chain
+	var scanners []ethereum.DepositScanner
+	if domainCoin.IsEVMChain(c.conf.CoinTypeCode) {
+		scanners = append(scanners, scanner.NewBlockScanner(
+			ethclient.NewClient(c.newEthRPCClient()),
+			c.newEthRPCClient(),
+		))
+	}
+	if len(c.conf.Ethereum.ERC20s) != 0 {
+		scanners = append(scanners, c.newTransferScanner())
+	}
+	return watchusecaseeth.NewScanDepositUseCase(
+		scanners,
+		c.newEthDepositRepo(),
+		c.newAddressRepo(),
+		c.newStreamCursorRepo(),
+		c.conf.Ethereum.ScanBlockRange,
+	)
+}
+
 func (c *container) NewWatchCreatePaymentRequestUseCase() watchusecase.CreatePaymentRequestUseCase {
 	return c.newWatchCreatePaymentRequestUseCase()
 }
 
+func (c *container) NewWatchRefreshMetricsUseCase() watchusecase.RefreshMetricsUseCase {
+	return c.newWatchRefreshMetricsUseCase()
+}
+
+// Watch Daemon
+
+func (c *container) NewWatchScheduler() *scheduler.Scheduler {
+	opts := []scheduler.Option{
+		scheduler.WithStatusStore(c.newDaemonJobRepo()),
+	}
+	if c.conf.Daemon.LeaderLock != "" {
+		opts = append(opts, scheduler.WithLeaderLock(
+			c.newLeaderLocker(),
+			c.conf.Daemon.LeaderLock,
+		))
+	}
+	return scheduler.New(opts...)
+}
+
+func (c *container) NewWatchDaemonJobRepo() watch.DaemonJobRepositorier {
+	return c.newDaemonJobRepo()
+}
+
+// NewWatchMetricsServer returns nil when metrics is disabled
+func (c *container) NewWatchMetricsServer() *metrics.Server {
+	if !c.conf.Metrics.Enabled {
+		return nil
+	}
+	return metrics.NewServer(c.conf.Metrics.Address, c.newWatchRefreshMetricsUseCase().Execute)
+}
+
 // Keygen Use Cases
 
 func (c *container) NewKeygenGenerateHDWalletUseCase() keygenusecase.GenerateHDWalletUseCase {
@@ -784,6 +1663,27 @@ func (c *container) NewKeygenGenerateKeyUseCase() keygenusecase.GenerateKeyUseCa
 	return c.newXRPKeygenGenerateKeyUseCase()
 }
 
+func (c *container) NewKeygenCreateRegularKeyUseCase() keygenusecase.CreateRegularKeyUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support regular key", c.conf.CoinTypeCode))
+	}
+	return keygenusecasexrp.NewCreateRegularKeyUseCase(
+		c.newXRP(),
+		c.newXRPAccountKeyRepo(),
+		c.newRegularKeyFileRepo(),
+	)
+}
+
+func (c *container) NewKeygenImportRegularKeyUseCase() keygenusecase.ImportRegularKeyUseCase {
+	if c.conf.CoinTypeCode != domainCoin.XRP {
+		panic(fmt.Sprintf("coinType[%s] doesn't support regular key", c.conf.CoinTypeCode))
+	}
+	return keygenusecasexrp.NewImportRegularKeyUseCase(
+		c.newXRPAccountKeyRepo(),
+		c.newRegularKeyFileRepo(),
+	)
+}
+
 func (c *container) NewKeygenSignTransactionUseCase() keygenusecase.SignTransactionUseCase {
 	switch {
 	case domainCoin.IsBTCGroup(c.conf.CoinTypeCode):
@@ -792,6 +1692,10 @@ func (c *container) NewKeygenSignTransactionUseCase() keygenusecase.SignTransact
 		return c.newETHKeygenSignTransactionUseCase()
 	case c.conf.CoinTypeCode == domainCoin.XRP:
 		return c.newXRPKeygenSignTransactionUseCase()
+	case c.conf.CoinTypeCode == domainCoin.SOL:
+		return c.newSOLKeygenSignTransactionUseCase()
+	case c.conf.CoinTypeCode == domainCoin.TRX:
+		return c.newTRXKeygenSignTransactionUseCase()
 	default:
 		panic(fmt.Sprintf("coinType[%s] is not implemented yet.", c.conf.CoinTypeCode))
 	}
@@ -815,12 +1719,21 @@ func (c *container) NewSignTransactionUseCase() signusecase.SignTransactionUseCa
 func (c *container) NewSignImportPrivateKeyUseCase(
 	authType domainAccount.AuthType,
 ) signusecase.ImportPrivateKeyUseCase {
+	if domainCoin.IsETHGroup(c.conf.CoinTypeCode) {
+		return signusecaseeth.NewImportPrivateKeyUseCase(c.newAuthKeyRepo(), authType, c.walletType)
+	}
+	if c.conf.CoinTypeCode == domainCoin.XRP {
+		return c.newXRPSignImportPrivateKeyUseCase(authType)
+	}
 	return c.newBTCSignImportPrivateKeyUseCase(authType)
 }
 
 func (c *container) NewSignExportFullPubkeyUseCase(
 	authType domainAccount.AuthType,
 ) signusecase.ExportFullPubkeyUseCase {
+	if c.conf.CoinTypeCode == domainCoin.XRP {
+		return c.newXRPSignExportFullPubkeyUseCase(authType)
+	}
 	return c.newBTCSignExportFullPubkeyUseCase(authType)
 }
 
@@ -846,7 +1759,7 @@ func (c *container) NewSignGenerateAuthKeyUseCase() signusecase.GenerateAuthKeyU
 func (c *container) newBTCWatchCreateTransactionUseCase() watchusecase.CreateTransactionUseCase {
 	return watchusecasebtc.NewCreateTransactionUseCase(
 		c.newBTC(),
-		c.newMySQLClient(),
+		c.newDBClient(),
 		c.newAddressRepo(),
 		c.newBTCTxRepo(),
 		c.newBTCTxInputRepo(),
@@ -862,13 +1775,25 @@ func (c *container) newBTCWatchCreateTransactionUseCase() watchusecase.CreateTra
 func (c *container) newBTCWatchMonitorTransactionUseCase() watchusecase.MonitorTransactionUseCase {
 	return watchusecasebtc.NewMonitorTransactionUseCase(
 		c.newBTC(),
-		c.newMySQLClient(),
+		c.newDBClient(),
 		c.newBTCTxRepo(),
 		c.newBTCTxInputRepo(),
 		c.newPaymentRequestRepo(),
 	)
 }
 
+func (c *container) newBTCWatchStreamMonitorUseCase() watchusecase.StreamMonitorUseCase {
+	return watchusecasebtc.NewStreamMonitorUseCase(
+		c.newBTC(),
+		c.newBTCBlockNotifier(),
+		c.newBTCWatchMonitorTransactionUseCase(),
+		c.newBTCWatchCreateTransactionUseCase(),
+		c.newAddressRepo(),
+		c.newStreamCursorRepo(),
+		c.conf.Bitcoin.ZMQ.PollInterval,
+	)
+}
+
 func (c *container) newBTCWatchSendTransactionUseCase() watchusecase.SendTransactionUseCase {
 	return watchusecasebtc.NewSendTransactionUseCase(
 		c.newBTC(),
@@ -893,16 +1818,25 @@ func (c *container) newBTCWatchImportAddressUseCase() watchusecase.ImportAddress
 
 func (c *container) newETHWatchCreateTransactionUseCase() watchusecase.CreateTransactionUseCase {
 	// Determine which Ethereum API to use based on coin type
+	// Safe and forwarder are available for only native coin, tokens are swept with coin from forwarders
 	var targetEthAPI ethereum.EtherTxCreator
+	var targetSafe ethereum.Safer
+	var targetForwarder ethereum.Forwarder
 	if domainCoin.IsERC20Token(c.conf.CoinTypeCode.String()) {
 		targetEthAPI = c.newERC20()
 	} else {
 		targetEthAPI = c.newETH()
+		targetSafe = c.newSafe()
+		if c.conf.Ethereum.Forwarder.Factory != "" {
+			targetForwarder = c.newForwarder()
+		}
 	}
 
 	return watchusecaseeth.NewCreateTransactionUseCase(
 		targetEthAPI,
-		c.newMySQLClient(),
+		targetSafe,
+		targetForwarder,
+		c.newDBClient(),
 		c.newAddressRepo(),
 		c.newTxRepo(),
 		c.newETHTxDetailRepo(),
@@ -910,6 +1844,7 @@ func (c *container) newETHWatchCreateTransactionUseCase() watchusecase.CreateTra
 		c.newTxFileRepo(),
 		c.newDepositAccount(),
 		c.newPaymentAccount(),
+		c.conf.CoinTypeCode,
 	)
 }
 
@@ -929,6 +1864,7 @@ func (c *container) newETHWatchMonitorTransactionUseCase() watchusecase.MonitorT
 func (c *container) newETHWatchSendTransactionUseCase() watchusecase.SendTransactionUseCase {
 	return watchusecaseeth.NewSendTransactionUseCase(
 		c.newETH(),
+		c.newSafe(),
 		c.newETHTxDetailRepo(),
 		c.newTxFileRepo(),
 	)
@@ -939,7 +1875,7 @@ func (c *container) newETHWatchSendTransactionUseCase() watchusecase.SendTransac
 func (c *container) newXRPWatchCreateTransactionUseCase() watchusecase.CreateTransactionUseCase {
 	return watchusecasexrp.NewCreateTransactionUseCase(
 		c.newXRP(),
-		c.newMySQLClient(),
+		c.newDBClient(),
 		c.newUUIDHandler(),
 		c.newAddressRepo(),
 		c.newTxRepo(),
@@ -954,7 +1890,30 @@ func (c *container) newXRPWatchCreateTransactionUseCase() watchusecase.CreateTra
 func (c *container) newXRPWatchMonitorTransactionUseCase() watchusecase.MonitorTransactionUseCase {
 	return watchusecasexrp.NewMonitorTransactionUseCase(
 		c.newXRP(),
+		c.newDBClient(),
+		c.newUUIDHandler(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newXRPTxDetailRepo(),
+		c.newPaymentRequestRepo(),
+		c.newTxFileRepo(),
+		c.conf.Ripple.Resubmit.MaxRetry,
+	)
+}
+
+func (c *container) newXRPWatchStreamMonitorUseCase() watchusecase.StreamMonitorUseCase {
+	subscriber, err := ripple.NewSubscriber(&c.conf.Ripple)
+	if err != nil {
+		panic(err)
+	}
+	return watchusecasexrp.NewStreamMonitorUseCase(
+		c.newXRP(),
+		subscriber,
+		c.newXRPTxDetailRepo(),
 		c.newAddressRepo(),
+		c.newStreamCursorRepo(),
+		c.newXRPDepositRepo(),
+		c.conf.Ripple.DepositTag.Address,
 	)
 }
 
@@ -966,6 +1925,74 @@ func (c *container) newXRPWatchSendTransactionUseCase() watchusecase.SendTransac
 	)
 }
 
+// SOL Watch Use Cases
+
+func (c *container) newSOLWatchCreateTransactionUseCase() watchusecase.CreateTransactionUseCase {
+	return watchusecasesol.NewCreateTransactionUseCase(
+		c.newSOL(),
+		c.newDBClient(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newSOLTxDetailRepo(),
+		c.newPaymentRequestRepo(),
+		c.newTxFileRepo(),
+		c.newDepositAccount(),
+		c.newPaymentAccount(),
+		c.conf.CoinTypeCode,
+	)
+}
+
+func (c *container) newSOLWatchMonitorTransactionUseCase() watchusecase.MonitorTransactionUseCase {
+	return watchusecasesol.NewMonitorTransactionUseCase(
+		c.newSOL(),
+		c.newAddressRepo(),
+		c.newSOLTxDetailRepo(),
+		sol.Commitment(c.conf.Solana.Commitment),
+	)
+}
+
+func (c *container) newSOLWatchSendTransactionUseCase() watchusecase.SendTransactionUseCase {
+	return watchusecasesol.NewSendTransactionUseCase(
+		c.newSOL(),
+		c.newSOLTxDetailRepo(),
+		c.newTxFileRepo(),
+	)
+}
+
+// TRX Watch Use Cases
+
+func (c *container) newTRXWatchCreateTransactionUseCase() watchusecase.CreateTransactionUseCase {
+	return watchusecasetrx.NewCreateTransactionUseCase(
+		c.newTRX(),
+		c.newDBClient(),
+		c.newAddressRepo(),
+		c.newTxRepo(),
+		c.newTRXTxDetailRepo(),
+		c.newPaymentRequestRepo(),
+		c.newTxFileRepo(),
+		c.newDepositAccount(),
+		c.newPaymentAccount(),
+		c.conf.CoinTypeCode,
+	)
+}
+
+func (c *container) newTRXWatchMonitorTransactionUseCase() watchusecase.MonitorTransactionUseCase {
+	return watchusecasetrx.NewMonitorTransactionUseCase(
+		c.newTRX(),
+		c.newAddressRepo(),
+		c.newTRXTxDetailRepo(),
+		c.conf.Tron.ConfirmationNum,
+	)
+}
+
+func (c *container) newTRXWatchSendTransactionUseCase() watchusecase.SendTransactionUseCase {
+	return watchusecasetrx.NewSendTransactionUseCase(
+		c.newTRX(),
+		c.newTRXTxDetailRepo(),
+		c.newTxFileRepo(),
+	)
+}
+
 // Shared Watch Use Cases
 
 func (c *container) newWatchImportAddressUseCase() watchusecase.ImportAddressUseCase {
@@ -981,7 +2008,7 @@ func (c *container) newWatchImportAddressUseCase() watchusecase.ImportAddressUse
 func (c *container) newWatchCreatePaymentRequestUseCase() watchusecase.CreatePaymentRequestUseCase {
 	return watchusecaseshared.NewCreatePaymentRequestUseCase(
 		c.newConverter(c.conf.CoinTypeCode),
-		c.newMySQLClient(),
+		c.newDBClient(),
 		c.newAddressRepo(),
 		c.newPaymentRequestRepo(),
 		c.conf.CoinTypeCode,
@@ -989,6 +2016,15 @@ func (c *container) newWatchCreatePaymentRequestUseCase() watchusecase.CreatePay
 	)
 }
 
+func (c *container) newWatchRefreshMetricsUseCase() watchusecase.RefreshMetricsUseCase {
+	return watchusecaseshared.NewRefreshMetricsUseCase(
+		c.newAddressRepo(),
+		c.newPaymentRequestRepo(),
+		c.newUnsignedTxRepo(),
+		c.conf.CoinTypeCode,
+	)
+}
+
 // Keygen Use Cases
 
 func (c *container) newKeygenGenerateHDWalletUseCase() keygenusecase.GenerateHDWalletUseCase {
@@ -1054,7 +2090,7 @@ func (c *container) newETHKeygenImportPrivateKeyUseCase() keygenusecase.ImportPr
 func (c *container) newXRPKeygenGenerateKeyUseCase() keygenusecase.GenerateKeyUseCase {
 	return keygenusecasexrp.NewGenerateKeyUseCase(
 		c.newXRP(),
-		c.newMySQLClient(),
+		c.newDBClient(),
 		c.conf.CoinTypeCode,
 		c.newAccountKeyRepo(),
 		c.newXRPAccountKeyRepo(),
@@ -1087,6 +2123,22 @@ func (c *container) newXRPKeygenSignTransactionUseCase() keygenusecase.SignTrans
 	)
 }
 
+func (c *container) newSOLKeygenSignTransactionUseCase() keygenusecase.SignTransactionUseCase {
+	return keygenusecasesol.NewSignTransactionUseCase(
+		c.newSOL(),
+		c.newAccountKeyRepo(),
+		c.newTxFileRepo(),
+	)
+}
+
+func (c *container) newTRXKeygenSignTransactionUseCase() keygenusecase.SignTransactionUseCase {
+	return keygenusecasetrx.NewSignTransactionUseCase(
+		c.newTRX(),
+		c.newAccountKeyRepo(),
+		c.newTxFileRepo(),
+	)
+}
+
 // Sign Use Cases
 
 // BTC Sign Use Cases
@@ -1130,8 +2182,10 @@ func (c *container) newBTCSignExportFullPubkeyUseCase(
 
 func (c *container) newETHSignTransactionUseCase() signusecase.SignTransactionUseCase {
 	return signusecaseeth.NewSignTransactionUseCase(
-		c.newETH(),
+		c.newSafe(),
+		c.newAuthKeyRepo(),
 		c.newTxFileStorager(),
+		c.AuthType(),
 		c.walletType,
 	)
 }
@@ -1143,6 +2197,31 @@ func (c *container) newXRPSignTransactionUseCase() signusecase.SignTransactionUs
 		c.newXRP(),
 		c.newXRPAccountKeyRepo(),
 		c.newTxFileStorager(),
+		c.AuthType(),
+		c.walletType,
+	)
+}
+
+func (c *container) newXRPSignImportPrivateKeyUseCase(
+	authType domainAccount.AuthType,
+) signusecase.ImportPrivateKeyUseCase {
+	return signusecasexrp.NewImportPrivateKeyUseCase(
+		c.newXRP(),
+		c.newAuthKeyRepo(),
+		c.newXRPAccountKeyRepo(),
+		authType,
+		c.walletType,
+	)
+}
+
+func (c *container) newXRPSignExportFullPubkeyUseCase(
+	authType domainAccount.AuthType,
+) signusecase.ExportFullPubkeyUseCase {
+	return signusecasexrp.NewExportFullPubkeyUseCase(
+		c.newXRPAccountKeyRepo(),
+		c.newPubkeyFileStorager(),
+		c.conf.CoinTypeCode,
+		authType,
 		c.walletType,
 	)
 }
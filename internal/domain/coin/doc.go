@@ -10,8 +10,10 @@
 //   - Bitcoin (BTC)
 //   - Bitcoin Cash (BCH)
 //   - Litecoin (LTC)
-//   - Ethereum (ETH)
+//   - Ethereum (ETH) and other EVM chains (POL, BNB, ARB, BASE)
 //   - Ripple (XRP)
+//   - Solana (SOL and SPL tokens)
+//   - Tron (TRX and TRC-20 tokens)
 //   - ERC20 tokens (HYT, BAT, and others)
 //
 // This package has no infrastructure dependencies and can be tested in isolation.
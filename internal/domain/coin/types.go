@@ -23,6 +23,9 @@ const (
 	// CoinTypeLitecoin represents Litecoin (BIP44 coin type 2)
 	CoinTypeLitecoin CoinType = 2
 
+	// CoinTypeDogecoin represents Dogecoin (BIP44 coin type 3)
+	CoinTypeDogecoin CoinType = 3
+
 	// CoinTypeEther represents Ethereum (BIP44 coin type 60)
 	CoinTypeEther CoinType = 60
 
@@ -32,6 +35,12 @@ const (
 	// CoinTypeBitcoinCash represents Bitcoin Cash (BIP44 coin type 145)
 	CoinTypeBitcoinCash CoinType = 145
 
+	// CoinTypeTron represents Tron (BIP44 coin type 195)
+	CoinTypeTron CoinType = 195
+
+	// CoinTypeSolana represents Solana (BIP44 coin type 501)
+	CoinTypeSolana CoinType = 501
+
 	// ERC20 tokens (temporary values, not part of SLIP-0044)
 	// TODO: Review these temporary values
 
@@ -56,12 +65,33 @@ const (
 	// LTC represents Litecoin
 	LTC CoinTypeCode = "ltc"
 
+	// DOGE represents Dogecoin
+	DOGE CoinTypeCode = "doge"
+
 	// ETH represents Ethereum
 	ETH CoinTypeCode = "eth"
 
 	// XRP represents Ripple
 	XRP CoinTypeCode = "xrp"
 
+	// SOL represents Solana
+	SOL CoinTypeCode = "sol"
+
+	// TRX represents Tron
+	TRX CoinTypeCode = "trx"
+
+	// POL represents Polygon PoS chain
+	POL CoinTypeCode = "pol"
+
+	// BNB represents BNB Smart Chain
+	BNB CoinTypeCode = "bnb"
+
+	// ARB represents Arbitrum One, native coin is ETH
+	ARB CoinTypeCode = "arb"
+
+	// BASE represents Base, native coin is ETH
+	BASE CoinTypeCode = "base"
+
 	// ERC20 represents generic ERC20 tokens
 	ERC20 CoinTypeCode = "erc20"
 
@@ -79,8 +109,15 @@ var CoinTypeCodeValue = map[CoinTypeCode]CoinType{
 	BTC:   CoinTypeBitcoin,
 	BCH:   CoinTypeBitcoinCash,
 	LTC:   CoinTypeLitecoin,
+	DOGE:  CoinTypeDogecoin,
 	ETH:   CoinTypeEther,
 	XRP:   CoinTypeRipple,
+	SOL:   CoinTypeSolana,
+	TRX:   CoinTypeTron,
+	POL:   CoinTypeEther,
+	BNB:   CoinTypeEther,
+	ARB:   CoinTypeEther,
+	BASE:  CoinTypeEther,
 	ERC20: CoinTypeERC20,
 	HYT:   CoinTypeERC20HYT,
 }
@@ -91,14 +128,37 @@ func IsCoinTypeCode(val string) bool {
 	return ok
 }
 
-// IsBTCGroup returns true if the coin is part of the Bitcoin group (BTC, BCH).
+// IsBTCGroup returns true if the coin is part of the Bitcoin group (BTC, BCH, LTC, DOGE).
 func IsBTCGroup(val CoinTypeCode) bool {
-	return val == BTC || val == BCH
+	return val == BTC || val == BCH || val == LTC || val == DOGE
 }
 
-// IsETHGroup returns true if the coin is part of the Ethereum group (ETH, ERC20 tokens).
+// IsETHGroup returns true if the coin is part of the Ethereum group (EVM chains, ERC20 tokens).
 func IsETHGroup(val CoinTypeCode) bool {
-	return val == ETH || val == ERC20 || IsERC20Token(val.String())
+	return IsEVMChain(val) || val == ERC20 || IsERC20Token(val.String())
+}
+
+// evmChains is coin type codes of native coin of EVM chain.
+// chain defined in config is added by RegisterEVMChain.
+var evmChains = map[CoinTypeCode]bool{
+	ETH:  true,
+	POL:  true,
+	BNB:  true,
+	ARB:  true,
+	BASE: true,
+}
+
+// IsEVMChain returns true if the coin is native coin of EVM chain (ETH, POL, BNB, ARB, BASE and registered chain).
+// every EVM chain uses coin type of Ethereum, so same address is derived on each chain.
+func IsEVMChain(val CoinTypeCode) bool {
+	return evmChains[val]
+}
+
+// RegisterEVMChain registers coin type code of EVM chain defined in config.
+// it must be called before any goroutine reads coin type codes.
+func RegisterEVMChain(val CoinTypeCode) {
+	evmChains[val] = true
+	CoinTypeCodeValue[val] = CoinTypeEther
 }
 
 // ERC20Token represents ERC20 token identifiers.
@@ -130,6 +190,33 @@ func IsERC20Token(val string) bool {
 	return ok
 }
 
+// SPLToken represents symbol of SPL token on Solana.
+// mint address of each token is defined in config, so any symbol is acceptable.
+type SPLToken string
+
+// String returns the string representation of the SPL token.
+func (s SPLToken) String() string {
+	return string(s)
+}
+
+// TRC20Token represents symbol of TRC-20 token on Tron.
+// contract address of each token is defined in config, so any symbol is acceptable.
+type TRC20Token string
+
+// String returns the string representation of the TRC-20 token.
+func (t TRC20Token) String() string {
+	return string(t)
+}
+
+// IssuedCurrency represents symbol of issued currency on XRP Ledger.
+// currency code and issuer of each currency are defined in config, so any symbol is acceptable.
+type IssuedCurrency string
+
+// String returns the string representation of the issued currency.
+func (i IssuedCurrency) String() string {
+	return string(i)
+}
+
 // GetCoinType returns CoinType based on network configuration
 // This function has infrastructure dependency (chaincfg) and remains in this package
 func GetCoinType(c CoinTypeCode, conf *chaincfg.Params) CoinType {
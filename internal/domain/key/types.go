@@ -58,3 +58,19 @@ func (k KeyType) Validate() error {
 		return fmt.Errorf("invalid key type: %s", k)
 	}
 }
+
+// XRPActiveKey represents which key pair signs transactions of XRP account
+type XRPActiveKey int8
+
+const (
+	// XRPActiveKeyMaster is master key pair generated with account
+	XRPActiveKeyMaster XRPActiveKey = 0
+
+	// XRPActiveKeyRegular is regular key pair set by SetRegularKey transaction
+	XRPActiveKeyRegular XRPActiveKey = 1
+)
+
+// Int8 returns the value stored in database
+func (k XRPActiveKey) Int8() int8 {
+	return int8(k)
+}
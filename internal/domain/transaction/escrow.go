@@ -0,0 +1,46 @@
+package transaction
+
+// EscrowStatus represents the lifecycle state of XRP escrow.
+//
+// Escrow progresses through a state machine:
+// created → finishing → finished or canceled
+// escrow whose EscrowCreate isn't validated is failed
+type EscrowStatus string
+
+// Escrow status constants
+const (
+	// EscrowStatusCreated means EscrowCreate has been created, escrow is held until it matures
+	EscrowStatusCreated EscrowStatus = "created"
+
+	// EscrowStatusFinishing means EscrowFinish or EscrowCancel has been created
+	EscrowStatusFinishing EscrowStatus = "finishing"
+
+	// EscrowStatusFinished means escrowed XRP has been delivered to destination by EscrowFinish
+	EscrowStatusFinished EscrowStatus = "finished"
+
+	// EscrowStatusCanceled means escrowed XRP has been returned to owner by EscrowCancel
+	EscrowStatusCanceled EscrowStatus = "canceled"
+
+	// EscrowStatusFailed means EscrowCreate has been canceled or expired, escrow doesn't exist
+	EscrowStatusFailed EscrowStatus = "failed"
+)
+
+// String returns the string representation of the escrow status.
+func (s EscrowStatus) String() string {
+	return string(s)
+}
+
+// Int8 returns the numeric value of the escrow status as int8.
+func (s EscrowStatus) Int8() int8 {
+	return int8(EscrowStatusValue[s])
+}
+
+// EscrowStatusValue provides numeric values for escrow statuses.
+// These values are used for database storage.
+var EscrowStatusValue = map[EscrowStatus]uint8{
+	EscrowStatusCreated:   0,
+	EscrowStatusFinishing: 1,
+	EscrowStatusFinished:  2,
+	EscrowStatusCanceled:  3,
+	EscrowStatusFailed:    4,
+}
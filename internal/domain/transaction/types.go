@@ -4,6 +4,7 @@ package transaction
 //
 // Transactions progress through a state machine:
 // unsigned → signed → sent → done → (optional: notified or canceled)
+// transaction which can't be validated any more is expired (XRP only)
 type TxType string
 
 // Transaction type constants representing the lifecycle states
@@ -25,6 +26,9 @@ const (
 
 	// TxTypeCancel means the transaction was canceled before being sent
 	TxTypeCancel TxType = "canceled"
+
+	// TxTypeExpired means the transaction can't be validated because its LastLedgerSequence has passed
+	TxTypeExpired TxType = "expired"
 )
 
 // String returns the string representation of the transaction type.
@@ -51,6 +55,7 @@ var TxTypeValue = map[TxType]uint8{
 	TxTypeDone:     4,
 	TxTypeNotified: 5,
 	TxTypeCancel:   6,
+	TxTypeExpired:  7,
 }
 
 // ValidateTxType validates that the given string is a valid transaction type.
@@ -64,15 +64,17 @@ func ValidateTransactionType(txType TxType) error {
 // This enforces the transaction state machine:
 // unsigned → signed → sent → done → (optional: notified)
 // Cancellation is only allowed before the transaction is confirmed (done)
+// Expiration is only allowed before the transaction is confirmed (done) as well
 func CanTransitionTo(from, to TxType) bool {
 	// Define valid transitions
 	validTransitions := map[TxType][]TxType{
-		TxTypeUnsigned: {TxTypeSigned, TxTypeCancel},
-		TxTypeSigned:   {TxTypeSent, TxTypeCancel},
-		TxTypeSent:     {TxTypeDone, TxTypeCancel},
+		TxTypeUnsigned: {TxTypeSigned, TxTypeCancel, TxTypeExpired},
+		TxTypeSigned:   {TxTypeSent, TxTypeCancel, TxTypeExpired},
+		TxTypeSent:     {TxTypeDone, TxTypeCancel, TxTypeExpired},
 		TxTypeDone:     {TxTypeNotified},
 		TxTypeNotified: {}, // Terminal state
 		TxTypeCancel:   {}, // Terminal state
+		TxTypeExpired:  {}, // Terminal state
 	}
 
 	allowedTransitions, ok := validTransitions[from]
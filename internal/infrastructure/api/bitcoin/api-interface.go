@@ -1,6 +1,8 @@
 package bitcoin
 
 import (
+	"context"
+
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -17,12 +19,12 @@ import (
 // Bitcoiner Bitcoin/BitcoinCash Interface
 type Bitcoiner interface {
 	// public_account.go -> wrapper of GetAddressInfo to return account
-	GetAccount(addr string) (string, error)
+	GetAccount(ctx context.Context, addr string) (string, error)
 
 	// address.go
-	GetAddressInfo(addr string) (*btc.GetAddressInfoResult, error)
-	GetAddressesByLabel(labelName string) ([]btcutil.Address, error)
-	ValidateAddress(addr string) (*btc.ValidateAddressResult, error)
+	GetAddressInfo(ctx context.Context, addr string) (*btc.GetAddressInfoResult, error)
+	GetAddressesByLabel(ctx context.Context, labelName string) ([]btcutil.Address, error)
+	ValidateAddress(ctx context.Context, addr string) (*btc.ValidateAddressResult, error)
 	DecodeAddress(addr string) (btcutil.Address, error)
 
 	// amount.go
@@ -34,12 +36,17 @@ type Bitcoiner interface {
 	StrSatoshiToAmount(s string) (btcutil.Amount, error)
 
 	// balance.go
-	GetBalance() (btcutil.Amount, error)
-	GetBalanceByListUnspent(confirmationNum uint64) (btcutil.Amount, error)
-	GetBalanceByAccount(accountType domainAccount.AccountType, confirmationNum uint64) (btcutil.Amount, error)
+	GetBalance(ctx context.Context) (btcutil.Amount, error)
+	GetBalanceByListUnspent(ctx context.Context, confirmationNum uint64) (btcutil.Amount, error)
+	GetBalanceByAccount(
+		ctx context.Context, accountType domainAccount.AccountType, confirmationNum uint64,
+	) (btcutil.Amount, error)
 
 	// block.go
-	GetBlockCount() (int64, error)
+	GetBlockCount(ctx context.Context) (int64, error)
+	GetBlockHash(ctx context.Context, blockHeight int64) (string, error)
+	IsBlockInBestChain(ctx context.Context, blockHash string, blockHeight int64) (bool, error)
+	GetBlock(ctx context.Context, blockHash string) (*wire.MsgBlock, error)
 
 	// bitcoin.go
 	Close()
@@ -47,55 +54,58 @@ type Bitcoiner interface {
 	SetChainConf(conf *chaincfg.Params)
 	SetChainConfNet(btcNet wire.BitcoinNet)
 	ConfirmationBlock() uint64
+	ReorgDepth() uint64
 	FeeRangeMax() float64
 	FeeRangeMin() float64
 	Version() btc.BTCVersion
 	CoinTypeCode() domainCoin.CoinTypeCode
 
 	// fee.go
-	EstimateSmartFee() (float64, error)
-	GetTransactionFee(tx *wire.MsgTx) (btcutil.Amount, error)
-	GetFee(tx *wire.MsgTx, adjustmentFee float64) (btcutil.Amount, error)
+	EstimateSmartFee(ctx context.Context) (float64, error)
+	GetTransactionFee(ctx context.Context, tx *wire.MsgTx) (btcutil.Amount, error)
+	GetFee(ctx context.Context, tx *wire.MsgTx, adjustmentFee float64) (btcutil.Amount, error)
 
 	// import.go
-	ImportPrivKey(privKeyWIF *btcutil.WIF) error
-	ImportPrivKeyLabel(privKeyWIF *btcutil.WIF, label string) error
-	ImportPrivKeyWithoutReScan(privKeyWIF *btcutil.WIF, label string) error
-	ImportAddress(pubkey string) error
-	ImportAddressWithoutReScan(pubkey string) error
-	ImportAddressWithLabel(addr, label string, rescan bool) error
+	ImportPrivKey(ctx context.Context, privKeyWIF *btcutil.WIF) error
+	ImportPrivKeyLabel(ctx context.Context, privKeyWIF *btcutil.WIF, label string) error
+	ImportPrivKeyWithoutReScan(ctx context.Context, privKeyWIF *btcutil.WIF, label string) error
+	ImportAddress(ctx context.Context, pubkey string) error
+	ImportAddressWithoutReScan(ctx context.Context, pubkey string) error
+	ImportAddressWithLabel(ctx context.Context, addr, label string, rescan bool) error
 
 	// label.go
-	SetLabel(addr, label string) error
+	SetLabel(ctx context.Context, addr, label string) error
 	// GetReceivedByLabelAndMinConf(accountName string, minConf int) (btcutil.Amount, error)
 
 	// logging.go
-	Logging() (*btc.LoggingResult, error)
+	Logging(ctx context.Context) (*btc.LoggingResult, error)
 
 	// multisig.go
 	AddMultisigAddress(
-		requiredSigs int, addresses []string, accountName string, addressType address.AddrType,
+		ctx context.Context, requiredSigs int, addresses []string, accountName string, addressType address.AddrType,
 	) (*btc.AddMultisigAddressResult, error)
 
 	// network.go
-	GetNetworkInfo() (*btc.GetNetworkInfoResult, error)
-	GetBlockchainInfo() (*btc.GetBlockchainInfoResult, error)
+	GetNetworkInfo(ctx context.Context) (*btc.GetNetworkInfoResult, error)
+	GetBlockchainInfo(ctx context.Context) (*btc.GetBlockchainInfoResult, error)
 
 	// transaction.go
 	ToHex(tx *wire.MsgTx) (string, error)
 	ToMsgTx(txHex string) (*wire.MsgTx, error)
-	GetTransactionByTxID(txID string) (*btc.GetTransactionResult, error)
-	GetTxOutByTxID(txID string, index uint32) (*btcjson.GetTxOutResult, error)
-	DecodeRawTransaction(hexTx string) (*btc.TxRawResult, error)
-	GetRawTransactionByHex(strHashTx string) (*btcutil.Tx, error)
+	GetTransactionByTxID(ctx context.Context, txID string) (*btc.GetTransactionResult, error)
+	GetTxOutByTxID(ctx context.Context, txID string, index uint32) (*btcjson.GetTxOutResult, error)
+	DecodeRawTransaction(ctx context.Context, hexTx string) (*btc.TxRawResult, error)
+	GetRawTransactionByHex(ctx context.Context, strHashTx string) (*btcutil.Tx, error)
 	CreateRawTransaction(
-		inputs []btcjson.TransactionInput, outputs map[btcutil.Address]btcutil.Amount,
+		ctx context.Context, inputs []btcjson.TransactionInput, outputs map[btcutil.Address]btcutil.Amount,
 	) (*wire.MsgTx, error)
-	FundRawTransaction(hex string) (*btc.FundRawTransactionResult, error)
-	SignRawTransaction(tx *wire.MsgTx, prevtxs []btc.PrevTx) (*wire.MsgTx, bool, error)
-	SignRawTransactionWithKey(tx *wire.MsgTx, privKeysWIF []string, prevtxs []btc.PrevTx) (*wire.MsgTx, bool, error)
-	SendTransactionByHex(hex string) (*chainhash.Hash, error)
-	SendTransactionByByte(rawTx []byte) (*chainhash.Hash, error)
+	FundRawTransaction(ctx context.Context, hex string) (*btc.FundRawTransactionResult, error)
+	SignRawTransaction(ctx context.Context, tx *wire.MsgTx, prevtxs []btc.PrevTx) (*wire.MsgTx, bool, error)
+	SignRawTransactionWithKey(
+		ctx context.Context, tx *wire.MsgTx, privKeysWIF []string, prevtxs []btc.PrevTx,
+	) (*wire.MsgTx, bool, error)
+	SendTransactionByHex(ctx context.Context, hex string) (*chainhash.Hash, error)
+	SendTransactionByByte(ctx context.Context, rawTx []byte) (*chainhash.Hash, error)
 	Sign(tx *wire.MsgTx, strPrivateKey string) (string, error)
 
 	// psbt.go (BIP174 Partially Signed Bitcoin Transaction support)
@@ -109,21 +119,31 @@ type Bitcoiner interface {
 	GetPSBTFee(psbtBase64 string) (int64, error)
 
 	// unspent.go
-	ListUnspent(confirmationNum uint64) ([]btc.ListUnspentResult, error)
-	ListUnspentByAccount(accountType domainAccount.AccountType, confirmationNum uint64) ([]btc.ListUnspentResult, error)
+	ListUnspent(ctx context.Context, confirmationNum uint64) ([]btc.ListUnspentResult, error)
+	ListUnspentByAccount(
+		ctx context.Context, accountType domainAccount.AccountType, confirmationNum uint64,
+	) ([]btc.ListUnspentResult, error)
 	GetUnspentListAddrs(unspentList []btc.ListUnspentResult, accountType domainAccount.AccountType) []string
-	LockUnspent(tx *btc.ListUnspentResult) error
-	UnlockUnspent() error
+	LockUnspent(ctx context.Context, tx *btc.ListUnspentResult) error
+	UnlockUnspent(ctx context.Context) error
 
 	// wallet.go
-	BackupWallet(fileName string) error
-	DumpWallet(fileName string) error
-	ImportWallet(fileName string) error
-	EncryptWallet(passphrase string) error
-	WalletLock() error
-	WalletPassphrase(passphrase string, timeoutSecs int64) error
-	WalletPassphraseChange(old, newPass string) error
-	LoadWallet(fileName string) error
-	UnLoadWallet(fileName string) error
-	CreateWallet(fileName string, disablePrivKey bool) error
+	BackupWallet(ctx context.Context, fileName string) error
+	DumpWallet(ctx context.Context, fileName string) error
+	ImportWallet(ctx context.Context, fileName string) error
+	EncryptWallet(ctx context.Context, passphrase string) error
+	WalletLock(ctx context.Context) error
+	WalletPassphrase(ctx context.Context, passphrase string, timeoutSecs int64) error
+	WalletPassphraseChange(ctx context.Context, old, newPass string) error
+	LoadWallet(ctx context.Context, fileName string) error
+	UnLoadWallet(ctx context.Context, fileName string) error
+	CreateWallet(ctx context.Context, fileName string, disablePrivKey bool) error
+}
+
+// BlockNotifier pushes block and transaction notifications from node
+type BlockNotifier interface {
+	// zmq.go
+	Start(ctx context.Context) error
+	Events() <-chan btc.ZMQEvent
+	Topics() []string
 }
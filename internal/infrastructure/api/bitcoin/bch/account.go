@@ -1,14 +1,15 @@
 package bch
 
 import (
+	"context"
 	"fmt"
 )
 
 // GetAccount returns account name of address
 // `getaccount` should be called because getaccount RPC is gone from version 0.18
-func (b *BitcoinCash) GetAccount(addr string) (string, error) {
+func (b *BitcoinCash) GetAccount(ctx context.Context, addr string) (string, error) {
 	// actually `getaddressinfo` is called
-	res, err := b.GetAddressInfo(addr)
+	res, err := b.GetAddressInfo(ctx, addr)
 	if err != nil {
 		return "", fmt.Errorf("fail to call btc.GetAddressInfo()in bch: %w", err)
 	}
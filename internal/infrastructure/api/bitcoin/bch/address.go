@@ -1,6 +1,7 @@
 package bch
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -26,7 +27,7 @@ type GetAddressInfoResult struct {
 }
 
 // GetAddressInfo can be used as an alternative to `getaccount`, `validateaddress`
-func (b *BitcoinCash) GetAddressInfo(addr string) (*btc.GetAddressInfoResult, error) {
+func (b *BitcoinCash) GetAddressInfo(_ context.Context, addr string) (*btc.GetAddressInfoResult, error) {
 	input, err := json.Marshal(addr)
 	if err != nil {
 		return nil, fmt.Errorf("fail to call json.Marchal() in bch: %w", err)
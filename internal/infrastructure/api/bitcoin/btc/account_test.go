@@ -4,6 +4,7 @@
 package btc_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -42,7 +43,7 @@ func (at *accountTest) TestGetAccount() {
 
 	for _, tt := range tests {
 		at.T().Run(tt.name, func(t *testing.T) {
-			res, err := at.BTC.GetAccount(tt.args.addr)
+			res, err := at.BTC.GetAccount(context.Background(), tt.args.addr)
 			at.NoError(err)
 			at.Equal(tt.want.account, res)
 			if err == nil {
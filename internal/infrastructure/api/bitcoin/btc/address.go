@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -51,7 +52,7 @@ type Purpose struct {
 }
 
 // GetAddressInfo can be used as an alternative to `getaccount`, `validateaddress`
-func (b *Bitcoin) GetAddressInfo(addr string) (*GetAddressInfoResult, error) {
+func (b *Bitcoin) GetAddressInfo(_ context.Context, addr string) (*GetAddressInfoResult, error) {
 	input, err := json.Marshal(addr)
 	if err != nil {
 		return nil, fmt.Errorf("fail to call json.Marchal(): %w", err)
@@ -76,7 +77,7 @@ func (b *Bitcoin) GetAddressInfo(addr string) (*GetAddressInfoResult, error) {
 //	it seems 3 different address types are returned respectively
 //
 // For now, it would be better to stop using it
-func (b *Bitcoin) GetAddressesByLabel(labelName string) ([]btcutil.Address, error) {
+func (b *Bitcoin) GetAddressesByLabel(_ context.Context, labelName string) ([]btcutil.Address, error) {
 	// input for rpc api
 	input, err := json.Marshal(labelName)
 	if err != nil {
@@ -118,7 +119,7 @@ func (b *Bitcoin) GetAddressesByLabel(labelName string) ([]btcutil.Address, erro
 }
 
 // ValidateAddress validate address
-func (b *Bitcoin) ValidateAddress(addr string) (*ValidateAddressResult, error) {
+func (b *Bitcoin) ValidateAddress(_ context.Context, addr string) (*ValidateAddressResult, error) {
 	input, err := json.Marshal(addr)
 	if err != nil {
 		return nil, fmt.Errorf("json.Marchal(): error: %s", err)
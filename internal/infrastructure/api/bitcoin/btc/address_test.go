@@ -4,6 +4,7 @@
 package btc_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -47,7 +48,7 @@ func (at *addressTest) TestGetAddressInfo() {
 
 	for _, tt := range tests {
 		at.T().Run(tt.name, func(t *testing.T) {
-			res, err := at.BTC.GetAddressInfo(tt.args.addr)
+			res, err := at.BTC.GetAddressInfo(context.Background(), tt.args.addr)
 			at.Equal(tt.want.err, err)
 			if err == nil {
 				t.Log(res)
@@ -75,7 +76,7 @@ func (at *addressTest) TestGetAddressesByLabel() {
 	}
 	for _, tt := range tests {
 		at.T().Run(tt.name, func(t *testing.T) {
-			got, err := at.BTC.GetAddressesByLabel(tt.args.labelName)
+			got, err := at.BTC.GetAddressesByLabel(context.Background(), tt.args.labelName)
 			at.NoError(err)
 			if err == nil {
 				t.Log(got)
@@ -116,7 +117,7 @@ func (at *addressTest) TestValidateAddress() {
 
 	for _, tt := range tests {
 		at.T().Run(tt.name, func(t *testing.T) {
-			got, err := at.BTC.ValidateAddress(tt.args.addr)
+			got, err := at.BTC.ValidateAddress(context.Background(), tt.args.addr)
 			at.Equal(tt.want.isErr, err != nil)
 			if err == nil {
 				t.Log(got)
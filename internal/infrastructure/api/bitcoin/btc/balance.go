@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -13,7 +14,7 @@ import (
 // - It would include dirty outputs already spent tx, so it maybe useless
 //   - wallet does not have the "avoid reuse" feature enabled
 //   - `bitcoin-cli getbalance "*" 6 true true`
-func (b *Bitcoin) GetBalance() (btcutil.Amount, error) {
+func (b *Bitcoin) GetBalance(_ context.Context) (btcutil.Amount, error) {
 	input1, err := json.Marshal("*")
 	if err != nil {
 		return 0, fmt.Errorf("fail to call json.Marchal(dummy): %w", err)
@@ -38,8 +39,8 @@ func (b *Bitcoin) GetBalance() (btcutil.Amount, error) {
 }
 
 // GetBalanceByListUnspent gets balance by rpc `listunspent`
-func (b *Bitcoin) GetBalanceByListUnspent(confirmationNum uint64) (btcutil.Amount, error) {
-	listunspentResult, err := b.ListUnspent(confirmationNum)
+func (b *Bitcoin) GetBalanceByListUnspent(ctx context.Context, confirmationNum uint64) (btcutil.Amount, error) {
+	listunspentResult, err := b.ListUnspent(ctx, confirmationNum)
 	if err != nil {
 		return 0, err
 	}
@@ -49,9 +50,9 @@ func (b *Bitcoin) GetBalanceByListUnspent(confirmationNum uint64) (btcutil.Amoun
 
 // GetBalanceByAccount gets balance by account
 func (b *Bitcoin) GetBalanceByAccount(
-	accountType domainAccount.AccountType, confirmationNum uint64,
+	ctx context.Context, accountType domainAccount.AccountType, confirmationNum uint64,
 ) (btcutil.Amount, error) {
-	unspentList, err := b.ListUnspentByAccount(accountType, confirmationNum)
+	unspentList, err := b.ListUnspentByAccount(ctx, accountType, confirmationNum)
 	if err != nil {
 		return 0, fmt.Errorf("fail to call btc.ListUnspentByAccount(%s): %w", accountType.String(), err)
 	}
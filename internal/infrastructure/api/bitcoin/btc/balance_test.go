@@ -4,6 +4,7 @@
 package btc_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -19,7 +20,7 @@ type balanceTest struct {
 // TestListAccounts is test for ListAccounts
 func (bt *balanceTest) TestGetBalance() {
 	// GetBalance
-	res, err := bt.BTC.GetBalance()
+	res, err := bt.BTC.GetBalance(context.Background())
 	bt.NoError(err)
 	if err == nil {
 		bt.T().Log(res)
@@ -56,7 +57,7 @@ func (bt *balanceTest) TestGetBalanceByAccount() {
 
 	for _, tt := range tests {
 		bt.T().Run(tt.name, func(t *testing.T) {
-			got, err := bt.BTC.GetBalanceByAccount(tt.args.account, bt.BTC.ConfirmationBlock())
+			got, err := bt.BTC.GetBalanceByAccount(context.Background(), tt.args.account, bt.BTC.ConfirmationBlock())
 			bt.NoError(err)
 			if err == nil {
 				t.Log(got)
@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -20,9 +21,14 @@ type Bitcoin struct {
 	coinTypeCode      domainCoin.CoinTypeCode // btc
 	version           BTCVersion              // 179900
 	confirmationBlock uint64
+	reorgDepth        uint64
 	feeRange          FeeAdjustmentRate
 }
 
+// DefaultReorgDepth is the number of recent blocks in which confirmed transactions
+// are checked against chain reorganization when `reorg_depth` is not configured
+const DefaultReorgDepth uint64 = 100
+
 // FeeAdjustmentRate range of fee adjustment rate
 type FeeAdjustmentRate struct {
 	min float64
@@ -41,8 +47,10 @@ func NewBitcoin(
 
 	bit.coinTypeCode = coinTypeCode
 
+	ctx := context.Background()
+
 	// check network consistency between config and bitcoind
-	blockInfo, err := bit.GetBlockchainInfo()
+	blockInfo, err := bit.GetBlockchainInfo(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("fail to call bit.GetBlockchainInfo(): %w", err)
 	}
@@ -81,7 +89,7 @@ func NewBitcoin(
 	}
 
 	// set bitcoin version
-	netInfo, err := bit.GetNetworkInfo()
+	netInfo, err := bit.GetNetworkInfo(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("fail to call bit.GetNetworkInfo(): %w", err)
 	}
@@ -95,6 +103,10 @@ func NewBitcoin(
 
 	// set other information from config
 	bit.confirmationBlock = conf.Block.ConfirmationNum
+	bit.reorgDepth = conf.Block.ReorgDepth
+	if bit.reorgDepth == 0 {
+		bit.reorgDepth = DefaultReorgDepth
+	}
 	bit.feeRange.max = conf.Fee.AdjustmentMax
 	bit.feeRange.min = conf.Fee.AdjustmentMin
 
@@ -128,6 +140,11 @@ func (b *Bitcoin) ConfirmationBlock() uint64 {
 	return b.confirmationBlock
 }
 
+// ReorgDepth returns block depth to watch confirmed transactions for chain reorganization
+func (b *Bitcoin) ReorgDepth() uint64 {
+	return b.reorgDepth
+}
+
 // FeeRangeMax return maximum fee rate for adjustment
 func (b *Bitcoin) FeeRangeMax() float64 {
 	return b.feeRange.max
@@ -1,13 +1,17 @@
 package btc
 
 import (
+	"context"
 	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 )
 
 // GetBlockCount gets block count
 //
 //	e.g. 1383526
-func (b *Bitcoin) GetBlockCount() (int64, error) {
+func (b *Bitcoin) GetBlockCount(_ context.Context) (int64, error) {
 	blockCnt, err := b.Client.GetBlockCount()
 	if err != nil {
 		return 0, fmt.Errorf("fail to call client.GetBlockCount(): %w", err)
@@ -15,3 +19,37 @@ func (b *Bitcoin) GetBlockCount() (int64, error) {
 
 	return blockCnt, nil
 }
+
+// GetBlockHash gets hash of block at given height on the best chain
+func (b *Bitcoin) GetBlockHash(_ context.Context, blockHeight int64) (string, error) {
+	hash, err := b.Client.GetBlockHash(blockHeight)
+	if err != nil {
+		return "", fmt.Errorf("fail to call client.GetBlockHash(%d): %w", blockHeight, err)
+	}
+
+	return hash.String(), nil
+}
+
+// IsBlockInBestChain returns true if block hash is still on the best chain at given height
+func (b *Bitcoin) IsBlockInBestChain(ctx context.Context, blockHash string, blockHeight int64) (bool, error) {
+	bestHash, err := b.GetBlockHash(ctx, blockHeight)
+	if err != nil {
+		return false, err
+	}
+
+	return bestHash == blockHash, nil
+}
+
+// GetBlock gets block by block hash
+func (b *Bitcoin) GetBlock(_ context.Context, blockHash string) (*wire.MsgBlock, error) {
+	hash, err := chainhash.NewHashFromStr(blockHash)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call chainhash.NewHashFromStr(%s): %w", blockHash, err)
+	}
+	block, err := b.Client.GetBlock(hash)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call client.GetBlock(%s): %w", blockHash, err)
+	}
+
+	return block, nil
+}
@@ -4,6 +4,7 @@
 package btc_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -18,7 +19,7 @@ type blockTest struct {
 // TestGetBlockCount is test for GetBlockCount
 func (bt *blockTest) TestGetBlockCount(t *testing.T) {
 	// GetBalance
-	res, err := bt.BTC.GetBlockCount()
+	res, err := bt.BTC.GetBlockCount(context.Background())
 	bt.NoError(err)
 	if err == nil {
 		t.Log(res)
@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -22,7 +23,7 @@ type EstimateSmartFeeResult struct {
 // https://bitzuma.com/posts/making-sense-of-bitcoin-transaction-fees/
 
 // EstimateSmartFee calls RPC `estimatesmartfee` and returns BTC/kB(float64)
-func (b *Bitcoin) EstimateSmartFee() (float64, error) {
+func (b *Bitcoin) EstimateSmartFee(_ context.Context) (float64, error) {
 	input, err := json.Marshal(b.confirmationBlock)
 	if err != nil {
 		return 0, fmt.Errorf("fail to call json.Marchal(confirmationBlock): %w", err)
@@ -45,8 +46,8 @@ func (b *Bitcoin) EstimateSmartFee() (float64, error) {
 }
 
 // GetTransactionFee calculate fee from transaction size
-func (b *Bitcoin) GetTransactionFee(tx *wire.MsgTx) (btcutil.Amount, error) {
-	feePerKB, err := b.EstimateSmartFee()
+func (b *Bitcoin) GetTransactionFee(ctx context.Context, tx *wire.MsgTx) (btcutil.Amount, error) {
+	feePerKB, err := b.EstimateSmartFee(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("fail to call btc.EstimateSmartFee(): %w", err)
 	}
@@ -62,16 +63,16 @@ func (b *Bitcoin) GetTransactionFee(tx *wire.MsgTx) (btcutil.Amount, error) {
 }
 
 // GetFee get more preferable fee
-func (b *Bitcoin) GetFee(tx *wire.MsgTx, adjustmentFee float64) (btcutil.Amount, error) {
+func (b *Bitcoin) GetFee(ctx context.Context, tx *wire.MsgTx, adjustmentFee float64) (btcutil.Amount, error) {
 	// get tx fee
-	fee, err := b.GetTransactionFee(tx)
+	fee, err := b.GetTransactionFee(ctx, tx)
 	if err != nil {
 		return 0, err
 	}
 	// logger.Debug("called GetTransactionFee()", "fee", fee) //0.000208 BTC
 
 	// if response doesn't meet minimum fee, it should be overridden
-	relayFee, err := b.getMinRelayFee()
+	relayFee, err := b.getMinRelayFee(ctx)
 	if err != nil {
 		logger.Warn("fail to call btc.getMinRelayFee() but continue", "error", err)
 	} else if fee < relayFee {
@@ -109,8 +110,8 @@ func (b *Bitcoin) calculateNewFee(fee btcutil.Amount, adjustmentFee float64) (bt
 	return newFee, nil
 }
 
-func (b *Bitcoin) getMinRelayFee() (btcutil.Amount, error) {
-	res, err := b.GetNetworkInfo()
+func (b *Bitcoin) getMinRelayFee(ctx context.Context) (btcutil.Amount, error) {
+	res, err := b.GetNetworkInfo(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("fail to call btc.GetNetworkInfo(): %w", err)
 	}
@@ -4,6 +4,7 @@
 package btc_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -18,7 +19,7 @@ type feeTest struct {
 // TestEstimateSmartFee is test for EstimateSmartFee
 func (ft *feeTest) TestEstimateSmartFee() {
 	// EstimateSmartFee
-	res, err := ft.BTC.EstimateSmartFee()
+	res, err := ft.BTC.EstimateSmartFee(context.Background())
 	ft.NoError(err)
 	if err == nil {
 		ft.T().Logf("%f", res)
@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -9,7 +10,7 @@ import (
 
 // ImportPrivKey import privKey to wallet
 // - Rescan  *bool `jsonrpcdefault:"true"`
-func (b *Bitcoin) ImportPrivKey(privKeyWIF *btcutil.WIF) error {
+func (b *Bitcoin) ImportPrivKey(_ context.Context, privKeyWIF *btcutil.WIF) error {
 	err := b.Client.ImportPrivKey(privKeyWIF)
 	if err != nil {
 		return fmt.Errorf("fail to call client.ImportPrivKey(): %w", err)
@@ -20,7 +21,7 @@ func (b *Bitcoin) ImportPrivKey(privKeyWIF *btcutil.WIF) error {
 
 // ImportPrivKeyLabel import privKey with label to wallet
 // - Rescan  *bool `jsonrpcdefault:"true"`
-func (b *Bitcoin) ImportPrivKeyLabel(privKeyWIF *btcutil.WIF, label string) error {
+func (b *Bitcoin) ImportPrivKeyLabel(_ context.Context, privKeyWIF *btcutil.WIF, label string) error {
 	err := b.Client.ImportPrivKeyLabel(privKeyWIF, label)
 	if err != nil {
 		return fmt.Errorf("fail to call client.ImportPrivKeyLabel(): %w", err)
@@ -30,7 +31,7 @@ func (b *Bitcoin) ImportPrivKeyLabel(privKeyWIF *btcutil.WIF, label string) erro
 }
 
 // ImportPrivKeyWithoutReScan import privKey without rescan to wallet
-func (b *Bitcoin) ImportPrivKeyWithoutReScan(privKeyWIF *btcutil.WIF, label string) error {
+func (b *Bitcoin) ImportPrivKeyWithoutReScan(_ context.Context, privKeyWIF *btcutil.WIF, label string) error {
 	err := b.Client.ImportPrivKeyRescan(privKeyWIF, label, false)
 	if err != nil {
 		return fmt.Errorf("fail to call ImportPrivKeyRescan(): %w", err)
@@ -40,7 +41,7 @@ func (b *Bitcoin) ImportPrivKeyWithoutReScan(privKeyWIF *btcutil.WIF, label stri
 }
 
 // ImportAddress import pubkey to wallet
-func (b *Bitcoin) ImportAddress(pubkey string) error {
+func (b *Bitcoin) ImportAddress(_ context.Context, pubkey string) error {
 	err := b.Client.ImportAddress(pubkey)
 	if err != nil {
 		return fmt.Errorf("fail to call ImportAddress(): %w", err)
@@ -50,8 +51,8 @@ func (b *Bitcoin) ImportAddress(pubkey string) error {
 }
 
 // ImportAddressWithoutReScan import pubkey without rescan
-func (b *Bitcoin) ImportAddressWithoutReScan(pubkey string) error {
-	err := b.ImportAddressWithLabel(pubkey, "", false)
+func (b *Bitcoin) ImportAddressWithoutReScan(ctx context.Context, pubkey string) error {
+	err := b.ImportAddressWithLabel(ctx, pubkey, "", false)
 	if err != nil {
 		return fmt.Errorf("fail to call ImportAddressWithoutReScan(): %w", err)
 	}
@@ -61,7 +62,7 @@ func (b *Bitcoin) ImportAddressWithoutReScan(pubkey string) error {
 
 // ImportAddressWithLabel import geven address with label to wallet
 // - rescan is adjustable
-func (b *Bitcoin) ImportAddressWithLabel(address, label string, rescan bool) error {
+func (b *Bitcoin) ImportAddressWithLabel(_ context.Context, address, label string, rescan bool) error {
 	bAddress, err := json.Marshal(address)
 	if err != nil {
 		return fmt.Errorf("fail to call json.Marchal(address): %w", err)
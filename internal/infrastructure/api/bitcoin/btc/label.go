@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -8,7 +9,7 @@ import (
 // listlabels
 
 // SetLabel sets account to existing imported address
-func (b *Bitcoin) SetLabel(addr, label string) error {
+func (b *Bitcoin) SetLabel(_ context.Context, addr, label string) error {
 	_, err := b.DecodeAddress(addr)
 	if err != nil {
 		return fmt.Errorf("fail to call btc.DecodeAddress(%s): %w", addr, err)
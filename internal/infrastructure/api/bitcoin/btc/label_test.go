@@ -4,6 +4,7 @@
 package btc_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -25,15 +26,15 @@ func (lt *labelTest) TestSetLabel() {
 	lt.NoError(err)
 
 	// import address
-	err = lt.BTC.ImportAddressWithoutReScan(pubKey)
+	err = lt.BTC.ImportAddressWithoutReScan(context.Background(), pubKey)
 	lt.NoError(err)
 
 	// set label
-	err = lt.BTC.SetLabel(pubKey, account.AccountTypeTest.String())
+	err = lt.BTC.SetLabel(context.Background(), pubKey, account.AccountTypeTest.String())
 	lt.NoError(err)
 
 	// check addr
-	res, err := lt.BTC.GetAddressInfo(pubKey)
+	res, err := lt.BTC.GetAddressInfo(context.Background(), pubKey)
 	lt.NoError(err)
 	if err == nil {
 		lt.T().Log(res.Labels)
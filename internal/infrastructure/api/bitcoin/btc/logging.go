@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -32,7 +33,7 @@ type LoggingResult struct {
 }
 
 // Logging calls RPC `logging`
-func (b *Bitcoin) Logging() (*LoggingResult, error) {
+func (b *Bitcoin) Logging(_ context.Context) (*LoggingResult, error) {
 	rawResult, err := b.Client.RawRequest("logging", []json.RawMessage{})
 	if err != nil {
 		return nil, fmt.Errorf("fail to call json.RawRequest(logging): %w", err)
@@ -4,6 +4,7 @@
 package btc_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -18,7 +19,7 @@ type loggingTest struct {
 // TestLogging is test for GetLogging
 func (lt *loggingTest) TestLogging() {
 	// Logging
-	res, err := lt.BTC.Logging()
+	res, err := lt.BTC.Logging(context.Background())
 	lt.NoError(err)
 	if err == nil {
 		lt.T().Log(res)
@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -20,7 +21,7 @@ type AddMultisigAddressResult struct {
 //   - addresses:    list of addresses(e.g. client, auth1, auth2, auth3
 //   - [N:M] e.g. 2:5 => requiredSigs=2, addresses[addr1, addr2, addr3, addr4, addr5]
 func (b *Bitcoin) AddMultisigAddress(
-	requiredSigs int,
+	_ context.Context, requiredSigs int,
 	addresses []string,
 	accountName string,
 	addressType address.AddrType,
@@ -56,7 +57,7 @@ func (b *Bitcoin) AddMultisigAddress(
 	// addressType for only BTC
 	var jsonRawMsg []json.RawMessage
 	switch b.coinTypeCode {
-	case domainCoin.BTC:
+	case domainCoin.BTC, domainCoin.LTC:
 		var bAddrType []byte
 		bAddrType, err = json.Marshal(addressType.String())
 		if err != nil {
@@ -70,7 +71,8 @@ func (b *Bitcoin) AddMultisigAddress(
 		jsonRawMsg = []json.RawMessage{bRequiredSigs, bAddresses, bAccount, bAddrType}
 	case domainCoin.BCH:
 		jsonRawMsg = []json.RawMessage{bRequiredSigs, bAddresses, bAccount}
-	case domainCoin.LTC, domainCoin.ETH, domainCoin.XRP, domainCoin.ERC20, domainCoin.HYT:
+	case domainCoin.DOGE, domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE,
+		domainCoin.XRP, domainCoin.SOL, domainCoin.TRX, domainCoin.ERC20, domainCoin.HYT:
 		return nil, fmt.Errorf("not implemented for %s in AddMultisigAddress()", b.coinTypeCode.String())
 	default:
 		return nil, fmt.Errorf("not implemented for %s in AddMultisigAddress()", b.coinTypeCode.String())
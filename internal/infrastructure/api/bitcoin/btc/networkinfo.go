@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -89,7 +90,7 @@ type LocalAddress struct {
 }
 
 // GetNetworkInfo call RPC `getnetworkinfo`
-func (b *Bitcoin) GetNetworkInfo() (*GetNetworkInfoResult, error) {
+func (b *Bitcoin) GetNetworkInfo(_ context.Context) (*GetNetworkInfoResult, error) {
 	rawResult, err := b.Client.RawRequest("getnetworkinfo", []json.RawMessage{})
 	if err != nil {
 		return nil, fmt.Errorf("fail to call json.RawRequest(getnetworkinfo): %w", err)
@@ -105,7 +106,7 @@ func (b *Bitcoin) GetNetworkInfo() (*GetNetworkInfoResult, error) {
 }
 
 // GetBlockchainInfo call RPC `getblockchaininfo`
-func (b *Bitcoin) GetBlockchainInfo() (*GetBlockchainInfoResult, error) {
+func (b *Bitcoin) GetBlockchainInfo(_ context.Context) (*GetBlockchainInfoResult, error) {
 	rawResult, err := b.Client.RawRequest("getblockchaininfo", []json.RawMessage{})
 	if err != nil {
 		return nil, fmt.Errorf("fail to call json.RawRequest(getblockchaininfo): %w", err)
@@ -4,6 +4,7 @@
 package btc_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -18,7 +19,7 @@ type networkTest struct {
 // TestGetNetworkInfo is test for GetNetworkInfo
 func (nt *networkTest) TestGetNetworkInfo() {
 	// GetNetworkInfo
-	res, err := nt.BTC.GetNetworkInfo()
+	res, err := nt.BTC.GetNetworkInfo(context.Background())
 	nt.NoError(err)
 	if err == nil {
 		nt.T().Log(res)
@@ -28,7 +29,7 @@ func (nt *networkTest) TestGetNetworkInfo() {
 // TestGetBlockchainInfo is test for GetBlockchainInfo
 func (nt *networkTest) TestBlockchainInfo() {
 	// GetBlockchainInfo
-	res, err := nt.BTC.GetBlockchainInfo()
+	res, err := nt.BTC.GetBlockchainInfo(context.Background())
 	nt.NoError(err)
 	if err == nil {
 		nt.T().Log(res)
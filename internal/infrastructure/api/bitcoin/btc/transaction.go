@@ -2,6 +2,7 @@ package btc
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -181,7 +182,7 @@ func (b *Bitcoin) GetTransaction(txID string) (*GetTransactionResult, error) {
 }
 
 // GetTransactionByTxID get transaction result by txID
-func (b *Bitcoin) GetTransactionByTxID(txID string) (*GetTransactionResult, error) {
+func (b *Bitcoin) GetTransactionByTxID(_ context.Context, txID string) (*GetTransactionResult, error) {
 	// hashTx, err := chainhash.NewHashFromStr(txID)
 	// if err != nil {
 	//	return nil, errors.Wrapf(err, "fail to call chainhash.NewHashFromStr(%s)", txID)
@@ -197,7 +198,7 @@ func (b *Bitcoin) GetTransactionByTxID(txID string) (*GetTransactionResult, erro
 
 // GetTxOutByTxID get txOut by txID and index
 //   - it's not used anywhere
-func (b *Bitcoin) GetTxOutByTxID(txID string, index uint32) (*btcjson.GetTxOutResult, error) {
+func (b *Bitcoin) GetTxOutByTxID(_ context.Context, txID string, index uint32) (*btcjson.GetTxOutResult, error) {
 	hash, err := chainhash.NewHashFromStr(txID)
 	if err != nil {
 		return nil, fmt.Errorf("fail to call chainhash.NewHashFromStr(%s): %w", txID, err)
@@ -229,7 +230,7 @@ func (b *Bitcoin) GetTxOutByTxID(txID string, index uint32) (*btcjson.GetTxOutRe
 }
 
 // DecodeRawTransaction returns information about a transaction given its serialized byte
-func (b *Bitcoin) DecodeRawTransaction(hexTx string) (*TxRawResult, error) {
+func (b *Bitcoin) DecodeRawTransaction(_ context.Context, hexTx string) (*TxRawResult, error) {
 	// byteHex, err := hex.DecodeString(hexTx)
 	// if err != nil {
 	//	return nil, fmt.Errorf("fail to call hex.DecodeString(): %w", err)
@@ -255,7 +256,7 @@ func (b *Bitcoin) DecodeRawTransaction(hexTx string) (*TxRawResult, error) {
 
 // GetRawTransactionByHex get tx from hex string
 // unused for now
-func (b *Bitcoin) GetRawTransactionByHex(strHashTx string) (*btcutil.Tx, error) {
+func (b *Bitcoin) GetRawTransactionByHex(_ context.Context, strHashTx string) (*btcutil.Tx, error) {
 	hashTx, err := chainhash.NewHashFromStr(strHashTx)
 	if err != nil {
 		return nil, fmt.Errorf("fail to call chainhash.NewHashFromStr(%s): %w", strHashTx, err)
@@ -274,7 +275,7 @@ func (b *Bitcoin) GetRawTransactionByHex(strHashTx string) (*btcutil.Tx, error)
 // CreateRawTransaction create raw transaction
 //   - for payment action
 func (b *Bitcoin) CreateRawTransaction(
-	inputs []btcjson.TransactionInput, outputs map[btcutil.Address]btcutil.Amount,
+	_ context.Context, inputs []btcjson.TransactionInput, outputs map[btcutil.Address]btcutil.Amount,
 ) (*wire.MsgTx, error) {
 	lockTime := int64(0) // TODO:Raw locktime what value is exactly required??
 
@@ -289,7 +290,7 @@ func (b *Bitcoin) CreateRawTransaction(
 
 // FundRawTransaction Add inputs to a transaction until it has enough in value to meet its out value.
 // TODO: unused for now, but it looks useful
-func (b *Bitcoin) FundRawTransaction(hexTx string) (*FundRawTransactionResult, error) {
+func (b *Bitcoin) FundRawTransaction(ctx context.Context, hexTx string) (*FundRawTransactionResult, error) {
 	// fundrawtransaction
 	// https://bitcoincore.org/en/doc/0.19.0/rpc/rawtransactions/fundrawtransaction/
 
@@ -300,7 +301,7 @@ func (b *Bitcoin) FundRawTransaction(hexTx string) (*FundRawTransactionResult, e
 	}
 
 	// fee rate
-	feePerKb, err := b.EstimateSmartFee()
+	feePerKb, err := b.EstimateSmartFee(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("fail to call btc.EstimateSmartFee(): %w", err)
 	}
@@ -343,7 +344,7 @@ func (b *Bitcoin) FundRawTransaction(hexTx string) (*FundRawTransactionResult, e
 // For multisig addresses, refer to SignRawTransactionWithKey().
 //
 // TODO: this code can be shared with SignRawTransactionWithKey() to some extent
-func (b *Bitcoin) SignRawTransaction(tx *wire.MsgTx, prevtxs []PrevTx) (*wire.MsgTx, bool, error) {
+func (b *Bitcoin) SignRawTransaction(_ context.Context, tx *wire.MsgTx, prevtxs []PrevTx) (*wire.MsgTx, bool, error) {
 	// hex tx
 	hexTx, err := b.ToHex(tx)
 	if err != nil {
@@ -407,7 +408,7 @@ func (b *Bitcoin) SignRawTransaction(tx *wire.MsgTx, prevtxs []PrevTx) (*wire.Ms
 //
 // This would be used for payment and transfer actions from multisig accounts.
 func (b *Bitcoin) SignRawTransactionWithKey(
-	tx *wire.MsgTx, privKeysWIF []string, prevtxs []PrevTx,
+	_ context.Context, tx *wire.MsgTx, privKeysWIF []string, prevtxs []PrevTx,
 ) (*wire.MsgTx, bool, error) {
 	// if b.Version() >= ctype.BTCVer17 {
 
@@ -469,7 +470,7 @@ func (b *Bitcoin) SignRawTransactionWithKey(
 }
 
 // SendTransactionByHex send raw transaction by hex string
-func (b *Bitcoin) SendTransactionByHex(hexTx string) (*chainhash.Hash, error) {
+func (b *Bitcoin) SendTransactionByHex(_ context.Context, hexTx string) (*chainhash.Hash, error) {
 	msgTx, err := b.ToMsgTx(hexTx)
 	if err != nil {
 		return nil, err
@@ -487,7 +488,7 @@ func (b *Bitcoin) SendTransactionByHex(hexTx string) (*chainhash.Hash, error) {
 }
 
 // SendTransactionByByte send raw transaction by byte array
-func (b *Bitcoin) SendTransactionByByte(rawTx []byte) (*chainhash.Hash, error) {
+func (b *Bitcoin) SendTransactionByByte(_ context.Context, rawTx []byte) (*chainhash.Hash, error) {
 	// []byte to wireTx
 	wireTx := new(wire.MsgTx)
 	r := bytes.NewBuffer(rawTx)
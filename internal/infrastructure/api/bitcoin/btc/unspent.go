@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -30,7 +31,7 @@ type ListUnspentResult struct {
 }
 
 // ListUnspent call RPC `listunspent`
-func (b *Bitcoin) ListUnspent(confirmationNum uint64) ([]ListUnspentResult, error) {
+func (b *Bitcoin) ListUnspent(_ context.Context, confirmationNum uint64) ([]ListUnspentResult, error) {
 	logger.Debug("call ListUnspent()", "confirmation", b.confirmationBlock)
 
 	input, err := json.Marshal(confirmationNum)
@@ -57,9 +58,9 @@ func (b *Bitcoin) ListUnspent(confirmationNum uint64) ([]ListUnspentResult, erro
 
 // ListUnspentByAccount gets listunspent by account
 func (b *Bitcoin) ListUnspentByAccount(
-	accountType domainAccount.AccountType, confirmationNum uint64,
+	ctx context.Context, accountType domainAccount.AccountType, confirmationNum uint64,
 ) ([]ListUnspentResult, error) {
-	addrs, err := b.GetAddressesByLabel(accountType.String())
+	addrs, err := b.GetAddressesByLabel(ctx, accountType.String())
 	if err != nil {
 		return nil, fmt.Errorf("fail to call btc.GetAddressesByLabel(): %w", err)
 	}
@@ -149,7 +150,7 @@ func (b *Bitcoin) listUnspentByAccount(addrs []btcutil.Address, confirmationNum
 
 // LockUnspent lock given txID
 // 1st param lock (false)
-func (b *Bitcoin) LockUnspent(tx *ListUnspentResult) error {
+func (b *Bitcoin) LockUnspent(_ context.Context, tx *ListUnspentResult) error {
 	txIDHash, err := chainhash.NewHashFromStr(tx.TxID)
 	if err != nil {
 		return fmt.Errorf("fail to call chainhash.NewHashFromStr(%s): %w", tx.TxID, err)
@@ -164,7 +165,7 @@ func (b *Bitcoin) LockUnspent(tx *ListUnspentResult) error {
 
 // UnlockUnspent unlock locked unspent tx
 // 1st param unlock (true)
-func (b *Bitcoin) UnlockUnspent() error {
+func (b *Bitcoin) UnlockUnspent(_ context.Context) error {
 	list, err := b.Client.ListLockUnspent() // []*wire.OutPoint
 	if err != nil {
 		return fmt.Errorf("fail to call client.ListLockUnspent(): %w", err)
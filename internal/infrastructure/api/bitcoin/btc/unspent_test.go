@@ -4,6 +4,7 @@
 package btc_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -29,7 +30,7 @@ func findUnspentListID(unspentList []btc.ListUnspentResult, txid string) bool {
 // TestListUnspent is test for ListUnspent
 func (ut *unspentTest) TestListUnspent() {
 	// ListUnspent
-	res, err := ut.BTC.ListUnspent(6)
+	res, err := ut.BTC.ListUnspent(context.Background(), 6)
 	ut.NoError(err)
 	if err == nil {
 		ut.T().Log(res)
@@ -39,7 +40,7 @@ func (ut *unspentTest) TestListUnspent() {
 // TestListUnspentByAccount is test for ListUnspentByAccount
 func (ut *unspentTest) TestListUnspentByAccount() {
 	// ListUnspentByAccount
-	res, err := ut.BTC.ListUnspentByAccount(account.AccountTypePayment, ut.BTC.ConfirmationBlock())
+	res, err := ut.BTC.ListUnspentByAccount(context.Background(), account.AccountTypePayment, ut.BTC.ConfirmationBlock())
 	ut.NoError(err)
 	if err == nil {
 		ut.T().Log(res)
@@ -49,7 +50,7 @@ func (ut *unspentTest) TestListUnspentByAccount() {
 // TestLockUnspent is test for LockUnspent, UnlockSpent
 func (ut *unspentTest) TestLockUnspent() {
 	// get unspent list
-	listUnspent, err := ut.BTC.ListUnspent(ut.BTC.ConfirmationBlock())
+	listUnspent, err := ut.BTC.ListUnspent(context.Background(), ut.BTC.ConfirmationBlock())
 	ut.NoError(err)
 
 	// use one of ListUnspentResult
@@ -58,23 +59,23 @@ func (ut *unspentTest) TestLockUnspent() {
 		return
 	}
 	// call LockUnspent to lock
-	err = ut.BTC.LockUnspent(&listUnspent[0])
+	err = ut.BTC.LockUnspent(context.Background(), &listUnspent[0])
 	ut.NoError(err)
 	targetTxID := listUnspent[0].TxID
 
 	// get unspent list again
-	listUnspent, err = ut.BTC.ListUnspent(ut.BTC.ConfirmationBlock())
+	listUnspent, err = ut.BTC.ListUnspent(context.Background(), ut.BTC.ConfirmationBlock())
 	ut.NoError(err)
 
 	// check that tx is locked or not
 	ut.False(findUnspentListID(listUnspent, targetTxID), "LockUnspent() failed to lock target unspent")
 
 	// call UnlockUnspent to unlock
-	err = ut.BTC.UnlockUnspent()
+	err = ut.BTC.UnlockUnspent(context.Background())
 	ut.NoError(err)
 
 	// get unspent list again
-	listUnspent, err = ut.BTC.ListUnspent(ut.BTC.ConfirmationBlock())
+	listUnspent, err = ut.BTC.ListUnspent(context.Background(), ut.BTC.ConfirmationBlock())
 	ut.NoError(err)
 
 	// check unlocked or not
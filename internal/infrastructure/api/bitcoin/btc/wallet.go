@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -29,7 +30,7 @@ type LoadWalletResult struct {
 // BackupWallet unload wallet.dat
 //
 //	Safely copies current wallet file to destination, which can be a directory or a path with filename
-func (b *Bitcoin) BackupWallet(fileName string) error {
+func (b *Bitcoin) BackupWallet(_ context.Context, fileName string) error {
 	// backupwallet
 	bFileName, err := json.Marshal(fileName)
 	if err != nil {
@@ -46,12 +47,12 @@ func (b *Bitcoin) BackupWallet(fileName string) error {
 // DumpWallet dump wallet.dat
 //   - fileName: full path
 //   - This does not allow overwriting existing files.
-func (b *Bitcoin) DumpWallet(fileName string) error {
+func (b *Bitcoin) DumpWallet(_ context.Context, fileName string) error {
 	return b.dumpImportWallet(fileName, dumpWallet)
 }
 
 // ImportWallet import wallet.dat
-func (b *Bitcoin) ImportWallet(fileName string) error {
+func (b *Bitcoin) ImportWallet(_ context.Context, fileName string) error {
 	return b.dumpImportWallet(fileName, importWallet)
 }
 
@@ -77,7 +78,7 @@ func (b *Bitcoin) dumpImportWallet(fileName, method string) error {
 
 // EncryptWallet encrypt wallet by pass phrase
 // https://bitcoincore.org/en/doc/0.19.0/rpc/wallet/encryptwallet/
-func (b *Bitcoin) EncryptWallet(passphrase string) error {
+func (b *Bitcoin) EncryptWallet(_ context.Context, passphrase string) error {
 	// backupwallet
 	input1, err := json.Marshal(passphrase)
 	if err != nil {
@@ -92,17 +93,17 @@ func (b *Bitcoin) EncryptWallet(passphrase string) error {
 }
 
 // WalletLock lock wallet
-func (b *Bitcoin) WalletLock() error {
+func (b *Bitcoin) WalletLock(_ context.Context) error {
 	return b.Client.WalletLock()
 }
 
 // WalletPassphrase unlock wallet
-func (b *Bitcoin) WalletPassphrase(passphrase string, timeoutSecs int64) error {
+func (b *Bitcoin) WalletPassphrase(_ context.Context, passphrase string, timeoutSecs int64) error {
 	return b.Client.WalletPassphrase(passphrase, timeoutSecs)
 }
 
 // WalletPassphraseChange change pass phrase
-func (b *Bitcoin) WalletPassphraseChange(old, newPass string) error {
+func (b *Bitcoin) WalletPassphraseChange(_ context.Context, old, newPass string) error {
 	return b.Client.WalletPassphraseChange(old, newPass)
 }
 
@@ -112,7 +113,7 @@ func (b *Bitcoin) WalletPassphraseChange(old, newPass string) error {
 //	Note that all wallet command-line options used when starting bitcoind will be
 //	applied to the new wallet (eg -zapwallettxes, upgradewallet, rescan, etc).
 //	e.g. bitcoin-cli loadwallet "test.dat"
-func (b *Bitcoin) LoadWallet(fileName string) error {
+func (b *Bitcoin) LoadWallet(_ context.Context, fileName string) error {
 	if b.Version() < BTCVer17 {
 		return errors.New("`loadwallet` is available from bitcoin version 0.17")
 	}
@@ -144,7 +145,7 @@ func (b *Bitcoin) LoadWallet(fileName string) error {
 //	Unloads the wallet referenced by the request endpoint otherwise unloads the wallet specified in the argument.
 //	Specifying the wallet name on a wallet endpoint is invalid.
 //	e.g. bitcoin-cli unloadwallet wallet_name
-func (b *Bitcoin) UnLoadWallet(fileName string) error {
+func (b *Bitcoin) UnLoadWallet(_ context.Context, fileName string) error {
 	if b.Version() < BTCVer17 {
 		return errors.New("`unloadwallet` is available from bitcoin version 0.17")
 	}
@@ -162,7 +163,7 @@ func (b *Bitcoin) UnLoadWallet(fileName string) error {
 }
 
 // CreateWallet Creates and loads a new wallet
-func (b *Bitcoin) CreateWallet(fileName string, disablePrivKey bool) error {
+func (b *Bitcoin) CreateWallet(_ context.Context, fileName string, disablePrivKey bool) error {
 	if b.Version() < BTCVer17 {
 		return errors.New("`createwallet` is available from bitcoin version 0.17")
 	}
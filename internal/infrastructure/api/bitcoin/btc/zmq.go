@@ -0,0 +1,261 @@
+package btc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/go-zeromq/zmq4"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// ZMQ topics published by bitcoind
+//
+//	e.g. bitcoin.conf
+//	zmqpubhashblock=tcp://127.0.0.1:28332
+//	zmqpubrawblock=tcp://127.0.0.1:29000
+//	zmqpubrawtx=tcp://127.0.0.1:2900
+const (
+	ZMQTopicHashBlock = "hashblock"
+	ZMQTopicRawBlock  = "rawblock"
+	ZMQTopicRawTx     = "rawtx"
+)
+
+// ZMQEventType is type of notification
+type ZMQEventType int
+
+// ZMQEventType constants
+const (
+	// ZMQEventBlock is new block connected to the chain (hashblock, rawblock)
+	ZMQEventBlock ZMQEventType = iota + 1
+	// ZMQEventTx is transaction accepted to mempool or connected in block (rawtx)
+	ZMQEventTx
+	// ZMQEventGap means notifications were missed by disconnection or node restart,
+	// so subscriber should catch up by polling
+	ZMQEventGap
+)
+
+// String returns the string representation of the event type.
+func (e ZMQEventType) String() string {
+	switch e {
+	case ZMQEventBlock:
+		return "block"
+	case ZMQEventTx:
+		return "tx"
+	case ZMQEventGap:
+		return "gap"
+	default:
+		return "unknown"
+	}
+}
+
+// ZMQEvent is notification from bitcoind
+type ZMQEvent struct {
+	Type      ZMQEventType
+	Topic     string
+	BlockHash string         // hashblock, rawblock
+	Block     *wire.MsgBlock // rawblock only
+	Tx        *wire.MsgTx    // rawtx only
+}
+
+// ZMQSubscriber subscribes block and transaction notifications from bitcoind
+//
+// each message from bitcoind has monotonic sequence number per topic,
+// gap of sequence number is notified as ZMQEventGap
+// - socket reconnects automatically and missed messages are detected by sequence number
+// - sequence number is reset when bitcoind restarts, it's also notified as gap
+type ZMQSubscriber struct {
+	endpoints map[string]string // topic -> endpoint
+	retry     time.Duration
+	events    chan ZMQEvent
+
+	mu        sync.Mutex
+	sequences map[string]uint32
+}
+
+// DefaultZMQRetry is interval to redial bitcoind
+const DefaultZMQRetry = 5 * time.Second
+
+// NewZMQSubscriber creates ZMQSubscriber, topic whose endpoint is empty is not subscribed
+func NewZMQSubscriber(conf *config.BitcoinZMQ) (*ZMQSubscriber, error) {
+	endpoints := make(map[string]string)
+	if conf.HashBlock != "" {
+		endpoints[ZMQTopicHashBlock] = conf.HashBlock
+	}
+	if conf.RawBlock != "" {
+		endpoints[ZMQTopicRawBlock] = conf.RawBlock
+	}
+	if conf.RawTx != "" {
+		endpoints[ZMQTopicRawTx] = conf.RawTx
+	}
+	if len(endpoints) == 0 {
+		return nil, errors.New("at least one zmq endpoint is required")
+	}
+
+	return &ZMQSubscriber{
+		endpoints: endpoints,
+		retry:     DefaultZMQRetry,
+		events:    make(chan ZMQEvent, 100),
+		sequences: make(map[string]uint32),
+	}, nil
+}
+
+// Events returns channel of notifications, it's closed when Start returns
+func (z *ZMQSubscriber) Events() <-chan ZMQEvent {
+	return z.events
+}
+
+// Topics returns subscribed topics
+func (z *ZMQSubscriber) Topics() []string {
+	topics := make([]string, 0, len(z.endpoints))
+	for topic := range z.endpoints {
+		topics = append(topics, topic)
+	}
+	slices.Sort(topics)
+	return topics
+}
+
+// Start subscribes all topics and blocks until ctx is canceled
+func (z *ZMQSubscriber) Start(ctx context.Context) error {
+	defer close(z.events)
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(z.endpoints))
+	for topic, endpoint := range z.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := z.subscribe(ctx, topic, endpoint); err != nil {
+				errCh <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+
+	if err := <-errCh; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (z *ZMQSubscriber) subscribe(ctx context.Context, topic, endpoint string) error {
+	sub := zmq4.NewSub(ctx,
+		zmq4.WithAutomaticReconnect(true),
+		zmq4.WithDialerRetry(z.retry),
+		zmq4.WithDialerMaxRetries(-1),
+	)
+	defer sub.Close()
+
+	if err := sub.SetOption(zmq4.OptionSubscribe, topic); err != nil {
+		return fmt.Errorf("fail to subscribe %s: %w", topic, err)
+	}
+	if err := sub.Dial(endpoint); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("fail to dial %s: %w", endpoint, err)
+	}
+	logger.Info("zmq subscribed", "topic", topic, "endpoint", endpoint)
+
+	for {
+		msg, err := sub.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			logger.Warn("fail to receive zmq message", "topic", topic, "error", err)
+			// messages missed until socket recovers are detected by sequence number
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(z.retry):
+			}
+			continue
+		}
+
+		event, err := z.parse(msg.Frames)
+		if err != nil {
+			logger.Warn("fail to parse zmq message", "topic", topic, "error", err)
+			continue
+		}
+		if event == nil {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case z.events <- *event:
+		}
+	}
+}
+
+// parse parses message [topic, body, sequence(uint32 little endian)]
+// and returns gap event instead when previous messages were missed
+func (z *ZMQSubscriber) parse(frames [][]byte) (*ZMQEvent, error) {
+	if len(frames) != 3 {
+		return nil, fmt.Errorf("invalid number of frames: %d", len(frames))
+	}
+	topic, body, seqBytes := string(frames[0]), frames[1], frames[2]
+	if len(seqBytes) != 4 {
+		return nil, fmt.Errorf("invalid length of sequence: %d", len(seqBytes))
+	}
+	isGap := z.checkSequence(topic, binary.LittleEndian.Uint32(seqBytes))
+
+	event := &ZMQEvent{Topic: topic}
+	switch topic {
+	case ZMQTopicHashBlock:
+		event.Type = ZMQEventBlock
+		event.BlockHash = hex.EncodeToString(body)
+	case ZMQTopicRawBlock:
+		block := &wire.MsgBlock{}
+		if err := block.Deserialize(bytes.NewReader(body)); err != nil {
+			return nil, fmt.Errorf("fail to deserialize block: %w", err)
+		}
+		event.Type = ZMQEventBlock
+		event.BlockHash = block.BlockHash().String()
+		event.Block = block
+	case ZMQTopicRawTx:
+		tx := &wire.MsgTx{}
+		if err := tx.Deserialize(bytes.NewReader(body)); err != nil {
+			return nil, fmt.Errorf("fail to deserialize transaction: %w", err)
+		}
+		event.Type = ZMQEventTx
+		event.Tx = tx
+	default:
+		return nil, fmt.Errorf("unknown topic: %s", topic)
+	}
+
+	// catching up by polling covers the received message as well
+	if isGap {
+		return &ZMQEvent{Type: ZMQEventGap, Topic: topic}, nil
+	}
+	return event, nil
+}
+
+// checkSequence stores sequence number and returns true if previous messages were missed
+func (z *ZMQSubscriber) checkSequence(topic string, seq uint32) bool {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	last, ok := z.sequences[topic]
+	z.sequences[topic] = seq
+	if !ok {
+		// first message, notifications before subscription are caught up by caller at start
+		return false
+	}
+	if seq == last+1 {
+		return false
+	}
+	logger.Warn("zmq notification gap is detected", "topic", topic, "last", last, "current", seq)
+	return true
+}
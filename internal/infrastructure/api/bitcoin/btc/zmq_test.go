@@ -0,0 +1,124 @@
+package btc_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/go-zeromq/zmq4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// fakePublisher publishes messages in the same format as bitcoind
+type fakePublisher struct {
+	t   *testing.T
+	pub zmq4.Socket
+}
+
+func newFakePublisher(t *testing.T) *fakePublisher {
+	t.Helper()
+	pub := zmq4.NewPub(context.Background())
+	require.NoError(t, pub.Listen("tcp://127.0.0.1:0"))
+	t.Cleanup(func() { _ = pub.Close() })
+	return &fakePublisher{t: t, pub: pub}
+}
+
+func (p *fakePublisher) endpoint() string {
+	return "tcp://" + p.pub.Addr().String()
+}
+
+// waitSubscribed waits until subscriber's topics reach publisher, messages before that are dropped
+func (p *fakePublisher) waitSubscribed(count int) {
+	topicer, ok := p.pub.(interface{ Topics() []string })
+	require.True(p.t, ok)
+	require.Eventually(p.t, func() bool {
+		return len(topicer.Topics()) == count
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (p *fakePublisher) publish(topic string, body []byte, seq uint32) {
+	seqBytes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(seqBytes, seq)
+	require.NoError(p.t, p.pub.SendMulti(zmq4.NewMsgFrom([]byte(topic), body, seqBytes)))
+}
+
+func receive(t *testing.T, events <-chan btc.ZMQEvent) btc.ZMQEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("event is not received")
+		return btc.ZMQEvent{}
+	}
+}
+
+// TestZMQSubscriber tests notifications from fake publisher
+func TestZMQSubscriber(t *testing.T) {
+	// set before goroutines of subscriber use it
+	logger.SetGlobal(logger.NewNoopLogger())
+
+	pub := newFakePublisher(t)
+
+	sub, err := btc.NewZMQSubscriber(&config.BitcoinZMQ{
+		HashBlock: pub.endpoint(),
+		RawTx:     pub.endpoint(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{btc.ZMQTopicHashBlock, btc.ZMQTopicRawTx}, sub.Topics())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- sub.Start(ctx) }()
+	pub.waitSubscribed(2)
+
+	// hashblock is published in the byte order of RPC
+	hash := chainhash.DoubleHashH([]byte("block"))
+	pub.publish(btc.ZMQTopicHashBlock, hash[:], 10)
+	event := receive(t, sub.Events())
+	assert.Equal(t, btc.ZMQEventBlock, event.Type)
+	assert.Equal(t, hex.EncodeToString(hash[:]), event.BlockHash)
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&hash, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	var buf bytes.Buffer
+	require.NoError(t, tx.Serialize(&buf))
+	pub.publish(btc.ZMQTopicRawTx, buf.Bytes(), 0)
+	event = receive(t, sub.Events())
+	assert.Equal(t, btc.ZMQEventTx, event.Type)
+	assert.Equal(t, tx.TxHash(), event.Tx.TxHash())
+
+	// sequence 11 is missed
+	pub.publish(btc.ZMQTopicHashBlock, hash[:], 12)
+	event = receive(t, sub.Events())
+	assert.Equal(t, btc.ZMQEventGap, event.Type)
+	assert.Equal(t, btc.ZMQTopicHashBlock, event.Topic)
+
+	// sequence is reset by restart of bitcoind
+	pub.publish(btc.ZMQTopicRawTx, buf.Bytes(), 0)
+	event = receive(t, sub.Events())
+	assert.Equal(t, btc.ZMQEventGap, event.Type)
+	assert.Equal(t, btc.ZMQTopicRawTx, event.Topic)
+
+	cancel()
+	require.NoError(t, <-done)
+	_, ok := <-sub.Events()
+	assert.False(t, ok, "events should be closed when subscriber stops")
+}
+
+// TestNewZMQSubscriber tests validation of endpoints
+func TestNewZMQSubscriber(t *testing.T) {
+	_, err := btc.NewZMQSubscriber(&config.BitcoinZMQ{})
+	assert.Error(t, err)
+}
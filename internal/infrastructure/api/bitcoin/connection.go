@@ -8,6 +8,8 @@ import (
 	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/bch"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/doge"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/ltc"
 	"github.com/hiromaily/go-crypto-wallet/pkg/config"
 )
 
@@ -31,7 +33,7 @@ func NewRPCClient(conf *config.Bitcoin) (*rpcclient.Client, error) {
 	return client, err
 }
 
-// NewBitcoin creates bitcoin/bitcoin cash instance according to coinType
+// NewBitcoin creates bitcoin/bitcoin cash/litecoin/dogecoin instance according to coinType
 func NewBitcoin(
 	client *rpcclient.Client, conf *config.Bitcoin, coinTypeCode domainCoin.CoinTypeCode,
 ) (Bitcoiner, error) {
@@ -51,7 +53,22 @@ func NewBitcoin(
 		}
 
 		return bitc, err
-	case domainCoin.LTC, domainCoin.ETH, domainCoin.XRP, domainCoin.ERC20, domainCoin.HYT:
+	case domainCoin.LTC:
+		ltcc, err := ltc.NewLitecoin(client, coinTypeCode, conf)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call ltc.NewLitecoin(): %w", err)
+		}
+
+		return ltcc, err
+	case domainCoin.DOGE:
+		dogec, err := doge.NewDogecoin(client, coinTypeCode, conf)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call doge.NewDogecoin(): %w", err)
+		}
+
+		return dogec, err
+	case domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE, domainCoin.XRP,
+		domainCoin.SOL, domainCoin.TRX, domainCoin.ERC20, domainCoin.HYT:
 		return nil, fmt.Errorf("coinType %s is not defined", coinTypeCode.String())
 	default:
 		return nil, fmt.Errorf("coinType %s is not defined", coinTypeCode.String())
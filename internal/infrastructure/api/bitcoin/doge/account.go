@@ -0,0 +1,26 @@
+package doge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// GetAccount returns account name of address by RPC `getaccount`
+func (b *Dogecoin) GetAccount(_ context.Context, addr string) (string, error) {
+	input, err := json.Marshal(addr)
+	if err != nil {
+		return "", fmt.Errorf("fail to call json.Marchal() in doge: %w", err)
+	}
+	rawResult, err := b.Client.RawRequest("getaccount", []json.RawMessage{input})
+	if err != nil {
+		return "", fmt.Errorf("fail to call json.RawRequest(getaccount) %s in doge: %w", addr, err)
+	}
+
+	var account string
+	err = json.Unmarshal(rawResult, &account)
+	if err != nil {
+		return "", fmt.Errorf("fail to call json.Unmarshal(rawResult) in doge: %w", err)
+	}
+	return account, nil
+}
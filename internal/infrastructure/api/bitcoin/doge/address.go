@@ -0,0 +1,92 @@
+package doge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// ValidateAddressResult is response type of RPC `validateaddress` in dogecoind
+type ValidateAddressResult struct {
+	IsValid      bool   `json:"isvalid"`
+	Address      string `json:"address"`
+	ScriptPubKey string `json:"scriptPubKey"`
+	Ismine       bool   `json:"ismine"`
+	Iswatchonly  bool   `json:"iswatchonly"`
+	Isscript     bool   `json:"isscript"`
+	Pubkey       string `json:"pubkey,omitempty"`
+	Iscompressed bool   `json:"iscompressed,omitempty"`
+	Account      string `json:"account"`
+	Timestamp    int64  `json:"timestamp,omitempty"`
+}
+
+// GetAddressInfo calls `validateaddress` as an alternative to `getaddressinfo` which doesn't exist in dogecoind
+func (b *Dogecoin) GetAddressInfo(_ context.Context, addr string) (*btc.GetAddressInfoResult, error) {
+	input, err := json.Marshal(addr)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Marchal() in doge: %w", err)
+	}
+	rawResult, err := b.Client.RawRequest("validateaddress", []json.RawMessage{input})
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.RawRequest(validateaddress) %s in doge: %w", addr, err)
+	}
+
+	infoResult := ValidateAddressResult{}
+	err = json.Unmarshal(rawResult, &infoResult)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Unmarshal(rawResult) in doge: %w", err)
+	}
+	if !infoResult.IsValid {
+		return nil, fmt.Errorf("this address is invalid: %s", addr)
+	}
+
+	// convert doge result to btc
+	return &btc.GetAddressInfoResult{
+		Address:      infoResult.Address,
+		ScriptPubKey: infoResult.ScriptPubKey,
+		Ismine:       infoResult.Ismine,
+		Iswatchonly:  infoResult.Iswatchonly,
+		Isscript:     infoResult.Isscript,
+		Pubkey:       infoResult.Pubkey,
+		Iscompressed: infoResult.Iscompressed,
+		Timestamp:    infoResult.Timestamp,
+		Labels:       []string{infoResult.Account},
+	}, nil
+}
+
+// GetAddressesByLabel returns addresses of account by RPC `getaddressesbyaccount`
+func (b *Dogecoin) GetAddressesByLabel(_ context.Context, labelName string) ([]btcutil.Address, error) {
+	input, err := json.Marshal(labelName)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Marchal() in doge: %w", err)
+	}
+	rawResult, err := b.Client.RawRequest("getaddressesbyaccount", []json.RawMessage{input})
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.RawRequest(getaddressesbyaccount) in doge: %w", err)
+	}
+
+	var strAddrs []string
+	err = json.Unmarshal(rawResult, &strAddrs)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Unmarshal(rawResult) in doge: %w", err)
+	}
+
+	addrs := make([]btcutil.Address, 0, len(strAddrs))
+	for _, strAddr := range strAddrs {
+		addr, err := b.DecodeAddress(strAddr)
+		if err != nil {
+			logger.Error(
+				"fail to call b.DecodeAddress()",
+				"address", strAddr,
+				"error", err)
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
@@ -0,0 +1,45 @@
+package doge
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/rpcclient"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+// Dogecoin embeds Bitcoin
+//
+// dogecoind 1.14 is based on bitcoin core 0.14, so RPC which changed after that is overridden
+//   - `getaccount`, `setaccount`, `getaddressesbyaccount` are used instead of label RPC
+//   - `addmultisigaddress` returns only address
+//   - segwit is not activated, so PSBT is created and signed for legacy(P2PKH, P2SH) inputs
+//
+// Note: version reported by dogecoind (e.g. 1140600) is larger than btc.RequiredVersion
+type Dogecoin struct {
+	btc.Bitcoin
+}
+
+// NewDogecoin dogecoin instance based on Bitcoin
+func NewDogecoin(
+	client *rpcclient.Client,
+	coinTypeCode domainCoin.CoinTypeCode,
+	conf *config.Bitcoin,
+) (*Dogecoin, error) {
+	// bitcoin base, network is validated by `getblockchaininfo` which returns the same chain names
+	bit, err := btc.NewBitcoin(client, conf, coinTypeCode)
+	if err != nil {
+		return nil, fmt.Errorf("btc.NewBitcoin() error: %w", err)
+	}
+
+	chainConf, err := ChainParams(bit.GetChainConf())
+	if err != nil {
+		return nil, err
+	}
+	doge := Dogecoin{Bitcoin: *bit}
+	doge.SetChainConf(chainConf)
+
+	return &doge, nil
+}
@@ -0,0 +1,89 @@
+package doge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// fee policy of dogecoind 1.14.5+
+// https://github.com/dogecoin/dogecoin/blob/master/doc/fee-recommendation.md
+const (
+	// MinFeePerKB is recommended minimum fee rate, 0.01 DOGE/kB
+	MinFeePerKB btcutil.Amount = 1_000_000
+
+	// DustLimit is soft dust limit, 0.01 DOGE
+	//   - output less than this value requires additional fee and is rejected by this wallet
+	DustLimit btcutil.Amount = 1_000_000
+)
+
+// GetTransactionFee calculate fee from transaction size
+//   - fee estimation of dogecoind is unreliable because blocks are rarely full,
+//     so estimated rate is used only when it's higher than MinFeePerKB
+func (b *Dogecoin) GetTransactionFee(ctx context.Context, tx *wire.MsgTx) (btcutil.Amount, error) {
+	feePerKB := MinFeePerKB
+	estimated, err := b.EstimateSmartFee(ctx)
+	if err != nil {
+		logger.Warn("fail to call doge.EstimateSmartFee() then minimum fee rate is used", "error", err)
+	} else if estimatedAmt, amtErr := b.FloatToAmount(estimated); amtErr == nil && estimatedAmt > feePerKB {
+		feePerKB = estimatedAmt
+	}
+
+	return calculateFee(feePerKB, tx.SerializeSize()), nil
+}
+
+// GetFee get more preferable fee
+//   - adjusted fee never goes below MinFeePerKB and minimum relay fee of dogecoind
+func (b *Dogecoin) GetFee(ctx context.Context, tx *wire.MsgTx, adjustmentFee float64) (btcutil.Amount, error) {
+	fee, err := b.GetTransactionFee(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	// if adjustmentFee param is given
+	if adjustmentFee >= b.FeeRangeMin() && adjustmentFee <= b.FeeRangeMax() {
+		var newFee btcutil.Amount
+		newFee, err = b.FloatToAmount(fee.ToBTC() * adjustmentFee)
+		if err != nil {
+			logger.Warn("fail to adjust fee in doge but continue", "error", err)
+		} else {
+			logger.Debug("adjusted fee in doge", "newFee", newFee)
+			fee = newFee
+		}
+	}
+
+	minFee := calculateFee(MinFeePerKB, tx.SerializeSize())
+	relayFee, err := b.getMinRelayFee(ctx, tx)
+	if err != nil {
+		logger.Warn("fail to call doge.getMinRelayFee() but continue", "error", err)
+	} else if relayFee > minFee {
+		minFee = relayFee
+	}
+	if fee < minFee {
+		fee = minFee
+	}
+
+	return fee, nil
+}
+
+// getMinRelayFee returns minimum relay fee for tx, `relayfee` of `getnetworkinfo` is fee rate per kB
+func (b *Dogecoin) getMinRelayFee(ctx context.Context, tx *wire.MsgTx) (btcutil.Amount, error) {
+	res, err := b.GetNetworkInfo(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to call doge.GetNetworkInfo(): %w", err)
+	}
+	feePerKB, err := b.FloatToAmount(res.Relayfee)
+	if err != nil {
+		return 0, err
+	}
+	return calculateFee(feePerKB, tx.SerializeSize()), nil
+}
+
+// calculateFee returns fee for size of bytes by fee rate per kB, fraction is rounded up
+func calculateFee(feePerKB btcutil.Amount, size int) btcutil.Amount {
+	return (feePerKB*btcutil.Amount(size) + 999) / 1000
+}
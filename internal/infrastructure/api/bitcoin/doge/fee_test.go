@@ -0,0 +1,51 @@
+package doge
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateFee(t *testing.T) {
+	tests := []struct {
+		name     string
+		feePerKB btcutil.Amount
+		size     int
+		want     btcutil.Amount
+	}{
+		{
+			name:     "1kB by minimum fee rate",
+			feePerKB: MinFeePerKB,
+			size:     1000,
+			want:     1_000_000,
+		},
+		{
+			name:     "fraction is rounded up",
+			feePerKB: 1001,
+			size:     226,
+			want:     227,
+		},
+		{
+			name:     "typical transaction by minimum fee rate",
+			feePerKB: MinFeePerKB,
+			size:     226,
+			want:     226_000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, calculateFee(tt.feePerKB, tt.size))
+		})
+	}
+}
+
+func TestValidateDust(t *testing.T) {
+	addr, err := btcutil.NewAddressPubKeyHash(make([]byte, 20), &RegressionNetParams)
+	require.NoError(t, err)
+
+	require.NoError(t, validateDust(map[btcutil.Address]btcutil.Amount{addr: DustLimit}))
+	require.Error(t, validateDust(map[btcutil.Address]btcutil.Amount{addr: DustLimit - 1}))
+}
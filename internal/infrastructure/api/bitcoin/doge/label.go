@@ -0,0 +1,30 @@
+package doge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SetLabel sets account to existing imported address by RPC `setaccount`
+func (b *Dogecoin) SetLabel(_ context.Context, addr, label string) error {
+	_, err := b.DecodeAddress(addr)
+	if err != nil {
+		return fmt.Errorf("fail to call btc.DecodeAddress(%s) in doge: %w", addr, err)
+	}
+
+	input1, err := json.Marshal(addr)
+	if err != nil {
+		return fmt.Errorf("fail to call json.Marchal(addr) in doge: %w", err)
+	}
+	input2, err := json.Marshal(label)
+	if err != nil {
+		return fmt.Errorf("fail to call json.Marchal(label) in doge: %w", err)
+	}
+
+	_, err = b.Client.RawRequest("setaccount", []json.RawMessage{input1, input2})
+	if err != nil {
+		return fmt.Errorf("fail to call json.RawRequest(setaccount) in doge: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package doge
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+)
+
+// AddMultisigAddress create P2SH multisig address
+//   - requiredSigs: required number of signature for transaction
+//   - pubkeys:      list of full public keys(e.g. client, auth1, auth2, auth3
+//   - addressType is ignored because dogecoin supports only P2SH
+//
+// `addmultisigaddress` of dogecoind returns only address,
+// so redeemScript is built from given public keys in the same order as dogecoind
+func (b *Dogecoin) AddMultisigAddress(
+	_ context.Context, requiredSigs int,
+	pubkeys []string,
+	accountName string,
+	_ address.AddrType,
+) (*btc.AddMultisigAddressResult, error) {
+	if requiredSigs > len(pubkeys) {
+		return nil, fmt.Errorf(
+			"number of given address doesn't meet number of requiredSigs: requiredSigs:%d, len(addresses):%d",
+			requiredSigs, len(pubkeys))
+	}
+
+	redeemScript, err := b.multisigRedeemScript(requiredSigs, pubkeys)
+	if err != nil {
+		return nil, err
+	}
+
+	bRequiredSigs, err := json.Marshal(requiredSigs)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Marchal(requiredSigs): %w", err)
+	}
+	bPubkeys, err := json.Marshal(pubkeys)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Marchal(pubkeys): %w", err)
+	}
+	bAccount, err := json.Marshal(accountName)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Marchal(accountName): %w", err)
+	}
+
+	rawResult, err := b.Client.RawRequest("addmultisigaddress", []json.RawMessage{bRequiredSigs, bPubkeys, bAccount})
+	if err != nil {
+		return nil, fmt.Errorf("fail to call client.RawRequest(addmultisigaddress): %w", err)
+	}
+
+	var multisigAddr string
+	err = json.Unmarshal(rawResult, &multisigAddr)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Unmarshal(rawResult): %w", err)
+	}
+
+	// address returned by dogecoind must be hash of redeemScript
+	scriptAddr, err := btcutil.NewAddressScriptHash(redeemScript, b.GetChainConf())
+	if err != nil {
+		return nil, fmt.Errorf("fail to call btcutil.NewAddressScriptHash(): %w", err)
+	}
+	if scriptAddr.EncodeAddress() != multisigAddr {
+		return nil, fmt.Errorf("multisig address %s doesn't match redeemScript address %s",
+			multisigAddr, scriptAddr.EncodeAddress())
+	}
+
+	return &btc.AddMultisigAddressResult{
+		Address:      multisigAddr,
+		RedeemScript: hex.EncodeToString(redeemScript),
+	}, nil
+}
+
+func (b *Dogecoin) multisigRedeemScript(requiredSigs int, pubkeys []string) ([]byte, error) {
+	addrPubKeys := make([]*btcutil.AddressPubKey, len(pubkeys))
+	for idx, pubkey := range pubkeys {
+		bPubKey, err := hex.DecodeString(pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call hex.DecodeString(%s): %w", pubkey, err)
+		}
+		addrPubKeys[idx], err = btcutil.NewAddressPubKey(bPubKey, b.GetChainConf())
+		if err != nil {
+			return nil, fmt.Errorf("fail to call btcutil.NewAddressPubKey(%s): %w", pubkey, err)
+		}
+	}
+	redeemScript, err := txscript.MultiSigScript(addrPubKeys, requiredSigs)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call txscript.MultiSigScript(): %w", err)
+	}
+	return redeemScript, nil
+}
@@ -0,0 +1,94 @@
+package doge
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// refer to [dogecoin chainparams.cpp](https://github.com/dogecoin/dogecoin/blob/master/src/chainparams.cpp)
+//
+// only parameters used for keys and addresses are overridden,
+// genesis block and checkpoints of bitcoin remain because blocks are validated by dogecoind.
+// dogecoin doesn't activate segwit, so bech32 parameters are cleared
+
+const (
+	// MainnetMagic represents the main dogecoin network.
+	MainnetMagic wire.BitcoinNet = 0xc0c0c0c0
+
+	// TestnetMagic represents the test network (version 3).
+	TestnetMagic wire.BitcoinNet = 0xdcb7c1fc
+
+	// RegtestMagic represents the regression test network, which is the same as bitcoin.
+	RegtestMagic wire.BitcoinNet = 0xdab5bffa
+)
+
+// MainNetParams defines the network parameters for the main dogecoin network.
+var MainNetParams = newParams(chaincfg.MainNetParams, func(p *chaincfg.Params) {
+	p.Name = "mainnet"
+	p.Net = MainnetMagic
+	p.DefaultPort = "22556"
+	p.PubKeyHashAddrID = 0x1e // starts with D
+	p.ScriptHashAddrID = 0x16 // starts with 9 or A
+	p.PrivateKeyID = 0x9e
+	p.HDPrivateKeyID = [4]byte{0x02, 0xfa, 0xc3, 0x98} // starts with dgpv
+	p.HDPublicKeyID = [4]byte{0x02, 0xfa, 0xca, 0xfd}  // starts with dgub
+	p.HDCoinType = 3
+})
+
+// TestNet3Params defines the network parameters for the test dogecoin network (version 3).
+var TestNet3Params = newParams(chaincfg.TestNet3Params, func(p *chaincfg.Params) {
+	p.Name = "testnet3"
+	p.Net = TestnetMagic
+	p.DefaultPort = "44556"
+	p.PubKeyHashAddrID = 0x71 // starts with n
+	p.ScriptHashAddrID = 0xc4 // starts with 2
+	p.PrivateKeyID = 0xf1
+	p.HDCoinType = 1
+})
+
+// RegressionNetParams defines the network parameters for the regression test dogecoin network.
+var RegressionNetParams = newParams(chaincfg.RegressionNetParams, func(p *chaincfg.Params) {
+	p.Name = "regtest"
+	p.Net = RegtestMagic
+	p.DefaultPort = "18444"
+	p.PubKeyHashAddrID = 0x6f // starts with m or n
+	p.ScriptHashAddrID = 0xc4 // starts with 2
+	p.PrivateKeyID = 0xef
+	p.HDCoinType = 1
+})
+
+func newParams(base chaincfg.Params, override func(p *chaincfg.Params)) chaincfg.Params {
+	base.Bech32HRPSegwit = ""
+	base.WitnessPubKeyHashAddrID = 0
+	base.WitnessScriptHashAddrID = 0
+	override(&base)
+	return base
+}
+
+// params registered to chaincfg are required to derive public extended key by hdkeychain.ExtendedKey.Neuter()
+//
+// regtest is not registered because its magic is the same as bitcoin regtest
+// and its extended key versions are the same as bitcoin testnet
+func init() {
+	for _, params := range []*chaincfg.Params{&MainNetParams, &TestNet3Params} {
+		if err := chaincfg.Register(params); err != nil {
+			panic("fail to register dogecoin params " + params.Name + ": " + err.Error())
+		}
+	}
+}
+
+// ChainParams returns dogecoin params corresponding to bitcoin params of same network
+func ChainParams(conf *chaincfg.Params) (*chaincfg.Params, error) {
+	switch conf.Name {
+	case chaincfg.MainNetParams.Name:
+		return &MainNetParams, nil
+	case chaincfg.TestNet3Params.Name:
+		return &TestNet3Params, nil
+	case chaincfg.RegressionNetParams.Name:
+		return &RegressionNetParams, nil
+	default:
+		return nil, fmt.Errorf("network %s is not supported by dogecoin", conf.Name)
+	}
+}
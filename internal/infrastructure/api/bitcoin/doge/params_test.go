@@ -0,0 +1,103 @@
+package doge
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainParams(t *testing.T) {
+	tests := []struct {
+		name        string
+		btcParams   *chaincfg.Params
+		want        *chaincfg.Params
+		p2pkhPrefix string
+		wifPrefix   string
+		xpubPrefix  string
+		isErr       bool
+	}{
+		{
+			name:        "mainnet",
+			btcParams:   &chaincfg.MainNetParams,
+			want:        &MainNetParams,
+			p2pkhPrefix: "D",
+			wifPrefix:   "Q",
+			xpubPrefix:  "dgub",
+		},
+		{
+			name:        "testnet",
+			btcParams:   &chaincfg.TestNet3Params,
+			want:        &TestNet3Params,
+			p2pkhPrefix: "n",
+			wifPrefix:   "c",
+			xpubPrefix:  "tpub",
+		},
+		{
+			name:       "regtest",
+			btcParams:  &chaincfg.RegressionNetParams,
+			want:       &RegressionNetParams,
+			wifPrefix:  "c",
+			xpubPrefix: "tpub",
+		},
+		{
+			name:      "signet is not supported",
+			btcParams: &chaincfg.SigNetParams,
+			isErr:     true,
+		},
+	}
+
+	privKey, _ := btcec.PrivKeyFromBytes([]byte("dogecoin private key for testing"))
+	pkHash := btcutil.Hash160(privKey.PubKey().SerializeCompressed())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := ChainParams(tt.btcParams)
+			if tt.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, params)
+			assert.Empty(t, params.Bech32HRPSegwit)
+
+			p2pkh, err := btcutil.NewAddressPubKeyHash(pkHash, params)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(p2pkh.EncodeAddress(), tt.p2pkhPrefix), p2pkh.EncodeAddress())
+
+			decoded, err := btcutil.DecodeAddress(p2pkh.EncodeAddress(), params)
+			require.NoError(t, err)
+			assert.True(t, decoded.IsForNet(params))
+
+			wif, err := btcutil.NewWIF(privKey, params, true)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(wif.String(), tt.wifPrefix), wif.String())
+			decodedWIF, err := btcutil.DecodeWIF(wif.String())
+			require.NoError(t, err)
+			assert.True(t, decodedWIF.IsForNet(params))
+
+			// public extended key is derived by registered params
+			master, err := hdkeychain.NewMaster(make([]byte, hdkeychain.RecommendedSeedLen), params)
+			require.NoError(t, err)
+			pub, err := master.Neuter()
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(pub.String(), tt.xpubPrefix), pub.String())
+		})
+	}
+}
+
+func TestMainNetAddress(t *testing.T) {
+	// P2SH address of dogecoin mainnet starts with 9 or A
+	scriptHash := btcutil.Hash160([]byte("redeem script"))
+	p2sh, err := btcutil.NewAddressScriptHashFromHash(scriptHash, &MainNetParams)
+	require.NoError(t, err)
+	assert.Contains(t, []byte{'9', 'A'}, p2sh.EncodeAddress()[0], p2sh.EncodeAddress())
+
+	// dogecoin address is not decoded as bitcoin address
+	_, err = btcutil.DecodeAddress(p2sh.EncodeAddress(), &chaincfg.MainNetParams)
+	assert.Error(t, err)
+}
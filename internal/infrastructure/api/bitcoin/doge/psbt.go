@@ -0,0 +1,299 @@
+package doge
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// dogecoin doesn't support segwit, so PSBT is handled for only legacy inputs
+//   - full previous transaction is added as non-witness UTXO instead of witness UTXO
+//   - signature hash is calculated by legacy algorithm
+//   - input is finalized as soon as required signatures are collected
+//     because psbt.Packet.IsComplete() returns true only when all inputs are finalized
+
+// CreatePSBT creates a PSBT from an unsigned transaction with non-witness UTXO and redeem scripts.
+// Previous transactions are retrieved by `gettransaction` from watch only wallet.
+func (b *Dogecoin) CreatePSBT(msgTx *wire.MsgTx, prevTxs []btc.PrevTx) (string, error) {
+	packet, err := psbt.NewFromUnsignedTx(msgTx)
+	if err != nil {
+		return "", fmt.Errorf("failed to create PSBT from transaction: %w", err)
+	}
+	updater, err := psbt.NewUpdater(packet)
+	if err != nil {
+		return "", fmt.Errorf("failed to create PSBT updater: %w", err)
+	}
+
+	for i, prevTx := range prevTxs {
+		if i >= len(packet.UnsignedTx.TxIn) {
+			return "", fmt.Errorf("prevTxs index %d exceeds number of inputs %d", i, len(packet.UnsignedTx.TxIn))
+		}
+		txIn := packet.UnsignedTx.TxIn[i]
+
+		prevTxHash, err := chainhash.NewHashFromStr(prevTx.Txid)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse txid for input %d: %w", i, err)
+		}
+		if !prevTxHash.IsEqual(&txIn.PreviousOutPoint.Hash) || prevTx.Vout != txIn.PreviousOutPoint.Index {
+			return "", fmt.Errorf("input %d: prevTx %s:%d does not match transaction input %s",
+				i, prevTx.Txid, prevTx.Vout, txIn.PreviousOutPoint.String())
+		}
+
+		// full previous transaction is required to sign legacy input
+		res, err := b.GetTransaction(prevTx.Txid)
+		if err != nil {
+			return "", fmt.Errorf("failed to get previous transaction for input %d: %w", i, err)
+		}
+		fullPrevTx, err := b.ToMsgTx(res.Hex)
+		if err != nil {
+			return "", fmt.Errorf("failed to decode previous transaction for input %d: %w", i, err)
+		}
+		if err := updater.AddInNonWitnessUtxo(fullPrevTx, i); err != nil {
+			return "", fmt.Errorf("failed to add non-witness UTXO for input %d: %w", i, err)
+		}
+
+		// Add redeem script for P2SH multisig if provided
+		if prevTx.RedeemScript != "" {
+			redeemScript, err := hexToScript(prevTx.RedeemScript)
+			if err != nil {
+				return "", fmt.Errorf("failed to decode redeemScript for input %d: %w", i, err)
+			}
+			if err := updater.AddInRedeemScript(redeemScript, i); err != nil {
+				return "", fmt.Errorf("failed to add redeem script for input %d: %w", i, err)
+			}
+		}
+
+		if err := updater.AddInSighashType(txscript.SigHashAll, i); err != nil {
+			return "", fmt.Errorf("failed to add sighash type for input %d: %w", i, err)
+		}
+	}
+
+	psbtBase64, err := serializePSBT(packet)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize PSBT: %w", err)
+	}
+
+	logger.Debug("Created PSBT from transaction in doge",
+		"inputs", len(msgTx.TxIn),
+		"outputs", len(msgTx.TxOut),
+		"txid", msgTx.TxHash().String())
+
+	return psbtBase64, nil
+}
+
+// SignPSBTWithKey signs legacy inputs of PSBT with provided private keys (offline).
+//
+// Returns:
+//   - psbtBase64: The signed PSBT in base64 format
+//   - isComplete: true if all inputs are finalized with required signatures
+//   - error: any error that occurred
+func (b *Dogecoin) SignPSBTWithKey(psbtBase64 string, wifs []string) (string, bool, error) {
+	parsed, err := b.ParsePSBT(psbtBase64)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to parse PSBT for signing: %w", err)
+	}
+
+	privKeys := make([]*btcutil.WIF, 0, len(wifs))
+	for _, wif := range wifs {
+		privKey, err := btcutil.DecodeWIF(wif)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to decode WIF private key: %w", err)
+		}
+		privKeys = append(privKeys, privKey)
+	}
+
+	updater, err := psbt.NewUpdater(parsed.Packet)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to create updater for signing: %w", err)
+	}
+
+	signedCount := 0
+	for i := range parsed.Packet.UnsignedTx.TxIn {
+		count, err := b.signInput(updater, i, privKeys)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to sign input %d: %w", i, err)
+		}
+		signedCount += count
+	}
+	if signedCount == 0 {
+		return "", false, errors.New("no signatures were added (keys may not match PSBT inputs)")
+	}
+
+	isComplete := parsed.Packet.IsComplete()
+	signedPSBT, err := serializePSBT(parsed.Packet)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to serialize signed PSBT: %w", err)
+	}
+
+	logger.Debug("PSBT signing completed in doge",
+		"signedCount", signedCount,
+		"isComplete", isComplete)
+
+	return signedPSBT, isComplete, nil
+}
+
+// FinalizePSBT finalizes inputs which are not finalized yet.
+// Inputs are usually finalized by SignPSBTWithKey() when last signature is added.
+func (b *Dogecoin) FinalizePSBT(psbtBase64 string) (string, error) {
+	parsed, err := b.ParsePSBT(psbtBase64)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse PSBT for finalization: %w", err)
+	}
+
+	for i := range parsed.Packet.UnsignedTx.TxIn {
+		if err := maybeFinalize(parsed.Packet, i); err != nil {
+			return "", fmt.Errorf("failed to finalize input %d: %w", i, err)
+		}
+	}
+	if !parsed.Packet.IsComplete() {
+		return "", errors.New("cannot finalize incomplete PSBT (missing signatures)")
+	}
+
+	return serializePSBT(parsed.Packet)
+}
+
+// signInput adds signatures by matched keys to input until required number of signatures is met
+func (b *Dogecoin) signInput(updater *psbt.Updater, idx int, privKeys []*btcutil.WIF) (int, error) {
+	packet := updater.Upsbt
+	pInput := packet.Inputs[idx]
+	if pInput.FinalScriptSig != nil {
+		return 0, nil
+	}
+	if pInput.NonWitnessUtxo == nil {
+		logger.Warn("Skipping input without non-witness UTXO", "input", idx)
+		return 0, nil
+	}
+
+	subScript, required, err := signatureScript(packet, idx)
+	if err != nil {
+		return 0, err
+	}
+
+	signedCount := 0
+	for _, privKey := range privKeys {
+		if len(packet.Inputs[idx].PartialSigs) >= required {
+			break
+		}
+		pubKey := privKey.SerializePubKey()
+		if !b.isSigner(subScript, pubKey) {
+			continue
+		}
+
+		sig, err := txscript.RawTxInSignature(
+			packet.UnsignedTx, idx, subScript, txscript.SigHashAll, privKey.PrivKey)
+		if err != nil {
+			return signedCount, fmt.Errorf("fail to call txscript.RawTxInSignature(): %w", err)
+		}
+		outcome, err := updater.Sign(idx, sig, pubKey, nil, nil)
+		if err != nil {
+			// key has already signed
+			logger.Debug("Signature not applicable for this input", "input", idx, "error", err)
+			continue
+		}
+		if outcome == psbt.SignSuccesful {
+			signedCount++
+		}
+	}
+
+	return signedCount, maybeFinalize(packet, idx)
+}
+
+// maybeFinalize finalizes input only when required number of signatures are collected
+func maybeFinalize(packet *psbt.Packet, idx int) error {
+	pInput := packet.Inputs[idx]
+	if pInput.FinalScriptSig != nil || pInput.NonWitnessUtxo == nil {
+		return nil
+	}
+	_, required, err := signatureScript(packet, idx)
+	if err != nil {
+		return err
+	}
+	if len(pInput.PartialSigs) < required {
+		return nil
+	}
+	return psbt.Finalize(packet, idx)
+}
+
+// isSigner returns true if public key is required to sign script
+func (b *Dogecoin) isSigner(subScript, pubKey []byte) bool {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(subScript, b.GetChainConf())
+	if err != nil {
+		return false
+	}
+	pubKeyHash := btcutil.Hash160(pubKey)
+	for _, addr := range addrs {
+		switch a := addr.(type) {
+		case *btcutil.AddressPubKeyHash:
+			if bytes.Equal(a.ScriptAddress(), pubKeyHash) {
+				return true
+			}
+		case *btcutil.AddressPubKey:
+			if bytes.Equal(a.ScriptAddress(), pubKey) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// signatureScript returns script to calculate signature hash of input and required number of signatures
+//   - redeemScript for P2SH, scriptPubKey of previous output for P2PKH
+func signatureScript(packet *psbt.Packet, idx int) ([]byte, int, error) {
+	pInput := packet.Inputs[idx]
+	subScript := pInput.RedeemScript
+	if subScript == nil {
+		outIndex := packet.UnsignedTx.TxIn[idx].PreviousOutPoint.Index
+		if outIndex >= uint32(len(pInput.NonWitnessUtxo.TxOut)) {
+			return nil, 0, fmt.Errorf("previous output index %d out of range", outIndex)
+		}
+		subScript = pInput.NonWitnessUtxo.TxOut[outIndex].PkScript
+	}
+	required, err := requiredSignatures(subScript)
+	if err != nil {
+		return nil, 0, err
+	}
+	return subScript, required, nil
+}
+
+// requiredSignatures returns number of signatures to spend P2PKH or multisig script
+func requiredSignatures(subScript []byte) (int, error) {
+	switch txscript.GetScriptClass(subScript) {
+	case txscript.PubKeyHashTy:
+		return 1, nil
+	case txscript.MultiSigTy:
+		_, numSigs, err := txscript.CalcMultiSigStats(subScript)
+		if err != nil {
+			return 0, fmt.Errorf("fail to call txscript.CalcMultiSigStats(): %w", err)
+		}
+		return numSigs, nil
+	default:
+		return 0, fmt.Errorf("script class %s is not supported by dogecoin", txscript.GetScriptClass(subScript))
+	}
+}
+
+func hexToScript(hexScript string) ([]byte, error) {
+	script, err := hex.DecodeString(hexScript)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex script: %w", err)
+	}
+	return script, nil
+}
+
+func serializePSBT(packet *psbt.Packet) (string, error) {
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return "", fmt.Errorf("failed to serialize PSBT packet: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
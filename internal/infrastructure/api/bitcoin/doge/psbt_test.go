@@ -0,0 +1,153 @@
+package doge
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDogecoin() *Dogecoin {
+	dogecoin := &Dogecoin{}
+	dogecoin.SetChainConf(&RegressionNetParams)
+	return dogecoin
+}
+
+func newTestWIF(t *testing.T, seed string) *btcutil.WIF {
+	t.Helper()
+
+	privKey, _ := btcec.PrivKeyFromBytes(chainhash.HashB([]byte(seed)))
+	wif, err := btcutil.NewWIF(privKey, &RegressionNetParams, true)
+	require.NoError(t, err)
+	return wif
+}
+
+// TestSignPSBTWithKey signs P2PKH input and 2-of-2 P2SH multisig input by two signers
+func TestSignPSBTWithKey(t *testing.T) {
+	dogecoin := newTestDogecoin()
+	wif1 := newTestWIF(t, "doge key 1")
+	wif2 := newTestWIF(t, "doge key 2")
+
+	// previous outputs
+	p2pkhAddr, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(wif1.SerializePubKey()), &RegressionNetParams)
+	require.NoError(t, err)
+	p2pkhScript, err := txscript.PayToAddrScript(p2pkhAddr)
+	require.NoError(t, err)
+
+	redeemScript, err := dogecoin.multisigRedeemScript(2, []string{
+		hex.EncodeToString(wif1.SerializePubKey()),
+		hex.EncodeToString(wif2.SerializePubKey()),
+	})
+	require.NoError(t, err)
+	p2shAddr, err := btcutil.NewAddressScriptHash(redeemScript, &RegressionNetParams)
+	require.NoError(t, err)
+	p2shScript, err := txscript.PayToAddrScript(p2shAddr)
+	require.NoError(t, err)
+
+	prevTx := wire.NewMsgTx(wire.TxVersion)
+	prevTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	prevTx.AddTxOut(wire.NewTxOut(500_000_000, p2pkhScript))
+	prevTx.AddTxOut(wire.NewTxOut(700_000_000, p2shScript))
+	prevHash := prevTx.TxHash()
+
+	// unsigned transaction
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0), nil, nil))
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 1), nil, nil))
+	msgTx.AddTxOut(wire.NewTxOut(1_100_000_000, p2pkhScript))
+
+	packet, err := psbt.NewFromUnsignedTx(msgTx)
+	require.NoError(t, err)
+	updater, err := psbt.NewUpdater(packet)
+	require.NoError(t, err)
+	for i := range msgTx.TxIn {
+		require.NoError(t, updater.AddInNonWitnessUtxo(prevTx, i))
+		require.NoError(t, updater.AddInSighashType(txscript.SigHashAll, i))
+	}
+	require.NoError(t, updater.AddInRedeemScript(redeemScript, 1))
+	unsignedPSBT, err := serializePSBT(packet)
+	require.NoError(t, err)
+
+	// first signer completes P2PKH input only
+	signedPSBT, isComplete, err := dogecoin.SignPSBTWithKey(unsignedPSBT, []string{wif1.String()})
+	require.NoError(t, err)
+	assert.False(t, isComplete)
+	_, err = dogecoin.FinalizePSBT(signedPSBT)
+	require.Error(t, err)
+
+	// key which is not related to inputs can't sign
+	_, _, err = dogecoin.SignPSBTWithKey(signedPSBT, []string{newTestWIF(t, "unknown").String()})
+	require.Error(t, err)
+
+	// second signer completes multisig input
+	signedPSBT, isComplete, err = dogecoin.SignPSBTWithKey(signedPSBT, []string{wif2.String()})
+	require.NoError(t, err)
+	assert.True(t, isComplete)
+
+	finalizedPSBT, err := dogecoin.FinalizePSBT(signedPSBT)
+	require.NoError(t, err)
+
+	// extracted transaction is valid for previous outputs
+	psbtBytes, err := base64.StdEncoding.DecodeString(finalizedPSBT)
+	require.NoError(t, err)
+	finalized, err := psbt.NewFromRawBytes(bytes.NewReader(psbtBytes), false)
+	require.NoError(t, err)
+	signedTx, err := psbt.Extract(finalized)
+	require.NoError(t, err)
+
+	fetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, txOut := range prevTx.TxOut {
+		fetcher.AddPrevOut(wire.OutPoint{Hash: prevHash, Index: uint32(i)}, txOut)
+	}
+	sigHashes := txscript.NewTxSigHashes(signedTx, fetcher)
+	for i, txIn := range signedTx.TxIn {
+		assert.Empty(t, txIn.Witness)
+		prevOut := prevTx.TxOut[txIn.PreviousOutPoint.Index]
+		vm, err := txscript.NewEngine(prevOut.PkScript, signedTx, i,
+			txscript.StandardVerifyFlags, nil, sigHashes, prevOut.Value, fetcher)
+		require.NoError(t, err)
+		require.NoError(t, vm.Execute(), "input %d", i)
+	}
+}
+
+func TestRequiredSignatures(t *testing.T) {
+	dogecoin := newTestDogecoin()
+	wif1 := newTestWIF(t, "doge key 1")
+	wif2 := newTestWIF(t, "doge key 2")
+	wif3 := newTestWIF(t, "doge key 3")
+
+	addr, err := btcutil.NewAddressPubKeyHash(btcutil.Hash160(wif1.SerializePubKey()), &RegressionNetParams)
+	require.NoError(t, err)
+	p2pkhScript, err := txscript.PayToAddrScript(addr)
+	require.NoError(t, err)
+	required, err := requiredSignatures(p2pkhScript)
+	require.NoError(t, err)
+	assert.Equal(t, 1, required)
+
+	redeemScript, err := dogecoin.multisigRedeemScript(2, []string{
+		hex.EncodeToString(wif1.SerializePubKey()),
+		hex.EncodeToString(wif2.SerializePubKey()),
+		hex.EncodeToString(wif3.SerializePubKey()),
+	})
+	require.NoError(t, err)
+	required, err = requiredSignatures(redeemScript)
+	require.NoError(t, err)
+	assert.Equal(t, 2, required)
+
+	// segwit script is not supported
+	witnessAddr, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(wif1.SerializePubKey()), &RegressionNetParams)
+	require.NoError(t, err)
+	witnessScript, err := txscript.PayToAddrScript(witnessAddr)
+	require.NoError(t, err)
+	_, err = requiredSignatures(witnessScript)
+	require.Error(t, err)
+}
@@ -0,0 +1,30 @@
+package doge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// CreateRawTransaction create raw transaction after outputs are validated by dust limit
+func (b *Dogecoin) CreateRawTransaction(
+	ctx context.Context, inputs []btcjson.TransactionInput, outputs map[btcutil.Address]btcutil.Amount,
+) (*wire.MsgTx, error) {
+	if err := validateDust(outputs); err != nil {
+		return nil, err
+	}
+	return b.Bitcoin.CreateRawTransaction(ctx, inputs, outputs)
+}
+
+// validateDust returns error if any output is less than DustLimit
+func validateDust(outputs map[btcutil.Address]btcutil.Amount) error {
+	for addr, amt := range outputs {
+		if amt < DustLimit {
+			return fmt.Errorf("output amount %v to %s is less than dust limit %v", amt, addr.String(), DustLimit)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package doge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcutil"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+)
+
+// listUnspentResult is response type of PRC `listunspent` in dogecoind
+//   - `account` is returned instead of `label`
+type listUnspentResult struct {
+	btc.ListUnspentResult
+	Account string `json:"account"`
+}
+
+// ListUnspentByAccount gets listunspent by account
+func (b *Dogecoin) ListUnspentByAccount(
+	ctx context.Context, accountType domainAccount.AccountType, confirmationNum uint64,
+) ([]btc.ListUnspentResult, error) {
+	addrs, err := b.GetAddressesByLabel(ctx, accountType.String())
+	if err != nil {
+		return nil, fmt.Errorf("fail to call doge.GetAddressesByLabel(): %w", err)
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("address for %s can not be found", accountType)
+	}
+
+	unspentList, err := b.listUnspentByAddresses(addrs, confirmationNum)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call doge.listUnspentByAddresses(): %w", err)
+	}
+
+	// sort amount by ascending (small to big)
+	sort.Slice(unspentList, func(i, j int) bool {
+		return unspentList[i].Amount < unspentList[j].Amount
+	})
+
+	return unspentList, nil
+}
+
+// GetBalanceByAccount gets balance by account
+func (b *Dogecoin) GetBalanceByAccount(
+	ctx context.Context, accountType domainAccount.AccountType, confirmationNum uint64,
+) (btcutil.Amount, error) {
+	unspentList, err := b.ListUnspentByAccount(ctx, accountType, confirmationNum)
+	if err != nil {
+		return 0, fmt.Errorf("fail to call doge.ListUnspentByAccount(%s): %w", accountType.String(), err)
+	}
+	var totalAmout float64
+	for _, tx := range unspentList {
+		totalAmout += tx.Amount
+	}
+	return b.FloatToAmount(totalAmout)
+}
+
+func (b *Dogecoin) listUnspentByAddresses(
+	addrs []btcutil.Address, confirmationNum uint64,
+) ([]btc.ListUnspentResult, error) {
+	input1, err := json.Marshal(confirmationNum)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Marchal(confirmationBlock): %w", err)
+	}
+	input2, err := json.Marshal(uint64(9999999))
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Marchal(9999999): %w", err)
+	}
+	strAddrs := make([]string, len(addrs))
+	for idx, addr := range addrs {
+		strAddrs[idx] = addr.String()
+	}
+	input3, err := json.Marshal(strAddrs)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Marchal(addresses): %w", err)
+	}
+
+	rawResult, err := b.Client.RawRequest("listunspent", []json.RawMessage{input1, input2, input3})
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.RawRequest(listunspent): %w", err)
+	}
+
+	var dogeResult []listUnspentResult
+	err = json.Unmarshal(rawResult, &dogeResult)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call json.Unmarshal(rawResult): %w", err)
+	}
+	if len(dogeResult) == 0 {
+		return nil, nil
+	}
+
+	unspentList := make([]btc.ListUnspentResult, len(dogeResult))
+	for idx, unspent := range dogeResult {
+		unspentList[idx] = unspent.ListUnspentResult
+		unspentList[idx].Label = unspent.Account
+	}
+	return unspentList, nil
+}
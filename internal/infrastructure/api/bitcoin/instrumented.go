@@ -0,0 +1,383 @@
+package bitcoin
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// instrumentedBitcoiner records latency and errors of methods calling bitcoin core RPC server,
+// other methods are called as is
+type instrumentedBitcoiner struct {
+	Bitcoiner
+	coin string
+}
+
+// NewInstrumentedBitcoiner wraps Bitcoiner to record RPC metrics per method
+func NewInstrumentedBitcoiner(bit Bitcoiner) Bitcoiner {
+	return &instrumentedBitcoiner{
+		Bitcoiner: bit,
+		coin:      bit.CoinTypeCode().String(),
+	}
+}
+
+func (b *instrumentedBitcoiner) start(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "bitcoin."+method, attribute.String("coin", b.coin))
+}
+
+func (b *instrumentedBitcoiner) observe(method string, span trace.Span, started time.Time, err *error) {
+	metrics.ObserveRPC(b.coin, method, started, *err)
+	tracer.End(span, err)
+}
+
+func (b *instrumentedBitcoiner) GetAccount(ctx context.Context, addr string) (_ string, err error) {
+	ctx, span := b.start(ctx, "GetAccount")
+	defer b.observe("GetAccount", span, time.Now(), &err)
+	return b.Bitcoiner.GetAccount(ctx, addr)
+}
+
+func (b *instrumentedBitcoiner) GetAddressInfo(
+	ctx context.Context, addr string,
+) (_ *btc.GetAddressInfoResult, err error) {
+	ctx, span := b.start(ctx, "GetAddressInfo")
+	defer b.observe("GetAddressInfo", span, time.Now(), &err)
+	return b.Bitcoiner.GetAddressInfo(ctx, addr)
+}
+
+func (b *instrumentedBitcoiner) GetAddressesByLabel(
+	ctx context.Context, labelName string,
+) (_ []btcutil.Address, err error) {
+	ctx, span := b.start(ctx, "GetAddressesByLabel")
+	defer b.observe("GetAddressesByLabel", span, time.Now(), &err)
+	return b.Bitcoiner.GetAddressesByLabel(ctx, labelName)
+}
+
+func (b *instrumentedBitcoiner) ValidateAddress(
+	ctx context.Context, addr string,
+) (_ *btc.ValidateAddressResult, err error) {
+	ctx, span := b.start(ctx, "ValidateAddress")
+	defer b.observe("ValidateAddress", span, time.Now(), &err)
+	return b.Bitcoiner.ValidateAddress(ctx, addr)
+}
+
+func (b *instrumentedBitcoiner) GetBalance(ctx context.Context) (_ btcutil.Amount, err error) {
+	ctx, span := b.start(ctx, "GetBalance")
+	defer b.observe("GetBalance", span, time.Now(), &err)
+	return b.Bitcoiner.GetBalance(ctx)
+}
+
+func (b *instrumentedBitcoiner) GetBalanceByListUnspent(
+	ctx context.Context, confirmationNum uint64,
+) (_ btcutil.Amount, err error) {
+	ctx, span := b.start(ctx, "GetBalanceByListUnspent")
+	defer b.observe("GetBalanceByListUnspent", span, time.Now(), &err)
+	return b.Bitcoiner.GetBalanceByListUnspent(ctx, confirmationNum)
+}
+
+func (b *instrumentedBitcoiner) GetBalanceByAccount(
+	ctx context.Context, accountType domainAccount.AccountType, confirmationNum uint64,
+) (_ btcutil.Amount, err error) {
+	ctx, span := b.start(ctx, "GetBalanceByAccount")
+	defer b.observe("GetBalanceByAccount", span, time.Now(), &err)
+	return b.Bitcoiner.GetBalanceByAccount(ctx, accountType, confirmationNum)
+}
+
+func (b *instrumentedBitcoiner) GetBlockCount(ctx context.Context) (_ int64, err error) {
+	ctx, span := b.start(ctx, "GetBlockCount")
+	defer b.observe("GetBlockCount", span, time.Now(), &err)
+	return b.Bitcoiner.GetBlockCount(ctx)
+}
+
+func (b *instrumentedBitcoiner) GetBlockHash(ctx context.Context, blockHeight int64) (_ string, err error) {
+	ctx, span := b.start(ctx, "GetBlockHash")
+	defer b.observe("GetBlockHash", span, time.Now(), &err)
+	return b.Bitcoiner.GetBlockHash(ctx, blockHeight)
+}
+
+func (b *instrumentedBitcoiner) IsBlockInBestChain(
+	ctx context.Context, blockHash string, blockHeight int64,
+) (_ bool, err error) {
+	ctx, span := b.start(ctx, "IsBlockInBestChain")
+	defer b.observe("IsBlockInBestChain", span, time.Now(), &err)
+	return b.Bitcoiner.IsBlockInBestChain(ctx, blockHash, blockHeight)
+}
+
+func (b *instrumentedBitcoiner) GetBlock(ctx context.Context, blockHash string) (_ *wire.MsgBlock, err error) {
+	ctx, span := b.start(ctx, "GetBlock")
+	defer b.observe("GetBlock", span, time.Now(), &err)
+	return b.Bitcoiner.GetBlock(ctx, blockHash)
+}
+
+func (b *instrumentedBitcoiner) EstimateSmartFee(ctx context.Context) (_ float64, err error) {
+	ctx, span := b.start(ctx, "EstimateSmartFee")
+	defer b.observe("EstimateSmartFee", span, time.Now(), &err)
+	return b.Bitcoiner.EstimateSmartFee(ctx)
+}
+
+func (b *instrumentedBitcoiner) GetTransactionFee(ctx context.Context, tx *wire.MsgTx) (_ btcutil.Amount, err error) {
+	ctx, span := b.start(ctx, "GetTransactionFee")
+	defer b.observe("GetTransactionFee", span, time.Now(), &err)
+	return b.Bitcoiner.GetTransactionFee(ctx, tx)
+}
+
+func (b *instrumentedBitcoiner) GetFee(
+	ctx context.Context, tx *wire.MsgTx, adjustmentFee float64,
+) (_ btcutil.Amount, err error) {
+	ctx, span := b.start(ctx, "GetFee")
+	defer b.observe("GetFee", span, time.Now(), &err)
+	return b.Bitcoiner.GetFee(ctx, tx, adjustmentFee)
+}
+
+func (b *instrumentedBitcoiner) ImportPrivKey(ctx context.Context, privKeyWIF *btcutil.WIF) (err error) {
+	ctx, span := b.start(ctx, "ImportPrivKey")
+	defer b.observe("ImportPrivKey", span, time.Now(), &err)
+	return b.Bitcoiner.ImportPrivKey(ctx, privKeyWIF)
+}
+
+func (b *instrumentedBitcoiner) ImportPrivKeyLabel(
+	ctx context.Context, privKeyWIF *btcutil.WIF, label string,
+) (err error) {
+	ctx, span := b.start(ctx, "ImportPrivKeyLabel")
+	defer b.observe("ImportPrivKeyLabel", span, time.Now(), &err)
+	return b.Bitcoiner.ImportPrivKeyLabel(ctx, privKeyWIF, label)
+}
+
+func (b *instrumentedBitcoiner) ImportPrivKeyWithoutReScan(
+	ctx context.Context, privKeyWIF *btcutil.WIF, label string,
+) (err error) {
+	ctx, span := b.start(ctx, "ImportPrivKeyWithoutReScan")
+	defer b.observe("ImportPrivKeyWithoutReScan", span, time.Now(), &err)
+	return b.Bitcoiner.ImportPrivKeyWithoutReScan(ctx, privKeyWIF, label)
+}
+
+func (b *instrumentedBitcoiner) ImportAddress(ctx context.Context, pubkey string) (err error) {
+	ctx, span := b.start(ctx, "ImportAddress")
+	defer b.observe("ImportAddress", span, time.Now(), &err)
+	return b.Bitcoiner.ImportAddress(ctx, pubkey)
+}
+
+func (b *instrumentedBitcoiner) ImportAddressWithoutReScan(ctx context.Context, pubkey string) (err error) {
+	ctx, span := b.start(ctx, "ImportAddressWithoutReScan")
+	defer b.observe("ImportAddressWithoutReScan", span, time.Now(), &err)
+	return b.Bitcoiner.ImportAddressWithoutReScan(ctx, pubkey)
+}
+
+func (b *instrumentedBitcoiner) ImportAddressWithLabel(
+	ctx context.Context, addr, label string, rescan bool,
+) (err error) {
+	ctx, span := b.start(ctx, "ImportAddressWithLabel")
+	defer b.observe("ImportAddressWithLabel", span, time.Now(), &err)
+	return b.Bitcoiner.ImportAddressWithLabel(ctx, addr, label, rescan)
+}
+
+func (b *instrumentedBitcoiner) SetLabel(ctx context.Context, addr, label string) (err error) {
+	ctx, span := b.start(ctx, "SetLabel")
+	defer b.observe("SetLabel", span, time.Now(), &err)
+	return b.Bitcoiner.SetLabel(ctx, addr, label)
+}
+
+func (b *instrumentedBitcoiner) Logging(ctx context.Context) (_ *btc.LoggingResult, err error) {
+	ctx, span := b.start(ctx, "Logging")
+	defer b.observe("Logging", span, time.Now(), &err)
+	return b.Bitcoiner.Logging(ctx)
+}
+
+func (b *instrumentedBitcoiner) AddMultisigAddress(
+	ctx context.Context, requiredSigs int, addresses []string, accountName string, addressType address.AddrType,
+) (_ *btc.AddMultisigAddressResult, err error) {
+	ctx, span := b.start(ctx, "AddMultisigAddress")
+	defer b.observe("AddMultisigAddress", span, time.Now(), &err)
+	return b.Bitcoiner.AddMultisigAddress(ctx, requiredSigs, addresses, accountName, addressType)
+}
+
+func (b *instrumentedBitcoiner) GetNetworkInfo(ctx context.Context) (_ *btc.GetNetworkInfoResult, err error) {
+	ctx, span := b.start(ctx, "GetNetworkInfo")
+	defer b.observe("GetNetworkInfo", span, time.Now(), &err)
+	return b.Bitcoiner.GetNetworkInfo(ctx)
+}
+
+func (b *instrumentedBitcoiner) GetBlockchainInfo(ctx context.Context) (_ *btc.GetBlockchainInfoResult, err error) {
+	ctx, span := b.start(ctx, "GetBlockchainInfo")
+	defer b.observe("GetBlockchainInfo", span, time.Now(), &err)
+	return b.Bitcoiner.GetBlockchainInfo(ctx)
+}
+
+func (b *instrumentedBitcoiner) GetTransactionByTxID(
+	ctx context.Context, txID string,
+) (_ *btc.GetTransactionResult, err error) {
+	ctx, span := b.start(ctx, "GetTransactionByTxID")
+	defer b.observe("GetTransactionByTxID", span, time.Now(), &err)
+	return b.Bitcoiner.GetTransactionByTxID(ctx, txID)
+}
+
+func (b *instrumentedBitcoiner) GetTxOutByTxID(
+	ctx context.Context, txID string, index uint32,
+) (_ *btcjson.GetTxOutResult, err error) {
+	ctx, span := b.start(ctx, "GetTxOutByTxID")
+	defer b.observe("GetTxOutByTxID", span, time.Now(), &err)
+	return b.Bitcoiner.GetTxOutByTxID(ctx, txID, index)
+}
+
+func (b *instrumentedBitcoiner) DecodeRawTransaction(
+	ctx context.Context, hexTx string,
+) (_ *btc.TxRawResult, err error) {
+	ctx, span := b.start(ctx, "DecodeRawTransaction")
+	defer b.observe("DecodeRawTransaction", span, time.Now(), &err)
+	return b.Bitcoiner.DecodeRawTransaction(ctx, hexTx)
+}
+
+func (b *instrumentedBitcoiner) GetRawTransactionByHex(
+	ctx context.Context, strHashTx string,
+) (_ *btcutil.Tx, err error) {
+	ctx, span := b.start(ctx, "GetRawTransactionByHex")
+	defer b.observe("GetRawTransactionByHex", span, time.Now(), &err)
+	return b.Bitcoiner.GetRawTransactionByHex(ctx, strHashTx)
+}
+
+func (b *instrumentedBitcoiner) CreateRawTransaction(
+	ctx context.Context, inputs []btcjson.TransactionInput, outputs map[btcutil.Address]btcutil.Amount,
+) (_ *wire.MsgTx, err error) {
+	ctx, span := b.start(ctx, "CreateRawTransaction")
+	defer b.observe("CreateRawTransaction", span, time.Now(), &err)
+	return b.Bitcoiner.CreateRawTransaction(ctx, inputs, outputs)
+}
+
+func (b *instrumentedBitcoiner) FundRawTransaction(
+	ctx context.Context, hex string,
+) (_ *btc.FundRawTransactionResult, err error) {
+	ctx, span := b.start(ctx, "FundRawTransaction")
+	defer b.observe("FundRawTransaction", span, time.Now(), &err)
+	return b.Bitcoiner.FundRawTransaction(ctx, hex)
+}
+
+func (b *instrumentedBitcoiner) SignRawTransaction(
+	ctx context.Context, tx *wire.MsgTx, prevtxs []btc.PrevTx,
+) (_ *wire.MsgTx, _ bool, err error) {
+	ctx, span := b.start(ctx, "SignRawTransaction")
+	defer b.observe("SignRawTransaction", span, time.Now(), &err)
+	return b.Bitcoiner.SignRawTransaction(ctx, tx, prevtxs)
+}
+
+func (b *instrumentedBitcoiner) SignRawTransactionWithKey(
+	ctx context.Context, tx *wire.MsgTx, privKeysWIF []string, prevtxs []btc.PrevTx,
+) (_ *wire.MsgTx, _ bool, err error) {
+	ctx, span := b.start(ctx, "SignRawTransactionWithKey")
+	defer b.observe("SignRawTransactionWithKey", span, time.Now(), &err)
+	return b.Bitcoiner.SignRawTransactionWithKey(ctx, tx, privKeysWIF, prevtxs)
+}
+
+func (b *instrumentedBitcoiner) SendTransactionByHex(ctx context.Context, hex string) (_ *chainhash.Hash, err error) {
+	ctx, span := b.start(ctx, "SendTransactionByHex")
+	defer b.observe("SendTransactionByHex", span, time.Now(), &err)
+	return b.Bitcoiner.SendTransactionByHex(ctx, hex)
+}
+
+func (b *instrumentedBitcoiner) SendTransactionByByte(
+	ctx context.Context, rawTx []byte,
+) (_ *chainhash.Hash, err error) {
+	ctx, span := b.start(ctx, "SendTransactionByByte")
+	defer b.observe("SendTransactionByByte", span, time.Now(), &err)
+	return b.Bitcoiner.SendTransactionByByte(ctx, rawTx)
+}
+
+func (b *instrumentedBitcoiner) ListUnspent(
+	ctx context.Context, confirmationNum uint64,
+) (_ []btc.ListUnspentResult, err error) {
+	ctx, span := b.start(ctx, "ListUnspent")
+	defer b.observe("ListUnspent", span, time.Now(), &err)
+	return b.Bitcoiner.ListUnspent(ctx, confirmationNum)
+}
+
+func (b *instrumentedBitcoiner) ListUnspentByAccount(
+	ctx context.Context, accountType domainAccount.AccountType, confirmationNum uint64,
+) (_ []btc.ListUnspentResult, err error) {
+	ctx, span := b.start(ctx, "ListUnspentByAccount")
+	defer b.observe("ListUnspentByAccount", span, time.Now(), &err)
+	return b.Bitcoiner.ListUnspentByAccount(ctx, accountType, confirmationNum)
+}
+
+func (b *instrumentedBitcoiner) LockUnspent(ctx context.Context, tx *btc.ListUnspentResult) (err error) {
+	ctx, span := b.start(ctx, "LockUnspent")
+	defer b.observe("LockUnspent", span, time.Now(), &err)
+	return b.Bitcoiner.LockUnspent(ctx, tx)
+}
+
+func (b *instrumentedBitcoiner) UnlockUnspent(ctx context.Context) (err error) {
+	ctx, span := b.start(ctx, "UnlockUnspent")
+	defer b.observe("UnlockUnspent", span, time.Now(), &err)
+	return b.Bitcoiner.UnlockUnspent(ctx)
+}
+
+func (b *instrumentedBitcoiner) BackupWallet(ctx context.Context, fileName string) (err error) {
+	ctx, span := b.start(ctx, "BackupWallet")
+	defer b.observe("BackupWallet", span, time.Now(), &err)
+	return b.Bitcoiner.BackupWallet(ctx, fileName)
+}
+
+func (b *instrumentedBitcoiner) DumpWallet(ctx context.Context, fileName string) (err error) {
+	ctx, span := b.start(ctx, "DumpWallet")
+	defer b.observe("DumpWallet", span, time.Now(), &err)
+	return b.Bitcoiner.DumpWallet(ctx, fileName)
+}
+
+func (b *instrumentedBitcoiner) ImportWallet(ctx context.Context, fileName string) (err error) {
+	ctx, span := b.start(ctx, "ImportWallet")
+	defer b.observe("ImportWallet", span, time.Now(), &err)
+	return b.Bitcoiner.ImportWallet(ctx, fileName)
+}
+
+func (b *instrumentedBitcoiner) EncryptWallet(ctx context.Context, passphrase string) (err error) {
+	ctx, span := b.start(ctx, "EncryptWallet")
+	defer b.observe("EncryptWallet", span, time.Now(), &err)
+	return b.Bitcoiner.EncryptWallet(ctx, passphrase)
+}
+
+func (b *instrumentedBitcoiner) WalletLock(ctx context.Context) (err error) {
+	ctx, span := b.start(ctx, "WalletLock")
+	defer b.observe("WalletLock", span, time.Now(), &err)
+	return b.Bitcoiner.WalletLock(ctx)
+}
+
+func (b *instrumentedBitcoiner) WalletPassphrase(
+	ctx context.Context, passphrase string, timeoutSecs int64,
+) (err error) {
+	ctx, span := b.start(ctx, "WalletPassphrase")
+	defer b.observe("WalletPassphrase", span, time.Now(), &err)
+	return b.Bitcoiner.WalletPassphrase(ctx, passphrase, timeoutSecs)
+}
+
+func (b *instrumentedBitcoiner) WalletPassphraseChange(ctx context.Context, old, newPass string) (err error) {
+	ctx, span := b.start(ctx, "WalletPassphraseChange")
+	defer b.observe("WalletPassphraseChange", span, time.Now(), &err)
+	return b.Bitcoiner.WalletPassphraseChange(ctx, old, newPass)
+}
+
+func (b *instrumentedBitcoiner) LoadWallet(ctx context.Context, fileName string) (err error) {
+	ctx, span := b.start(ctx, "LoadWallet")
+	defer b.observe("LoadWallet", span, time.Now(), &err)
+	return b.Bitcoiner.LoadWallet(ctx, fileName)
+}
+
+func (b *instrumentedBitcoiner) UnLoadWallet(ctx context.Context, fileName string) (err error) {
+	ctx, span := b.start(ctx, "UnLoadWallet")
+	defer b.observe("UnLoadWallet", span, time.Now(), &err)
+	return b.Bitcoiner.UnLoadWallet(ctx, fileName)
+}
+
+func (b *instrumentedBitcoiner) CreateWallet(ctx context.Context, fileName string, disablePrivKey bool) (err error) {
+	ctx, span := b.start(ctx, "CreateWallet")
+	defer b.observe("CreateWallet", span, time.Now(), &err)
+	return b.Bitcoiner.CreateWallet(ctx, fileName, disablePrivKey)
+}
@@ -0,0 +1,83 @@
+package bitcoin_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// fakeBitcoiner implements only methods used in test
+type fakeBitcoiner struct {
+	bitcoin.Bitcoiner
+}
+
+func (*fakeBitcoiner) CoinTypeCode() domainCoin.CoinTypeCode {
+	return domainCoin.BCH
+}
+
+func (*fakeBitcoiner) GetBlockCount(_ context.Context) (int64, error) {
+	return 100, nil
+}
+
+func (*fakeBitcoiner) GetBalance(_ context.Context) (btcutil.Amount, error) {
+	return 0, errors.New("connection refused")
+}
+
+func (*fakeBitcoiner) AmountString(amt btcutil.Amount) string {
+	return amt.String()
+}
+
+// TestInstrumentedBitcoiner tests RPC metrics and spans are recorded per method
+func TestInstrumentedBitcoiner(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	bit := bitcoin.NewInstrumentedBitcoiner(&fakeBitcoiner{})
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+	count, err := bit.GetBlockCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), count)
+	_, err = bit.GetBalance(ctx)
+	require.Error(t, err)
+	// method not calling RPC is not recorded
+	assert.Equal(t, "1 BTC", bit.AmountString(btcutil.SatoshiPerBitcoin))
+
+	expected := `
+# HELP wallet_rpc_errors_total Number of failed RPC calls to node per method.
+# TYPE wallet_rpc_errors_total counter
+wallet_rpc_errors_total{coin="bch",method="GetBalance"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(
+		metrics.Registry(), strings.NewReader(expected), "wallet_rpc_errors_total"))
+
+	calls, err := testutil.GatherAndCount(metrics.Registry(), "wallet_rpc_duration_seconds")
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls, "GetBlockCount and GetBalance")
+
+	parent.End()
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	assert.Equal(t, "bitcoin.GetBlockCount", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, "bitcoin.GetBalance", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	// RPC spans are children of span in context
+	assert.Equal(t, spans[2].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, spans[2].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+}
@@ -0,0 +1,41 @@
+package ltc
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/rpcclient"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/btc"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+// Litecoin embeds Bitcoin
+//
+// litecoind is fork of bitcoin core and provides the same RPC,
+// so only chain params for keys and addresses are replaced
+type Litecoin struct {
+	btc.Bitcoin
+}
+
+// NewLitecoin litecoin instance based on Bitcoin
+func NewLitecoin(
+	client *rpcclient.Client,
+	coinTypeCode domainCoin.CoinTypeCode,
+	conf *config.Bitcoin,
+) (*Litecoin, error) {
+	// bitcoin base, network is validated by `getblockchaininfo` which returns the same chain names
+	bit, err := btc.NewBitcoin(client, conf, coinTypeCode)
+	if err != nil {
+		return nil, fmt.Errorf("btc.NewBitcoin() error: %w", err)
+	}
+
+	chainConf, err := ChainParams(bit.GetChainConf())
+	if err != nil {
+		return nil, err
+	}
+	ltc := Litecoin{Bitcoin: *bit}
+	ltc.SetChainConf(chainConf)
+
+	return &ltc, nil
+}
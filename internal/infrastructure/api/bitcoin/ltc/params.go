@@ -0,0 +1,102 @@
+package ltc
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// refer to [github.com/ltcsuite/ltcd](https://github.com/ltcsuite/ltcd/blob/master/chaincfg/params.go)
+//
+// only parameters used for keys and addresses are overridden,
+// genesis block and checkpoints of bitcoin remain because blocks are validated by litecoind
+
+const (
+	// MainnetMagic represents the main litecoin network.
+	MainnetMagic wire.BitcoinNet = 0xdbb6c0fb
+
+	// TestnetMagic represents the test network (version 4).
+	TestnetMagic wire.BitcoinNet = 0xf1c8d2fd
+
+	// RegtestMagic represents the regression test network, which is the same as bitcoin.
+	RegtestMagic wire.BitcoinNet = 0xdab5bffa
+
+	// regtestRegisteredMagic is used only to register regtest params to chaincfg
+	regtestRegisteredMagic wire.BitcoinNet = 0x7274636c
+)
+
+// MainNetParams defines the network parameters for the main litecoin network.
+var MainNetParams = newParams(chaincfg.MainNetParams, func(p *chaincfg.Params) {
+	p.Name = "mainnet"
+	p.Net = MainnetMagic
+	p.DefaultPort = "9333"
+	p.Bech32HRPSegwit = "ltc"
+	p.PubKeyHashAddrID = 0x30 // starts with L
+	p.ScriptHashAddrID = 0x32 // starts with M
+	p.PrivateKeyID = 0xb0
+	p.WitnessPubKeyHashAddrID = 0x06
+	p.WitnessScriptHashAddrID = 0x0a
+	p.HDCoinType = 2
+})
+
+// TestNet4Params defines the network parameters for the test litecoin network (version 4).
+var TestNet4Params = newParams(chaincfg.TestNet3Params, func(p *chaincfg.Params) {
+	p.Name = "testnet4"
+	p.Net = TestnetMagic
+	p.DefaultPort = "19335"
+	p.Bech32HRPSegwit = "tltc"
+	p.PubKeyHashAddrID = 0x6f // starts with m or n
+	p.ScriptHashAddrID = 0x3a // starts with Q
+	p.PrivateKeyID = 0xef
+	p.WitnessPubKeyHashAddrID = 0x52
+	p.WitnessScriptHashAddrID = 0x31
+	p.HDCoinType = 1
+})
+
+// RegressionNetParams defines the network parameters for the regression test litecoin network.
+var RegressionNetParams = newParams(chaincfg.RegressionNetParams, func(p *chaincfg.Params) {
+	p.Name = "regtest"
+	p.Net = RegtestMagic
+	p.DefaultPort = "19444"
+	p.Bech32HRPSegwit = "rltc"
+	p.PubKeyHashAddrID = 0x6f // starts with m or n
+	p.ScriptHashAddrID = 0x3a // starts with Q
+	p.PrivateKeyID = 0xef
+	p.WitnessPubKeyHashAddrID = 0x52
+	p.WitnessScriptHashAddrID = 0x31
+	p.HDCoinType = 1
+})
+
+func newParams(base chaincfg.Params, override func(p *chaincfg.Params)) chaincfg.Params {
+	override(&base)
+	return base
+}
+
+// params registered to chaincfg are required to decode bech32 address by btcutil.DecodeAddress()
+//
+// litecoin regtest shares magic with bitcoin regtest, which is rejected as duplicated network by chaincfg,
+// so copy with dummy magic is registered only to add address prefixes
+func init() {
+	regtest := RegressionNetParams
+	regtest.Net = regtestRegisteredMagic
+	for _, params := range []*chaincfg.Params{&MainNetParams, &TestNet4Params, &regtest} {
+		if err := chaincfg.Register(params); err != nil {
+			panic("fail to register litecoin params " + params.Name + ": " + err.Error())
+		}
+	}
+}
+
+// ChainParams returns litecoin params corresponding to bitcoin params of same network
+func ChainParams(conf *chaincfg.Params) (*chaincfg.Params, error) {
+	switch conf.Name {
+	case chaincfg.MainNetParams.Name:
+		return &MainNetParams, nil
+	case chaincfg.TestNet3Params.Name:
+		return &TestNet4Params, nil
+	case chaincfg.RegressionNetParams.Name:
+		return &RegressionNetParams, nil
+	default:
+		return nil, fmt.Errorf("network %s is not supported by litecoin", conf.Name)
+	}
+}
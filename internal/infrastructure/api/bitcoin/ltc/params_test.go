@@ -0,0 +1,83 @@
+package ltc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainParams(t *testing.T) {
+	tests := []struct {
+		name         string
+		btcParams    *chaincfg.Params
+		want         *chaincfg.Params
+		p2pkhPrefix  string
+		bech32Prefix string
+		xprvPrefix   string
+		isErr        bool
+	}{
+		{
+			name:         "mainnet",
+			btcParams:    &chaincfg.MainNetParams,
+			want:         &MainNetParams,
+			p2pkhPrefix:  "L",
+			bech32Prefix: "ltc1q",
+			xprvPrefix:   "xprv",
+		},
+		{
+			name:         "testnet",
+			btcParams:    &chaincfg.TestNet3Params,
+			want:         &TestNet4Params,
+			bech32Prefix: "tltc1q",
+			xprvPrefix:   "tprv",
+		},
+		{
+			name:         "regtest",
+			btcParams:    &chaincfg.RegressionNetParams,
+			want:         &RegressionNetParams,
+			bech32Prefix: "rltc1q",
+			xprvPrefix:   "tprv",
+		},
+		{
+			name:      "signet is not supported",
+			btcParams: &chaincfg.SigNetParams,
+			isErr:     true,
+		},
+	}
+
+	pkHash := btcutil.Hash160([]byte("public key"))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := ChainParams(tt.btcParams)
+			if tt.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, params)
+
+			p2pkh, err := btcutil.NewAddressPubKeyHash(pkHash, params)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(p2pkh.EncodeAddress(), tt.p2pkhPrefix), p2pkh.EncodeAddress())
+
+			bech32, err := btcutil.NewAddressWitnessPubKeyHash(pkHash, params)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(bech32.EncodeAddress(), tt.bech32Prefix), bech32.EncodeAddress())
+
+			// bech32 address is decoded as litecoin address
+			decoded, err := btcutil.DecodeAddress(bech32.EncodeAddress(), params)
+			require.NoError(t, err)
+			assert.True(t, decoded.IsForNet(params))
+			assert.False(t, decoded.IsForNet(tt.btcParams))
+
+			master, err := hdkeychain.NewMaster(make([]byte, hdkeychain.RecommendedSeedLen), params)
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(master.String(), tt.xprvPrefix))
+		})
+	}
+}
@@ -112,6 +112,45 @@ type ERC20er interface {
 // EtherTxCreator is a type alias for ERC20er used in transaction creation contexts
 type EtherTxCreator = ERC20er
 
+// Safer Safe multisig contract Interface
+type Safer interface {
+	Executor() string
+	Address(accountType domainAccount.AccountType) string
+	Nonce(ctx context.Context, safeAddr string) (uint64, error)
+	Threshold(ctx context.Context, safeAddr string) (uint64, error)
+	CreateTransaction(
+		ctx context.Context, safeAddr, toAddr string, amount uint64, additionalNonce int,
+	) (*ethtx.RawTx, *models.EthDetailTX, error)
+	SignTransaction(rawTx *ethtx.RawTx, privKey string) (bool, error)
+	ExecTransaction(ctx context.Context, rawTx *ethtx.RawTx, executor *ecdsa.PrivateKey) (string, string, error)
+	Deploy(
+		ctx context.Context, owners []string, threshold, saltNonce uint64, executor *ecdsa.PrivateKey,
+	) (string, string, error)
+}
+
+// Forwarder CREATE2 forwarder Interface
+type Forwarder interface {
+	Factory() string
+	Address(salt uint64) string
+	Destination(ctx context.Context) (string, error)
+	HasBalance(ctx context.Context, forwarderAddr string) (bool, error)
+	CreateSweepTransaction(
+		ctx context.Context, fromAddr string, salts []uint64, additionalNonce int,
+	) (*ethtx.RawTx, *models.EthDetailTX, error)
+	Deploy(ctx context.Context, destination string, deployer *ecdsa.PrivateKey) (string, string, error)
+}
+
+// DepositScanner deposit scanner Interface
+//   - Name is used as name of stream cursor
+//   - TokenContracts are token_contract of deposits which are detected, empty string is native coin
+type DepositScanner interface {
+	Name() string
+	TokenContracts() []string
+	BlockNumber(ctx context.Context) (uint64, error)
+	BlockHash(ctx context.Context, number uint64) (string, error)
+	Scan(ctx context.Context, fromBlock, toBlock uint64, addrs []string) ([]*models.EthDeposit, error)
+}
+
 type EtherTxMonitor interface {
 	GetTotalBalance(ctx context.Context, addrs []string) (*big.Int, []eth.UserAmount)
 	GetConfirmation(ctx context.Context, hashTx string) (uint64, error)
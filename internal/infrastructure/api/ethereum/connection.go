@@ -17,8 +17,12 @@ import (
 )
 
 // NewRPCClient try to connect Ethereum node RPC Server to create client instance
+//   - rpc_url is used for RPC endpoint of any EVM chain, otherwise host and port are used
 func NewRPCClient(conf *config.Ethereum) (*ethrpc.Client, error) {
 	url := "http://" + net.JoinHostPort(conf.Host, strconv.Itoa(conf.Port))
+	if conf.RPCURL != "" {
+		url = conf.RPCURL
+	}
 	if conf.IPCPath != "" {
 		log.Println("IPC connection")
 		url = conf.IPCPath
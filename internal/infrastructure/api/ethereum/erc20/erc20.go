@@ -8,7 +8,6 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"golang.org/x/crypto/sha3"
 
@@ -29,6 +28,8 @@ type ERC20 struct {
 	tokenClient     *contract.Token
 	token           domainCoin.ERC20Token
 	uuidHandler     uuid.UUIDHandler
+	chainID         *big.Int
+	feeModel        string
 	name            string
 	contractAddress string
 	masterAddress   string
@@ -40,6 +41,8 @@ func NewERC20(
 	tokenClient *contract.Token,
 	token domainCoin.ERC20Token,
 	uuidHandler uuid.UUIDHandler,
+	chainID uint64,
+	feeModel string,
 	name string,
 	contractAddress string,
 	masterAddress string,
@@ -50,6 +53,8 @@ func NewERC20(
 		tokenClient:     tokenClient,
 		token:           token,
 		uuidHandler:     uuidHandler,
+		chainID:         new(big.Int).SetUint64(chainID),
+		feeModel:        feeModel,
 		name:            name,
 		contractAddress: contractAddress,
 		masterAddress:   masterAddress,
@@ -131,6 +136,11 @@ func (e *ERC20) CreateRawTransaction(
 		"amount", amount,
 	)
 
+	// transaction must be created on the chain of config
+	if err := eth.ValidateNodeChainID(ctx, e.client, e.chainID); err != nil {
+		return nil, nil, err
+	}
+
 	balance, err := e.GetBalance(ctx, fromAddr, "")
 	if err != nil {
 		return nil, nil, fmt.Errorf("fail to call eth.GetBalance(): %w", err)
@@ -150,9 +160,9 @@ func (e *ERC20) CreateRawTransaction(
 		return nil, nil, fmt.Errorf("fail to call estimateGas(data): %w", err)
 	}
 
-	gasPrice, err := e.client.SuggestGasPrice(ctx)
+	gasFee, err := eth.SuggestGasFee(ctx, e.client, e.feeModel)
 	if err != nil {
-		return nil, nil, fmt.Errorf("fail to call client.SuggestGasPrice(): %w", err)
+		return nil, nil, fmt.Errorf("fail to call eth.SuggestGasFee(): %w", err)
 	}
 
 	// nonce
@@ -165,19 +175,12 @@ func (e *ERC20) CreateRawTransaction(
 		"Nonce", nonce,
 		"TokenAmount", tokenAmount.Uint64(),
 		"GasLimit", gasLimit,
-		"GasPrice", gasPrice.Uint64(),
+		"GasPrice", gasFee.GasFeeCap.Uint64(),
 	)
 
-	// create transaction
+	// create transaction, value must be 0 for ERC-20
 	contractAddr := common.HexToAddress(e.contractAddress)
-	tx := types.NewTx(&types.LegacyTx{
-		Nonce:    nonce,
-		To:       &contractAddr,
-		Value:    new(big.Int), // value must be 0 for ERC-20
-		Gas:      gasLimit,
-		GasPrice: gasPrice,
-		Data:     data,
-	})
+	tx := ethtx.NewTx(e.chainID, nonce, contractAddr, new(big.Int), gasLimit, gasFee, data)
 	// From here, same as CreateRawTransaction() in ethgrop/eth/transaction.go
 	txHash := tx.Hash().Hex()
 	rawTxHex, err := ethtx.EncodeTx(tx)
@@ -207,13 +210,14 @@ func (e *ERC20) CreateRawTransaction(
 
 	// RawTx
 	rawtx := &ethtx.RawTx{
-		UUID:  uid.String(),
-		From:  fromAddr,
-		To:    toAddr,
-		Value: *tokenAmount,
-		Nonce: nonce,
-		TxHex: *rawTxHex,
-		Hash:  txHash,
+		UUID:    uid.String(),
+		ChainID: e.chainID.Uint64(),
+		From:    fromAddr,
+		To:      toAddr,
+		Value:   *tokenAmount,
+		Nonce:   nonce,
+		TxHex:   *rawTxHex,
+		Hash:    txHash,
 	}
 	return rawtx, txDetailItem, nil
 }
@@ -0,0 +1,76 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+// ErrChainIDMismatch means transaction or node belongs to different chain from config
+var ErrChainIDMismatch = errors.New("chain id doesn't match config")
+
+// GasFeeSuggester is client to suggest gas fee, ethclient.Client satisfies it
+type GasFeeSuggester interface {
+	ethereum.GasPricer
+	ethereum.GasPricer1559
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// ValidateNodeChainID returns error if node is connected to different chain from config
+func ValidateNodeChainID(ctx context.Context, client ethereum.ChainIDReader, chainID *big.Int) error {
+	nodeChainID, err := client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to call client.ChainID(): %w", err)
+	}
+	if nodeChainID.Cmp(chainID) != 0 {
+		return fmt.Errorf("node is connected to chain %d, config is %d: %w", nodeChainID, chainID, ErrChainIDMismatch)
+	}
+	return nil
+}
+
+// SuggestGasFee returns gas fee by fee model
+//   - legacy: gas price by eth_gasPrice
+//   - eip1559: max fee is 2 * base fee + tip, so transaction is included even if base fee increases for some blocks
+func SuggestGasFee(ctx context.Context, client GasFeeSuggester, feeModel string) (*ethtx.GasFee, error) {
+	if feeModel != config.FeeModelEIP1559 {
+		gasPrice, err := client.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call client.SuggestGasPrice(): %w", err)
+		}
+		return &ethtx.GasFee{GasFeeCap: gasPrice}, nil
+	}
+
+	tip, err := client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call client.SuggestGasTipCap(): %w", err)
+	}
+	header, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call client.HeaderByNumber(): %w", err)
+	}
+	if header.BaseFee == nil {
+		return nil, errors.New("base fee is not found in latest block, use legacy fee_model")
+	}
+	feeCap := new(big.Int).Add(new(big.Int).Mul(header.BaseFee, big.NewInt(2)), tip)
+	return &ethtx.GasFee{GasFeeCap: feeCap, GasTipCap: tip}, nil
+}
+
+// validateTxChainID returns error if chain ID of transaction is different from config
+//   - dynamic fee transaction includes chain ID, legacy transaction relies on chain ID of RawTx
+func validateTxChainID(rawTx *ethtx.RawTx, txChainID, chainID *big.Int) error {
+	if rawTx.ChainID != chainID.Uint64() {
+		return fmt.Errorf("transaction is created for chain %d, config is %d: %w",
+			rawTx.ChainID, chainID, ErrChainIDMismatch)
+	}
+	if txChainID != nil && txChainID.Cmp(chainID) != 0 {
+		return fmt.Errorf("transaction includes chain %d, config is %d: %w", txChainID, chainID, ErrChainIDMismatch)
+	}
+	return nil
+}
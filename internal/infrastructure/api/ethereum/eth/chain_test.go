@@ -0,0 +1,87 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
+)
+
+// TestValidateTxChainID is test for validateTxChainID
+func TestValidateTxChainID(t *testing.T) {
+	sepolia := big.NewInt(11155111)
+	polygon := big.NewInt(137)
+	to := common.HexToAddress("0x328F371a76dfAc47b89Cc007bb048ec446c21494")
+	legacyFee := &ethtx.GasFee{GasFeeCap: big.NewInt(1_000_000_000)}
+	dynamicFee := &ethtx.GasFee{GasFeeCap: big.NewInt(2_000_000_000), GasTipCap: big.NewInt(1_000_000_000)}
+
+	tests := []struct {
+		name         string
+		txChainID    *big.Int
+		rawTxChainID uint64
+		fee          *ethtx.GasFee
+		wantErr      bool
+	}{
+		{
+			name:         "legacy transaction of same chain",
+			txChainID:    sepolia,
+			rawTxChainID: sepolia.Uint64(),
+			fee:          legacyFee,
+		},
+		{
+			name:         "legacy transaction of other chain",
+			txChainID:    polygon,
+			rawTxChainID: polygon.Uint64(),
+			fee:          legacyFee,
+			wantErr:      true,
+		},
+		{
+			name:         "legacy transaction without chain id",
+			txChainID:    sepolia,
+			rawTxChainID: 0,
+			fee:          legacyFee,
+			wantErr:      true,
+		},
+		{
+			name:         "dynamic fee transaction of same chain",
+			txChainID:    sepolia,
+			rawTxChainID: sepolia.Uint64(),
+			fee:          dynamicFee,
+		},
+		{
+			name:         "dynamic fee transaction includes other chain",
+			txChainID:    polygon,
+			rawTxChainID: sepolia.Uint64(),
+			fee:          dynamicFee,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := ethtx.NewTx(tt.txChainID, 0, to, big.NewInt(1), 21000, tt.fee, nil)
+			// encoded transaction is decoded by sign wallet
+			txHex, err := ethtx.EncodeTx(tx)
+			require.NoError(t, err)
+			decoded, err := ethtx.DecodeTx(*txHex)
+			require.NoError(t, err)
+
+			var txChainID *big.Int
+			if decoded.Type() == types.DynamicFeeTxType {
+				txChainID = decoded.ChainId()
+			} else {
+				assert.Equal(t, uint8(types.LegacyTxType), decoded.Type())
+			}
+			err = validateTxChainID(&ethtx.RawTx{ChainID: tt.rawTxChainID}, txChainID, sepolia)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrChainIDMismatch)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
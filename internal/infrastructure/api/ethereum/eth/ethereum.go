@@ -3,6 +3,7 @@ package eth
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/btcsuite/btcd/chaincfg"
@@ -22,7 +23,10 @@ type Ethereum struct {
 	chainConf    *chaincfg.Params
 	coinTypeCode domainCoin.CoinTypeCode
 	uuidHandler  uuid.UUIDHandler
-	netID        uint16
+	chainID      *big.Int
+	feeModel     string
+	symbol       string
+	decimals     int
 	version      string
 	keyDir       string
 	isParity     bool
@@ -42,6 +46,10 @@ func NewEthereum(
 		rpcClient:    rpcClient,
 		coinTypeCode: coinTypeCode,
 		uuidHandler:  uuidHandler,
+		chainID:      new(big.Int).SetUint64(conf.ChainID),
+		feeModel:     conf.FeeModel,
+		symbol:       conf.Symbol,
+		decimals:     conf.Decimals,
 		keyDir:       conf.KeyDirName,
 	}
 
@@ -55,18 +63,17 @@ func NewEthereum(
 	}
 	logger.Debug("eth.keyDir", "eth.keyDir", eth.keyDir)
 
-	// get NetID
-	netID, err := eth.NetVersion(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("fail to call eth.NetVersion(): %w", err)
-	}
-	eth.netID = netID
-
-	if netID == 1 {
+	// chain conf decides coin type of key derivation
+	if conf.IsMainnet() {
 		eth.chainConf = &chaincfg.MainNetParams
 	} else {
 		eth.chainConf = &chaincfg.TestNet3Params
 	}
+	logger.Info("evm chain",
+		"chainID", conf.ChainID,
+		"symbol", conf.Symbol,
+		"feeModel", conf.FeeModel,
+	)
 
 	// get client version
 	clientVer, err := eth.ClientVersion(ctx)
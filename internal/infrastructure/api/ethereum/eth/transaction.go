@@ -96,15 +96,20 @@ func (e *Ethereum) CreateRawTransaction(
 		"amount", amount,
 	)
 
+	// transaction must be created on the chain of config
+	if err := ValidateNodeChainID(ctx, e.ethClient, e.chainID); err != nil {
+		return nil, nil, err
+	}
+
 	// TODO: pending status should be included in target balance??
 	// TODO: if block is still syncing, proper balance is not returned
 	balance, err := e.GetBalance(ctx, fromAddr, QuantityTagPending)
 	if err != nil {
 		return nil, nil, fmt.Errorf("fail to call eth.GetBalance(): %w", err)
 	}
-	logger.Info("balance", "balance", balance.Int64())
+	logger.Info("balance", "balance", balance.Int64(), "symbol", e.symbol)
 	if balance.Uint64() == 0 {
-		return nil, nil, errors.New("balance is needed to send eth")
+		return nil, nil, fmt.Errorf("balance is needed to send %s", e.symbol)
 	}
 
 	// nonce
@@ -113,12 +118,12 @@ func (e *Ethereum) CreateRawTransaction(
 		return nil, nil, fmt.Errorf("fail to call eth.getNonce(): %w", err)
 	}
 
-	// gasPrice
-	gasPrice, err := e.GasPrice(ctx)
+	// gas price, max fee is used to calculate fee for EIP-1559
+	gasFee, err := SuggestGasFee(ctx, e.ethClient, e.feeModel)
 	if err != nil {
-		return nil, nil, fmt.Errorf("fail to call eth.GasPrice(): %w", err)
+		return nil, nil, fmt.Errorf("fail to call eth.SuggestGasFee(): %w", err)
 	}
-	logger.Info("gas_price", "gas_price", gasPrice.Int64())
+	logger.Info("gas_price", "gas_price", gasFee.GasFeeCap.Int64(), "eip1559", gasFee.IsEIP1559())
 
 	// fromAddr, toAddr common.Address, gasPrice, value *big.Int
 	newValue, txFee, estimatedGas, err := e.calculateFee(
@@ -126,7 +131,7 @@ func (e *Ethereum) CreateRawTransaction(
 		common.HexToAddress(fromAddr),
 		common.HexToAddress(toAddr),
 		balance,
-		gasPrice,
+		gasFee.GasFeeCap,
 		new(big.Int).SetUint64(amount),
 	)
 	if err != nil {
@@ -134,19 +139,12 @@ func (e *Ethereum) CreateRawTransaction(
 	}
 
 	logger.Debug("tx parameter",
-		"GasLimit", GasLimit,
 		"estimatedGas", estimatedGas.Uint64(),
 		"txFee", txFee.Uint64())
 
 	// create transaction
-	tmpToAddr := common.HexToAddress(toAddr)
-	tx := types.NewTx(&types.LegacyTx{
-		Nonce:    nonce,
-		To:       &tmpToAddr,
-		Value:    newValue,
-		Gas:      GasLimit,
-		GasPrice: gasPrice,
-	})
+	// estimated gas is used as gas limit because transfer costs more than 21000 on some L2 chains
+	tx := ethtx.NewTx(e.chainID, nonce, common.HexToAddress(toAddr), newValue, estimatedGas.Uint64(), gasFee, nil)
 	txHash := tx.Hash().Hex()
 	rawTxHex, err := ethtx.EncodeTx(tx)
 	if err != nil {
@@ -175,13 +173,14 @@ func (e *Ethereum) CreateRawTransaction(
 
 	// RawTx
 	rawtx := &ethtx.RawTx{
-		UUID:  uid.String(),
-		From:  fromAddr,
-		To:    toAddr,
-		Value: *newValue,
-		Nonce: nonce,
-		TxHex: *rawTxHex,
-		Hash:  txHash,
+		UUID:    uid.String(),
+		ChainID: e.chainID.Uint64(),
+		From:    fromAddr,
+		To:      toAddr,
+		Value:   *newValue,
+		Nonce:   nonce,
+		TxHex:   *rawTxHex,
+		Hash:    txHash,
 	}
 	return rawtx, txDetailItem, nil
 }
@@ -197,26 +196,29 @@ func (e *Ethereum) SignOnRawTransaction(rawTx *ethtx.RawTx, passphrase string) (
 		return nil, fmt.Errorf("fail to call decodeTx(txHex): %w", err)
 	}
 
+	// chain id must be checked before signing not to replay transaction on other chain
+	// https://github.com/ethereum/EIPs/blob/master/EIPS/eip-155.md
+	var txChainID *big.Int
+	if tx.Type() != types.LegacyTxType {
+		txChainID = tx.ChainId()
+	}
+	if err = validateTxChainID(rawTx, txChainID, e.chainID); err != nil {
+		return nil, err
+	}
+
 	// get private key
 	key, err := e.GetPrivKey(fromAddr, passphrase)
 	if err != nil {
 		return nil, fmt.Errorf("fail to call e.GetPrivKey(): %w", err)
 	}
 
-	// chain id
-	// https://github.com/ethereum/EIPs/blob/master/EIPS/eip-155.md
-	chainID := big.NewInt(int64(e.netID))
-	if chainID.Uint64() == 0 {
-		return nil, fmt.Errorf("chainID can't get from netID:  %d", e.netID)
-	}
-
 	logger.Debug("call types.SignTx",
 		"tx", tx,
-		"chainID", chainID.Uint64(),
+		"chainID", e.chainID.Uint64(),
 		"key.PrivateKey", key.PrivateKey,
 	)
 	// var signer types.Signer = types.NewEIP155Signer(chainID)
-	signer := types.NewLondonSigner(chainID)
+	signer := types.NewLondonSigner(e.chainID)
 
 	// sign
 	signedTX, err := types.SignTx(tx, signer, key.PrivateKey)
@@ -236,13 +238,14 @@ func (e *Ethereum) SignOnRawTransaction(rawTx *ethtx.RawTx, passphrase string) (
 	}
 
 	resTx := &ethtx.RawTx{
-		UUID:  rawTx.UUID,
-		From:  fromSignedAddr.Hex(),
-		To:    signedTX.To().Hex(),
-		Value: *signedTX.Value(),
-		Nonce: signedTX.Nonce(),
-		TxHex: *encodedTx,
-		Hash:  signedTX.Hash().Hex(),
+		UUID:    rawTx.UUID,
+		ChainID: rawTx.ChainID,
+		From:    fromSignedAddr.Hex(),
+		To:      signedTX.To().Hex(),
+		Value:   *signedTX.Value(),
+		Nonce:   signedTX.Nonce(),
+		TxHex:   *encodedTx,
+		Hash:    signedTX.Hash().Hex(),
 	}
 
 	return resTx, nil
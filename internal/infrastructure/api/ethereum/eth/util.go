@@ -3,6 +3,7 @@ package eth
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum"
@@ -131,7 +132,11 @@ func (*Ethereum) FromFloatEther(v float64) *big.Int {
 	return big.NewInt(int64(v * params.Ether))
 }
 
-// FloatToBigInt is alias of FromFloatEther for interface
+// FloatToBigInt converts native coin(float64) to smallest unit(*big.Int) by decimals of config
+//   - it's same as FromFloatEther when decimals is 18
 func (e *Ethereum) FloatToBigInt(v float64) *big.Int {
-	return e.FromFloatEther(v)
+	if e.decimals == 0 {
+		return e.FromFloatEther(v)
+	}
+	return big.NewInt(int64(v * math.Pow10(e.decimals)))
 }
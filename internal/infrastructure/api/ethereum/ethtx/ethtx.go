@@ -4,20 +4,98 @@ import (
 	"bytes"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
 // RawTx is raw transaction
+//   - ChainID is kept because unsigned legacy transaction doesn't include chain ID
+//   - Safe is set when sender is Safe multisig contract, then From is Safe address and Hash is safeTxHash
 type RawTx struct {
-	UUID  string  `json:"uuid"`
-	From  string  `json:"from"`
-	To    string  `json:"to"`
-	Value big.Int `json:"value"`
-	Nonce uint64  `json:"nonce"`
-	TxHex string  `json:"txhex"`
-	Hash  string  `json:"hash"`
+	UUID    string  `json:"uuid"`
+	ChainID uint64  `json:"chain_id"`
+	From    string  `json:"from"`
+	To      string  `json:"to"`
+	Value   big.Int `json:"value"`
+	Nonce   uint64  `json:"nonce"`
+	TxHex   string  `json:"txhex"`
+	Hash    string  `json:"hash"`
+	Safe    *SafeTx `json:"safe,omitempty"`
+}
+
+// SafeTx is transaction of Safe multisig contract which is signed by owners
+//   - operation is call and gas refund isn't used, so those fields are always zero
+type SafeTx struct {
+	To         string          `json:"to"`
+	Value      big.Int         `json:"value"`
+	Data       []byte          `json:"data"`
+	Nonce      uint64          `json:"nonce"`
+	Threshold  uint64          `json:"threshold"`
+	Signatures []SafeSignature `json:"signatures"`
+}
+
+// SafeSignature is signature of owner over safeTxHash
+type SafeSignature struct {
+	Owner     string `json:"owner"`
+	Signature []byte `json:"signature"`
+}
+
+// IsSigned returns true if signatures reach threshold
+func (s *SafeTx) IsSigned() bool {
+	return uint64(len(s.Signatures)) >= s.Threshold
+}
+
+// GasFee is gas price of transaction
+//   - GasTipCap is nil for legacy transaction, then GasFeeCap is used as gas price
+type GasFee struct {
+	GasFeeCap *big.Int
+	GasTipCap *big.Int
+}
+
+// IsEIP1559 returns true if dynamic fee transaction is created
+func (g *GasFee) IsEIP1559() bool {
+	return g.GasTipCap != nil
+}
+
+// NewTx creates legacy transaction or dynamic fee transaction of EIP-1559 by gas fee
+func NewTx(
+	chainID *big.Int, nonce uint64, to common.Address, value *big.Int, gas uint64, fee *GasFee, data []byte,
+) *types.Transaction {
+	return newTx(chainID, nonce, &to, value, gas, fee, data)
+}
+
+// NewContractCreationTx creates transaction which deploys contract by data
+func NewContractCreationTx(
+	chainID *big.Int, nonce uint64, value *big.Int, gas uint64, fee *GasFee, data []byte,
+) *types.Transaction {
+	return newTx(chainID, nonce, nil, value, gas, fee, data)
+}
+
+func newTx(
+	chainID *big.Int, nonce uint64, to *common.Address, value *big.Int, gas uint64, fee *GasFee, data []byte,
+) *types.Transaction {
+	if !fee.IsEIP1559() {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			To:       to,
+			Value:    value,
+			Gas:      gas,
+			GasPrice: fee.GasFeeCap,
+			Data:     data,
+		})
+	}
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: fee.GasTipCap,
+		GasFeeCap: fee.GasFeeCap,
+		Gas:       gas,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	})
 }
 
 func EncodeTx(tx *types.Transaction) (*string, error) {
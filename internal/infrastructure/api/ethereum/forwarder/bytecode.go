@@ -0,0 +1,14 @@
+package forwarder
+
+// Bytecode of ForwarderFactory and Forwarder
+//   - they are assembled from forwarder.easm, see it for the behavior
+
+// FactoryCode is creation code of ForwarderFactory, destination address is appended as constructor argument
+//
+//nolint:lll
+const FactoryCode = "0x61001d3803806101e91461001257600080fd5b8061001d6000396000f3346100205760003560e01c806355c05afe146100335763b269681d14610025575b600080fd5b602080380360003960206000f35b506100e86100e1608039602080380361018039602435600401803560051b9060200181906101a0376020016100e86080206040523060005260ff600b53600435600401803560051b90602001908101905b818110156100df578035806020526055600b2073ffffffffffffffffffffffffffffffffffffffff16803b6100c457816100e860806000f5811415610020575b600080866101806000855af115610020575050602001610084565b006100d5806100136000393360208203526000f36020803803600039600051331461001757366100b057005b471561002e576000808080476000355af1156100b0575b60205b803611156100ae576370a0823160e01b60005230600452602060006024600084355afa156100b05760203d106100b05760005180156100a45763a9059cbb60e01b6000526000356004526024526020600060446000600085355af1156100b0573d156100a657600051156100b0576100a6565b505b602001610031565b005b600080fd0000000000000000000000000000000000000000000000000000000000000000"
+
+// ForwarderInitCode is init code of Forwarder which is deployed by ForwarderFactory with CREATE2
+//
+//nolint:lll
+const ForwarderInitCode = "0x6100d5806100136000393360208203526000f36020803803600039600051331461001757366100b057005b471561002e576000808080476000355af1156100b0575b60205b803611156100ae576370a0823160e01b60005230600452602060006024600084355afa156100b05760203d106100b05760005180156100a45763a9059cbb60e01b6000526000356004526024526020600060446000600085355af1156100b0573d156100a657600051156100b0576100a6565b505b602001610031565b005b600080fd0000000000000000000000000000000000000000000000000000000000000000"
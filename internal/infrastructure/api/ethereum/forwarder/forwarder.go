@@ -0,0 +1,180 @@
+package forwarder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// CREATE2 forwarder
+// - address of client account is counterfactual address of forwarder, it is computed without key
+// - forwarder is deployed when coin or token is swept at first time
+// - all forwarders are swept by one transaction which calls ForwarderFactory.sweep()
+
+// forwarderABI is the part of ForwarderFactory and ERC-20 which is called by wallet
+//
+//nolint:lll
+const forwarderABI = `[
+{"name":"sweep","type":"function","stateMutability":"nonpayable","inputs":[{"name":"salts","type":"uint256[]"},{"name":"tokens","type":"address[]"}],"outputs":[]},
+{"name":"destination","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+{"name":"balanceOf","type":"function","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+// Backend is client of EVM node which Forwarder calls, ethclient.Client and simulated backend satisfy it
+type Backend interface {
+	ethereum.ChainIDReader
+	ethereum.ContractCaller
+	ethereum.GasEstimator
+	ethereum.PendingStateReader
+	ethereum.TransactionSender
+	eth.GasFeeSuggester
+}
+
+// Forwarder calls ForwarderFactory
+type Forwarder struct {
+	client       Backend
+	abi          abi.ABI
+	uuidHandler  uuid.UUIDHandler
+	chainID      *big.Int
+	feeModel     string
+	factory      common.Address
+	initCodeHash []byte
+	tokens       []common.Address
+}
+
+// NewForwarder returns Forwarder object
+//   - tokens are contract addresses of ERC-20 tokens which are swept with coin
+func NewForwarder(
+	client Backend,
+	uuidHandler uuid.UUIDHandler,
+	chainID uint64,
+	feeModel string,
+	conf *config.Forwarder,
+	tokens []string,
+) (*Forwarder, error) {
+	parsed, err := abi.JSON(strings.NewReader(forwarderABI))
+	if err != nil {
+		return nil, fmt.Errorf("fail to call abi.JSON(): %w", err)
+	}
+	// order of tokens is fixed to create same calldata
+	tokenAddrs := make([]common.Address, 0, len(tokens))
+	for _, token := range tokens {
+		if !common.IsHexAddress(token) {
+			return nil, fmt.Errorf("token address %s is invalid", token)
+		}
+		tokenAddrs = append(tokenAddrs, common.HexToAddress(token))
+	}
+	slices.SortFunc(tokenAddrs, func(a, b common.Address) int { return a.Cmp(b) })
+
+	return &Forwarder{
+		client:       client,
+		abi:          parsed,
+		uuidHandler:  uuidHandler,
+		chainID:      new(big.Int).SetUint64(chainID),
+		feeModel:     feeModel,
+		factory:      common.HexToAddress(conf.Factory),
+		initCodeHash: crypto.Keccak256(hexutil.MustDecode(ForwarderInitCode)),
+		tokens:       tokenAddrs,
+	}, nil
+}
+
+// Factory returns address of ForwarderFactory
+func (f *Forwarder) Factory() string {
+	if f.factory == (common.Address{}) {
+		return ""
+	}
+	return f.factory.Hex()
+}
+
+// Address returns address of forwarder for salt, it is available before forwarder is deployed
+func (f *Forwarder) Address(salt uint64) string {
+	return ComputeAddress(f.factory, salt, f.initCodeHash).Hex()
+}
+
+// ComputeAddress returns CREATE2 address of forwarder which factory deploys
+func ComputeAddress(factory common.Address, salt uint64, initCodeHash []byte) common.Address {
+	return crypto.CreateAddress2(factory, common.BigToHash(new(big.Int).SetUint64(salt)), initCodeHash)
+}
+
+// validateNode returns error if factory isn't set or node is connected to other chain
+func (f *Forwarder) validateNode(ctx context.Context) error {
+	if f.factory == (common.Address{}) {
+		return errors.New("factory of ethereum.forwarder is required in toml file")
+	}
+	return eth.ValidateNodeChainID(ctx, f.client, f.chainID)
+}
+
+// call calls view function of contract
+func (f *Forwarder) call(ctx context.Context, contractAddr common.Address, method string, args ...any) ([]any, error) {
+	data, err := f.abi.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call abi.Pack(%s): %w", method, err)
+	}
+	res, err := f.client.CallContract(ctx, ethereum.CallMsg{To: &contractAddr, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call client.CallContract(%s): %w", method, err)
+	}
+	values, err := f.abi.Unpack(method, res)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call abi.Unpack(%s): %w", method, err)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%s returns no value from %s", method, contractAddr.Hex())
+	}
+	return values, nil
+}
+
+// Destination returns address where ForwarderFactory sweeps coin and tokens to
+func (f *Forwarder) Destination(ctx context.Context) (string, error) {
+	if err := f.validateNode(ctx); err != nil {
+		return "", err
+	}
+	values, err := f.call(ctx, f.factory, "destination")
+	if err != nil {
+		return "", err
+	}
+	destination, ok := values[0].(common.Address)
+	if !ok {
+		return "", errors.New("fail to cast destination to common.Address")
+	}
+	return destination.Hex(), nil
+}
+
+// HasBalance returns true if forwarder has coin or any token
+func (f *Forwarder) HasBalance(ctx context.Context, forwarderAddr string) (bool, error) {
+	addr := common.HexToAddress(forwarderAddr)
+	balance, err := f.client.PendingBalanceAt(ctx, addr)
+	if err != nil {
+		return false, fmt.Errorf("fail to call client.PendingBalanceAt(): %w", err)
+	}
+	if balance.Sign() != 0 {
+		return true, nil
+	}
+	for _, token := range f.tokens {
+		values, err := f.call(ctx, token, "balanceOf", addr)
+		if err != nil {
+			return false, err
+		}
+		tokenBalance, ok := values[0].(*big.Int)
+		if !ok {
+			return false, errors.New("fail to cast balance to *big.Int")
+		}
+		if tokenBalance.Sign() != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,257 @@
+package forwarder
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// simulated backend uses chain id 1337
+const simulatedChainID = 1337
+
+const wethABI = `[
+{"name":"deposit","type":"function","stateMutability":"payable","inputs":[],"outputs":[]},
+{"name":"transfer","type":"function","stateMutability":"nonpayable","inputs":[{"name":"dst","type":"address"},{"name":"wad","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+type testForwarder struct {
+	backend     *simulated.Backend
+	forwarder   *Forwarder
+	token       common.Address
+	destination common.Address
+	sender      *ecdsa.PrivateKey
+	payer       *ecdsa.PrivateKey
+}
+
+// newTestForwarder deploys ForwarderFactory and WETH9 as ERC-20 token on simulated backend
+func newTestForwarder(t *testing.T) *testForwarder {
+	t.Helper()
+	ctx := context.Background()
+
+	sender, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	payer, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	destKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	destination := crypto.PubkeyToAddress(destKey.PublicKey)
+
+	fund, _ := new(big.Int).SetString("100000000000000000000", 10)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(sender.PublicKey): {Balance: fund},
+		crypto.PubkeyToAddress(payer.PublicKey):  {Balance: fund},
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+	client := backend.Client()
+
+	bin, err := os.ReadFile("testdata/weth9.bin")
+	require.NoError(t, err)
+	code, err := hex.DecodeString(strings.TrimSpace(string(bin)))
+	require.NoError(t, err)
+	token := sendTx(t, backend, payer, common.Address{}, new(big.Int), code).ContractAddress
+
+	fwd, err := NewForwarder(client, uuid.NewGoogleUUIDHandler(), simulatedChainID, config.FeeModelEIP1559,
+		&config.Forwarder{}, []string{token.Hex()})
+	require.NoError(t, err)
+	factory, txHash, err := fwd.Deploy(ctx, destination.Hex(), sender)
+	require.NoError(t, err)
+	backend.Commit()
+	receipt, err := client.TransactionReceipt(ctx, common.HexToHash(txHash))
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	require.Equal(t, factory, receipt.ContractAddress.Hex())
+
+	fwd, err = NewForwarder(client, uuid.NewGoogleUUIDHandler(), simulatedChainID, config.FeeModelEIP1559,
+		&config.Forwarder{Factory: factory}, []string{token.Hex()})
+	require.NoError(t, err)
+
+	return &testForwarder{
+		backend:     backend,
+		forwarder:   fwd,
+		token:       token,
+		destination: destination,
+		sender:      sender,
+		payer:       payer,
+	}
+}
+
+// sendTx sends transaction, contract is deployed when to is zero address
+func sendTx(
+	t *testing.T, backend *simulated.Backend, key *ecdsa.PrivateKey, to common.Address, value *big.Int, data []byte,
+) *types.Receipt {
+	t.Helper()
+	ctx := context.Background()
+	client := backend.Client()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	msg := ethereum.CallMsg{From: from, Value: value, Data: data}
+	if to != (common.Address{}) {
+		msg.To = &to
+	}
+	gas, err := client.EstimateGas(ctx, msg)
+	require.NoError(t, err)
+	gasFee, err := eth.SuggestGasFee(ctx, client, config.FeeModelEIP1559)
+	require.NoError(t, err)
+	nonce, err := client.PendingNonceAt(ctx, from)
+	require.NoError(t, err)
+
+	tx := ethtx.NewContractCreationTx(big.NewInt(simulatedChainID), nonce, value, gas, gasFee, data)
+	if msg.To != nil {
+		tx = ethtx.NewTx(big.NewInt(simulatedChainID), nonce, to, value, gas, gasFee, data)
+	}
+	signedTx, err := types.SignTx(tx, types.NewLondonSigner(big.NewInt(simulatedChainID)), key)
+	require.NoError(t, err)
+	require.NoError(t, client.SendTransaction(ctx, signedTx))
+	backend.Commit()
+
+	receipt, err := client.TransactionReceipt(ctx, signedTx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	return receipt
+}
+
+// sweep signs and sends sweep transaction like keygen wallet
+func (tf *testForwarder) sweep(t *testing.T, salts []uint64) {
+	t.Helper()
+	ctx := context.Background()
+	client := tf.backend.Client()
+
+	rawTx, txDetail, err := tf.forwarder.CreateSweepTransaction(
+		ctx, crypto.PubkeyToAddress(tf.sender.PublicKey).Hex(), salts, 0)
+	require.NoError(t, err)
+	assert.Equal(t, tf.destination.Hex(), txDetail.ReceiverAddress)
+	assert.Equal(t, tf.forwarder.Factory(), rawTx.To)
+
+	tx, err := ethtx.DecodeTx(rawTx.TxHex)
+	require.NoError(t, err)
+	signedTx, err := types.SignTx(tx, types.NewLondonSigner(big.NewInt(simulatedChainID)), tf.sender)
+	require.NoError(t, err)
+	require.NoError(t, client.SendTransaction(ctx, signedTx))
+	tf.backend.Commit()
+
+	receipt, err := client.TransactionReceipt(ctx, signedTx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+}
+
+func (tf *testForwarder) tokenBalance(t *testing.T, addr common.Address) *big.Int {
+	t.Helper()
+	values, err := tf.forwarder.call(context.Background(), tf.token, "balanceOf", addr)
+	require.NoError(t, err)
+	balance, ok := values[0].(*big.Int)
+	require.True(t, ok)
+	return balance
+}
+
+func TestComputeAddress(t *testing.T) {
+	factory := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	initCodeHash := crypto.Keccak256(common.FromHex(ForwarderInitCode))
+
+	// address is decided by factory and salt
+	addr0 := ComputeAddress(factory, 0, initCodeHash)
+	assert.Equal(t, addr0, ComputeAddress(factory, 0, initCodeHash))
+	assert.NotEqual(t, addr0, ComputeAddress(factory, 1, initCodeHash))
+	assert.NotEqual(t, addr0, ComputeAddress(common.HexToAddress("0x01"), 0, initCodeHash))
+}
+
+func TestSweep(t *testing.T) {
+	ctx := context.Background()
+	tf := newTestForwarder(t)
+	client := tf.backend.Client()
+
+	destination, err := tf.forwarder.Destination(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, tf.destination.Hex(), destination)
+
+	// forwarders receive coin and token before deployment
+	addr0 := common.HexToAddress(tf.forwarder.Address(0))
+	addr1 := common.HexToAddress(tf.forwarder.Address(1))
+	oneETH := big.NewInt(1_000_000_000_000_000_000)
+	sendTx(t, tf.backend, tf.payer, addr0, oneETH, nil)
+
+	parsed, err := abi.JSON(strings.NewReader(wethABI))
+	require.NoError(t, err)
+	sendTx(t, tf.backend, tf.payer, tf.token, oneETH, parsed.Methods["deposit"].ID)
+	data, err := parsed.Pack("transfer", addr1, oneETH)
+	require.NoError(t, err)
+	sendTx(t, tf.backend, tf.payer, tf.token, new(big.Int), data)
+
+	for salt, expected := range []bool{true, true, false} {
+		hasBalance, err := tf.forwarder.HasBalance(ctx, tf.forwarder.Address(uint64(salt)))
+		require.NoError(t, err)
+		assert.Equal(t, expected, hasBalance, "salt %d", salt)
+	}
+
+	// forwarders are deployed and swept
+	tf.sweep(t, []uint64{0, 1})
+	for _, addr := range []common.Address{addr0, addr1} {
+		code, err := client.CodeAt(ctx, addr, nil)
+		require.NoError(t, err)
+		assert.NotEmpty(t, code)
+	}
+	balance, err := client.BalanceAt(ctx, tf.destination, nil)
+	require.NoError(t, err)
+	assert.Equal(t, oneETH, balance)
+	assert.Equal(t, oneETH, tf.tokenBalance(t, tf.destination))
+	assert.Equal(t, int64(0), tf.tokenBalance(t, addr1).Int64())
+
+	// deployed forwarder receives coin and it is swept again
+	sendTx(t, tf.backend, tf.payer, addr0, oneETH, nil)
+	tf.sweep(t, []uint64{0})
+	balance, err = client.BalanceAt(ctx, tf.destination, nil)
+	require.NoError(t, err)
+	assert.Equal(t, new(big.Int).Mul(oneETH, big.NewInt(2)), balance)
+	balance, err = client.BalanceAt(ctx, addr0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), balance.Int64())
+}
+
+func TestSweepError(t *testing.T) {
+	ctx := context.Background()
+	tf := newTestForwarder(t)
+	client := tf.backend.Client()
+	sendTx(t, tf.backend, tf.payer, common.HexToAddress(tf.forwarder.Address(0)), big.NewInt(1), nil)
+	tf.sweep(t, []uint64{0})
+
+	// only factory can flush forwarder
+	forwarderAddr := common.HexToAddress(tf.forwarder.Address(0))
+	_, err := client.EstimateGas(ctx, ethereum.CallMsg{
+		From: crypto.PubkeyToAddress(tf.payer.PublicKey),
+		To:   &forwarderAddr,
+		Data: common.LeftPadBytes(crypto.PubkeyToAddress(tf.payer.PublicKey).Bytes(), 32),
+	})
+	require.Error(t, err)
+
+	// factory doesn't accept coin
+	factory := common.HexToAddress(tf.forwarder.Factory())
+	_, err = client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  crypto.PubkeyToAddress(tf.payer.PublicKey),
+		To:    &factory,
+		Value: big.NewInt(1),
+	})
+	require.Error(t, err)
+
+	// sender without coin can't pay fee
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	_, _, err = tf.forwarder.CreateSweepTransaction(ctx, crypto.PubkeyToAddress(key.PublicKey).Hex(), []uint64{0}, 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,173 @@
+package forwarder
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// CreateSweepTransaction creates raw transaction which calls ForwarderFactory.sweep() for watch only wallet
+//   - fromAddr is address of key which signs transaction on keygen wallet and pays fee
+//   - forwarders of salts are deployed if needed, and coin and tokens are sent to destination of factory
+//   - amount of eth_detail_tx is total coin of forwarders, tokens are not included
+func (f *Forwarder) CreateSweepTransaction(
+	ctx context.Context, fromAddr string, salts []uint64, additionalNonce int,
+) (*ethtx.RawTx, *models.EthDetailTX, error) {
+	if !common.IsHexAddress(fromAddr) {
+		return nil, nil, errors.New("address validation error")
+	}
+	if len(salts) == 0 {
+		return nil, nil, errors.New("salts are required")
+	}
+	logger.Debug("forwarder.CreateSweepTransaction()",
+		"fromAddr", fromAddr,
+		"salts", salts,
+	)
+
+	// transaction must be created on the chain of config
+	if err := f.validateNode(ctx); err != nil {
+		return nil, nil, err
+	}
+	destination, err := f.Destination(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call forwarder.Destination(): %w", err)
+	}
+
+	// total coin of forwarders
+	amount := new(big.Int)
+	saltValues := make([]*big.Int, len(salts))
+	for i, salt := range salts {
+		saltValues[i] = new(big.Int).SetUint64(salt)
+		balance, err := f.client.PendingBalanceAt(ctx, common.HexToAddress(f.Address(salt)))
+		if err != nil {
+			return nil, nil, fmt.Errorf("fail to call client.PendingBalanceAt(): %w", err)
+		}
+		amount.Add(amount, balance)
+	}
+
+	data, err := f.abi.Pack("sweep", saltValues, f.tokens)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call abi.Pack(sweep): %w", err)
+	}
+
+	from := common.HexToAddress(fromAddr)
+	gas, err := f.client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &f.factory, Data: data})
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call client.EstimateGas(): %w", err)
+	}
+	gasFee, err := eth.SuggestGasFee(ctx, f.client, f.feeModel)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call eth.SuggestGasFee(): %w", err)
+	}
+
+	// sender pays fee instead of forwarders
+	balance, err := f.client.PendingBalanceAt(ctx, from)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call client.PendingBalanceAt(): %w", err)
+	}
+	txFee := new(big.Int).Mul(gasFee.GasFeeCap, new(big.Int).SetUint64(gas))
+	if balance.Cmp(txFee) == -1 {
+		return nil, nil, fmt.Errorf("sender %s has %d, but %d is needed for fee", fromAddr, balance, txFee)
+	}
+
+	nonce, err := f.client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call client.PendingNonceAt(): %w", err)
+	}
+	nonce += uint64(additionalNonce)
+
+	tx := ethtx.NewTx(f.chainID, nonce, f.factory, new(big.Int), gas, gasFee, data)
+	rawTxHex, err := ethtx.EncodeTx(tx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call encodeTx(): %w", err)
+	}
+
+	// generate UUID to trace transaction because unsignedTx is not unique
+	uid, err := f.uuidHandler.GenerateV7()
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call uuidHandler.GenerateV7(): %w", err)
+	}
+
+	// create insert data for eth_detail_tx
+	txDetailItem := &models.EthDetailTX{
+		UUID:            uid.String(),
+		SenderAccount:   "",
+		SenderAddress:   fromAddr,
+		ReceiverAccount: "",
+		ReceiverAddress: destination,
+		Amount:          amount.Uint64(),
+		Fee:             txFee.Uint64(),
+		GasLimit:        uint32(gas),
+		Nonce:           nonce,
+		UnsignedHexTX:   *rawTxHex,
+	}
+
+	rawTx := &ethtx.RawTx{
+		UUID:    uid.String(),
+		ChainID: f.chainID.Uint64(),
+		From:    fromAddr,
+		To:      f.factory.Hex(),
+		Value:   *new(big.Int),
+		Nonce:   nonce,
+		TxHex:   *rawTxHex,
+		Hash:    tx.Hash().Hex(),
+	}
+	return rawTx, txDetailItem, nil
+}
+
+// Deploy deploys ForwarderFactory whose destination is given address, deployer pays fee
+//   - factory address is returned, it should be set to [ethereum.forwarder] in toml file
+func (f *Forwarder) Deploy(ctx context.Context, destination string, deployer *ecdsa.PrivateKey) (string, string, error) {
+	if !common.IsHexAddress(destination) {
+		return "", "", fmt.Errorf("destination address %s is invalid", destination)
+	}
+	if err := eth.ValidateNodeChainID(ctx, f.client, f.chainID); err != nil {
+		return "", "", err
+	}
+
+	// constructor argument is appended to creation code
+	data := append(hexutil.MustDecode(FactoryCode), common.LeftPadBytes(common.HexToAddress(destination).Bytes(), 32)...)
+
+	from := crypto.PubkeyToAddress(deployer.PublicKey)
+	gas, err := f.client.EstimateGas(ctx, ethereum.CallMsg{From: from, Data: data})
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call client.EstimateGas(): %w", err)
+	}
+	gasFee, err := eth.SuggestGasFee(ctx, f.client, f.feeModel)
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call eth.SuggestGasFee(): %w", err)
+	}
+	nonce, err := f.client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call client.PendingNonceAt(): %w", err)
+	}
+
+	tx := ethtx.NewContractCreationTx(f.chainID, nonce, new(big.Int), gas, gasFee, data)
+	signedTx, err := types.SignTx(tx, types.NewLondonSigner(f.chainID), deployer)
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call types.SignTx(): %w", err)
+	}
+	if err = f.client.SendTransaction(ctx, signedTx); err != nil {
+		return "", "", fmt.Errorf("fail to call client.SendTransaction(): %w", err)
+	}
+	factory := crypto.CreateAddress(from, nonce)
+	logger.Debug("deployer sent transaction",
+		"from", from.Hex(),
+		"factory", factory.Hex(),
+		"txHash", signedTx.Hash().Hex(),
+	)
+	return factory.Hex(), signedTx.Hash().Hex(), nil
+}
@@ -0,0 +1,320 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/p2p"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// instrumentedEthereumer records latency and errors of methods calling ethereum RPC server,
+// other methods are called as is
+type instrumentedEthereumer struct {
+	Ethereumer
+	coin string
+}
+
+// NewInstrumentedEthereumer wraps Ethereumer to record RPC metrics per method
+func NewInstrumentedEthereumer(ethClient Ethereumer) Ethereumer {
+	return &instrumentedEthereumer{
+		Ethereumer: ethClient,
+		coin:       ethClient.CoinTypeCode().String(),
+	}
+}
+
+func (e *instrumentedEthereumer) start(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "ethereum."+method, attribute.String("coin", e.coin))
+}
+
+func (e *instrumentedEthereumer) observe(method string, span trace.Span, started time.Time, err *error) {
+	metrics.ObserveRPC(e.coin, method, started, *err)
+	tracer.End(span, err)
+}
+
+func (e *instrumentedEthereumer) BalanceAt(ctx context.Context, hexAddr string) (_ *big.Int, err error) {
+	ctx, span := e.start(ctx, "BalanceAt")
+	defer e.observe("BalanceAt", span, time.Now(), &err)
+	return e.Ethereumer.BalanceAt(ctx, hexAddr)
+}
+
+func (e *instrumentedEthereumer) SendRawTx(ctx context.Context, tx *types.Transaction) (err error) {
+	ctx, span := e.start(ctx, "SendRawTx")
+	defer e.observe("SendRawTx", span, time.Now(), &err)
+	return e.Ethereumer.SendRawTx(ctx, tx)
+}
+
+func (e *instrumentedEthereumer) AddPeer(ctx context.Context, nodeURL string) (err error) {
+	ctx, span := e.start(ctx, "AddPeer")
+	defer e.observe("AddPeer", span, time.Now(), &err)
+	return e.Ethereumer.AddPeer(ctx, nodeURL)
+}
+
+func (e *instrumentedEthereumer) AdminDataDir(ctx context.Context) (_ string, err error) {
+	ctx, span := e.start(ctx, "AdminDataDir")
+	defer e.observe("AdminDataDir", span, time.Now(), &err)
+	return e.Ethereumer.AdminDataDir(ctx)
+}
+
+func (e *instrumentedEthereumer) NodeInfo(ctx context.Context) (_ *p2p.NodeInfo, err error) {
+	ctx, span := e.start(ctx, "NodeInfo")
+	defer e.observe("NodeInfo", span, time.Now(), &err)
+	return e.Ethereumer.NodeInfo(ctx)
+}
+
+func (e *instrumentedEthereumer) AdminPeers(ctx context.Context) (_ []*p2p.PeerInfo, err error) {
+	ctx, span := e.start(ctx, "AdminPeers")
+	defer e.observe("AdminPeers", span, time.Now(), &err)
+	return e.Ethereumer.AdminPeers(ctx)
+}
+
+func (e *instrumentedEthereumer) Syncing(ctx context.Context) (_ *eth.ResponseSyncing, _ bool, err error) {
+	ctx, span := e.start(ctx, "Syncing")
+	defer e.observe("Syncing", span, time.Now(), &err)
+	return e.Ethereumer.Syncing(ctx)
+}
+
+func (e *instrumentedEthereumer) ProtocolVersion(ctx context.Context) (_ uint64, err error) {
+	ctx, span := e.start(ctx, "ProtocolVersion")
+	defer e.observe("ProtocolVersion", span, time.Now(), &err)
+	return e.Ethereumer.ProtocolVersion(ctx)
+}
+
+func (e *instrumentedEthereumer) Coinbase(ctx context.Context) (_ string, err error) {
+	ctx, span := e.start(ctx, "Coinbase")
+	defer e.observe("Coinbase", span, time.Now(), &err)
+	return e.Ethereumer.Coinbase(ctx)
+}
+
+func (e *instrumentedEthereumer) Accounts(ctx context.Context) (_ []string, err error) {
+	ctx, span := e.start(ctx, "Accounts")
+	defer e.observe("Accounts", span, time.Now(), &err)
+	return e.Ethereumer.Accounts(ctx)
+}
+
+func (e *instrumentedEthereumer) BlockNumber(ctx context.Context) (_ *big.Int, err error) {
+	ctx, span := e.start(ctx, "BlockNumber")
+	defer e.observe("BlockNumber", span, time.Now(), &err)
+	return e.Ethereumer.BlockNumber(ctx)
+}
+
+func (e *instrumentedEthereumer) EnsureBlockNumber(ctx context.Context, loopCount int) (_ *big.Int, err error) {
+	ctx, span := e.start(ctx, "EnsureBlockNumber")
+	defer e.observe("EnsureBlockNumber", span, time.Now(), &err)
+	return e.Ethereumer.EnsureBlockNumber(ctx, loopCount)
+}
+
+func (e *instrumentedEthereumer) GetBalance(
+	ctx context.Context, hexAddr string, quantityTag eth.QuantityTag,
+) (_ *big.Int, err error) {
+	ctx, span := e.start(ctx, "GetBalance")
+	defer e.observe("GetBalance", span, time.Now(), &err)
+	return e.Ethereumer.GetBalance(ctx, hexAddr, quantityTag)
+}
+
+func (e *instrumentedEthereumer) GetTransactionCount(
+	ctx context.Context, hexAddr string, quantityTag eth.QuantityTag,
+) (_ *big.Int, err error) {
+	ctx, span := e.start(ctx, "GetTransactionCount")
+	defer e.observe("GetTransactionCount", span, time.Now(), &err)
+	return e.Ethereumer.GetTransactionCount(ctx, hexAddr, quantityTag)
+}
+
+func (e *instrumentedEthereumer) GetBlockTransactionCountByNumber(
+	ctx context.Context, blockNumber uint64,
+) (_ *big.Int, err error) {
+	ctx, span := e.start(ctx, "GetBlockTransactionCountByNumber")
+	defer e.observe("GetBlockTransactionCountByNumber", span, time.Now(), &err)
+	return e.Ethereumer.GetBlockTransactionCountByNumber(ctx, blockNumber)
+}
+
+func (e *instrumentedEthereumer) GetUncleCountByBlockNumber(
+	ctx context.Context, blockNumber uint64,
+) (_ *big.Int, err error) {
+	ctx, span := e.start(ctx, "GetUncleCountByBlockNumber")
+	defer e.observe("GetUncleCountByBlockNumber", span, time.Now(), &err)
+	return e.Ethereumer.GetUncleCountByBlockNumber(ctx, blockNumber)
+}
+
+func (e *instrumentedEthereumer) GetBlockByNumber(
+	ctx context.Context, blockNumber uint64,
+) (_ *eth.BlockInfo, err error) {
+	ctx, span := e.start(ctx, "GetBlockByNumber")
+	defer e.observe("GetBlockByNumber", span, time.Now(), &err)
+	return e.Ethereumer.GetBlockByNumber(ctx, blockNumber)
+}
+
+func (e *instrumentedEthereumer) GasPrice(ctx context.Context) (_ *big.Int, err error) {
+	ctx, span := e.start(ctx, "GasPrice")
+	defer e.observe("GasPrice", span, time.Now(), &err)
+	return e.Ethereumer.GasPrice(ctx)
+}
+
+func (e *instrumentedEthereumer) EstimateGas(ctx context.Context, msg *ethereum.CallMsg) (_ *big.Int, err error) {
+	ctx, span := e.start(ctx, "EstimateGas")
+	defer e.observe("EstimateGas", span, time.Now(), &err)
+	return e.Ethereumer.EstimateGas(ctx, msg)
+}
+
+func (e *instrumentedEthereumer) Sign(ctx context.Context, hexAddr, message string) (_ string, err error) {
+	ctx, span := e.start(ctx, "Sign")
+	defer e.observe("Sign", span, time.Now(), &err)
+	return e.Ethereumer.Sign(ctx, hexAddr, message)
+}
+
+func (e *instrumentedEthereumer) SendTransaction(ctx context.Context, msg *ethereum.CallMsg) (_ string, err error) {
+	ctx, span := e.start(ctx, "SendTransaction")
+	defer e.observe("SendTransaction", span, time.Now(), &err)
+	return e.Ethereumer.SendTransaction(ctx, msg)
+}
+
+func (e *instrumentedEthereumer) SendRawTransaction(ctx context.Context, signedTx string) (_ string, err error) {
+	ctx, span := e.start(ctx, "SendRawTransaction")
+	defer e.observe("SendRawTransaction", span, time.Now(), &err)
+	return e.Ethereumer.SendRawTransaction(ctx, signedTx)
+}
+
+func (e *instrumentedEthereumer) SendRawTransactionWithTypesTx(
+	ctx context.Context, tx *types.Transaction,
+) (_ string, err error) {
+	ctx, span := e.start(ctx, "SendRawTransactionWithTypesTx")
+	defer e.observe("SendRawTransactionWithTypesTx", span, time.Now(), &err)
+	return e.Ethereumer.SendRawTransactionWithTypesTx(ctx, tx)
+}
+
+func (e *instrumentedEthereumer) GetTransactionByHash(
+	ctx context.Context, hashTx string,
+) (_ *eth.ResponseGetTransaction, err error) {
+	ctx, span := e.start(ctx, "GetTransactionByHash")
+	defer e.observe("GetTransactionByHash", span, time.Now(), &err)
+	return e.Ethereumer.GetTransactionByHash(ctx, hashTx)
+}
+
+func (e *instrumentedEthereumer) GetTransactionReceipt(
+	ctx context.Context, hashTx string,
+) (_ *eth.ResponseGetTransactionReceipt, err error) {
+	ctx, span := e.start(ctx, "GetTransactionReceipt")
+	defer e.observe("GetTransactionReceipt", span, time.Now(), &err)
+	return e.Ethereumer.GetTransactionReceipt(ctx, hashTx)
+}
+
+func (e *instrumentedEthereumer) StartMining(ctx context.Context) (err error) {
+	ctx, span := e.start(ctx, "StartMining")
+	defer e.observe("StartMining", span, time.Now(), &err)
+	return e.Ethereumer.StartMining(ctx)
+}
+
+func (e *instrumentedEthereumer) StopMining(ctx context.Context) (err error) {
+	ctx, span := e.start(ctx, "StopMining")
+	defer e.observe("StopMining", span, time.Now(), &err)
+	return e.Ethereumer.StopMining(ctx)
+}
+
+func (e *instrumentedEthereumer) Mining(ctx context.Context) (_ bool, err error) {
+	ctx, span := e.start(ctx, "Mining")
+	defer e.observe("Mining", span, time.Now(), &err)
+	return e.Ethereumer.Mining(ctx)
+}
+
+func (e *instrumentedEthereumer) HashRate(ctx context.Context) (_ *big.Int, err error) {
+	ctx, span := e.start(ctx, "HashRate")
+	defer e.observe("HashRate", span, time.Now(), &err)
+	return e.Ethereumer.HashRate(ctx)
+}
+
+func (e *instrumentedEthereumer) NetVersion(ctx context.Context) (_ uint16, err error) {
+	ctx, span := e.start(ctx, "NetVersion")
+	defer e.observe("NetVersion", span, time.Now(), &err)
+	return e.Ethereumer.NetVersion(ctx)
+}
+
+func (e *instrumentedEthereumer) NetListening(ctx context.Context) (_ bool, err error) {
+	ctx, span := e.start(ctx, "NetListening")
+	defer e.observe("NetListening", span, time.Now(), &err)
+	return e.Ethereumer.NetListening(ctx)
+}
+
+func (e *instrumentedEthereumer) NetPeerCount(ctx context.Context) (_ *big.Int, err error) {
+	ctx, span := e.start(ctx, "NetPeerCount")
+	defer e.observe("NetPeerCount", span, time.Now(), &err)
+	return e.Ethereumer.NetPeerCount(ctx)
+}
+
+func (e *instrumentedEthereumer) ImportRawKey(ctx context.Context, hexKey, passPhrase string) (_ string, err error) {
+	ctx, span := e.start(ctx, "ImportRawKey")
+	defer e.observe("ImportRawKey", span, time.Now(), &err)
+	return e.Ethereumer.ImportRawKey(ctx, hexKey, passPhrase)
+}
+
+func (e *instrumentedEthereumer) ListAccounts(ctx context.Context) (_ []string, err error) {
+	ctx, span := e.start(ctx, "ListAccounts")
+	defer e.observe("ListAccounts", span, time.Now(), &err)
+	return e.Ethereumer.ListAccounts(ctx)
+}
+
+func (e *instrumentedEthereumer) NewAccount(
+	ctx context.Context, passphrase string, accountType domainAccount.AccountType,
+) (_ string, err error) {
+	ctx, span := e.start(ctx, "NewAccount")
+	defer e.observe("NewAccount", span, time.Now(), &err)
+	return e.Ethereumer.NewAccount(ctx, passphrase, accountType)
+}
+
+func (e *instrumentedEthereumer) LockAccount(ctx context.Context, hexAddr string) (err error) {
+	ctx, span := e.start(ctx, "LockAccount")
+	defer e.observe("LockAccount", span, time.Now(), &err)
+	return e.Ethereumer.LockAccount(ctx, hexAddr)
+}
+
+func (e *instrumentedEthereumer) UnlockAccount(
+	ctx context.Context, hexAddr, passphrase string, duration uint64,
+) (_ bool, err error) {
+	ctx, span := e.start(ctx, "UnlockAccount")
+	defer e.observe("UnlockAccount", span, time.Now(), &err)
+	return e.Ethereumer.UnlockAccount(ctx, hexAddr, passphrase, duration)
+}
+
+func (e *instrumentedEthereumer) ClientVersion(ctx context.Context) (_ string, err error) {
+	ctx, span := e.start(ctx, "ClientVersion")
+	defer e.observe("ClientVersion", span, time.Now(), &err)
+	return e.Ethereumer.ClientVersion(ctx)
+}
+
+func (e *instrumentedEthereumer) SHA3(ctx context.Context, data string) (_ string, err error) {
+	ctx, span := e.start(ctx, "SHA3")
+	defer e.observe("SHA3", span, time.Now(), &err)
+	return e.Ethereumer.SHA3(ctx, data)
+}
+
+func (e *instrumentedEthereumer) CreateRawTransaction(
+	ctx context.Context, fromAddr, toAddr string, amount uint64, additionalNonce int,
+) (_ *ethtx.RawTx, _ *models.EthDetailTX, err error) {
+	ctx, span := e.start(ctx, "CreateRawTransaction")
+	defer e.observe("CreateRawTransaction", span, time.Now(), &err)
+	return e.Ethereumer.CreateRawTransaction(ctx, fromAddr, toAddr, amount, additionalNonce)
+}
+
+func (e *instrumentedEthereumer) SendSignedRawTransaction(
+	ctx context.Context, signedTxHex string,
+) (_ string, err error) {
+	ctx, span := e.start(ctx, "SendSignedRawTransaction")
+	defer e.observe("SendSignedRawTransaction", span, time.Now(), &err)
+	return e.Ethereumer.SendSignedRawTransaction(ctx, signedTxHex)
+}
+
+func (e *instrumentedEthereumer) GetConfirmation(ctx context.Context, hashTx string) (_ uint64, err error) {
+	ctx, span := e.start(ctx, "GetConfirmation")
+	defer e.observe("GetConfirmation", span, time.Now(), &err)
+	return e.Ethereumer.GetConfirmation(ctx, hashTx)
+}
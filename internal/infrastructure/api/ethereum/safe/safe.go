@@ -0,0 +1,173 @@
+package safe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// Safe{Wallet} contracts
+// - https://github.com/safe-global/safe-smart-account
+// - Safe v1.3.0 or later is supported because EIP-712 domain includes chain ID
+
+// safeABI is the part of Safe and SafeProxyFactory which is called by wallet
+//
+//nolint:lll
+const safeABI = `[
+{"name":"nonce","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"name":"getThreshold","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"name":"getOwners","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address[]"}]},
+{"name":"getTransactionHash","type":"function","stateMutability":"view","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},{"name":"_nonce","type":"uint256"}],"outputs":[{"name":"","type":"bytes32"}]},
+{"name":"execTransaction","type":"function","stateMutability":"payable","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},{"name":"operation","type":"uint8"},{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},{"name":"gasPrice","type":"uint256"},{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},{"name":"signatures","type":"bytes"}],"outputs":[{"name":"success","type":"bool"}]},
+{"name":"setup","type":"function","stateMutability":"nonpayable","inputs":[{"name":"_owners","type":"address[]"},{"name":"_threshold","type":"uint256"},{"name":"to","type":"address"},{"name":"data","type":"bytes"},{"name":"fallbackHandler","type":"address"},{"name":"paymentToken","type":"address"},{"name":"payment","type":"uint256"},{"name":"paymentReceiver","type":"address"}],"outputs":[]},
+{"name":"createProxyWithNonce","type":"function","stateMutability":"nonpayable","inputs":[{"name":"_singleton","type":"address"},{"name":"initializer","type":"bytes"},{"name":"saltNonce","type":"uint256"}],"outputs":[{"name":"proxy","type":"address"}]}
+]`
+
+// Backend is client of EVM node which Safe calls, ethclient.Client and simulated backend satisfy it
+type Backend interface {
+	ethereum.ChainIDReader
+	ethereum.ContractCaller
+	ethereum.GasEstimator
+	ethereum.PendingStateReader
+	ethereum.TransactionSender
+	eth.GasFeeSuggester
+}
+
+// errOffline is returned when node is called on sign wallet
+var errOffline = errors.New("client is not available on offline wallet")
+
+// Safe calls Safe multisig contract
+//   - client is nil for sign wallet which signs safeTxHash offline
+type Safe struct {
+	client          Backend
+	abi             abi.ABI
+	uuidHandler     uuid.UUIDHandler
+	chainID         *big.Int
+	feeModel        string
+	executor        string
+	proxyFactory    common.Address
+	singleton       common.Address
+	fallbackHandler common.Address
+	accounts        map[domainAccount.AccountType]string
+}
+
+// NewSafe returns Safe object
+func NewSafe(
+	client Backend,
+	uuidHandler uuid.UUIDHandler,
+	chainID uint64,
+	feeModel string,
+	conf *config.Safe,
+) (*Safe, error) {
+	parsed, err := abi.JSON(strings.NewReader(safeABI))
+	if err != nil {
+		return nil, fmt.Errorf("fail to call abi.JSON(): %w", err)
+	}
+	accounts := make(map[domainAccount.AccountType]string, len(conf.Accounts))
+	for accountType, safeAddr := range conf.Accounts {
+		accounts[domainAccount.AccountType(accountType)] = safeAddr
+	}
+	return &Safe{
+		client:          client,
+		abi:             parsed,
+		uuidHandler:     uuidHandler,
+		chainID:         new(big.Int).SetUint64(chainID),
+		feeModel:        feeModel,
+		executor:        conf.Executor,
+		proxyFactory:    common.HexToAddress(conf.ProxyFactory),
+		singleton:       common.HexToAddress(conf.Singleton),
+		fallbackHandler: common.HexToAddress(conf.FallbackHandler),
+		accounts:        accounts,
+	}, nil
+}
+
+// Executor returns address which sends execTransaction
+func (s *Safe) Executor() string {
+	return s.executor
+}
+
+// Address returns Safe address which holds account, empty string is returned if account is held by key
+func (s *Safe) Address(accountType domainAccount.AccountType) string {
+	return s.accounts[accountType]
+}
+
+// validateNode returns error if node isn't available or is connected to other chain
+func (s *Safe) validateNode(ctx context.Context) error {
+	if s.client == nil {
+		return errOffline
+	}
+	return eth.ValidateNodeChainID(ctx, s.client, s.chainID)
+}
+
+// call calls view function of contract
+func (s *Safe) call(ctx context.Context, contractAddr common.Address, method string, args ...any) ([]any, error) {
+	if s.client == nil {
+		return nil, errOffline
+	}
+	data, err := s.abi.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call abi.Pack(%s): %w", method, err)
+	}
+	res, err := s.client.CallContract(ctx, ethereum.CallMsg{To: &contractAddr, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call client.CallContract(%s): %w", method, err)
+	}
+	values, err := s.abi.Unpack(method, res)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call abi.Unpack(%s): %w", method, err)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("%s returns no value, %s may not be Safe", method, contractAddr.Hex())
+	}
+	return values, nil
+}
+
+// Nonce returns nonce of Safe which is included in next safeTxHash
+func (s *Safe) Nonce(ctx context.Context, safeAddr string) (uint64, error) {
+	values, err := s.call(ctx, common.HexToAddress(safeAddr), "nonce")
+	if err != nil {
+		return 0, err
+	}
+	nonce, ok := values[0].(*big.Int)
+	if !ok {
+		return 0, errors.New("fail to cast nonce to *big.Int")
+	}
+	return nonce.Uint64(), nil
+}
+
+// Threshold returns the number of required signatures
+func (s *Safe) Threshold(ctx context.Context, safeAddr string) (uint64, error) {
+	values, err := s.call(ctx, common.HexToAddress(safeAddr), "getThreshold")
+	if err != nil {
+		return 0, err
+	}
+	threshold, ok := values[0].(*big.Int)
+	if !ok {
+		return 0, errors.New("fail to cast threshold to *big.Int")
+	}
+	return threshold.Uint64(), nil
+}
+
+// Owners returns owner addresses of Safe
+func (s *Safe) Owners(ctx context.Context, safeAddr string) ([]common.Address, error) {
+	values, err := s.call(ctx, common.HexToAddress(safeAddr), "getOwners")
+	if err != nil {
+		return nil, err
+	}
+	owners, ok := values[0].([]common.Address)
+	if !ok {
+		return nil, errors.New("fail to cast owners to []common.Address")
+	}
+	return owners, nil
+}
@@ -0,0 +1,332 @@
+package safe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// simulated backend uses chain id 1337
+const simulatedChainID = 1337
+
+type testSafe struct {
+	backend  *simulated.Backend
+	safe     *Safe
+	safeAddr string
+	owners   []*ecdsa.PrivateKey
+	executor *ecdsa.PrivateKey
+}
+
+// newTestSafe deploys Safe v1.3.0 with 2 of 3 owners on simulated backend, Safe has 1 ETH
+func newTestSafe(t *testing.T) *testSafe {
+	t.Helper()
+	ctx := context.Background()
+
+	executor, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owners := make([]*ecdsa.PrivateKey, 3)
+	ownerAddrs := make([]string, 3)
+	for i := range owners {
+		owners[i], err = crypto.GenerateKey()
+		require.NoError(t, err)
+		ownerAddrs[i] = crypto.PubkeyToAddress(owners[i].PublicKey).Hex()
+	}
+
+	fund, _ := new(big.Int).SetString("100000000000000000000", 10)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(executor.PublicKey): {Balance: fund},
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+	client := backend.Client()
+
+	singleton := deployContract(t, backend, executor, "testdata/safe_v1.3.0.bin")
+	factory := deployContract(t, backend, executor, "testdata/safe_proxy_factory.bin")
+
+	safe, err := NewSafe(client, uuid.NewGoogleUUIDHandler(), simulatedChainID, config.FeeModelEIP1559,
+		&config.Safe{ProxyFactory: factory.Hex(), Singleton: singleton.Hex()})
+	require.NoError(t, err)
+
+	safeAddr, txHash, err := safe.Deploy(ctx, ownerAddrs, 2, 0, executor)
+	require.NoError(t, err)
+	backend.Commit()
+	receipt, err := client.TransactionReceipt(ctx, common.HexToHash(txHash))
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	// Safe receives coin by fallback of proxy
+	oneETH := big.NewInt(1_000_000_000_000_000_000)
+	sendTx(t, backend, executor, common.HexToAddress(safeAddr), oneETH, nil)
+
+	return &testSafe{
+		backend:  backend,
+		safe:     safe,
+		safeAddr: safeAddr,
+		owners:   owners,
+		executor: executor,
+	}
+}
+
+func deployContract(t *testing.T, backend *simulated.Backend, key *ecdsa.PrivateKey, file string) common.Address {
+	t.Helper()
+	bin, err := os.ReadFile(file)
+	require.NoError(t, err)
+	code, err := hex.DecodeString(strings.TrimSpace(string(bin)))
+	require.NoError(t, err)
+
+	receipt := sendTx(t, backend, key, common.Address{}, new(big.Int), code)
+	return receipt.ContractAddress
+}
+
+// sendTx sends transaction, contract is deployed when to is zero address
+func sendTx(
+	t *testing.T, backend *simulated.Backend, key *ecdsa.PrivateKey, to common.Address, value *big.Int, data []byte,
+) *types.Receipt {
+	t.Helper()
+	ctx := context.Background()
+	client := backend.Client()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	msg := ethereum.CallMsg{From: from, Value: value, Data: data}
+	if to != (common.Address{}) {
+		msg.To = &to
+	}
+	gas, err := client.EstimateGas(ctx, msg)
+	require.NoError(t, err)
+	gasFee, err := eth.SuggestGasFee(ctx, client, config.FeeModelEIP1559)
+	require.NoError(t, err)
+	nonce, err := client.PendingNonceAt(ctx, from)
+	require.NoError(t, err)
+
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(simulatedChainID),
+		Nonce:     nonce,
+		GasTipCap: gasFee.GasTipCap,
+		GasFeeCap: gasFee.GasFeeCap,
+		Gas:       gas,
+		To:        msg.To,
+		Value:     value,
+		Data:      data,
+	})
+	signedTx, err := types.SignTx(tx, types.NewLondonSigner(big.NewInt(simulatedChainID)), key)
+	require.NoError(t, err)
+	require.NoError(t, client.SendTransaction(ctx, signedTx))
+	backend.Commit()
+
+	receipt, err := client.TransactionReceipt(ctx, signedTx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	return receipt
+}
+
+// TestTransactionHash is test for TransactionHash
+func TestTransactionHash(t *testing.T) {
+	ts := newTestSafe(t)
+	safeTx := &ethtx.SafeTx{
+		To:    "0x328F371a76dfAc47b89Cc007bb048ec446c21494",
+		Value: *big.NewInt(12345),
+		Data:  []byte{0x01, 0x02},
+		Nonce: 7,
+	}
+
+	zero := new(big.Int)
+	values, err := ts.safe.call(context.Background(), common.HexToAddress(ts.safeAddr), "getTransactionHash",
+		common.HexToAddress(safeTx.To), &safeTx.Value, safeTx.Data, uint8(0), zero, zero, zero,
+		common.Address{}, common.Address{}, new(big.Int).SetUint64(safeTx.Nonce))
+	require.NoError(t, err)
+	want, ok := values[0].([32]byte)
+	require.True(t, ok)
+
+	got := TransactionHash(big.NewInt(simulatedChainID), common.HexToAddress(ts.safeAddr), safeTx)
+	assert.Equal(t, common.Hash(want), got)
+}
+
+// TestExecTransaction is test for CreateTransaction, SignTransaction and ExecTransaction
+func TestExecTransaction(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestSafe(t)
+	client := ts.backend.Client()
+	receiver := common.HexToAddress("0x328F371a76dfAc47b89Cc007bb048ec446c21494")
+
+	rawTx, txDetail, err := ts.safe.CreateTransaction(ctx, ts.safeAddr, receiver.Hex(), 300_000, 0)
+	require.NoError(t, err)
+	assert.Equal(t, rawTx.Hash, txDetail.UnsignedHexTX)
+	assert.Equal(t, uint64(2), rawTx.Safe.Threshold)
+
+	// sign wallet of each owner signs safeTxHash offline
+	offline, err := NewSafe(nil, nil, simulatedChainID, config.FeeModelEIP1559, &config.Safe{})
+	require.NoError(t, err)
+	isSigned, err := offline.SignTransaction(rawTx, hex.EncodeToString(crypto.FromECDSA(ts.owners[2])))
+	require.NoError(t, err)
+	assert.False(t, isSigned)
+	isSigned, err = offline.SignTransaction(rawTx, hex.EncodeToString(crypto.FromECDSA(ts.owners[0])))
+	require.NoError(t, err)
+	assert.True(t, isSigned)
+
+	_, txHash, err := ts.safe.ExecTransaction(ctx, rawTx, ts.executor)
+	require.NoError(t, err)
+	ts.backend.Commit()
+
+	receipt, err := client.TransactionReceipt(ctx, common.HexToHash(txHash))
+	require.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	balance, err := client.BalanceAt(ctx, receiver, nil)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(300_000), balance)
+	nonce, err := ts.safe.Nonce(ctx, ts.safeAddr)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), nonce)
+
+	// same transaction can't be executed again
+	_, _, err = ts.safe.ExecTransaction(ctx, rawTx, ts.executor)
+	assert.Error(t, err)
+}
+
+// TestExecTransactionError is test for ExecTransaction with invalid signatures
+func TestExecTransactionError(t *testing.T) {
+	ctx := context.Background()
+	ts := newTestSafe(t)
+	outsider, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		signers []*ecdsa.PrivateKey
+		modify  func(rawTx *ethtx.RawTx)
+	}{
+		{
+			name:    "signatures are insufficient",
+			signers: []*ecdsa.PrivateKey{ts.owners[0]},
+		},
+		{
+			name:    "signer is not owner",
+			signers: []*ecdsa.PrivateKey{ts.owners[0], outsider},
+		},
+		{
+			name:    "value is modified after signing",
+			signers: []*ecdsa.PrivateKey{ts.owners[0], ts.owners[1]},
+			modify: func(rawTx *ethtx.RawTx) {
+				rawTx.Safe.Value = *big.NewInt(900_000)
+			},
+		},
+		{
+			name:    "transaction is created for other chain",
+			signers: []*ecdsa.PrivateKey{ts.owners[0], ts.owners[1]},
+			modify: func(rawTx *ethtx.RawTx) {
+				rawTx.ChainID = 1
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawTx, _, err := ts.safe.CreateTransaction(
+				ctx, ts.safeAddr, "0x328F371a76dfAc47b89Cc007bb048ec446c21494", 300_000, 0)
+			require.NoError(t, err)
+			for _, signer := range tt.signers {
+				_, err = ts.safe.SignTransaction(rawTx, hex.EncodeToString(crypto.FromECDSA(signer)))
+				require.NoError(t, err)
+			}
+			if tt.modify != nil {
+				tt.modify(rawTx)
+			}
+			_, _, err = ts.safe.ExecTransaction(ctx, rawTx, ts.executor)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestSignTransaction is test for SignTransaction
+func TestSignTransaction(t *testing.T) {
+	owner, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	privKey := hex.EncodeToString(crypto.FromECDSA(owner))
+	safe, err := NewSafe(nil, nil, simulatedChainID, config.FeeModelLegacy, &config.Safe{})
+	require.NoError(t, err)
+
+	newRawTx := func() *ethtx.RawTx {
+		safeAddr := common.HexToAddress("0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359")
+		safeTx := &ethtx.SafeTx{
+			To:        "0x328F371a76dfAc47b89Cc007bb048ec446c21494",
+			Value:     *big.NewInt(1000),
+			Nonce:     3,
+			Threshold: 2,
+		}
+		return &ethtx.RawTx{
+			ChainID: simulatedChainID,
+			From:    safeAddr.Hex(),
+			To:      safeTx.To,
+			Value:   safeTx.Value,
+			Nonce:   safeTx.Nonce,
+			Hash:    TransactionHash(big.NewInt(simulatedChainID), safeAddr, safeTx).Hex(),
+			Safe:    safeTx,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(rawTx *ethtx.RawTx)
+		wantErr bool
+	}{
+		{
+			name: "valid transaction",
+		},
+		{
+			name: "hash doesn't match transaction",
+			modify: func(rawTx *ethtx.RawTx) {
+				rawTx.Safe.To = "0x0000000000000000000000000000000000000001"
+			},
+			wantErr: true,
+		},
+		{
+			name: "transaction is created for other chain",
+			modify: func(rawTx *ethtx.RawTx) {
+				rawTx.ChainID = 1
+			},
+			wantErr: true,
+		},
+		{
+			name: "owner already signed",
+			modify: func(rawTx *ethtx.RawTx) {
+				_, err := safe.SignTransaction(rawTx, privKey)
+				require.NoError(t, err)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawTx := newRawTx()
+			if tt.modify != nil {
+				tt.modify(rawTx)
+			}
+			_, err := safe.SignTransaction(rawTx, privKey)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, rawTx.Safe.Signatures, 1)
+			signer, err := recoverOwner(common.HexToHash(rawTx.Hash), rawTx.Safe.Signatures[0].Signature)
+			require.NoError(t, err)
+			assert.Equal(t, crypto.PubkeyToAddress(owner.PublicKey), signer)
+		})
+	}
+}
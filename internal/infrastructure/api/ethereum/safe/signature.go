@@ -0,0 +1,122 @@
+package safe
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
+)
+
+var (
+	// EIP-712 domain of Safe v1.3.0 or later
+	domainSeparatorTypeHash = crypto.Keccak256Hash(
+		[]byte("EIP712Domain(uint256 chainId,address verifyingContract)"),
+	)
+	safeTxTypeHash = crypto.Keccak256Hash([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation," +
+		"uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"))
+)
+
+// TransactionHash returns safeTxHash of EIP-712 which is signed by owners
+//   - it's same as getTransactionHash() of Safe, operation and gas refund fields are zero
+func TransactionHash(chainID *big.Int, safeAddr common.Address, tx *ethtx.SafeTx) common.Hash {
+	domainSeparator := crypto.Keccak256Hash(
+		domainSeparatorTypeHash.Bytes(),
+		common.LeftPadBytes(chainID.Bytes(), 32),
+		common.LeftPadBytes(safeAddr.Bytes(), 32),
+	)
+	zero := make([]byte, 32)
+	structHash := crypto.Keccak256Hash(
+		safeTxTypeHash.Bytes(),
+		common.LeftPadBytes(common.HexToAddress(tx.To).Bytes(), 32),
+		common.LeftPadBytes(tx.Value.Bytes(), 32),
+		crypto.Keccak256(tx.Data),
+		zero, // operation: call
+		zero, // safeTxGas
+		zero, // baseGas
+		zero, // gasPrice
+		zero, // gasToken
+		zero, // refundReceiver
+		common.LeftPadBytes(new(big.Int).SetUint64(tx.Nonce).Bytes(), 32),
+	)
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator.Bytes(), structHash.Bytes())
+}
+
+// signHash signs safeTxHash, v is 27 or 28 so that Safe recovers owner by ecrecover
+func signHash(hash common.Hash, key *ecdsa.PrivateKey) ([]byte, error) {
+	sig, err := crypto.Sign(hash.Bytes(), key)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call crypto.Sign(): %w", err)
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// recoverOwner returns owner address which signed safeTxHash
+func recoverOwner(hash common.Hash, sig []byte) (common.Address, error) {
+	if len(sig) != crypto.SignatureLength || sig[crypto.RecoveryIDOffset] < 27 {
+		return common.Address{}, errors.New("signature format is invalid")
+	}
+	recoverable := bytes.Clone(sig)
+	recoverable[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(hash.Bytes(), recoverable)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("fail to call crypto.SigToPub(): %w", err)
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// verifySignatures returns signatures for execTransaction after verifying them
+//   - signer must be current owner, and signatures must reach current threshold
+func verifySignatures(
+	hash common.Hash, sigs []ethtx.SafeSignature, owners []common.Address, threshold uint64,
+) ([]byte, error) {
+	isOwner := make(map[common.Address]bool, len(owners))
+	for _, owner := range owners {
+		isOwner[owner] = true
+	}
+	signed := make(map[common.Address]bool, len(sigs))
+	for _, sig := range sigs {
+		owner, err := recoverOwner(hash, sig.Signature)
+		if err != nil {
+			return nil, err
+		}
+		if owner != common.HexToAddress(sig.Owner) {
+			return nil, fmt.Errorf("signature of %s is invalid for safeTxHash %s", sig.Owner, hash.Hex())
+		}
+		if !isOwner[owner] {
+			return nil, fmt.Errorf("%s is not owner of Safe", owner.Hex())
+		}
+		if signed[owner] {
+			return nil, fmt.Errorf("%s signed twice", owner.Hex())
+		}
+		signed[owner] = true
+	}
+	if uint64(len(sigs)) < threshold {
+		return nil, fmt.Errorf("%d signatures are collected, but Safe requires %d", len(sigs), threshold)
+	}
+	return packSignatures(sigs), nil
+}
+
+// packSignatures concatenates signatures in ascending order of owner address which Safe requires
+func packSignatures(sigs []ethtx.SafeSignature) []byte {
+	sorted := make([]ethtx.SafeSignature, len(sigs))
+	copy(sorted, sigs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(
+			common.HexToAddress(sorted[i].Owner).Bytes(),
+			common.HexToAddress(sorted[j].Owner).Bytes(),
+		) < 0
+	})
+	packed := make([]byte, 0, len(sorted)*crypto.SignatureLength)
+	for _, sig := range sorted {
+		packed = append(packed, sig.Signature...)
+	}
+	return packed
+}
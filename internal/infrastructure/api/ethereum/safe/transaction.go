@@ -0,0 +1,334 @@
+package safe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// gas of execTransaction except inner call, inner call is estimated as transaction from Safe
+//   - base covers nonce update, event and value transfer to new account which isn't counted by estimation
+//   - ecrecover and owner lookup are needed for each signature
+const (
+	execBaseGas      = 100000
+	execSignatureGas = 10000
+)
+
+// CreateTransaction creates Safe transaction which sends coin from Safe
+//   - amount 0 means all balance of Safe, executor pays fee instead of Safe
+//   - nonce of Safe must be incremented when creating multiple transactions from same Safe
+func (s *Safe) CreateTransaction(
+	ctx context.Context, safeAddr, toAddr string, amount uint64, additionalNonce int,
+) (*ethtx.RawTx, *models.EthDetailTX, error) {
+	if !common.IsHexAddress(safeAddr) || !common.IsHexAddress(toAddr) {
+		return nil, nil, errors.New("address validation error")
+	}
+	logger.Debug("safe.CreateTransaction()",
+		"safeAddr", safeAddr,
+		"toAddr", toAddr,
+		"amount", amount,
+	)
+
+	// transaction must be created on the chain of config
+	if err := s.validateNode(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	nonce, err := s.Nonce(ctx, safeAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call safe.Nonce(): %w", err)
+	}
+	nonce += uint64(additionalNonce)
+	threshold, err := s.Threshold(ctx, safeAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call safe.Threshold(): %w", err)
+	}
+
+	balance, err := s.client.PendingBalanceAt(ctx, common.HexToAddress(safeAddr))
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call client.PendingBalanceAt(): %w", err)
+	}
+	value := new(big.Int).SetUint64(amount)
+	if amount == 0 {
+		value = balance
+	}
+	if value.Sign() == 0 {
+		return nil, nil, errors.New("balance is needed to send")
+	}
+	if balance.Cmp(value) == -1 {
+		return nil, nil, fmt.Errorf("balance`%d` is insufficient to send `%d`", balance, value)
+	}
+
+	safeTx := &ethtx.SafeTx{
+		To:        toAddr,
+		Value:     *value,
+		Nonce:     nonce,
+		Threshold: threshold,
+	}
+	safeTxHash := TransactionHash(s.chainID, common.HexToAddress(safeAddr), safeTx)
+
+	// generate UUID to trace transaction because safeTxHash is signed on other wallets
+	uid, err := s.uuidHandler.GenerateV7()
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call uuidHandler.GenerateV7(): %w", err)
+	}
+
+	// create insert data for eth_detail_tx, fee is paid by executor
+	txDetailItem := &models.EthDetailTX{
+		UUID:            uid.String(),
+		SenderAccount:   "",
+		SenderAddress:   safeAddr,
+		ReceiverAccount: "",
+		ReceiverAddress: toAddr,
+		Amount:          value.Uint64(),
+		Fee:             0,
+		GasLimit:        0,
+		Nonce:           nonce,
+		UnsignedHexTX:   safeTxHash.Hex(),
+	}
+
+	rawTx := &ethtx.RawTx{
+		UUID:    uid.String(),
+		ChainID: s.chainID.Uint64(),
+		From:    safeAddr,
+		To:      toAddr,
+		Value:   *value,
+		Nonce:   nonce,
+		Hash:    safeTxHash.Hex(),
+		Safe:    safeTx,
+	}
+	return rawTx, txDetailItem, nil
+}
+
+// SignTransaction adds signature of owner over safeTxHash, it works offline
+//   - returns true when signatures reach threshold
+func (s *Safe) SignTransaction(rawTx *ethtx.RawTx, privKey string) (bool, error) {
+	if rawTx.Safe == nil {
+		return false, errors.New("transaction is not Safe transaction")
+	}
+	// safeTxHash includes chain ID, but signature for other chain is useless
+	if rawTx.ChainID != s.chainID.Uint64() {
+		return false, fmt.Errorf("transaction is created for chain %d, config is %d: %w",
+			rawTx.ChainID, s.chainID, eth.ErrChainIDMismatch)
+	}
+
+	// hash is computed again not to sign what watch wallet claims
+	safeTxHash := TransactionHash(s.chainID, common.HexToAddress(rawTx.From), rawTx.Safe)
+	if !strings.EqualFold(safeTxHash.Hex(), rawTx.Hash) {
+		return false, fmt.Errorf("safeTxHash %s doesn't match transaction %s", rawTx.Hash, safeTxHash.Hex())
+	}
+
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privKey, "0x"))
+	if err != nil {
+		return false, fmt.Errorf("fail to call crypto.HexToECDSA(): %w", err)
+	}
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+	for _, sig := range rawTx.Safe.Signatures {
+		if common.HexToAddress(sig.Owner) == owner {
+			return false, fmt.Errorf("%s already signed on safeTxHash %s", owner.Hex(), rawTx.Hash)
+		}
+	}
+
+	sig, err := signHash(safeTxHash, key)
+	if err != nil {
+		return false, err
+	}
+	rawTx.Safe.Signatures = append(rawTx.Safe.Signatures, ethtx.SafeSignature{
+		Owner:     owner.Hex(),
+		Signature: sig,
+	})
+	return rawTx.Safe.IsSigned(), nil
+}
+
+// ExecTransaction sends execTransaction with collected signatures by executor
+//   - returns signed transaction hex and transaction hash
+func (s *Safe) ExecTransaction(
+	ctx context.Context, rawTx *ethtx.RawTx, executor *ecdsa.PrivateKey,
+) (string, string, error) {
+	if rawTx.Safe == nil {
+		return "", "", errors.New("transaction is not Safe transaction")
+	}
+	if err := s.validateNode(ctx); err != nil {
+		return "", "", err
+	}
+	if rawTx.ChainID != s.chainID.Uint64() {
+		return "", "", fmt.Errorf("transaction is created for chain %d, config is %d: %w",
+			rawTx.ChainID, s.chainID, eth.ErrChainIDMismatch)
+	}
+
+	safeAddr := common.HexToAddress(rawTx.From)
+	safeTx := rawTx.Safe
+	nonce, err := s.Nonce(ctx, rawTx.From)
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call safe.Nonce(): %w", err)
+	}
+	if nonce > safeTx.Nonce {
+		return "", "", fmt.Errorf("nonce %d of Safe is already used, current nonce is %d", safeTx.Nonce, nonce)
+	}
+
+	// owners and threshold may be changed after transaction is created
+	owners, err := s.Owners(ctx, rawTx.From)
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call safe.Owners(): %w", err)
+	}
+	threshold, err := s.Threshold(ctx, rawTx.From)
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call safe.Threshold(): %w", err)
+	}
+	signatures, err := verifySignatures(
+		TransactionHash(s.chainID, safeAddr, safeTx), safeTx.Signatures, owners, threshold)
+	if err != nil {
+		return "", "", err
+	}
+
+	to := common.HexToAddress(safeTx.To)
+	zero := new(big.Int)
+	data, err := s.abi.Pack("execTransaction",
+		to, &safeTx.Value, safeTx.Data, uint8(0), zero, zero, zero, common.Address{}, common.Address{}, signatures)
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call abi.Pack(execTransaction): %w", err)
+	}
+
+	// execTransaction can't be estimated until former transaction of Safe is executed,
+	// so only inner call is estimated
+	innerGas, err := s.client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  safeAddr,
+		To:    &to,
+		Value: &safeTx.Value,
+		Data:  safeTx.Data,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call client.EstimateGas(): %w", err)
+	}
+	gas := innerGas + execBaseGas + execSignatureGas*uint64(len(safeTx.Signatures)) + 16*uint64(len(data))
+
+	signedTx, err := s.sendTx(ctx, executor, safeAddr, data, gas)
+	if err != nil {
+		return "", "", err
+	}
+	encodedTx, err := ethtx.EncodeTx(signedTx)
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call encodeTx(): %w", err)
+	}
+	return *encodedTx, signedTx.Hash().Hex(), nil
+}
+
+// Deploy deploys Safe by proxy factory, executor sends transaction
+//   - Safe address is decided by owners, threshold and salt nonce
+//   - returns Safe address and transaction hash
+func (s *Safe) Deploy(
+	ctx context.Context, owners []string, threshold, saltNonce uint64, executor *ecdsa.PrivateKey,
+) (string, string, error) {
+	if s.proxyFactory == (common.Address{}) || s.singleton == (common.Address{}) {
+		return "", "", errors.New("proxy_factory and singleton of ethereum.safe are required in toml file")
+	}
+	if threshold == 0 || threshold > uint64(len(owners)) {
+		return "", "", fmt.Errorf("threshold %d is invalid for %d owners", threshold, len(owners))
+	}
+	if err := s.validateNode(ctx); err != nil {
+		return "", "", err
+	}
+
+	ownerAddrs := make([]common.Address, len(owners))
+	for i, owner := range owners {
+		if !common.IsHexAddress(owner) {
+			return "", "", fmt.Errorf("owner address %s is invalid", owner)
+		}
+		ownerAddrs[i] = common.HexToAddress(owner)
+	}
+	zero := new(big.Int)
+	initializer, err := s.abi.Pack("setup", ownerAddrs, new(big.Int).SetUint64(threshold),
+		common.Address{}, []byte{}, s.fallbackHandler, common.Address{}, zero, common.Address{})
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call abi.Pack(setup): %w", err)
+	}
+	data, err := s.abi.Pack("createProxyWithNonce", s.singleton, initializer, new(big.Int).SetUint64(saltNonce))
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call abi.Pack(createProxyWithNonce): %w", err)
+	}
+
+	// proxy address is returned by calling factory before sending transaction
+	res, err := s.client.CallContract(ctx, ethereum.CallMsg{
+		From: crypto.PubkeyToAddress(executor.PublicKey),
+		To:   &s.proxyFactory,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call client.CallContract(createProxyWithNonce): %w", err)
+	}
+	values, err := s.abi.Unpack("createProxyWithNonce", res)
+	if err != nil || len(values) == 0 {
+		return "", "", fmt.Errorf("fail to call abi.Unpack(createProxyWithNonce): %w", err)
+	}
+	safeAddr, ok := values[0].(common.Address)
+	if !ok {
+		return "", "", errors.New("fail to cast proxy to common.Address")
+	}
+
+	signedTx, err := s.sendTx(ctx, executor, s.proxyFactory, data, 0)
+	if err != nil {
+		return "", "", err
+	}
+	return safeAddr.Hex(), signedTx.Hash().Hex(), nil
+}
+
+// sendTx signs and sends transaction of executor, gas is estimated when 0
+func (s *Safe) sendTx(
+	ctx context.Context, executor *ecdsa.PrivateKey, to common.Address, data []byte, gas uint64,
+) (*types.Transaction, error) {
+	from := crypto.PubkeyToAddress(executor.PublicKey)
+	if gas == 0 {
+		estimatedGas, err := s.client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &to, Data: data})
+		if err != nil {
+			return nil, fmt.Errorf("fail to call client.EstimateGas(): %w", err)
+		}
+		gas = estimatedGas
+	}
+	gasFee, err := eth.SuggestGasFee(ctx, s.client, s.feeModel)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call eth.SuggestGasFee(): %w", err)
+	}
+
+	// executor pays fee of execTransaction instead of Safe
+	balance, err := s.client.PendingBalanceAt(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call client.PendingBalanceAt(): %w", err)
+	}
+	maxFee := new(big.Int).Mul(gasFee.GasFeeCap, new(big.Int).SetUint64(gas))
+	if balance.Cmp(maxFee) == -1 {
+		return nil, fmt.Errorf("executor %s has %d, but %d is needed for fee", from.Hex(), balance, maxFee)
+	}
+	nonce, err := s.client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call client.PendingNonceAt(): %w", err)
+	}
+
+	tx := ethtx.NewTx(s.chainID, nonce, to, new(big.Int), gas, gasFee, data)
+	signedTx, err := types.SignTx(tx, types.NewLondonSigner(s.chainID), executor)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call types.SignTx(): %w", err)
+	}
+	if err = s.client.SendTransaction(ctx, signedTx); err != nil {
+		return nil, fmt.Errorf("fail to call client.SendTransaction(): %w", err)
+	}
+	logger.Debug("executor sent transaction",
+		"from", from.Hex(),
+		"to", to.Hex(),
+		"gas", gas,
+		"txHash", signedTx.Hash().Hex(),
+	)
+	return signedTx, nil
+}
@@ -0,0 +1,228 @@
+package scanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/guregu/null/v6"
+
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// BlockScannerName is name of stream cursor for BlockScanner
+const BlockScannerName = "eth_block"
+
+// errCodeMethodNotFound is JSON-RPC error code when node doesn't provide the method
+const errCodeMethodNotFound = -32601
+
+// Tracer calls debug API of node, rpc.Client satisfies it
+type Tracer interface {
+	CallContext(ctx context.Context, result any, method string, args ...any) error
+}
+
+// callFrame is result of callTracer
+type callFrame struct {
+	Type  string       `json:"type"`
+	From  string       `json:"from"`
+	To    string       `json:"to"`
+	Value *hexutil.Big `json:"value"`
+	Error string       `json:"error"`
+	Calls []callFrame  `json:"calls"`
+}
+
+// txTrace is result of debug_traceBlockByHash for each transaction
+type txTrace struct {
+	TxHash string    `json:"txHash"`
+	Result callFrame `json:"result"`
+}
+
+// BlockScanner detects deposits of native coin by transactions of blocks
+//   - transfers by contract (internal transactions) are detected by callTracer of debug_traceBlockByHash
+//     only when node supports it, otherwise value of top level transactions is detected
+//   - LogIndex of deposit is index of call frame in transaction by depth first order, top level call is 0
+type BlockScanner struct {
+	chain
+	tracer Tracer
+}
+
+// NewBlockScanner returns BlockScanner object
+//   - tracer can be nil, then internal transactions are not detected
+func NewBlockScanner(client Backend, tracer Tracer) *BlockScanner {
+	return &BlockScanner{
+		chain:  chain{client: client},
+		tracer: tracer,
+	}
+}
+
+// Name returns name of stream cursor
+func (s *BlockScanner) Name() string {
+	return BlockScannerName
+}
+
+// TokenContracts returns empty string as native coin
+func (*BlockScanner) TokenContracts() []string {
+	return []string{""}
+}
+
+// Scan returns transfers of native coin into addrs between fromBlock and toBlock inclusive
+//   - failed or reverted transfer is skipped
+//   - ReceiverAccount isn't set, ReceiverAddress is the same string as addrs
+func (s *BlockScanner) Scan(
+	ctx context.Context, fromBlock, toBlock uint64, addrs []string,
+) ([]*models.EthDeposit, error) {
+	if len(addrs) == 0 {
+		return nil, nil
+	}
+
+	// hex string may be stored in different case
+	receivers := make(map[common.Address]string, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := receivers[common.HexToAddress(addr)]; !ok {
+			receivers[common.HexToAddress(addr)] = addr
+		}
+	}
+
+	var deposits []*models.EthDeposit
+	for number := fromBlock; number <= toBlock; number++ {
+		block, err := s.client.BlockByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, fmt.Errorf("fail to call client.BlockByNumber(%d): %w", number, err)
+		}
+
+		var blockDeposits []*models.EthDeposit
+		traced := false
+		if s.tracer != nil {
+			blockDeposits, traced, err = s.scanTrace(ctx, block, receivers)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if !traced {
+			blockDeposits, err = s.scanTransactions(ctx, block, receivers)
+			if err != nil {
+				return nil, err
+			}
+		}
+		deposits = append(deposits, blockDeposits...)
+	}
+	logger.Debug("scanner.BlockScanner.Scan()",
+		"from", fromBlock,
+		"to", toBlock,
+		"trace", s.tracer != nil,
+		"deposits", len(deposits),
+	)
+
+	return deposits, nil
+}
+
+// scanTransactions returns value of top level transactions into receivers
+func (s *BlockScanner) scanTransactions(
+	ctx context.Context, block *types.Block, receivers map[common.Address]string,
+) ([]*models.EthDeposit, error) {
+	var deposits []*models.EthDeposit
+	for _, tx := range block.Transactions() {
+		if tx.To() == nil || tx.Value().Sign() == 0 {
+			continue
+		}
+		receiver, ok := receivers[*tx.To()]
+		if !ok {
+			continue
+		}
+		receipt, err := s.client.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("fail to call client.TransactionReceipt(%s): %w", tx.Hash().Hex(), err)
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		sender, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call types.Sender(%s): %w", tx.Hash().Hex(), err)
+		}
+		deposits = append(deposits, newNativeDeposit(block, tx.Hash().Hex(), 0, sender.Hex(), receiver, tx.Value()))
+	}
+	return deposits, nil
+}
+
+// scanTrace returns value transfers into receivers in call frames of transactions
+//   - false is returned when node doesn't support debug_traceBlockByHash, then tracer is disabled
+func (s *BlockScanner) scanTrace(
+	ctx context.Context, block *types.Block, receivers map[common.Address]string,
+) ([]*models.EthDeposit, bool, error) {
+	var traces []txTrace
+	err := s.tracer.CallContext(ctx, &traces, "debug_traceBlockByHash", block.Hash(),
+		map[string]string{"tracer": "callTracer"})
+	if err != nil {
+		var rpcErr rpc.Error
+		if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == errCodeMethodNotFound {
+			logger.Warn("node doesn't support debug_traceBlockByHash, internal transactions are not scanned",
+				"error", err)
+			s.tracer = nil
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("fail to call debug_traceBlockByHash(%d): %w", block.NumberU64(), err)
+	}
+	if len(traces) != len(block.Transactions()) {
+		return nil, false, fmt.Errorf("number of traces %d doesn't match transactions %d of block %d",
+			len(traces), len(block.Transactions()), block.NumberU64())
+	}
+
+	var deposits []*models.EthDeposit
+	for i, tx := range block.Transactions() {
+		var index uint32
+		walkCallFrame(&traces[i].Result, &index, func(frame *callFrame, frameIndex uint32) {
+			receiver, ok := receivers[common.HexToAddress(frame.To)]
+			if !ok {
+				return
+			}
+			deposits = append(deposits, newNativeDeposit(
+				block, tx.Hash().Hex(), frameIndex, common.HexToAddress(frame.From).Hex(), receiver, frame.Value.ToInt()))
+		})
+	}
+	return deposits, true, nil
+}
+
+// walkCallFrame calls fn for frames which transfer value in depth first order
+//   - frame with error is reverted with its sub calls
+//   - DELEGATECALL, STATICCALL and CALLCODE don't move value to `to`
+func walkCallFrame(frame *callFrame, index *uint32, fn func(frame *callFrame, frameIndex uint32)) {
+	frameIndex := *index
+	*index++
+	if frame.Error != "" {
+		return
+	}
+	switch strings.ToUpper(frame.Type) {
+	case "CALL", "SELFDESTRUCT", "CREATE", "CREATE2":
+		if frame.Value != nil && frame.Value.ToInt().Sign() > 0 {
+			fn(frame, frameIndex)
+		}
+	}
+	for i := range frame.Calls {
+		walkCallFrame(&frame.Calls[i], index, fn)
+	}
+}
+
+func newNativeDeposit(
+	block *types.Block, txHash string, index uint32, sender, receiver string, amount *big.Int,
+) *models.EthDeposit {
+	return &models.EthDeposit{
+		TokenContract:   "",
+		TXHash:          txHash,
+		LogIndex:        index,
+		BlockNumber:     block.NumberU64(),
+		BlockHash:       block.Hash().Hex(),
+		BlockTime:       null.TimeFrom(time.Unix(int64(block.Time()), 0)),
+		SenderAddress:   sender,
+		ReceiverAddress: receiver,
+		Amount:          amount.String(),
+	}
+}
@@ -0,0 +1,111 @@
+package scanner
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTracer returns fixed result of debug_traceBlockByHash, nil result means method not found
+type fakeTracer struct {
+	result *string
+}
+
+func (f *fakeTracer) CallContext(_ context.Context, result any, _ string, _ ...any) error {
+	if f.result == nil {
+		return methodNotFoundError{}
+	}
+	return json.Unmarshal([]byte(*f.result), result)
+}
+
+// methodNotFoundError is returned by node which doesn't provide debug API
+type methodNotFoundError struct{}
+
+func (methodNotFoundError) Error() string  { return "the method debug_traceBlockByHash does not exist" }
+func (methodNotFoundError) ErrorCode() int { return errCodeMethodNotFound }
+
+// newTestBlock sends coin to receiver on simulated backend
+func newTestBlock(t *testing.T, receiver common.Address, value *big.Int) (*simulated.Backend, *types.Receipt) {
+	t.Helper()
+	payer, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	fund, _ := new(big.Int).SetString("100000000000000000000", 10)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(payer.PublicKey): {Balance: fund},
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+
+	receipt := sendTx(t, backend, payer, receiver, value, nil)
+	return backend, receipt
+}
+
+func TestBlockScan(t *testing.T) {
+	ctx := context.Background()
+	receiver := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	amount := big.NewInt(1_000_000_000)
+	backend, receipt := newTestBlock(t, receiver, amount)
+	client := backend.Client()
+
+	// node doesn't provide debug API, then top level transactions are scanned
+	s := NewBlockScanner(client, &fakeTracer{})
+	assert.Equal(t, []string{""}, s.TokenContracts())
+
+	latest, err := s.BlockNumber(ctx)
+	require.NoError(t, err)
+	addrs := []string{strings.ToLower(receiver.Hex())}
+	deposits, err := s.Scan(ctx, 1, latest, addrs)
+	require.NoError(t, err)
+	assert.Nil(t, s.tracer)
+
+	require.Len(t, deposits, 1)
+	deposit := deposits[0]
+	assert.Empty(t, deposit.TokenContract)
+	assert.Equal(t, receipt.TxHash.Hex(), deposit.TXHash)
+	assert.Equal(t, uint32(0), deposit.LogIndex)
+	assert.Equal(t, receipt.BlockNumber.Uint64(), deposit.BlockNumber)
+	assert.Equal(t, receipt.BlockHash.Hex(), deposit.BlockHash)
+	assert.Equal(t, addrs[0], deposit.ReceiverAddress)
+	assert.Equal(t, amount.String(), deposit.Amount)
+	assert.True(t, deposit.BlockTime.Valid)
+}
+
+func TestBlockScanTrace(t *testing.T) {
+	ctx := context.Background()
+	contract := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	receiver := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	backend, receipt := newTestBlock(t, contract, big.NewInt(10))
+
+	// contract sends coin to receiver, reverted call and delegatecall are skipped
+	trace := `[{"txHash":"` + receipt.TxHash.Hex() + `","result":{
+"type":"CALL","from":"0x4444444444444444444444444444444444444444","to":"` + contract.Hex() + `","value":"0xa",
+"calls":[
+  {"type":"CALL","from":"` + contract.Hex() + `","to":"` + receiver.Hex() + `","value":"0x3"},
+  {"type":"CALL","from":"` + contract.Hex() + `","to":"` + receiver.Hex() + `","value":"0x4","error":"execution reverted"},
+  {"type":"DELEGATECALL","from":"` + contract.Hex() + `","to":"` + receiver.Hex() + `","value":"0x5"},
+  {"type":"CALL","from":"` + contract.Hex() + `","to":"0x5555555555555555555555555555555555555555","value":"0x1",
+   "calls":[{"type":"SELFDESTRUCT","from":"0x5555555555555555555555555555555555555555","to":"` + receiver.Hex() + `","value":"0x6"}]}
+]}}]`
+	s := NewBlockScanner(backend.Client(), &fakeTracer{result: &trace})
+
+	deposits, err := s.Scan(ctx, receipt.BlockNumber.Uint64(), receipt.BlockNumber.Uint64(), []string{receiver.Hex()})
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+	assert.Equal(t, uint32(1), deposits[0].LogIndex)
+	assert.Equal(t, contract.Hex(), deposits[0].SenderAddress)
+	assert.Equal(t, "3", deposits[0].Amount)
+	assert.Equal(t, uint32(5), deposits[1].LogIndex)
+	assert.Equal(t, "6", deposits[1].Amount)
+	for _, deposit := range deposits {
+		assert.Equal(t, receipt.TxHash.Hex(), deposit.TXHash)
+		assert.Equal(t, receiver.Hex(), deposit.ReceiverAddress)
+	}
+}
@@ -0,0 +1,44 @@
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+)
+
+// Deposit scanner
+// - scanner detects deposits into our addresses from range of blocks
+// - cursor, confirmation depth and reorg are handled by caller with BlockNumber() and BlockHash()
+
+// Backend is client of EVM node which scanner calls, ethclient.Client and simulated backend satisfy it
+type Backend interface {
+	ethereum.BlockNumberReader
+	ethereum.ChainReader
+	ethereum.LogFilterer
+	ethereum.TransactionReader
+}
+
+// chain provides block number and hash for caller of scanner
+type chain struct {
+	client Backend
+}
+
+// BlockNumber returns latest block number
+func (c *chain) BlockNumber(ctx context.Context) (uint64, error) {
+	number, err := c.client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to call client.BlockNumber(): %w", err)
+	}
+	return number, nil
+}
+
+// BlockHash returns hash of canonical block at number
+func (c *chain) BlockHash(ctx context.Context, number uint64) (string, error) {
+	header, err := c.client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return "", fmt.Errorf("fail to call client.HeaderByNumber(%d): %w", number, err)
+	}
+	return header.Hash().Hex(), nil
+}
@@ -0,0 +1,144 @@
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/guregu/null/v6"
+
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// TransferScannerName is name of stream cursor for TransferScanner
+const TransferScannerName = "erc20_transfer"
+
+// maxTopicAddresses is number of recipients in one eth_getLogs filter to keep request small
+const maxTopicAddresses = 500
+
+// transferTopic is topic of `Transfer(address indexed from, address indexed to, uint256 value)`
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// TransferScanner detects ERC-20 token deposits by Transfer event logs
+type TransferScanner struct {
+	chain
+	tokens []common.Address
+}
+
+// NewTransferScanner returns TransferScanner object
+//   - tokens are contract addresses of ERC-20 tokens to scan
+func NewTransferScanner(client Backend, tokens []string) (*TransferScanner, error) {
+	tokenAddrs := make([]common.Address, 0, len(tokens))
+	for _, token := range tokens {
+		if !common.IsHexAddress(token) {
+			return nil, fmt.Errorf("token address %s is invalid", token)
+		}
+		tokenAddrs = append(tokenAddrs, common.HexToAddress(token))
+	}
+
+	return &TransferScanner{
+		chain:  chain{client: client},
+		tokens: tokenAddrs,
+	}, nil
+}
+
+// Name returns name of stream cursor
+func (s *TransferScanner) Name() string {
+	return TransferScannerName
+}
+
+// TokenContracts returns contract addresses of deposits which are detected
+func (s *TransferScanner) TokenContracts() []string {
+	contracts := make([]string, len(s.tokens))
+	for i, token := range s.tokens {
+		contracts[i] = token.Hex()
+	}
+	return contracts
+}
+
+// Scan returns token transfers into addrs between fromBlock and toBlock inclusive
+//   - recipients are filtered by node with topic, logs of removed block are skipped
+//   - ReceiverAccount isn't set, ReceiverAddress is the same string as addrs
+func (s *TransferScanner) Scan(
+	ctx context.Context, fromBlock, toBlock uint64, addrs []string,
+) ([]*models.EthDeposit, error) {
+	if len(s.tokens) == 0 || len(addrs) == 0 {
+		return nil, nil
+	}
+
+	// hex string may be stored in different case
+	receivers := make(map[common.Address]string, len(addrs))
+	topics := make([]common.Hash, 0, len(addrs))
+	for _, addr := range addrs {
+		receiver := common.HexToAddress(addr)
+		if _, ok := receivers[receiver]; ok {
+			continue
+		}
+		receivers[receiver] = addr
+		topics = append(topics, common.BytesToHash(receiver.Bytes()))
+	}
+
+	var logs []types.Log
+	for start := 0; start < len(topics); start += maxTopicAddresses {
+		end := min(start+maxTopicAddresses, len(topics))
+		chunk, err := s.client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(fromBlock),
+			ToBlock:   new(big.Int).SetUint64(toBlock),
+			Addresses: s.tokens,
+			Topics:    [][]common.Hash{{transferTopic}, nil, topics[start:end]},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("fail to call client.FilterLogs(%d-%d): %w", fromBlock, toBlock, err)
+		}
+		logs = append(logs, chunk...)
+	}
+
+	blockTimes := make(map[common.Hash]null.Time)
+	deposits := make([]*models.EthDeposit, 0, len(logs))
+	for i := range logs {
+		log := &logs[i]
+		// ERC-721 Transfer has tokenId as third indexed topic
+		if log.Removed || len(log.Topics) != 3 || log.Topics[0] != transferTopic || len(log.Data) != 32 {
+			continue
+		}
+		receiver, ok := receivers[common.BytesToAddress(log.Topics[2].Bytes())]
+		if !ok {
+			continue
+		}
+		blockTime, ok := blockTimes[log.BlockHash]
+		if !ok {
+			header, err := s.client.HeaderByHash(ctx, log.BlockHash)
+			if err != nil {
+				return nil, fmt.Errorf("fail to call client.HeaderByHash(%s): %w", log.BlockHash.Hex(), err)
+			}
+			blockTime = null.TimeFrom(time.Unix(int64(header.Time), 0))
+			blockTimes[log.BlockHash] = blockTime
+		}
+
+		deposits = append(deposits, &models.EthDeposit{
+			TokenContract:   log.Address.Hex(),
+			TXHash:          log.TxHash.Hex(),
+			LogIndex:        uint32(log.Index),
+			BlockNumber:     log.BlockNumber,
+			BlockHash:       log.BlockHash.Hex(),
+			BlockTime:       blockTime,
+			SenderAddress:   common.BytesToAddress(log.Topics[1].Bytes()).Hex(),
+			ReceiverAddress: receiver,
+			Amount:          new(big.Int).SetBytes(log.Data).String(),
+		})
+	}
+	logger.Debug("scanner.TransferScanner.Scan()",
+		"from", fromBlock,
+		"to", toBlock,
+		"logs", len(logs),
+		"deposits", len(deposits),
+	)
+
+	return deposits, nil
+}
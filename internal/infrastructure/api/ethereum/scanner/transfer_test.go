@@ -0,0 +1,133 @@
+package scanner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/ethtx"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+// simulated backend uses chain id 1337
+const simulatedChainID = 1337
+
+const wethABI = `[
+{"name":"deposit","type":"function","stateMutability":"payable","inputs":[],"outputs":[]},
+{"name":"transfer","type":"function","stateMutability":"nonpayable","inputs":[{"name":"dst","type":"address"},{"name":"wad","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+// sendTx sends transaction, contract is deployed when to is zero address
+func sendTx(
+	t *testing.T, backend *simulated.Backend, key *ecdsa.PrivateKey, to common.Address, value *big.Int, data []byte,
+) *types.Receipt {
+	t.Helper()
+	ctx := context.Background()
+	client := backend.Client()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	msg := ethereum.CallMsg{From: from, Value: value, Data: data}
+	if to != (common.Address{}) {
+		msg.To = &to
+	}
+	gas, err := client.EstimateGas(ctx, msg)
+	require.NoError(t, err)
+	gasFee, err := eth.SuggestGasFee(ctx, client, config.FeeModelEIP1559)
+	require.NoError(t, err)
+	nonce, err := client.PendingNonceAt(ctx, from)
+	require.NoError(t, err)
+
+	tx := ethtx.NewContractCreationTx(big.NewInt(simulatedChainID), nonce, value, gas, gasFee, data)
+	if msg.To != nil {
+		tx = ethtx.NewTx(big.NewInt(simulatedChainID), nonce, to, value, gas, gasFee, data)
+	}
+	signedTx, err := types.SignTx(tx, types.NewLondonSigner(big.NewInt(simulatedChainID)), key)
+	require.NoError(t, err)
+	require.NoError(t, client.SendTransaction(ctx, signedTx))
+	backend.Commit()
+
+	receipt, err := client.TransactionReceipt(ctx, signedTx.Hash())
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+	return receipt
+}
+
+func TestTransferScan(t *testing.T) {
+	ctx := context.Background()
+
+	payer, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	fund, _ := new(big.Int).SetString("100000000000000000000", 10)
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		crypto.PubkeyToAddress(payer.PublicKey): {Balance: fund},
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+	client := backend.Client()
+
+	// WETH9 is used as ERC-20 token
+	bin, err := os.ReadFile("../forwarder/testdata/weth9.bin")
+	require.NoError(t, err)
+	code, err := hex.DecodeString(strings.TrimSpace(string(bin)))
+	require.NoError(t, err)
+	token := sendTx(t, backend, payer, common.Address{}, new(big.Int), code).ContractAddress
+
+	parsed, err := abi.JSON(strings.NewReader(wethABI))
+	require.NoError(t, err)
+	amount := big.NewInt(1_000_000_000_000_000_000)
+	sendTx(t, backend, payer, token, new(big.Int).Mul(amount, big.NewInt(2)), parsed.Methods["deposit"].ID)
+
+	// one transfer to client address and one transfer to other address
+	client1 := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	other := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	data, err := parsed.Pack("transfer", client1, amount)
+	require.NoError(t, err)
+	receipt := sendTx(t, backend, payer, token, new(big.Int), data)
+	data, err = parsed.Pack("transfer", other, amount)
+	require.NoError(t, err)
+	sendTx(t, backend, payer, token, new(big.Int), data)
+
+	s, err := NewTransferScanner(client, []string{strings.ToLower(token.Hex())})
+	require.NoError(t, err)
+	assert.Equal(t, []string{token.Hex()}, s.TokenContracts())
+
+	latest, err := s.BlockNumber(ctx)
+	require.NoError(t, err)
+
+	// address is matched regardless of case
+	addrs := []string{strings.ToLower(client1.Hex())}
+	deposits, err := s.Scan(ctx, 1, latest, addrs)
+	require.NoError(t, err)
+	require.Len(t, deposits, 1)
+	deposit := deposits[0]
+	assert.Equal(t, token.Hex(), deposit.TokenContract)
+	assert.Equal(t, receipt.TxHash.Hex(), deposit.TXHash)
+	assert.Equal(t, receipt.BlockNumber.Uint64(), deposit.BlockNumber)
+	assert.Equal(t, receipt.BlockHash.Hex(), deposit.BlockHash)
+	assert.Equal(t, crypto.PubkeyToAddress(payer.PublicKey).Hex(), deposit.SenderAddress)
+	assert.Equal(t, addrs[0], deposit.ReceiverAddress)
+	assert.Equal(t, amount.String(), deposit.Amount)
+	assert.True(t, deposit.BlockTime.Valid)
+
+	blockHash, err := s.BlockHash(ctx, deposit.BlockNumber)
+	require.NoError(t, err)
+	assert.Equal(t, deposit.BlockHash, blockHash)
+
+	// range without transfer
+	deposits, err = s.Scan(ctx, deposit.BlockNumber+1, latest, addrs)
+	require.NoError(t, err)
+	assert.Empty(t, deposits)
+}
@@ -2,6 +2,7 @@ package ripple
 
 import (
 	"context"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg"
 
@@ -24,6 +25,64 @@ type Rippler interface {
 		ctx context.Context, senderAccount, receiverAccount string, amount float64, instructions *xrp.Instructions,
 	) (*xrp.TxInput, string, error)
 
+	// multisig
+	GetSignerList(ctx context.Context, address string) (*xrp.SignerList, error)
+	CreateSignerListSetTransaction(
+		ctx context.Context, account string, quorum uint32, signers []string, instructions *xrp.Instructions,
+	) (*xrp.TxInput, string, error)
+
+	// account settings
+	CreateAccountSetTransaction(
+		ctx context.Context, account string, setFlag uint32, instructions *xrp.Instructions,
+	) (*xrp.TxInput, string, error)
+	IsRequireDest(ctx context.Context, address string) (bool, error)
+	IsMasterDisabled(ctx context.Context, address string) (bool, error)
+
+	// regular key
+	CreateSetRegularKeyTransaction(
+		ctx context.Context, account, regularKey string, instructions *xrp.Instructions,
+	) (*xrp.TxInput, string, error)
+	GetRegularKey(ctx context.Context, address string) (string, error)
+
+	// account deletion
+	CreateAccountDeleteTransaction(
+		ctx context.Context, account, destination string, instructions *xrp.Instructions,
+	) (*xrp.TxInput, string, error)
+	CheckAccountDeletable(ctx context.Context, address string) error
+
+	// escrow
+	CreateEscrowCreateTransaction(
+		ctx context.Context,
+		account, destination string,
+		amount float64,
+		finishAfter, cancelAfter time.Time,
+		instructions *xrp.Instructions,
+	) (*xrp.TxInput, string, error)
+	CreateEscrowFinishTransaction(
+		ctx context.Context, account, owner string, offerSequence uint64, instructions *xrp.Instructions,
+	) (*xrp.TxInput, string, error)
+	CreateEscrowCancelTransaction(
+		ctx context.Context, account, owner string, offerSequence uint64, instructions *xrp.Instructions,
+	) (*xrp.TxInput, string, error)
+
+	// issued currency
+	IssuedCurrency() *xrp.IssuedCurrency
+	GetIssuedBalance(ctx context.Context, address string) (float64, error)
+	HasTrustLine(ctx context.Context, address string) (bool, error)
+	CreateTrustSetTransaction(
+		ctx context.Context, account string, instructions *xrp.Instructions,
+	) (*xrp.TxInput, string, error)
+
+	// ticket
+	CreateTicketCreateTransaction(
+		ctx context.Context, account string, count uint32, instructions *xrp.Instructions,
+	) (*xrp.TxInput, string, error)
+	GetTickets(ctx context.Context, address string) ([]uint64, error)
+
+	// expiration
+	GetValidatedLedgerIndex(ctx context.Context) (uint64, error)
+	IsSequenceUsed(ctx context.Context, account string, sequence, ticketSequence uint64) (bool, error)
+
 	// ripple
 	Close() error
 	CoinTypeCode() domainCoin.CoinTypeCode
@@ -42,7 +101,11 @@ type RippleAPIer interface {
 	PrepareTransaction(
 		ctx context.Context, senderAccount, receiverAccount string, amount float64, instructions *xrp.Instructions,
 	) (*xrp.TxInput, string, error)
+	PrepareRawTransaction(
+		ctx context.Context, txInput *xrp.TxInput, instructions *xrp.Instructions,
+	) (*xrp.TxInput, string, error)
 	SignTransaction(ctx context.Context, txJSON *xrp.TxInput, secret string) (string, string, error)
+	MultiSignTransaction(ctx context.Context, txJSON *xrp.TxInput, secret, signAs string) (string, string, error)
 	CombineTransaction(ctx context.Context, signedTxs []string) (string, string, error)
 	SubmitTransaction(ctx context.Context, signedTx string) (*xrp.SentTx, uint64, error)
 	WaitValidation(ctx context.Context, targetledgerVarsion uint64) (uint64, error)
@@ -54,6 +117,13 @@ type RipplePublicer interface {
 	// public_account
 	AccountChannels(ctx context.Context, sender, receiver string) (*xrp.ResponseAccountChannels, error)
 	AccountInfo(ctx context.Context, address string) (*xrp.ResponseAccountInfo, error)
+	AccountTx(
+		ctx context.Context, address string, ledgerIndexMin, ledgerIndexMax int64, marker any,
+	) (*xrp.ResponseAccountTx, error)
+	AccountLines(ctx context.Context, address, peer string) (*xrp.ResponseAccountLines, error)
+	AccountObjects(
+		ctx context.Context, address, objectType string, limit int,
+	) (*xrp.ResponseAccountObjects, error)
 	// public_server_info
 	ServerInfo(ctx context.Context) (*xrp.ResponseServerInfo, error)
 }
@@ -65,3 +135,10 @@ type RippleAdminer interface {
 	WalletProposeWithKey(ctx context.Context, seed string, keyType xrp.XRPKeyType) (*xrp.ResponseWalletPropose, error)
 	WalletPropose(ctx context.Context, passphrase string) (*xrp.ResponseWalletPropose, error)
 }
+
+// LedgerSubscriber pushes validated ledgers and transactions of accounts
+type LedgerSubscriber interface {
+	// public_subscription
+	Start(ctx context.Context, accounts []string) error
+	Events() <-chan xrp.StreamEvent
+}
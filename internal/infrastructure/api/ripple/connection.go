@@ -40,6 +40,17 @@ func NewWSClient(conf *config.Ripple) (*websocket.WS, *websocket.WS, error) {
 	return public, admin, nil
 }
 
+// NewSubscriber returns subscriber with dedicated web socket connection to public server
+func NewSubscriber(conf *config.Ripple) (LedgerSubscriber, error) {
+	publicURL := conf.WebsocketPublicURL
+	if publicURL == "" {
+		if publicURL = xrp.GetPublicWSServer(conf.NetworkType).String(); publicURL == "" {
+			return nil, errors.New("websocket URL is not found")
+		}
+	}
+	return xrp.NewSubscriber(publicURL), nil
+}
+
 // NewGRPCClient try to connect gRPC Server
 func NewGRPCClient(conf *config.RippleAPI) (*grpc.ClientConn, error) {
 	if conf.URL == "" {
@@ -77,7 +88,9 @@ func NewRipple(
 			return nil, fmt.Errorf("fail to call xrp.NewRipple(): %w", err)
 		}
 		return ripple, err
-	case domainCoin.BTC, domainCoin.BCH, domainCoin.LTC, domainCoin.ETH, domainCoin.ERC20, domainCoin.HYT:
+	case domainCoin.BTC, domainCoin.BCH, domainCoin.LTC, domainCoin.DOGE, domainCoin.ETH, domainCoin.POL,
+		domainCoin.BNB, domainCoin.ARB, domainCoin.BASE, domainCoin.SOL, domainCoin.TRX, domainCoin.ERC20,
+		domainCoin.HYT:
 		return nil, fmt.Errorf("coinType %s is not defined", coinTypeCode.String())
 	default:
 		return nil, fmt.Errorf("coinType %s is not defined", coinTypeCode.String())
@@ -0,0 +1,348 @@
+package ripple
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// instrumentedRippler records latency and errors of methods calling rippled and RippleAPI server,
+// other methods are called as is
+type instrumentedRippler struct {
+	Rippler
+	coin string
+}
+
+// NewInstrumentedRippler wraps Rippler to record RPC metrics per method
+func NewInstrumentedRippler(rippler Rippler) Rippler {
+	return &instrumentedRippler{
+		Rippler: rippler,
+		coin:    rippler.CoinTypeCode().String(),
+	}
+}
+
+func (r *instrumentedRippler) start(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "ripple."+method, attribute.String("coin", r.coin))
+}
+
+func (r *instrumentedRippler) observe(method string, span trace.Span, started time.Time, err *error) {
+	metrics.ObserveRPC(r.coin, method, started, *err)
+	tracer.End(span, err)
+}
+
+func (r *instrumentedRippler) ValidationCreate(
+	ctx context.Context, secret string,
+) (_ *xrp.ResponseValidationCreate, err error) {
+	ctx, span := r.start(ctx, "ValidationCreate")
+	defer r.observe("ValidationCreate", span, time.Now(), &err)
+	return r.Rippler.ValidationCreate(ctx, secret)
+}
+
+func (r *instrumentedRippler) WalletProposeWithKey(
+	ctx context.Context, seed string, keyType xrp.XRPKeyType,
+) (_ *xrp.ResponseWalletPropose, err error) {
+	ctx, span := r.start(ctx, "WalletProposeWithKey")
+	defer r.observe("WalletProposeWithKey", span, time.Now(), &err)
+	return r.Rippler.WalletProposeWithKey(ctx, seed, keyType)
+}
+
+func (r *instrumentedRippler) WalletPropose(
+	ctx context.Context, passphrase string,
+) (_ *xrp.ResponseWalletPropose, err error) {
+	ctx, span := r.start(ctx, "WalletPropose")
+	defer r.observe("WalletPropose", span, time.Now(), &err)
+	return r.Rippler.WalletPropose(ctx, passphrase)
+}
+
+func (r *instrumentedRippler) AccountChannels(
+	ctx context.Context, sender, receiver string,
+) (_ *xrp.ResponseAccountChannels, err error) {
+	ctx, span := r.start(ctx, "AccountChannels")
+	defer r.observe("AccountChannels", span, time.Now(), &err)
+	return r.Rippler.AccountChannels(ctx, sender, receiver)
+}
+
+func (r *instrumentedRippler) AccountInfo(
+	ctx context.Context, address string,
+) (_ *xrp.ResponseAccountInfo, err error) {
+	ctx, span := r.start(ctx, "AccountInfo")
+	defer r.observe("AccountInfo", span, time.Now(), &err)
+	return r.Rippler.AccountInfo(ctx, address)
+}
+
+func (r *instrumentedRippler) AccountTx(
+	ctx context.Context, address string, ledgerIndexMin, ledgerIndexMax int64, marker any,
+) (_ *xrp.ResponseAccountTx, err error) {
+	ctx, span := r.start(ctx, "AccountTx")
+	defer r.observe("AccountTx", span, time.Now(), &err)
+	return r.Rippler.AccountTx(ctx, address, ledgerIndexMin, ledgerIndexMax, marker)
+}
+
+func (r *instrumentedRippler) AccountLines(
+	ctx context.Context, address, peer string,
+) (_ *xrp.ResponseAccountLines, err error) {
+	ctx, span := r.start(ctx, "AccountLines")
+	defer r.observe("AccountLines", span, time.Now(), &err)
+	return r.Rippler.AccountLines(ctx, address, peer)
+}
+
+func (r *instrumentedRippler) AccountObjects(
+	ctx context.Context, address, objectType string, limit int,
+) (_ *xrp.ResponseAccountObjects, err error) {
+	ctx, span := r.start(ctx, "AccountObjects")
+	defer r.observe("AccountObjects", span, time.Now(), &err)
+	return r.Rippler.AccountObjects(ctx, address, objectType, limit)
+}
+
+func (r *instrumentedRippler) ServerInfo(ctx context.Context) (_ *xrp.ResponseServerInfo, err error) {
+	ctx, span := r.start(ctx, "ServerInfo")
+	defer r.observe("ServerInfo", span, time.Now(), &err)
+	return r.Rippler.ServerInfo(ctx)
+}
+
+func (r *instrumentedRippler) GetAccountInfo(
+	ctx context.Context, address string,
+) (_ *xrp.ResponseGetAccountInfo, err error) {
+	ctx, span := r.start(ctx, "GetAccountInfo")
+	defer r.observe("GetAccountInfo", span, time.Now(), &err)
+	return r.Rippler.GetAccountInfo(ctx, address)
+}
+
+func (r *instrumentedRippler) GenerateAddress(ctx context.Context) (_ *xrp.ResponseGenerateAddress, err error) {
+	ctx, span := r.start(ctx, "GenerateAddress")
+	defer r.observe("GenerateAddress", span, time.Now(), &err)
+	return r.Rippler.GenerateAddress(ctx)
+}
+
+func (r *instrumentedRippler) GenerateXAddress(ctx context.Context) (_ *xrp.ResponseGenerateXAddress, err error) {
+	ctx, span := r.start(ctx, "GenerateXAddress")
+	defer r.observe("GenerateXAddress", span, time.Now(), &err)
+	return r.Rippler.GenerateXAddress(ctx)
+}
+
+func (r *instrumentedRippler) IsValidAddress(ctx context.Context, addr string) (_ bool, err error) {
+	ctx, span := r.start(ctx, "IsValidAddress")
+	defer r.observe("IsValidAddress", span, time.Now(), &err)
+	return r.Rippler.IsValidAddress(ctx, addr)
+}
+
+func (r *instrumentedRippler) PrepareTransaction(
+	ctx context.Context, senderAccount, receiverAccount string, amount float64, instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "PrepareTransaction")
+	defer r.observe("PrepareTransaction", span, time.Now(), &err)
+	return r.Rippler.PrepareTransaction(ctx, senderAccount, receiverAccount, amount, instructions)
+}
+
+func (r *instrumentedRippler) PrepareRawTransaction(
+	ctx context.Context, txInput *xrp.TxInput, instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "PrepareRawTransaction")
+	defer r.observe("PrepareRawTransaction", span, time.Now(), &err)
+	return r.Rippler.PrepareRawTransaction(ctx, txInput, instructions)
+}
+
+func (r *instrumentedRippler) SignTransaction(
+	ctx context.Context, txJSON *xrp.TxInput, secret string,
+) (_ string, _ string, err error) {
+	ctx, span := r.start(ctx, "SignTransaction")
+	defer r.observe("SignTransaction", span, time.Now(), &err)
+	return r.Rippler.SignTransaction(ctx, txJSON, secret)
+}
+
+func (r *instrumentedRippler) MultiSignTransaction(
+	ctx context.Context, txJSON *xrp.TxInput, secret, signAs string,
+) (_ string, _ string, err error) {
+	ctx, span := r.start(ctx, "MultiSignTransaction")
+	defer r.observe("MultiSignTransaction", span, time.Now(), &err)
+	return r.Rippler.MultiSignTransaction(ctx, txJSON, secret, signAs)
+}
+
+func (r *instrumentedRippler) CombineTransaction(
+	ctx context.Context, signedTxs []string,
+) (_ string, _ string, err error) {
+	ctx, span := r.start(ctx, "CombineTransaction")
+	defer r.observe("CombineTransaction", span, time.Now(), &err)
+	return r.Rippler.CombineTransaction(ctx, signedTxs)
+}
+
+func (r *instrumentedRippler) SubmitTransaction(
+	ctx context.Context, signedTx string,
+) (_ *xrp.SentTx, _ uint64, err error) {
+	ctx, span := r.start(ctx, "SubmitTransaction")
+	defer r.observe("SubmitTransaction", span, time.Now(), &err)
+	return r.Rippler.SubmitTransaction(ctx, signedTx)
+}
+
+func (r *instrumentedRippler) WaitValidation(ctx context.Context, targetledgerVarsion uint64) (_ uint64, err error) {
+	ctx, span := r.start(ctx, "WaitValidation")
+	defer r.observe("WaitValidation", span, time.Now(), &err)
+	return r.Rippler.WaitValidation(ctx, targetledgerVarsion)
+}
+
+func (r *instrumentedRippler) GetTransaction(
+	ctx context.Context, txID string, targetLedgerVersion uint64,
+) (_ *xrp.TxInfo, err error) {
+	ctx, span := r.start(ctx, "GetTransaction")
+	defer r.observe("GetTransaction", span, time.Now(), &err)
+	return r.Rippler.GetTransaction(ctx, txID, targetLedgerVersion)
+}
+
+func (r *instrumentedRippler) GetBalance(ctx context.Context, addr string) (_ float64, err error) {
+	ctx, span := r.start(ctx, "GetBalance")
+	defer r.observe("GetBalance", span, time.Now(), &err)
+	return r.Rippler.GetBalance(ctx, addr)
+}
+
+func (r *instrumentedRippler) CreateRawTransaction(
+	ctx context.Context, senderAccount, receiverAccount string, amount float64, instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "CreateRawTransaction")
+	defer r.observe("CreateRawTransaction", span, time.Now(), &err)
+	return r.Rippler.CreateRawTransaction(ctx, senderAccount, receiverAccount, amount, instructions)
+}
+
+func (r *instrumentedRippler) GetSignerList(ctx context.Context, address string) (_ *xrp.SignerList, err error) {
+	ctx, span := r.start(ctx, "GetSignerList")
+	defer r.observe("GetSignerList", span, time.Now(), &err)
+	return r.Rippler.GetSignerList(ctx, address)
+}
+
+func (r *instrumentedRippler) CreateSignerListSetTransaction(
+	ctx context.Context, account string, quorum uint32, signers []string, instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "CreateSignerListSetTransaction")
+	defer r.observe("CreateSignerListSetTransaction", span, time.Now(), &err)
+	return r.Rippler.CreateSignerListSetTransaction(ctx, account, quorum, signers, instructions)
+}
+
+func (r *instrumentedRippler) CreateAccountSetTransaction(
+	ctx context.Context, account string, setFlag uint32, instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "CreateAccountSetTransaction")
+	defer r.observe("CreateAccountSetTransaction", span, time.Now(), &err)
+	return r.Rippler.CreateAccountSetTransaction(ctx, account, setFlag, instructions)
+}
+
+func (r *instrumentedRippler) IsRequireDest(ctx context.Context, address string) (_ bool, err error) {
+	ctx, span := r.start(ctx, "IsRequireDest")
+	defer r.observe("IsRequireDest", span, time.Now(), &err)
+	return r.Rippler.IsRequireDest(ctx, address)
+}
+
+func (r *instrumentedRippler) IsMasterDisabled(ctx context.Context, address string) (_ bool, err error) {
+	ctx, span := r.start(ctx, "IsMasterDisabled")
+	defer r.observe("IsMasterDisabled", span, time.Now(), &err)
+	return r.Rippler.IsMasterDisabled(ctx, address)
+}
+
+func (r *instrumentedRippler) CreateSetRegularKeyTransaction(
+	ctx context.Context, account, regularKey string, instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "CreateSetRegularKeyTransaction")
+	defer r.observe("CreateSetRegularKeyTransaction", span, time.Now(), &err)
+	return r.Rippler.CreateSetRegularKeyTransaction(ctx, account, regularKey, instructions)
+}
+
+func (r *instrumentedRippler) GetRegularKey(ctx context.Context, address string) (_ string, err error) {
+	ctx, span := r.start(ctx, "GetRegularKey")
+	defer r.observe("GetRegularKey", span, time.Now(), &err)
+	return r.Rippler.GetRegularKey(ctx, address)
+}
+
+func (r *instrumentedRippler) CreateAccountDeleteTransaction(
+	ctx context.Context, account, destination string, instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "CreateAccountDeleteTransaction")
+	defer r.observe("CreateAccountDeleteTransaction", span, time.Now(), &err)
+	return r.Rippler.CreateAccountDeleteTransaction(ctx, account, destination, instructions)
+}
+
+func (r *instrumentedRippler) CheckAccountDeletable(ctx context.Context, address string) (err error) {
+	ctx, span := r.start(ctx, "CheckAccountDeletable")
+	defer r.observe("CheckAccountDeletable", span, time.Now(), &err)
+	return r.Rippler.CheckAccountDeletable(ctx, address)
+}
+
+func (r *instrumentedRippler) CreateEscrowCreateTransaction(
+	ctx context.Context,
+	account, destination string,
+	amount float64,
+	finishAfter, cancelAfter time.Time,
+	instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "CreateEscrowCreateTransaction")
+	defer r.observe("CreateEscrowCreateTransaction", span, time.Now(), &err)
+	return r.Rippler.CreateEscrowCreateTransaction(
+		ctx, account, destination, amount, finishAfter, cancelAfter, instructions)
+}
+
+func (r *instrumentedRippler) CreateEscrowFinishTransaction(
+	ctx context.Context, account, owner string, offerSequence uint64, instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "CreateEscrowFinishTransaction")
+	defer r.observe("CreateEscrowFinishTransaction", span, time.Now(), &err)
+	return r.Rippler.CreateEscrowFinishTransaction(ctx, account, owner, offerSequence, instructions)
+}
+
+func (r *instrumentedRippler) CreateEscrowCancelTransaction(
+	ctx context.Context, account, owner string, offerSequence uint64, instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "CreateEscrowCancelTransaction")
+	defer r.observe("CreateEscrowCancelTransaction", span, time.Now(), &err)
+	return r.Rippler.CreateEscrowCancelTransaction(ctx, account, owner, offerSequence, instructions)
+}
+
+func (r *instrumentedRippler) GetIssuedBalance(ctx context.Context, address string) (_ float64, err error) {
+	ctx, span := r.start(ctx, "GetIssuedBalance")
+	defer r.observe("GetIssuedBalance", span, time.Now(), &err)
+	return r.Rippler.GetIssuedBalance(ctx, address)
+}
+
+func (r *instrumentedRippler) HasTrustLine(ctx context.Context, address string) (_ bool, err error) {
+	ctx, span := r.start(ctx, "HasTrustLine")
+	defer r.observe("HasTrustLine", span, time.Now(), &err)
+	return r.Rippler.HasTrustLine(ctx, address)
+}
+
+func (r *instrumentedRippler) CreateTrustSetTransaction(
+	ctx context.Context, account string, instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "CreateTrustSetTransaction")
+	defer r.observe("CreateTrustSetTransaction", span, time.Now(), &err)
+	return r.Rippler.CreateTrustSetTransaction(ctx, account, instructions)
+}
+
+func (r *instrumentedRippler) CreateTicketCreateTransaction(
+	ctx context.Context, account string, count uint32, instructions *xrp.Instructions,
+) (_ *xrp.TxInput, _ string, err error) {
+	ctx, span := r.start(ctx, "CreateTicketCreateTransaction")
+	defer r.observe("CreateTicketCreateTransaction", span, time.Now(), &err)
+	return r.Rippler.CreateTicketCreateTransaction(ctx, account, count, instructions)
+}
+
+func (r *instrumentedRippler) GetTickets(ctx context.Context, address string) (_ []uint64, err error) {
+	ctx, span := r.start(ctx, "GetTickets")
+	defer r.observe("GetTickets", span, time.Now(), &err)
+	return r.Rippler.GetTickets(ctx, address)
+}
+
+func (r *instrumentedRippler) GetValidatedLedgerIndex(ctx context.Context) (_ uint64, err error) {
+	ctx, span := r.start(ctx, "GetValidatedLedgerIndex")
+	defer r.observe("GetValidatedLedgerIndex", span, time.Now(), &err)
+	return r.Rippler.GetValidatedLedgerIndex(ctx)
+}
+
+func (r *instrumentedRippler) IsSequenceUsed(
+	ctx context.Context, account string, sequence, ticketSequence uint64,
+) (_ bool, err error) {
+	ctx, span := r.start(ctx, "IsSequenceUsed")
+	defer r.observe("IsSequenceUsed", span, time.Now(), &err)
+	return r.Rippler.IsSequenceUsed(ctx, account, sequence, ticketSequence)
+}
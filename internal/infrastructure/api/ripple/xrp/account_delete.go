@@ -0,0 +1,80 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// - AccountDelete https://xrpl.org/accountdelete.html
+// - Deletion of Accounts https://xrpl.org/accounts.html#deletion-of-accounts
+
+// AccountDeleteSequenceGap is number of ledgers which must pass since sequence of account before deletion
+const AccountDeleteSequenceGap = 256
+
+// CreateAccountDeleteTransaction creates AccountDelete transaction to send remaining XRP to destination
+//   - fee is owner reserve increment instead of reference cost, instructions.Fee is overwritten by it
+//   - rest of balance including base reserve is delivered to destination
+func (r *Ripple) CreateAccountDeleteTransaction(
+	ctx context.Context, account, destination string, instructions *Instructions,
+) (*TxInput, string, error) {
+	// validation
+	if account == "" {
+		return nil, "", errors.New("account is empty")
+	}
+	if destination == "" {
+		return nil, "", errors.New("destination is empty")
+	}
+	if account == destination {
+		return nil, "", errors.New("destination must be different from account")
+	}
+	if instructions == nil {
+		instructions = &Instructions{}
+	}
+
+	res, err := r.ServerInfo(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("fail to call ServerInfo(): %w", err)
+	}
+	if res.Error != "" {
+		return nil, "", fmt.Errorf("fail to call ServerInfo(): %s", res.Error)
+	}
+	reserveInc := res.Result.Info.ValidatedLedger.ReserveIncXrp
+	if reserveInc <= 0 {
+		return nil, "", errors.New("reserve increment is not found in server_info")
+	}
+	instructions.Fee = strconv.FormatFloat(reserveInc, 'f', -1, 64)
+
+	txInput := &TxInput{
+		TransactionType: "AccountDelete",
+		Account:         account,
+		Destination:     destination,
+	}
+	return r.PrepareRawTransaction(ctx, txInput, instructions)
+}
+
+// CheckAccountDeletable returns error if address doesn't meet requirements of AccountDelete yet
+//   - account must not own any object such as trust line, ticket or signer list
+//   - sequence of account plus 256 must be less than or equal to current ledger index
+func (r *Ripple) CheckAccountDeletable(ctx context.Context, address string) error {
+	res, err := r.AccountInfo(ctx, address)
+	if err != nil {
+		return fmt.Errorf("fail to call AccountInfo(): %w", err)
+	}
+	if res.Error != "" {
+		return fmt.Errorf("fail to call AccountInfo(): %s", res.Error)
+	}
+	if res.Result.AccountData.OwnerCount != 0 {
+		return fmt.Errorf("account owns %d objects in ledger", res.Result.AccountData.OwnerCount)
+	}
+	ledgerIndex, err := r.GetValidatedLedgerIndex(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to call GetValidatedLedgerIndex(): %w", err)
+	}
+	if uint64(res.Result.AccountData.Sequence)+AccountDeleteSequenceGap > ledgerIndex {
+		return fmt.Errorf("sequence %d of account is too recent for ledger %d",
+			res.Result.AccountData.Sequence, ledgerIndex)
+	}
+	return nil
+}
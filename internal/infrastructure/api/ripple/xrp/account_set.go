@@ -0,0 +1,71 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// - AccountSet https://xrpl.org/accountset.html
+// - Require Destination Tags https://xrpl.org/require-destination-tags.html
+// - Disable Master Key Pair https://xrpl.org/disable-master-key-pair.html
+
+// AccountSet flags
+const (
+	// AsfRequireDest requires destination tag to send transaction to this account
+	AsfRequireDest uint32 = 1
+	// AsfDisableMaster disallows master key to sign transaction, regular key or signer list must be set in advance
+	AsfDisableMaster uint32 = 4
+)
+
+// AccountRoot flags
+const (
+	// LsfRequireDestTag is enabled by AsfRequireDest
+	LsfRequireDestTag = 0x00020000
+	// LsfDisableMaster is enabled by AsfDisableMaster
+	LsfDisableMaster = 0x00100000
+)
+
+// CreateAccountSetTransaction creates AccountSet transaction to enable flag of account
+func (r *Ripple) CreateAccountSetTransaction(
+	ctx context.Context, account string, setFlag uint32, instructions *Instructions,
+) (*TxInput, string, error) {
+	// validation
+	if account == "" {
+		return nil, "", errors.New("account is empty")
+	}
+	if setFlag == 0 {
+		return nil, "", errors.New("setFlag is empty")
+	}
+
+	txInput := &TxInput{
+		TransactionType: "AccountSet",
+		Account:         account,
+		SetFlag:         setFlag,
+	}
+	return r.PrepareRawTransaction(ctx, txInput, instructions)
+}
+
+// IsRequireDest returns true if destination tag is required to send transaction to address
+func (r *Ripple) IsRequireDest(ctx context.Context, address string) (bool, error) {
+	res, err := r.AccountInfo(ctx, address)
+	if err != nil {
+		return false, fmt.Errorf("fail to call AccountInfo(): %w", err)
+	}
+	if res.Error != "" {
+		return false, fmt.Errorf("fail to call AccountInfo(): %s", res.Error)
+	}
+	return res.Result.AccountData.Flags&LsfRequireDestTag != 0, nil
+}
+
+// IsMasterDisabled returns true if master key of address can't sign transaction any more
+func (r *Ripple) IsMasterDisabled(ctx context.Context, address string) (bool, error) {
+	res, err := r.AccountInfo(ctx, address)
+	if err != nil {
+		return false, fmt.Errorf("fail to call AccountInfo(): %w", err)
+	}
+	if res.Error != "" {
+		return false, fmt.Errorf("fail to call AccountInfo(): %s", res.Error)
+	}
+	return res.Result.AccountData.Flags&LsfDisableMaster != 0, nil
+}
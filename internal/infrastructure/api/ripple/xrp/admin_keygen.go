@@ -60,7 +60,7 @@ type WalletProposeWithKey struct {
 // WalletPropose is request data for wallet_propose method
 type WalletPropose struct {
 	Command    string `json:"command"`
-	Passphrase string `json:"passphrase"`
+	Passphrase string `json:"passphrase,omitempty"`
 }
 
 // ResponseWalletPropose is response data for wallet_propose method
@@ -102,6 +102,7 @@ func (r *Ripple) WalletProposeWithKey(
 
 // WalletPropose calls wallet_propose method
 // - result is same as long as using same passphrase
+// - random seed is generated if passphrase is empty
 func (r *Ripple) WalletPropose(ctx context.Context, passphrase string) (*ResponseWalletPropose, error) {
 	if r.wsAdmin == nil {
 		return nil, XRPErrorDisabledAdminAPI
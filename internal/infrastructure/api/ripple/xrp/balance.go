@@ -3,7 +3,11 @@ package xrp
 import "context"
 
 // GetBalance returns amount of address
+// - balance of issued currency is returned when it's set in config
 func (r *Ripple) GetBalance(ctx context.Context, addr string) (float64, error) {
+	if r.issuedCurrency != nil {
+		return r.GetIssuedBalance(ctx, addr)
+	}
 	accountInfo, err := r.GetAccountInfo(ctx, addr)
 	if err != nil {
 		return 0, err
@@ -12,9 +16,13 @@ func (r *Ripple) GetBalance(ctx context.Context, addr string) (float64, error) {
 }
 
 // GetTotalBalance returns total amount in address list
+// - X-address is skipped because it shares balance with classic address
 func (r *Ripple) GetTotalBalance(ctx context.Context, addrs []string) float64 {
 	var total float64
 	for _, addr := range addrs {
+		if IsXAddress(addr) {
+			continue
+		}
 		amt, err := r.GetBalance(ctx, addr)
 		if err == nil {
 			total += amt
@@ -0,0 +1,107 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+)
+
+// - Escrow https://xrpl.org/escrow.html
+// - EscrowCreate https://xrpl.org/escrowcreate.html
+// - EscrowFinish https://xrpl.org/escrowfinish.html
+// - EscrowCancel https://xrpl.org/escrowcancel.html
+
+// RippleEpochOffset is seconds from Unix epoch to Ripple epoch 2000-01-01T00:00:00Z
+const RippleEpochOffset = 946684800
+
+// ToRippleTime converts time to seconds since Ripple epoch
+func ToRippleTime(t time.Time) uint32 {
+	return uint32(t.Unix() - RippleEpochOffset)
+}
+
+// CreateEscrowCreateTransaction creates EscrowCreate transaction to lock XRP until finishAfter
+//   - escrow can be finished only after finishAfter, and canceled only after cancelAfter
+//   - zero cancelAfter means escrow never expires, it must be finished
+//   - escrowed XRP is removed from balance, and escrow itself is counted toward owner reserve
+func (r *Ripple) CreateEscrowCreateTransaction(
+	ctx context.Context,
+	account, destination string,
+	amount float64,
+	finishAfter, cancelAfter time.Time,
+	instructions *Instructions,
+) (*TxInput, string, error) {
+	// validation
+	if r.issuedCurrency != nil {
+		return nil, "", errors.New("escrow supports only XRP")
+	}
+	if account == "" {
+		return nil, "", errors.New("account is empty")
+	}
+	if destination == "" {
+		return nil, "", errors.New("destination is empty")
+	}
+	if amount <= 0 {
+		return nil, "", errors.New("amount must be greater than 0")
+	}
+	if finishAfter.IsZero() {
+		return nil, "", errors.New("finishAfter is empty")
+	}
+	if !cancelAfter.IsZero() && !cancelAfter.After(finishAfter) {
+		return nil, "", errors.New("cancelAfter must be after finishAfter")
+	}
+
+	txInput := &TxInput{
+		TransactionType: "EscrowCreate",
+		Account:         account,
+		Destination:     destination,
+		// 1 XRP = 1,000,000 drops
+		Amount:      &CurrencyAmount{Value: strconv.FormatInt(int64(math.Round(amount*1e6)), 10)},
+		FinishAfter: ToRippleTime(finishAfter),
+	}
+	if !cancelAfter.IsZero() {
+		txInput.CancelAfter = ToRippleTime(cancelAfter)
+	}
+	return r.PrepareRawTransaction(ctx, txInput, instructions)
+}
+
+// CreateEscrowFinishTransaction creates EscrowFinish transaction to deliver escrowed XRP to destination
+//   - offerSequence is sequence or ticket of EscrowCreate transaction
+//   - it fails if close time of ledger isn't after FinishAfter yet
+func (r *Ripple) CreateEscrowFinishTransaction(
+	ctx context.Context, account, owner string, offerSequence uint64, instructions *Instructions,
+) (*TxInput, string, error) {
+	return r.createEscrowCloseTransaction(ctx, "EscrowFinish", account, owner, offerSequence, instructions)
+}
+
+// CreateEscrowCancelTransaction creates EscrowCancel transaction to return escrowed XRP to owner
+//   - it fails if close time of ledger isn't after CancelAfter yet
+func (r *Ripple) CreateEscrowCancelTransaction(
+	ctx context.Context, account, owner string, offerSequence uint64, instructions *Instructions,
+) (*TxInput, string, error) {
+	return r.createEscrowCloseTransaction(ctx, "EscrowCancel", account, owner, offerSequence, instructions)
+}
+
+func (r *Ripple) createEscrowCloseTransaction(
+	ctx context.Context, txType, account, owner string, offerSequence uint64, instructions *Instructions,
+) (*TxInput, string, error) {
+	// validation
+	if account == "" {
+		return nil, "", errors.New("account is empty")
+	}
+	if owner == "" {
+		return nil, "", errors.New("owner is empty")
+	}
+	if offerSequence == 0 {
+		return nil, "", errors.New("offerSequence is empty")
+	}
+
+	txInput := &TxInput{
+		TransactionType: txType,
+		Account:         account,
+		Owner:           owner,
+		OfferSequence:   offerSequence,
+	}
+	return r.PrepareRawTransaction(ctx, txInput, instructions)
+}
@@ -0,0 +1,42 @@
+package xrp_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+)
+
+// TestToRippleTime is test for conversion to seconds since Ripple epoch
+func TestToRippleTime(t *testing.T) {
+	assert.Equal(t, uint32(0), xrp.ToRippleTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, uint32(86400), xrp.ToRippleTime(time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+// TestTxInputEscrow is test for JSON encoding of escrow transactions
+func TestTxInputEscrow(t *testing.T) {
+	txJSON := `{"TransactionType":"EscrowCreate","Account":"rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq",` +
+		`"Amount":"1000000","Destination":"rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq","Fee":"12","Flags":0,` +
+		`"LastLedgerSequence":100,"Sequence":5,"FinishAfter":533257958,"CancelAfter":533344358}`
+	var txInput xrp.TxInput
+	require.NoError(t, json.Unmarshal([]byte(txJSON), &txInput))
+	assert.Equal(t, uint32(533257958), txInput.FinishAfter)
+
+	b, err := json.Marshal(txInput)
+	require.NoError(t, err)
+	assert.JSONEq(t, txJSON, string(b))
+
+	// EscrowFinish refers to escrow by Owner and OfferSequence
+	txJSON = `{"TransactionType":"EscrowFinish","Account":"rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq",` +
+		`"Owner":"rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq","OfferSequence":5,"Fee":"12","Flags":0,` +
+		`"LastLedgerSequence":200,"Sequence":6}`
+	txInput = xrp.TxInput{}
+	require.NoError(t, json.Unmarshal([]byte(txJSON), &txInput))
+	b, err = json.Marshal(txInput)
+	require.NoError(t, err)
+	assert.JSONEq(t, txJSON, string(b))
+}
@@ -0,0 +1,50 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// - Reliable Transaction Submission https://xrpl.org/reliable-transaction-submission.html
+// - Finality of Results https://xrpl.org/finality-of-results.html
+
+// GetValidatedLedgerIndex returns index of the latest validated ledger
+//   - transaction whose LastLedgerSequence is less than this index can't be validated any more
+func (r *Ripple) GetValidatedLedgerIndex(ctx context.Context) (uint64, error) {
+	res, err := r.ServerInfo(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to call ServerInfo(): %w", err)
+	}
+	if res.Error != "" {
+		return 0, fmt.Errorf("fail to call ServerInfo(): %s", res.Error)
+	}
+	if res.Result.Info.ValidatedLedger.Seq <= 0 {
+		return 0, errors.New("validated ledger is not found in server_info")
+	}
+	return uint64(res.Result.Info.ValidatedLedger.Seq), nil
+}
+
+// IsSequenceUsed returns true if sequence or ticket of transaction is already consumed by account
+//   - ticket is removed from ledger once it's used
+//   - sequence is consumed once sequence of account passes it
+//   - consumed sequence means transaction or another one using the same sequence may be validated
+func (r *Ripple) IsSequenceUsed(ctx context.Context, account string, sequence, ticketSequence uint64) (bool, error) {
+	if ticketSequence != 0 {
+		tickets, err := r.GetTickets(ctx, account)
+		if err != nil {
+			return false, fmt.Errorf("fail to call GetTickets(): %w", err)
+		}
+		return !slices.Contains(tickets, ticketSequence), nil
+	}
+
+	res, err := r.AccountInfo(ctx, account)
+	if err != nil {
+		return false, fmt.Errorf("fail to call AccountInfo(): %w", err)
+	}
+	if res.Error != "" {
+		return false, fmt.Errorf("fail to call AccountInfo(): %s", res.Error)
+	}
+	return uint64(res.Result.AccountData.Sequence) > sequence, nil
+}
@@ -0,0 +1,119 @@
+package xrp_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	ws "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/network/websocket"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+// newRippleWithResponses returns Ripple connected to websocket server which replies fixed response per command
+func newRippleWithResponses(t *testing.T, responses map[string]any) *xrp.Ripple {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.CloseNow()
+		for {
+			var req struct {
+				Command string `json:"command"`
+			}
+			if err = wsjson.Read(r.Context(), conn, &req); err != nil {
+				return
+			}
+			if err = wsjson.Write(r.Context(), conn, responses[req.Command]); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	wsPublic, err := ws.New(context.Background(), "ws"+strings.TrimPrefix(server.URL, "http"))
+	require.NoError(t, err)
+	ripple, err := xrp.NewRipple(context.Background(), wsPublic, nil, nil, domainCoin.XRP, &config.Ripple{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ripple.Close() })
+	return ripple
+}
+
+// TestIsSequenceUsed is test for sequence or ticket consumed by account
+func TestIsSequenceUsed(t *testing.T) {
+	accountInfo := map[string]any{
+		"status": "success",
+		"result": map[string]any{
+			"account_data": map[string]any{"Sequence": 100},
+		},
+	}
+	accountObjects := map[string]any{
+		"status": "success",
+		"result": map[string]any{
+			"account_objects": []map[string]any{
+				{"LedgerEntryType": "Ticket", "TicketSequence": 10},
+				{"LedgerEntryType": "Ticket", "TicketSequence": 12},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		sequence       uint64
+		ticketSequence uint64
+		want           bool
+	}{
+		{
+			name:     "sequence of account passed it",
+			sequence: 99,
+			want:     true,
+		},
+		{
+			name:     "sequence of account is the same",
+			sequence: 100,
+			want:     false,
+		},
+		{
+			name:           "ticket is still in ledger",
+			sequence:       0,
+			ticketSequence: 12,
+			want:           false,
+		},
+		{
+			name:           "ticket is removed from ledger",
+			sequence:       0,
+			ticketSequence: 11,
+			want:           true,
+		},
+	}
+	ripple := newRippleWithResponses(t, map[string]any{
+		"account_info":    accountInfo,
+		"account_objects": accountObjects,
+	})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isUsed, err := ripple.IsSequenceUsed(context.Background(), signerA, tt.sequence, tt.ticketSequence)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, isUsed)
+		})
+	}
+
+	t.Run("error of account_info", func(t *testing.T) {
+		ripple := newRippleWithResponses(t, map[string]any{
+			"account_info": map[string]any{"status": "error", "error": "actNotFound"},
+		})
+		_, err := ripple.IsSequenceUsed(context.Background(), signerA, 1, 0)
+		require.Error(t, err)
+	})
+}
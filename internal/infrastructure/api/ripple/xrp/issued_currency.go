@@ -0,0 +1,266 @@
+package xrp
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+// - Issued Currencies https://xrpl.org/issued-currencies.html
+// - Currency Formats https://xrpl.org/currency-formats.html
+// - TrustSet https://xrpl.org/trustset.html
+// - Partial Payments https://xrpl.org/partial-payments.html
+
+// TrustSet flags
+const (
+	// TfSetNoRipple blocks rippling between trust lines of our account
+	TfSetNoRipple uint64 = 0x00020000
+)
+
+// Payment flags
+const (
+	// TfPartialPayment allows payment to deliver less than Amount, delivered_amount must be used instead
+	TfPartialPayment uint64 = 0x00020000
+)
+
+// DefaultTrustLimit is limit of trust line when trust_limit is not defined in config
+const DefaultTrustLimit = "1000000000"
+
+// deliveredAmountUnavailable is delivered_amount of transaction validated before 2014-01-20
+const deliveredAmountUnavailable = "unavailable"
+
+// CurrencyAmount is amount field of transaction
+//   - XRP is string of drops in JSON, Currency and Issuer are empty
+//   - issued currency is object of currency, issuer and value in JSON
+type CurrencyAmount struct {
+	Currency string `json:"currency"`
+	Issuer   string `json:"issuer"`
+	Value    string `json:"value"`
+}
+
+// IsXRP returns true if amount is XRP
+func (a CurrencyAmount) IsXRP() bool {
+	return a.Currency == ""
+}
+
+// MarshalJSON encodes XRP as string of drops and issued currency as object
+func (a CurrencyAmount) MarshalJSON() ([]byte, error) {
+	if a.IsXRP() {
+		return json.Marshal(a.Value)
+	}
+	type issuedAmount CurrencyAmount
+	return json.Marshal(issuedAmount(a))
+}
+
+// UnmarshalJSON decodes string of drops as XRP and object as issued currency
+func (a *CurrencyAmount) UnmarshalJSON(data []byte) error {
+	if len(data) != 0 && data[0] == '"' {
+		*a = CurrencyAmount{}
+		return json.Unmarshal(data, &a.Value)
+	}
+	type issuedAmount CurrencyAmount
+	var amt issuedAmount
+	if err := json.Unmarshal(data, &amt); err != nil {
+		return err
+	}
+	*a = CurrencyAmount(amt)
+	return nil
+}
+
+// IssuedCurrency is currency issued by issuer, it is sent instead of XRP when it's set in config
+//   - issuer which charges transfer fee isn't supported because SendMax isn't set
+type IssuedCurrency struct {
+	Currency   string
+	Issuer     string
+	TrustLimit string
+}
+
+// newIssuedCurrency returns IssuedCurrency selected in config, nil is returned for XRP
+func newIssuedCurrency(conf *config.Ripple) (*IssuedCurrency, error) {
+	if conf.IssuedCurrency == "" {
+		return nil, nil
+	}
+	info, ok := conf.IssuedCurrencies[conf.IssuedCurrency]
+	if !ok {
+		return nil, fmt.Errorf("issued currency information for [%s] is not found", conf.IssuedCurrency)
+	}
+	if !ValidateCurrencyCode(info.Currency) {
+		return nil, fmt.Errorf("currency code of [%s] is invalid: %s", conf.IssuedCurrency, info.Currency)
+	}
+	if !ValidateAddress(info.Issuer) {
+		return nil, fmt.Errorf("issuer of [%s] is invalid: %s", conf.IssuedCurrency, info.Issuer)
+	}
+	trustLimit := info.TrustLimit
+	if trustLimit == "" {
+		trustLimit = DefaultTrustLimit
+	}
+	return &IssuedCurrency{
+		Currency:   info.Currency,
+		Issuer:     info.Issuer,
+		TrustLimit: trustLimit,
+	}, nil
+}
+
+// Amount returns CurrencyAmount of value in this currency
+func (c *IssuedCurrency) Amount(value string) *CurrencyAmount {
+	return &CurrencyAmount{
+		Currency: c.Currency,
+		Issuer:   c.Issuer,
+		Value:    value,
+	}
+}
+
+// IsSameCurrency returns true if amount is this currency issued by the same issuer
+func (c *IssuedCurrency) IsSameCurrency(amount *CurrencyAmount) bool {
+	return amount != nil && amount.Currency == c.Currency && amount.Issuer == c.Issuer
+}
+
+// ValidateCurrencyCode validates currency code of issued currency
+//   - standard code is 3 characters except `XRP`, non-standard code is 40 characters hex
+func ValidateCurrencyCode(code string) bool {
+	switch len(code) {
+	case 3:
+		return code != "XRP"
+	case 40:
+		_, err := hex.DecodeString(code)
+		return err == nil
+	default:
+		return false
+	}
+}
+
+// DeliveredAmount returns amount actually delivered by validated payment
+//   - Amount can't be trusted when partial payment flag is set, delivered_amount in metadata is used instead
+//   - nil is returned if delivered amount is unknown
+func DeliveredAmount(tx *Transaction, meta *TxMeta) *CurrencyAmount {
+	if meta != nil && meta.DeliveredAmount != nil {
+		if meta.DeliveredAmount.IsXRP() && meta.DeliveredAmount.Value == deliveredAmountUnavailable {
+			return nil
+		}
+		return meta.DeliveredAmount
+	}
+	if tx.Flags&TfPartialPayment != 0 {
+		return nil
+	}
+	return tx.Amount
+}
+
+// IssuedCurrency returns issued currency selected in config, nil is returned for XRP
+func (r *Ripple) IssuedCurrency() *IssuedCurrency {
+	return r.issuedCurrency
+}
+
+// getTrustLine returns trust line of account for issued currency, nil is returned if it doesn't exist
+func (r *Ripple) getTrustLine(ctx context.Context, address string) (*TrustLine, error) {
+	if r.issuedCurrency == nil {
+		return nil, errors.New("issued currency is not set in config")
+	}
+	// X-address shares trust line with classic address
+	if IsXAddress(address) {
+		classicAddr, _, _, err := DecodeXAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		address = classicAddr
+	}
+	res, err := r.AccountLines(ctx, address, r.issuedCurrency.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call AccountLines(): %w", err)
+	}
+	for i := range res.Result.Lines {
+		if res.Result.Lines[i].Currency == r.issuedCurrency.Currency {
+			return &res.Result.Lines[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// HasTrustLine returns true if account holds trust line for issued currency
+func (r *Ripple) HasTrustLine(ctx context.Context, address string) (bool, error) {
+	line, err := r.getTrustLine(ctx, address)
+	if err != nil {
+		return false, err
+	}
+	return line != nil, nil
+}
+
+// GetIssuedBalance returns balance of issued currency, 0 is returned if account doesn't hold trust line
+func (r *Ripple) GetIssuedBalance(ctx context.Context, address string) (float64, error) {
+	line, err := r.getTrustLine(ctx, address)
+	if err != nil {
+		return 0, err
+	}
+	if line == nil {
+		return 0, nil
+	}
+	balance, err := strconv.ParseFloat(line.Balance, 64)
+	if err != nil {
+		return 0, fmt.Errorf("fail to parse balance of trust line %s: %w", line.Balance, err)
+	}
+	return balance, nil
+}
+
+// CreateTrustSetTransaction creates TrustSet transaction to hold issued currency selected in config
+//   - NoRipple is set not to let balance of other holders ripple through our account
+func (r *Ripple) CreateTrustSetTransaction(
+	ctx context.Context, account string, instructions *Instructions,
+) (*TxInput, string, error) {
+	// validation
+	if r.issuedCurrency == nil {
+		return nil, "", errors.New("issued currency is not set in config")
+	}
+	if account == "" {
+		return nil, "", errors.New("account is empty")
+	}
+
+	txInput := &TxInput{
+		TransactionType: "TrustSet",
+		Account:         account,
+		LimitAmount:     r.issuedCurrency.Amount(r.issuedCurrency.TrustLimit),
+		Flags:           TfSetNoRipple,
+	}
+	return r.PrepareRawTransaction(ctx, txInput, instructions)
+}
+
+// createIssuedRawTransaction creates Payment transaction of issued currency
+//   - amount 0 means sending all balance of issued currency, fee is paid by XRP
+func (r *Ripple) createIssuedRawTransaction(
+	ctx context.Context, senderAccount, receiverAccount string, amount float64, instructions *Instructions,
+) (*TxInput, string, error) {
+	balance, err := r.GetIssuedBalance(ctx, senderAccount)
+	if err != nil {
+		return nil, "", fmt.Errorf("fail to call GetIssuedBalance(): %w", err)
+	}
+	if amount == 0 {
+		if balance <= 0 {
+			return nil, "", fmt.Errorf("balance of %s is short to send %f", r.issuedCurrency.Currency, balance)
+		}
+		amount = balance
+	} else if balance < amount {
+		return nil, "", fmt.Errorf("balance of %s is short to send %f", r.issuedCurrency.Currency, balance)
+	}
+
+	// payment to account without trust line fails after fee is charged
+	if receiverAccount != r.issuedCurrency.Issuer {
+		hasTrustLine, err := r.HasTrustLine(ctx, receiverAccount)
+		if err != nil {
+			return nil, "", fmt.Errorf("fail to call HasTrustLine(): %w", err)
+		}
+		if !hasTrustLine {
+			return nil, "", fmt.Errorf("%s doesn't hold trust line for %s", receiverAccount, r.issuedCurrency.Currency)
+		}
+	}
+
+	txInput := &TxInput{
+		TransactionType: "Payment",
+		Account:         senderAccount,
+		Destination:     receiverAccount,
+		Amount:          r.issuedCurrency.Amount(strconv.FormatFloat(amount, 'f', -1, 64)),
+	}
+	return r.PrepareRawTransaction(ctx, txInput, instructions)
+}
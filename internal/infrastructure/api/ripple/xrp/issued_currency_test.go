@@ -0,0 +1,105 @@
+package xrp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+)
+
+// TestCurrencyAmount is test for JSON encoding of CurrencyAmount
+func TestCurrencyAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount xrp.CurrencyAmount
+		json   string
+	}{
+		{
+			name:   "xrp",
+			amount: xrp.CurrencyAmount{Value: "1000000"},
+			json:   `"1000000"`,
+		},
+		{
+			name: "issued currency",
+			amount: xrp.CurrencyAmount{
+				Currency: "USD",
+				Issuer:   "rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq",
+				Value:    "1.5",
+			},
+			json: `{"currency":"USD","issuer":"rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq","value":"1.5"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.amount)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(b))
+
+			var amount xrp.CurrencyAmount
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &amount))
+			assert.Equal(t, tt.amount, amount)
+		})
+	}
+
+	// Amount is omitted from transaction other than Payment
+	b, err := json.Marshal(xrp.TxInput{TransactionType: "AccountSet", SetFlag: xrp.AsfRequireDest})
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "Amount")
+}
+
+// TestValidateCurrencyCode is test for ValidateCurrencyCode
+func TestValidateCurrencyCode(t *testing.T) {
+	assert.True(t, xrp.ValidateCurrencyCode("USD"))
+	assert.True(t, xrp.ValidateCurrencyCode("524C555344000000000000000000000000000000"))
+	assert.False(t, xrp.ValidateCurrencyCode("XRP"))
+	assert.False(t, xrp.ValidateCurrencyCode("RLUSD"))
+	assert.False(t, xrp.ValidateCurrencyCode("524C55534400000000000000000000000000000Z"))
+}
+
+// TestDeliveredAmount is test for DeliveredAmount
+func TestDeliveredAmount(t *testing.T) {
+	issued := &xrp.CurrencyAmount{Currency: "USD", Issuer: "rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq", Value: "100"}
+	delivered := &xrp.CurrencyAmount{Currency: "USD", Issuer: "rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq", Value: "0.01"}
+
+	tests := []struct {
+		name string
+		tx   *xrp.Transaction
+		meta *xrp.TxMeta
+		want *xrp.CurrencyAmount
+	}{
+		{
+			name: "delivered_amount is used for partial payment",
+			tx:   &xrp.Transaction{Amount: issued, Flags: xrp.TfPartialPayment},
+			meta: &xrp.TxMeta{DeliveredAmount: delivered},
+			want: delivered,
+		},
+		{
+			name: "partial payment without delivered_amount is unknown",
+			tx:   &xrp.Transaction{Amount: issued, Flags: xrp.TfPartialPayment},
+			meta: &xrp.TxMeta{},
+			want: nil,
+		},
+		{
+			name: "unavailable delivered_amount is unknown",
+			tx:   &xrp.Transaction{Amount: issued},
+			meta: &xrp.TxMeta{DeliveredAmount: &xrp.CurrencyAmount{Value: "unavailable"}},
+			want: nil,
+		},
+		{
+			name: "Amount is used without delivered_amount",
+			tx:   &xrp.Transaction{Amount: issued},
+			meta: &xrp.TxMeta{},
+			want: issued,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, xrp.DeliveredAmount(tt.tx, tt.meta))
+		})
+	}
+}
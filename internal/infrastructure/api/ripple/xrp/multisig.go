@@ -0,0 +1,150 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// - Multi-Signing https://xrpl.org/multi-signing.html
+// - Set Up Multi-Signing https://xrpl.org/set-up-multi-signing.html
+
+// MaxSignerEntries is maximum number of signers in SignerList
+const MaxSignerEntries = 32
+
+// SignerEntry is element of SignerEntries in SignerListSet transaction
+type SignerEntry struct {
+	SignerEntry SignerEntryData `json:"SignerEntry"`
+}
+
+// SignerEntryData is signer account and weight
+type SignerEntryData struct {
+	Account      string `json:"Account"`
+	SignerWeight uint16 `json:"SignerWeight"`
+}
+
+// SignerList is SignerList ledger object which is returned by account_info with signer_lists
+type SignerList struct {
+	SignerQuorum  uint32        `json:"SignerQuorum"`
+	SignerEntries []SignerEntry `json:"SignerEntries"`
+}
+
+// Signers returns accounts of signers
+func (s *SignerList) Signers() []string {
+	signers := make([]string, 0, len(s.SignerEntries))
+	for _, entry := range s.SignerEntries {
+		signers = append(signers, entry.SignerEntry.Account)
+	}
+	return signers
+}
+
+// Weights returns weight of each signer
+func (s *SignerList) Weights() map[string]uint16 {
+	weights := make(map[string]uint16, len(s.SignerEntries))
+	for _, entry := range s.SignerEntries {
+		weights[entry.SignerEntry.Account] = entry.SignerEntry.SignerWeight
+	}
+	return weights
+}
+
+// MultisigTx is unsigned transaction of multisig account which goes around sign wallets
+//   - it is serialized by serial.EncodeToString() as one line in transaction file
+//   - each signer adds multisigned TxBlob to SignedTxs, they are combined once weight of signers reaches Quorum
+//   - Weights is SignerWeight of each signer in SignerList, signer which isn't in Weights has weight 1
+type MultisigTx struct {
+	UUID      string
+	TxJSON    string
+	Quorum    uint32
+	Signers   []string
+	Weights   map[string]uint16
+	SignedBy  []string
+	SignedTxs []string
+}
+
+// SignedWeight returns sum of weight of signers who have signed
+func (m *MultisigTx) SignedWeight() uint32 {
+	var total uint32
+	for _, signer := range m.SignedBy {
+		weight, ok := m.Weights[signer]
+		if !ok {
+			weight = 1
+		}
+		total += uint32(weight)
+	}
+	return total
+}
+
+// IsSigned returns true if weight of signatures reaches quorum
+func (m *MultisigTx) IsSigned() bool {
+	return m.SignedWeight() >= m.Quorum
+}
+
+// IsSignedBy returns true if signer has already signed
+func (m *MultisigTx) IsSignedBy(signer string) bool {
+	return slices.Contains(m.SignedBy, signer)
+}
+
+// AddSignature adds multisigned TxBlob by signer
+func (m *MultisigTx) AddSignature(signer, txBlob string) error {
+	if !slices.Contains(m.Signers, signer) {
+		return fmt.Errorf("%s is not in signer list", signer)
+	}
+	if m.IsSignedBy(signer) {
+		return fmt.Errorf("%s has already signed", signer)
+	}
+	m.SignedBy = append(m.SignedBy, signer)
+	m.SignedTxs = append(m.SignedTxs, txBlob)
+	return nil
+}
+
+// GetSignerList returns SignerList of account, nil is returned if account isn't multisig account
+func (r *Ripple) GetSignerList(ctx context.Context, address string) (*SignerList, error) {
+	res, err := r.AccountInfo(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call AccountInfo(): %w", err)
+	}
+	if res.Error != "" {
+		return nil, fmt.Errorf("fail to call AccountInfo(): %s", res.Error)
+	}
+	if len(res.Result.AccountData.SignerLists) == 0 {
+		return nil, nil
+	}
+	return &res.Result.AccountData.SignerLists[0], nil
+}
+
+// CreateSignerListSetTransaction creates SignerListSet transaction to make account multisig account
+//   - every signer has weight 1, so quorum means required number of signatures
+//   - https://xrpl.org/signerlistset.html
+func (r *Ripple) CreateSignerListSetTransaction(
+	ctx context.Context, account string, quorum uint32, signers []string, instructions *Instructions,
+) (*TxInput, string, error) {
+	// validation
+	if account == "" {
+		return nil, "", errors.New("account is empty")
+	}
+	if len(signers) == 0 || len(signers) > MaxSignerEntries {
+		return nil, "", fmt.Errorf("number of signers must be between 1 and %d", MaxSignerEntries)
+	}
+	if quorum == 0 || quorum > uint32(len(signers)) {
+		return nil, "", fmt.Errorf("quorum %d is invalid for %d signers", quorum, len(signers))
+	}
+
+	entries := make([]SignerEntry, 0, len(signers))
+	for _, signer := range signers {
+		if signer == account {
+			return nil, "", errors.New("account itself can't be signer")
+		}
+		entries = append(entries, SignerEntry{
+			SignerEntry: SignerEntryData{Account: signer, SignerWeight: 1},
+		})
+	}
+
+	txInput := &TxInput{
+		TransactionType: "SignerListSet",
+		Account:         account,
+		SignerQuorum:    quorum,
+		SignerEntries:   entries,
+	}
+	return r.PrepareRawTransaction(ctx, txInput, instructions)
+}
@@ -0,0 +1,177 @@
+package xrp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+)
+
+const (
+	signerA = "rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq"
+	signerB = "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"
+	signerC = "rnkZMhbXQZ8GTfSihmdTqNUtvUAAqwkLWN"
+)
+
+// TestMultisigTxIsSigned is test for quorum by weight of signers
+func TestMultisigTxIsSigned(t *testing.T) {
+	tests := []struct {
+		name     string
+		quorum   uint32
+		weights  map[string]uint16
+		signedBy []string
+		want     bool
+	}{
+		{
+			name:     "no signature",
+			quorum:   1,
+			weights:  map[string]uint16{signerA: 1, signerB: 1},
+			signedBy: nil,
+			want:     false,
+		},
+		{
+			name:     "equal weight reaches quorum",
+			quorum:   2,
+			weights:  map[string]uint16{signerA: 1, signerB: 1, signerC: 1},
+			signedBy: []string{signerA, signerC},
+			want:     true,
+		},
+		{
+			name:     "equal weight doesn't reach quorum",
+			quorum:   2,
+			weights:  map[string]uint16{signerA: 1, signerB: 1, signerC: 1},
+			signedBy: []string{signerB},
+			want:     false,
+		},
+		{
+			name:     "heavy signer reaches quorum alone",
+			quorum:   3,
+			weights:  map[string]uint16{signerA: 3, signerB: 1, signerC: 1},
+			signedBy: []string{signerA},
+			want:     true,
+		},
+		{
+			name:     "light signers don't reach quorum",
+			quorum:   3,
+			weights:  map[string]uint16{signerA: 3, signerB: 1, signerC: 1},
+			signedBy: []string{signerB, signerC},
+			want:     false,
+		},
+		{
+			name:     "weight is 1 when weights are not stored",
+			quorum:   2,
+			weights:  nil,
+			signedBy: []string{signerA, signerB},
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			multisigTx := &xrp.MultisigTx{
+				Quorum:   tt.quorum,
+				Signers:  []string{signerA, signerB, signerC},
+				Weights:  tt.weights,
+				SignedBy: tt.signedBy,
+			}
+			assert.Equal(t, tt.want, multisigTx.IsSigned())
+		})
+	}
+}
+
+// TestMultisigTxAddSignature is test for signature added by signer
+func TestMultisigTxAddSignature(t *testing.T) {
+	signerList := &xrp.SignerList{
+		SignerQuorum: 2,
+		SignerEntries: []xrp.SignerEntry{
+			{SignerEntry: xrp.SignerEntryData{Account: signerA, SignerWeight: 1}},
+			{SignerEntry: xrp.SignerEntryData{Account: signerB, SignerWeight: 1}},
+		},
+	}
+	multisigTx := &xrp.MultisigTx{
+		Quorum:  signerList.SignerQuorum,
+		Signers: signerList.Signers(),
+		Weights: signerList.Weights(),
+	}
+
+	require.NoError(t, multisigTx.AddSignature(signerA, "blob-a"))
+	assert.True(t, multisigTx.IsSignedBy(signerA))
+	assert.False(t, multisigTx.IsSignedBy(signerB))
+	assert.False(t, multisigTx.IsSigned())
+
+	// signer which isn't in signer list
+	require.Error(t, multisigTx.AddSignature(signerC, "blob-c"))
+	// double signing
+	require.Error(t, multisigTx.AddSignature(signerA, "blob-a2"))
+	assert.Equal(t, []string{"blob-a"}, multisigTx.SignedTxs)
+
+	require.NoError(t, multisigTx.AddSignature(signerB, "blob-b"))
+	assert.True(t, multisigTx.IsSigned())
+	assert.Equal(t, []string{signerA, signerB}, multisigTx.SignedBy)
+	assert.Equal(t, []string{"blob-a", "blob-b"}, multisigTx.SignedTxs)
+}
+
+// TestCreateSignerListSetTransactionValidation is test for validation of SignerListSet
+//   - valid input isn't tested because it requires rippled
+func TestCreateSignerListSetTransactionValidation(t *testing.T) {
+	tooManySigners := make([]string, xrp.MaxSignerEntries+1)
+	for i := range tooManySigners {
+		tooManySigners[i] = fmt.Sprintf("rSigner%d", i)
+	}
+
+	tests := []struct {
+		name    string
+		account string
+		quorum  uint32
+		signers []string
+	}{
+		{
+			name:    "empty account",
+			account: "",
+			quorum:  1,
+			signers: []string{signerB},
+		},
+		{
+			name:    "no signer",
+			account: signerA,
+			quorum:  1,
+			signers: nil,
+		},
+		{
+			name:    "quorum 0",
+			account: signerA,
+			quorum:  0,
+			signers: []string{signerB, signerC},
+		},
+		{
+			name:    "quorum is more than signers",
+			account: signerA,
+			quorum:  3,
+			signers: []string{signerB, signerC},
+		},
+		{
+			name:    "account itself is signer",
+			account: signerA,
+			quorum:  1,
+			signers: []string{signerA, signerB},
+		},
+		{
+			name:    "more than max signer entries",
+			account: signerA,
+			quorum:  1,
+			signers: tooManySigners,
+		},
+	}
+	ripple := &xrp.Ripple{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := ripple.CreateSignerListSetTransaction(
+				context.Background(), tt.account, tt.quorum, tt.signers, &xrp.Instructions{},
+			)
+			require.Error(t, err)
+		})
+	}
+}
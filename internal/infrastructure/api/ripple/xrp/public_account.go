@@ -51,6 +51,7 @@ type AccountInfo struct {
 	Strict      bool   `json:"strict"`
 	LedgerIndex string `json:"ledger_index"`
 	Queue       bool   `json:"queue"`
+	SignerLists bool   `json:"signer_lists,omitempty"`
 }
 
 // ResponseAccountInfo is response data for account_info method
@@ -58,15 +59,17 @@ type ResponseAccountInfo struct {
 	ID     int `json:"id"`
 	Result struct {
 		AccountData struct {
-			Account           string `json:"Account"`
-			Balance           string `json:"Balance"`
-			Flags             int    `json:"Flags"`
-			LedgerEntryType   string `json:"LedgerEntryType"`
-			OwnerCount        int    `json:"OwnerCount"`
-			PreviousTxnID     string `json:"PreviousTxnID"`
-			PreviousTxnLgrSeq int    `json:"PreviousTxnLgrSeq"`
-			Sequence          int    `json:"Sequence"`
-			Index             string `json:"index"`
+			Account           string       `json:"Account"`
+			Balance           string       `json:"Balance"`
+			Flags             int          `json:"Flags"`
+			LedgerEntryType   string       `json:"LedgerEntryType"`
+			OwnerCount        int          `json:"OwnerCount"`
+			PreviousTxnID     string       `json:"PreviousTxnID"`
+			PreviousTxnLgrSeq int          `json:"PreviousTxnLgrSeq"`
+			Sequence          int          `json:"Sequence"`
+			RegularKey        string       `json:"RegularKey,omitempty"`
+			Index             string       `json:"index"`
+			SignerLists       []SignerList `json:"signer_lists"`
 		} `json:"account_data"`
 		LedgerCurrentIndex int `json:"ledger_current_index"`
 		QueueData          struct {
@@ -104,6 +107,7 @@ func (r *Ripple) AccountInfo(ctx context.Context, address string) (*ResponseAcco
 		Strict:      true,
 		LedgerIndex: "current",
 		Queue:       true,
+		SignerLists: true,
 	}
 	var res ResponseAccountInfo
 	if err := r.wsPublic.Call(ctx, &req, &res); err != nil {
@@ -111,3 +115,182 @@ func (r *Ripple) AccountInfo(ctx context.Context, address string) (*ResponseAcco
 	}
 	return &res, nil
 }
+
+// AccountTx is request data for account_tx method
+type AccountTx struct {
+	ID             int    `json:"id"`
+	Command        string `json:"command"`
+	Account        string `json:"account"`
+	LedgerIndexMin int64  `json:"ledger_index_min"`
+	LedgerIndexMax int64  `json:"ledger_index_max"`
+	Limit          int    `json:"limit,omitempty"`
+	Forward        bool   `json:"forward"`
+	Marker         any    `json:"marker,omitempty"`
+}
+
+// ResponseAccountTx is response data for account_tx method
+type ResponseAccountTx struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+	Type   string `json:"type"`
+	Result struct {
+		Account        string `json:"account"`
+		LedgerIndexMin uint64 `json:"ledger_index_min"`
+		LedgerIndexMax uint64 `json:"ledger_index_max"`
+		Limit          int    `json:"limit"`
+		Marker         any    `json:"marker,omitempty"`
+		Transactions   []struct {
+			Meta      TxMeta      `json:"meta"`
+			Tx        Transaction `json:"tx"`
+			Validated bool        `json:"validated"`
+		} `json:"transactions"`
+	} `json:"result"`
+	Error string `json:"error,omitempty"`
+}
+
+// AccountTx calls account_tx method to get validated transactions of account in ascending order
+//
+//	ledgerIndexMax -1 means the most recent validated ledger
+//	marker is returned when there are more transactions, it must be passed to next call
+func (r *Ripple) AccountTx(
+	ctx context.Context, address string, ledgerIndexMin, ledgerIndexMax int64, marker any,
+) (*ResponseAccountTx, error) {
+	req := AccountTx{
+		ID:             3,
+		Command:        "account_tx",
+		Account:        address,
+		LedgerIndexMin: ledgerIndexMin,
+		LedgerIndexMax: ledgerIndexMax,
+		Limit:          200,
+		Forward:        true,
+		Marker:         marker,
+	}
+	var res ResponseAccountTx
+	if err := r.wsPublic.Call(ctx, &req, &res); err != nil {
+		return nil, fmt.Errorf("fail to call wsClient.Call(account_tx): %w", err)
+	}
+	if res.Status != StatusCodeSuccess.String() {
+		return nil, fmt.Errorf("fail to call account_tx: %s", res.Error)
+	}
+	return &res, nil
+}
+
+// AccountLines is request data for account_lines method
+type AccountLines struct {
+	ID          int    `json:"id"`
+	Command     string `json:"command"`
+	Account     string `json:"account"`
+	Peer        string `json:"peer,omitempty"`
+	LedgerIndex string `json:"ledger_index"`
+}
+
+// TrustLine is trust line of account returned by account_lines method
+//   - balance is positive when account holds issued currency
+type TrustLine struct {
+	Account      string `json:"account"`
+	Balance      string `json:"balance"`
+	Currency     string `json:"currency"`
+	Limit        string `json:"limit"`
+	LimitPeer    string `json:"limit_peer"`
+	QualityIn    int    `json:"quality_in"`
+	QualityOut   int    `json:"quality_out"`
+	NoRipple     bool   `json:"no_ripple"`
+	NoRipplePeer bool   `json:"no_ripple_peer"`
+	Freeze       bool   `json:"freeze"`
+	FreezePeer   bool   `json:"freeze_peer"`
+}
+
+// ResponseAccountLines is response data for account_lines method
+type ResponseAccountLines struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+	Type   string `json:"type"`
+	Result struct {
+		Account     string      `json:"account"`
+		Lines       []TrustLine `json:"lines"`
+		LedgerHash  string      `json:"ledger_hash"`
+		LedgerIndex int         `json:"ledger_index"`
+		Validated   bool        `json:"validated"`
+	} `json:"result"`
+	Error string `json:"error,omitempty"`
+}
+
+// AccountLines calls account_lines method to get trust lines of account in validated ledger
+//
+//	peer filters trust lines to those with the account, it's issuer of issued currency
+func (r *Ripple) AccountLines(ctx context.Context, address, peer string) (*ResponseAccountLines, error) {
+	req := AccountLines{
+		ID:          4,
+		Command:     "account_lines",
+		Account:     address,
+		Peer:        peer,
+		LedgerIndex: "validated",
+	}
+	var res ResponseAccountLines
+	if err := r.wsPublic.Call(ctx, &req, &res); err != nil {
+		return nil, fmt.Errorf("fail to call wsClient.Call(account_lines): %w", err)
+	}
+	if res.Status != StatusCodeSuccess.String() {
+		return nil, fmt.Errorf("fail to call account_lines: %s", res.Error)
+	}
+	return &res, nil
+}
+
+// AccountObjects is request data for account_objects method
+type AccountObjects struct {
+	ID          int    `json:"id"`
+	Command     string `json:"command"`
+	Account     string `json:"account"`
+	Type        string `json:"type,omitempty"`
+	LedgerIndex string `json:"ledger_index"`
+	Limit       int    `json:"limit,omitempty"`
+}
+
+// AccountObject is ledger object owned by account returned by account_objects method
+//   - only common fields and fields of Ticket are defined
+type AccountObject struct {
+	LedgerEntryType string `json:"LedgerEntryType"`
+	Account         string `json:"Account"`
+	Flags           uint64 `json:"Flags"`
+	TicketSequence  uint64 `json:"TicketSequence"`
+	Index           string `json:"index"`
+}
+
+// ResponseAccountObjects is response data for account_objects method
+type ResponseAccountObjects struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+	Type   string `json:"type"`
+	Result struct {
+		Account        string          `json:"account"`
+		AccountObjects []AccountObject `json:"account_objects"`
+		LedgerHash     string          `json:"ledger_hash"`
+		LedgerIndex    int             `json:"ledger_index"`
+		Validated      bool            `json:"validated"`
+	} `json:"result"`
+	Error string `json:"error,omitempty"`
+}
+
+// AccountObjects calls account_objects method to get ledger objects of account in validated ledger
+//
+//	objectType filters objects such as `ticket`, limit is up to 400
+func (r *Ripple) AccountObjects(
+	ctx context.Context, address, objectType string, limit int,
+) (*ResponseAccountObjects, error) {
+	req := AccountObjects{
+		ID:          5,
+		Command:     "account_objects",
+		Account:     address,
+		Type:        objectType,
+		LedgerIndex: "validated",
+		Limit:       limit,
+	}
+	var res ResponseAccountObjects
+	if err := r.wsPublic.Call(ctx, &req, &res); err != nil {
+		return nil, fmt.Errorf("fail to call wsClient.Call(account_objects): %w", err)
+	}
+	if res.Status != StatusCodeSuccess.String() {
+		return nil, fmt.Errorf("fail to call account_objects: %s", res.Error)
+	}
+	return &res, nil
+}
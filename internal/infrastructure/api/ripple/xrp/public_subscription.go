@@ -1,3 +1,257 @@
 package xrp
 
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/network/websocket"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
 // https://xrpl.org/subscription-methods.html
+
+// Subscribe is request data for subscribe method
+type Subscribe struct {
+	ID       int      `json:"id"`
+	Command  string   `json:"command"`
+	Streams  []string `json:"streams,omitempty"`
+	Accounts []string `json:"accounts,omitempty"`
+}
+
+// ResponseSubscribe is response data for subscribe method
+//
+//	result includes current ledger when `ledger` stream is subscribed
+type ResponseSubscribe struct {
+	ID     int    `json:"id"`
+	Status string `json:"status"`
+	Type   string `json:"type"`
+	Result struct {
+		FeeBase          int    `json:"fee_base"`
+		LedgerHash       string `json:"ledger_hash"`
+		LedgerIndex      uint64 `json:"ledger_index"`
+		LedgerTime       int64  `json:"ledger_time"`
+		ValidatedLedgers string `json:"validated_ledgers"`
+	} `json:"result"`
+	Error string `json:"error,omitempty"`
+}
+
+// Stream names
+const (
+	StreamLedger = "ledger"
+)
+
+// message types of stream
+const (
+	streamTypeResponse     = "response"
+	streamTypeLedgerClosed = "ledgerClosed"
+	streamTypeTransaction  = "transaction"
+)
+
+// StreamLedgerClosed is message of `ledger` stream sent when ledger is validated
+type StreamLedgerClosed struct {
+	Type             string `json:"type"`
+	FeeBase          int    `json:"fee_base"`
+	LedgerHash       string `json:"ledger_hash"`
+	LedgerIndex      uint64 `json:"ledger_index"`
+	LedgerTime       int64  `json:"ledger_time"`
+	TxnCount         int    `json:"txn_count"`
+	ValidatedLedgers string `json:"validated_ledgers"`
+}
+
+// StreamTransaction is message of transaction affecting subscribed accounts
+type StreamTransaction struct {
+	Type                string      `json:"type"`
+	EngineResult        string      `json:"engine_result"`
+	EngineResultCode    int         `json:"engine_result_code"`
+	EngineResultMessage string      `json:"engine_result_message"`
+	LedgerHash          string      `json:"ledger_hash"`
+	LedgerIndex         uint64      `json:"ledger_index"`
+	Meta                TxMeta      `json:"meta"`
+	Transaction         Transaction `json:"transaction"`
+	Validated           bool        `json:"validated"`
+}
+
+// Transaction is transaction fields in stream message and account_tx
+//
+//	Amount is string as drops for XRP or object for issued currency,
+//	it isn't delivered amount of partial payment, use DeliveredAmount() instead
+//	DestinationTag is nil if it isn't given, tag 0 is valid
+type Transaction struct {
+	Account         string          `json:"Account"`
+	Amount          *CurrencyAmount `json:"Amount,omitempty"`
+	Destination     string          `json:"Destination,omitempty"`
+	DestinationTag  *uint32         `json:"DestinationTag,omitempty"`
+	Fee             string          `json:"Fee"`
+	Flags           uint64          `json:"Flags"`
+	Sequence        uint64          `json:"Sequence"`
+	TransactionType string          `json:"TransactionType"`
+	Hash            string          `json:"hash"`
+	LedgerIndex     uint64          `json:"ledger_index,omitempty"`
+}
+
+// TxMeta is metadata of validated transaction
+type TxMeta struct {
+	TransactionIndex  int             `json:"TransactionIndex"`
+	TransactionResult string          `json:"TransactionResult"`
+	DeliveredAmount   *CurrencyAmount `json:"delivered_amount,omitempty"`
+}
+
+// StreamEventType is type of StreamEvent
+type StreamEventType int
+
+// StreamEventType constants
+const (
+	// StreamEventConnected is sent every time subscription starts including reconnection,
+	// LedgerIndex is the latest validated ledger, so messages before that should be caught up
+	StreamEventConnected StreamEventType = iota + 1
+	// StreamEventLedger is sent when ledger is validated
+	StreamEventLedger
+	// StreamEventTransaction is sent when transaction affecting subscribed accounts is validated
+	StreamEventTransaction
+)
+
+// StreamEvent is event from Subscriber
+type StreamEvent struct {
+	Type        StreamEventType
+	LedgerIndex uint64
+	Transaction *StreamTransaction
+}
+
+// Subscriber subscribes `ledger` stream and transactions of accounts
+// with dedicated websocket connection
+type Subscriber struct {
+	url    string
+	retry  time.Duration
+	events chan StreamEvent
+}
+
+// DefaultSubscriberRetry is interval to reconnect server
+const DefaultSubscriberRetry = 5 * time.Second
+
+// NewSubscriber creates Subscriber
+func NewSubscriber(url string) *Subscriber {
+	return &Subscriber{
+		url:    url,
+		retry:  DefaultSubscriberRetry,
+		events: make(chan StreamEvent, 100),
+	}
+}
+
+// Events returns channel of events, it's closed when Start returns
+func (s *Subscriber) Events() <-chan StreamEvent {
+	return s.events
+}
+
+// Start subscribes streams and blocks until ctx is canceled, it reconnects when connection is lost
+func (s *Subscriber) Start(ctx context.Context, accounts []string) error {
+	defer close(s.events)
+
+	if len(accounts) == 0 {
+		return errors.New("accounts to subscribe are required")
+	}
+
+	for {
+		err := s.subscribe(ctx, accounts)
+		if ctx.Err() != nil {
+			return nil
+		}
+		logger.Warn("subscription is disconnected, reconnecting", "url", s.url, "error", err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.retry):
+		}
+	}
+}
+
+func (s *Subscriber) subscribe(ctx context.Context, accounts []string) error {
+	ws, err := websocket.New(ctx, s.url)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = ws.Close() // Best effort cleanup
+	}()
+
+	req := Subscribe{
+		ID:       1,
+		Command:  "subscribe",
+		Streams:  []string{StreamLedger},
+		Accounts: accounts,
+	}
+	if err = ws.Write(ctx, &req); err != nil {
+		return fmt.Errorf("fail to call ws.Write(subscribe): %w", err)
+	}
+	var res ResponseSubscribe
+	if err = ws.Read(ctx, &res); err != nil {
+		return fmt.Errorf("fail to call ws.Read(subscribe): %w", err)
+	}
+	if res.Status != StatusCodeSuccess.String() {
+		return fmt.Errorf("fail to subscribe: %s", res.Error)
+	}
+	logger.Info("subscribed", "url", s.url, "accounts", len(accounts), "ledger_index", res.Result.LedgerIndex)
+
+	if err = s.send(ctx, StreamEvent{Type: StreamEventConnected, LedgerIndex: res.Result.LedgerIndex}); err != nil {
+		return err
+	}
+
+	for {
+		var msg json.RawMessage
+		if err = ws.Read(ctx, &msg); err != nil {
+			return err
+		}
+		event, err := parseStreamMessage(msg)
+		if err != nil {
+			logger.Warn("fail to parse stream message", "error", err)
+			continue
+		}
+		if event == nil {
+			continue
+		}
+		if err = s.send(ctx, *event); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Subscriber) send(ctx context.Context, event StreamEvent) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case s.events <- event:
+		return nil
+	}
+}
+
+// parseStreamMessage returns nil for message which is not handled
+func parseStreamMessage(msg json.RawMessage) (*StreamEvent, error) {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(msg, &header); err != nil {
+		return nil, fmt.Errorf("fail to call json.Unmarshal(): %w", err)
+	}
+
+	switch header.Type {
+	case streamTypeLedgerClosed:
+		var ledger StreamLedgerClosed
+		if err := json.Unmarshal(msg, &ledger); err != nil {
+			return nil, fmt.Errorf("fail to call json.Unmarshal(%s): %w", header.Type, err)
+		}
+		return &StreamEvent{Type: StreamEventLedger, LedgerIndex: ledger.LedgerIndex}, nil
+	case streamTypeTransaction:
+		var tx StreamTransaction
+		if err := json.Unmarshal(msg, &tx); err != nil {
+			return nil, fmt.Errorf("fail to call json.Unmarshal(%s): %w", header.Type, err)
+		}
+		return &StreamEvent{Type: StreamEventTransaction, LedgerIndex: tx.LedgerIndex, Transaction: &tx}, nil
+	case streamTypeResponse:
+		return nil, nil
+	default:
+		return nil, nil
+	}
+}
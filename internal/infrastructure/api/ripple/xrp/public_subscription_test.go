@@ -0,0 +1,121 @@
+package xrp_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// newFakeRippled returns server which responds to subscribe and publishes stream messages,
+// connection is closed after messages are sent to test reconnection
+func newFakeRippled(t *testing.T, messages []string) *httptest.Server {
+	t.Helper()
+	var connCount atomic.Uint64
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.CloseNow()
+		ctx := r.Context()
+
+		var req xrp.Subscribe
+		if err := wsjson.Read(ctx, conn, &req); err != nil {
+			return
+		}
+		ledgerIndex := 100 + connCount.Add(1)*10
+		res := map[string]any{
+			"id":     req.ID,
+			"status": "success",
+			"type":   "response",
+			"result": map[string]any{"ledger_index": ledgerIndex},
+		}
+		if err := wsjson.Write(ctx, conn, res); err != nil {
+			return
+		}
+		for _, msg := range messages {
+			if err := conn.Write(ctx, websocket.MessageText, []byte(msg)); err != nil {
+				return
+			}
+		}
+		_ = conn.Close(websocket.StatusGoingAway, "")
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func receive(t *testing.T, events <-chan xrp.StreamEvent) xrp.StreamEvent {
+	t.Helper()
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(10 * time.Second):
+		t.Fatal("event is not received")
+		return xrp.StreamEvent{}
+	}
+}
+
+// TestSubscriber tests stream messages and reconnection
+func TestSubscriber(t *testing.T) {
+	// set before goroutines of subscriber use it
+	logger.SetGlobal(logger.NewNoopLogger())
+
+	server := newFakeRippled(t, []string{
+		`{"type":"ledgerClosed","ledger_hash":"ABC","ledger_index":111,"txn_count":1}`,
+		`{"type":"transaction","engine_result":"tesSUCCESS","ledger_index":111,"validated":true,` +
+			`"meta":{"TransactionResult":"tesSUCCESS","delivered_amount":"1000000"},` +
+			`"transaction":{"Account":"rSender","Destination":"rReceiver","DestinationTag":7,` +
+			`"Amount":"1000000","TransactionType":"Payment","hash":"HASH"}}`,
+	})
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	sub := xrp.NewSubscriber(url)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- sub.Start(ctx, []string{"rReceiver"}) }()
+
+	event := receive(t, sub.Events())
+	assert.Equal(t, xrp.StreamEventConnected, event.Type)
+	assert.Equal(t, uint64(110), event.LedgerIndex)
+
+	event = receive(t, sub.Events())
+	assert.Equal(t, xrp.StreamEventLedger, event.Type)
+	assert.Equal(t, uint64(111), event.LedgerIndex)
+
+	event = receive(t, sub.Events())
+	require.Equal(t, xrp.StreamEventTransaction, event.Type)
+	assert.True(t, event.Transaction.Validated)
+	assert.Equal(t, "HASH", event.Transaction.Transaction.Hash)
+	assert.Equal(t, "rReceiver", event.Transaction.Transaction.Destination)
+	require.NotNil(t, event.Transaction.Transaction.DestinationTag)
+	assert.Equal(t, uint32(7), *event.Transaction.Transaction.DestinationTag)
+	assert.Equal(t, "tesSUCCESS", event.Transaction.Meta.TransactionResult)
+
+	// server closes connection, then subscriber reconnects and notifies it to catch up
+	event = receive(t, sub.Events())
+	assert.Equal(t, xrp.StreamEventConnected, event.Type)
+	assert.Equal(t, uint64(120), event.LedgerIndex)
+
+	cancel()
+	require.NoError(t, <-done)
+}
+
+// TestSubscriberWithoutAccounts tests validation of accounts
+func TestSubscriberWithoutAccounts(t *testing.T) {
+	sub := xrp.NewSubscriber("ws://127.0.0.1:0")
+	assert.Error(t, sub.Start(context.Background(), nil))
+}
@@ -0,0 +1,48 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// - SetRegularKey https://xrpl.org/setregularkey.html
+// - Assign a Regular Key Pair https://xrpl.org/assign-a-regular-key-pair.html
+// - Change or Remove a Regular Key Pair https://xrpl.org/change-or-remove-a-regular-key-pair.html
+
+// CreateSetRegularKeyTransaction creates SetRegularKey transaction to authorize regular key pair
+//   - existing regular key is replaced, so the same transaction is used to rotate regular key
+//   - it can be signed by either master key or current regular key
+func (r *Ripple) CreateSetRegularKeyTransaction(
+	ctx context.Context, account, regularKey string, instructions *Instructions,
+) (*TxInput, string, error) {
+	// validation
+	if account == "" {
+		return nil, "", errors.New("account is empty")
+	}
+	if regularKey == "" {
+		return nil, "", errors.New("regularKey is empty")
+	}
+	if account == regularKey {
+		return nil, "", errors.New("regularKey must be different from account")
+	}
+
+	txInput := &TxInput{
+		TransactionType: "SetRegularKey",
+		Account:         account,
+		RegularKey:      regularKey,
+	}
+	return r.PrepareRawTransaction(ctx, txInput, instructions)
+}
+
+// GetRegularKey returns regular key set on address, it's empty if regular key isn't set
+func (r *Ripple) GetRegularKey(ctx context.Context, address string) (string, error) {
+	res, err := r.AccountInfo(ctx, address)
+	if err != nil {
+		return "", fmt.Errorf("fail to call AccountInfo(): %w", err)
+	}
+	if res.Error != "" {
+		return "", fmt.Errorf("fail to call AccountInfo(): %s", res.Error)
+	}
+	return res.Result.AccountData.RegularKey, nil
+}
@@ -0,0 +1,30 @@
+package xrp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+)
+
+// TestTxInputRegularKey is test for JSON encoding of SetRegularKey transaction
+func TestTxInputRegularKey(t *testing.T) {
+	txJSON := `{"TransactionType":"SetRegularKey","Account":"rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq",` +
+		`"RegularKey":"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59","Fee":"12","Flags":0,` +
+		`"LastLedgerSequence":100,"Sequence":5}`
+	var txInput xrp.TxInput
+	require.NoError(t, json.Unmarshal([]byte(txJSON), &txInput))
+	assert.Equal(t, "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", txInput.RegularKey)
+
+	b, err := json.Marshal(txInput)
+	require.NoError(t, err)
+	assert.JSONEq(t, txJSON, string(b))
+
+	// RegularKey is omitted from other transactions, SetRegularKey without it removes regular key
+	b, err = json.Marshal(xrp.TxInput{TransactionType: "Payment", Sequence: 10})
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "RegularKey")
+}
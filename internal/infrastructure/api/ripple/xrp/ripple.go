@@ -17,6 +17,8 @@ type Ripple struct {
 	API          *RippleAPI
 	chainConf    *chaincfg.Params
 	coinTypeCode domainCoin.CoinTypeCode // eth
+	// issuedCurrency is sent instead of XRP, nil for XRP
+	issuedCurrency *IssuedCurrency
 }
 
 // NewRipple creates Ripple object
@@ -41,6 +43,12 @@ func NewRipple(
 		xrp.chainConf = &chaincfg.MainNetParams
 	}
 
+	issuedCurrency, err := newIssuedCurrency(conf)
+	if err != nil {
+		return nil, err
+	}
+	xrp.issuedCurrency = issuedCurrency
+
 	return xrp, nil
 }
 
@@ -20,18 +20,36 @@ import (
 // - Payment System Basics https://xrpl.org/payment-system-basics.html
 
 // TxInput is transaction input json type
+// - Amount and Destination are used by Payment
+// - SignerQuorum and SignerEntries are used by SignerListSet
+// - SetFlag is used by AccountSet
+// - LimitAmount is used by TrustSet
+// - TicketCount is used by TicketCreate, TicketSequence is used instead of Sequence by any transaction
+// - RegularKey is used by SetRegularKey, Destination is used by AccountDelete as well
+// - FinishAfter and CancelAfter are used by EscrowCreate, Owner and OfferSequence are used by EscrowFinish/EscrowCancel
 type TxInput struct {
-	TransactionType    string `json:"TransactionType"`
-	Account            string `json:"Account"`
-	Amount             string `json:"Amount"`
-	Destination        string `json:"Destination"`
-	Fee                string `json:"Fee"`
-	Flags              uint64 `json:"Flags"`
-	LastLedgerSequence uint64 `json:"LastLedgerSequence"`
-	Sequence           uint64 `json:"Sequence"`
-	SigningPubKey      string `json:"SigningPubKey,omitempty"`
-	TxnSignature       string `json:"TxnSignature,omitempty"`
-	Hash               string `json:"hash,omitempty"`
+	TransactionType    string          `json:"TransactionType"`
+	Account            string          `json:"Account"`
+	Amount             *CurrencyAmount `json:"Amount,omitempty"`
+	Destination        string          `json:"Destination,omitempty"`
+	LimitAmount        *CurrencyAmount `json:"LimitAmount,omitempty"`
+	Fee                string          `json:"Fee"`
+	Flags              uint64          `json:"Flags"`
+	LastLedgerSequence uint64          `json:"LastLedgerSequence"`
+	Sequence           uint64          `json:"Sequence"`
+	TicketSequence     uint64          `json:"TicketSequence,omitempty"`
+	TicketCount        uint32          `json:"TicketCount,omitempty"`
+	SignerQuorum       uint32          `json:"SignerQuorum,omitempty"`
+	SignerEntries      []SignerEntry   `json:"SignerEntries,omitempty"`
+	SetFlag            uint32          `json:"SetFlag,omitempty"`
+	RegularKey         string          `json:"RegularKey,omitempty"`
+	FinishAfter        uint32          `json:"FinishAfter,omitempty"`
+	CancelAfter        uint32          `json:"CancelAfter,omitempty"`
+	Owner              string          `json:"Owner,omitempty"`
+	OfferSequence      uint64          `json:"OfferSequence,omitempty"`
+	SigningPubKey      string          `json:"SigningPubKey,omitempty"`
+	TxnSignature       string          `json:"TxnSignature,omitempty"`
+	Hash               string          `json:"hash,omitempty"`
 }
 
 // SentTx is result transaction json type after sending
@@ -137,6 +155,39 @@ func (r *Ripple) PrepareTransaction(
 	return &txInput, unquotedJSON, nil
 }
 
+// PrepareRawTransaction calls PrepareTransaction API with transaction JSON
+// - it is used for transaction types other than Payment such as SignerListSet
+func (r *Ripple) PrepareRawTransaction(
+	ctx context.Context, txInput *TxInput, instructions *Instructions,
+) (*TxInput, string, error) {
+	strJSON, err := json.Marshal(txInput)
+	if err != nil {
+		return nil, "", fmt.Errorf("fail to call json.Marshal(txJSON): %w", err)
+	}
+	req := &RequestPrepareTransaction{
+		SenderAccount: txInput.Account,
+		Instructions:  instructions,
+		TxJSON:        string(strJSON),
+	}
+
+	res, err := r.API.txClient.PrepareTransaction(ctx, req)
+	if err != nil {
+		return nil, "", fmt.Errorf("fail to call client.PrepareTransaction(): %w", err)
+	}
+	logger.Debug("response",
+		"TxJSON", res.TxJSON,
+		"Instructions", res.Instructions,
+	)
+
+	var preparedTx TxInput
+	unquotedJSON, _ := strconv.Unquote(res.TxJSON)
+	if err = json.Unmarshal([]byte(unquotedJSON), &preparedTx); err != nil {
+		return nil, "", fmt.Errorf("fail to call json.Unmarshal(txJSON): %w", err)
+	}
+
+	return &preparedTx, unquotedJSON, nil
+}
+
 // SignTransaction calls SignTransaction API
 // Offline functionality
 // - https://xrpl.org/rippleapi-reference.html#offline-functionality
@@ -158,6 +209,30 @@ func (r *Ripple) SignTransaction(ctx context.Context, txInput *TxInput, secret s
 	return res.TxID, res.TxBlob, nil
 }
 
+// MultiSignTransaction signs transaction as one of signers of multisig account
+// - signAs is the address of signer, returned TxBlob must be combined by CombineTransaction()
+// - https://xrpl.org/send-a-multi-signed-transaction.html
+func (r *Ripple) MultiSignTransaction(
+	ctx context.Context, txInput *TxInput, secret, signAs string,
+) (string, string, error) {
+	strJSON, err := json.Marshal(txInput)
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call json.Marshal(txJSON): %w", err)
+	}
+	req := &RequestSignTransaction{
+		TxJSON: string(strJSON),
+		Secret: secret,
+		SignAs: signAs,
+	}
+
+	res, err := r.API.txClient.SignTransaction(ctx, req)
+	if err != nil {
+		return "", "", fmt.Errorf("fail to call client.SignTransaction(): %w", err)
+	}
+
+	return res.TxID, res.TxBlob, nil
+}
+
 // CombineTransaction combines signed transactions from multiple accounts for a multisignature transaction.
 // - The signed transaction must subsequently be submitted.
 func (r *Ripple) CombineTransaction(ctx context.Context, signedTxs []string) (string, string, error) {
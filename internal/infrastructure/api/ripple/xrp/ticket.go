@@ -0,0 +1,52 @@
+package xrp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// - Tickets https://xrpl.org/tickets.html
+// - TicketCreate https://xrpl.org/ticketcreate.html
+
+// MaxTicketCount is maximum number of tickets which account can hold at once
+const MaxTicketCount = 250
+
+// CreateTicketCreateTransaction creates TicketCreate transaction to reserve sequence numbers as tickets
+//   - each ticket is counted toward owner reserve until it's used
+func (r *Ripple) CreateTicketCreateTransaction(
+	ctx context.Context, account string, count uint32, instructions *Instructions,
+) (*TxInput, string, error) {
+	// validation
+	if account == "" {
+		return nil, "", errors.New("account is empty")
+	}
+	if count == 0 || count > MaxTicketCount {
+		return nil, "", fmt.Errorf("count must be between 1 and %d", MaxTicketCount)
+	}
+
+	txInput := &TxInput{
+		TransactionType: "TicketCreate",
+		Account:         account,
+		TicketCount:     count,
+	}
+	return r.PrepareRawTransaction(ctx, txInput, instructions)
+}
+
+// GetTickets returns sequences of tickets held by account in ascending order
+//   - used ticket is removed from ledger, so every returned ticket is available
+func (r *Ripple) GetTickets(ctx context.Context, address string) ([]uint64, error) {
+	res, err := r.AccountObjects(ctx, address, "ticket", MaxTicketCount)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call AccountObjects(): %w", err)
+	}
+	tickets := make([]uint64, 0, len(res.Result.AccountObjects))
+	for _, obj := range res.Result.AccountObjects {
+		if obj.LedgerEntryType == "Ticket" {
+			tickets = append(tickets, obj.TicketSequence)
+		}
+	}
+	slices.Sort(tickets)
+	return tickets, nil
+}
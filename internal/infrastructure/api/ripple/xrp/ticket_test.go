@@ -0,0 +1,32 @@
+package xrp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+)
+
+// TestTxInputTicket is test for JSON encoding of transaction with ticket
+func TestTxInputTicket(t *testing.T) {
+	// Sequence must be 0 when TicketSequence is used
+	txJSON := `{"TransactionType":"Payment","Account":"rhub8VRN55s94qWKDv6jmDy1pUykJzF3wq",` +
+		`"Amount":"1000000","Destination":"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59","Fee":"12","Flags":0,` +
+		`"LastLedgerSequence":100,"Sequence":0,"TicketSequence":15}`
+	var txInput xrp.TxInput
+	require.NoError(t, json.Unmarshal([]byte(txJSON), &txInput))
+	assert.Equal(t, uint64(15), txInput.TicketSequence)
+
+	// signed transaction must keep TicketSequence
+	b, err := json.Marshal(txInput)
+	require.NoError(t, err)
+	assert.JSONEq(t, txJSON, string(b))
+
+	// TicketCount and TicketSequence are omitted from transaction without ticket
+	b, err = json.Marshal(xrp.TxInput{TransactionType: "Payment", Sequence: 10})
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "Ticket")
+}
@@ -10,6 +10,7 @@ import (
 
 // CreateRawTransaction creates raw transaction
 // - https://xrpl.org/ja/send-xrp.html
+// - issued currency is sent instead of XRP when it's set in config
 func (r *Ripple) CreateRawTransaction(
 	ctx context.Context, senderAccount, receiverAccount string, amount float64, instructions *Instructions,
 ) (*TxInput, string, error) {
@@ -20,6 +21,9 @@ func (r *Ripple) CreateRawTransaction(
 	if receiverAccount == "" {
 		return nil, "", errors.New("receiverAccount is empty")
 	}
+	if r.issuedCurrency != nil {
+		return r.createIssuedRawTransaction(ctx, senderAccount, receiverAccount, amount, instructions)
+	}
 
 	// get balance
 	// xrp.MinimumReserve
@@ -7,12 +7,13 @@
 package xrp
 
 import (
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 const (
@@ -43,6 +44,7 @@ const (
 	EnumTransactionType_TX_SET_REGULAR_KEY        EnumTransactionType = 15 // SetRegularKey
 	EnumTransactionType_TX_SINGER_LIST_SET        EnumTransactionType = 16 // SignerListSet
 	EnumTransactionType_TX_TRUST_SET              EnumTransactionType = 17 // TrustSet
+	EnumTransactionType_TX_TICKET_CREATE          EnumTransactionType = 18 // TicketCreate
 )
 
 // Enum value maps for EnumTransactionType.
@@ -66,6 +68,7 @@ var (
 		15: "TX_SET_REGULAR_KEY",
 		16: "TX_SINGER_LIST_SET",
 		17: "TX_TRUST_SET",
+		18: "TX_TICKET_CREATE",
 	}
 	EnumTransactionType_value = map[string]int32{
 		"TX_ACCOUNT_SET":            0,
@@ -86,6 +89,7 @@ var (
 		"TX_SET_REGULAR_KEY":        15,
 		"TX_SINGER_LIST_SET":        16,
 		"TX_TRUST_SET":              17,
+		"TX_TICKET_CREATE":          18,
 	}
 )
 
@@ -124,6 +128,7 @@ type Instructions struct {
 	MaxLedgerVersionOffset uint64                 `protobuf:"varint,4,opt,name=maxLedgerVersionOffset" json:"maxLedgerVersionOffset,omitempty"`
 	Sequence               uint64                 `protobuf:"varint,5,opt,name=sequence" json:"sequence,omitempty"`
 	SignersCount           uint64                 `protobuf:"varint,6,opt,name=signersCount" json:"signersCount,omitempty"`
+	TicketSequence         uint64                 `protobuf:"varint,7,opt,name=ticketSequence" json:"ticketSequence,omitempty"` // ticket used instead of sequence
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -200,6 +205,13 @@ func (x *Instructions) GetSignersCount() uint64 {
 	return 0
 }
 
+func (x *Instructions) GetTicketSequence() uint64 {
+	if x != nil {
+		return x.TicketSequence
+	}
+	return 0
+}
+
 type RequestPrepareTransaction struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TxType          EnumTransactionType    `protobuf:"varint,1,opt,name=tx_type,json=txType,enum=rippleapi.transaction.EnumTransactionType" json:"tx_type,omitempty"`
@@ -207,6 +219,7 @@ type RequestPrepareTransaction struct {
 	Amount          float64                `protobuf:"fixed64,3,opt,name=amount" json:"amount,omitempty"`
 	ReceiverAccount string                 `protobuf:"bytes,4,opt,name=receiverAccount" json:"receiverAccount,omitempty"`
 	Instructions    *Instructions          `protobuf:"bytes,5,opt,name=instructions" json:"instructions,omitempty"`
+	TxJSON          string                 `protobuf:"bytes,6,opt,name=txJSON" json:"txJSON,omitempty"` // transaction JSON for types other than Payment, used as is if set
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -276,6 +289,13 @@ func (x *RequestPrepareTransaction) GetInstructions() *Instructions {
 	return nil
 }
 
+func (x *RequestPrepareTransaction) GetTxJSON() string {
+	if x != nil {
+		return x.TxJSON
+	}
+	return ""
+}
+
 type ResponsePrepareTransaction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TxJSON        string                 `protobuf:"bytes,1,opt,name=txJSON" json:"txJSON,omitempty"`
@@ -332,6 +352,7 @@ type RequestSignTransaction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TxJSON        string                 `protobuf:"bytes,1,opt,name=txJSON" json:"txJSON,omitempty"`
 	Secret        string                 `protobuf:"bytes,2,opt,name=secret" json:"secret,omitempty"`
+	SignAs        string                 `protobuf:"bytes,3,opt,name=signAs" json:"signAs,omitempty"` // account to sign as for multisigning
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -380,6 +401,13 @@ func (x *RequestSignTransaction) GetSecret() string {
 	return ""
 }
 
+func (x *RequestSignTransaction) GetSignAs() string {
+	if x != nil {
+		return x.SignAs
+	}
+	return ""
+}
+
 type ResponseSignTransaction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TxID          string                 `protobuf:"bytes,1,opt,name=txID" json:"txID,omitempty"`
@@ -768,26 +796,29 @@ var File_transaction_proto protoreflect.FileDescriptor
 
 const file_transaction_proto_rawDesc = "" +
 	"\n" +
-	"\x11transaction.proto\x12\x15rippleapi.transaction\x1a\x1bgoogle/protobuf/empty.proto\"\xdc\x01\n" +
+	"\x11transaction.proto\x12\x15rippleapi.transaction\x1a\x1bgoogle/protobuf/empty.proto\"\x84\x02\n" +
 	"\fInstructions\x12\x10\n" +
 	"\x03fee\x18\x01 \x01(\tR\x03fee\x12\x16\n" +
 	"\x06maxFee\x18\x02 \x01(\tR\x06maxFee\x12*\n" +
 	"\x10maxLedgerVersion\x18\x03 \x01(\x04R\x10maxLedgerVersion\x126\n" +
 	"\x16maxLedgerVersionOffset\x18\x04 \x01(\x04R\x16maxLedgerVersionOffset\x12\x1a\n" +
 	"\bsequence\x18\x05 \x01(\x04R\bsequence\x12\"\n" +
-	"\fsignersCount\x18\x06 \x01(\x04R\fsignersCount\"\x91\x02\n" +
+	"\fsignersCount\x18\x06 \x01(\x04R\fsignersCount\x12&\n" +
+	"\x0eticketSequence\x18\a \x01(\x04R\x0eticketSequence\"\xa9\x02\n" +
 	"\x19RequestPrepareTransaction\x12C\n" +
 	"\atx_type\x18\x01 \x01(\x0e2*.rippleapi.transaction.EnumTransactionTypeR\x06txType\x12$\n" +
 	"\rsenderAccount\x18\x02 \x01(\tR\rsenderAccount\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12(\n" +
 	"\x0freceiverAccount\x18\x04 \x01(\tR\x0freceiverAccount\x12G\n" +
-	"\finstructions\x18\x05 \x01(\v2#.rippleapi.transaction.InstructionsR\finstructions\"}\n" +
+	"\finstructions\x18\x05 \x01(\v2#.rippleapi.transaction.InstructionsR\finstructions\x12\x16\n" +
+	"\x06txJSON\x18\x06 \x01(\tR\x06txJSON\"}\n" +
 	"\x1aResponsePrepareTransaction\x12\x16\n" +
 	"\x06txJSON\x18\x01 \x01(\tR\x06txJSON\x12G\n" +
-	"\finstructions\x18\x02 \x01(\v2#.rippleapi.transaction.InstructionsR\finstructions\"H\n" +
+	"\finstructions\x18\x02 \x01(\v2#.rippleapi.transaction.InstructionsR\finstructions\"`\n" +
 	"\x16RequestSignTransaction\x12\x16\n" +
 	"\x06txJSON\x18\x01 \x01(\tR\x06txJSON\x12\x16\n" +
-	"\x06secret\x18\x02 \x01(\tR\x06secret\"E\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06signAs\x18\x03 \x01(\tR\x06signAs\"E\n" +
 	"\x17ResponseSignTransaction\x12\x12\n" +
 	"\x04txID\x18\x01 \x01(\tR\x04txID\x12\x16\n" +
 	"\x06txBlob\x18\x02 \x01(\tR\x06txBlob\"2\n" +
@@ -807,7 +838,7 @@ const file_transaction_proto_rawDesc = "" +
 	"\x12signedTransactions\x18\x01 \x03(\tR\x12signedTransactions\"^\n" +
 	"\x1aResponseCombineTransaction\x12,\n" +
 	"\x11signedTransaction\x18\x01 \x01(\tR\x11signedTransaction\x12\x12\n" +
-	"\x04txID\x18\x02 \x01(\tR\x04txID*\xad\x03\n" +
+	"\x04txID\x18\x02 \x01(\tR\x04txID*\xc3\x03\n" +
 	"\x13EnumTransactionType\x12\x12\n" +
 	"\x0eTX_ACCOUNT_SET\x10\x00\x12\x15\n" +
 	"\x11TX_ACCOUNT_DELETE\x10\x01\x12\x13\n" +
@@ -828,7 +859,8 @@ const file_transaction_proto_rawDesc = "" +
 	"\x17TX_PAYMENT_CHANNEL_FUND\x10\x0e\x12\x16\n" +
 	"\x12TX_SET_REGULAR_KEY\x10\x0f\x12\x16\n" +
 	"\x12TX_SINGER_LIST_SET\x10\x10\x12\x10\n" +
-	"\fTX_TRUST_SET\x10\x112\xcc\x05\n" +
+	"\fTX_TRUST_SET\x10\x11\x12\x14\n" +
+	"\x10TX_TICKET_CREATE\x10\x122\xcc\x05\n" +
 	"\x14RippleTransactionAPI\x12{\n" +
 	"\x12PrepareTransaction\x120.rippleapi.transaction.RequestPrepareTransaction\x1a1.rippleapi.transaction.ResponsePrepareTransaction\"\x00\x12r\n" +
 	"\x0fSignTransaction\x12-.rippleapi.transaction.RequestSignTransaction\x1a..rippleapi.transaction.ResponseSignTransaction\"\x00\x12x\n" +
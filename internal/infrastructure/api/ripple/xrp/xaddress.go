@@ -0,0 +1,85 @@
+package xrp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/LanfordCai/rbase58"
+)
+
+// - X-address format https://xrpaddress.info/
+// - https://github.com/XRPLF/XRPL-Standards/discussions/9
+
+var (
+	xAddressPrefixMainNet = []byte{0x05, 0x44}
+	xAddressPrefixTestNet = []byte{0x04, 0x93}
+)
+
+const (
+	// classicAddressVersion is version byte of classic address
+	classicAddressVersion = 0x00
+	// accountIDLength is length of account ID which classic address encodes
+	accountIDLength = 20
+	// xAddressPayloadLength is length of payload after version byte, prefix(1) + accountID(20) + flag(1) + tag(8)
+	xAddressPayloadLength = 30
+)
+
+// EncodeXAddress encodes classic address and destination tag to X-address
+//   - tag is always encoded, so X-address is unique for each tag on the same classic address
+func EncodeXAddress(classicAddress string, tag uint32, isTestNet bool) (string, error) {
+	accountID, version, err := rbase58.CheckDecode(classicAddress)
+	if err != nil {
+		return "", fmt.Errorf("fail to decode classic address %s: %w", classicAddress, err)
+	}
+	if version != classicAddressVersion || len(accountID) != accountIDLength {
+		return "", fmt.Errorf("classic address is invalid: %s", classicAddress)
+	}
+
+	prefix := xAddressPrefixMainNet
+	if isTestNet {
+		prefix = xAddressPrefixTestNet
+	}
+	payload := make([]byte, 0, xAddressPayloadLength)
+	payload = append(payload, prefix[1])
+	payload = append(payload, accountID...)
+	payload = append(payload, 1) // flag: tag is present
+	payload = binary.LittleEndian.AppendUint32(payload, tag)
+	payload = append(payload, 0, 0, 0, 0) // reserved for 64bit tag
+	return rbase58.CheckEncode(payload, prefix[0]), nil
+}
+
+// DecodeXAddress decodes X-address to classic address and destination tag
+//   - hasTag is false when X-address doesn't include tag
+func DecodeXAddress(xAddress string) (string, uint32, bool, error) {
+	payload, version, err := rbase58.CheckDecode(xAddress)
+	if err != nil {
+		return "", 0, false, fmt.Errorf("fail to decode X-address %s: %w", xAddress, err)
+	}
+	if len(payload) != xAddressPayloadLength {
+		return "", 0, false, fmt.Errorf("X-address is invalid: %s", xAddress)
+	}
+	prefix := []byte{version, payload[0]}
+	if !bytes.Equal(prefix, xAddressPrefixMainNet) && !bytes.Equal(prefix, xAddressPrefixTestNet) {
+		return "", 0, false, fmt.Errorf("prefix of X-address is invalid: %s", xAddress)
+	}
+
+	accountID := payload[1 : 1+accountIDLength]
+	flag := payload[1+accountIDLength]
+	tag := binary.LittleEndian.Uint32(payload[2+accountIDLength:])
+	reserved := binary.LittleEndian.Uint32(payload[6+accountIDLength:])
+	switch {
+	case flag > 1:
+		return "", 0, false, errors.New("64bit tag is not supported")
+	case reserved != 0 || (flag == 0 && tag != 0):
+		return "", 0, false, fmt.Errorf("tag of X-address is invalid: %s", xAddress)
+	}
+	return rbase58.CheckEncode(accountID, classicAddressVersion), tag, flag == 1, nil
+}
+
+// IsXAddress returns true if address is X-address
+func IsXAddress(addr string) bool {
+	_, _, _, err := DecodeXAddress(addr)
+	return err == nil
+}
@@ -0,0 +1,71 @@
+package xrp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple/xrp"
+)
+
+// TestEncodeXAddress is test for EncodeXAddress
+func TestEncodeXAddress(t *testing.T) {
+	type args struct {
+		addr      string
+		tag       uint32
+		isTestNet bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "mainnet tag 1",
+			args: args{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", 1, false},
+			want: "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGZMhc9YTE92ehJ2Fu",
+		},
+		{
+			name: "mainnet tag 14",
+			args: args{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", 14, false},
+			want: "X7AcgcsBL6XDcUb289X4mJ8djcdyKaGo2K5VpXpmCqbV2gS",
+		},
+		{
+			name: "testnet tag 1",
+			args: args{"r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", 1, true},
+			want: "T719a5UwUCnEs54UsxG9CJYYDhwmFCvbJNZbi37gBGkRkbE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			xAddress, err := xrp.EncodeXAddress(tt.args.addr, tt.args.tag, tt.args.isTestNet)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, xAddress)
+
+			// round trip
+			addr, tag, hasTag, err := xrp.DecodeXAddress(xAddress)
+			require.NoError(t, err)
+			assert.Equal(t, tt.args.addr, addr)
+			assert.Equal(t, tt.args.tag, tag)
+			assert.True(t, hasTag)
+		})
+	}
+
+	_, err := xrp.EncodeXAddress("0x931D387731bBbC988B312206c74F77D004D6B84b", 1, false)
+	assert.Error(t, err)
+}
+
+// TestDecodeXAddress is test for DecodeXAddress
+func TestDecodeXAddress(t *testing.T) {
+	addr, tag, hasTag, err := xrp.DecodeXAddress("X7AcgcsBL6XDcUb289X4mJ8djcdyKaB5hJDWMArnXr61cqZ")
+	require.NoError(t, err)
+	assert.Equal(t, "r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59", addr)
+	assert.Equal(t, uint32(0), tag)
+	assert.False(t, hasTag)
+
+	// classic address isn't X-address
+	assert.False(t, xrp.IsXAddress("r9cZA1mLK5R5Am25ArfXFmqgNwjZgnfk59"))
+	assert.True(t, xrp.IsXAddress("T719a5UwUCnEs54UsxG9CJYYDhwmFCvbJNZbi37gBGkRkbE"))
+}
@@ -0,0 +1,67 @@
+package solana
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/chaincfg"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/solana/sol"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+)
+
+// Solanaer Solana Interface
+type Solanaer interface {
+	SolanaRPCer
+
+	// balance
+	GetTokenBalance(ctx context.Context, owner string) (uint64, error)
+	GetAccountBalance(ctx context.Context, addr string) (uint64, error)
+	GetTotalBalance(ctx context.Context, addrs []string) (uint64, []sol.UserAmount)
+	// raw_transaction
+	CreateRawTransaction(
+		ctx context.Context, fromAddr, toAddr string, amount uint64, nonceIdx int,
+	) (*sol.RawTx, *models.SOLDetailTX, error)
+	SignRawTransaction(rawTx *sol.RawTx, wif string) (*sol.RawTx, error)
+	SendSignedTransaction(ctx context.Context, signedTx string) (string, error)
+	GetSignatureStatus(ctx context.Context, signature string) (*sol.SignatureStatus, error)
+	// solana
+	Close()
+	CoinTypeCode() domainCoin.CoinTypeCode
+	GetChainConf() *chaincfg.Params
+	TokenMint() string
+	// util
+	ValidateAddr(addr string) error
+	Decimals() uint8
+	FloatToAmount(v float64) uint64
+	AmountToFloat(v uint64) float64
+}
+
+// SolanaRPCer is JSON-RPC interface
+type SolanaRPCer interface {
+	GetBalance(ctx context.Context, addr string) (uint64, error)
+	GetAccountInfo(ctx context.Context, addr string) (*sol.AccountInfo, error)
+	GetAccountData(ctx context.Context, addr string) ([]byte, error)
+	GetLatestBlockhash(ctx context.Context) (*sol.LatestBlockhash, error)
+	GetMinimumBalanceForRentExemption(ctx context.Context, dataSize uint64) (uint64, error)
+	GetFeeForMessage(ctx context.Context, msg *sol.Message) (uint64, error)
+	SendTransaction(ctx context.Context, signedTx string) (string, error)
+	GetSignatureStatuses(ctx context.Context, signatures []string) ([]*sol.SignatureStatus, error)
+}
+
+// SolTxCreator is used in transaction creation contexts
+type SolTxCreator interface {
+	ValidateAddr(addr string) error
+	FloatToAmount(v float64) uint64
+	GetAccountBalance(ctx context.Context, addr string) (uint64, error)
+	CreateRawTransaction(
+		ctx context.Context, fromAddr, toAddr string, amount uint64, nonceIdx int,
+	) (*sol.RawTx, *models.SOLDetailTX, error)
+}
+
+// SolTxMonitor is used in transaction monitoring contexts
+type SolTxMonitor interface {
+	GetTotalBalance(ctx context.Context, addrs []string) (uint64, []sol.UserAmount)
+	GetSignatureStatuses(ctx context.Context, signatures []string) ([]*sol.SignatureStatus, error)
+	AmountToFloat(v uint64) float64
+}
@@ -0,0 +1,48 @@
+package solana
+
+import (
+	"errors"
+	"fmt"
+
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/solana/sol"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// NewRPCClient creates JSON-RPC client for Solana RPC node
+//   - JSON-RPC 2.0 client of go-ethereum is used because protocol is the same
+func NewRPCClient(conf *config.Solana) (*ethrpc.Client, error) {
+	if conf.RPCURL == "" {
+		return nil, errors.New("rpc_url for solana is not defined in config")
+	}
+	rpcClient, err := ethrpc.DialHTTP(conf.RPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call rpc.DialHTTP(): %w", err)
+	}
+	return rpcClient, nil
+}
+
+// NewSolana creates Solana instance according to coinType
+//   - rpcClient can be nil for keygen wallet which signs transaction offline
+func NewSolana(
+	rpcClient *ethrpc.Client, conf *config.Solana,
+	coinTypeCode domainCoin.CoinTypeCode, uuidHandler uuid.UUIDHandler,
+) (Solanaer, error) {
+	switch coinTypeCode {
+	case domainCoin.SOL:
+		solAPI, err := sol.NewSolana(rpcClient, coinTypeCode, conf, uuidHandler)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call sol.NewSolana(): %w", err)
+		}
+		return solAPI, nil
+	case domainCoin.BTC, domainCoin.BCH, domainCoin.LTC, domainCoin.DOGE, domainCoin.ETH, domainCoin.POL,
+		domainCoin.BNB, domainCoin.ARB, domainCoin.BASE, domainCoin.ERC20, domainCoin.HYT, domainCoin.XRP,
+		domainCoin.TRX:
+		return nil, fmt.Errorf("coinType %s is not defined", coinTypeCode.String())
+	default:
+		return nil, fmt.Errorf("coinType %s is not defined", coinTypeCode.String())
+	}
+}
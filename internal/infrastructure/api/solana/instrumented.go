@@ -0,0 +1,128 @@
+package solana
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/solana/sol"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// instrumentedSolanaer records latency and errors of methods calling solana RPC node,
+// other methods are called as is
+type instrumentedSolanaer struct {
+	Solanaer
+	coin string
+}
+
+// NewInstrumentedSolanaer wraps Solanaer to record RPC metrics per method
+func NewInstrumentedSolanaer(solClient Solanaer) Solanaer {
+	return &instrumentedSolanaer{
+		Solanaer: solClient,
+		coin:     solClient.CoinTypeCode().String(),
+	}
+}
+
+func (s *instrumentedSolanaer) start(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "solana."+method, attribute.String("coin", s.coin))
+}
+
+func (s *instrumentedSolanaer) observe(method string, span trace.Span, started time.Time, err *error) {
+	metrics.ObserveRPC(s.coin, method, started, *err)
+	tracer.End(span, err)
+}
+
+func (s *instrumentedSolanaer) GetBalance(ctx context.Context, addr string) (_ uint64, err error) {
+	ctx, span := s.start(ctx, "GetBalance")
+	defer s.observe("GetBalance", span, time.Now(), &err)
+	return s.Solanaer.GetBalance(ctx, addr)
+}
+
+func (s *instrumentedSolanaer) GetAccountInfo(
+	ctx context.Context, addr string,
+) (_ *sol.AccountInfo, err error) {
+	ctx, span := s.start(ctx, "GetAccountInfo")
+	defer s.observe("GetAccountInfo", span, time.Now(), &err)
+	return s.Solanaer.GetAccountInfo(ctx, addr)
+}
+
+func (s *instrumentedSolanaer) GetAccountData(ctx context.Context, addr string) (_ []byte, err error) {
+	ctx, span := s.start(ctx, "GetAccountData")
+	defer s.observe("GetAccountData", span, time.Now(), &err)
+	return s.Solanaer.GetAccountData(ctx, addr)
+}
+
+func (s *instrumentedSolanaer) GetLatestBlockhash(ctx context.Context) (_ *sol.LatestBlockhash, err error) {
+	ctx, span := s.start(ctx, "GetLatestBlockhash")
+	defer s.observe("GetLatestBlockhash", span, time.Now(), &err)
+	return s.Solanaer.GetLatestBlockhash(ctx)
+}
+
+func (s *instrumentedSolanaer) GetMinimumBalanceForRentExemption(
+	ctx context.Context, dataSize uint64,
+) (_ uint64, err error) {
+	ctx, span := s.start(ctx, "GetMinimumBalanceForRentExemption")
+	defer s.observe("GetMinimumBalanceForRentExemption", span, time.Now(), &err)
+	return s.Solanaer.GetMinimumBalanceForRentExemption(ctx, dataSize)
+}
+
+func (s *instrumentedSolanaer) GetFeeForMessage(ctx context.Context, msg *sol.Message) (_ uint64, err error) {
+	ctx, span := s.start(ctx, "GetFeeForMessage")
+	defer s.observe("GetFeeForMessage", span, time.Now(), &err)
+	return s.Solanaer.GetFeeForMessage(ctx, msg)
+}
+
+func (s *instrumentedSolanaer) SendTransaction(ctx context.Context, signedTx string) (_ string, err error) {
+	ctx, span := s.start(ctx, "SendTransaction")
+	defer s.observe("SendTransaction", span, time.Now(), &err)
+	return s.Solanaer.SendTransaction(ctx, signedTx)
+}
+
+func (s *instrumentedSolanaer) GetSignatureStatuses(
+	ctx context.Context, signatures []string,
+) (_ []*sol.SignatureStatus, err error) {
+	ctx, span := s.start(ctx, "GetSignatureStatuses")
+	defer s.observe("GetSignatureStatuses", span, time.Now(), &err)
+	return s.Solanaer.GetSignatureStatuses(ctx, signatures)
+}
+
+func (s *instrumentedSolanaer) GetTokenBalance(ctx context.Context, owner string) (_ uint64, err error) {
+	ctx, span := s.start(ctx, "GetTokenBalance")
+	defer s.observe("GetTokenBalance", span, time.Now(), &err)
+	return s.Solanaer.GetTokenBalance(ctx, owner)
+}
+
+func (s *instrumentedSolanaer) GetAccountBalance(ctx context.Context, addr string) (_ uint64, err error) {
+	ctx, span := s.start(ctx, "GetAccountBalance")
+	defer s.observe("GetAccountBalance", span, time.Now(), &err)
+	return s.Solanaer.GetAccountBalance(ctx, addr)
+}
+
+func (s *instrumentedSolanaer) CreateRawTransaction(
+	ctx context.Context, fromAddr, toAddr string, amount uint64, nonceIdx int,
+) (_ *sol.RawTx, _ *models.SOLDetailTX, err error) {
+	ctx, span := s.start(ctx, "CreateRawTransaction")
+	defer s.observe("CreateRawTransaction", span, time.Now(), &err)
+	return s.Solanaer.CreateRawTransaction(ctx, fromAddr, toAddr, amount, nonceIdx)
+}
+
+func (s *instrumentedSolanaer) SendSignedTransaction(
+	ctx context.Context, signedTx string,
+) (_ string, err error) {
+	ctx, span := s.start(ctx, "SendSignedTransaction")
+	defer s.observe("SendSignedTransaction", span, time.Now(), &err)
+	return s.Solanaer.SendSignedTransaction(ctx, signedTx)
+}
+
+func (s *instrumentedSolanaer) GetSignatureStatus(
+	ctx context.Context, signature string,
+) (_ *sol.SignatureStatus, err error) {
+	ctx, span := s.start(ctx, "GetSignatureStatus")
+	defer s.observe("GetSignatureStatus", span, time.Now(), &err)
+	return s.Solanaer.GetSignatureStatus(ctx, signature)
+}
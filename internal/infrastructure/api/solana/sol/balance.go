@@ -0,0 +1,68 @@
+package sol
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// tokenAccountAmountOffset is offset of amount(u64) in token account data,
+// layout: mint(32), owner(32), amount(8), ...
+const tokenAccountAmountOffset = 64
+
+// GetTokenBalance returns SPL token amount of owner's associated token account,
+// 0 is returned if token account doesn't exist
+func (s *Solana) GetTokenBalance(ctx context.Context, owner string) (uint64, error) {
+	ownerKey, err := PublicKeyFromBase58(owner)
+	if err != nil {
+		return 0, err
+	}
+	ata, err := FindAssociatedTokenAddress(ownerKey, s.tokenMint)
+	if err != nil {
+		return 0, err
+	}
+	data, err := s.GetAccountData(ctx, ata.String())
+	if err != nil {
+		return 0, fmt.Errorf("fail to call sol.GetAccountData(): %w", err)
+	}
+	if data == nil {
+		return 0, nil
+	}
+	if len(data) < tokenAccountAmountOffset+8 {
+		return 0, fmt.Errorf("invalid size of token account: %d", len(data))
+	}
+	return binary.LittleEndian.Uint64(data[tokenAccountAmountOffset:]), nil
+}
+
+// GetAccountBalance returns balance of sent coin,
+// lamports for SOL and base unit of token for SPL token
+func (s *Solana) GetAccountBalance(ctx context.Context, addr string) (uint64, error) {
+	if s.isToken {
+		return s.GetTokenBalance(ctx, addr)
+	}
+	return s.GetBalance(ctx, addr)
+}
+
+// GetTotalBalance returns total balance of addresses
+func (s *Solana) GetTotalBalance(ctx context.Context, addrs []string) (uint64, []UserAmount) {
+	var total uint64
+	userAmounts := make([]UserAmount, 0, len(addrs))
+	for _, addr := range addrs {
+		balance, err := s.GetAccountBalance(ctx, addr)
+		if err != nil {
+			logger.Warn("fail to call sol.GetAccountBalance()",
+				"address", addr,
+				"error", err,
+			)
+			continue
+		}
+		if balance == 0 {
+			continue
+		}
+		total += balance
+		userAmounts = append(userAmounts, UserAmount{Address: addr, Amount: balance})
+	}
+	return total, userAmounts
+}
@@ -0,0 +1,125 @@
+package sol
+
+import (
+	"encoding/binary"
+)
+
+// system program instruction index
+const (
+	systemInsCreateAccountWithSeed uint32 = 3
+	systemInsAdvanceNonceAccount   uint32 = 4
+	systemInsInitializeNonce       uint32 = 6
+	systemInsTransfer              uint32 = 2
+)
+
+// token program instruction index
+const tokenInsTransferChecked uint8 = 12
+
+// associated token account program instruction index
+const ataInsCreateIdempotent uint8 = 1
+
+// account data size
+const (
+	// NonceAccountSize is data size of nonce account
+	NonceAccountSize = 80
+	// TokenAccountSize is data size of SPL token account
+	TokenAccountSize = 165
+)
+
+// TransferInstruction transfers lamports by system program
+func TransferInstruction(from, to PublicKey, lamports uint64) Instruction {
+	data := binary.LittleEndian.AppendUint32(nil, systemInsTransfer)
+	data = binary.LittleEndian.AppendUint64(data, lamports)
+	return Instruction{
+		ProgramID: SystemProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: from, IsSigner: true, IsWritable: true},
+			{PublicKey: to, IsWritable: true},
+		},
+		Data: data,
+	}
+}
+
+// CreateAccountWithSeedInstruction creates account whose address is derived from base and seed
+func CreateAccountWithSeedInstruction(
+	from, newAccount, base PublicKey, seed string, lamports, space uint64, owner PublicKey,
+) Instruction {
+	data := binary.LittleEndian.AppendUint32(nil, systemInsCreateAccountWithSeed)
+	data = append(data, base[:]...)
+	data = binary.LittleEndian.AppendUint64(data, uint64(len(seed)))
+	data = append(data, seed...)
+	data = binary.LittleEndian.AppendUint64(data, lamports)
+	data = binary.LittleEndian.AppendUint64(data, space)
+	data = append(data, owner[:]...)
+
+	accounts := []AccountMeta{
+		{PublicKey: from, IsSigner: true, IsWritable: true},
+		{PublicKey: newAccount, IsWritable: true},
+	}
+	if base != from {
+		accounts = append(accounts, AccountMeta{PublicKey: base, IsSigner: true})
+	}
+	return Instruction{ProgramID: SystemProgramID, Accounts: accounts, Data: data}
+}
+
+// InitializeNonceAccountInstruction initializes nonce account with authority
+func InitializeNonceAccountInstruction(nonceAccount, authority PublicKey) Instruction {
+	data := binary.LittleEndian.AppendUint32(nil, systemInsInitializeNonce)
+	data = append(data, authority[:]...)
+	return Instruction{
+		ProgramID: SystemProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: nonceAccount, IsWritable: true},
+			{PublicKey: SysvarRecentBlockhashesID},
+			{PublicKey: SysvarRentID},
+		},
+		Data: data,
+	}
+}
+
+// AdvanceNonceAccountInstruction advances durable nonce,
+// it must be first instruction of transaction which uses durable nonce as blockhash
+func AdvanceNonceAccountInstruction(nonceAccount, authority PublicKey) Instruction {
+	return Instruction{
+		ProgramID: SystemProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: nonceAccount, IsWritable: true},
+			{PublicKey: SysvarRecentBlockhashesID},
+			{PublicKey: authority, IsSigner: true},
+		},
+		Data: binary.LittleEndian.AppendUint32(nil, systemInsAdvanceNonceAccount),
+	}
+}
+
+// CreateAssociatedTokenAccountIdempotentInstruction creates associated token account if it doesn't exist
+func CreateAssociatedTokenAccountIdempotentInstruction(payer, ata, wallet, mint PublicKey) Instruction {
+	return Instruction{
+		ProgramID: AssociatedTokenProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: payer, IsSigner: true, IsWritable: true},
+			{PublicKey: ata, IsWritable: true},
+			{PublicKey: wallet},
+			{PublicKey: mint},
+			{PublicKey: SystemProgramID},
+			{PublicKey: TokenProgramID},
+		},
+		Data: []byte{ataInsCreateIdempotent},
+	}
+}
+
+// TransferCheckedInstruction transfers SPL token between token accounts
+func TransferCheckedInstruction(source, mint, destination, owner PublicKey, amount uint64, decimals uint8) Instruction {
+	data := []byte{tokenInsTransferChecked}
+	data = binary.LittleEndian.AppendUint64(data, amount)
+	data = append(data, decimals)
+	return Instruction{
+		ProgramID: TokenProgramID,
+		Accounts: []AccountMeta{
+			{PublicKey: source, IsWritable: true},
+			{PublicKey: mint},
+			{PublicKey: destination, IsWritable: true},
+			{PublicKey: owner, IsSigner: true},
+		},
+		Data: data,
+	}
+}
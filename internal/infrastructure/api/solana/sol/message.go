@@ -0,0 +1,258 @@
+package sol
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// AccountMeta is account used by instruction
+type AccountMeta struct {
+	PublicKey  PublicKey
+	IsSigner   bool
+	IsWritable bool
+}
+
+// Instruction is instruction before accounts are compiled into message
+type Instruction struct {
+	ProgramID PublicKey
+	Accounts  []AccountMeta
+	Data      []byte
+}
+
+// MessageHeader is header of legacy message
+type MessageHeader struct {
+	NumRequiredSignatures       uint8
+	NumReadonlySignedAccounts   uint8
+	NumReadonlyUnsignedAccounts uint8
+}
+
+// CompiledInstruction refers accounts by index of account keys in message
+type CompiledInstruction struct {
+	ProgramIDIndex uint8
+	Accounts       []uint8
+	Data           []byte
+}
+
+// Message is legacy transaction message which is signed by signers
+type Message struct {
+	Header          MessageHeader
+	AccountKeys     []PublicKey
+	RecentBlockhash Hash
+	Instructions    []CompiledInstruction
+}
+
+// NewMessage compiles instructions into message
+//   - fee payer is always first account
+//   - accounts are ordered as writable signers, readonly signers, writable non-signers and readonly non-signers
+//   - when same account is used multiple times, the strongest privilege is kept
+func NewMessage(feePayer PublicKey, instructions []Instruction, recentBlockhash Hash) (*Message, error) {
+	metas := []AccountMeta{{PublicKey: feePayer, IsSigner: true, IsWritable: true}}
+	indexes := map[PublicKey]int{feePayer: 0}
+	addMeta := func(meta AccountMeta) {
+		if idx, ok := indexes[meta.PublicKey]; ok {
+			metas[idx].IsSigner = metas[idx].IsSigner || meta.IsSigner
+			metas[idx].IsWritable = metas[idx].IsWritable || meta.IsWritable
+			return
+		}
+		indexes[meta.PublicKey] = len(metas)
+		metas = append(metas, meta)
+	}
+	for _, ins := range instructions {
+		for _, meta := range ins.Accounts {
+			addMeta(meta)
+		}
+		addMeta(AccountMeta{PublicKey: ins.ProgramID})
+	}
+
+	// stable ordering by privilege, fee payer stays first
+	var ordered []AccountMeta
+	for _, group := range []struct{ signer, writable bool }{
+		{true, true}, {true, false}, {false, true}, {false, false},
+	} {
+		for _, meta := range metas {
+			if meta.IsSigner == group.signer && meta.IsWritable == group.writable {
+				ordered = append(ordered, meta)
+			}
+		}
+	}
+	if len(ordered) > 256 {
+		return nil, errors.New("too many accounts in message")
+	}
+
+	msg := &Message{RecentBlockhash: recentBlockhash}
+	keyIndex := make(map[PublicKey]uint8, len(ordered))
+	for i, meta := range ordered {
+		keyIndex[meta.PublicKey] = uint8(i)
+		msg.AccountKeys = append(msg.AccountKeys, meta.PublicKey)
+		switch {
+		case meta.IsSigner:
+			msg.Header.NumRequiredSignatures++
+			if !meta.IsWritable {
+				msg.Header.NumReadonlySignedAccounts++
+			}
+		case !meta.IsWritable:
+			msg.Header.NumReadonlyUnsignedAccounts++
+		}
+	}
+
+	for _, ins := range instructions {
+		compiled := CompiledInstruction{
+			ProgramIDIndex: keyIndex[ins.ProgramID],
+			Data:           ins.Data,
+		}
+		for _, meta := range ins.Accounts {
+			compiled.Accounts = append(compiled.Accounts, keyIndex[meta.PublicKey])
+		}
+		msg.Instructions = append(msg.Instructions, compiled)
+	}
+	return msg, nil
+}
+
+// Signers returns accounts which have to sign message
+func (m *Message) Signers() []PublicKey {
+	return m.AccountKeys[:m.Header.NumRequiredSignatures]
+}
+
+// Serialize returns wire format of message which is signed
+func (m *Message) Serialize() []byte {
+	var buf bytes.Buffer
+	buf.WriteByte(m.Header.NumRequiredSignatures)
+	buf.WriteByte(m.Header.NumReadonlySignedAccounts)
+	buf.WriteByte(m.Header.NumReadonlyUnsignedAccounts)
+
+	writeCompactU16(&buf, len(m.AccountKeys))
+	for _, key := range m.AccountKeys {
+		buf.Write(key[:])
+	}
+	buf.Write(m.RecentBlockhash[:])
+
+	writeCompactU16(&buf, len(m.Instructions))
+	for _, ins := range m.Instructions {
+		buf.WriteByte(ins.ProgramIDIndex)
+		writeCompactU16(&buf, len(ins.Accounts))
+		buf.Write(ins.Accounts)
+		writeCompactU16(&buf, len(ins.Data))
+		buf.Write(ins.Data)
+	}
+	return buf.Bytes()
+}
+
+// DeserializeMessage decodes wire format of legacy message
+func DeserializeMessage(data []byte) (*Message, error) {
+	r := bytes.NewReader(data)
+	msg, err := readMessage(r)
+	if err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%d bytes are left after message", r.Len())
+	}
+	return msg, nil
+}
+
+func readMessage(r *bytes.Reader) (*Message, error) {
+	msg := &Message{}
+	header := make([]byte, 3)
+	if _, err := readFull(r, header); err != nil {
+		return nil, fmt.Errorf("fail to read header: %w", err)
+	}
+	if header[0]&0x80 != 0 {
+		return nil, errors.New("versioned message is not supported")
+	}
+	msg.Header = MessageHeader{
+		NumRequiredSignatures:       header[0],
+		NumReadonlySignedAccounts:   header[1],
+		NumReadonlyUnsignedAccounts: header[2],
+	}
+
+	numKeys, err := readCompactU16(r)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read number of account keys: %w", err)
+	}
+	msg.AccountKeys = make([]PublicKey, numKeys)
+	for i := range msg.AccountKeys {
+		if _, err = readFull(r, msg.AccountKeys[i][:]); err != nil {
+			return nil, fmt.Errorf("fail to read account key: %w", err)
+		}
+	}
+	if int(msg.Header.NumRequiredSignatures) > numKeys {
+		return nil, errors.New("number of signatures exceeds number of accounts")
+	}
+	if _, err = readFull(r, msg.RecentBlockhash[:]); err != nil {
+		return nil, fmt.Errorf("fail to read recent blockhash: %w", err)
+	}
+
+	numIns, err := readCompactU16(r)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read number of instructions: %w", err)
+	}
+	msg.Instructions = make([]CompiledInstruction, numIns)
+	for i := range msg.Instructions {
+		ins := &msg.Instructions[i]
+		if ins.ProgramIDIndex, err = r.ReadByte(); err != nil {
+			return nil, fmt.Errorf("fail to read program id index: %w", err)
+		}
+		numAccounts, err := readCompactU16(r)
+		if err != nil {
+			return nil, fmt.Errorf("fail to read number of instruction accounts: %w", err)
+		}
+		ins.Accounts = make([]uint8, numAccounts)
+		if _, err = readFull(r, ins.Accounts); err != nil {
+			return nil, fmt.Errorf("fail to read instruction accounts: %w", err)
+		}
+		dataLen, err := readCompactU16(r)
+		if err != nil {
+			return nil, fmt.Errorf("fail to read length of instruction data: %w", err)
+		}
+		ins.Data = make([]byte, dataLen)
+		if _, err = readFull(r, ins.Data); err != nil {
+			return nil, fmt.Errorf("fail to read instruction data: %w", err)
+		}
+		if int(ins.ProgramIDIndex) >= numKeys {
+			return nil, errors.New("program id index is out of range")
+		}
+		for _, idx := range ins.Accounts {
+			if int(idx) >= numKeys {
+				return nil, errors.New("account index is out of range")
+			}
+		}
+	}
+	return msg, nil
+}
+
+// writeCompactU16 writes length as compact-u16 (7 bits per byte, little endian)
+func writeCompactU16(buf *bytes.Buffer, n int) {
+	for {
+		b := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 {
+			buf.WriteByte(b)
+			return
+		}
+		buf.WriteByte(b | 0x80)
+	}
+}
+
+// readCompactU16 reads compact-u16 length
+func readCompactU16(r *bytes.Reader) (int, error) {
+	var n int
+	for i := 0; i < 3; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		n |= int(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return n, nil
+		}
+	}
+	return 0, errors.New("compact-u16 is too long")
+}
+
+func readFull(r *bytes.Reader, buf []byte) (int, error) {
+	if r.Len() < len(buf) {
+		return 0, errors.New("unexpected end of data")
+	}
+	return r.Read(buf)
+}
@@ -0,0 +1,85 @@
+package sol_test
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/solana/sol"
+)
+
+func newKey(t *testing.T, seed string) (sol.PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+	sum := sha256.Sum256([]byte(seed))
+	privKey := ed25519.NewKeyFromSeed(sum[:])
+	var pubKey sol.PublicKey
+	copy(pubKey[:], privKey.Public().(ed25519.PublicKey))
+	return pubKey, privKey
+}
+
+// TestNewMessage tests account ordering, header and serialization round trip
+func TestNewMessage(t *testing.T) {
+	payer, payerKey := newKey(t, "payer")
+	receiver, _ := newKey(t, "receiver")
+	nonceAccount, _ := newKey(t, "nonce")
+	blockhash := sol.Hash(sha256.Sum256([]byte("blockhash")))
+
+	msg, err := sol.NewMessage(payer, []sol.Instruction{
+		sol.AdvanceNonceAccountInstruction(nonceAccount, payer),
+		sol.TransferInstruction(payer, receiver, 1000),
+	}, blockhash)
+	require.NoError(t, err)
+
+	// payer, nonce, receiver, sysvar, system program
+	assert.Equal(t, sol.MessageHeader{
+		NumRequiredSignatures:       1,
+		NumReadonlySignedAccounts:   0,
+		NumReadonlyUnsignedAccounts: 2,
+	}, msg.Header)
+	require.Len(t, msg.AccountKeys, 5)
+	assert.Equal(t, payer, msg.AccountKeys[0])
+	assert.ElementsMatch(t, []sol.PublicKey{nonceAccount, receiver}, msg.AccountKeys[1:3])
+	assert.ElementsMatch(t,
+		[]sol.PublicKey{sol.SysvarRecentBlockhashesID, sol.SystemProgramID}, msg.AccountKeys[3:])
+	assert.Equal(t, []sol.PublicKey{payer}, msg.Signers())
+
+	decoded, err := sol.DeserializeMessage(msg.Serialize())
+	require.NoError(t, err)
+	assert.Equal(t, msg, decoded)
+
+	// transaction round trip
+	tx := sol.NewTransaction(msg)
+	assert.False(t, tx.IsSigned())
+	restored, err := sol.TransactionFromBase64(tx.ToBase64())
+	require.NoError(t, err)
+	assert.Equal(t, tx, restored)
+
+	require.NoError(t, restored.Sign(payerKey))
+	assert.True(t, restored.IsSigned())
+	assert.Equal(t, restored.Signatures[0].String(), restored.ID())
+
+	_, otherKey := newKey(t, "other")
+	assert.Error(t, restored.Sign(otherKey))
+}
+
+// TestDeserializeMessage tests invalid data
+func TestDeserializeMessage(t *testing.T) {
+	payer, _ := newKey(t, "payer")
+	receiver, _ := newKey(t, "receiver")
+	msg, err := sol.NewMessage(payer, []sol.Instruction{sol.TransferInstruction(payer, receiver, 1)}, sol.Hash{})
+	require.NoError(t, err)
+	data := msg.Serialize()
+
+	_, err = sol.DeserializeMessage(data[:len(data)-1])
+	assert.Error(t, err)
+	_, err = sol.DeserializeMessage(append(data, 0))
+	assert.Error(t, err)
+
+	// versioned message
+	versioned := append([]byte{0x80}, data...)
+	_, err = sol.DeserializeMessage(versioned)
+	assert.Error(t, err)
+}
@@ -0,0 +1,45 @@
+package sol
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// nonce account state
+const nonceStateInitialized uint32 = 1
+
+// NonceAccount is state of durable nonce account
+//   - data layout: version(u32), state(u32), authority(32), nonce(32), lamportsPerSignature(u64)
+type NonceAccount struct {
+	Authority            PublicKey
+	Nonce                Hash
+	LamportsPerSignature uint64
+}
+
+// ParseNonceAccount decodes data of initialized nonce account
+func ParseNonceAccount(data []byte) (*NonceAccount, error) {
+	if len(data) != NonceAccountSize {
+		return nil, fmt.Errorf("invalid size of nonce account: %d", len(data))
+	}
+	if state := binary.LittleEndian.Uint32(data[4:8]); state != nonceStateInitialized {
+		return nil, errors.New("nonce account is not initialized")
+	}
+	nonce := &NonceAccount{
+		LamportsPerSignature: binary.LittleEndian.Uint64(data[72:80]),
+	}
+	copy(nonce.Authority[:], data[8:40])
+	copy(nonce.Nonce[:], data[40:72])
+	return nonce, nil
+}
+
+// NonceSeed returns seed of nonce account,
+// each transaction in same file uses its own nonce account so that they can be sent in any order
+func NonceSeed(idx int) string {
+	return fmt.Sprintf("nonce%d", idx)
+}
+
+// NonceAccountAddress returns address of nonce account derived from authority
+func NonceAccountAddress(authority PublicKey, idx int) (PublicKey, error) {
+	return CreateWithSeed(authority, NonceSeed(idx), SystemProgramID)
+}
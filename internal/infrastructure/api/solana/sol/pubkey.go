@@ -0,0 +1,142 @@
+package sol
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"filippo.io/edwards25519"
+	"github.com/btcsuite/btcd/btcutil/base58"
+)
+
+const (
+	// PublicKeySize is byte length of public key (address)
+	PublicKeySize = 32
+	// maxSeedLength is max byte length of a seed for program derived address
+	maxSeedLength = 32
+	// pdaMarker is appended to seeds when program derived address is hashed
+	pdaMarker = "ProgramDerivedAddress"
+)
+
+// well known program and sysvar addresses
+var (
+	SystemProgramID           = MustPublicKey("11111111111111111111111111111111")
+	TokenProgramID            = MustPublicKey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	AssociatedTokenProgramID  = MustPublicKey("ATokenGPvbdGVxr1b2hvZbsiqW5xWH25efTNsLJA8knL")
+	SysvarRecentBlockhashesID = MustPublicKey("SysvarRecentB1ockHashes11111111111111111111")
+	SysvarRentID              = MustPublicKey("SysvarRent111111111111111111111111111111111")
+)
+
+// PublicKey is ed25519 public key which is used as address of account
+type PublicKey [PublicKeySize]byte
+
+// PublicKeyFromBase58 decodes base58 address
+func PublicKeyFromBase58(addr string) (PublicKey, error) {
+	var pubKey PublicKey
+	decoded := base58.Decode(addr)
+	if len(decoded) != PublicKeySize {
+		return pubKey, fmt.Errorf("invalid address: %s", addr)
+	}
+	copy(pubKey[:], decoded)
+	return pubKey, nil
+}
+
+// MustPublicKey decodes base58 address, it panics when address is invalid
+func MustPublicKey(addr string) PublicKey {
+	pubKey, err := PublicKeyFromBase58(addr)
+	if err != nil {
+		panic(err)
+	}
+	return pubKey
+}
+
+// String returns base58 address
+func (p PublicKey) String() string {
+	return base58.Encode(p[:])
+}
+
+// IsOnCurve returns true if public key is valid point of ed25519 curve,
+// program derived address must be off curve so that nobody has private key of it
+func (p PublicKey) IsOnCurve() bool {
+	_, err := new(edwards25519.Point).SetBytes(p[:])
+	return err == nil
+}
+
+// Hash is blockhash, durable nonce is also stored as this type
+type Hash [32]byte
+
+// HashFromBase58 decodes base58 blockhash
+func HashFromBase58(str string) (Hash, error) {
+	var hash Hash
+	decoded := base58.Decode(str)
+	if len(decoded) != len(hash) {
+		return hash, fmt.Errorf("invalid hash: %s", str)
+	}
+	copy(hash[:], decoded)
+	return hash, nil
+}
+
+// String returns base58 blockhash
+func (h Hash) String() string {
+	return base58.Encode(h[:])
+}
+
+// CreateWithSeed returns address derived from base address, seed and owner program
+// same as system program `create_account_with_seed`
+func CreateWithSeed(base PublicKey, seed string, owner PublicKey) (PublicKey, error) {
+	if len(seed) > maxSeedLength {
+		return PublicKey{}, fmt.Errorf("seed is too long: %s", seed)
+	}
+	h := sha256.New()
+	h.Write(base[:])
+	h.Write([]byte(seed))
+	h.Write(owner[:])
+
+	var pubKey PublicKey
+	copy(pubKey[:], h.Sum(nil))
+	return pubKey, nil
+}
+
+// CreateProgramAddress returns program derived address, it fails when address is on curve
+func CreateProgramAddress(seeds [][]byte, programID PublicKey) (PublicKey, error) {
+	h := sha256.New()
+	for _, seed := range seeds {
+		if len(seed) > maxSeedLength {
+			return PublicKey{}, errors.New("seed is too long")
+		}
+		h.Write(seed)
+	}
+	h.Write(programID[:])
+	h.Write([]byte(pdaMarker))
+
+	var pubKey PublicKey
+	copy(pubKey[:], h.Sum(nil))
+	if pubKey.IsOnCurve() {
+		return PublicKey{}, errors.New("program derived address is on curve")
+	}
+	return pubKey, nil
+}
+
+// FindProgramAddress returns valid program derived address with bump seed searched from 255
+func FindProgramAddress(seeds [][]byte, programID PublicKey) (PublicKey, uint8, error) {
+	for bump := 255; bump >= 0; bump-- {
+		bumpSeeds := append(append([][]byte{}, seeds...), []byte{uint8(bump)})
+		pubKey, err := CreateProgramAddress(bumpSeeds, programID)
+		if err == nil {
+			return pubKey, uint8(bump), nil
+		}
+	}
+	return PublicKey{}, 0, errors.New("unable to find valid program derived address")
+}
+
+// FindAssociatedTokenAddress returns associated token account of wallet for mint
+func FindAssociatedTokenAddress(wallet, mint PublicKey) (PublicKey, error) {
+	ata, _, err := FindProgramAddress(
+		[][]byte{wallet[:], TokenProgramID[:], mint[:]},
+		AssociatedTokenProgramID,
+	)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("fail to call FindProgramAddress(): %w", err)
+	}
+	return ata, nil
+}
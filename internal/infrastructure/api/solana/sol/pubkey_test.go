@@ -0,0 +1,88 @@
+package sol_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/solana/sol"
+)
+
+// TestPublicKey tests base58 encoding
+func TestPublicKey(t *testing.T) {
+	assert.Equal(t, "11111111111111111111111111111111", sol.SystemProgramID.String())
+	assert.Equal(t, sol.PublicKey{}, sol.SystemProgramID)
+
+	_, err := sol.PublicKeyFromBase58("0xabc")
+	assert.Error(t, err)
+	_, err = sol.PublicKeyFromBase58("rPEPPER7kfTD9w2To4CQk6UCfuHM9c6GDY")
+	assert.Error(t, err)
+
+	payer, _ := newKey(t, "payer")
+	assert.True(t, payer.IsOnCurve())
+	decoded, err := sol.PublicKeyFromBase58(payer.String())
+	require.NoError(t, err)
+	assert.Equal(t, payer, decoded)
+}
+
+// TestFindAssociatedTokenAddress tests derived address is off curve and bump seed is searched from 255
+func TestFindAssociatedTokenAddress(t *testing.T) {
+	wallet, _ := newKey(t, "wallet")
+	mint, _ := newKey(t, "mint")
+
+	ata, err := sol.FindAssociatedTokenAddress(wallet, mint)
+	require.NoError(t, err)
+	assert.False(t, ata.IsOnCurve())
+
+	pda, bump, err := sol.FindProgramAddress(
+		[][]byte{wallet[:], sol.TokenProgramID[:], mint[:]}, sol.AssociatedTokenProgramID)
+	require.NoError(t, err)
+	assert.Equal(t, ata, pda)
+	for b := 255; b > int(bump); b-- {
+		_, err = sol.CreateProgramAddress(
+			[][]byte{wallet[:], sol.TokenProgramID[:], mint[:], {uint8(b)}}, sol.AssociatedTokenProgramID)
+		assert.Error(t, err)
+	}
+
+	// different owner has different token account
+	other, _ := newKey(t, "other")
+	otherATA, err := sol.FindAssociatedTokenAddress(other, mint)
+	require.NoError(t, err)
+	assert.NotEqual(t, ata, otherATA)
+}
+
+// TestParseNonceAccount tests nonce account data
+func TestParseNonceAccount(t *testing.T) {
+	authority, _ := newKey(t, "authority")
+	nonce, _ := newKey(t, "nonce")
+
+	data := make([]byte, sol.NonceAccountSize)
+	binary.LittleEndian.PutUint32(data[0:], 1)
+	binary.LittleEndian.PutUint32(data[4:], 1)
+	copy(data[8:], authority[:])
+	copy(data[40:], nonce[:])
+	binary.LittleEndian.PutUint64(data[72:], 5000)
+
+	parsed, err := sol.ParseNonceAccount(data)
+	require.NoError(t, err)
+	assert.Equal(t, authority, parsed.Authority)
+	assert.Equal(t, nonce[:], parsed.Nonce[:])
+	assert.Equal(t, uint64(5000), parsed.LamportsPerSignature)
+
+	// uninitialized
+	binary.LittleEndian.PutUint32(data[4:], 0)
+	_, err = sol.ParseNonceAccount(data)
+	assert.Error(t, err)
+
+	_, err = sol.ParseNonceAccount(data[:10])
+	assert.Error(t, err)
+
+	// each index has own nonce account
+	addr0, err := sol.NonceAccountAddress(authority, 0)
+	require.NoError(t, err)
+	addr1, err := sol.NonceAccountAddress(authority, 1)
+	require.NoError(t, err)
+	assert.NotEqual(t, addr0, addr1)
+}
@@ -0,0 +1,345 @@
+package sol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// RawTx is raw transaction passed between watch wallet and keygen wallet
+type RawTx struct {
+	UUID         string `json:"uuid"`
+	From         string `json:"from"`
+	To           string `json:"to"`
+	Amount       uint64 `json:"amount"`
+	Fee          uint64 `json:"fee"`
+	TokenMint    string `json:"token_mint"`
+	NonceAccount string `json:"nonce_account"`
+	Nonce        string `json:"nonce"`
+	TxBase64     string `json:"tx_base64"`
+	Signature    string `json:"signature"`
+}
+
+// blockhashSource is blockhash of transaction and instructions to put before transfer
+type blockhashSource struct {
+	nonceAccount PublicKey
+	blockhash    Hash
+	instructions []Instruction
+	// rent for nonce account when it is created by this transaction
+	rent uint64
+}
+
+// prepareNonce returns durable nonce of nonce account,
+// if nonce account doesn't exist yet, it is created by this transaction with recent blockhash
+//   - such transaction expires in about 1 minute, so it must be signed and sent soon
+func (s *Solana) prepareNonce(ctx context.Context, sender PublicKey, nonceIdx int) (*blockhashSource, error) {
+	nonceAddr, err := NonceAccountAddress(sender, nonceIdx)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call NonceAccountAddress(): %w", err)
+	}
+	data, err := s.GetAccountData(ctx, nonceAddr.String())
+	if err != nil {
+		return nil, fmt.Errorf("fail to call sol.GetAccountData(nonce): %w", err)
+	}
+
+	if data != nil {
+		nonce, err := ParseNonceAccount(data)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call ParseNonceAccount(%s): %w", nonceAddr, err)
+		}
+		if nonce.Authority != sender {
+			return nil, fmt.Errorf("authority of nonce account %s is not sender", nonceAddr)
+		}
+		return &blockhashSource{
+			nonceAccount: nonceAddr,
+			blockhash:    nonce.Nonce,
+			instructions: []Instruction{AdvanceNonceAccountInstruction(nonceAddr, sender)},
+		}, nil
+	}
+
+	// bootstrap nonce account
+	rent, err := s.GetMinimumBalanceForRentExemption(ctx, NonceAccountSize)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := s.GetLatestBlockhash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	blockhash, err := HashFromBase58(latest.Blockhash)
+	if err != nil {
+		return nil, err
+	}
+	logger.Warn("nonce account doesn't exist, it is created with recent blockhash which expires soon",
+		"nonce_account", nonceAddr.String(),
+		"last_valid_block_height", latest.LastValidBlockHeight,
+	)
+	return &blockhashSource{
+		nonceAccount: nonceAddr,
+		blockhash:    blockhash,
+		instructions: []Instruction{
+			CreateAccountWithSeedInstruction(
+				sender, nonceAddr, sender, NonceSeed(nonceIdx), rent, NonceAccountSize, SystemProgramID),
+			InitializeNonceAccountInstruction(nonceAddr, sender),
+		},
+		rent: rent,
+	}, nil
+}
+
+// calculateFee returns fee of transaction which includes given instructions
+//   - durable nonce is not accepted by getFeeForMessage, so fee is queried with latest blockhash
+func (s *Solana) calculateFee(ctx context.Context, feePayer PublicKey, instructions []Instruction) (uint64, error) {
+	latest, err := s.GetLatestBlockhash(ctx)
+	if err != nil {
+		return 0, err
+	}
+	blockhash, err := HashFromBase58(latest.Blockhash)
+	if err != nil {
+		return 0, err
+	}
+	msg, err := NewMessage(feePayer, instructions, blockhash)
+	if err != nil {
+		return 0, fmt.Errorf("fail to call NewMessage(): %w", err)
+	}
+	return s.GetFeeForMessage(ctx, msg)
+}
+
+// transferInstructions returns instructions to send amount with fee,
+// amount 0 means sender sends all balance (receiver pays fee for SOL)
+func (s *Solana) transferInstructions(
+	ctx context.Context, from, to PublicKey, amount uint64, source *blockhashSource,
+) ([]Instruction, uint64, uint64, error) {
+	if s.isToken {
+		return s.tokenTransferInstructions(ctx, from, to, amount, source)
+	}
+
+	balance, err := s.GetBalance(ctx, from.String())
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("fail to call sol.GetBalance(): %w", err)
+	}
+	logger.Info("balance", "balance", balance)
+	if balance == 0 {
+		return nil, 0, 0, errors.New("balance is needed to send sol")
+	}
+
+	// fee doesn't depend on amount
+	instructions := append(append([]Instruction{}, source.instructions...), TransferInstruction(from, to, amount))
+	fee, err := s.calculateFee(ctx, from, instructions)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("fail to call sol.calculateFee(): %w", err)
+	}
+
+	cost := fee + source.rent
+	if amount == 0 {
+		// receiver pays fee (deposit, transfer(pays all) action)
+		if balance <= cost {
+			return nil, 0, 0, fmt.Errorf("balance`%d` is insufficient to pay fee `%d`", balance, cost)
+		}
+		amount = balance - cost
+	} else if balance < amount+cost {
+		// sender pays fee (payment, transfer(pays partially))
+		return nil, 0, 0, fmt.Errorf("balance`%d` is insufficient to send `%d`", balance, amount)
+	}
+	instructions[len(instructions)-1] = TransferInstruction(from, to, amount)
+	return instructions, amount, fee, nil
+}
+
+// tokenTransferInstructions returns instructions to send SPL token between associated token accounts,
+// fee and rent of token account of receiver are always paid by sender in SOL
+func (s *Solana) tokenTransferInstructions(
+	ctx context.Context, from, to PublicKey, amount uint64, source *blockhashSource,
+) ([]Instruction, uint64, uint64, error) {
+	tokenBalance, err := s.GetTokenBalance(ctx, from.String())
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("fail to call sol.GetTokenBalance(): %w", err)
+	}
+	logger.Info("token balance", "balance", tokenBalance, "mint", s.tokenMint.String())
+	if tokenBalance == 0 {
+		return nil, 0, 0, errors.New("token balance is needed to send token")
+	}
+	if amount == 0 {
+		amount = tokenBalance
+	} else if tokenBalance < amount {
+		return nil, 0, 0, fmt.Errorf("token balance`%d` is insufficient to send `%d`", tokenBalance, amount)
+	}
+
+	sourceATA, err := FindAssociatedTokenAddress(from, s.tokenMint)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	destATA, err := FindAssociatedTokenAddress(to, s.tokenMint)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	instructions := append([]Instruction{}, source.instructions...)
+	cost := source.rent
+	destInfo, err := s.GetAccountInfo(ctx, destATA.String())
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("fail to call sol.GetAccountInfo(destination): %w", err)
+	}
+	if destInfo == nil {
+		rent, err := s.GetMinimumBalanceForRentExemption(ctx, TokenAccountSize)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		cost += rent
+		instructions = append(instructions,
+			CreateAssociatedTokenAccountIdempotentInstruction(from, destATA, to, s.tokenMint))
+	}
+	instructions = append(instructions,
+		TransferCheckedInstruction(sourceATA, s.tokenMint, destATA, from, amount, s.tokenDecimals))
+
+	fee, err := s.calculateFee(ctx, from, instructions)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("fail to call sol.calculateFee(): %w", err)
+	}
+	balance, err := s.GetBalance(ctx, from.String())
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("fail to call sol.GetBalance(): %w", err)
+	}
+	if balance < fee+cost {
+		return nil, 0, 0, fmt.Errorf("balance`%d` is insufficient to pay fee `%d`", balance, fee+cost)
+	}
+	return instructions, amount, fee, nil
+}
+
+// CreateRawTransaction creates unsigned transaction for watch only wallet
+//   - durable nonce is used as blockhash so that transaction doesn't expire until it is signed offline
+//   - nonceIdx is index of transaction from same sender in a file, each index has its own nonce account
+//   - amount 0 means all balance of sender is sent
+func (s *Solana) CreateRawTransaction(
+	ctx context.Context, fromAddr, toAddr string, amount uint64, nonceIdx int,
+) (*RawTx, *models.SOLDetailTX, error) {
+	from, err := PublicKeyFromBase58(fromAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("address validation error: %w", err)
+	}
+	to, err := PublicKeyFromBase58(toAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("address validation error: %w", err)
+	}
+	logger.Debug("sol.CreateRawTransaction()",
+		"fromAddr", fromAddr,
+		"toAddr", toAddr,
+		"amount", amount,
+		"nonceIdx", nonceIdx,
+	)
+
+	source, err := s.prepareNonce(ctx, from, nonceIdx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call sol.prepareNonce(): %w", err)
+	}
+	instructions, newAmount, fee, err := s.transferInstructions(ctx, from, to, amount, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	msg, err := NewMessage(from, instructions, source.blockhash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call NewMessage(): %w", err)
+	}
+	unsignedTx := NewTransaction(msg).ToBase64()
+
+	// generate UUID to trace transaction because unsignedTx is not unique
+	uid, err := s.uuidHandler.GenerateV7()
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call uuidHandler.GenerateV7(): %w", err)
+	}
+
+	// create insert data for sol_detail_tx
+	txDetailItem := &models.SOLDetailTX{
+		UUID:            uid.String(),
+		SenderAccount:   "",
+		SenderAddress:   fromAddr,
+		ReceiverAccount: "",
+		ReceiverAddress: toAddr,
+		Amount:          newAmount,
+		Fee:             fee,
+		TokenMint:       s.TokenMint(),
+		NonceAccount:    source.nonceAccount.String(),
+		Nonce:           source.blockhash.String(),
+		UnsignedTX:      unsignedTx,
+	}
+
+	rawTx := &RawTx{
+		UUID:         uid.String(),
+		From:         fromAddr,
+		To:           toAddr,
+		Amount:       newAmount,
+		Fee:          fee,
+		TokenMint:    s.TokenMint(),
+		NonceAccount: source.nonceAccount.String(),
+		Nonce:        source.blockhash.String(),
+		TxBase64:     unsignedTx,
+	}
+	return rawTx, txDetailItem, nil
+}
+
+// SignRawTransaction signs raw transaction by base58 encoded secret key, it works offline
+func SignRawTransaction(rawTx *RawTx, wif string) (*RawTx, error) {
+	privKey, err := PrivateKeyFromBase58(wif)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call PrivateKeyFromBase58(): %w", err)
+	}
+	tx, err := TransactionFromBase64(rawTx.TxBase64)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call TransactionFromBase64(): %w", err)
+	}
+	if signers := tx.Message.Signers(); len(signers) == 0 || signers[0].String() != rawTx.From {
+		return nil, fmt.Errorf("fee payer of transaction is not %s", rawTx.From)
+	}
+	if err = tx.Sign(privKey); err != nil {
+		return nil, fmt.Errorf("fail to call tx.Sign(): %w", err)
+	}
+	if !tx.IsSigned() {
+		return nil, errors.New("transaction requires other signatures")
+	}
+
+	signedTx := *rawTx
+	signedTx.TxBase64 = tx.ToBase64()
+	signedTx.Signature = tx.ID()
+	return &signedTx, nil
+}
+
+// SignRawTransaction signs raw transaction
+func (*Solana) SignRawTransaction(rawTx *RawTx, wif string) (*RawTx, error) {
+	return SignRawTransaction(rawTx, wif)
+}
+
+// SendSignedTransaction sends signed transaction and returns signature
+func (s *Solana) SendSignedTransaction(ctx context.Context, signedTx string) (string, error) {
+	tx, err := TransactionFromBase64(signedTx)
+	if err != nil {
+		return "", fmt.Errorf("fail to call TransactionFromBase64(): %w", err)
+	}
+	if !tx.IsSigned() {
+		return "", errors.New("transaction is not signed")
+	}
+	signature, err := s.SendTransaction(ctx, signedTx)
+	if err != nil {
+		return "", fmt.Errorf("fail to call sol.SendTransaction(): %w", err)
+	}
+	if signature != tx.ID() {
+		logger.Warn("returned signature is different from signature of transaction",
+			"returned", signature,
+			"expected", tx.ID(),
+		)
+	}
+	return signature, nil
+}
+
+// GetSignatureStatus returns status of sent transaction, nil is returned if it is not found yet
+func (s *Solana) GetSignatureStatus(ctx context.Context, signature string) (*SignatureStatus, error) {
+	statuses, err := s.GetSignatureStatuses(ctx, []string{signature})
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+	return statuses[0], nil
+}
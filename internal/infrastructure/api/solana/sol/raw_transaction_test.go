@@ -0,0 +1,312 @@
+package sol_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/solana/sol"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+const (
+	testFee         = 5000
+	testNonceRent   = 1447680
+	testAccountRent = 2039280
+)
+
+// fakeNode is JSON-RPC stand-in of solana node
+type fakeNode struct {
+	mu        sync.Mutex
+	balances  map[string]uint64
+	accounts  map[string][]byte
+	blockhash sol.Hash
+	sent      []string
+}
+
+func newFakeNode(t *testing.T) (*fakeNode, *httptest.Server) {
+	t.Helper()
+	node := &fakeNode{
+		balances:  map[string]uint64{},
+		accounts:  map[string][]byte{},
+		blockhash: sol.Hash{1, 2, 3},
+	}
+	server := httptest.NewServer(http.HandlerFunc(node.serve))
+	t.Cleanup(server.Close)
+	return node, server
+}
+
+func (n *fakeNode) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	var first string
+	if len(req.Params) != 0 {
+		_ = json.Unmarshal(req.Params[0], &first)
+	}
+	ctx := map[string]any{"slot": 100}
+	var result any
+	switch req.Method {
+	case "getBalance":
+		result = map[string]any{"context": ctx, "value": n.balances[first]}
+	case "getAccountInfo":
+		data, ok := n.accounts[first]
+		if !ok {
+			result = map[string]any{"context": ctx, "value": nil}
+			break
+		}
+		result = map[string]any{"context": ctx, "value": map[string]any{
+			"data":     []string{base64.StdEncoding.EncodeToString(data), "base64"},
+			"lamports": 1, "owner": sol.SystemProgramID.String(), "space": len(data),
+		}}
+	case "getLatestBlockhash":
+		result = map[string]any{"context": ctx, "value": map[string]any{
+			"blockhash": n.blockhash.String(), "lastValidBlockHeight": 200,
+		}}
+	case "getMinimumBalanceForRentExemption":
+		var size uint64
+		_ = json.Unmarshal(req.Params[0], &size)
+		if size == sol.NonceAccountSize {
+			result = testNonceRent
+		} else {
+			result = testAccountRent
+		}
+	case "getFeeForMessage":
+		result = map[string]any{"context": ctx, "value": testFee}
+	case "sendTransaction":
+		n.sent = append(n.sent, first)
+		tx, _ := sol.TransactionFromBase64(first)
+		result = tx.ID()
+	case "getSignatureStatuses":
+		result = map[string]any{"context": ctx, "value": []any{
+			map[string]any{"slot": 99, "confirmations": nil, "err": nil, "confirmationStatus": "finalized"},
+		}}
+	default:
+		result = nil
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+func (n *fakeNode) putNonceAccount(authority sol.PublicKey, idx int, nonce sol.Hash) sol.PublicKey {
+	addr, _ := sol.NonceAccountAddress(authority, idx)
+	data := make([]byte, sol.NonceAccountSize)
+	binary.LittleEndian.PutUint32(data[4:], 1)
+	copy(data[8:], authority[:])
+	copy(data[40:], nonce[:])
+	n.accounts[addr.String()] = data
+	return addr
+}
+
+func (n *fakeNode) putTokenAccount(owner, mint sol.PublicKey, amount uint64) {
+	ata, _ := sol.FindAssociatedTokenAddress(owner, mint)
+	data := make([]byte, sol.TokenAccountSize)
+	copy(data[0:], mint[:])
+	copy(data[32:], owner[:])
+	binary.LittleEndian.PutUint64(data[64:], amount)
+	n.accounts[ata.String()] = data
+}
+
+func newSolana(t *testing.T, url string, conf *config.Solana) *sol.Solana {
+	t.Helper()
+	rpcClient, err := ethrpc.DialHTTP(url)
+	require.NoError(t, err)
+	t.Cleanup(rpcClient.Close)
+	solAPI, err := sol.NewSolana(rpcClient, domainCoin.SOL, conf, uuid.NewGoogleUUIDHandler())
+	require.NoError(t, err)
+	return solAPI
+}
+
+// programIDs returns program of each instruction
+func programIDs(tx *sol.Transaction) []sol.PublicKey {
+	ids := make([]sol.PublicKey, 0, len(tx.Message.Instructions))
+	for _, ins := range tx.Message.Instructions {
+		ids = append(ids, tx.Message.AccountKeys[ins.ProgramIDIndex])
+	}
+	return ids
+}
+
+// TestCreateRawTransaction tests SOL transfer with durable nonce, signing and sending
+func TestCreateRawTransaction(t *testing.T) {
+	logger.SetGlobal(logger.NewNoopLogger())
+	ctx := context.Background()
+	node, server := newFakeNode(t)
+	solAPI := newSolana(t, server.URL, &config.Solana{NetworkType: "devnet"})
+
+	sender, senderKey := newKey(t, "sender")
+	receiver, _ := newKey(t, "receiver")
+	node.balances[sender.String()] = 1_000_000_000
+	nonce := sol.Hash{9, 9, 9}
+	nonceAddr := node.putNonceAccount(sender, 0, nonce)
+
+	type args struct {
+		amount   uint64
+		nonceIdx int
+	}
+	type want struct {
+		amount       uint64
+		instructions int
+		isDurable    bool
+		isErr        bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "payment with durable nonce",
+			args: args{amount: 100_000_000, nonceIdx: 0},
+			want: want{amount: 100_000_000, instructions: 2, isDurable: true},
+		},
+		{
+			name: "deposit sends all balance",
+			args: args{amount: 0, nonceIdx: 0},
+			want: want{amount: 1_000_000_000 - testFee, instructions: 2, isDurable: true},
+		},
+		{
+			name: "nonce account is created when it doesn't exist",
+			args: args{amount: 0, nonceIdx: 1},
+			want: want{amount: 1_000_000_000 - testFee - testNonceRent, instructions: 3},
+		},
+		{
+			name: "insufficient balance",
+			args: args{amount: 1_000_000_000, nonceIdx: 0},
+			want: want{isErr: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rawTx, detail, err := solAPI.CreateRawTransaction(
+				ctx, sender.String(), receiver.String(), tt.args.amount, tt.args.nonceIdx)
+			if tt.want.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.amount, rawTx.Amount)
+			assert.Equal(t, uint64(testFee), detail.Fee)
+			assert.Equal(t, rawTx.UUID, detail.UUID)
+			assert.Equal(t, rawTx.TxBase64, detail.UnsignedTX)
+			assert.Empty(t, detail.TokenMint)
+
+			tx, err := sol.TransactionFromBase64(rawTx.TxBase64)
+			require.NoError(t, err)
+			require.Len(t, tx.Message.Instructions, tt.want.instructions)
+			if tt.want.isDurable {
+				assert.Equal(t, nonce, tx.Message.RecentBlockhash)
+				assert.Equal(t, nonceAddr.String(), detail.NonceAccount)
+				// advance nonce must be first instruction
+				first := tx.Message.Instructions[0]
+				assert.Equal(t, []byte{4, 0, 0, 0}, first.Data)
+			} else {
+				assert.Equal(t, node.blockhash, tx.Message.RecentBlockhash)
+			}
+
+			// sign offline
+			_, err = sol.SignRawTransaction(rawTx, base58.Encode(make([]byte, 64)))
+			assert.Error(t, err)
+			signedTx, err := sol.SignRawTransaction(rawTx, base58.Encode(senderKey))
+			require.NoError(t, err)
+			assert.NotEmpty(t, signedTx.Signature)
+
+			// send
+			_, err = solAPI.SendSignedTransaction(ctx, rawTx.TxBase64)
+			assert.Error(t, err, "unsigned transaction can't be sent")
+			signature, err := solAPI.SendSignedTransaction(ctx, signedTx.TxBase64)
+			require.NoError(t, err)
+			assert.Equal(t, signedTx.Signature, signature)
+
+			status, err := solAPI.GetSignatureStatus(ctx, signature)
+			require.NoError(t, err)
+			assert.Equal(t, sol.CommitmentFinalized, status.ConfirmationStatus)
+			assert.False(t, status.IsFailed())
+		})
+	}
+}
+
+// TestCreateRawTransactionSPLToken tests SPL token transfer between associated token accounts
+func TestCreateRawTransactionSPLToken(t *testing.T) {
+	logger.SetGlobal(logger.NewNoopLogger())
+	ctx := context.Background()
+	node, server := newFakeNode(t)
+
+	mint, _ := newKey(t, "mint")
+	solAPI := newSolana(t, server.URL, &config.Solana{
+		NetworkType: "devnet",
+		SPLToken:    "usdc",
+		SPLTokens: map[domainCoin.SPLToken]config.SPLToken{
+			"usdc": {Symbol: "usdc", MintAddress: mint.String(), Decimals: 6},
+		},
+	})
+	assert.Equal(t, uint64(1_500_000), solAPI.FloatToAmount(1.5))
+	assert.Equal(t, mint.String(), solAPI.TokenMint())
+
+	sender, senderKey := newKey(t, "sender")
+	receiver, _ := newKey(t, "receiver")
+	holder, _ := newKey(t, "holder")
+	node.balances[sender.String()] = testFee + testAccountRent
+	node.putNonceAccount(sender, 0, sol.Hash{7})
+	node.putTokenAccount(sender, mint, 3_000_000)
+	node.putTokenAccount(holder, mint, 1)
+
+	// receiver doesn't have token account yet
+	rawTx, detail, err := solAPI.CreateRawTransaction(ctx, sender.String(), receiver.String(), 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3_000_000), rawTx.Amount)
+	assert.Equal(t, mint.String(), detail.TokenMint)
+
+	tx, err := sol.TransactionFromBase64(rawTx.TxBase64)
+	require.NoError(t, err)
+	assert.Equal(t, []sol.PublicKey{
+		sol.SystemProgramID, sol.AssociatedTokenProgramID, sol.TokenProgramID,
+	}, programIDs(tx))
+	transfer := tx.Message.Instructions[2]
+	assert.Equal(t, byte(12), transfer.Data[0])
+	assert.Equal(t, uint64(3_000_000), binary.LittleEndian.Uint64(transfer.Data[1:9]))
+	assert.Equal(t, byte(6), transfer.Data[9])
+
+	// receiver has token account
+	rawTx, _, err = solAPI.CreateRawTransaction(ctx, sender.String(), holder.String(), 1_000_000, 0)
+	require.NoError(t, err)
+	tx, err = sol.TransactionFromBase64(rawTx.TxBase64)
+	require.NoError(t, err)
+	assert.Equal(t, []sol.PublicKey{sol.SystemProgramID, sol.TokenProgramID}, programIDs(tx))
+
+	_, err = sol.SignRawTransaction(rawTx, base58.Encode(senderKey))
+	require.NoError(t, err)
+
+	// token balance is insufficient
+	_, _, err = solAPI.CreateRawTransaction(ctx, sender.String(), holder.String(), 4_000_000, 0)
+	assert.Error(t, err)
+
+	// SOL for fee is insufficient
+	node.balances[sender.String()] = testFee
+	_, _, err = solAPI.CreateRawTransaction(ctx, sender.String(), receiver.String(), 0, 0)
+	assert.Error(t, err)
+
+	total, userAmounts := solAPI.GetTotalBalance(ctx, []string{sender.String(), receiver.String(), holder.String()})
+	assert.Equal(t, uint64(3_000_001), total)
+	assert.Len(t, userAmounts, 2)
+}
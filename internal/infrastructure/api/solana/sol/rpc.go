@@ -0,0 +1,111 @@
+package sol
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+)
+
+// https://solana.com/docs/rpc/http
+
+// commitmentConfig returns config object of request
+func (s *Solana) commitmentConfig() map[string]any {
+	return map[string]any{"commitment": s.commitment}
+}
+
+// GetBalance returns lamports of account
+func (s *Solana) GetBalance(ctx context.Context, addr string) (uint64, error) {
+	var res ResponseGetBalance
+	if err := s.rpcClient.CallContext(ctx, &res, "getBalance", addr, s.commitmentConfig()); err != nil {
+		return 0, fmt.Errorf("fail to call rpcClient.CallContext(getBalance): %w", err)
+	}
+	return res.Value, nil
+}
+
+// GetAccountInfo returns account information, nil is returned if account doesn't exist
+func (s *Solana) GetAccountInfo(ctx context.Context, addr string) (*AccountInfo, error) {
+	config := s.commitmentConfig()
+	config["encoding"] = "base64"
+
+	var res ResponseGetAccountInfo
+	if err := s.rpcClient.CallContext(ctx, &res, "getAccountInfo", addr, config); err != nil {
+		return nil, fmt.Errorf("fail to call rpcClient.CallContext(getAccountInfo): %w", err)
+	}
+	return res.Value, nil
+}
+
+// GetAccountData returns decoded data of account, nil is returned if account doesn't exist
+func (s *Solana) GetAccountData(ctx context.Context, addr string) ([]byte, error) {
+	info, err := s.GetAccountInfo(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, nil
+	}
+	if len(info.Data) == 0 {
+		return []byte{}, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(info.Data[0])
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode account data of %s: %w", addr, err)
+	}
+	return data, nil
+}
+
+// GetLatestBlockhash returns latest blockhash
+func (s *Solana) GetLatestBlockhash(ctx context.Context) (*LatestBlockhash, error) {
+	var res ResponseGetLatestBlockhash
+	if err := s.rpcClient.CallContext(ctx, &res, "getLatestBlockhash", s.commitmentConfig()); err != nil {
+		return nil, fmt.Errorf("fail to call rpcClient.CallContext(getLatestBlockhash): %w", err)
+	}
+	return &res.Value, nil
+}
+
+// GetMinimumBalanceForRentExemption returns lamports which makes account of dataSize rent exempt
+func (s *Solana) GetMinimumBalanceForRentExemption(ctx context.Context, dataSize uint64) (uint64, error) {
+	var res uint64
+	err := s.rpcClient.CallContext(ctx, &res, "getMinimumBalanceForRentExemption", dataSize, s.commitmentConfig())
+	if err != nil {
+		return 0, fmt.Errorf("fail to call rpcClient.CallContext(getMinimumBalanceForRentExemption): %w", err)
+	}
+	return res, nil
+}
+
+// GetFeeForMessage returns fee of message
+func (s *Solana) GetFeeForMessage(ctx context.Context, msg *Message) (uint64, error) {
+	var res ResponseGetFeeForMessage
+	err := s.rpcClient.CallContext(ctx, &res, "getFeeForMessage",
+		base64.StdEncoding.EncodeToString(msg.Serialize()), s.commitmentConfig())
+	if err != nil {
+		return 0, fmt.Errorf("fail to call rpcClient.CallContext(getFeeForMessage): %w", err)
+	}
+	if res.Value == nil {
+		return 0, fmt.Errorf("fee is not available for blockhash %s", msg.RecentBlockhash)
+	}
+	return *res.Value, nil
+}
+
+// SendTransaction sends base64 encoded signed transaction and returns signature
+func (s *Solana) SendTransaction(ctx context.Context, signedTx string) (string, error) {
+	config := map[string]any{
+		"encoding":            "base64",
+		"preflightCommitment": s.commitment,
+	}
+	var signature string
+	if err := s.rpcClient.CallContext(ctx, &signature, "sendTransaction", signedTx, config); err != nil {
+		return "", fmt.Errorf("fail to call rpcClient.CallContext(sendTransaction): %w", err)
+	}
+	return signature, nil
+}
+
+// GetSignatureStatuses returns statuses of signatures, nil is returned for unknown signature
+func (s *Solana) GetSignatureStatuses(ctx context.Context, signatures []string) ([]*SignatureStatus, error) {
+	config := map[string]any{"searchTransactionHistory": true}
+
+	var res ResponseGetSignatureStatuses
+	if err := s.rpcClient.CallContext(ctx, &res, "getSignatureStatuses", signatures, config); err != nil {
+		return nil, fmt.Errorf("fail to call rpcClient.CallContext(getSignatureStatuses): %w", err)
+	}
+	return res.Value, nil
+}
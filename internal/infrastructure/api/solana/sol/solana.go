@@ -0,0 +1,112 @@
+package sol
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// SOLDecimals is decimals of SOL, 1 SOL = 1,000,000,000 lamports
+const SOLDecimals uint8 = 9
+
+// NetworkTypeSOL is network type
+type NetworkTypeSOL string
+
+// network type
+const (
+	NetworkTypeMainNet  NetworkTypeSOL = "mainnet"
+	NetworkTypeTestNet  NetworkTypeSOL = "testnet"
+	NetworkTypeDevNet   NetworkTypeSOL = "devnet"
+	NetworkTypeLocalNet NetworkTypeSOL = "localnet"
+)
+
+// String converter
+func (n NetworkTypeSOL) String() string {
+	return string(n)
+}
+
+// Solana includes client to call JSON-RPC
+//   - rpcClient is nil for keygen wallet because transaction is signed offline
+//   - tokenMint is zero value when SOL is sent, otherwise SPL token of tokenMint is sent
+type Solana struct {
+	rpcClient     *ethrpc.Client
+	chainConf     *chaincfg.Params
+	coinTypeCode  domainCoin.CoinTypeCode
+	uuidHandler   uuid.UUIDHandler
+	commitment    Commitment
+	tokenMint     PublicKey
+	tokenDecimals uint8
+	isToken       bool
+}
+
+// NewSolana creates Solana object, no RPC is called here because keygen wallet is offline
+func NewSolana(
+	rpcClient *ethrpc.Client,
+	coinTypeCode domainCoin.CoinTypeCode,
+	conf *config.Solana,
+	uuidHandler uuid.UUIDHandler,
+) (*Solana, error) {
+	sol := &Solana{
+		rpcClient:     rpcClient,
+		coinTypeCode:  coinTypeCode,
+		uuidHandler:   uuidHandler,
+		commitment:    Commitment(conf.Commitment),
+		tokenDecimals: SOLDecimals,
+	}
+	if sol.commitment == "" {
+		sol.commitment = CommitmentFinalized
+	}
+
+	if NetworkTypeSOL(conf.NetworkType) == NetworkTypeMainNet {
+		sol.chainConf = &chaincfg.MainNetParams
+	} else {
+		sol.chainConf = &chaincfg.TestNet3Params
+	}
+
+	// SPL token
+	if conf.SPLToken != "" {
+		token, ok := conf.SPLTokens[conf.SPLToken]
+		if !ok {
+			return nil, fmt.Errorf("spl token information for [%s] is not found", conf.SPLToken)
+		}
+		mint, err := PublicKeyFromBase58(token.MintAddress)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call PublicKeyFromBase58(mint_address): %w", err)
+		}
+		sol.tokenMint = mint
+		sol.tokenDecimals = token.Decimals
+		sol.isToken = true
+	}
+
+	return sol, nil
+}
+
+// Close disconnect to server
+func (s *Solana) Close() {
+	if s.rpcClient != nil {
+		s.rpcClient.Close()
+	}
+}
+
+// CoinTypeCode returns coinTypeCode
+func (s *Solana) CoinTypeCode() domainCoin.CoinTypeCode {
+	return s.coinTypeCode
+}
+
+// GetChainConf returns chain conf
+func (s *Solana) GetChainConf() *chaincfg.Params {
+	return s.chainConf
+}
+
+// TokenMint returns mint address of SPL token, empty string is returned for SOL
+func (s *Solana) TokenMint() string {
+	if !s.isToken {
+		return ""
+	}
+	return s.tokenMint.String()
+}
@@ -0,0 +1,128 @@
+package sol
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+)
+
+// SignatureSize is byte length of ed25519 signature
+const SignatureSize = ed25519.SignatureSize
+
+// Signature is ed25519 signature, first signature is used as transaction id
+type Signature [SignatureSize]byte
+
+// String returns base58 signature
+func (s Signature) String() string {
+	return base58.Encode(s[:])
+}
+
+// Transaction is legacy transaction
+type Transaction struct {
+	Signatures []Signature
+	Message    Message
+}
+
+// NewTransaction returns unsigned transaction whose signatures are filled by zero
+func NewTransaction(msg *Message) *Transaction {
+	return &Transaction{
+		Signatures: make([]Signature, msg.Header.NumRequiredSignatures),
+		Message:    *msg,
+	}
+}
+
+// Sign signs message by given private keys, private key must be one of signers of message
+func (t *Transaction) Sign(privKeys ...ed25519.PrivateKey) error {
+	msgData := t.Message.Serialize()
+	signers := t.Message.Signers()
+	for _, privKey := range privKeys {
+		var pubKey PublicKey
+		copy(pubKey[:], privKey.Public().(ed25519.PublicKey))
+		signed := false
+		for i, signer := range signers {
+			if signer == pubKey {
+				copy(t.Signatures[i][:], ed25519.Sign(privKey, msgData))
+				signed = true
+			}
+		}
+		if !signed {
+			return fmt.Errorf("%s is not signer of transaction", pubKey)
+		}
+	}
+	return nil
+}
+
+// IsSigned returns true if all signatures are filled and valid
+func (t *Transaction) IsSigned() bool {
+	msgData := t.Message.Serialize()
+	for i, signer := range t.Message.Signers() {
+		if !ed25519.Verify(signer[:], msgData, t.Signatures[i][:]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ID returns first signature which identifies transaction on chain
+func (t *Transaction) ID() string {
+	if len(t.Signatures) == 0 {
+		return ""
+	}
+	return t.Signatures[0].String()
+}
+
+// Serialize returns wire format of transaction
+func (t *Transaction) Serialize() []byte {
+	var buf bytes.Buffer
+	writeCompactU16(&buf, len(t.Signatures))
+	for _, sig := range t.Signatures {
+		buf.Write(sig[:])
+	}
+	buf.Write(t.Message.Serialize())
+	return buf.Bytes()
+}
+
+// ToBase64 returns base64 encoded transaction which is accepted by sendTransaction
+func (t *Transaction) ToBase64() string {
+	return base64.StdEncoding.EncodeToString(t.Serialize())
+}
+
+// DeserializeTransaction decodes wire format of transaction
+func DeserializeTransaction(data []byte) (*Transaction, error) {
+	r := bytes.NewReader(data)
+	numSigs, err := readCompactU16(r)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read number of signatures: %w", err)
+	}
+	tx := &Transaction{Signatures: make([]Signature, numSigs)}
+	for i := range tx.Signatures {
+		if _, err = readFull(r, tx.Signatures[i][:]); err != nil {
+			return nil, fmt.Errorf("fail to read signature: %w", err)
+		}
+	}
+	msg, err := readMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read message: %w", err)
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%d bytes are left after transaction", r.Len())
+	}
+	if int(msg.Header.NumRequiredSignatures) != numSigs {
+		return nil, errors.New("number of signatures doesn't match with message header")
+	}
+	tx.Message = *msg
+	return tx, nil
+}
+
+// TransactionFromBase64 decodes base64 encoded transaction
+func TransactionFromBase64(str string) (*Transaction, error) {
+	data, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode base64: %w", err)
+	}
+	return DeserializeTransaction(data)
+}
@@ -0,0 +1,93 @@
+package sol
+
+import (
+	"encoding/json"
+)
+
+// Commitment is level of finality which is used when querying state
+type Commitment string
+
+// commitment
+const (
+	CommitmentProcessed Commitment = "processed"
+	CommitmentConfirmed Commitment = "confirmed"
+	CommitmentFinalized Commitment = "finalized"
+)
+
+// String converter
+func (c Commitment) String() string {
+	return string(c)
+}
+
+// ResponseContext is context of response
+type ResponseContext struct {
+	Slot uint64 `json:"slot"`
+}
+
+// ResponseGetBalance is response of getBalance
+type ResponseGetBalance struct {
+	Context ResponseContext `json:"context"`
+	Value   uint64          `json:"value"`
+}
+
+// AccountInfo is value of getAccountInfo
+//   - Data is [encoded data, encoding]
+type AccountInfo struct {
+	Data       []string `json:"data"`
+	Executable bool     `json:"executable"`
+	Lamports   uint64   `json:"lamports"`
+	Owner      string   `json:"owner"`
+	RentEpoch  uint64   `json:"rentEpoch"`
+	Space      uint64   `json:"space"`
+}
+
+// ResponseGetAccountInfo is response of getAccountInfo, Value is nil if account doesn't exist
+type ResponseGetAccountInfo struct {
+	Context ResponseContext `json:"context"`
+	Value   *AccountInfo    `json:"value"`
+}
+
+// LatestBlockhash is value of getLatestBlockhash
+type LatestBlockhash struct {
+	Blockhash            string `json:"blockhash"`
+	LastValidBlockHeight uint64 `json:"lastValidBlockHeight"`
+}
+
+// ResponseGetLatestBlockhash is response of getLatestBlockhash
+type ResponseGetLatestBlockhash struct {
+	Context ResponseContext `json:"context"`
+	Value   LatestBlockhash `json:"value"`
+}
+
+// ResponseGetFeeForMessage is response of getFeeForMessage, Value is nil if blockhash is expired
+type ResponseGetFeeForMessage struct {
+	Context ResponseContext `json:"context"`
+	Value   *uint64         `json:"value"`
+}
+
+// SignatureStatus is status of sent transaction
+//   - Confirmations is nil when transaction is finalized
+//   - Err is not nil when transaction failed
+type SignatureStatus struct {
+	Slot               uint64          `json:"slot"`
+	Confirmations      *uint64         `json:"confirmations"`
+	Err                json.RawMessage `json:"err"`
+	ConfirmationStatus Commitment      `json:"confirmationStatus"`
+}
+
+// IsFailed returns true if transaction was processed with error
+func (s *SignatureStatus) IsFailed() bool {
+	return len(s.Err) != 0 && string(s.Err) != "null"
+}
+
+// ResponseGetSignatureStatuses is response of getSignatureStatuses, unknown signature is nil
+type ResponseGetSignatureStatuses struct {
+	Context ResponseContext    `json:"context"`
+	Value   []*SignatureStatus `json:"value"`
+}
+
+// UserAmount is used for GetTotalBalance
+type UserAmount struct {
+	Address string
+	Amount  uint64
+}
@@ -0,0 +1,48 @@
+package sol
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+)
+
+// ValidateAddr validates address
+func (*Solana) ValidateAddr(addr string) error {
+	if _, err := PublicKeyFromBase58(addr); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Decimals returns decimals of sent coin, SPL token has its own decimals
+func (s *Solana) Decimals() uint8 {
+	return s.tokenDecimals
+}
+
+// FloatToAmount converts float value to smallest unit (lamports or base unit of token)
+func (s *Solana) FloatToAmount(v float64) uint64 {
+	return uint64(math.Round(v * math.Pow10(int(s.tokenDecimals))))
+}
+
+// AmountToFloat converts smallest unit to float value
+func (s *Solana) AmountToFloat(v uint64) float64 {
+	return float64(v) / math.Pow10(int(s.tokenDecimals))
+}
+
+// PrivateKeyFromBase58 decodes base58 encoded 64 bytes secret key which is stored as WIF by keygen wallet
+func PrivateKeyFromBase58(wif string) (ed25519.PrivateKey, error) {
+	decoded := base58.Decode(wif)
+	if len(decoded) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid private key")
+	}
+	privKey := ed25519.PrivateKey(decoded)
+	// public key part must be derived from seed part
+	derived := ed25519.NewKeyFromSeed(privKey.Seed())
+	if !derived.Equal(privKey) {
+		return nil, fmt.Errorf("public key doesn't match with private key")
+	}
+	return privKey, nil
+}
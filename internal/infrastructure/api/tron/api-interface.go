@@ -0,0 +1,69 @@
+package tron
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/chaincfg"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron/trx"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+)
+
+// Troner Tron Interface
+type Troner interface {
+	TronRPCer
+
+	// balance
+	GetTRC20Balance(ctx context.Context, owner string) (uint64, error)
+	GetAccountBalance(ctx context.Context, addr string) (uint64, error)
+	GetTotalBalance(ctx context.Context, addrs []string) (uint64, []trx.UserAmount)
+	// raw_transaction
+	CreateRawTransaction(
+		ctx context.Context, fromAddr, toAddr string, amount uint64,
+	) (*trx.RawTx, *models.TRXDetailTX, error)
+	SignRawTransaction(rawTx *trx.RawTx, wif string) (*trx.RawTx, error)
+	SendSignedTransaction(ctx context.Context, signedTx string) (string, error)
+	GetConfirmation(ctx context.Context, txID string) (uint64, error)
+	// tron
+	Close()
+	CoinTypeCode() domainCoin.CoinTypeCode
+	GetChainConf() *chaincfg.Params
+	ContractAddress() string
+	FeeLimit() uint64
+	// util
+	ValidateAddr(addr string) error
+	Decimals() uint8
+	FloatToAmount(v float64) uint64
+	AmountToFloat(v uint64) float64
+}
+
+// TronRPCer is HTTP API interface of full node
+type TronRPCer interface {
+	GetNowBlock(ctx context.Context) (*trx.Block, error)
+	GetAccount(ctx context.Context, addr string) (*trx.Account, error)
+	GetAccountResource(ctx context.Context, addr string) (*trx.AccountResource, error)
+	GetChainParameters(ctx context.Context) (map[string]int64, error)
+	TriggerConstantContract(
+		ctx context.Context, owner, contract, functionSelector string, parameter []byte,
+	) (*trx.ResponseTriggerConstantContract, error)
+	BroadcastHex(ctx context.Context, signedTx string) (string, error)
+	GetTransactionInfoByID(ctx context.Context, txID string) (*trx.TransactionInfo, error)
+}
+
+// TrxTxCreator is used in transaction creation contexts
+type TrxTxCreator interface {
+	ValidateAddr(addr string) error
+	FloatToAmount(v float64) uint64
+	GetAccountBalance(ctx context.Context, addr string) (uint64, error)
+	CreateRawTransaction(
+		ctx context.Context, fromAddr, toAddr string, amount uint64,
+	) (*trx.RawTx, *models.TRXDetailTX, error)
+}
+
+// TrxTxMonitor is used in transaction monitoring contexts
+type TrxTxMonitor interface {
+	GetTotalBalance(ctx context.Context, addrs []string) (uint64, []trx.UserAmount)
+	GetConfirmation(ctx context.Context, txID string) (uint64, error)
+	AmountToFloat(v uint64) float64
+}
@@ -0,0 +1,47 @@
+package tron
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron/trx"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// httpTimeout is timeout of each request to full node
+const httpTimeout = 30 * time.Second
+
+// NewHTTPClient creates HTTP client for full node
+//   - full node of Tron provides HTTP API instead of JSON-RPC for transaction
+func NewHTTPClient(conf *config.Tron) (*http.Client, error) {
+	if conf.FullNodeURL == "" {
+		return nil, errors.New("full_node_url for tron is not defined in config")
+	}
+	return &http.Client{Timeout: httpTimeout}, nil
+}
+
+// NewTron creates Tron instance according to coinType
+//   - httpClient can be nil for keygen wallet which signs transaction offline
+func NewTron(
+	httpClient *http.Client, conf *config.Tron,
+	coinTypeCode domainCoin.CoinTypeCode, uuidHandler uuid.UUIDHandler,
+) (Troner, error) {
+	switch coinTypeCode {
+	case domainCoin.TRX:
+		trxAPI, err := trx.NewTron(httpClient, coinTypeCode, conf, uuidHandler)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call trx.NewTron(): %w", err)
+		}
+		return trxAPI, nil
+	case domainCoin.BTC, domainCoin.BCH, domainCoin.LTC, domainCoin.DOGE, domainCoin.ETH, domainCoin.POL,
+		domainCoin.BNB, domainCoin.ARB, domainCoin.BASE, domainCoin.ERC20, domainCoin.HYT, domainCoin.XRP,
+		domainCoin.SOL:
+		return nil, fmt.Errorf("coinType %s is not defined", coinTypeCode.String())
+	default:
+		return nil, fmt.Errorf("coinType %s is not defined", coinTypeCode.String())
+	}
+}
@@ -0,0 +1,120 @@
+package tron
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron/trx"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// instrumentedTroner records latency and errors of methods calling tron full node,
+// other methods are called as is
+type instrumentedTroner struct {
+	Troner
+	coin string
+}
+
+// NewInstrumentedTroner wraps Troner to record RPC metrics per method
+func NewInstrumentedTroner(trxClient Troner) Troner {
+	return &instrumentedTroner{
+		Troner: trxClient,
+		coin:   trxClient.CoinTypeCode().String(),
+	}
+}
+
+func (t *instrumentedTroner) start(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "tron."+method, attribute.String("coin", t.coin))
+}
+
+func (t *instrumentedTroner) observe(method string, span trace.Span, started time.Time, err *error) {
+	metrics.ObserveRPC(t.coin, method, started, *err)
+	tracer.End(span, err)
+}
+
+func (t *instrumentedTroner) GetNowBlock(ctx context.Context) (_ *trx.Block, err error) {
+	ctx, span := t.start(ctx, "GetNowBlock")
+	defer t.observe("GetNowBlock", span, time.Now(), &err)
+	return t.Troner.GetNowBlock(ctx)
+}
+
+func (t *instrumentedTroner) GetAccount(ctx context.Context, addr string) (_ *trx.Account, err error) {
+	ctx, span := t.start(ctx, "GetAccount")
+	defer t.observe("GetAccount", span, time.Now(), &err)
+	return t.Troner.GetAccount(ctx, addr)
+}
+
+func (t *instrumentedTroner) GetAccountResource(
+	ctx context.Context, addr string,
+) (_ *trx.AccountResource, err error) {
+	ctx, span := t.start(ctx, "GetAccountResource")
+	defer t.observe("GetAccountResource", span, time.Now(), &err)
+	return t.Troner.GetAccountResource(ctx, addr)
+}
+
+func (t *instrumentedTroner) GetChainParameters(ctx context.Context) (_ map[string]int64, err error) {
+	ctx, span := t.start(ctx, "GetChainParameters")
+	defer t.observe("GetChainParameters", span, time.Now(), &err)
+	return t.Troner.GetChainParameters(ctx)
+}
+
+func (t *instrumentedTroner) TriggerConstantContract(
+	ctx context.Context, owner, contract, functionSelector string, parameter []byte,
+) (_ *trx.ResponseTriggerConstantContract, err error) {
+	ctx, span := t.start(ctx, "TriggerConstantContract")
+	defer t.observe("TriggerConstantContract", span, time.Now(), &err)
+	return t.Troner.TriggerConstantContract(ctx, owner, contract, functionSelector, parameter)
+}
+
+func (t *instrumentedTroner) BroadcastHex(ctx context.Context, signedTx string) (_ string, err error) {
+	ctx, span := t.start(ctx, "BroadcastHex")
+	defer t.observe("BroadcastHex", span, time.Now(), &err)
+	return t.Troner.BroadcastHex(ctx, signedTx)
+}
+
+func (t *instrumentedTroner) GetTransactionInfoByID(
+	ctx context.Context, txID string,
+) (_ *trx.TransactionInfo, err error) {
+	ctx, span := t.start(ctx, "GetTransactionInfoByID")
+	defer t.observe("GetTransactionInfoByID", span, time.Now(), &err)
+	return t.Troner.GetTransactionInfoByID(ctx, txID)
+}
+
+func (t *instrumentedTroner) GetTRC20Balance(ctx context.Context, owner string) (_ uint64, err error) {
+	ctx, span := t.start(ctx, "GetTRC20Balance")
+	defer t.observe("GetTRC20Balance", span, time.Now(), &err)
+	return t.Troner.GetTRC20Balance(ctx, owner)
+}
+
+func (t *instrumentedTroner) GetAccountBalance(ctx context.Context, addr string) (_ uint64, err error) {
+	ctx, span := t.start(ctx, "GetAccountBalance")
+	defer t.observe("GetAccountBalance", span, time.Now(), &err)
+	return t.Troner.GetAccountBalance(ctx, addr)
+}
+
+func (t *instrumentedTroner) CreateRawTransaction(
+	ctx context.Context, fromAddr, toAddr string, amount uint64,
+) (_ *trx.RawTx, _ *models.TRXDetailTX, err error) {
+	ctx, span := t.start(ctx, "CreateRawTransaction")
+	defer t.observe("CreateRawTransaction", span, time.Now(), &err)
+	return t.Troner.CreateRawTransaction(ctx, fromAddr, toAddr, amount)
+}
+
+func (t *instrumentedTroner) SendSignedTransaction(
+	ctx context.Context, signedTx string,
+) (_ string, err error) {
+	ctx, span := t.start(ctx, "SendSignedTransaction")
+	defer t.observe("SendSignedTransaction", span, time.Now(), &err)
+	return t.Troner.SendSignedTransaction(ctx, signedTx)
+}
+
+func (t *instrumentedTroner) GetConfirmation(ctx context.Context, txID string) (_ uint64, err error) {
+	ctx, span := t.start(ctx, "GetConfirmation")
+	defer t.observe("GetConfirmation", span, time.Now(), &err)
+	return t.Troner.GetConfirmation(ctx, txID)
+}
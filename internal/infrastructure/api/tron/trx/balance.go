@@ -0,0 +1,99 @@
+package trx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	trxaddr "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address/trx"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// TRC-20 functions
+const (
+	functionBalanceOf = "balanceOf(address)"
+	functionTransfer  = "transfer(address,uint256)"
+)
+
+// abiAddress returns 32 bytes ABI word of address
+func abiAddress(addr trxaddr.Address) []byte {
+	return common.LeftPadBytes(addr.EVMBytes(), 32)
+}
+
+// abiUint256 returns 32 bytes ABI word of amount
+func abiUint256(amount uint64) []byte {
+	return common.LeftPadBytes(new(big.Int).SetUint64(amount).Bytes(), 32)
+}
+
+// transferParameter returns ABI encoded parameter of transfer(address,uint256)
+func transferParameter(to trxaddr.Address, amount uint64) []byte {
+	return append(abiAddress(to), abiUint256(amount)...)
+}
+
+// transferData returns call data of transfer(address,uint256) which includes method ID
+func transferData(to trxaddr.Address, amount uint64) []byte {
+	methodID := crypto.Keccak256([]byte(functionTransfer))[:4]
+	return append(methodID, transferParameter(to, amount)...)
+}
+
+// GetTRC20Balance returns TRC-20 token amount of owner
+func (t *Tron) GetTRC20Balance(ctx context.Context, owner string) (uint64, error) {
+	ownerAddr, err := trxaddr.AddressFromBase58(owner)
+	if err != nil {
+		return 0, err
+	}
+	res, err := t.TriggerConstantContract(ctx, owner, t.contract.String(), functionBalanceOf, abiAddress(ownerAddr))
+	if err != nil {
+		return 0, fmt.Errorf("fail to call trx.TriggerConstantContract(balanceOf): %w", err)
+	}
+	if len(res.ConstantResult) == 0 {
+		return 0, errors.New("result of balanceOf is empty")
+	}
+	balance, ok := new(big.Int).SetString(res.ConstantResult[0], 16)
+	if !ok {
+		return 0, fmt.Errorf("invalid result of balanceOf: %s", res.ConstantResult[0])
+	}
+	if !balance.IsUint64() {
+		return 0, fmt.Errorf("balance of %s overflows uint64: %s", owner, balance.String())
+	}
+	return balance.Uint64(), nil
+}
+
+// GetAccountBalance returns balance of sent coin,
+// sun for TRX and base unit of token for TRC-20 token
+func (t *Tron) GetAccountBalance(ctx context.Context, addr string) (uint64, error) {
+	if t.isToken {
+		return t.GetTRC20Balance(ctx, addr)
+	}
+	account, err := t.GetAccount(ctx, addr)
+	if err != nil {
+		return 0, fmt.Errorf("fail to call trx.GetAccount(): %w", err)
+	}
+	return account.Balance, nil
+}
+
+// GetTotalBalance returns total balance of addresses
+func (t *Tron) GetTotalBalance(ctx context.Context, addrs []string) (uint64, []UserAmount) {
+	var total uint64
+	userAmounts := make([]UserAmount, 0, len(addrs))
+	for _, addr := range addrs {
+		balance, err := t.GetAccountBalance(ctx, addr)
+		if err != nil {
+			logger.Warn("fail to call trx.GetAccountBalance()",
+				"address", addr,
+				"error", err,
+			)
+			continue
+		}
+		if balance == 0 {
+			continue
+		}
+		total += balance
+		userAmounts = append(userAmounts, UserAmount{Address: addr, Amount: balance})
+	}
+	return total, userAmounts
+}
@@ -0,0 +1,73 @@
+package trx
+
+import (
+	"context"
+	"fmt"
+
+	trxaddr "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address/trx"
+)
+
+// https://developers.tron.network/docs/resource-model
+
+// chainParam returns positive value of chain parameter
+func chainParam(params map[string]int64, key string) (uint64, error) {
+	v, ok := params[key]
+	if !ok || v < 0 {
+		return 0, fmt.Errorf("chain parameter %s is not found", key)
+	}
+	return uint64(v), nil
+}
+
+// bandwidthFee returns sun burned for bandwidth of transaction,
+// it's 0 if staked or free bandwidth of sender covers the size
+//   - staked bandwidth is consumed first, then free bandwidth, they aren't combined
+func bandwidthFee(resource *AccountResource, params map[string]int64, tx *Transaction) (uint64, error) {
+	size := tx.bandwidthSize()
+	if resource.NetLimit >= resource.NetUsed+size || resource.FreeNetLimit >= resource.FreeNetUsed+size {
+		return 0, nil
+	}
+	price, err := chainParam(params, ChainParamTransactionFee)
+	if err != nil {
+		return 0, err
+	}
+	return size * price, nil
+}
+
+// activationFee returns sun burned when TRX is sent to account which is not activated yet,
+// it's charged instead of bandwidth fee
+func activationFee(params map[string]int64) (uint64, error) {
+	createAccountFee, err := chainParam(params, ChainParamCreateAccountFee)
+	if err != nil {
+		return 0, err
+	}
+	systemContractFee, err := chainParam(params, ChainParamCreateNewAccountFeeInSysContract)
+	if err != nil {
+		return 0, err
+	}
+	return createAccountFee + systemContractFee, nil
+}
+
+// EstimateTRC20Energy returns energy consumed by transfer of TRC-20 token
+func (t *Tron) EstimateTRC20Energy(
+	ctx context.Context, from string, to trxaddr.Address, amount uint64,
+) (uint64, error) {
+	res, err := t.TriggerConstantContract(
+		ctx, from, t.contract.String(), functionTransfer, transferParameter(to, amount))
+	if err != nil {
+		return 0, fmt.Errorf("fail to call trx.TriggerConstantContract(transfer): %w", err)
+	}
+	return res.EnergyUsed, nil
+}
+
+// energyFee returns sun burned for energy which exceeds staked energy of sender
+func energyFee(resource *AccountResource, params map[string]int64, energy uint64) (uint64, error) {
+	available := resource.availableEnergy()
+	if available >= energy {
+		return 0, nil
+	}
+	price, err := chainParam(params, ChainParamEnergyFee)
+	if err != nil {
+		return 0, err
+	}
+	return (energy - available) * price, nil
+}
@@ -0,0 +1,333 @@
+package trx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	trxaddr "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address/trx"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// ErrTransactionFailed means sent transaction was included in block but failed
+var ErrTransactionFailed = errors.New("transaction failed")
+
+// RawTx is raw transaction passed between watch wallet and keygen wallet
+type RawTx struct {
+	UUID            string `json:"uuid"`
+	From            string `json:"from"`
+	To              string `json:"to"`
+	Amount          uint64 `json:"amount"`
+	Fee             uint64 `json:"fee"`
+	FeeLimit        uint64 `json:"fee_limit"`
+	ContractAddress string `json:"contract_address"`
+	RefBlockNum     uint64 `json:"ref_block_num"`
+	Expiration      int64  `json:"expiration"`
+	TxHex           string `json:"tx_hex"`
+	TxID            string `json:"txid"`
+}
+
+// transferContract sets TransferContract to raw data and returns sent amount and fee,
+// amount 0 means sender sends all balance (receiver pays fee)
+//   - activation fee is charged instead of bandwidth fee when receiver is not activated
+func (t *Tron) transferContract(
+	ctx context.Context, raw *RawData, sender *Account, to trxaddr.Address, amount uint64,
+	resource *AccountResource, params map[string]int64,
+) (uint64, uint64, error) {
+	logger.Info("balance", "balance", sender.Balance)
+	if sender.Balance == 0 {
+		return 0, 0, errors.New("balance is needed to send trx")
+	}
+
+	receiver, err := t.GetAccount(ctx, to.String())
+	if err != nil {
+		return 0, 0, fmt.Errorf("fail to call trx.GetAccount(receiver): %w", err)
+	}
+
+	// size of amount varint is max when balance is set, so fee is never underestimated
+	raw.Contract.Type = ContractTypeTransfer
+	raw.Contract.Amount = int64(sender.Balance)
+	if amount != 0 {
+		raw.Contract.Amount = int64(amount)
+	}
+
+	var fee uint64
+	if receiver.IsActivated() {
+		tx, err := NewTransaction(raw)
+		if err != nil {
+			return 0, 0, err
+		}
+		fee, err = bandwidthFee(resource, params, tx)
+		if err != nil {
+			return 0, 0, err
+		}
+	} else {
+		fee, err = activationFee(params)
+		if err != nil {
+			return 0, 0, err
+		}
+		logger.Info("receiver is not activated, activation fee is charged", "receiver", to.String())
+	}
+
+	if amount == 0 {
+		// receiver pays fee (deposit, transfer(pays all) action)
+		if sender.Balance <= fee {
+			return 0, 0, fmt.Errorf("%w: balance`%d`, fee `%d`", ErrInsufficientFee, sender.Balance, fee)
+		}
+		amount = sender.Balance - fee
+	} else if sender.Balance < amount+fee {
+		// sender pays fee (payment, transfer(pays partially))
+		return 0, 0, fmt.Errorf("balance`%d` is insufficient to send `%d` with fee `%d`", sender.Balance, amount, fee)
+	}
+	raw.Contract.Amount = int64(amount)
+	return amount, fee, nil
+}
+
+// trc20TransferContract sets TriggerSmartContract calling transfer(address,uint256) to raw data,
+// fee for energy and bandwidth is always paid by sender in TRX
+func (t *Tron) trc20TransferContract(
+	ctx context.Context, raw *RawData, sender *Account, to trxaddr.Address, amount uint64,
+	resource *AccountResource, params map[string]int64,
+) (uint64, uint64, error) {
+	from := raw.Contract.Owner.String()
+	tokenBalance, err := t.GetTRC20Balance(ctx, from)
+	if err != nil {
+		return 0, 0, fmt.Errorf("fail to call trx.GetTRC20Balance(): %w", err)
+	}
+	logger.Info("token balance", "balance", tokenBalance, "contract", t.contract.String())
+	if tokenBalance == 0 {
+		return 0, 0, errors.New("token balance is needed to send token")
+	}
+	if amount == 0 {
+		amount = tokenBalance
+	} else if tokenBalance < amount {
+		return 0, 0, fmt.Errorf("token balance`%d` is insufficient to send `%d`", tokenBalance, amount)
+	}
+
+	energy, err := t.EstimateTRC20Energy(ctx, from, to, amount)
+	if err != nil {
+		return 0, 0, err
+	}
+	burnedEnergy, err := energyFee(resource, params, energy)
+	if err != nil {
+		return 0, 0, err
+	}
+	if burnedEnergy > t.feeLimit {
+		return 0, 0, fmt.Errorf("energy fee `%d` exceeds fee_limit `%d`", burnedEnergy, t.feeLimit)
+	}
+
+	raw.FeeLimit = int64(t.feeLimit)
+	raw.Contract.Type = ContractTypeTriggerSmartContract
+	raw.Contract.To = t.contract
+	raw.Contract.Data = transferData(to, amount)
+	tx, err := NewTransaction(raw)
+	if err != nil {
+		return 0, 0, err
+	}
+	burnedBandwidth, err := bandwidthFee(resource, params, tx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	fee := burnedEnergy + burnedBandwidth
+	if sender.Balance < fee {
+		return 0, 0, fmt.Errorf("%w: balance`%d`, fee `%d`", ErrInsufficientFee, sender.Balance, fee)
+	}
+	logger.Info("estimated fee", "energy", energy, "energy_fee", burnedEnergy, "bandwidth_fee", burnedBandwidth)
+	return amount, fee, nil
+}
+
+// CreateRawTransaction creates unsigned transaction for watch only wallet
+//   - latest block is referred and expiration is set by config so that transaction can be signed offline
+//   - amount 0 means all balance of sender is sent
+//   - ErrAccountNotActivated or ErrInsufficientFee is returned when sender can't send transaction
+func (t *Tron) CreateRawTransaction(
+	ctx context.Context, fromAddr, toAddr string, amount uint64,
+) (*RawTx, *models.TRXDetailTX, error) {
+	from, err := trxaddr.AddressFromBase58(fromAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("address validation error: %w", err)
+	}
+	to, err := trxaddr.AddressFromBase58(toAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("address validation error: %w", err)
+	}
+	logger.Debug("trx.CreateRawTransaction()",
+		"fromAddr", fromAddr,
+		"toAddr", toAddr,
+		"amount", amount,
+	)
+
+	sender, err := t.GetAccount(ctx, fromAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call trx.GetAccount(sender): %w", err)
+	}
+	if !sender.IsActivated() {
+		return nil, nil, fmt.Errorf("%w: %s", ErrAccountNotActivated, fromAddr)
+	}
+	resource, err := t.GetAccountResource(ctx, fromAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call trx.GetAccountResource(): %w", err)
+	}
+	params, err := t.GetChainParameters(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call trx.GetChainParameters(): %w", err)
+	}
+	block, err := t.GetNowBlock(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call trx.GetNowBlock(): %w", err)
+	}
+	refBlockBytes, refBlockHash, err := block.RefBlock()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	raw := &RawData{
+		RefBlockBytes: refBlockBytes,
+		RefBlockHash:  refBlockHash,
+		Expiration:    block.BlockHeader.RawData.Timestamp + t.expiration.Milliseconds(),
+		Timestamp:     time.Now().UnixMilli(),
+		Contract:      Contract{Owner: from, To: to},
+	}
+	var newAmount, fee uint64
+	if t.isToken {
+		newAmount, fee, err = t.trc20TransferContract(ctx, raw, sender, to, amount, resource, params)
+	} else {
+		newAmount, fee, err = t.transferContract(ctx, raw, sender, to, amount, resource, params)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := NewTransaction(raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call NewTransaction(): %w", err)
+	}
+	unsignedTx := tx.ToHex()
+
+	// generate UUID to trace transaction
+	uid, err := t.uuidHandler.GenerateV7()
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to call uuidHandler.GenerateV7(): %w", err)
+	}
+
+	// create insert data for trx_detail_tx
+	txDetailItem := &models.TRXDetailTX{
+		UUID:            uid.String(),
+		SenderAccount:   "",
+		SenderAddress:   fromAddr,
+		ReceiverAccount: "",
+		ReceiverAddress: toAddr,
+		Amount:          newAmount,
+		Fee:             fee,
+		FeeLimit:        uint64(raw.FeeLimit),
+		ContractAddress: t.ContractAddress(),
+		RefBlockNum:     block.Number(),
+		Expiration:      raw.Expiration,
+		UnsignedHexTX:   unsignedTx,
+	}
+
+	rawTx := &RawTx{
+		UUID:            uid.String(),
+		From:            fromAddr,
+		To:              toAddr,
+		Amount:          newAmount,
+		Fee:             fee,
+		FeeLimit:        uint64(raw.FeeLimit),
+		ContractAddress: t.ContractAddress(),
+		RefBlockNum:     block.Number(),
+		Expiration:      raw.Expiration,
+		TxHex:           unsignedTx,
+		TxID:            tx.ID(),
+	}
+	return rawTx, txDetailItem, nil
+}
+
+// SignRawTransaction signs raw transaction by hex private key, it works offline
+func SignRawTransaction(rawTx *RawTx, wif string) (*RawTx, error) {
+	privKey, err := PrivateKeyFromHex(wif)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call PrivateKeyFromHex(): %w", err)
+	}
+	tx, err := TransactionFromHex(rawTx.TxHex)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call TransactionFromHex(): %w", err)
+	}
+	raw, err := tx.Raw()
+	if err != nil {
+		return nil, fmt.Errorf("fail to call tx.Raw(): %w", err)
+	}
+	if raw.Contract.Owner.String() != rawTx.From {
+		return nil, fmt.Errorf("owner of transaction is not %s", rawTx.From)
+	}
+	if trxaddr.PubkeyToAddress(&privKey.PublicKey) != raw.Contract.Owner {
+		return nil, fmt.Errorf("private key is not for %s", rawTx.From)
+	}
+	if raw.Expiration <= time.Now().UnixMilli() {
+		return nil, fmt.Errorf("transaction is expired at %s", time.UnixMilli(raw.Expiration).String())
+	}
+	if err = tx.Sign(privKey); err != nil {
+		return nil, fmt.Errorf("fail to call tx.Sign(): %w", err)
+	}
+
+	signedTx := *rawTx
+	signedTx.TxHex = tx.ToHex()
+	signedTx.TxID = tx.ID()
+	return &signedTx, nil
+}
+
+// SignRawTransaction signs raw transaction
+func (*Tron) SignRawTransaction(rawTx *RawTx, wif string) (*RawTx, error) {
+	return SignRawTransaction(rawTx, wif)
+}
+
+// SendSignedTransaction sends signed transaction and returns transaction ID
+func (t *Tron) SendSignedTransaction(ctx context.Context, signedTx string) (string, error) {
+	tx, err := TransactionFromHex(signedTx)
+	if err != nil {
+		return "", fmt.Errorf("fail to call TransactionFromHex(): %w", err)
+	}
+	raw, err := tx.Raw()
+	if err != nil {
+		return "", fmt.Errorf("fail to call tx.Raw(): %w", err)
+	}
+	if !tx.IsSignedBy(raw.Contract.Owner) {
+		return "", errors.New("transaction is not signed")
+	}
+	txID, err := t.BroadcastHex(ctx, signedTx)
+	if err != nil {
+		return "", fmt.Errorf("fail to call trx.BroadcastHex(): %w", err)
+	}
+	if txID != tx.ID() {
+		logger.Warn("returned txid is different from id of transaction",
+			"returned", txID,
+			"expected", tx.ID(),
+		)
+	}
+	return tx.ID(), nil
+}
+
+// GetConfirmation returns number of blocks after block including transaction,
+// 0 is returned if transaction is not in block yet and ErrTransactionFailed is returned if it failed
+func (t *Tron) GetConfirmation(ctx context.Context, txID string) (uint64, error) {
+	info, err := t.GetTransactionInfoByID(ctx, txID)
+	if err != nil {
+		return 0, fmt.Errorf("fail to call trx.GetTransactionInfoByID(): %w", err)
+	}
+	if info == nil {
+		return 0, nil
+	}
+	if info.IsFailed() {
+		return 0, fmt.Errorf("%w: %s", ErrTransactionFailed, txID)
+	}
+	block, err := t.GetNowBlock(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to call trx.GetNowBlock(): %w", err)
+	}
+	if block.Number() < info.BlockNumber {
+		return 0, nil
+	}
+	return block.Number() - info.BlockNumber, nil
+}
@@ -0,0 +1,370 @@
+package trx_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron/trx"
+	trxaddr "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address/trx"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+const (
+	testTransactionFee   = 1000
+	testEnergyFee        = 420
+	testCreateAccountFee = 100_000
+	testSystemAccountFee = 1_000_000
+	testEnergyUsed       = 14_650
+	testBlockNumber      = 1000
+	testBlockID          = "00000000000003e8aabbccddeeff0011223344556677889900aabbccddeeff00"
+	testAPIKey           = "test-api-key"
+)
+
+// fakeNode is HTTP API stand-in of tron full node
+type fakeNode struct {
+	mu          sync.Mutex
+	balances    map[string]uint64
+	tokens      map[string]uint64
+	resources   map[string]map[string]uint64
+	sent        []string
+	blockNumber uint64
+	apiKey      string
+}
+
+func newFakeNode(t *testing.T) (*fakeNode, *httptest.Server) {
+	t.Helper()
+	node := &fakeNode{
+		balances:    map[string]uint64{},
+		tokens:      map[string]uint64{},
+		resources:   map[string]map[string]uint64{},
+		blockNumber: testBlockNumber,
+	}
+	server := httptest.NewServer(http.HandlerFunc(node.serve))
+	t.Cleanup(server.Close)
+	return node, server
+}
+
+func (n *fakeNode) serve(w http.ResponseWriter, r *http.Request) {
+	var req map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.apiKey = r.Header.Get("TRON-PRO-API-KEY")
+
+	addr, _ := req["address"].(string)
+	var res any
+	switch r.URL.Path {
+	case "/wallet/getnowblock":
+		res = map[string]any{
+			"blockID": testBlockID,
+			"block_header": map[string]any{"raw_data": map[string]any{
+				"number": n.blockNumber, "timestamp": time.Now().UnixMilli(),
+			}},
+		}
+	case "/wallet/getaccount":
+		balance, ok := n.balances[addr]
+		if !ok {
+			res = map[string]any{}
+			break
+		}
+		res = map[string]any{"address": addr, "balance": balance}
+	case "/wallet/getaccountresource":
+		res = n.resources[addr]
+	case "/wallet/getchainparameters":
+		res = map[string]any{"chainParameter": []map[string]any{
+			{"key": trx.ChainParamTransactionFee, "value": testTransactionFee},
+			{"key": trx.ChainParamEnergyFee, "value": testEnergyFee},
+			{"key": trx.ChainParamCreateAccountFee, "value": testCreateAccountFee},
+			{"key": trx.ChainParamCreateNewAccountFeeInSysContract, "value": testSystemAccountFee},
+		}}
+	case "/wallet/triggerconstantcontract":
+		owner, _ := req["owner_address"].(string)
+		switch req["function_selector"] {
+		case "balanceOf(address)":
+			param, _ := hex.DecodeString(req["parameter"].(string))
+			holder, _ := trxaddr.AddressFromBytes(append([]byte{trxaddr.AddressPrefix}, param[12:]...))
+			balance := new(big.Int).SetUint64(n.tokens[holder.String()])
+			res = map[string]any{
+				"result":          map[string]any{"result": true},
+				"constant_result": []string{hex.EncodeToString(balance.FillBytes(make([]byte, 32)))},
+			}
+		case "transfer(address,uint256)":
+			if n.tokens[owner] == 0 {
+				res = map[string]any{"result": map[string]any{
+					"result": false, "message": hex.EncodeToString([]byte("REVERT opcode executed")),
+				}}
+				break
+			}
+			res = map[string]any{"result": map[string]any{"result": true}, "energy_used": testEnergyUsed}
+		}
+	case "/wallet/broadcasthex":
+		signed, _ := req["transaction"].(string)
+		n.sent = append(n.sent, signed)
+		tx, _ := trx.TransactionFromHex(signed)
+		res = map[string]any{"result": true, "txid": tx.ID()}
+	case "/wallet/gettransactioninfobyid":
+		res = map[string]any{
+			"id": req["value"], "blockNumber": testBlockNumber, "receipt": map[string]any{"result": "SUCCESS"},
+		}
+	default:
+		res = map[string]any{"Error": "unknown path " + r.URL.Path}
+	}
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func newTron(t *testing.T, url string, conf *config.Tron) *trx.Tron {
+	t.Helper()
+	conf.FullNodeURL = url
+	trxAPI, err := trx.NewTron(&http.Client{}, domainCoin.TRX, conf, uuid.NewGoogleUUIDHandler())
+	require.NoError(t, err)
+	return trxAPI
+}
+
+// signedBandwidth returns bandwidth fee of transaction after it is signed
+func signedBandwidth(t *testing.T, rawTx *trx.RawTx) uint64 {
+	t.Helper()
+	tx, err := trx.TransactionFromHex(rawTx.TxHex)
+	require.NoError(t, err)
+	tx.Signatures = [][]byte{make([]byte, trx.SignatureLength)}
+	return uint64(len(tx.Serialize())+64) * testTransactionFee
+}
+
+// TestCreateRawTransaction tests TRX transfer with bandwidth and activation fee, signing and sending
+func TestCreateRawTransaction(t *testing.T) {
+	logger.SetGlobal(logger.NewNoopLogger())
+	ctx := context.Background()
+	node, server := newFakeNode(t)
+	trxAPI := newTron(t, server.URL, &config.Tron{NetworkType: "nile", APIKey: testAPIKey})
+
+	sender, senderKey := newKey(t, "sender")
+	receiver, _ := newKey(t, "receiver")
+	inactive, _ := newKey(t, "inactive")
+	staked, stakedKey := newKey(t, "staked")
+	notActivated, _ := newKey(t, "not activated")
+	node.balances[sender.String()] = 10_000_000
+	node.balances[receiver.String()] = 0
+	node.balances[staked.String()] = 10_000_000
+	node.resources[staked.String()] = map[string]uint64{"freeNetLimit": 600}
+
+	type args struct {
+		from    trxaddr.Address
+		fromKey string
+		to      trxaddr.Address
+		amount  uint64
+	}
+	type want struct {
+		amount    uint64
+		fee       uint64
+		isFreeFee bool
+		err       error
+		isErr     bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "payment burns bandwidth",
+			args: args{from: sender, to: receiver, amount: 1_000_000},
+			want: want{amount: 1_000_000},
+		},
+		{
+			name: "deposit sends all balance",
+			args: args{from: sender, to: receiver, amount: 0},
+		},
+		{
+			name: "free bandwidth covers transaction",
+			args: args{from: staked, fromKey: hexutil.Encode(crypto.FromECDSA(stakedKey)), to: receiver},
+			want: want{amount: 10_000_000, isFreeFee: true},
+		},
+		{
+			name: "receiver is activated by transfer",
+			args: args{from: sender, to: inactive, amount: 0},
+			want: want{amount: 10_000_000 - testCreateAccountFee - testSystemAccountFee,
+				fee: testCreateAccountFee + testSystemAccountFee},
+		},
+		{
+			name: "insufficient balance",
+			args: args{from: sender, to: receiver, amount: 10_000_000},
+			want: want{isErr: true},
+		},
+		{
+			name: "sender is not activated",
+			args: args{from: notActivated, to: receiver, amount: 0},
+			want: want{err: trx.ErrAccountNotActivated},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fromKey := tt.args.fromKey
+			if fromKey == "" {
+				fromKey = hexutil.Encode(crypto.FromECDSA(senderKey))
+			}
+			rawTx, detail, err := trxAPI.CreateRawTransaction(
+				ctx, tt.args.from.String(), tt.args.to.String(), tt.args.amount)
+			if tt.want.err != nil {
+				require.ErrorIs(t, err, tt.want.err)
+				return
+			}
+			if tt.want.isErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testAPIKey, node.apiKey)
+
+			fee := tt.want.fee
+			if fee == 0 && !tt.want.isFreeFee {
+				fee = signedBandwidth(t, rawTx)
+			}
+			amount := tt.want.amount
+			if amount == 0 {
+				amount = 10_000_000 - fee
+			}
+			assert.Equal(t, amount, rawTx.Amount)
+			assert.Equal(t, fee, detail.Fee)
+			assert.Equal(t, rawTx.UUID, detail.UUID)
+			assert.Equal(t, rawTx.TxHex, detail.UnsignedHexTX)
+			assert.Equal(t, uint64(testBlockNumber), detail.RefBlockNum)
+			assert.Empty(t, detail.ContractAddress)
+			assert.Zero(t, detail.FeeLimit)
+
+			tx, err := trx.TransactionFromHex(rawTx.TxHex)
+			require.NoError(t, err)
+			raw, err := tx.Raw()
+			require.NoError(t, err)
+			assert.Equal(t, trx.ContractTypeTransfer, raw.Contract.Type)
+			assert.Equal(t, int64(amount), raw.Contract.Amount)
+			assert.Equal(t, []byte{0x03, 0xe8}, raw.RefBlockBytes)
+			assert.Equal(t, detail.Expiration, raw.Expiration)
+
+			// sign offline
+			_, err = trx.SignRawTransaction(rawTx, hexutil.Encode(crypto.FromECDSA(stakedKey)[:31]))
+			assert.Error(t, err)
+			if tt.args.from != staked {
+				_, err = trx.SignRawTransaction(rawTx, hexutil.Encode(crypto.FromECDSA(stakedKey)))
+				assert.Error(t, err, "private key of other address")
+			}
+			signedTx, err := trx.SignRawTransaction(rawTx, fromKey)
+			require.NoError(t, err)
+			assert.Equal(t, rawTx.TxID, signedTx.TxID)
+
+			// send
+			_, err = trxAPI.SendSignedTransaction(ctx, rawTx.TxHex)
+			assert.Error(t, err, "unsigned transaction can't be sent")
+			txID, err := trxAPI.SendSignedTransaction(ctx, signedTx.TxHex)
+			require.NoError(t, err)
+			assert.Equal(t, signedTx.TxID, txID)
+
+			node.blockNumber = testBlockNumber + 19
+			confirmation, err := trxAPI.GetConfirmation(ctx, txID)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(19), confirmation)
+			node.blockNumber = testBlockNumber
+		})
+	}
+}
+
+// TestCreateRawTransactionTRC20 tests TRC-20 transfer with energy estimation
+func TestCreateRawTransactionTRC20(t *testing.T) {
+	logger.SetGlobal(logger.NewNoopLogger())
+	ctx := context.Background()
+	node, server := newFakeNode(t)
+
+	contract, _ := newKey(t, "usdt")
+	trxAPI := newTron(t, server.URL, &config.Tron{
+		NetworkType: "nile",
+		FeeLimit:    10,
+		TRC20Token:  "usdt",
+		TRC20s: map[domainCoin.TRC20Token]config.TRC20Token{
+			"usdt": {Symbol: "usdt", ContractAddress: contract.String(), Decimals: 6},
+		},
+	})
+	assert.Equal(t, uint64(1_500_000), trxAPI.FloatToAmount(1.5))
+	assert.Equal(t, contract.String(), trxAPI.ContractAddress())
+	assert.Equal(t, uint64(10_000_000), trxAPI.FeeLimit())
+
+	sender, senderKey := newKey(t, "sender")
+	receiver, _ := newKey(t, "receiver")
+	staked, _ := newKey(t, "staked")
+	energyFee := uint64(testEnergyUsed * testEnergyFee)
+	node.balances[sender.String()] = 10_000_000
+	node.tokens[sender.String()] = 3_000_000
+	node.balances[staked.String()] = 0
+	node.tokens[staked.String()] = 1
+	node.resources[staked.String()] = map[string]uint64{
+		"freeNetLimit": 600, "EnergyLimit": testEnergyUsed * 2,
+	}
+
+	rawTx, detail, err := trxAPI.CreateRawTransaction(ctx, sender.String(), receiver.String(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3_000_000), rawTx.Amount)
+	assert.Equal(t, energyFee+signedBandwidth(t, rawTx), detail.Fee)
+	assert.Equal(t, contract.String(), detail.ContractAddress)
+	assert.Equal(t, uint64(10_000_000), detail.FeeLimit)
+
+	tx, err := trx.TransactionFromHex(rawTx.TxHex)
+	require.NoError(t, err)
+	raw, err := tx.Raw()
+	require.NoError(t, err)
+	assert.Equal(t, trx.ContractTypeTriggerSmartContract, raw.Contract.Type)
+	assert.Equal(t, contract, raw.Contract.To)
+	assert.Equal(t, int64(10_000_000), raw.FeeLimit)
+	assert.Equal(t, "a9059cbb", hex.EncodeToString(raw.Contract.Data[:4]))
+	assert.Equal(t, receiver.EVMBytes(), raw.Contract.Data[16:36])
+	assert.Equal(t, uint64(3_000_000), new(big.Int).SetBytes(raw.Contract.Data[36:]).Uint64())
+
+	_, err = trx.SignRawTransaction(rawTx, hexutil.Encode(crypto.FromECDSA(senderKey)))
+	require.NoError(t, err)
+
+	// staked energy and free bandwidth cover all fee
+	_, detail, err = trxAPI.CreateRawTransaction(ctx, staked.String(), receiver.String(), 0)
+	require.NoError(t, err)
+	assert.Zero(t, detail.Fee)
+
+	// token balance is insufficient
+	_, _, err = trxAPI.CreateRawTransaction(ctx, sender.String(), receiver.String(), 4_000_000)
+	assert.Error(t, err)
+
+	// TRX for fee is insufficient
+	node.balances[sender.String()] = energyFee
+	_, _, err = trxAPI.CreateRawTransaction(ctx, sender.String(), receiver.String(), 0)
+	assert.ErrorIs(t, err, trx.ErrInsufficientFee)
+
+	// energy exceeds fee_limit
+	node.resources[staked.String()] = map[string]uint64{}
+	node.balances[staked.String()] = 100_000_000
+	strict := newTron(t, server.URL, &config.Tron{
+		NetworkType: "nile",
+		FeeLimit:    1,
+		TRC20Token:  "usdt",
+		TRC20s: map[domainCoin.TRC20Token]config.TRC20Token{
+			"usdt": {Symbol: "usdt", ContractAddress: contract.String(), Decimals: 6},
+		},
+	})
+	_, _, err = strict.CreateRawTransaction(ctx, staked.String(), receiver.String(), 0)
+	assert.Error(t, err)
+
+	total, userAmounts := trxAPI.GetTotalBalance(ctx, []string{sender.String(), receiver.String(), staked.String()})
+	assert.Equal(t, uint64(3_000_001), total)
+	assert.Len(t, userAmounts, 2)
+}
@@ -0,0 +1,155 @@
+package trx
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// https://developers.tron.network/reference/full-node-api-overview
+//   - `visible` is set so that addresses are handled as base58
+
+// apiKeyHeader is header for API key of TronGrid
+const apiKeyHeader = "TRON-PRO-API-KEY"
+
+// post calls HTTP API of full node
+func (t *Tron) post(ctx context.Context, path string, req, res any) error {
+	if t.httpClient == nil {
+		return errors.New("http client is not available for offline wallet")
+	}
+	body := []byte("{}")
+	if req != nil {
+		var err error
+		if body, err = json.Marshal(req); err != nil {
+			return fmt.Errorf("fail to call json.Marshal(): %w", err)
+		}
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, t.fullNodeURL+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("fail to call http.NewRequestWithContext(): %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if t.apiKey != "" {
+		httpReq.Header.Set(apiKeyHeader, t.apiKey)
+	}
+
+	httpRes, err := t.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("fail to call httpClient.Do(%s): %w", path, err)
+	}
+	defer httpRes.Body.Close()
+
+	resBody, err := io.ReadAll(httpRes.Body)
+	if err != nil {
+		return fmt.Errorf("fail to read response of %s: %w", path, err)
+	}
+	if httpRes.StatusCode != http.StatusOK {
+		return fmt.Errorf("status code of %s is %d: %s", path, httpRes.StatusCode, string(resBody))
+	}
+	// full node returns {"Error": "..."} with status 200
+	var apiErr struct {
+		Error string `json:"Error"`
+	}
+	if err = json.Unmarshal(resBody, &apiErr); err == nil && apiErr.Error != "" {
+		return fmt.Errorf("%s returns error: %s", path, apiErr.Error)
+	}
+	if err = json.Unmarshal(resBody, res); err != nil {
+		return fmt.Errorf("fail to call json.Unmarshal(%s): %w", path, err)
+	}
+	return nil
+}
+
+// GetNowBlock returns latest block
+func (t *Tron) GetNowBlock(ctx context.Context) (*Block, error) {
+	var res Block
+	if err := t.post(ctx, "/wallet/getnowblock", nil, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetAccount returns account, address of returned account is empty if account is not activated
+func (t *Tron) GetAccount(ctx context.Context, addr string) (*Account, error) {
+	req := map[string]any{"address": addr, "visible": true}
+	var res Account
+	if err := t.post(ctx, "/wallet/getaccount", req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetAccountResource returns bandwidth and energy of account
+func (t *Tron) GetAccountResource(ctx context.Context, addr string) (*AccountResource, error) {
+	req := map[string]any{"address": addr, "visible": true}
+	var res AccountResource
+	if err := t.post(ctx, "/wallet/getaccountresource", req, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetChainParameters returns chain parameters as map
+func (t *Tron) GetChainParameters(ctx context.Context) (map[string]int64, error) {
+	var res ResponseGetChainParameters
+	if err := t.post(ctx, "/wallet/getchainparameters", nil, &res); err != nil {
+		return nil, err
+	}
+	params := make(map[string]int64, len(res.ChainParameter))
+	for _, param := range res.ChainParameter {
+		params[param.Key] = param.Value
+	}
+	return params, nil
+}
+
+// TriggerConstantContract calls contract without creating transaction,
+// it's used for reading state and estimating energy
+func (t *Tron) TriggerConstantContract(
+	ctx context.Context, owner, contract, functionSelector string, parameter []byte,
+) (*ResponseTriggerConstantContract, error) {
+	req := map[string]any{
+		"owner_address":     owner,
+		"contract_address":  contract,
+		"function_selector": functionSelector,
+		"parameter":         hex.EncodeToString(parameter),
+		"visible":           true,
+	}
+	var res ResponseTriggerConstantContract
+	if err := t.post(ctx, "/wallet/triggerconstantcontract", req, &res); err != nil {
+		return nil, err
+	}
+	if !res.Result.Result {
+		return nil, fmt.Errorf("fail to call %s: %s", functionSelector, res.Result.ErrorMessage())
+	}
+	return &res, nil
+}
+
+// BroadcastHex sends hex encoded signed transaction and returns transaction ID
+func (t *Tron) BroadcastHex(ctx context.Context, signedTx string) (string, error) {
+	req := map[string]any{"transaction": signedTx}
+	var res ResponseBroadcast
+	if err := t.post(ctx, "/wallet/broadcasthex", req, &res); err != nil {
+		return "", err
+	}
+	if !res.Result {
+		return "", fmt.Errorf("transaction is rejected: %s: %s", res.Code, res.ErrorMessage())
+	}
+	return res.TxID, nil
+}
+
+// GetTransactionInfoByID returns transaction info, nil is returned if transaction is not in block yet
+func (t *Tron) GetTransactionInfoByID(ctx context.Context, txID string) (*TransactionInfo, error) {
+	req := map[string]any{"value": txID}
+	var res TransactionInfo
+	if err := t.post(ctx, "/wallet/gettransactioninfobyid", req, &res); err != nil {
+		return nil, err
+	}
+	if res.ID == "" {
+		return nil, nil
+	}
+	return &res, nil
+}
@@ -0,0 +1,364 @@
+package trx
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	trxaddr "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address/trx"
+)
+
+// Transaction is encoded as protobuf message defined in Tron.proto of java-tron,
+// only fields used by TransferContract and TriggerSmartContract are supported
+// https://github.com/tronprotocol/protocol/blob/master/core/Tron.proto
+
+// ContractType is type of contract in transaction
+type ContractType int32
+
+// contract type
+const (
+	ContractTypeTransfer             ContractType = 1
+	ContractTypeTriggerSmartContract ContractType = 31
+)
+
+// typeURL returns type_url of google.protobuf.Any for parameter of contract
+func (c ContractType) typeURL() (string, error) {
+	switch c {
+	case ContractTypeTransfer:
+		return "type.googleapis.com/protocol.TransferContract", nil
+	case ContractTypeTriggerSmartContract:
+		return "type.googleapis.com/protocol.TriggerSmartContract", nil
+	default:
+		return "", fmt.Errorf("contract type %d is not supported", c)
+	}
+}
+
+// field numbers
+const (
+	// Transaction
+	fieldTxRawData   protowire.Number = 1
+	fieldTxSignature protowire.Number = 2
+	// Transaction.raw
+	fieldRawRefBlockBytes protowire.Number = 1
+	fieldRawRefBlockHash  protowire.Number = 4
+	fieldRawExpiration    protowire.Number = 8
+	fieldRawContract      protowire.Number = 11
+	fieldRawTimestamp     protowire.Number = 14
+	fieldRawFeeLimit      protowire.Number = 18
+	// Transaction.Contract
+	fieldContractType      protowire.Number = 1
+	fieldContractParameter protowire.Number = 2
+	// google.protobuf.Any
+	fieldAnyTypeURL protowire.Number = 1
+	fieldAnyValue   protowire.Number = 2
+	// TransferContract and TriggerSmartContract
+	fieldOwnerAddress protowire.Number = 1
+	fieldToAddress    protowire.Number = 2
+	fieldAmount       protowire.Number = 3
+	fieldData         protowire.Number = 4
+)
+
+// SignatureLength is length of signature [R || S || V]
+const SignatureLength = 65
+
+// Contract is a contract of transaction
+//   - To is receiver for TransferContract and contract address for TriggerSmartContract
+//   - Amount is sun of TransferContract, Data is ABI encoded call data of TriggerSmartContract
+type Contract struct {
+	Type   ContractType
+	Owner  trxaddr.Address
+	To     trxaddr.Address
+	Amount int64
+	Data   []byte
+}
+
+// RawData is raw_data of transaction, transaction ID is sha256 hash of serialized RawData
+type RawData struct {
+	RefBlockBytes []byte
+	RefBlockHash  []byte
+	Expiration    int64
+	Timestamp     int64
+	FeeLimit      int64
+	Contract      Contract
+}
+
+// Serialize returns protobuf encoded raw data
+func (r *RawData) Serialize() ([]byte, error) {
+	typeURL, err := r.Contract.Type.typeURL()
+	if err != nil {
+		return nil, err
+	}
+
+	var param []byte
+	param = protowire.AppendTag(param, fieldOwnerAddress, protowire.BytesType)
+	param = protowire.AppendBytes(param, r.Contract.Owner.Bytes())
+	param = protowire.AppendTag(param, fieldToAddress, protowire.BytesType)
+	param = protowire.AppendBytes(param, r.Contract.To.Bytes())
+	switch r.Contract.Type {
+	case ContractTypeTransfer:
+		param = protowire.AppendTag(param, fieldAmount, protowire.VarintType)
+		param = protowire.AppendVarint(param, uint64(r.Contract.Amount))
+	case ContractTypeTriggerSmartContract:
+		param = protowire.AppendTag(param, fieldData, protowire.BytesType)
+		param = protowire.AppendBytes(param, r.Contract.Data)
+	}
+
+	var anyValue []byte
+	anyValue = protowire.AppendTag(anyValue, fieldAnyTypeURL, protowire.BytesType)
+	anyValue = protowire.AppendString(anyValue, typeURL)
+	anyValue = protowire.AppendTag(anyValue, fieldAnyValue, protowire.BytesType)
+	anyValue = protowire.AppendBytes(anyValue, param)
+
+	var contract []byte
+	contract = protowire.AppendTag(contract, fieldContractType, protowire.VarintType)
+	contract = protowire.AppendVarint(contract, uint64(r.Contract.Type))
+	contract = protowire.AppendTag(contract, fieldContractParameter, protowire.BytesType)
+	contract = protowire.AppendBytes(contract, anyValue)
+
+	var raw []byte
+	raw = protowire.AppendTag(raw, fieldRawRefBlockBytes, protowire.BytesType)
+	raw = protowire.AppendBytes(raw, r.RefBlockBytes)
+	raw = protowire.AppendTag(raw, fieldRawRefBlockHash, protowire.BytesType)
+	raw = protowire.AppendBytes(raw, r.RefBlockHash)
+	raw = protowire.AppendTag(raw, fieldRawExpiration, protowire.VarintType)
+	raw = protowire.AppendVarint(raw, uint64(r.Expiration))
+	raw = protowire.AppendTag(raw, fieldRawContract, protowire.BytesType)
+	raw = protowire.AppendBytes(raw, contract)
+	raw = protowire.AppendTag(raw, fieldRawTimestamp, protowire.VarintType)
+	raw = protowire.AppendVarint(raw, uint64(r.Timestamp))
+	if r.FeeLimit != 0 {
+		raw = protowire.AppendTag(raw, fieldRawFeeLimit, protowire.VarintType)
+		raw = protowire.AppendVarint(raw, uint64(r.FeeLimit))
+	}
+	return raw, nil
+}
+
+// ParseRawData decodes protobuf encoded raw data
+func ParseRawData(b []byte) (*RawData, error) {
+	raw := &RawData{}
+	var contract []byte
+	err := consumeFields(b, func(num protowire.Number, v []byte, n uint64) error {
+		switch num {
+		case fieldRawRefBlockBytes:
+			raw.RefBlockBytes = v
+		case fieldRawRefBlockHash:
+			raw.RefBlockHash = v
+		case fieldRawExpiration:
+			raw.Expiration = int64(n)
+		case fieldRawContract:
+			if contract != nil {
+				return errors.New("multiple contracts are not supported")
+			}
+			contract = v
+		case fieldRawTimestamp:
+			raw.Timestamp = int64(n)
+		case fieldRawFeeLimit:
+			raw.FeeLimit = int64(n)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode raw data: %w", err)
+	}
+	if contract == nil {
+		return nil, errors.New("contract is not found in raw data")
+	}
+	if err = raw.Contract.parse(contract); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// parse decodes Transaction.Contract
+func (c *Contract) parse(b []byte) error {
+	var anyValue, param []byte
+	err := consumeFields(b, func(num protowire.Number, v []byte, n uint64) error {
+		switch num {
+		case fieldContractType:
+			c.Type = ContractType(n)
+		case fieldContractParameter:
+			anyValue = v
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("fail to decode contract: %w", err)
+	}
+	if _, err = c.Type.typeURL(); err != nil {
+		return err
+	}
+	err = consumeFields(anyValue, func(num protowire.Number, v []byte, _ uint64) error {
+		if num == fieldAnyValue {
+			param = v
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("fail to decode contract parameter: %w", err)
+	}
+
+	return consumeFields(param, func(num protowire.Number, v []byte, n uint64) error {
+		var err error
+		switch num {
+		case fieldOwnerAddress:
+			c.Owner, err = trxaddr.AddressFromBytes(v)
+		case fieldToAddress:
+			c.To, err = trxaddr.AddressFromBytes(v)
+		case fieldAmount:
+			c.Amount = int64(n)
+		case fieldData:
+			c.Data = v
+		}
+		return err
+	})
+}
+
+// consumeFields calls fn for each field, v is set for bytes type and n is set for varint type
+func consumeFields(b []byte, fn func(num protowire.Number, v []byte, n uint64) error) error {
+	for len(b) > 0 {
+		num, typ, l := protowire.ConsumeTag(b)
+		if l < 0 {
+			return protowire.ParseError(l)
+		}
+		b = b[l:]
+
+		var (
+			v []byte
+			n uint64
+		)
+		switch typ {
+		case protowire.BytesType:
+			v, l = protowire.ConsumeBytes(b)
+		case protowire.VarintType:
+			n, l = protowire.ConsumeVarint(b)
+		default:
+			l = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if l < 0 {
+			return protowire.ParseError(l)
+		}
+		b = b[l:]
+		if err := fn(num, v, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Transaction is signed or unsigned transaction
+//   - RawData keeps serialized bytes as is because transaction ID is calculated from them
+type Transaction struct {
+	RawData    []byte
+	Signatures [][]byte
+}
+
+// NewTransaction creates unsigned transaction
+func NewTransaction(raw *RawData) (*Transaction, error) {
+	b, err := raw.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return &Transaction{RawData: b}, nil
+}
+
+// TransactionFromHex decodes hex encoded transaction
+func TransactionFromHex(s string) (*Transaction, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode hex transaction: %w", err)
+	}
+	tx := &Transaction{}
+	err = consumeFields(b, func(num protowire.Number, v []byte, _ uint64) error {
+		switch num {
+		case fieldTxRawData:
+			tx.RawData = v
+		case fieldTxSignature:
+			tx.Signatures = append(tx.Signatures, v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to decode transaction: %w", err)
+	}
+	if len(tx.RawData) == 0 {
+		return nil, errors.New("raw data is not found in transaction")
+	}
+	return tx, nil
+}
+
+// Serialize returns protobuf encoded transaction
+func (t *Transaction) Serialize() []byte {
+	var b []byte
+	b = protowire.AppendTag(b, fieldTxRawData, protowire.BytesType)
+	b = protowire.AppendBytes(b, t.RawData)
+	for _, sig := range t.Signatures {
+		b = protowire.AppendTag(b, fieldTxSignature, protowire.BytesType)
+		b = protowire.AppendBytes(b, sig)
+	}
+	return b
+}
+
+// ToHex returns hex encoded transaction which is accepted by broadcasthex API
+func (t *Transaction) ToHex() string {
+	return hex.EncodeToString(t.Serialize())
+}
+
+// Hash returns sha256 hash of raw data which is signed
+func (t *Transaction) Hash() []byte {
+	h := sha256.Sum256(t.RawData)
+	return h[:]
+}
+
+// ID returns transaction ID
+func (t *Transaction) ID() string {
+	return hex.EncodeToString(t.Hash())
+}
+
+// Raw returns decoded raw data
+func (t *Transaction) Raw() (*RawData, error) {
+	return ParseRawData(t.RawData)
+}
+
+// Sign appends signature of private key
+func (t *Transaction) Sign(privKey *ecdsa.PrivateKey) error {
+	sig, err := crypto.Sign(t.Hash(), privKey)
+	if err != nil {
+		return fmt.Errorf("fail to call crypto.Sign(): %w", err)
+	}
+	t.Signatures = append(t.Signatures, sig)
+	return nil
+}
+
+// IsSignedBy returns true if transaction has signature of address
+func (t *Transaction) IsSignedBy(addr trxaddr.Address) bool {
+	hash := t.Hash()
+	for _, sig := range t.Signatures {
+		if len(sig) != SignatureLength {
+			continue
+		}
+		pubKey, err := crypto.SigToPub(hash, sig)
+		if err != nil {
+			continue
+		}
+		if trxaddr.PubkeyToAddress(pubKey) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// bandwidthSize returns bytes consumed as bandwidth, it includes signature and max size of result
+//   - java-tron adds MAX_RESULT_SIZE_IN_TX(64) to serialized size of signed transaction
+func (t *Transaction) bandwidthSize() uint64 {
+	const maxResultSize = 64
+	signed := &Transaction{
+		RawData:    t.RawData,
+		Signatures: [][]byte{make([]byte, SignatureLength)},
+	}
+	return uint64(len(signed.Serialize())) + maxResultSize
+}
@@ -0,0 +1,126 @@
+package trx_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron/trx"
+	trxaddr "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address/trx"
+)
+
+// newKey returns deterministic key and address from seed text
+func newKey(t *testing.T, seed string) (trxaddr.Address, *ecdsa.PrivateKey) {
+	t.Helper()
+	h := sha256.Sum256([]byte(seed))
+	privKey, err := crypto.ToECDSA(h[:])
+	require.NoError(t, err)
+	return trxaddr.PubkeyToAddress(&privKey.PublicKey), privKey
+}
+
+// TestAddress tests base58check and hex encoding
+func TestAddress(t *testing.T) {
+	// private key 1
+	privKey, err := crypto.HexToECDSA("0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	addr := trxaddr.PubkeyToAddress(&privKey.PublicKey)
+	assert.Equal(t, "417e5f4552091a69125d5dfcb7b8c2659029395bdf", addr.Hex())
+	assert.Equal(t, "TMVQGm1qAQYVdetCeGRRkTWYYrLXuHK2HC", addr.String())
+
+	decoded, err := trxaddr.AddressFromBase58(addr.String())
+	require.NoError(t, err)
+	assert.Equal(t, addr, decoded)
+	decoded, err = trxaddr.AddressFromHex(addr.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, addr, decoded)
+
+	// bitcoin and ripple addresses are rejected
+	_, err = trxaddr.AddressFromBase58("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2")
+	assert.ErrorIs(t, err, trxaddr.ErrInvalidAddress)
+	_, err = trxaddr.AddressFromBase58("rPEPPER7kfTD9w2To4CQk6UCfuHM9c6GDY")
+	assert.ErrorIs(t, err, trxaddr.ErrInvalidAddress)
+	_, err = trxaddr.AddressFromHex("7e5f4552091a69125d5dfcb7b8c2659029395bdf")
+	assert.ErrorIs(t, err, trxaddr.ErrInvalidAddress)
+}
+
+// TestTransaction tests protobuf encoding, transaction ID and signature
+func TestTransaction(t *testing.T) {
+	owner, ownerKey := newKey(t, "owner")
+	to, _ := newKey(t, "to")
+	contract, _ := newKey(t, "contract")
+
+	tests := []struct {
+		name string
+		raw  *trx.RawData
+	}{
+		{
+			name: "transfer contract",
+			raw: &trx.RawData{
+				RefBlockBytes: []byte{0x01, 0x02},
+				RefBlockHash:  []byte{1, 2, 3, 4, 5, 6, 7, 8},
+				Expiration:    1_700_000_060_000,
+				Timestamp:     1_700_000_000_000,
+				Contract: trx.Contract{
+					Type: trx.ContractTypeTransfer, Owner: owner, To: to, Amount: 1_000_000,
+				},
+			},
+		},
+		{
+			name: "trigger smart contract",
+			raw: &trx.RawData{
+				RefBlockBytes: []byte{0x01, 0x02},
+				RefBlockHash:  []byte{1, 2, 3, 4, 5, 6, 7, 8},
+				Expiration:    1_700_000_060_000,
+				Timestamp:     1_700_000_000_000,
+				FeeLimit:      30_000_000,
+				Contract: trx.Contract{
+					Type: trx.ContractTypeTriggerSmartContract, Owner: owner, To: contract, Data: []byte{0xa9, 0x05},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := trx.NewTransaction(tt.raw)
+			require.NoError(t, err)
+			id := sha256.Sum256(tx.RawData)
+			assert.Equal(t, hex.EncodeToString(id[:]), tx.ID())
+
+			decoded, err := tx.Raw()
+			require.NoError(t, err)
+			assert.Equal(t, tt.raw, decoded)
+
+			// sign and decode
+			assert.False(t, tx.IsSignedBy(owner))
+			require.NoError(t, tx.Sign(ownerKey))
+			signed, err := trx.TransactionFromHex(tx.ToHex())
+			require.NoError(t, err)
+			assert.Equal(t, tx.ID(), signed.ID())
+			require.Len(t, signed.Signatures, 1)
+			assert.Len(t, signed.Signatures[0], trx.SignatureLength)
+			assert.True(t, signed.IsSignedBy(owner))
+			assert.False(t, signed.IsSignedBy(to))
+		})
+	}
+
+	// transfer contract of java-tron encoding, field order is same as protoc
+	raw := &trx.RawData{
+		RefBlockBytes: []byte{0xab, 0xcd},
+		RefBlockHash:  []byte{0, 0, 0, 0, 0, 0, 0, 1},
+		Expiration:    1,
+		Timestamp:     2,
+		Contract:      trx.Contract{Type: trx.ContractTypeTransfer, Owner: owner, To: to, Amount: 3},
+	}
+	b, err := raw.Serialize()
+	require.NoError(t, err)
+	assert.Equal(t, "0a02abcd"+"22080000000000000001"+"4001"+
+		"5a65"+"0801"+"1261"+
+		"0a2d"+hex.EncodeToString([]byte("type.googleapis.com/protocol.TransferContract"))+
+		"1230"+"0a15"+owner.Hex()+"1215"+to.Hex()+"1803"+
+		"7002", hex.EncodeToString(b))
+}
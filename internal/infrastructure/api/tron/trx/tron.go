@@ -0,0 +1,136 @@
+package trx
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	trxaddr "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address/trx"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/uuid"
+)
+
+// TRXDecimals is decimals of TRX, 1 TRX = 1,000,000 sun
+const TRXDecimals uint8 = 6
+
+// defaultExpiration is used when expiration is not defined in config,
+// it must be long enough to sign transaction offline
+const defaultExpiration = 12 * time.Hour
+
+// defaultFeeLimit is max TRX burned for energy of TRC-20 transfer when fee_limit is not defined in config
+const defaultFeeLimit = 30
+
+// NetworkTypeTRX is network type
+type NetworkTypeTRX string
+
+// network type
+const (
+	NetworkTypeMainNet NetworkTypeTRX = "mainnet"
+	NetworkTypeShasta  NetworkTypeTRX = "shasta"
+	NetworkTypeNile    NetworkTypeTRX = "nile"
+)
+
+// String converter
+func (n NetworkTypeTRX) String() string {
+	return string(n)
+}
+
+// Tron includes client to call HTTP API of full node
+//   - httpClient is nil for keygen wallet because transaction is signed offline
+//   - contract is zero value when TRX is sent, otherwise TRC-20 token of contract is sent
+type Tron struct {
+	httpClient    *http.Client
+	fullNodeURL   string
+	apiKey        string
+	chainConf     *chaincfg.Params
+	coinTypeCode  domainCoin.CoinTypeCode
+	uuidHandler   uuid.UUIDHandler
+	expiration    time.Duration
+	feeLimit      uint64
+	contract      trxaddr.Address
+	tokenDecimals uint8
+	isToken       bool
+}
+
+// NewTron creates Tron object, no API is called here because keygen wallet is offline
+func NewTron(
+	httpClient *http.Client,
+	coinTypeCode domainCoin.CoinTypeCode,
+	conf *config.Tron,
+	uuidHandler uuid.UUIDHandler,
+) (*Tron, error) {
+	trx := &Tron{
+		httpClient:    httpClient,
+		fullNodeURL:   strings.TrimSuffix(conf.FullNodeURL, "/"),
+		apiKey:        conf.APIKey,
+		coinTypeCode:  coinTypeCode,
+		uuidHandler:   uuidHandler,
+		expiration:    conf.Expiration,
+		feeLimit:      uint64(math.Round(conf.FeeLimit * math.Pow10(int(TRXDecimals)))),
+		tokenDecimals: TRXDecimals,
+	}
+	if trx.expiration == 0 {
+		trx.expiration = defaultExpiration
+	}
+	if trx.feeLimit == 0 {
+		trx.feeLimit = defaultFeeLimit * uint64(math.Pow10(int(TRXDecimals)))
+	}
+
+	if NetworkTypeTRX(conf.NetworkType) == NetworkTypeMainNet {
+		trx.chainConf = &chaincfg.MainNetParams
+	} else {
+		trx.chainConf = &chaincfg.TestNet3Params
+	}
+
+	// TRC-20 token
+	if conf.TRC20Token != "" {
+		token, ok := conf.TRC20s[conf.TRC20Token]
+		if !ok {
+			return nil, fmt.Errorf("trc20 token information for [%s] is not found", conf.TRC20Token)
+		}
+		contract, err := trxaddr.AddressFromBase58(token.ContractAddress)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call AddressFromBase58(contract_address): %w", err)
+		}
+		trx.contract = contract
+		trx.tokenDecimals = token.Decimals
+		trx.isToken = true
+	}
+
+	return trx, nil
+}
+
+// Close disconnect to server
+func (t *Tron) Close() {
+	if t.httpClient != nil {
+		t.httpClient.CloseIdleConnections()
+	}
+}
+
+// CoinTypeCode returns coinTypeCode
+func (t *Tron) CoinTypeCode() domainCoin.CoinTypeCode {
+	return t.coinTypeCode
+}
+
+// GetChainConf returns chain conf
+func (t *Tron) GetChainConf() *chaincfg.Params {
+	return t.chainConf
+}
+
+// ContractAddress returns address of TRC-20 contract, empty string is returned for TRX
+func (t *Tron) ContractAddress() string {
+	if !t.isToken {
+		return ""
+	}
+	return t.contract.String()
+}
+
+// FeeLimit returns max sun burned for energy of TRC-20 transfer
+func (t *Tron) FeeLimit() uint64 {
+	return t.feeLimit
+}
@@ -0,0 +1,146 @@
+package trx
+
+import (
+	"encoding/hex"
+	"errors"
+)
+
+// sentinel errors which are skipped by deposit
+var (
+	// ErrAccountNotActivated means account doesn't exist on chain because it has never received TRX
+	ErrAccountNotActivated = errors.New("account is not activated")
+	// ErrInsufficientFee means sender doesn't have enough TRX to pay bandwidth or energy
+	ErrInsufficientFee = errors.New("trx balance is insufficient to pay fee")
+)
+
+// chain parameters
+const (
+	ChainParamTransactionFee                   = "getTransactionFee"
+	ChainParamEnergyFee                        = "getEnergyFee"
+	ChainParamCreateAccountFee                 = "getCreateAccountFee"
+	ChainParamCreateNewAccountFeeInSysContract = "getCreateNewAccountFeeInSystemContract"
+)
+
+// BlockHeaderRawData is raw_data of block header
+type BlockHeaderRawData struct {
+	Number    uint64 `json:"number"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// Block is response of getnowblock, transactions are not decoded
+type Block struct {
+	BlockID     string `json:"blockID"`
+	BlockHeader struct {
+		RawData BlockHeaderRawData `json:"raw_data"`
+	} `json:"block_header"`
+}
+
+// Number returns block number
+func (b *Block) Number() uint64 {
+	return b.BlockHeader.RawData.Number
+}
+
+// RefBlock returns ref_block_bytes and ref_block_hash of transaction which refers this block
+//   - ref_block_bytes is 7-8th bytes of block number, ref_block_hash is 9-16th bytes of block ID
+func (b *Block) RefBlock() ([]byte, []byte, error) {
+	id, err := hex.DecodeString(b.BlockID)
+	if err != nil || len(id) != 32 {
+		return nil, nil, errors.New("invalid block ID")
+	}
+	return id[6:8], id[8:16], nil
+}
+
+// Account is response of getaccount, Address is empty if account is not activated
+type Account struct {
+	Address string `json:"address"`
+	Balance uint64 `json:"balance"`
+}
+
+// IsActivated returns true if account exists on chain
+func (a *Account) IsActivated() bool {
+	return a.Address != ""
+}
+
+// AccountResource is response of getaccountresource
+type AccountResource struct {
+	FreeNetUsed  uint64 `json:"freeNetUsed"`
+	FreeNetLimit uint64 `json:"freeNetLimit"`
+	NetUsed      uint64 `json:"NetUsed"`
+	NetLimit     uint64 `json:"NetLimit"`
+	EnergyUsed   uint64 `json:"EnergyUsed"`
+	EnergyLimit  uint64 `json:"EnergyLimit"`
+}
+
+// availableEnergy returns energy which is not used
+func (r *AccountResource) availableEnergy() uint64 {
+	if r.EnergyLimit <= r.EnergyUsed {
+		return 0
+	}
+	return r.EnergyLimit - r.EnergyUsed
+}
+
+// ChainParameter is key value of chain parameter
+type ChainParameter struct {
+	Key   string `json:"key"`
+	Value int64  `json:"value"`
+}
+
+// ResponseGetChainParameters is response of getchainparameters
+type ResponseGetChainParameters struct {
+	ChainParameter []ChainParameter `json:"chainParameter"`
+}
+
+// ReturnResult is result of API call
+//   - Message is hex encoded text in some APIs
+type ReturnResult struct {
+	Result  bool   `json:"result"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ErrorMessage returns decoded message
+func (r *ReturnResult) ErrorMessage() string {
+	if b, err := hex.DecodeString(r.Message); err == nil {
+		return string(b)
+	}
+	return r.Message
+}
+
+// ResponseTriggerConstantContract is response of triggerconstantcontract
+type ResponseTriggerConstantContract struct {
+	Result         ReturnResult `json:"result"`
+	EnergyUsed     uint64       `json:"energy_used"`
+	ConstantResult []string     `json:"constant_result"`
+}
+
+// ResponseBroadcast is response of broadcasthex
+type ResponseBroadcast struct {
+	ReturnResult
+	TxID string `json:"txid"`
+}
+
+// TransactionInfo is response of gettransactioninfobyid, ID is empty if transaction is not found yet
+//   - Result is `FAILED` when transaction failed, contract execution result is in Receipt.Result
+type TransactionInfo struct {
+	ID          string `json:"id"`
+	Fee         uint64 `json:"fee"`
+	BlockNumber uint64 `json:"blockNumber"`
+	Result      string `json:"result"`
+	Receipt     struct {
+		Result string `json:"result"`
+	} `json:"receipt"`
+}
+
+// IsFailed returns true if transaction was processed with error
+func (i *TransactionInfo) IsFailed() bool {
+	if i.Result == "FAILED" {
+		return true
+	}
+	return i.Receipt.Result != "" && i.Receipt.Result != "SUCCESS"
+}
+
+// UserAmount is used for GetTotalBalance
+type UserAmount struct {
+	Address string
+	Amount  uint64
+}
@@ -0,0 +1,48 @@
+package trx
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	trxaddr "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address/trx"
+)
+
+// ValidateAddr validates address
+func (*Tron) ValidateAddr(addr string) error {
+	if _, err := trxaddr.AddressFromBase58(addr); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Decimals returns decimals of sent coin, TRC-20 token has its own decimals
+func (t *Tron) Decimals() uint8 {
+	return t.tokenDecimals
+}
+
+// FloatToAmount converts float value to smallest unit (sun or base unit of token)
+func (t *Tron) FloatToAmount(v float64) uint64 {
+	return uint64(math.Round(v * math.Pow10(int(t.tokenDecimals))))
+}
+
+// AmountToFloat converts smallest unit to float value
+func (t *Tron) AmountToFloat(v uint64) float64 {
+	return float64(v) / math.Pow10(int(t.tokenDecimals))
+}
+
+// PrivateKeyFromHex decodes hex private key which is stored as WIF by keygen wallet
+func PrivateKeyFromHex(wif string) (*ecdsa.PrivateKey, error) {
+	b, err := hexutil.Decode(wif)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call hexutil.Decode(): %w", err)
+	}
+	privKey, err := crypto.ToECDSA(b)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call crypto.ToECDSA(): %w", err)
+	}
+	return privKey, nil
+}
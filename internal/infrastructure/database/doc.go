@@ -1,14 +1,20 @@
-// Package database provides database infrastructure for MySQL connections and query execution.
+// Package database provides database infrastructure for MySQL, PostgreSQL and SQLite connections and query execution.
 //
 // This package contains:
 //   - mysql/: MySQL connection management and configuration
-//   - sqlc/: Type-safe SQL query code generated by sqlc
+//   - postgres/: PostgreSQL connection management and configuration
+//   - sqlite/: encrypted SQLite database file for keygen and sign wallet
+//   - migration/: versioned schema migrations embedded into binary
+//   - sqlc/: Type-safe SQL query code generated by sqlc for MySQL
+//   - sqlcpg/: Type-safe SQL query code generated by sqlc for PostgreSQL
+//   - sqlclite/: Type-safe SQL query code generated by sqlc for SQLite
 //
 // The database package is responsible for:
 //   - Establishing and managing database connections
 //   - Executing SQL queries via sqlc-generated code
 //   - Managing database transactions
 //   - Connection pooling and configuration
+//   - Applying schema migrations
 //
 // The database package does NOT:
 //   - Contain business logic
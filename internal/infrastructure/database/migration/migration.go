@@ -0,0 +1,276 @@
+// Package migration applies versioned schema migrations embedded into binary.
+//
+// migrations are placed as `{driver}/{set}/{version}_{name}.up.sql`
+// and applied version is recorded in schema_version table.
+// sqlc reads the same files as schema, so table definitions are changed only by adding new migration.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+//go:embed mysql postgres sqlite
+var files embed.FS
+
+// Set is group of migrations for each database
+type Set string
+
+// Sets
+const (
+	// SetWatch is for database of watch wallet
+	SetWatch Set = "watch"
+	// SetCold is for database of keygen and sign wallet
+	SetCold Set = "cold"
+)
+
+var (
+	// ErrDatabaseAhead is returned when database is migrated by newer binary
+	ErrDatabaseAhead = errors.New("database schema is newer than this binary")
+	// ErrDirty is returned when previous migration failed in the middle
+	ErrDirty = errors.New("database schema is dirty")
+	// ErrPending is returned when migrations are not applied yet
+	ErrPending = errors.New("database schema is not up to date")
+)
+
+const createVersionTable = `CREATE TABLE IF NOT EXISTS schema_version (
+  version    BIGINT NOT NULL PRIMARY KEY,
+  name       VARCHAR(255) NOT NULL,
+  dirty      BOOLEAN NOT NULL DEFAULT false,
+  applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+)`
+
+// Migration is one up step
+type Migration struct {
+	Version int64
+	Name    string
+	up      string
+}
+
+// Status is applied state of database
+type Status struct {
+	Current int64
+	Latest  int64
+	Dirty   bool
+	Pending []Migration
+}
+
+// Migrator applies migrations
+type Migrator struct {
+	db         *sql.DB
+	driver     string
+	migrations []Migration
+}
+
+// NewMigrator returns Migrator for set of migrations of driver
+func NewMigrator(db *sql.DB, driver string, set Set) (*Migrator, error) {
+	if driver == "" {
+		driver = config.DriverMySQL
+	}
+	migrations, err := load(files, path.Join(driver, string(set)))
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{
+		db:         db,
+		driver:     driver,
+		migrations: migrations,
+	}, nil
+}
+
+// load reads `{version}_{name}.up.sql` files in dir ordered by version
+func load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("migrations are not found in %s: %w", dir, err)
+	}
+
+	migrations := make([]Migration, 0, len(entries))
+	versions := make(map[int64]string, len(entries))
+	for _, entry := range entries {
+		fileName := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(fileName, ".up.sql") {
+			continue
+		}
+		strVersion, name, ok := strings.Cut(strings.TrimSuffix(fileName, ".up.sql"), "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name: %s", fileName)
+		}
+		version, err := strconv.ParseInt(strVersion, 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid version of migration file: %s", fileName)
+		}
+		if dup, ok := versions[version]; ok {
+			return nil, fmt.Errorf("version %d is duplicated in %s and %s", version, dup, fileName)
+		}
+		versions[version] = fileName
+
+		up, err := fs.ReadFile(fsys, path.Join(dir, fileName))
+		if err != nil {
+			return nil, fmt.Errorf("fail to read %s: %w", fileName, err)
+		}
+		migrations = append(migrations, Migration{Version: version, Name: name, up: string(up)})
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// Latest returns latest version known by this binary
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Status returns applied version and pending migrations
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	if _, err := m.db.ExecContext(ctx, createVersionTable); err != nil {
+		return nil, fmt.Errorf("fail to create schema_version table: %w", err)
+	}
+
+	status := &Status{Latest: m.Latest()}
+	err := m.db.QueryRowContext(ctx,
+		"SELECT version, dirty FROM schema_version ORDER BY version DESC LIMIT 1",
+	).Scan(&status.Current, &status.Dirty)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("fail to get schema version: %w", err)
+	}
+
+	for _, migration := range m.migrations {
+		if migration.Version > status.Current {
+			status.Pending = append(status.Pending, migration)
+		}
+	}
+	return status, nil
+}
+
+// Check returns error when database can't be used by this binary as it is
+func (m *Migrator) Check(ctx context.Context) error {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if err = validate(status); err != nil {
+		return err
+	}
+	if len(status.Pending) != 0 {
+		return fmt.Errorf("%w: version %d, latest %d, run `migrate up`", ErrPending, status.Current, status.Latest)
+	}
+	return nil
+}
+
+// Up applies pending migrations in order and returns applied migrations
+//
+// nothing is applied when database is ahead of binary or dirty
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	status, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = validate(status); err != nil {
+		return nil, err
+	}
+
+	applied := make([]Migration, 0, len(status.Pending))
+	for _, migration := range status.Pending {
+		logger.Info("apply migration", "version", migration.Version, "name", migration.Name)
+		if err = m.apply(ctx, migration); err != nil {
+			return applied, fmt.Errorf("fail to apply migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		applied = append(applied, migration)
+	}
+	return applied, nil
+}
+
+func validate(status *Status) error {
+	if status.Dirty {
+		return fmt.Errorf("%w: migration of version %d failed, fix database manually and update schema_version",
+			ErrDirty, status.Current)
+	}
+	if status.Current > status.Latest {
+		return fmt.Errorf("%w: version %d, latest %d of binary", ErrDatabaseAhead, status.Current, status.Latest)
+	}
+	return nil
+}
+
+// apply runs one migration in transaction
+//
+// MySQL commits DDL implicitly, so version is recorded as dirty beforehand
+// to detect migration which failed in the middle
+func (m *Migrator) apply(ctx context.Context, migration Migration) (err error) {
+	transactionalDDL := m.driver != config.DriverMySQL
+	if !transactionalDDL {
+		if _, err = m.db.ExecContext(ctx,
+			m.rebind("INSERT INTO schema_version (version, name, dirty) VALUES (?, ?, ?)"),
+			migration.Version, migration.Name, true,
+		); err != nil {
+			return fmt.Errorf("fail to record schema version: %w", err)
+		}
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("fail to call db.BeginTx(): %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, stmt := range split(migration.up) {
+		if _, err = tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	if transactionalDDL {
+		_, err = tx.ExecContext(ctx,
+			m.rebind("INSERT INTO schema_version (version, name, dirty) VALUES (?, ?, ?)"),
+			migration.Version, migration.Name, false,
+		)
+	} else {
+		_, err = tx.ExecContext(ctx,
+			m.rebind("UPDATE schema_version SET dirty = ? WHERE version = ?"),
+			false, migration.Version,
+		)
+	}
+	if err != nil {
+		return fmt.Errorf("fail to record schema version: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// rebind replaces `?` placeholder with `$n` for PostgreSQL
+func (m *Migrator) rebind(query string) string {
+	if m.driver != config.DriverPostgres {
+		return query
+	}
+	var (
+		b strings.Builder
+		n int
+	)
+	for _, c := range query {
+		if c == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,177 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"testing/fstest"
+
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func newTestMigrator(t *testing.T, db *sql.DB, fsys fstest.MapFS) *Migrator {
+	t.Helper()
+	migrations, err := load(fsys, "sqlite/cold")
+	require.NoError(t, err)
+	return &Migrator{db: db, driver: config.DriverSQLite, migrations: migrations}
+}
+
+var testFiles = fstest.MapFS{
+	"sqlite/cold/0001_init.up.sql": {Data: []byte(`-- first
+CREATE TABLE item (id INTEGER PRIMARY KEY, name TEXT NOT NULL DEFAULT 'a;b');
+CREATE INDEX item_idx_name ON item (name);
+`)},
+	"sqlite/cold/0002_add_note.up.sql":   {Data: []byte(`ALTER TABLE item ADD COLUMN note TEXT;`)},
+	"sqlite/cold/0002_add_note.down.sql": {Data: []byte(`ALTER TABLE item DROP COLUMN note;`)},
+	"sqlite/cold/README.md":              {Data: []byte(`ignored`)},
+}
+
+func TestMigratorUp(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	m := newTestMigrator(t, db, testFiles)
+	assert.Equal(t, int64(2), m.Latest())
+
+	require.ErrorIs(t, m.Check(ctx), ErrPending)
+
+	applied, err := m.Up(ctx)
+	require.NoError(t, err)
+	require.Len(t, applied, 2)
+	assert.Equal(t, "init", applied[0].Name)
+	assert.Equal(t, "add_note", applied[1].Name)
+
+	_, err = db.ExecContext(ctx, "INSERT INTO item (id, note) VALUES (1, 'x')")
+	require.NoError(t, err)
+	require.NoError(t, m.Check(ctx))
+
+	// nothing to apply
+	applied, err = m.Up(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+
+	t.Run("database is ahead of binary", func(t *testing.T) {
+		older := newTestMigrator(t, db, fstest.MapFS{
+			"sqlite/cold/0001_init.up.sql": testFiles["sqlite/cold/0001_init.up.sql"],
+		})
+		_, err := older.Up(ctx)
+		require.ErrorIs(t, err, ErrDatabaseAhead)
+		require.ErrorIs(t, older.Check(ctx), ErrDatabaseAhead)
+	})
+}
+
+func TestMigratorUpFailure(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	m := newTestMigrator(t, db, fstest.MapFS{
+		"sqlite/cold/0001_init.up.sql":   testFiles["sqlite/cold/0001_init.up.sql"],
+		"sqlite/cold/0002_broken.up.sql": {Data: []byte(`ALTER TABLE item ADD COLUMN note TEXT; ALTER TABLE unknown ADD COLUMN x;`)},
+	})
+
+	applied, err := m.Up(ctx)
+	require.Error(t, err)
+	require.Len(t, applied, 1)
+
+	// failed migration is rolled back as a whole
+	status, err := m.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), status.Current)
+	assert.False(t, status.Dirty)
+	_, err = db.ExecContext(ctx, "INSERT INTO item (id, note) VALUES (1, 'x')")
+	require.Error(t, err)
+
+	t.Run("dirty database", func(t *testing.T) {
+		_, err := db.ExecContext(ctx, "UPDATE schema_version SET dirty = true WHERE version = 1")
+		require.NoError(t, err)
+		_, err = m.Up(ctx)
+		require.ErrorIs(t, err, ErrDirty)
+	})
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name  string
+		files fstest.MapFS
+	}{
+		{
+			name:  "duplicated version",
+			files: fstest.MapFS{"d/1_a.up.sql": {}, "d/0001_b.up.sql": {}},
+		},
+		{
+			name:  "no version",
+			files: fstest.MapFS{"d/init.up.sql": {}},
+		},
+		{
+			name:  "invalid version",
+			files: fstest.MapFS{"d/v1_init.up.sql": {}},
+		},
+		{
+			name:  "no directory",
+			files: fstest.MapFS{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := load(tt.files, "d")
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	for _, driver := range []string{config.DriverMySQL, config.DriverPostgres, config.DriverSQLite} {
+		for _, set := range []Set{SetWatch, SetCold} {
+			if driver == config.DriverSQLite && set == SetWatch {
+				continue
+			}
+			m, err := NewMigrator(nil, driver, set)
+			require.NoError(t, err, driver, set)
+			assert.Positive(t, m.Latest(), driver, set)
+		}
+	}
+
+	// SQLite migrations can be applied without server
+	ctx := context.Background()
+	m, err := NewMigrator(newTestDB(t), config.DriverSQLite, SetCold)
+	require.NoError(t, err)
+	_, err = m.Up(ctx)
+	require.NoError(t, err)
+	require.NoError(t, m.Check(ctx))
+}
+
+func TestSplit(t *testing.T) {
+	got := split(`-- comment; not end
+CREATE TABLE a (
+  name VARCHAR(10) DEFAULT 'x;y' COMMENT 'it''s; fine', /* c; */
+  ` + "`semi;colon`" + ` INT
+);
+
+INSERT INTO a VALUES ("q;")
+;
+-- trailing comment;
+`)
+	require.Len(t, got, 2)
+	assert.Contains(t, got[0], "CREATE TABLE a")
+	assert.Contains(t, got[0], "`semi;colon` INT")
+	assert.Equal(t, `INSERT INTO a VALUES ("q;")`, got[1])
+}
+
+func TestRebind(t *testing.T) {
+	m := &Migrator{driver: config.DriverPostgres}
+	assert.Equal(t, "UPDATE t SET a = $1 WHERE b = $2", m.rebind("UPDATE t SET a = ? WHERE b = ?"))
+	m = &Migrator{driver: config.DriverMySQL}
+	assert.Equal(t, "UPDATE t SET a = ?", m.rebind("UPDATE t SET a = ?"))
+}
@@ -0,0 +1,6 @@
+-- add ltc to coin type code
+
+ALTER TABLE `seed` MODIFY `coin` ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc') NOT NULL COMMENT 'coin type code';
+ALTER TABLE `account_key` MODIFY `coin` ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc') NOT NULL COMMENT 'coin type code';
+ALTER TABLE `auth_fullpubkey` MODIFY `coin` ENUM('btc', 'bch', 'ltc') NOT NULL COMMENT 'coin type code';
+ALTER TABLE `auth_account_key` MODIFY `coin` ENUM('btc', 'bch', 'ltc') NOT NULL COMMENT 'coin type code';
@@ -0,0 +1,6 @@
+-- add doge to coin type code
+
+ALTER TABLE `seed` MODIFY `coin` ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge') NOT NULL COMMENT 'coin type code';
+ALTER TABLE `account_key` MODIFY `coin` ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge') NOT NULL COMMENT 'coin type code';
+ALTER TABLE `auth_fullpubkey` MODIFY `coin` ENUM('btc', 'bch', 'ltc', 'doge') NOT NULL COMMENT 'coin type code';
+ALTER TABLE `auth_account_key` MODIFY `coin` ENUM('btc', 'bch', 'ltc', 'doge') NOT NULL COMMENT 'coin type code';
@@ -0,0 +1,4 @@
+-- add sol to coin type code
+
+ALTER TABLE `seed` MODIFY `coin` ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol') NOT NULL COMMENT 'coin type code';
+ALTER TABLE `account_key` MODIFY `coin` ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol') NOT NULL COMMENT 'coin type code';
@@ -0,0 +1,4 @@
+-- add trx to coin type code
+
+ALTER TABLE `seed` MODIFY `coin` ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx') NOT NULL COMMENT 'coin type code';
+ALTER TABLE `account_key` MODIFY `coin` ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx') NOT NULL COMMENT 'coin type code';
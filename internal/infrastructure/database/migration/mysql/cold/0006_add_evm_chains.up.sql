@@ -0,0 +1,4 @@
+-- add EVM chains to coin type code
+
+ALTER TABLE `seed` MODIFY `coin` ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx', 'pol', 'bnb', 'arb', 'base') NOT NULL COMMENT 'coin type code';
+ALTER TABLE `account_key` MODIFY `coin` ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx', 'pol', 'bnb', 'arb', 'base') NOT NULL COMMENT 'coin type code';
@@ -0,0 +1,5 @@
+-- add eth and xrp to coin type code of auth tables and auth accounts to xrp_account_key for multisigning
+
+ALTER TABLE `auth_fullpubkey` MODIFY `coin` ENUM('btc', 'bch', 'ltc', 'doge', 'eth', 'xrp') NOT NULL COMMENT 'coin type code';
+ALTER TABLE `auth_account_key` MODIFY `coin` ENUM('btc', 'bch', 'ltc', 'doge', 'eth', 'xrp') NOT NULL COMMENT 'coin type code';
+ALTER TABLE `xrp_account_key` MODIFY `account` ENUM('client', 'deposit', 'payment', 'stored', 'auth1', 'auth2', 'auth3', 'auth4', 'auth5', 'auth6', 'auth7', 'auth8', 'auth9', 'auth10', 'auth11', 'auth12', 'auth13', 'auth14', 'auth15') NOT NULL COMMENT 'account type';
@@ -0,0 +1,9 @@
+-- regular key pair of xrp account which signs transactions instead of master key
+-- pending regular key is activated once SetRegularKey transaction is validated
+
+ALTER TABLE `xrp_account_key`
+  ADD COLUMN `active_key` tinyint(2) DEFAULT 0 NOT NULL COMMENT'key to sign transaction, 0: master key, 1: regular key',
+  ADD COLUMN `regular_key_account_id` VARCHAR(255) NOT NULL DEFAULT '' COMMENT'account_id of regular key pair',
+  ADD COLUMN `regular_key_seed` VARCHAR(255) NOT NULL DEFAULT '' COMMENT'seed of regular key pair',
+  ADD COLUMN `pending_regular_key_account_id` VARCHAR(255) NOT NULL DEFAULT '' COMMENT'account_id of regular key pair waiting for SetRegularKey',
+  ADD COLUMN `pending_regular_key_seed` VARCHAR(255) NOT NULL DEFAULT '' COMMENT'seed of regular key pair waiting for SetRegularKey';
@@ -0,0 +1,6 @@
+-- Watch database: block of confirmed BTC transaction to detect chain reorganization
+
+ALTER TABLE btc_tx
+  ADD COLUMN block_hash VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'hash of block including confirmed transaction',
+  ADD COLUMN block_height BIGINT NOT NULL DEFAULT 0 COMMENT 'height of block including confirmed transaction',
+  ADD INDEX idx_block_height (block_height);
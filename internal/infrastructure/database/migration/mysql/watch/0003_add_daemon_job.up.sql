@@ -0,0 +1,14 @@
+-- Watch database: last run status of watch daemon jobs
+
+CREATE TABLE IF NOT EXISTS daemon_job (
+  id               BIGINT NOT NULL AUTO_INCREMENT COMMENT 'ID',
+  coin             ENUM('btc', 'bch', 'eth', 'xrp', 'hyt') NOT NULL COMMENT 'coin type code',
+  name             VARCHAR(64) NOT NULL COMMENT 'job name',
+  last_status      ENUM('running', 'success', 'failure', 'skipped') NOT NULL COMMENT 'status of last run',
+  last_error       TEXT NOT NULL COMMENT 'error message of last run',
+  last_started_at  DATETIME DEFAULT NULL COMMENT 'started date of last run',
+  last_finished_at DATETIME DEFAULT NULL COMMENT 'finished date of last run',
+  updated_at       DATETIME DEFAULT CURRENT_TIMESTAMP COMMENT 'updated date',
+  PRIMARY KEY (id),
+  UNIQUE KEY idx_coin_name (coin, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='table for last run status of watch daemon jobs';
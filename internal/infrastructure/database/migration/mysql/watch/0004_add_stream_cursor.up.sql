@@ -0,0 +1,11 @@
+-- Watch database: last processed position of stream monitor
+
+CREATE TABLE IF NOT EXISTS stream_cursor (
+  id           BIGINT NOT NULL AUTO_INCREMENT COMMENT 'ID',
+  coin         ENUM('btc', 'bch', 'eth', 'xrp', 'hyt') NOT NULL COMMENT 'coin type code',
+  name         VARCHAR(64) NOT NULL COMMENT 'stream name',
+  position     BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT 'last processed ledger index or block height',
+  updated_at   DATETIME DEFAULT CURRENT_TIMESTAMP COMMENT 'updated date',
+  PRIMARY KEY (id),
+  UNIQUE KEY idx_coin_name (coin, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='table for last processed position of stream monitor';
@@ -0,0 +1,7 @@
+-- add ltc to coin type code
+
+ALTER TABLE btc_tx MODIFY coin ENUM('btc', 'bch', 'ltc') NOT NULL COMMENT 'coin type code';
+ALTER TABLE payment_request MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'ltc') NOT NULL COMMENT 'coin type code';
+ALTER TABLE address MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc') NOT NULL COMMENT 'coin type code';
+ALTER TABLE daemon_job MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc') NOT NULL COMMENT 'coin type code';
+ALTER TABLE stream_cursor MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc') NOT NULL COMMENT 'coin type code';
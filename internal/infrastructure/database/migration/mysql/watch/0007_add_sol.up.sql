@@ -0,0 +1,36 @@
+-- add sol to coin type code
+
+ALTER TABLE tx MODIFY coin ENUM('eth', 'xrp', 'hyt', 'sol') NOT NULL COMMENT 'coin type code';
+ALTER TABLE payment_request MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'ltc', 'doge', 'sol') NOT NULL COMMENT 'coin type code';
+ALTER TABLE address MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol') NOT NULL COMMENT 'coin type code';
+ALTER TABLE daemon_job MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol') NOT NULL COMMENT 'coin type code';
+ALTER TABLE stream_cursor MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol') NOT NULL COMMENT 'coin type code';
+
+-- Watch database: Solana transaction details
+
+CREATE TABLE IF NOT EXISTS sol_detail_tx (
+  id                  BIGINT NOT NULL AUTO_INCREMENT COMMENT 'ID',
+  tx_id               BIGINT NOT NULL COMMENT 'tx table ID',
+  uuid                VARCHAR(36) NOT NULL COMMENT 'UUID',
+  current_tx_type     TINYINT NOT NULL DEFAULT 1 COMMENT 'current transaction type',
+  sender_account      VARCHAR(255) NOT NULL COMMENT 'sender account',
+  sender_address      VARCHAR(255) NOT NULL COMMENT 'sender address',
+  receiver_account    VARCHAR(255) NOT NULL COMMENT 'receiver account',
+  receiver_address    VARCHAR(255) NOT NULL COMMENT 'receiver address',
+  amount              BIGINT UNSIGNED NOT NULL COMMENT 'amount of lamports or token base units to receive',
+  fee                 BIGINT UNSIGNED NOT NULL COMMENT 'fee in lamports',
+  token_mint          VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'SPL token mint address, empty for SOL',
+  nonce_account       VARCHAR(255) NOT NULL COMMENT 'durable nonce account',
+  nonce               VARCHAR(255) NOT NULL COMMENT 'durable nonce used as recent blockhash',
+  unsigned_tx         TEXT NOT NULL COMMENT 'base64 string for unsigned transaction',
+  signed_tx           TEXT NOT NULL DEFAULT '' COMMENT 'base64 string for signed transaction',
+  sent_signature      VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'signature of sent transaction',
+  unsigned_updated_at DATETIME DEFAULT CURRENT_TIMESTAMP COMMENT 'updated date for unsigned transaction created',
+  sent_updated_at     DATETIME DEFAULT NULL COMMENT 'updated date for signed transaction sent',
+  PRIMARY KEY (id),
+  UNIQUE KEY idx_uuid (uuid),
+  INDEX idx_txid (tx_id),
+  INDEX idx_sender_account (sender_account),
+  INDEX idx_receiver_account (receiver_account),
+  INDEX idx_sent_signature (sent_signature)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='table for sol transaction detail';
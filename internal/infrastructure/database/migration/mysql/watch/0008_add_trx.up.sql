@@ -0,0 +1,37 @@
+-- add trx to coin type code
+
+ALTER TABLE tx MODIFY coin ENUM('eth', 'xrp', 'hyt', 'sol', 'trx') NOT NULL COMMENT 'coin type code';
+ALTER TABLE payment_request MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'ltc', 'doge', 'sol', 'trx') NOT NULL COMMENT 'coin type code';
+ALTER TABLE address MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx') NOT NULL COMMENT 'coin type code';
+ALTER TABLE daemon_job MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx') NOT NULL COMMENT 'coin type code';
+ALTER TABLE stream_cursor MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx') NOT NULL COMMENT 'coin type code';
+
+-- Watch database: Tron transaction details
+
+CREATE TABLE IF NOT EXISTS trx_detail_tx (
+  id                  BIGINT NOT NULL AUTO_INCREMENT COMMENT 'ID',
+  tx_id               BIGINT NOT NULL COMMENT 'tx table ID',
+  uuid                VARCHAR(36) NOT NULL COMMENT 'UUID',
+  current_tx_type     TINYINT NOT NULL DEFAULT 1 COMMENT 'current transaction type',
+  sender_account      VARCHAR(255) NOT NULL COMMENT 'sender account',
+  sender_address      VARCHAR(255) NOT NULL COMMENT 'sender address',
+  receiver_account    VARCHAR(255) NOT NULL COMMENT 'receiver account',
+  receiver_address    VARCHAR(255) NOT NULL COMMENT 'receiver address',
+  amount              BIGINT UNSIGNED NOT NULL COMMENT 'amount of sun or token base units to receive',
+  fee                 BIGINT UNSIGNED NOT NULL COMMENT 'estimated fee in sun',
+  fee_limit           BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT 'max fee in sun for energy of TRC-20 transfer',
+  contract_address    VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'TRC-20 contract address, empty for TRX',
+  ref_block_num       BIGINT UNSIGNED NOT NULL COMMENT 'reference block number',
+  expiration          BIGINT NOT NULL COMMENT 'expiration of transaction in unix milliseconds',
+  unsigned_hex_tx     TEXT NOT NULL COMMENT 'HEX string for unsigned transaction',
+  signed_hex_tx       TEXT NOT NULL DEFAULT '' COMMENT 'HEX string for signed transaction',
+  sent_hash_tx        VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'Hash for sent transaction',
+  unsigned_updated_at DATETIME DEFAULT CURRENT_TIMESTAMP COMMENT 'updated date for unsigned transaction created',
+  sent_updated_at     DATETIME DEFAULT NULL COMMENT 'updated date for signed transaction sent',
+  PRIMARY KEY (id),
+  UNIQUE KEY idx_uuid (uuid),
+  INDEX idx_txid (tx_id),
+  INDEX idx_sender_account (sender_account),
+  INDEX idx_receiver_account (receiver_account),
+  INDEX idx_sent_hash_tx (sent_hash_tx)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='table for trx transaction detail';
@@ -0,0 +1,7 @@
+-- add EVM chains to coin type code
+
+ALTER TABLE tx MODIFY coin ENUM('eth', 'xrp', 'hyt', 'sol', 'trx', 'pol', 'bnb', 'arb', 'base') NOT NULL COMMENT 'coin type code';
+ALTER TABLE payment_request MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'ltc', 'doge', 'sol', 'trx', 'pol', 'bnb', 'arb', 'base') NOT NULL COMMENT 'coin type code';
+ALTER TABLE address MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx', 'pol', 'bnb', 'arb', 'base') NOT NULL COMMENT 'coin type code';
+ALTER TABLE daemon_job MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx', 'pol', 'bnb', 'arb', 'base') NOT NULL COMMENT 'coin type code';
+ALTER TABLE stream_cursor MODIFY coin ENUM('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx', 'pol', 'bnb', 'arb', 'base') NOT NULL COMMENT 'coin type code';
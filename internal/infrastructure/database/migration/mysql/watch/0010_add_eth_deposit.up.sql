@@ -0,0 +1,25 @@
+-- Watch database: deposits into client addresses detected by scanning EVM chain
+
+CREATE TABLE IF NOT EXISTS eth_deposit (
+  id               BIGINT NOT NULL AUTO_INCREMENT COMMENT 'ID',
+  coin             ENUM('eth', 'hyt', 'pol', 'bnb', 'arb', 'base') NOT NULL COMMENT 'coin type code',
+  token_contract   VARCHAR(42) NOT NULL DEFAULT '' COMMENT 'ERC-20 token contract address, empty for native coin',
+  tx_hash          VARCHAR(66) NOT NULL COMMENT 'transaction hash',
+  log_index        INT UNSIGNED NOT NULL COMMENT 'log index in block for token transfer',
+  block_number     BIGINT UNSIGNED NOT NULL COMMENT 'block number',
+  block_hash       VARCHAR(66) NOT NULL COMMENT 'block hash to detect reorg',
+  block_time       DATETIME DEFAULT NULL COMMENT 'block timestamp',
+  sender_address   VARCHAR(42) NOT NULL COMMENT 'sender address',
+  receiver_account VARCHAR(255) NOT NULL COMMENT 'receiver account',
+  receiver_address VARCHAR(42) NOT NULL COMMENT 'receiver address',
+  amount           DECIMAL(65,0) NOT NULL COMMENT 'amount in wei or token base units',
+  is_confirmed     BOOL NOT NULL DEFAULT false COMMENT 'true: block reaches confirmation depth',
+  created_at       DATETIME DEFAULT CURRENT_TIMESTAMP COMMENT 'created date',
+  confirmed_at     DATETIME DEFAULT NULL COMMENT 'confirmed date',
+  PRIMARY KEY (id),
+  UNIQUE KEY idx_coin_token_tx_log (coin, token_contract, tx_hash, log_index),
+  INDEX idx_block_number (block_number),
+  INDEX idx_receiver_address (receiver_address)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='table for deposit detected on EVM chain';
+
+ALTER TABLE stream_cursor ADD COLUMN block_hash VARCHAR(66) NOT NULL DEFAULT '' COMMENT 'hash of last processed block to detect reorg' AFTER position;
@@ -0,0 +1,18 @@
+-- Watch database: payments into shared deposit address of XRP, client is identified by destination tag
+
+CREATE TABLE IF NOT EXISTS xrp_deposit (
+  id               BIGINT NOT NULL AUTO_INCREMENT COMMENT 'ID',
+  tx_hash          VARCHAR(64) NOT NULL COMMENT 'transaction hash',
+  ledger_index     BIGINT UNSIGNED NOT NULL COMMENT 'ledger index in which transaction is validated',
+  sender_address   VARCHAR(35) NOT NULL COMMENT 'sender address',
+  receiver_address VARCHAR(35) NOT NULL COMMENT 'shared deposit address',
+  destination_tag  BIGINT DEFAULT NULL COMMENT 'destination tag, NULL if it is not given',
+  client_address   VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'X-address of client identified by destination tag, empty if quarantined',
+  amount           VARCHAR(255) NOT NULL COMMENT 'amount in drops',
+  is_quarantined   BOOL NOT NULL DEFAULT false COMMENT 'true: client is not identified, it must be handled manually',
+  created_at       DATETIME DEFAULT CURRENT_TIMESTAMP COMMENT 'created date',
+  PRIMARY KEY (id),
+  UNIQUE KEY idx_tx_hash (tx_hash),
+  INDEX idx_client_address (client_address),
+  INDEX idx_is_quarantined (is_quarantined)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='table for deposit into shared deposit address of XRP';
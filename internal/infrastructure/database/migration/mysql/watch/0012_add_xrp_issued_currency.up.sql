@@ -0,0 +1,10 @@
+-- Watch database: issued currency of XRP Ledger, currency and issuer are empty for XRP
+-- amount is value of issued currency instead of drops when currency is set
+
+ALTER TABLE xrp_detail_tx
+  ADD COLUMN currency VARCHAR(40) NOT NULL DEFAULT '' COMMENT 'currency code of issued currency, empty for XRP',
+  ADD COLUMN issuer   VARCHAR(35) NOT NULL DEFAULT '' COMMENT 'issuer of issued currency, empty for XRP';
+
+ALTER TABLE xrp_deposit
+  ADD COLUMN currency VARCHAR(40) NOT NULL DEFAULT '' COMMENT 'currency code of issued currency, empty for XRP',
+  ADD COLUMN issuer   VARCHAR(35) NOT NULL DEFAULT '' COMMENT 'issuer of issued currency, empty for XRP';
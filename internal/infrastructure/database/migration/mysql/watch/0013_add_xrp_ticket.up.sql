@@ -0,0 +1,6 @@
+-- Watch database: ticket of XRP Ledger, transaction with ticket can be sent in any order
+-- sequence is 0 when ticket_sequence is set
+
+ALTER TABLE xrp_detail_tx
+  ADD COLUMN ticket_sequence BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT 'tx TicketSequence, 0 if sequence is used',
+  ADD INDEX idx_ticket_sequence (sender_address, ticket_sequence);
@@ -0,0 +1,19 @@
+-- Watch database: escrow of XRP which locks funds of stored account until it matures
+
+CREATE TABLE IF NOT EXISTS xrp_escrow (
+  id                  BIGINT NOT NULL AUTO_INCREMENT COMMENT 'ID',
+  owner_address       VARCHAR(35) NOT NULL COMMENT 'address which creates escrow',
+  destination_address VARCHAR(35) NOT NULL COMMENT 'address which receives escrowed XRP',
+  amount              VARCHAR(255) NOT NULL COMMENT 'escrowed amount in drops',
+  offer_sequence      BIGINT UNSIGNED NOT NULL COMMENT 'sequence or ticket of EscrowCreate, escrow is identified by owner and it',
+  finish_after        DATETIME NOT NULL COMMENT 'escrow can be finished after this time',
+  cancel_after        DATETIME DEFAULT NULL COMMENT 'escrow can be canceled after this time, NULL if it never expires',
+  create_uuid         VARCHAR(36) NOT NULL COMMENT 'UUID of EscrowCreate in xrp_detail_tx',
+  finish_uuid         VARCHAR(36) NOT NULL DEFAULT '' COMMENT 'UUID of EscrowFinish or EscrowCancel in xrp_detail_tx, empty until it is created',
+  status              TINYINT NOT NULL DEFAULT 0 COMMENT '0: created, 1: finishing, 2: finished, 3: canceled, 4: failed',
+  created_at          DATETIME DEFAULT CURRENT_TIMESTAMP COMMENT 'created date',
+  updated_at          DATETIME DEFAULT CURRENT_TIMESTAMP COMMENT 'updated date',
+  PRIMARY KEY (id),
+  UNIQUE KEY idx_create_uuid (create_uuid),
+  INDEX idx_status (status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8 COLLATE=utf8_unicode_ci COMMENT='table for escrow of XRP';
@@ -0,0 +1,5 @@
+-- Watch database: payment_request of XRP payment
+-- expired payment is replaced for exactly the payment_request it was created for
+
+ALTER TABLE xrp_detail_tx
+  ADD COLUMN payment_request_id BIGINT NOT NULL DEFAULT 0 COMMENT 'payment_request table ID for payment action, 0 for other actions';
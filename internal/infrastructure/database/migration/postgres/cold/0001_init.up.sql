@@ -0,0 +1,170 @@
+-- initial schema
+
+-- Table structure for table seed
+
+CREATE TYPE seed_coin AS ENUM ('btc', 'bch', 'eth', 'xrp', 'hyt');
+
+CREATE TABLE seed (
+  id         SMALLSERIAL PRIMARY KEY,
+  coin       seed_coin NOT NULL,
+  seed       VARCHAR(255) NOT NULL,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX seed_idx_coin ON seed (coin);
+COMMENT ON TABLE seed IS 'table for seed';
+COMMENT ON COLUMN seed.id IS 'ID';
+COMMENT ON COLUMN seed.coin IS 'coin type code';
+COMMENT ON COLUMN seed.seed IS 'seed';
+COMMENT ON COLUMN seed.updated_at IS 'updated date';
+
+-- Table structure for table account_key
+
+CREATE TYPE account_key_coin AS ENUM ('btc', 'bch', 'eth', 'xrp', 'hyt');
+CREATE TYPE account_key_account AS ENUM ('client', 'deposit', 'payment', 'stored');
+
+CREATE TABLE account_key (
+  id                   BIGSERIAL PRIMARY KEY,
+  coin                 account_key_coin NOT NULL,
+  key_type             VARCHAR(20) NOT NULL DEFAULT 'bip44',
+  account              account_key_account NOT NULL,
+  p2pkh_address        VARCHAR(255) NOT NULL,
+  p2sh_segwit_address  VARCHAR(255) NOT NULL,
+  bech32_address       VARCHAR(255) NOT NULL,
+  taproot_address      VARCHAR(255) DEFAULT NULL,
+  full_public_key      VARCHAR(255) NOT NULL,
+  multisig_address     VARCHAR(255) NOT NULL DEFAULT '',
+  redeem_script        VARCHAR(1000) NOT NULL DEFAULT '',
+  wallet_import_format VARCHAR(255) NOT NULL,
+  idx                  BIGINT NOT NULL,
+  addr_status          SMALLINT NOT NULL DEFAULT 0,
+  updated_at           TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX account_key_idx_p2pkh_address ON account_key (p2pkh_address);
+CREATE UNIQUE INDEX account_key_idx_wallet_import_format ON account_key (wallet_import_format);
+CREATE INDEX account_key_idx_coin ON account_key (coin);
+CREATE INDEX account_key_idx_key_type ON account_key (key_type);
+CREATE INDEX account_key_idx_account ON account_key (account);
+COMMENT ON TABLE account_key IS 'table for keys for any account';
+COMMENT ON COLUMN account_key.id IS 'ID';
+COMMENT ON COLUMN account_key.coin IS 'coin type code';
+COMMENT ON COLUMN account_key.key_type IS 'key type (bip44, bip49, bip84, bip86, musig2)';
+COMMENT ON COLUMN account_key.account IS 'account type';
+COMMENT ON COLUMN account_key.p2pkh_address IS 'address as standard pubkey script that Pays To PubKey Hash (P2PKH)';
+COMMENT ON COLUMN account_key.p2sh_segwit_address IS 'p2sh-segwit address';
+COMMENT ON COLUMN account_key.bech32_address IS 'bech32 address';
+COMMENT ON COLUMN account_key.taproot_address IS 'taproot address (BIP86)';
+COMMENT ON COLUMN account_key.full_public_key IS 'full public key';
+COMMENT ON COLUMN account_key.multisig_address IS 'multisig address';
+COMMENT ON COLUMN account_key.redeem_script IS 'redeedScript after multisig address generated';
+COMMENT ON COLUMN account_key.wallet_import_format IS 'WIF';
+COMMENT ON COLUMN account_key.idx IS 'index for hd wallet';
+COMMENT ON COLUMN account_key.addr_status IS 'progress status for address generating';
+COMMENT ON COLUMN account_key.updated_at IS 'updated date';
+
+-- Table structure for table xrp_account_key
+
+CREATE TYPE xrp_account_key_coin AS ENUM ('xrp');
+CREATE TYPE xrp_account_key_account AS ENUM ('client', 'deposit', 'payment', 'stored');
+
+CREATE TABLE xrp_account_key (
+  id                  BIGSERIAL PRIMARY KEY,
+  coin                xrp_account_key_coin NOT NULL,
+  account             xrp_account_key_account NOT NULL,
+  account_id          VARCHAR(255) NOT NULL,
+  key_type            SMALLINT NOT NULL DEFAULT 0,
+  master_key          VARCHAR(255) NOT NULL,
+  master_seed         VARCHAR(255) NOT NULL,
+  master_seed_hex     VARCHAR(255) NOT NULL,
+  public_key          VARCHAR(255) NOT NULL,
+  public_key_hex      VARCHAR(255) NOT NULL,
+  is_regular_key_pair BOOLEAN NOT NULL DEFAULT false,
+  allocated_id        BIGINT NOT NULL DEFAULT 0,
+  addr_status         SMALLINT NOT NULL DEFAULT 0,
+  updated_at          TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX xrp_account_key_idx_account_id ON xrp_account_key (account_id);
+CREATE UNIQUE INDEX xrp_account_key_idx_master_seed ON xrp_account_key (master_seed);
+CREATE INDEX xrp_account_key_idx_coin ON xrp_account_key (coin);
+CREATE INDEX xrp_account_key_idx_account ON xrp_account_key (account);
+COMMENT ON TABLE xrp_account_key IS 'table for xrp keys for any account';
+COMMENT ON COLUMN xrp_account_key.id IS 'ID';
+COMMENT ON COLUMN xrp_account_key.coin IS 'coin type code';
+COMMENT ON COLUMN xrp_account_key.account IS 'account type';
+COMMENT ON COLUMN xrp_account_key.account_id IS 'account_id';
+COMMENT ON COLUMN xrp_account_key.key_type IS 'key_type';
+COMMENT ON COLUMN xrp_account_key.master_key IS 'master_key, DEPRECATED';
+COMMENT ON COLUMN xrp_account_key.master_seed IS 'master_seed';
+COMMENT ON COLUMN xrp_account_key.master_seed_hex IS 'master_seed_hex';
+COMMENT ON COLUMN xrp_account_key.public_key IS 'public_key';
+COMMENT ON COLUMN xrp_account_key.public_key_hex IS 'public_key_hex';
+COMMENT ON COLUMN xrp_account_key.is_regular_key_pair IS 'true: this key is for regular key pair';
+COMMENT ON COLUMN xrp_account_key.allocated_id IS 'index for hd wallet';
+COMMENT ON COLUMN xrp_account_key.addr_status IS 'progress status for address generating';
+COMMENT ON COLUMN xrp_account_key.updated_at IS 'updated date';
+
+-- Table structure for table auth_fullpubkey
+
+CREATE TYPE auth_fullpubkey_coin AS ENUM ('btc', 'bch');
+
+CREATE TABLE auth_fullpubkey (
+  id              SMALLSERIAL PRIMARY KEY,
+  coin            auth_fullpubkey_coin NOT NULL,
+  auth_account    VARCHAR(20) NOT NULL,
+  full_public_key VARCHAR(255) NOT NULL,
+  updated_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX auth_fullpubkey_idex_coin_auth_account ON auth_fullpubkey (coin, auth_account);
+CREATE UNIQUE INDEX auth_fullpubkey_idx_full_public_key ON auth_fullpubkey (full_public_key);
+CREATE INDEX auth_fullpubkey_idx_coin ON auth_fullpubkey (coin);
+COMMENT ON TABLE auth_fullpubkey IS 'table for auth key exported from sign db';
+COMMENT ON COLUMN auth_fullpubkey.id IS 'ID';
+COMMENT ON COLUMN auth_fullpubkey.coin IS 'coin type code';
+COMMENT ON COLUMN auth_fullpubkey.auth_account IS 'auth type';
+COMMENT ON COLUMN auth_fullpubkey.full_public_key IS 'full public key';
+COMMENT ON COLUMN auth_fullpubkey.updated_at IS 'updated date';
+
+-- Table structure for table auth_account_key
+
+CREATE TYPE auth_account_key_coin AS ENUM ('btc', 'bch');
+
+CREATE TABLE auth_account_key (
+  id                   SMALLSERIAL PRIMARY KEY,
+  coin                 auth_account_key_coin NOT NULL,
+  key_type             VARCHAR(20) NOT NULL DEFAULT 'bip44',
+  auth_account         VARCHAR(20) NOT NULL,
+  p2pkh_address        VARCHAR(255) NOT NULL,
+  p2sh_segwit_address  VARCHAR(255) NOT NULL,
+  bech32_address       VARCHAR(255) NOT NULL,
+  taproot_address      VARCHAR(255) DEFAULT NULL,
+  full_public_key      VARCHAR(255) NOT NULL,
+  multisig_address     VARCHAR(255) NOT NULL DEFAULT '',
+  redeem_script        VARCHAR(255) NOT NULL DEFAULT '',
+  wallet_import_format VARCHAR(255) NOT NULL,
+  idx                  BIGINT NOT NULL,
+  addr_status          SMALLINT NOT NULL DEFAULT 0,
+  updated_at           TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX auth_account_key_idex_coin_auth_account ON auth_account_key (coin, auth_account);
+CREATE UNIQUE INDEX auth_account_key_idx_p2pkh_address ON auth_account_key (p2pkh_address);
+CREATE UNIQUE INDEX auth_account_key_idx_p2sh_segwit_address ON auth_account_key (p2sh_segwit_address);
+CREATE UNIQUE INDEX auth_account_key_idx_bech32_address ON auth_account_key (bech32_address);
+CREATE UNIQUE INDEX auth_account_key_idx_wallet_import_format ON auth_account_key (wallet_import_format);
+CREATE INDEX auth_account_key_idx_coin ON auth_account_key (coin);
+CREATE INDEX auth_account_key_idx_key_type ON auth_account_key (key_type);
+CREATE INDEX auth_account_key_idx_auth_account ON auth_account_key (auth_account);
+COMMENT ON TABLE auth_account_key IS 'table for keys for auth account';
+COMMENT ON COLUMN auth_account_key.id IS 'ID';
+COMMENT ON COLUMN auth_account_key.coin IS 'coin type code';
+COMMENT ON COLUMN auth_account_key.key_type IS 'key type (bip44, bip49, bip84, bip86, musig2)';
+COMMENT ON COLUMN auth_account_key.auth_account IS 'auth type';
+COMMENT ON COLUMN auth_account_key.p2pkh_address IS 'address as standard pubkey script that Pays To PubKey Hash (P2PKH)';
+COMMENT ON COLUMN auth_account_key.p2sh_segwit_address IS 'p2sh-segwit address';
+COMMENT ON COLUMN auth_account_key.bech32_address IS 'bech32 address';
+COMMENT ON COLUMN auth_account_key.taproot_address IS 'taproot address (BIP86)';
+COMMENT ON COLUMN auth_account_key.full_public_key IS 'full public key';
+COMMENT ON COLUMN auth_account_key.multisig_address IS 'multisig address';
+COMMENT ON COLUMN auth_account_key.redeem_script IS 'redeedScript after multisig address generated';
+COMMENT ON COLUMN auth_account_key.wallet_import_format IS 'WIF';
+COMMENT ON COLUMN auth_account_key.idx IS 'index for hd wallet';
+COMMENT ON COLUMN auth_account_key.addr_status IS 'progress status for address generating';
+COMMENT ON COLUMN auth_account_key.updated_at IS 'updated date';
@@ -0,0 +1,6 @@
+-- add doge to coin type code
+
+ALTER TYPE seed_coin ADD VALUE 'doge';
+ALTER TYPE account_key_coin ADD VALUE 'doge';
+ALTER TYPE auth_fullpubkey_coin ADD VALUE 'doge';
+ALTER TYPE auth_account_key_coin ADD VALUE 'doge';
@@ -0,0 +1,4 @@
+-- add sol to coin type code
+
+ALTER TYPE seed_coin ADD VALUE 'sol';
+ALTER TYPE account_key_coin ADD VALUE 'sol';
@@ -0,0 +1,4 @@
+-- add trx to coin type code
+
+ALTER TYPE seed_coin ADD VALUE 'trx';
+ALTER TYPE account_key_coin ADD VALUE 'trx';
@@ -0,0 +1,10 @@
+-- add EVM chains to coin type code
+
+ALTER TYPE seed_coin ADD VALUE 'pol';
+ALTER TYPE seed_coin ADD VALUE 'bnb';
+ALTER TYPE seed_coin ADD VALUE 'arb';
+ALTER TYPE seed_coin ADD VALUE 'base';
+ALTER TYPE account_key_coin ADD VALUE 'pol';
+ALTER TYPE account_key_coin ADD VALUE 'bnb';
+ALTER TYPE account_key_coin ADD VALUE 'arb';
+ALTER TYPE account_key_coin ADD VALUE 'base';
@@ -0,0 +1,21 @@
+-- add eth and xrp to coin type code of auth tables and auth accounts to xrp_account_key for multisigning
+
+ALTER TYPE auth_fullpubkey_coin ADD VALUE 'eth';
+ALTER TYPE auth_account_key_coin ADD VALUE 'eth';
+ALTER TYPE auth_fullpubkey_coin ADD VALUE 'xrp';
+ALTER TYPE auth_account_key_coin ADD VALUE 'xrp';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth1';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth2';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth3';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth4';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth5';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth6';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth7';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth8';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth9';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth10';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth11';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth12';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth13';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth14';
+ALTER TYPE xrp_account_key_account ADD VALUE 'auth15';
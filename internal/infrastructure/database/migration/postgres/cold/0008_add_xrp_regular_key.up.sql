@@ -0,0 +1,13 @@
+-- regular key pair of xrp account which signs transactions instead of master key
+-- pending regular key is activated once SetRegularKey transaction is validated
+
+ALTER TABLE xrp_account_key ADD COLUMN active_key SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE xrp_account_key ADD COLUMN regular_key_account_id VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE xrp_account_key ADD COLUMN regular_key_seed VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE xrp_account_key ADD COLUMN pending_regular_key_account_id VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE xrp_account_key ADD COLUMN pending_regular_key_seed VARCHAR(255) NOT NULL DEFAULT '';
+COMMENT ON COLUMN xrp_account_key.active_key IS 'key to sign transaction, 0: master key, 1: regular key';
+COMMENT ON COLUMN xrp_account_key.regular_key_account_id IS 'account_id of regular key pair';
+COMMENT ON COLUMN xrp_account_key.regular_key_seed IS 'seed of regular key pair';
+COMMENT ON COLUMN xrp_account_key.pending_regular_key_account_id IS 'account_id of regular key pair waiting for SetRegularKey';
+COMMENT ON COLUMN xrp_account_key.pending_regular_key_seed IS 'seed of regular key pair waiting for SetRegularKey';
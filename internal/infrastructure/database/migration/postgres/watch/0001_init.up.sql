@@ -0,0 +1,244 @@
+-- initial schema
+
+-- Watch database: Bitcoin transaction tables
+
+CREATE TYPE btc_tx_coin AS ENUM ('btc', 'bch');
+CREATE TYPE btc_tx_action AS ENUM ('deposit', 'payment', 'transfer');
+
+CREATE TABLE btc_tx (
+  id                  BIGSERIAL PRIMARY KEY,
+  coin                btc_tx_coin NOT NULL,
+  action              btc_tx_action NOT NULL,
+  unsigned_hex_tx     TEXT NOT NULL,
+  signed_hex_tx       TEXT NOT NULL DEFAULT '',
+  sent_hash_tx        TEXT NOT NULL DEFAULT '',
+  total_input_amount  NUMERIC(26,10) NOT NULL,
+  total_output_amount NUMERIC(26,10) NOT NULL,
+  fee                 NUMERIC(26,10) NOT NULL,
+  current_tx_type     SMALLINT NOT NULL DEFAULT 1,
+  unsigned_updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  sent_updated_at     TIMESTAMP DEFAULT NULL
+);
+CREATE INDEX btc_tx_idx_coin ON btc_tx (coin);
+CREATE INDEX btc_tx_idx_action ON btc_tx (action);
+COMMENT ON TABLE btc_tx IS 'table for btc transaction info';
+COMMENT ON COLUMN btc_tx.id IS 'transaction ID';
+COMMENT ON COLUMN btc_tx.coin IS 'coin type code';
+COMMENT ON COLUMN btc_tx.action IS 'action type';
+COMMENT ON COLUMN btc_tx.unsigned_hex_tx IS 'HEX string for unsigned transaction';
+COMMENT ON COLUMN btc_tx.signed_hex_tx IS 'HEX string for signed transaction';
+COMMENT ON COLUMN btc_tx.sent_hash_tx IS 'Hash for sent transaction';
+COMMENT ON COLUMN btc_tx.total_input_amount IS 'total amount of coin to send';
+COMMENT ON COLUMN btc_tx.total_output_amount IS 'total amount of coin to receive without fee';
+COMMENT ON COLUMN btc_tx.fee IS 'fee';
+COMMENT ON COLUMN btc_tx.current_tx_type IS 'current transaction type';
+COMMENT ON COLUMN btc_tx.unsigned_updated_at IS 'updated date for unsigned transaction created';
+COMMENT ON COLUMN btc_tx.sent_updated_at IS 'updated date for signed transaction sent';
+
+CREATE TABLE btc_tx_input (
+  id                  BIGSERIAL PRIMARY KEY,
+  tx_id               BIGINT NOT NULL,
+  input_txid          VARCHAR(255) NOT NULL,
+  input_vout          INTEGER NOT NULL CHECK (input_vout >= 0),
+  input_address       VARCHAR(255) NOT NULL,
+  input_account       VARCHAR(255) NOT NULL,
+  input_amount        NUMERIC(26,10) NOT NULL,
+  input_confirmations BIGINT NOT NULL CHECK (input_confirmations >= 0),
+  updated_at          TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX btc_tx_input_idx_tx_id ON btc_tx_input (tx_id);
+COMMENT ON TABLE btc_tx_input IS 'table for input transaction';
+COMMENT ON COLUMN btc_tx_input.id IS 'ID';
+COMMENT ON COLUMN btc_tx_input.tx_id IS 'tx table ID';
+COMMENT ON COLUMN btc_tx_input.input_txid IS 'txid for input';
+COMMENT ON COLUMN btc_tx_input.input_vout IS 'vout for input';
+COMMENT ON COLUMN btc_tx_input.input_address IS 'sender address for input';
+COMMENT ON COLUMN btc_tx_input.input_account IS 'sender account for input';
+COMMENT ON COLUMN btc_tx_input.input_amount IS 'amount of coin to send for input';
+COMMENT ON COLUMN btc_tx_input.input_confirmations IS 'block confirmations when unspent rpc returned';
+COMMENT ON COLUMN btc_tx_input.updated_at IS 'updated date';
+
+CREATE TABLE btc_tx_output (
+  id             BIGSERIAL PRIMARY KEY,
+  tx_id          BIGINT NOT NULL,
+  output_address VARCHAR(255) NOT NULL,
+  output_account VARCHAR(255) NOT NULL,
+  output_amount  NUMERIC(26,10) NOT NULL,
+  is_change      BOOLEAN NOT NULL DEFAULT false,
+  updated_at     TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX btc_tx_output_idx_tx_id ON btc_tx_output (tx_id);
+COMMENT ON TABLE btc_tx_output IS 'table for output transaction';
+COMMENT ON COLUMN btc_tx_output.id IS 'ID';
+COMMENT ON COLUMN btc_tx_output.tx_id IS 'tx table ID';
+COMMENT ON COLUMN btc_tx_output.output_address IS 'receiver address for output';
+COMMENT ON COLUMN btc_tx_output.output_account IS 'receiver account for output';
+COMMENT ON COLUMN btc_tx_output.output_amount IS 'amount of coin to receive';
+COMMENT ON COLUMN btc_tx_output.is_change IS 'true: output is for fee';
+COMMENT ON COLUMN btc_tx_output.updated_at IS 'updated date';
+
+-- Watch database: Generic transaction table for ETH/XRP
+
+CREATE TYPE tx_coin AS ENUM ('eth', 'xrp', 'hyt');
+CREATE TYPE tx_action AS ENUM ('deposit', 'payment', 'transfer');
+
+CREATE TABLE tx (
+  id         BIGSERIAL PRIMARY KEY,
+  coin       tx_coin NOT NULL,
+  action     tx_action NOT NULL,
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX tx_idx_coin ON tx (coin);
+CREATE INDEX tx_idx_action ON tx (action);
+COMMENT ON TABLE tx IS 'table for eth/xrp transaction info';
+COMMENT ON COLUMN tx.id IS 'transaction ID';
+COMMENT ON COLUMN tx.coin IS 'coin type code';
+COMMENT ON COLUMN tx.action IS 'action type';
+COMMENT ON COLUMN tx.updated_at IS 'updated date';
+
+-- Watch database: Ethereum transaction details
+
+CREATE TABLE eth_detail_tx (
+  id                  BIGSERIAL PRIMARY KEY,
+  tx_id               BIGINT NOT NULL,
+  uuid                VARCHAR(36) NOT NULL,
+  current_tx_type     SMALLINT NOT NULL DEFAULT 1,
+  sender_account      VARCHAR(255) NOT NULL,
+  sender_address      VARCHAR(255) NOT NULL,
+  receiver_account    VARCHAR(255) NOT NULL,
+  receiver_address    VARCHAR(255) NOT NULL,
+  amount              BIGINT NOT NULL CHECK (amount >= 0),
+  fee                 BIGINT NOT NULL CHECK (fee >= 0),
+  gas_limit           INTEGER NOT NULL CHECK (gas_limit >= 0),
+  nonce               BIGINT NOT NULL CHECK (nonce >= 0),
+  unsigned_hex_tx     TEXT NOT NULL,
+  signed_hex_tx       TEXT NOT NULL DEFAULT '',
+  sent_hash_tx        TEXT NOT NULL DEFAULT '',
+  unsigned_updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  sent_updated_at     TIMESTAMP DEFAULT NULL
+);
+CREATE UNIQUE INDEX eth_detail_tx_idx_uuid ON eth_detail_tx (uuid);
+CREATE INDEX eth_detail_tx_idx_txid ON eth_detail_tx (tx_id);
+CREATE INDEX eth_detail_tx_idx_sender_account ON eth_detail_tx (sender_account);
+CREATE INDEX eth_detail_tx_idx_receiver_account ON eth_detail_tx (receiver_account);
+COMMENT ON TABLE eth_detail_tx IS 'table for eth transaction detail';
+COMMENT ON COLUMN eth_detail_tx.id IS 'ID';
+COMMENT ON COLUMN eth_detail_tx.tx_id IS 'eth_tx table ID';
+COMMENT ON COLUMN eth_detail_tx.uuid IS 'UUID';
+COMMENT ON COLUMN eth_detail_tx.current_tx_type IS 'current transaction type';
+COMMENT ON COLUMN eth_detail_tx.sender_account IS 'sender account';
+COMMENT ON COLUMN eth_detail_tx.sender_address IS 'sender address';
+COMMENT ON COLUMN eth_detail_tx.receiver_account IS 'receiver account';
+COMMENT ON COLUMN eth_detail_tx.receiver_address IS 'receiver address';
+COMMENT ON COLUMN eth_detail_tx.amount IS 'amount of coin to receive';
+COMMENT ON COLUMN eth_detail_tx.fee IS 'fee';
+COMMENT ON COLUMN eth_detail_tx.gas_limit IS 'gas limit';
+COMMENT ON COLUMN eth_detail_tx.nonce IS 'nonce';
+COMMENT ON COLUMN eth_detail_tx.unsigned_hex_tx IS 'HEX string for unsigned transaction';
+COMMENT ON COLUMN eth_detail_tx.signed_hex_tx IS 'HEX string for signed transaction';
+COMMENT ON COLUMN eth_detail_tx.sent_hash_tx IS 'Hash for sent transaction';
+COMMENT ON COLUMN eth_detail_tx.unsigned_updated_at IS 'updated date for unsigned transaction created';
+COMMENT ON COLUMN eth_detail_tx.sent_updated_at IS 'updated date for signed transaction sent';
+
+-- Watch database: XRP transaction details
+
+CREATE TABLE xrp_detail_tx (
+  id                      BIGSERIAL PRIMARY KEY,
+  tx_id                   BIGINT NOT NULL,
+  uuid                    VARCHAR(36) NOT NULL,
+  current_tx_type         SMALLINT NOT NULL DEFAULT 1,
+  sender_account          VARCHAR(255) NOT NULL,
+  sender_address          VARCHAR(255) NOT NULL,
+  receiver_account        VARCHAR(255) NOT NULL,
+  receiver_address        VARCHAR(255) NOT NULL,
+  amount                  VARCHAR(255) NOT NULL,
+  xrp_tx_type             VARCHAR(255) NOT NULL,
+  fee                     VARCHAR(255) NOT NULL,
+  flags                   BIGINT NOT NULL CHECK (flags >= 0),
+  last_ledger_sequence    BIGINT NOT NULL CHECK (last_ledger_sequence >= 0),
+  sequence                BIGINT NOT NULL CHECK (sequence >= 0),
+  signing_pubkey          VARCHAR(255) NOT NULL,
+  txn_signature           VARCHAR(255) NOT NULL,
+  hash                    VARCHAR(255) NOT NULL,
+  earliest_ledger_version BIGINT NOT NULL CHECK (earliest_ledger_version >= 0),
+  signed_tx_id            VARCHAR(255) NOT NULL,
+  tx_blob                 TEXT NOT NULL,
+  sent_updated_at         TIMESTAMP DEFAULT NULL
+);
+CREATE UNIQUE INDEX xrp_detail_tx_idx_uuid ON xrp_detail_tx (uuid);
+CREATE INDEX xrp_detail_tx_idx_txid ON xrp_detail_tx (tx_id);
+CREATE INDEX xrp_detail_tx_idx_sender_account ON xrp_detail_tx (sender_account);
+CREATE INDEX xrp_detail_tx_idx_receiver_account ON xrp_detail_tx (receiver_account);
+COMMENT ON TABLE xrp_detail_tx IS 'table for xrp transaction detail';
+COMMENT ON COLUMN xrp_detail_tx.id IS 'ID';
+COMMENT ON COLUMN xrp_detail_tx.tx_id IS 'xrp_tx table ID';
+COMMENT ON COLUMN xrp_detail_tx.uuid IS 'UUID';
+COMMENT ON COLUMN xrp_detail_tx.current_tx_type IS 'current transaction type';
+COMMENT ON COLUMN xrp_detail_tx.sender_account IS 'sender account';
+COMMENT ON COLUMN xrp_detail_tx.sender_address IS 'sender address';
+COMMENT ON COLUMN xrp_detail_tx.receiver_account IS 'receiver account';
+COMMENT ON COLUMN xrp_detail_tx.receiver_address IS 'receiver address';
+COMMENT ON COLUMN xrp_detail_tx.amount IS 'amount of coin to receive';
+COMMENT ON COLUMN xrp_detail_tx.xrp_tx_type IS 'xrp tx type like Payment';
+COMMENT ON COLUMN xrp_detail_tx.fee IS 'tx fee';
+COMMENT ON COLUMN xrp_detail_tx.flags IS 'tx flags';
+COMMENT ON COLUMN xrp_detail_tx.last_ledger_sequence IS 'tx LastLedgerSequence';
+COMMENT ON COLUMN xrp_detail_tx.sequence IS 'tx Sequence';
+COMMENT ON COLUMN xrp_detail_tx.signing_pubkey IS 'tx SigningPubKey';
+COMMENT ON COLUMN xrp_detail_tx.txn_signature IS 'tx TxnSignature';
+COMMENT ON COLUMN xrp_detail_tx.hash IS 'tx Hash';
+COMMENT ON COLUMN xrp_detail_tx.earliest_ledger_version IS 'tx earliest_ledger_version after sending tx';
+COMMENT ON COLUMN xrp_detail_tx.signed_tx_id IS 'signed tx id';
+COMMENT ON COLUMN xrp_detail_tx.tx_blob IS 'sent tx blob';
+COMMENT ON COLUMN xrp_detail_tx.sent_updated_at IS 'updated date for signed transaction sent';
+
+-- Watch database: Payment request table
+
+CREATE TYPE payment_request_coin AS ENUM ('btc', 'bch', 'eth', 'xrp');
+
+CREATE TABLE payment_request (
+  id               BIGSERIAL PRIMARY KEY,
+  coin             payment_request_coin NOT NULL,
+  payment_id       BIGINT DEFAULT NULL,
+  sender_address   VARCHAR(255) NOT NULL,
+  sender_account   VARCHAR(255) NOT NULL,
+  receiver_address VARCHAR(255) NOT NULL,
+  amount           NUMERIC(26,10) NOT NULL,
+  is_done          BOOLEAN NOT NULL DEFAULT false,
+  updated_at       TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX payment_request_idx_coin ON payment_request (coin);
+COMMENT ON TABLE payment_request IS 'table for payment request';
+COMMENT ON COLUMN payment_request.id IS 'ID';
+COMMENT ON COLUMN payment_request.coin IS 'coin type code';
+COMMENT ON COLUMN payment_request.payment_id IS 'tx table ID for payment action';
+COMMENT ON COLUMN payment_request.sender_address IS 'sender address';
+COMMENT ON COLUMN payment_request.sender_account IS 'sender account';
+COMMENT ON COLUMN payment_request.receiver_address IS 'receiver address';
+COMMENT ON COLUMN payment_request.amount IS 'amount of coin to send';
+COMMENT ON COLUMN payment_request.is_done IS 'true: unsigned transaction is created';
+COMMENT ON COLUMN payment_request.updated_at IS 'updated date';
+
+-- Watch database: Address table
+
+CREATE TYPE address_coin AS ENUM ('btc', 'bch', 'eth', 'xrp', 'hyt');
+CREATE TYPE address_account AS ENUM ('client', 'deposit', 'payment', 'stored');
+
+CREATE TABLE address (
+  id             BIGSERIAL PRIMARY KEY,
+  coin           address_coin NOT NULL,
+  account        address_account NOT NULL,
+  wallet_address VARCHAR(255) NOT NULL,
+  is_allocated   BOOLEAN NOT NULL DEFAULT false,
+  updated_at     TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX address_idx_wallet_address ON address (wallet_address);
+CREATE INDEX address_idx_coin ON address (coin);
+CREATE INDEX address_idx_account ON address (account);
+COMMENT ON TABLE address IS 'table for account pubkey';
+COMMENT ON COLUMN address.id IS 'ID';
+COMMENT ON COLUMN address.coin IS 'coin type code';
+COMMENT ON COLUMN address.account IS 'account type';
+COMMENT ON COLUMN address.wallet_address IS 'wallet address';
+COMMENT ON COLUMN address.is_allocated IS 'true: address is allocated(used)';
+COMMENT ON COLUMN address.updated_at IS 'updated date';
@@ -0,0 +1,7 @@
+-- Watch database: block of confirmed BTC transaction to detect chain reorganization
+
+ALTER TABLE btc_tx ADD COLUMN block_hash VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE btc_tx ADD COLUMN block_height BIGINT NOT NULL DEFAULT 0;
+CREATE INDEX btc_tx_idx_block_height ON btc_tx (block_height);
+COMMENT ON COLUMN btc_tx.block_hash IS 'hash of block including confirmed transaction';
+COMMENT ON COLUMN btc_tx.block_height IS 'height of block including confirmed transaction';
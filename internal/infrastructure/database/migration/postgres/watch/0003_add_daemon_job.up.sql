@@ -0,0 +1,25 @@
+-- Watch database: last run status of watch daemon jobs
+
+CREATE TYPE daemon_job_coin AS ENUM ('btc', 'bch', 'eth', 'xrp', 'hyt');
+CREATE TYPE daemon_job_last_status AS ENUM ('running', 'success', 'failure', 'skipped');
+
+CREATE TABLE daemon_job (
+  id               BIGSERIAL PRIMARY KEY,
+  coin             daemon_job_coin NOT NULL,
+  name             VARCHAR(64) NOT NULL,
+  last_status      daemon_job_last_status NOT NULL,
+  last_error       TEXT NOT NULL,
+  last_started_at  TIMESTAMP DEFAULT NULL,
+  last_finished_at TIMESTAMP DEFAULT NULL,
+  updated_at       TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX daemon_job_idx_coin_name ON daemon_job (coin, name);
+COMMENT ON TABLE daemon_job IS 'table for last run status of watch daemon jobs';
+COMMENT ON COLUMN daemon_job.id IS 'ID';
+COMMENT ON COLUMN daemon_job.coin IS 'coin type code';
+COMMENT ON COLUMN daemon_job.name IS 'job name';
+COMMENT ON COLUMN daemon_job.last_status IS 'status of last run';
+COMMENT ON COLUMN daemon_job.last_error IS 'error message of last run';
+COMMENT ON COLUMN daemon_job.last_started_at IS 'started date of last run';
+COMMENT ON COLUMN daemon_job.last_finished_at IS 'finished date of last run';
+COMMENT ON COLUMN daemon_job.updated_at IS 'updated date';
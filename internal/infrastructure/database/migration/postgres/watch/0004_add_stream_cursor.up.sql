@@ -0,0 +1,18 @@
+-- Watch database: last processed position of stream monitor
+
+CREATE TYPE stream_cursor_coin AS ENUM ('btc', 'bch', 'eth', 'xrp', 'hyt');
+
+CREATE TABLE stream_cursor (
+  id         BIGSERIAL PRIMARY KEY,
+  coin       stream_cursor_coin NOT NULL,
+  name       VARCHAR(64) NOT NULL,
+  position   BIGINT NOT NULL DEFAULT 0 CHECK (position >= 0),
+  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX stream_cursor_idx_coin_name ON stream_cursor (coin, name);
+COMMENT ON TABLE stream_cursor IS 'table for last processed position of stream monitor';
+COMMENT ON COLUMN stream_cursor.id IS 'ID';
+COMMENT ON COLUMN stream_cursor.coin IS 'coin type code';
+COMMENT ON COLUMN stream_cursor.name IS 'stream name';
+COMMENT ON COLUMN stream_cursor.position IS 'last processed ledger index or block height';
+COMMENT ON COLUMN stream_cursor.updated_at IS 'updated date';
@@ -0,0 +1,7 @@
+-- add ltc to coin type code
+
+ALTER TYPE btc_tx_coin ADD VALUE 'ltc';
+ALTER TYPE payment_request_coin ADD VALUE 'ltc';
+ALTER TYPE address_coin ADD VALUE 'ltc';
+ALTER TYPE daemon_job_coin ADD VALUE 'ltc';
+ALTER TYPE stream_cursor_coin ADD VALUE 'ltc';
@@ -0,0 +1,7 @@
+-- add doge to coin type code
+
+ALTER TYPE btc_tx_coin ADD VALUE 'doge';
+ALTER TYPE payment_request_coin ADD VALUE 'doge';
+ALTER TYPE address_coin ADD VALUE 'doge';
+ALTER TYPE daemon_job_coin ADD VALUE 'doge';
+ALTER TYPE stream_cursor_coin ADD VALUE 'doge';
@@ -0,0 +1,54 @@
+-- add sol to coin type code
+
+ALTER TYPE tx_coin ADD VALUE 'sol';
+ALTER TYPE payment_request_coin ADD VALUE 'sol';
+ALTER TYPE address_coin ADD VALUE 'sol';
+ALTER TYPE daemon_job_coin ADD VALUE 'sol';
+ALTER TYPE stream_cursor_coin ADD VALUE 'sol';
+
+-- Watch database: Solana transaction details
+
+CREATE TABLE sol_detail_tx (
+  id                  BIGSERIAL PRIMARY KEY,
+  tx_id               BIGINT NOT NULL,
+  uuid                VARCHAR(36) NOT NULL,
+  current_tx_type     SMALLINT NOT NULL DEFAULT 1,
+  sender_account      VARCHAR(255) NOT NULL,
+  sender_address      VARCHAR(255) NOT NULL,
+  receiver_account    VARCHAR(255) NOT NULL,
+  receiver_address    VARCHAR(255) NOT NULL,
+  amount              BIGINT NOT NULL CHECK (amount >= 0),
+  fee                 BIGINT NOT NULL CHECK (fee >= 0),
+  token_mint          VARCHAR(255) NOT NULL DEFAULT '',
+  nonce_account       VARCHAR(255) NOT NULL,
+  nonce               VARCHAR(255) NOT NULL,
+  unsigned_tx         TEXT NOT NULL,
+  signed_tx           TEXT NOT NULL DEFAULT '',
+  sent_signature      VARCHAR(255) NOT NULL DEFAULT '',
+  unsigned_updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  sent_updated_at     TIMESTAMP DEFAULT NULL
+);
+CREATE UNIQUE INDEX sol_detail_tx_idx_uuid ON sol_detail_tx (uuid);
+CREATE INDEX sol_detail_tx_idx_txid ON sol_detail_tx (tx_id);
+CREATE INDEX sol_detail_tx_idx_sender_account ON sol_detail_tx (sender_account);
+CREATE INDEX sol_detail_tx_idx_receiver_account ON sol_detail_tx (receiver_account);
+CREATE INDEX sol_detail_tx_idx_sent_signature ON sol_detail_tx (sent_signature);
+COMMENT ON TABLE sol_detail_tx IS 'table for sol transaction detail';
+COMMENT ON COLUMN sol_detail_tx.id IS 'ID';
+COMMENT ON COLUMN sol_detail_tx.tx_id IS 'tx table ID';
+COMMENT ON COLUMN sol_detail_tx.uuid IS 'UUID';
+COMMENT ON COLUMN sol_detail_tx.current_tx_type IS 'current transaction type';
+COMMENT ON COLUMN sol_detail_tx.sender_account IS 'sender account';
+COMMENT ON COLUMN sol_detail_tx.sender_address IS 'sender address';
+COMMENT ON COLUMN sol_detail_tx.receiver_account IS 'receiver account';
+COMMENT ON COLUMN sol_detail_tx.receiver_address IS 'receiver address';
+COMMENT ON COLUMN sol_detail_tx.amount IS 'amount of lamports or token base units to receive';
+COMMENT ON COLUMN sol_detail_tx.fee IS 'fee in lamports';
+COMMENT ON COLUMN sol_detail_tx.token_mint IS 'SPL token mint address, empty for SOL';
+COMMENT ON COLUMN sol_detail_tx.nonce_account IS 'durable nonce account';
+COMMENT ON COLUMN sol_detail_tx.nonce IS 'durable nonce used as recent blockhash';
+COMMENT ON COLUMN sol_detail_tx.unsigned_tx IS 'base64 string for unsigned transaction';
+COMMENT ON COLUMN sol_detail_tx.signed_tx IS 'base64 string for signed transaction';
+COMMENT ON COLUMN sol_detail_tx.sent_signature IS 'signature of sent transaction';
+COMMENT ON COLUMN sol_detail_tx.unsigned_updated_at IS 'updated date for unsigned transaction created';
+COMMENT ON COLUMN sol_detail_tx.sent_updated_at IS 'updated date for signed transaction sent';
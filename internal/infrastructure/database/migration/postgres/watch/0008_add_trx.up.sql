@@ -0,0 +1,56 @@
+-- add trx to coin type code
+
+ALTER TYPE tx_coin ADD VALUE 'trx';
+ALTER TYPE payment_request_coin ADD VALUE 'trx';
+ALTER TYPE address_coin ADD VALUE 'trx';
+ALTER TYPE daemon_job_coin ADD VALUE 'trx';
+ALTER TYPE stream_cursor_coin ADD VALUE 'trx';
+
+-- Watch database: Tron transaction details
+
+CREATE TABLE trx_detail_tx (
+  id                  BIGSERIAL PRIMARY KEY,
+  tx_id               BIGINT NOT NULL,
+  uuid                VARCHAR(36) NOT NULL,
+  current_tx_type     SMALLINT NOT NULL DEFAULT 1,
+  sender_account      VARCHAR(255) NOT NULL,
+  sender_address      VARCHAR(255) NOT NULL,
+  receiver_account    VARCHAR(255) NOT NULL,
+  receiver_address    VARCHAR(255) NOT NULL,
+  amount              BIGINT NOT NULL CHECK (amount >= 0),
+  fee                 BIGINT NOT NULL CHECK (fee >= 0),
+  fee_limit           BIGINT NOT NULL DEFAULT 0 CHECK (fee_limit >= 0),
+  contract_address    VARCHAR(255) NOT NULL DEFAULT '',
+  ref_block_num       BIGINT NOT NULL CHECK (ref_block_num >= 0),
+  expiration          BIGINT NOT NULL,
+  unsigned_hex_tx     TEXT NOT NULL,
+  signed_hex_tx       TEXT NOT NULL DEFAULT '',
+  sent_hash_tx        VARCHAR(255) NOT NULL DEFAULT '',
+  unsigned_updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  sent_updated_at     TIMESTAMP DEFAULT NULL
+);
+CREATE UNIQUE INDEX trx_detail_tx_idx_uuid ON trx_detail_tx (uuid);
+CREATE INDEX trx_detail_tx_idx_txid ON trx_detail_tx (tx_id);
+CREATE INDEX trx_detail_tx_idx_sender_account ON trx_detail_tx (sender_account);
+CREATE INDEX trx_detail_tx_idx_receiver_account ON trx_detail_tx (receiver_account);
+CREATE INDEX trx_detail_tx_idx_sent_hash_tx ON trx_detail_tx (sent_hash_tx);
+COMMENT ON TABLE trx_detail_tx IS 'table for trx transaction detail';
+COMMENT ON COLUMN trx_detail_tx.id IS 'ID';
+COMMENT ON COLUMN trx_detail_tx.tx_id IS 'tx table ID';
+COMMENT ON COLUMN trx_detail_tx.uuid IS 'UUID';
+COMMENT ON COLUMN trx_detail_tx.current_tx_type IS 'current transaction type';
+COMMENT ON COLUMN trx_detail_tx.sender_account IS 'sender account';
+COMMENT ON COLUMN trx_detail_tx.sender_address IS 'sender address';
+COMMENT ON COLUMN trx_detail_tx.receiver_account IS 'receiver account';
+COMMENT ON COLUMN trx_detail_tx.receiver_address IS 'receiver address';
+COMMENT ON COLUMN trx_detail_tx.amount IS 'amount of sun or token base units to receive';
+COMMENT ON COLUMN trx_detail_tx.fee IS 'estimated fee in sun';
+COMMENT ON COLUMN trx_detail_tx.fee_limit IS 'max fee in sun for energy of TRC-20 transfer';
+COMMENT ON COLUMN trx_detail_tx.contract_address IS 'TRC-20 contract address, empty for TRX';
+COMMENT ON COLUMN trx_detail_tx.ref_block_num IS 'reference block number';
+COMMENT ON COLUMN trx_detail_tx.expiration IS 'expiration of transaction in unix milliseconds';
+COMMENT ON COLUMN trx_detail_tx.unsigned_hex_tx IS 'HEX string for unsigned transaction';
+COMMENT ON COLUMN trx_detail_tx.signed_hex_tx IS 'HEX string for signed transaction';
+COMMENT ON COLUMN trx_detail_tx.sent_hash_tx IS 'Hash for sent transaction';
+COMMENT ON COLUMN trx_detail_tx.unsigned_updated_at IS 'updated date for unsigned transaction created';
+COMMENT ON COLUMN trx_detail_tx.sent_updated_at IS 'updated date for signed transaction sent';
@@ -0,0 +1,22 @@
+-- add EVM chains to coin type code
+
+ALTER TYPE tx_coin ADD VALUE 'pol';
+ALTER TYPE tx_coin ADD VALUE 'bnb';
+ALTER TYPE tx_coin ADD VALUE 'arb';
+ALTER TYPE tx_coin ADD VALUE 'base';
+ALTER TYPE payment_request_coin ADD VALUE 'pol';
+ALTER TYPE payment_request_coin ADD VALUE 'bnb';
+ALTER TYPE payment_request_coin ADD VALUE 'arb';
+ALTER TYPE payment_request_coin ADD VALUE 'base';
+ALTER TYPE address_coin ADD VALUE 'pol';
+ALTER TYPE address_coin ADD VALUE 'bnb';
+ALTER TYPE address_coin ADD VALUE 'arb';
+ALTER TYPE address_coin ADD VALUE 'base';
+ALTER TYPE daemon_job_coin ADD VALUE 'pol';
+ALTER TYPE daemon_job_coin ADD VALUE 'bnb';
+ALTER TYPE daemon_job_coin ADD VALUE 'arb';
+ALTER TYPE daemon_job_coin ADD VALUE 'base';
+ALTER TYPE stream_cursor_coin ADD VALUE 'pol';
+ALTER TYPE stream_cursor_coin ADD VALUE 'bnb';
+ALTER TYPE stream_cursor_coin ADD VALUE 'arb';
+ALTER TYPE stream_cursor_coin ADD VALUE 'base';
@@ -0,0 +1,43 @@
+-- Watch database: deposits into client addresses detected by scanning EVM chain
+
+CREATE TYPE eth_deposit_coin AS ENUM ('eth', 'hyt', 'pol', 'bnb', 'arb', 'base');
+
+CREATE TABLE eth_deposit (
+  id               BIGSERIAL PRIMARY KEY,
+  coin             eth_deposit_coin NOT NULL,
+  token_contract   VARCHAR(42) NOT NULL DEFAULT '',
+  tx_hash          VARCHAR(66) NOT NULL,
+  log_index        BIGINT NOT NULL CHECK (log_index >= 0),
+  block_number     BIGINT NOT NULL CHECK (block_number >= 0),
+  block_hash       VARCHAR(66) NOT NULL,
+  block_time       TIMESTAMP DEFAULT NULL,
+  sender_address   VARCHAR(42) NOT NULL,
+  receiver_account VARCHAR(255) NOT NULL,
+  receiver_address VARCHAR(42) NOT NULL,
+  amount           NUMERIC(78,0) NOT NULL,
+  is_confirmed     BOOLEAN NOT NULL DEFAULT false,
+  created_at       TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  confirmed_at     TIMESTAMP DEFAULT NULL
+);
+CREATE UNIQUE INDEX eth_deposit_idx_coin_token_tx_log ON eth_deposit (coin, token_contract, tx_hash, log_index);
+CREATE INDEX eth_deposit_idx_block_number ON eth_deposit (block_number);
+CREATE INDEX eth_deposit_idx_receiver_address ON eth_deposit (receiver_address);
+COMMENT ON TABLE eth_deposit IS 'table for deposit detected on EVM chain';
+COMMENT ON COLUMN eth_deposit.id IS 'ID';
+COMMENT ON COLUMN eth_deposit.coin IS 'coin type code';
+COMMENT ON COLUMN eth_deposit.token_contract IS 'ERC-20 token contract address, empty for native coin';
+COMMENT ON COLUMN eth_deposit.tx_hash IS 'transaction hash';
+COMMENT ON COLUMN eth_deposit.log_index IS 'log index in block for token transfer';
+COMMENT ON COLUMN eth_deposit.block_number IS 'block number';
+COMMENT ON COLUMN eth_deposit.block_hash IS 'block hash to detect reorg';
+COMMENT ON COLUMN eth_deposit.block_time IS 'block timestamp';
+COMMENT ON COLUMN eth_deposit.sender_address IS 'sender address';
+COMMENT ON COLUMN eth_deposit.receiver_account IS 'receiver account';
+COMMENT ON COLUMN eth_deposit.receiver_address IS 'receiver address';
+COMMENT ON COLUMN eth_deposit.amount IS 'amount in wei or token base units';
+COMMENT ON COLUMN eth_deposit.is_confirmed IS 'true: block reaches confirmation depth';
+COMMENT ON COLUMN eth_deposit.created_at IS 'created date';
+COMMENT ON COLUMN eth_deposit.confirmed_at IS 'confirmed date';
+
+ALTER TABLE stream_cursor ADD COLUMN block_hash VARCHAR(66) NOT NULL DEFAULT '';
+COMMENT ON COLUMN stream_cursor.block_hash IS 'hash of last processed block to detect reorg';
@@ -0,0 +1,28 @@
+-- Watch database: payments into shared deposit address of XRP, client is identified by destination tag
+
+CREATE TABLE xrp_deposit (
+  id               BIGSERIAL PRIMARY KEY,
+  tx_hash          VARCHAR(64) NOT NULL,
+  ledger_index     BIGINT NOT NULL CHECK (ledger_index >= 0),
+  sender_address   VARCHAR(35) NOT NULL,
+  receiver_address VARCHAR(35) NOT NULL,
+  destination_tag  BIGINT DEFAULT NULL CHECK (destination_tag >= 0),
+  client_address   VARCHAR(255) NOT NULL DEFAULT '',
+  amount           VARCHAR(255) NOT NULL,
+  is_quarantined   BOOLEAN NOT NULL DEFAULT false,
+  created_at       TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX xrp_deposit_idx_tx_hash ON xrp_deposit (tx_hash);
+CREATE INDEX xrp_deposit_idx_client_address ON xrp_deposit (client_address);
+CREATE INDEX xrp_deposit_idx_is_quarantined ON xrp_deposit (is_quarantined);
+COMMENT ON TABLE xrp_deposit IS 'table for deposit into shared deposit address of XRP';
+COMMENT ON COLUMN xrp_deposit.id IS 'ID';
+COMMENT ON COLUMN xrp_deposit.tx_hash IS 'transaction hash';
+COMMENT ON COLUMN xrp_deposit.ledger_index IS 'ledger index in which transaction is validated';
+COMMENT ON COLUMN xrp_deposit.sender_address IS 'sender address';
+COMMENT ON COLUMN xrp_deposit.receiver_address IS 'shared deposit address';
+COMMENT ON COLUMN xrp_deposit.destination_tag IS 'destination tag, NULL if it is not given';
+COMMENT ON COLUMN xrp_deposit.client_address IS 'X-address of client identified by destination tag, empty if quarantined';
+COMMENT ON COLUMN xrp_deposit.amount IS 'amount in drops';
+COMMENT ON COLUMN xrp_deposit.is_quarantined IS 'true: client is not identified, it must be handled manually';
+COMMENT ON COLUMN xrp_deposit.created_at IS 'created date';
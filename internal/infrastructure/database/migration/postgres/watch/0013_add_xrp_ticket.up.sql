@@ -0,0 +1,7 @@
+-- Watch database: ticket of XRP Ledger, transaction with ticket can be sent in any order
+-- sequence is 0 when ticket_sequence is set
+
+ALTER TABLE xrp_detail_tx ADD COLUMN ticket_sequence BIGINT NOT NULL DEFAULT 0 CHECK (ticket_sequence >= 0);
+COMMENT ON COLUMN xrp_detail_tx.ticket_sequence IS 'tx TicketSequence, 0 if sequence is used';
+
+CREATE INDEX xrp_detail_tx_idx_ticket_sequence ON xrp_detail_tx (sender_address, ticket_sequence);
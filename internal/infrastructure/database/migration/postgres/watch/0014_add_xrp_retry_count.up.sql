@@ -0,0 +1,5 @@
+-- Watch database: resubmission of XRP transaction which expired by LastLedgerSequence
+-- expired transaction is replaced by new unsigned transaction, retry_count of replacement is incremented
+
+ALTER TABLE xrp_detail_tx ADD COLUMN retry_count INTEGER NOT NULL DEFAULT 0 CHECK (retry_count >= 0);
+COMMENT ON COLUMN xrp_detail_tx.retry_count IS 'number of times transaction is resubmitted after expiration';
@@ -0,0 +1,31 @@
+-- Watch database: escrow of XRP which locks funds of stored account until it matures
+
+CREATE TABLE xrp_escrow (
+  id                  BIGSERIAL PRIMARY KEY,
+  owner_address       VARCHAR(35) NOT NULL,
+  destination_address VARCHAR(35) NOT NULL,
+  amount              VARCHAR(255) NOT NULL,
+  offer_sequence      BIGINT NOT NULL CHECK (offer_sequence >= 0),
+  finish_after        TIMESTAMP NOT NULL,
+  cancel_after        TIMESTAMP DEFAULT NULL,
+  create_uuid         VARCHAR(36) NOT NULL,
+  finish_uuid         VARCHAR(36) NOT NULL DEFAULT '',
+  status              SMALLINT NOT NULL DEFAULT 0,
+  created_at          TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+  updated_at          TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX xrp_escrow_idx_create_uuid ON xrp_escrow (create_uuid);
+CREATE INDEX xrp_escrow_idx_status ON xrp_escrow (status);
+COMMENT ON TABLE xrp_escrow IS 'table for escrow of XRP';
+COMMENT ON COLUMN xrp_escrow.id IS 'ID';
+COMMENT ON COLUMN xrp_escrow.owner_address IS 'address which creates escrow';
+COMMENT ON COLUMN xrp_escrow.destination_address IS 'address which receives escrowed XRP';
+COMMENT ON COLUMN xrp_escrow.amount IS 'escrowed amount in drops';
+COMMENT ON COLUMN xrp_escrow.offer_sequence IS 'sequence or ticket of EscrowCreate, escrow is identified by owner and it';
+COMMENT ON COLUMN xrp_escrow.finish_after IS 'escrow can be finished after this time';
+COMMENT ON COLUMN xrp_escrow.cancel_after IS 'escrow can be canceled after this time, NULL if it never expires';
+COMMENT ON COLUMN xrp_escrow.create_uuid IS 'UUID of EscrowCreate in xrp_detail_tx';
+COMMENT ON COLUMN xrp_escrow.finish_uuid IS 'UUID of EscrowFinish or EscrowCancel in xrp_detail_tx, empty until it is created';
+COMMENT ON COLUMN xrp_escrow.status IS '0: created, 1: finishing, 2: finished, 3: canceled, 4: failed';
+COMMENT ON COLUMN xrp_escrow.created_at IS 'created date';
+COMMENT ON COLUMN xrp_escrow.updated_at IS 'updated date';
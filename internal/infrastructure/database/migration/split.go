@@ -0,0 +1,63 @@
+package migration
+
+import (
+	"strings"
+)
+
+// split splits migration into statements by `;` outside of quotes and comments
+//
+// statements are executed one by one because MySQL driver doesn't allow multiple statements by default
+func split(src string) []string {
+	var (
+		stmts   []string
+		start   int
+		hasCode bool
+	)
+	for i := 0; i < len(src); i++ {
+		switch c := src[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(src, i)
+			hasCode = true
+		case strings.HasPrefix(src[i:], "--"):
+			if end := strings.IndexByte(src[i:], '\n'); end >= 0 {
+				i += end
+			} else {
+				i = len(src)
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			if end := strings.Index(src[i+2:], "*/"); end >= 0 {
+				i += end + 3
+			} else {
+				i = len(src)
+			}
+		case c == ';':
+			if hasCode {
+				stmts = append(stmts, strings.TrimSpace(src[start:i]))
+			}
+			start = i + 1
+			hasCode = false
+		case c != ' ' && c != '\t' && c != '\r' && c != '\n':
+			hasCode = true
+		}
+	}
+	if hasCode {
+		stmts = append(stmts, strings.TrimSpace(src[start:]))
+	}
+	return stmts
+}
+
+// skipQuoted returns index of closing quote, doubled quote is regarded as escaped one
+func skipQuoted(src string, i int) int {
+	quote := src[i]
+	for i++; i < len(src); i++ {
+		if src[i] != quote {
+			continue
+		}
+		if i+1 < len(src) && src[i+1] == quote {
+			i++
+			continue
+		}
+		return i
+	}
+	return len(src)
+}
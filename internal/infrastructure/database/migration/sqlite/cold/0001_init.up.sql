@@ -0,0 +1,101 @@
+-- initial schema
+
+-- Table structure for table `seed`
+-- column order follows MySQL definition so that rows of mysqldump can be imported as they are
+
+CREATE TABLE IF NOT EXISTS seed (
+  id         INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, -- ID
+  coin       TEXT NOT NULL CHECK (coin IN ('btc', 'bch', 'eth', 'xrp', 'hyt')), -- coin type code
+  seed       TEXT NOT NULL, -- seed
+  updated_at DATETIME DEFAULT CURRENT_TIMESTAMP -- updated date
+);
+CREATE INDEX IF NOT EXISTS seed_idx_coin ON seed (coin);
+
+-- Table structure for table `account_key`
+
+CREATE TABLE IF NOT EXISTS account_key (
+  id                   INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, -- ID
+  coin                 TEXT NOT NULL CHECK (coin IN ('btc', 'bch', 'eth', 'xrp', 'hyt')), -- coin type code
+  key_type             TEXT NOT NULL DEFAULT 'bip44', -- key type (bip44, bip49, bip84, bip86, musig2)
+  account              TEXT NOT NULL CHECK (account IN ('client', 'deposit', 'payment', 'stored')), -- account type
+  p2pkh_address        TEXT NOT NULL, -- address as standard pubkey script that Pays To PubKey Hash (P2PKH)
+  p2sh_segwit_address  TEXT NOT NULL, -- p2sh-segwit address
+  bech32_address       TEXT NOT NULL, -- bech32 address
+  taproot_address      TEXT DEFAULT NULL, -- taproot address (BIP86)
+  full_public_key      TEXT NOT NULL, -- full public key
+  multisig_address     TEXT NOT NULL DEFAULT '', -- multisig address
+  redeem_script        TEXT NOT NULL DEFAULT '', -- redeedScript after multisig address generated
+  wallet_import_format TEXT NOT NULL, -- WIF
+  idx                  INTEGER NOT NULL, -- index for hd wallet
+  addr_status          INTEGER NOT NULL DEFAULT 0, -- progress status for address generating
+  updated_at           DATETIME DEFAULT CURRENT_TIMESTAMP -- updated date
+);
+CREATE UNIQUE INDEX IF NOT EXISTS account_key_idx_p2pkh_address ON account_key (p2pkh_address);
+CREATE UNIQUE INDEX IF NOT EXISTS account_key_idx_wallet_import_format ON account_key (wallet_import_format);
+CREATE INDEX IF NOT EXISTS account_key_idx_coin ON account_key (coin);
+CREATE INDEX IF NOT EXISTS account_key_idx_key_type ON account_key (key_type);
+CREATE INDEX IF NOT EXISTS account_key_idx_account ON account_key (account);
+
+-- Table structure for table `xrp_account_key`
+
+CREATE TABLE IF NOT EXISTS xrp_account_key (
+  id                  INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, -- ID
+  coin                TEXT NOT NULL CHECK (coin IN ('xrp')), -- coin type code
+  account             TEXT NOT NULL CHECK (account IN ('client', 'deposit', 'payment', 'stored')), -- account type
+  account_id          TEXT NOT NULL, -- account_id
+  key_type            INTEGER NOT NULL DEFAULT 0, -- key_type
+  master_key          TEXT NOT NULL, -- master_key, DEPRECATED
+  master_seed         TEXT NOT NULL, -- master_seed
+  master_seed_hex     TEXT NOT NULL, -- master_seed_hex
+  public_key          TEXT NOT NULL, -- public_key
+  public_key_hex      TEXT NOT NULL, -- public_key_hex
+  is_regular_key_pair BOOLEAN NOT NULL DEFAULT false, -- true: this key is for regular key pair
+  allocated_id        INTEGER NOT NULL DEFAULT 0, -- index for hd wallet
+  addr_status         INTEGER NOT NULL DEFAULT 0, -- progress status for address generating
+  updated_at          DATETIME DEFAULT CURRENT_TIMESTAMP -- updated date
+);
+CREATE UNIQUE INDEX IF NOT EXISTS xrp_account_key_idx_account_id ON xrp_account_key (account_id);
+CREATE UNIQUE INDEX IF NOT EXISTS xrp_account_key_idx_master_seed ON xrp_account_key (master_seed);
+CREATE INDEX IF NOT EXISTS xrp_account_key_idx_coin ON xrp_account_key (coin);
+CREATE INDEX IF NOT EXISTS xrp_account_key_idx_account ON xrp_account_key (account);
+
+-- Table structure for table `auth_fullpubkey`
+
+CREATE TABLE IF NOT EXISTS auth_fullpubkey (
+  id              INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, -- ID
+  coin            TEXT NOT NULL CHECK (coin IN ('btc', 'bch')), -- coin type code
+  auth_account    TEXT NOT NULL, -- auth type
+  full_public_key TEXT NOT NULL, -- full public key
+  updated_at      DATETIME DEFAULT CURRENT_TIMESTAMP -- updated date
+);
+CREATE UNIQUE INDEX IF NOT EXISTS auth_fullpubkey_idex_coin_auth_account ON auth_fullpubkey (coin, auth_account);
+CREATE UNIQUE INDEX IF NOT EXISTS auth_fullpubkey_idx_full_public_key ON auth_fullpubkey (full_public_key);
+CREATE INDEX IF NOT EXISTS auth_fullpubkey_idx_coin ON auth_fullpubkey (coin);
+
+-- Table structure for table `auth_account_key`
+
+CREATE TABLE IF NOT EXISTS auth_account_key (
+  id                   INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, -- ID
+  coin                 TEXT NOT NULL CHECK (coin IN ('btc', 'bch')), -- coin type code
+  key_type             TEXT NOT NULL DEFAULT 'bip44', -- key type (bip44, bip49, bip84, bip86, musig2)
+  auth_account         TEXT NOT NULL, -- auth type
+  p2pkh_address        TEXT NOT NULL, -- address as standard pubkey script that Pays To PubKey Hash (P2PKH)
+  p2sh_segwit_address  TEXT NOT NULL, -- p2sh-segwit address
+  bech32_address       TEXT NOT NULL, -- bech32 address
+  taproot_address      TEXT DEFAULT NULL, -- taproot address (BIP86)
+  full_public_key      TEXT NOT NULL, -- full public key
+  multisig_address     TEXT NOT NULL DEFAULT '', -- multisig address
+  redeem_script        TEXT NOT NULL DEFAULT '', -- redeedScript after multisig address generated
+  wallet_import_format TEXT NOT NULL, -- WIF
+  idx                  INTEGER NOT NULL, -- index for hd wallet
+  addr_status          INTEGER NOT NULL DEFAULT 0, -- progress status for address generating
+  updated_at           DATETIME DEFAULT CURRENT_TIMESTAMP -- updated date
+);
+CREATE UNIQUE INDEX IF NOT EXISTS auth_account_key_idex_coin_auth_account ON auth_account_key (coin, auth_account);
+CREATE UNIQUE INDEX IF NOT EXISTS auth_account_key_idx_p2pkh_address ON auth_account_key (p2pkh_address);
+CREATE UNIQUE INDEX IF NOT EXISTS auth_account_key_idx_p2sh_segwit_address ON auth_account_key (p2sh_segwit_address);
+CREATE UNIQUE INDEX IF NOT EXISTS auth_account_key_idx_bech32_address ON auth_account_key (bech32_address);
+CREATE UNIQUE INDEX IF NOT EXISTS auth_account_key_idx_wallet_import_format ON auth_account_key (wallet_import_format);
+CREATE INDEX IF NOT EXISTS auth_account_key_idx_coin ON auth_account_key (coin);
+CREATE INDEX IF NOT EXISTS auth_account_key_idx_key_type ON auth_account_key (key_type);
+CREATE INDEX IF NOT EXISTS auth_account_key_idx_auth_account ON auth_account_key (auth_account);
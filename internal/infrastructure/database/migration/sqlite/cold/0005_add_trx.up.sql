@@ -0,0 +1,39 @@
+-- add trx to coin type code
+-- CHECK constraint can't be altered in SQLite, so tables are rebuilt and indexes are created again
+
+CREATE TABLE seed_new (
+  id         INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, -- ID
+  coin       TEXT NOT NULL CHECK (coin IN ('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx')), -- coin type code
+  seed       TEXT NOT NULL, -- seed
+  updated_at DATETIME DEFAULT CURRENT_TIMESTAMP -- updated date
+);
+INSERT INTO seed_new SELECT * FROM seed;
+DROP TABLE seed;
+ALTER TABLE seed_new RENAME TO seed;
+CREATE INDEX seed_idx_coin ON seed (coin);
+
+CREATE TABLE account_key_new (
+  id                   INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, -- ID
+  coin                 TEXT NOT NULL CHECK (coin IN ('btc', 'bch', 'eth', 'xrp', 'hyt', 'ltc', 'doge', 'sol', 'trx')), -- coin type code
+  key_type             TEXT NOT NULL DEFAULT 'bip44', -- key type (bip44, bip49, bip84, bip86, musig2)
+  account              TEXT NOT NULL CHECK (account IN ('client', 'deposit', 'payment', 'stored')), -- account type
+  p2pkh_address        TEXT NOT NULL, -- address as standard pubkey script that Pays To PubKey Hash (P2PKH)
+  p2sh_segwit_address  TEXT NOT NULL, -- p2sh-segwit address
+  bech32_address       TEXT NOT NULL, -- bech32 address
+  taproot_address      TEXT DEFAULT NULL, -- taproot address (BIP86)
+  full_public_key      TEXT NOT NULL, -- full public key
+  multisig_address     TEXT NOT NULL DEFAULT '', -- multisig address
+  redeem_script        TEXT NOT NULL DEFAULT '', -- redeedScript after multisig address generated
+  wallet_import_format TEXT NOT NULL, -- WIF
+  idx                  INTEGER NOT NULL, -- index for hd wallet
+  addr_status          INTEGER NOT NULL DEFAULT 0, -- progress status for address generating
+  updated_at           DATETIME DEFAULT CURRENT_TIMESTAMP -- updated date
+);
+INSERT INTO account_key_new SELECT * FROM account_key;
+DROP TABLE account_key;
+ALTER TABLE account_key_new RENAME TO account_key;
+CREATE UNIQUE INDEX account_key_idx_p2pkh_address ON account_key (p2pkh_address);
+CREATE UNIQUE INDEX account_key_idx_wallet_import_format ON account_key (wallet_import_format);
+CREATE INDEX account_key_idx_coin ON account_key (coin);
+CREATE INDEX account_key_idx_key_type ON account_key (key_type);
+CREATE INDEX account_key_idx_account ON account_key (account);
@@ -0,0 +1,69 @@
+-- add eth and xrp to coin type code of auth tables and auth accounts to xrp_account_key for multisigning
+-- CHECK constraint can't be altered in SQLite, so tables are rebuilt and indexes are created again
+
+CREATE TABLE xrp_account_key_new (
+  id                  INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, -- ID
+  coin                TEXT NOT NULL CHECK (coin IN ('xrp')), -- coin type code
+  account             TEXT NOT NULL CHECK (account IN ('client', 'deposit', 'payment', 'stored', 'auth1', 'auth2', 'auth3', 'auth4', 'auth5', 'auth6', 'auth7', 'auth8', 'auth9', 'auth10', 'auth11', 'auth12', 'auth13', 'auth14', 'auth15')), -- account type
+  account_id          TEXT NOT NULL, -- account_id
+  key_type            INTEGER NOT NULL DEFAULT 0, -- key_type
+  master_key          TEXT NOT NULL, -- master_key, DEPRECATED
+  master_seed         TEXT NOT NULL, -- master_seed
+  master_seed_hex     TEXT NOT NULL, -- master_seed_hex
+  public_key          TEXT NOT NULL, -- public_key
+  public_key_hex      TEXT NOT NULL, -- public_key_hex
+  is_regular_key_pair BOOLEAN NOT NULL DEFAULT false, -- true: this key is for regular key pair
+  allocated_id        INTEGER NOT NULL DEFAULT 0, -- index for hd wallet
+  addr_status         INTEGER NOT NULL DEFAULT 0, -- progress status for address generating
+  updated_at          DATETIME DEFAULT CURRENT_TIMESTAMP -- updated date
+);
+INSERT INTO xrp_account_key_new SELECT * FROM xrp_account_key;
+DROP TABLE xrp_account_key;
+ALTER TABLE xrp_account_key_new RENAME TO xrp_account_key;
+CREATE UNIQUE INDEX xrp_account_key_idx_account_id ON xrp_account_key (account_id);
+CREATE UNIQUE INDEX xrp_account_key_idx_master_seed ON xrp_account_key (master_seed);
+CREATE INDEX xrp_account_key_idx_coin ON xrp_account_key (coin);
+CREATE INDEX xrp_account_key_idx_account ON xrp_account_key (account);
+
+CREATE TABLE auth_fullpubkey_new (
+  id              INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, -- ID
+  coin            TEXT NOT NULL CHECK (coin IN ('btc', 'bch', 'ltc', 'doge', 'eth', 'xrp')), -- coin type code
+  auth_account    TEXT NOT NULL, -- auth type
+  full_public_key TEXT NOT NULL, -- full public key
+  updated_at      DATETIME DEFAULT CURRENT_TIMESTAMP -- updated date
+);
+INSERT INTO auth_fullpubkey_new SELECT * FROM auth_fullpubkey;
+DROP TABLE auth_fullpubkey;
+ALTER TABLE auth_fullpubkey_new RENAME TO auth_fullpubkey;
+CREATE UNIQUE INDEX auth_fullpubkey_idex_coin_auth_account ON auth_fullpubkey (coin, auth_account);
+CREATE UNIQUE INDEX auth_fullpubkey_idx_full_public_key ON auth_fullpubkey (full_public_key);
+CREATE INDEX auth_fullpubkey_idx_coin ON auth_fullpubkey (coin);
+
+CREATE TABLE auth_account_key_new (
+  id                   INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, -- ID
+  coin                 TEXT NOT NULL CHECK (coin IN ('btc', 'bch', 'ltc', 'doge', 'eth', 'xrp')), -- coin type code
+  key_type             TEXT NOT NULL DEFAULT 'bip44', -- key type (bip44, bip49, bip84, bip86, musig2)
+  auth_account         TEXT NOT NULL, -- auth type
+  p2pkh_address        TEXT NOT NULL, -- address as standard pubkey script that Pays To PubKey Hash (P2PKH)
+  p2sh_segwit_address  TEXT NOT NULL, -- p2sh-segwit address
+  bech32_address       TEXT NOT NULL, -- bech32 address
+  taproot_address      TEXT DEFAULT NULL, -- taproot address (BIP86)
+  full_public_key      TEXT NOT NULL, -- full public key
+  multisig_address     TEXT NOT NULL DEFAULT '', -- multisig address
+  redeem_script        TEXT NOT NULL DEFAULT '', -- redeedScript after multisig address generated
+  wallet_import_format TEXT NOT NULL, -- WIF
+  idx                  INTEGER NOT NULL, -- index for hd wallet
+  addr_status          INTEGER NOT NULL DEFAULT 0, -- progress status for address generating
+  updated_at           DATETIME DEFAULT CURRENT_TIMESTAMP -- updated date
+);
+INSERT INTO auth_account_key_new SELECT * FROM auth_account_key;
+DROP TABLE auth_account_key;
+ALTER TABLE auth_account_key_new RENAME TO auth_account_key;
+CREATE UNIQUE INDEX auth_account_key_idex_coin_auth_account ON auth_account_key (coin, auth_account);
+CREATE UNIQUE INDEX auth_account_key_idx_p2pkh_address ON auth_account_key (p2pkh_address);
+CREATE UNIQUE INDEX auth_account_key_idx_p2sh_segwit_address ON auth_account_key (p2sh_segwit_address);
+CREATE UNIQUE INDEX auth_account_key_idx_bech32_address ON auth_account_key (bech32_address);
+CREATE UNIQUE INDEX auth_account_key_idx_wallet_import_format ON auth_account_key (wallet_import_format);
+CREATE INDEX auth_account_key_idx_coin ON auth_account_key (coin);
+CREATE INDEX auth_account_key_idx_key_type ON auth_account_key (key_type);
+CREATE INDEX auth_account_key_idx_auth_account ON auth_account_key (auth_account);
@@ -0,0 +1,11 @@
+-- regular key pair of xrp account which signs transactions instead of master key
+-- pending regular key is activated once SetRegularKey transaction is validated
+
+-- key to sign transaction, 0: master key, 1: regular key
+ALTER TABLE xrp_account_key ADD COLUMN active_key INTEGER NOT NULL DEFAULT 0;
+-- account_id and seed of regular key pair
+ALTER TABLE xrp_account_key ADD COLUMN regular_key_account_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE xrp_account_key ADD COLUMN regular_key_seed TEXT NOT NULL DEFAULT '';
+-- account_id and seed of regular key pair waiting for SetRegularKey
+ALTER TABLE xrp_account_key ADD COLUMN pending_regular_key_account_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE xrp_account_key ADD COLUMN pending_regular_key_seed TEXT NOT NULL DEFAULT '';
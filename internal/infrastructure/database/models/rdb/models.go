@@ -4,6 +4,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/guregu/null/v6"
 	"github.com/quagmt/udecimal"
 )
@@ -132,6 +134,10 @@ type BTCTX struct {
 	UnsignedUpdatedAt null.Time `boil:"unsigned_updated_at" json:"unsigned_updated_at,omitempty"`
 	// updated date for signed transaction sent
 	SentUpdatedAt null.Time `boil:"sent_updated_at" json:"sent_updated_at,omitempty" toml:"sent_updated_at"`
+	// hash of block including confirmed transaction
+	BlockHash string `boil:"block_hash" json:"block_hash" toml:"block_hash" yaml:"block_hash"`
+	// height of block including confirmed transaction
+	BlockHeight int64 `boil:"block_height" json:"block_height" toml:"block_height" yaml:"block_height"`
 }
 
 // BTCTXInput is an object representing the database table.
@@ -174,6 +180,60 @@ type BTCTXOutput struct {
 	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 }
 
+// DaemonJob is an object representing the database table.
+type DaemonJob struct {
+	// ID
+	ID int64 `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// coin type code
+	Coin string `boil:"coin" json:"coin" toml:"coin" yaml:"coin"`
+	// job name
+	Name string `boil:"name" json:"name" toml:"name" yaml:"name"`
+	// status of last run
+	LastStatus string `boil:"last_status" json:"last_status" toml:"last_status" yaml:"last_status"`
+	// error message of last run
+	LastError string `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+	// started date of last run
+	LastStartedAt null.Time `boil:"last_started_at" json:"last_started_at,omitempty" toml:"last_started_at"`
+	// finished date of last run
+	LastFinishedAt null.Time `boil:"last_finished_at" json:"last_finished_at,omitempty" toml:"last_finished_at"`
+	// updated date
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+}
+
+// EthDeposit is an object representing the database table.
+type EthDeposit struct {
+	// ID
+	ID int64 `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// coin type code
+	Coin string `boil:"coin" json:"coin" toml:"coin" yaml:"coin"`
+	// ERC-20 token contract address, empty for native coin
+	TokenContract string `boil:"token_contract" json:"token_contract" toml:"token_contract" yaml:"token_contract"`
+	// transaction hash
+	TXHash string `boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	// log index in block for token transfer, index of call frame in transaction for native coin
+	LogIndex uint32 `boil:"log_index" json:"log_index" toml:"log_index" yaml:"log_index"`
+	// block number
+	BlockNumber uint64 `boil:"block_number" json:"block_number" toml:"block_number" yaml:"block_number"`
+	// block hash to detect reorg
+	BlockHash string `boil:"block_hash" json:"block_hash" toml:"block_hash" yaml:"block_hash"`
+	// block timestamp
+	BlockTime null.Time `boil:"block_time" json:"block_time,omitempty" toml:"block_time" yaml:"block_time,omitempty"`
+	// sender address
+	SenderAddress string `boil:"sender_address" json:"sender_address" toml:"sender_address" yaml:"sender_address"`
+	// receiver account
+	ReceiverAccount string `boil:"receiver_account" json:"receiver_account" toml:"receiver_account"`
+	// receiver address
+	ReceiverAddress string `boil:"receiver_address" json:"receiver_address" toml:"receiver_address"`
+	// amount in wei or token base units as decimal string, it may exceed uint64
+	Amount string `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	// true: block reaches confirmation depth
+	IsConfirmed bool `boil:"is_confirmed" json:"is_confirmed" toml:"is_confirmed" yaml:"is_confirmed"`
+	// created date
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	// confirmed date
+	ConfirmedAt null.Time `boil:"confirmed_at" json:"confirmed_at,omitempty" toml:"confirmed_at" yaml:"confirmed_at,omitempty"`
+}
+
 // EthDetailTX is an object representing the database table.
 type EthDetailTX struct {
 	// ID
@@ -246,6 +306,88 @@ type Seed struct {
 	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 }
 
+// SOLDetailTX is an object representing the database table.
+type SOLDetailTX struct {
+	// ID
+	ID int64 `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// tx table ID
+	TXID int64 `boil:"tx_id" json:"tx_id" toml:"tx_id" yaml:"tx_id"`
+	// UUID
+	UUID string `boil:"uuid" json:"uuid" toml:"uuid" yaml:"uuid"`
+	// current transaction type
+	CurrentTXType int8 `boil:"current_tx_type" json:"current_tx_type" toml:"current_tx_type" yaml:"current_tx_type"`
+	// sender account
+	SenderAccount string `boil:"sender_account" json:"sender_account" toml:"sender_account" yaml:"sender_account"`
+	// sender address
+	SenderAddress string `boil:"sender_address" json:"sender_address" toml:"sender_address" yaml:"sender_address"`
+	// receiver account
+	ReceiverAccount string `boil:"receiver_account" json:"receiver_account" toml:"receiver_account"`
+	// receiver address
+	ReceiverAddress string `boil:"receiver_address" json:"receiver_address" toml:"receiver_address"`
+	// amount of lamports or token base units to receive
+	Amount uint64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	// fee in lamports
+	Fee uint64 `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	// SPL token mint address, empty for SOL
+	TokenMint string `boil:"token_mint" json:"token_mint" toml:"token_mint" yaml:"token_mint"`
+	// durable nonce account
+	NonceAccount string `boil:"nonce_account" json:"nonce_account" toml:"nonce_account" yaml:"nonce_account"`
+	// durable nonce used as recent blockhash
+	Nonce string `boil:"nonce" json:"nonce" toml:"nonce" yaml:"nonce"`
+	// base64 string for unsigned transaction
+	UnsignedTX string `boil:"unsigned_tx" json:"unsigned_tx" toml:"unsigned_tx" yaml:"unsigned_tx"`
+	// base64 string for signed transaction
+	SignedTX string `boil:"signed_tx" json:"signed_tx" toml:"signed_tx" yaml:"signed_tx"`
+	// signature of sent transaction
+	SentSignature string `boil:"sent_signature" json:"sent_signature" toml:"sent_signature" yaml:"sent_signature"`
+	// updated date for unsigned transaction created
+	UnsignedUpdatedAt null.Time `boil:"unsigned_updated_at" json:"unsigned_updated_at,omitempty"`
+	// updated date for signed transaction sent
+	SentUpdatedAt null.Time `boil:"sent_updated_at" json:"sent_updated_at,omitempty" toml:"sent_updated_at"`
+}
+
+// TRXDetailTX is an object representing the database table.
+type TRXDetailTX struct {
+	// ID
+	ID int64 `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// tx table ID
+	TXID int64 `boil:"tx_id" json:"tx_id" toml:"tx_id" yaml:"tx_id"`
+	// UUID
+	UUID string `boil:"uuid" json:"uuid" toml:"uuid" yaml:"uuid"`
+	// current transaction type
+	CurrentTXType int8 `boil:"current_tx_type" json:"current_tx_type" toml:"current_tx_type" yaml:"current_tx_type"`
+	// sender account
+	SenderAccount string `boil:"sender_account" json:"sender_account" toml:"sender_account" yaml:"sender_account"`
+	// sender address
+	SenderAddress string `boil:"sender_address" json:"sender_address" toml:"sender_address" yaml:"sender_address"`
+	// receiver account
+	ReceiverAccount string `boil:"receiver_account" json:"receiver_account" toml:"receiver_account"`
+	// receiver address
+	ReceiverAddress string `boil:"receiver_address" json:"receiver_address" toml:"receiver_address"`
+	// amount of sun or token base units to receive
+	Amount uint64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	// estimated fee in sun
+	Fee uint64 `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	// max fee in sun for energy of TRC-20 transfer
+	FeeLimit uint64 `boil:"fee_limit" json:"fee_limit" toml:"fee_limit" yaml:"fee_limit"`
+	// TRC-20 contract address, empty for TRX
+	ContractAddress string `boil:"contract_address" json:"contract_address" toml:"contract_address"`
+	// reference block number
+	RefBlockNum uint64 `boil:"ref_block_num" json:"ref_block_num" toml:"ref_block_num" yaml:"ref_block_num"`
+	// expiration of transaction in unix milliseconds
+	Expiration int64 `boil:"expiration" json:"expiration" toml:"expiration" yaml:"expiration"`
+	// HEX string for unsigned transaction
+	UnsignedHexTX string `boil:"unsigned_hex_tx" json:"unsigned_hex_tx" toml:"unsigned_hex_tx" yaml:"unsigned_hex_tx"`
+	// HEX string for signed transaction
+	SignedHexTX string `boil:"signed_hex_tx" json:"signed_hex_tx" toml:"signed_hex_tx" yaml:"signed_hex_tx"`
+	// Hash for sent transaction
+	SentHashTX string `boil:"sent_hash_tx" json:"sent_hash_tx" toml:"sent_hash_tx" yaml:"sent_hash_tx"`
+	// updated date for unsigned transaction created
+	UnsignedUpdatedAt null.Time `boil:"unsigned_updated_at" json:"unsigned_updated_at,omitempty"`
+	// updated date for signed transaction sent
+	SentUpdatedAt null.Time `boil:"sent_updated_at" json:"sent_updated_at,omitempty" toml:"sent_updated_at"`
+}
+
 // TX is an object representing the database table.
 type TX struct {
 	// transaction ID
@@ -286,6 +428,72 @@ type XRPAccountKey struct {
 	AllocatedID int64 `boil:"allocated_id" json:"allocated_id" toml:"allocated_id" yaml:"allocated_id"`
 	// progress status for address generating
 	AddrStatus int8 `boil:"addr_status" json:"addr_status" toml:"addr_status" yaml:"addr_status"`
+	// key to sign transaction, 0: master key, 1: regular key
+	ActiveKey int8 `boil:"active_key" json:"active_key" toml:"active_key" yaml:"active_key"`
+	// account_id of regular key
+	RegularKeyAccountID string `boil:"regular_key_account_id" json:"regular_key_account_id" toml:"regular_key_account_id" yaml:"regular_key_account_id"` //nolint:lll
+	// seed of regular key
+	RegularKeySeed string `boil:"regular_key_seed" json:"regular_key_seed" toml:"regular_key_seed" yaml:"regular_key_seed"`
+	// account_id of regular key waiting for SetRegularKey to be validated
+	PendingRegularKeyAccountID string `boil:"pending_regular_key_account_id" json:"pending_regular_key_account_id" toml:"pending_regular_key_account_id" yaml:"pending_regular_key_account_id"` //nolint:lll
+	// seed of regular key waiting for SetRegularKey to be validated
+	PendingRegularKeySeed string `boil:"pending_regular_key_seed" json:"pending_regular_key_seed" toml:"pending_regular_key_seed" yaml:"pending_regular_key_seed"` //nolint:lll
+	// updated date
+	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+}
+
+// XRPDeposit is an object representing the database table.
+type XRPDeposit struct {
+	// ID
+	ID int64 `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// transaction hash
+	TXHash string `boil:"tx_hash" json:"tx_hash" toml:"tx_hash" yaml:"tx_hash"`
+	// ledger index in which transaction is validated
+	LedgerIndex uint64 `boil:"ledger_index" json:"ledger_index" toml:"ledger_index" yaml:"ledger_index"`
+	// sender address
+	SenderAddress string `boil:"sender_address" json:"sender_address" toml:"sender_address" yaml:"sender_address"`
+	// shared deposit address
+	ReceiverAddress string `boil:"receiver_address" json:"receiver_address" toml:"receiver_address"`
+	// destination tag, null if it is not given
+	DestinationTag null.Int64 `boil:"destination_tag" json:"destination_tag,omitempty" toml:"destination_tag"`
+	// X-address of client identified by destination tag, empty if quarantined
+	ClientAddress string `boil:"client_address" json:"client_address" toml:"client_address" yaml:"client_address"`
+	// delivered amount, drops for XRP or value for issued currency
+	Amount string `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	// currency code of issued currency, empty for XRP
+	Currency string `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	// issuer of issued currency, empty for XRP
+	Issuer string `boil:"issuer" json:"issuer" toml:"issuer" yaml:"issuer"`
+	// true: client is not identified, it must be handled manually
+	IsQuarantined bool `boil:"is_quarantined" json:"is_quarantined" toml:"is_quarantined" yaml:"is_quarantined"`
+	// created date
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+}
+
+// XRPEscrow is an object representing the database table.
+type XRPEscrow struct {
+	// ID
+	ID int64 `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// address which creates escrow
+	OwnerAddress string `boil:"owner_address" json:"owner_address" toml:"owner_address" yaml:"owner_address"`
+	// address which receives escrowed XRP
+	DestinationAddress string `boil:"destination_address" json:"destination_address" toml:"destination_address"`
+	// escrowed amount in drops
+	Amount string `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	// sequence or ticket of EscrowCreate, escrow is identified by owner and it
+	OfferSequence uint64 `boil:"offer_sequence" json:"offer_sequence" toml:"offer_sequence" yaml:"offer_sequence"`
+	// escrow can be finished after this time
+	FinishAfter time.Time `boil:"finish_after" json:"finish_after" toml:"finish_after" yaml:"finish_after"`
+	// escrow can be canceled after this time, null if it never expires
+	CancelAfter null.Time `boil:"cancel_after" json:"cancel_after,omitempty" toml:"cancel_after" yaml:"cancel_after,omitempty"`
+	// UUID of EscrowCreate in xrp_detail_tx
+	CreateUUID string `boil:"create_uuid" json:"create_uuid" toml:"create_uuid" yaml:"create_uuid"`
+	// UUID of EscrowFinish or EscrowCancel in xrp_detail_tx, empty until it is created
+	FinishUUID string `boil:"finish_uuid" json:"finish_uuid" toml:"finish_uuid" yaml:"finish_uuid"`
+	// 0: created, 1: finishing, 2: finished, 3: canceled, 4: failed
+	Status int8 `boil:"status" json:"status" toml:"status" yaml:"status"`
+	// created date
+	CreatedAt null.Time `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	// updated date
 	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 }
@@ -308,8 +516,12 @@ type XRPDetailTX struct {
 	ReceiverAccount string `boil:"receiver_account" json:"receiver_account" toml:"receiver_account"`
 	// receiver address
 	ReceiverAddress string `boil:"receiver_address" json:"receiver_address" toml:"receiver_address"`
-	// amount of coin to receive
+	// amount of coin to receive, drops for XRP or value for issued currency
 	Amount string `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	// currency code of issued currency, empty for XRP
+	Currency string `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	// issuer of issued currency, empty for XRP
+	Issuer string `boil:"issuer" json:"issuer" toml:"issuer" yaml:"issuer"`
 	// xrp tx type like `Payment`
 	XRPTXType string `boil:"xrp_tx_type" json:"xrp_tx_type" toml:"xrp_tx_type" yaml:"xrp_tx_type"`
 	// tx fee
@@ -320,6 +532,8 @@ type XRPDetailTX struct {
 	LastLedgerSequence uint64 `boil:"last_ledger_sequence" json:"last_ledger_sequence" toml:"last_ledger_sequence"`
 	// tx Sequence
 	Sequence uint64 `boil:"sequence" json:"sequence" toml:"sequence" yaml:"sequence"`
+	// tx TicketSequence, 0 if sequence is used
+	TicketSequence uint64 `boil:"ticket_sequence" json:"ticket_sequence" toml:"ticket_sequence" yaml:"ticket_sequence"`
 	// tx SigningPubKey
 	SigningPubkey string `boil:"signing_pubkey" json:"signing_pubkey" toml:"signing_pubkey" yaml:"signing_pubkey"`
 	// tx TxnSignature
@@ -334,4 +548,8 @@ type XRPDetailTX struct {
 	TXBlob string `boil:"tx_blob" json:"tx_blob" toml:"tx_blob" yaml:"tx_blob"`
 	// updated date for signed transaction sent
 	SentUpdatedAt null.Time `boil:"sent_updated_at" json:"sent_updated_at,omitempty" toml:"sent_updated_at"`
+	// number of times transaction is resubmitted after expiration
+	RetryCount uint32 `boil:"retry_count" json:"retry_count" toml:"retry_count" yaml:"retry_count"`
+	// payment_request table ID for payment action, 0 for other actions
+	PaymentRequestID int64 `boil:"payment_request_id" json:"payment_request_id" toml:"payment_request_id" yaml:"payment_request_id"`
 }
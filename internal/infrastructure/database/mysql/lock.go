@@ -0,0 +1,48 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// Locker is leader lock using MySQL named lock `GET_LOCK()`
+//
+// named lock belongs to session, so dedicated connection is kept while lock is held.
+// lock is released automatically by MySQL server when the connection is lost
+type Locker struct {
+	db *sql.DB
+}
+
+// NewLocker returns Locker
+func NewLocker(db *sql.DB) *Locker {
+	return &Locker{db: db}
+}
+
+// TryLock acquires named lock without waiting
+func (l *Locker) TryLock(ctx context.Context, name string) (func(), bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("fail to call db.Conn(): %w", err)
+	}
+
+	var result sql.NullInt64
+	if err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, 0)", name).Scan(&result); err != nil {
+		_ = conn.Close()
+		return nil, false, fmt.Errorf("fail to call GET_LOCK(%s): %w", name, err)
+	}
+	if !result.Valid || result.Int64 != 1 {
+		_ = conn.Close()
+		return nil, false, nil
+	}
+
+	unlock := func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", name); err != nil {
+			logger.Warn("fail to call RELEASE_LOCK()", "name", name, "error", err)
+		}
+		_ = conn.Close()
+	}
+	return unlock, true, nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+// NewPostgres connect to PostgreSQL server
+func NewPostgres(conf *config.Postgres) (*sql.DB, error) {
+	sslMode := conf.SSLMode
+	if sslMode == "" {
+		sslMode = "disable"
+	}
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(conf.User, conf.Pass),
+		Host:     conf.Host,
+		Path:     conf.DB,
+		RawQuery: url.Values{"sslmode": []string{sslMode}}.Encode(),
+	}
+	db, err := sql.Open("postgres", dsn.String())
+	if err != nil {
+		return nil, fmt.Errorf("Connection(): error: %v", err)
+	}
+	return db, nil
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// Locker is leader lock using PostgreSQL session level advisory lock
+//
+// advisory lock belongs to session, so dedicated connection is kept while lock is held.
+// lock is released automatically by PostgreSQL server when the connection is lost
+type Locker struct {
+	db *sql.DB
+}
+
+// NewLocker returns Locker
+func NewLocker(db *sql.DB) *Locker {
+	return &Locker{db: db}
+}
+
+// TryLock acquires advisory lock keyed by hash of name without waiting
+func (l *Locker) TryLock(ctx context.Context, name string) (func(), bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("fail to call db.Conn(): %w", err)
+	}
+
+	var locked bool
+	if err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", name).Scan(&locked); err != nil {
+		_ = conn.Close()
+		return nil, false, fmt.Errorf("fail to call pg_try_advisory_lock(%s): %w", name, err)
+	}
+	if !locked {
+		_ = conn.Close()
+		return nil, false, nil
+	}
+
+	unlock := func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", name); err != nil {
+			logger.Warn("fail to call pg_advisory_unlock()", "name", name, "error", err)
+		}
+		_ = conn.Close()
+	}
+	return unlock, true, nil
+}
@@ -12,7 +12,7 @@ import (
 )
 
 const getAccountKeysByAddrStatus = `-- name: GetAccountKeysByAddrStatus :many
-SELECT id, coin, key_type, account, p2pkh_address, p2sh_segwit_address, bech32_address, taproot_address, full_public_key, multisig_address, redeem_script, wallet_import_format, idx, addr_status, updated_at FROM account_key WHERE coin = ? AND account = ? AND addr_status = ?
+SELECT id, key_type, account, p2pkh_address, p2sh_segwit_address, bech32_address, taproot_address, full_public_key, multisig_address, redeem_script, wallet_import_format, idx, addr_status, updated_at, coin FROM account_key WHERE coin = ? AND account = ? AND addr_status = ?
 `
 
 type GetAccountKeysByAddrStatusParams struct {
@@ -32,7 +32,6 @@ func (q *Queries) GetAccountKeysByAddrStatus(ctx context.Context, arg GetAccount
 		var i AccountKey
 		if err := rows.Scan(
 			&i.ID,
-			&i.Coin,
 			&i.KeyType,
 			&i.Account,
 			&i.P2pkhAddress,
@@ -46,6 +45,7 @@ func (q *Queries) GetAccountKeysByAddrStatus(ctx context.Context, arg GetAccount
 			&i.Idx,
 			&i.AddrStatus,
 			&i.UpdatedAt,
+			&i.Coin,
 		); err != nil {
 			return nil, err
 		}
@@ -61,7 +61,7 @@ func (q *Queries) GetAccountKeysByAddrStatus(ctx context.Context, arg GetAccount
 }
 
 const getAccountKeysByMultisigAddresses = `-- name: GetAccountKeysByMultisigAddresses :many
-SELECT id, coin, key_type, account, p2pkh_address, p2sh_segwit_address, bech32_address, taproot_address, full_public_key, multisig_address, redeem_script, wallet_import_format, idx, addr_status, updated_at FROM account_key WHERE coin = ? AND account = ? AND multisig_address IN (/*SLICE:addrs*/?)
+SELECT id, key_type, account, p2pkh_address, p2sh_segwit_address, bech32_address, taproot_address, full_public_key, multisig_address, redeem_script, wallet_import_format, idx, addr_status, updated_at, coin FROM account_key WHERE coin = ? AND account = ? AND multisig_address IN (/*SLICE:addrs*/?)
 `
 
 type GetAccountKeysByMultisigAddressesParams struct {
@@ -93,7 +93,6 @@ func (q *Queries) GetAccountKeysByMultisigAddresses(ctx context.Context, arg Get
 		var i AccountKey
 		if err := rows.Scan(
 			&i.ID,
-			&i.Coin,
 			&i.KeyType,
 			&i.Account,
 			&i.P2pkhAddress,
@@ -107,6 +106,7 @@ func (q *Queries) GetAccountKeysByMultisigAddresses(ctx context.Context, arg Get
 			&i.Idx,
 			&i.AddrStatus,
 			&i.UpdatedAt,
+			&i.Coin,
 		); err != nil {
 			return nil, err
 		}
@@ -138,7 +138,7 @@ func (q *Queries) GetMaxAccountKeyIndex(ctx context.Context, arg GetMaxAccountKe
 }
 
 const getOneAccountKeyByMaxID = `-- name: GetOneAccountKeyByMaxID :one
-SELECT id, coin, key_type, account, p2pkh_address, p2sh_segwit_address, bech32_address, taproot_address, full_public_key, multisig_address, redeem_script, wallet_import_format, idx, addr_status, updated_at FROM account_key WHERE coin = ? AND account = ? ORDER BY id DESC LIMIT 1
+SELECT id, key_type, account, p2pkh_address, p2sh_segwit_address, bech32_address, taproot_address, full_public_key, multisig_address, redeem_script, wallet_import_format, idx, addr_status, updated_at, coin FROM account_key WHERE coin = ? AND account = ? ORDER BY id DESC LIMIT 1
 `
 
 type GetOneAccountKeyByMaxIDParams struct {
@@ -151,7 +151,6 @@ func (q *Queries) GetOneAccountKeyByMaxID(ctx context.Context, arg GetOneAccount
 	var i AccountKey
 	err := row.Scan(
 		&i.ID,
-		&i.Coin,
 		&i.KeyType,
 		&i.Account,
 		&i.P2pkhAddress,
@@ -165,6 +164,7 @@ func (q *Queries) GetOneAccountKeyByMaxID(ctx context.Context, arg GetOneAccount
 		&i.Idx,
 		&i.AddrStatus,
 		&i.UpdatedAt,
+		&i.Coin,
 	)
 	return i, err
 }
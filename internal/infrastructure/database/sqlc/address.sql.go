@@ -10,6 +10,23 @@ import (
 	"database/sql"
 )
 
+const countUnallocatedAddresses = `-- name: CountUnallocatedAddresses :one
+SELECT COUNT(*) as count FROM address
+WHERE coin = ? AND account = ? AND is_allocated = false
+`
+
+type CountUnallocatedAddressesParams struct {
+	Coin    AddressCoin
+	Account AddressAccount
+}
+
+func (q *Queries) CountUnallocatedAddresses(ctx context.Context, arg CountUnallocatedAddressesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnallocatedAddresses, arg.Coin, arg.Account)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getAllAddressStrings = `-- name: GetAllAddressStrings :many
 SELECT wallet_address FROM address
 WHERE coin = ? AND account = ?
@@ -44,7 +61,7 @@ func (q *Queries) GetAllAddressStrings(ctx context.Context, arg GetAllAddressStr
 }
 
 const getAllAddresses = `-- name: GetAllAddresses :many
-SELECT id, coin, account, wallet_address, is_allocated, updated_at FROM address
+SELECT id, account, wallet_address, is_allocated, updated_at, coin FROM address
 WHERE coin = ? AND account = ?
 `
 
@@ -64,11 +81,11 @@ func (q *Queries) GetAllAddresses(ctx context.Context, arg GetAllAddressesParams
 		var i Address
 		if err := rows.Scan(
 			&i.ID,
-			&i.Coin,
 			&i.Account,
 			&i.WalletAddress,
 			&i.IsAllocated,
 			&i.UpdatedAt,
+			&i.Coin,
 		); err != nil {
 			return nil, err
 		}
@@ -84,7 +101,7 @@ func (q *Queries) GetAllAddresses(ctx context.Context, arg GetAllAddressesParams
 }
 
 const getOneUnallocatedAddress = `-- name: GetOneUnallocatedAddress :one
-SELECT id, coin, account, wallet_address, is_allocated, updated_at FROM address
+SELECT id, account, wallet_address, is_allocated, updated_at, coin FROM address
 WHERE coin = ? AND account = ? AND is_allocated = false
 LIMIT 1
 `
@@ -99,11 +116,11 @@ func (q *Queries) GetOneUnallocatedAddress(ctx context.Context, arg GetOneUnallo
 	var i Address
 	err := row.Scan(
 		&i.ID,
-		&i.Coin,
 		&i.Account,
 		&i.WalletAddress,
 		&i.IsAllocated,
 		&i.UpdatedAt,
+		&i.Coin,
 	)
 	return i, err
 }
@@ -11,7 +11,7 @@ import (
 )
 
 const getAuthAccountKey = `-- name: GetAuthAccountKey :one
-SELECT id, coin, key_type, auth_account, p2pkh_address, p2sh_segwit_address, bech32_address, taproot_address, full_public_key, multisig_address, redeem_script, wallet_import_format, idx, addr_status, updated_at FROM auth_account_key WHERE coin = ? AND auth_account = ? LIMIT 1
+SELECT id, key_type, auth_account, p2pkh_address, p2sh_segwit_address, bech32_address, taproot_address, full_public_key, multisig_address, redeem_script, wallet_import_format, idx, addr_status, updated_at, coin FROM auth_account_key WHERE coin = ? AND auth_account = ? LIMIT 1
 `
 
 type GetAuthAccountKeyParams struct {
@@ -24,7 +24,6 @@ func (q *Queries) GetAuthAccountKey(ctx context.Context, arg GetAuthAccountKeyPa
 	var i AuthAccountKey
 	err := row.Scan(
 		&i.ID,
-		&i.Coin,
 		&i.KeyType,
 		&i.AuthAccount,
 		&i.P2pkhAddress,
@@ -38,6 +37,7 @@ func (q *Queries) GetAuthAccountKey(ctx context.Context, arg GetAuthAccountKeyPa
 		&i.Idx,
 		&i.AddrStatus,
 		&i.UpdatedAt,
+		&i.Coin,
 	)
 	return i, err
 }
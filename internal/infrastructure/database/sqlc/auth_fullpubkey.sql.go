@@ -11,7 +11,7 @@ import (
 )
 
 const getAuthFullPubkey = `-- name: GetAuthFullPubkey :one
-SELECT id, coin, auth_account, full_public_key, updated_at FROM auth_fullpubkey WHERE coin = ? AND auth_account = ? LIMIT 1
+SELECT id, auth_account, full_public_key, updated_at, coin FROM auth_fullpubkey WHERE coin = ? AND auth_account = ? LIMIT 1
 `
 
 type GetAuthFullPubkeyParams struct {
@@ -24,10 +24,10 @@ func (q *Queries) GetAuthFullPubkey(ctx context.Context, arg GetAuthFullPubkeyPa
 	var i AuthFullpubkey
 	err := row.Scan(
 		&i.ID,
-		&i.Coin,
 		&i.AuthAccount,
 		&i.FullPublicKey,
 		&i.UpdatedAt,
+		&i.Coin,
 	)
 	return i, err
 }
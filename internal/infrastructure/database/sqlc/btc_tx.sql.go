@@ -19,7 +19,7 @@ func (q *Queries) DeleteAllBtcTx(ctx context.Context) (sql.Result, error) {
 }
 
 const getBtcTxByID = `-- name: GetBtcTxByID :one
-SELECT id, coin, action, unsigned_hex_tx, signed_hex_tx, sent_hash_tx, total_input_amount, total_output_amount, fee, current_tx_type, unsigned_updated_at, sent_updated_at FROM btc_tx
+SELECT id, action, unsigned_hex_tx, signed_hex_tx, sent_hash_tx, total_input_amount, total_output_amount, fee, current_tx_type, unsigned_updated_at, sent_updated_at, block_hash, block_height, coin FROM btc_tx
 WHERE id = ?
 `
 
@@ -28,7 +28,6 @@ func (q *Queries) GetBtcTxByID(ctx context.Context, id int64) (BtcTx, error) {
 	var i BtcTx
 	err := row.Scan(
 		&i.ID,
-		&i.Coin,
 		&i.Action,
 		&i.UnsignedHexTx,
 		&i.SignedHexTx,
@@ -39,10 +38,70 @@ func (q *Queries) GetBtcTxByID(ctx context.Context, id int64) (BtcTx, error) {
 		&i.CurrentTxType,
 		&i.UnsignedUpdatedAt,
 		&i.SentUpdatedAt,
+		&i.BlockHash,
+		&i.BlockHeight,
+		&i.Coin,
 	)
 	return i, err
 }
 
+const getBtcTxConfirmedListFromHeight = `-- name: GetBtcTxConfirmedListFromHeight :many
+SELECT id, action, unsigned_hex_tx, signed_hex_tx, sent_hash_tx, total_input_amount, total_output_amount, fee, current_tx_type, unsigned_updated_at, sent_updated_at, block_hash, block_height, coin FROM btc_tx
+WHERE coin = ? AND action = ? AND current_tx_type IN (?, ?) AND block_height >= ?
+`
+
+type GetBtcTxConfirmedListFromHeightParams struct {
+	Coin            BtcTxCoin
+	Action          BtcTxAction
+	CurrentTxType   int8
+	CurrentTxType_2 int8
+	BlockHeight     int64
+}
+
+func (q *Queries) GetBtcTxConfirmedListFromHeight(ctx context.Context, arg GetBtcTxConfirmedListFromHeightParams) ([]BtcTx, error) {
+	rows, err := q.db.QueryContext(ctx, getBtcTxConfirmedListFromHeight,
+		arg.Coin,
+		arg.Action,
+		arg.CurrentTxType,
+		arg.CurrentTxType_2,
+		arg.BlockHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BtcTx
+	for rows.Next() {
+		var i BtcTx
+		if err := rows.Scan(
+			&i.ID,
+			&i.Action,
+			&i.UnsignedHexTx,
+			&i.SignedHexTx,
+			&i.SentHashTx,
+			&i.TotalInputAmount,
+			&i.TotalOutputAmount,
+			&i.Fee,
+			&i.CurrentTxType,
+			&i.UnsignedUpdatedAt,
+			&i.SentUpdatedAt,
+			&i.BlockHash,
+			&i.BlockHeight,
+			&i.Coin,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getBtcTxCountByUnsignedHex = `-- name: GetBtcTxCountByUnsignedHex :one
 SELECT COUNT(*) as count FROM btc_tx
 WHERE coin = ? AND action = ? AND unsigned_hex_tx = ?
@@ -97,6 +156,25 @@ func (q *Queries) GetBtcTxIDByUnsignedHex(ctx context.Context, arg GetBtcTxIDByU
 	return id, err
 }
 
+const getBtcTxOldestUnsignedUpdatedAt = `-- name: GetBtcTxOldestUnsignedUpdatedAt :one
+SELECT unsigned_updated_at FROM btc_tx
+WHERE coin = ? AND current_tx_type = ?
+ORDER BY unsigned_updated_at
+LIMIT 1
+`
+
+type GetBtcTxOldestUnsignedUpdatedAtParams struct {
+	Coin          BtcTxCoin
+	CurrentTxType int8
+}
+
+func (q *Queries) GetBtcTxOldestUnsignedUpdatedAt(ctx context.Context, arg GetBtcTxOldestUnsignedUpdatedAtParams) (sql.NullTime, error) {
+	row := q.db.QueryRowContext(ctx, getBtcTxOldestUnsignedUpdatedAt, arg.Coin, arg.CurrentTxType)
+	var unsigned_updated_at sql.NullTime
+	err := row.Scan(&unsigned_updated_at)
+	return unsigned_updated_at, err
+}
+
 const getBtcTxSentHashList = `-- name: GetBtcTxSentHashList :many
 SELECT sent_hash_tx FROM btc_tx
 WHERE coin = ? AND action = ? AND current_tx_type = ?
@@ -169,6 +247,21 @@ func (q *Queries) InsertBtcTx(ctx context.Context, arg InsertBtcTxParams) (sql.R
 	)
 }
 
+const rollbackBtcTxToSent = `-- name: RollbackBtcTxToSent :execresult
+UPDATE btc_tx
+SET current_tx_type = ?, block_hash = '', block_height = 0
+WHERE id = ?
+`
+
+type RollbackBtcTxToSentParams struct {
+	CurrentTxType int8
+	ID            int64
+}
+
+func (q *Queries) RollbackBtcTxToSent(ctx context.Context, arg RollbackBtcTxToSentParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, rollbackBtcTxToSent, arg.CurrentTxType, arg.ID)
+}
+
 const updateBtcTx = `-- name: UpdateBtcTx :exec
 UPDATE btc_tx
 SET coin = ?, action = ?, unsigned_hex_tx = ?, signed_hex_tx = ?, sent_hash_tx = ?,
@@ -234,6 +327,22 @@ func (q *Queries) UpdateBtcTxAfterSent(ctx context.Context, arg UpdateBtcTxAfter
 	)
 }
 
+const updateBtcTxBlock = `-- name: UpdateBtcTxBlock :execresult
+UPDATE btc_tx
+SET block_hash = ?, block_height = ?
+WHERE id = ?
+`
+
+type UpdateBtcTxBlockParams struct {
+	BlockHash   string
+	BlockHeight int64
+	ID          int64
+}
+
+func (q *Queries) UpdateBtcTxBlock(ctx context.Context, arg UpdateBtcTxBlockParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateBtcTxBlock, arg.BlockHash, arg.BlockHeight, arg.ID)
+}
+
 const updateBtcTxType = `-- name: UpdateBtcTxType :execresult
 UPDATE btc_tx
 SET current_tx_type = ?
@@ -10,6 +10,21 @@ import (
 	"database/sql"
 )
 
+const getEthDetailTxActionBySentHash = `-- name: GetEthDetailTxActionBySentHash :one
+SELECT tx.action
+FROM eth_detail_tx
+INNER JOIN tx ON tx.id = eth_detail_tx.tx_id
+WHERE eth_detail_tx.sent_hash_tx = ?
+LIMIT 1
+`
+
+func (q *Queries) GetEthDetailTxActionBySentHash(ctx context.Context, sentHashTx string) (TxAction, error) {
+	row := q.db.QueryRowContext(ctx, getEthDetailTxActionBySentHash, sentHashTx)
+	var action TxAction
+	err := row.Scan(&action)
+	return action, err
+}
+
 const getEthDetailTxByID = `-- name: GetEthDetailTxByID :one
 SELECT id, tx_id, uuid, current_tx_type, sender_account, sender_address, receiver_account, receiver_address, amount, fee, gas_limit, nonce, unsigned_hex_tx, signed_hex_tx, sent_hash_tx, unsigned_updated_at, sent_updated_at FROM eth_detail_tx
 WHERE id = ?
@@ -40,6 +55,27 @@ func (q *Queries) GetEthDetailTxByID(ctx context.Context, id int64) (EthDetailTx
 	return i, err
 }
 
+const getEthDetailTxOldestUnsignedUpdatedAt = `-- name: GetEthDetailTxOldestUnsignedUpdatedAt :one
+SELECT eth_detail_tx.unsigned_updated_at
+FROM eth_detail_tx
+INNER JOIN tx ON tx.id = eth_detail_tx.tx_id
+WHERE tx.coin = ? AND eth_detail_tx.current_tx_type = ?
+ORDER BY eth_detail_tx.unsigned_updated_at
+LIMIT 1
+`
+
+type GetEthDetailTxOldestUnsignedUpdatedAtParams struct {
+	Coin          TxCoin
+	CurrentTxType int8
+}
+
+func (q *Queries) GetEthDetailTxOldestUnsignedUpdatedAt(ctx context.Context, arg GetEthDetailTxOldestUnsignedUpdatedAtParams) (sql.NullTime, error) {
+	row := q.db.QueryRowContext(ctx, getEthDetailTxOldestUnsignedUpdatedAt, arg.Coin, arg.CurrentTxType)
+	var unsigned_updated_at sql.NullTime
+	err := row.Scan(&unsigned_updated_at)
+	return unsigned_updated_at, err
+}
+
 const getEthDetailTxSentHashList = `-- name: GetEthDetailTxSentHashList :many
 SELECT eth_detail_tx.sent_hash_tx
 FROM eth_detail_tx
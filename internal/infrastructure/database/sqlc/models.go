@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
+	"time"
 )
 
 type AccountKeyAccount string
@@ -57,11 +58,19 @@ func (ns NullAccountKeyAccount) Value() (driver.Value, error) {
 type AccountKeyCoin string
 
 const (
-	AccountKeyCoinBtc AccountKeyCoin = "btc"
-	AccountKeyCoinBch AccountKeyCoin = "bch"
-	AccountKeyCoinEth AccountKeyCoin = "eth"
-	AccountKeyCoinXrp AccountKeyCoin = "xrp"
-	AccountKeyCoinHyt AccountKeyCoin = "hyt"
+	AccountKeyCoinBtc  AccountKeyCoin = "btc"
+	AccountKeyCoinBch  AccountKeyCoin = "bch"
+	AccountKeyCoinEth  AccountKeyCoin = "eth"
+	AccountKeyCoinXrp  AccountKeyCoin = "xrp"
+	AccountKeyCoinHyt  AccountKeyCoin = "hyt"
+	AccountKeyCoinLtc  AccountKeyCoin = "ltc"
+	AccountKeyCoinDoge AccountKeyCoin = "doge"
+	AccountKeyCoinSol  AccountKeyCoin = "sol"
+	AccountKeyCoinTrx  AccountKeyCoin = "trx"
+	AccountKeyCoinPol  AccountKeyCoin = "pol"
+	AccountKeyCoinBnb  AccountKeyCoin = "bnb"
+	AccountKeyCoinArb  AccountKeyCoin = "arb"
+	AccountKeyCoinBase AccountKeyCoin = "base"
 )
 
 func (e *AccountKeyCoin) Scan(src interface{}) error {
@@ -146,11 +155,19 @@ func (ns NullAddressAccount) Value() (driver.Value, error) {
 type AddressCoin string
 
 const (
-	AddressCoinBtc AddressCoin = "btc"
-	AddressCoinBch AddressCoin = "bch"
-	AddressCoinEth AddressCoin = "eth"
-	AddressCoinXrp AddressCoin = "xrp"
-	AddressCoinHyt AddressCoin = "hyt"
+	AddressCoinBtc  AddressCoin = "btc"
+	AddressCoinBch  AddressCoin = "bch"
+	AddressCoinEth  AddressCoin = "eth"
+	AddressCoinXrp  AddressCoin = "xrp"
+	AddressCoinHyt  AddressCoin = "hyt"
+	AddressCoinLtc  AddressCoin = "ltc"
+	AddressCoinDoge AddressCoin = "doge"
+	AddressCoinSol  AddressCoin = "sol"
+	AddressCoinTrx  AddressCoin = "trx"
+	AddressCoinPol  AddressCoin = "pol"
+	AddressCoinBnb  AddressCoin = "bnb"
+	AddressCoinArb  AddressCoin = "arb"
+	AddressCoinBase AddressCoin = "base"
 )
 
 func (e *AddressCoin) Scan(src interface{}) error {
@@ -191,8 +208,12 @@ func (ns NullAddressCoin) Value() (driver.Value, error) {
 type AuthAccountKeyCoin string
 
 const (
-	AuthAccountKeyCoinBtc AuthAccountKeyCoin = "btc"
-	AuthAccountKeyCoinBch AuthAccountKeyCoin = "bch"
+	AuthAccountKeyCoinBtc  AuthAccountKeyCoin = "btc"
+	AuthAccountKeyCoinBch  AuthAccountKeyCoin = "bch"
+	AuthAccountKeyCoinLtc  AuthAccountKeyCoin = "ltc"
+	AuthAccountKeyCoinDoge AuthAccountKeyCoin = "doge"
+	AuthAccountKeyCoinEth  AuthAccountKeyCoin = "eth"
+	AuthAccountKeyCoinXrp  AuthAccountKeyCoin = "xrp"
 )
 
 func (e *AuthAccountKeyCoin) Scan(src interface{}) error {
@@ -233,8 +254,12 @@ func (ns NullAuthAccountKeyCoin) Value() (driver.Value, error) {
 type AuthFullpubkeyCoin string
 
 const (
-	AuthFullpubkeyCoinBtc AuthFullpubkeyCoin = "btc"
-	AuthFullpubkeyCoinBch AuthFullpubkeyCoin = "bch"
+	AuthFullpubkeyCoinBtc  AuthFullpubkeyCoin = "btc"
+	AuthFullpubkeyCoinBch  AuthFullpubkeyCoin = "bch"
+	AuthFullpubkeyCoinLtc  AuthFullpubkeyCoin = "ltc"
+	AuthFullpubkeyCoinDoge AuthFullpubkeyCoin = "doge"
+	AuthFullpubkeyCoinEth  AuthFullpubkeyCoin = "eth"
+	AuthFullpubkeyCoinXrp  AuthFullpubkeyCoin = "xrp"
 )
 
 func (e *AuthFullpubkeyCoin) Scan(src interface{}) error {
@@ -318,8 +343,10 @@ func (ns NullBtcTxAction) Value() (driver.Value, error) {
 type BtcTxCoin string
 
 const (
-	BtcTxCoinBtc BtcTxCoin = "btc"
-	BtcTxCoinBch BtcTxCoin = "bch"
+	BtcTxCoinBtc  BtcTxCoin = "btc"
+	BtcTxCoinBch  BtcTxCoin = "bch"
+	BtcTxCoinLtc  BtcTxCoin = "ltc"
+	BtcTxCoinDoge BtcTxCoin = "doge"
 )
 
 func (e *BtcTxCoin) Scan(src interface{}) error {
@@ -357,13 +384,164 @@ func (ns NullBtcTxCoin) Value() (driver.Value, error) {
 	return string(ns.BtcTxCoin), nil
 }
 
+type DaemonJobCoin string
+
+const (
+	DaemonJobCoinBtc  DaemonJobCoin = "btc"
+	DaemonJobCoinBch  DaemonJobCoin = "bch"
+	DaemonJobCoinEth  DaemonJobCoin = "eth"
+	DaemonJobCoinXrp  DaemonJobCoin = "xrp"
+	DaemonJobCoinHyt  DaemonJobCoin = "hyt"
+	DaemonJobCoinLtc  DaemonJobCoin = "ltc"
+	DaemonJobCoinDoge DaemonJobCoin = "doge"
+	DaemonJobCoinSol  DaemonJobCoin = "sol"
+	DaemonJobCoinTrx  DaemonJobCoin = "trx"
+	DaemonJobCoinPol  DaemonJobCoin = "pol"
+	DaemonJobCoinBnb  DaemonJobCoin = "bnb"
+	DaemonJobCoinArb  DaemonJobCoin = "arb"
+	DaemonJobCoinBase DaemonJobCoin = "base"
+)
+
+func (e *DaemonJobCoin) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DaemonJobCoin(s)
+	case string:
+		*e = DaemonJobCoin(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DaemonJobCoin: %T", src)
+	}
+	return nil
+}
+
+type NullDaemonJobCoin struct {
+	DaemonJobCoin DaemonJobCoin
+	Valid         bool // Valid is true if DaemonJobCoin is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDaemonJobCoin) Scan(value interface{}) error {
+	if value == nil {
+		ns.DaemonJobCoin, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DaemonJobCoin.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDaemonJobCoin) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DaemonJobCoin), nil
+}
+
+type DaemonJobLastStatus string
+
+const (
+	DaemonJobLastStatusRunning DaemonJobLastStatus = "running"
+	DaemonJobLastStatusSuccess DaemonJobLastStatus = "success"
+	DaemonJobLastStatusFailure DaemonJobLastStatus = "failure"
+	DaemonJobLastStatusSkipped DaemonJobLastStatus = "skipped"
+)
+
+func (e *DaemonJobLastStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DaemonJobLastStatus(s)
+	case string:
+		*e = DaemonJobLastStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DaemonJobLastStatus: %T", src)
+	}
+	return nil
+}
+
+type NullDaemonJobLastStatus struct {
+	DaemonJobLastStatus DaemonJobLastStatus
+	Valid               bool // Valid is true if DaemonJobLastStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDaemonJobLastStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.DaemonJobLastStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DaemonJobLastStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDaemonJobLastStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DaemonJobLastStatus), nil
+}
+
+type EthDepositCoin string
+
+const (
+	EthDepositCoinEth  EthDepositCoin = "eth"
+	EthDepositCoinHyt  EthDepositCoin = "hyt"
+	EthDepositCoinPol  EthDepositCoin = "pol"
+	EthDepositCoinBnb  EthDepositCoin = "bnb"
+	EthDepositCoinArb  EthDepositCoin = "arb"
+	EthDepositCoinBase EthDepositCoin = "base"
+)
+
+func (e *EthDepositCoin) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = EthDepositCoin(s)
+	case string:
+		*e = EthDepositCoin(s)
+	default:
+		return fmt.Errorf("unsupported scan type for EthDepositCoin: %T", src)
+	}
+	return nil
+}
+
+type NullEthDepositCoin struct {
+	EthDepositCoin EthDepositCoin
+	Valid          bool // Valid is true if EthDepositCoin is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullEthDepositCoin) Scan(value interface{}) error {
+	if value == nil {
+		ns.EthDepositCoin, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.EthDepositCoin.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullEthDepositCoin) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.EthDepositCoin), nil
+}
+
 type PaymentRequestCoin string
 
 const (
-	PaymentRequestCoinBtc PaymentRequestCoin = "btc"
-	PaymentRequestCoinBch PaymentRequestCoin = "bch"
-	PaymentRequestCoinEth PaymentRequestCoin = "eth"
-	PaymentRequestCoinXrp PaymentRequestCoin = "xrp"
+	PaymentRequestCoinBtc  PaymentRequestCoin = "btc"
+	PaymentRequestCoinBch  PaymentRequestCoin = "bch"
+	PaymentRequestCoinEth  PaymentRequestCoin = "eth"
+	PaymentRequestCoinXrp  PaymentRequestCoin = "xrp"
+	PaymentRequestCoinLtc  PaymentRequestCoin = "ltc"
+	PaymentRequestCoinDoge PaymentRequestCoin = "doge"
+	PaymentRequestCoinSol  PaymentRequestCoin = "sol"
+	PaymentRequestCoinTrx  PaymentRequestCoin = "trx"
+	PaymentRequestCoinPol  PaymentRequestCoin = "pol"
+	PaymentRequestCoinBnb  PaymentRequestCoin = "bnb"
+	PaymentRequestCoinArb  PaymentRequestCoin = "arb"
+	PaymentRequestCoinBase PaymentRequestCoin = "base"
 )
 
 func (e *PaymentRequestCoin) Scan(src interface{}) error {
@@ -404,11 +582,19 @@ func (ns NullPaymentRequestCoin) Value() (driver.Value, error) {
 type SeedCoin string
 
 const (
-	SeedCoinBtc SeedCoin = "btc"
-	SeedCoinBch SeedCoin = "bch"
-	SeedCoinEth SeedCoin = "eth"
-	SeedCoinXrp SeedCoin = "xrp"
-	SeedCoinHyt SeedCoin = "hyt"
+	SeedCoinBtc  SeedCoin = "btc"
+	SeedCoinBch  SeedCoin = "bch"
+	SeedCoinEth  SeedCoin = "eth"
+	SeedCoinXrp  SeedCoin = "xrp"
+	SeedCoinHyt  SeedCoin = "hyt"
+	SeedCoinLtc  SeedCoin = "ltc"
+	SeedCoinDoge SeedCoin = "doge"
+	SeedCoinSol  SeedCoin = "sol"
+	SeedCoinTrx  SeedCoin = "trx"
+	SeedCoinPol  SeedCoin = "pol"
+	SeedCoinBnb  SeedCoin = "bnb"
+	SeedCoinArb  SeedCoin = "arb"
+	SeedCoinBase SeedCoin = "base"
 )
 
 func (e *SeedCoin) Scan(src interface{}) error {
@@ -446,6 +632,59 @@ func (ns NullSeedCoin) Value() (driver.Value, error) {
 	return string(ns.SeedCoin), nil
 }
 
+type StreamCursorCoin string
+
+const (
+	StreamCursorCoinBtc  StreamCursorCoin = "btc"
+	StreamCursorCoinBch  StreamCursorCoin = "bch"
+	StreamCursorCoinEth  StreamCursorCoin = "eth"
+	StreamCursorCoinXrp  StreamCursorCoin = "xrp"
+	StreamCursorCoinHyt  StreamCursorCoin = "hyt"
+	StreamCursorCoinLtc  StreamCursorCoin = "ltc"
+	StreamCursorCoinDoge StreamCursorCoin = "doge"
+	StreamCursorCoinSol  StreamCursorCoin = "sol"
+	StreamCursorCoinTrx  StreamCursorCoin = "trx"
+	StreamCursorCoinPol  StreamCursorCoin = "pol"
+	StreamCursorCoinBnb  StreamCursorCoin = "bnb"
+	StreamCursorCoinArb  StreamCursorCoin = "arb"
+	StreamCursorCoinBase StreamCursorCoin = "base"
+)
+
+func (e *StreamCursorCoin) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StreamCursorCoin(s)
+	case string:
+		*e = StreamCursorCoin(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StreamCursorCoin: %T", src)
+	}
+	return nil
+}
+
+type NullStreamCursorCoin struct {
+	StreamCursorCoin StreamCursorCoin
+	Valid            bool // Valid is true if StreamCursorCoin is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStreamCursorCoin) Scan(value interface{}) error {
+	if value == nil {
+		ns.StreamCursorCoin, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StreamCursorCoin.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStreamCursorCoin) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StreamCursorCoin), nil
+}
+
 type TxAction string
 
 const (
@@ -492,9 +731,15 @@ func (ns NullTxAction) Value() (driver.Value, error) {
 type TxCoin string
 
 const (
-	TxCoinEth TxCoin = "eth"
-	TxCoinXrp TxCoin = "xrp"
-	TxCoinHyt TxCoin = "hyt"
+	TxCoinEth  TxCoin = "eth"
+	TxCoinXrp  TxCoin = "xrp"
+	TxCoinHyt  TxCoin = "hyt"
+	TxCoinSol  TxCoin = "sol"
+	TxCoinTrx  TxCoin = "trx"
+	TxCoinPol  TxCoin = "pol"
+	TxCoinBnb  TxCoin = "bnb"
+	TxCoinArb  TxCoin = "arb"
+	TxCoinBase TxCoin = "base"
 )
 
 func (e *TxCoin) Scan(src interface{}) error {
@@ -539,6 +784,21 @@ const (
 	XrpAccountKeyAccountDeposit XrpAccountKeyAccount = "deposit"
 	XrpAccountKeyAccountPayment XrpAccountKeyAccount = "payment"
 	XrpAccountKeyAccountStored  XrpAccountKeyAccount = "stored"
+	XrpAccountKeyAccountAuth1   XrpAccountKeyAccount = "auth1"
+	XrpAccountKeyAccountAuth2   XrpAccountKeyAccount = "auth2"
+	XrpAccountKeyAccountAuth3   XrpAccountKeyAccount = "auth3"
+	XrpAccountKeyAccountAuth4   XrpAccountKeyAccount = "auth4"
+	XrpAccountKeyAccountAuth5   XrpAccountKeyAccount = "auth5"
+	XrpAccountKeyAccountAuth6   XrpAccountKeyAccount = "auth6"
+	XrpAccountKeyAccountAuth7   XrpAccountKeyAccount = "auth7"
+	XrpAccountKeyAccountAuth8   XrpAccountKeyAccount = "auth8"
+	XrpAccountKeyAccountAuth9   XrpAccountKeyAccount = "auth9"
+	XrpAccountKeyAccountAuth10  XrpAccountKeyAccount = "auth10"
+	XrpAccountKeyAccountAuth11  XrpAccountKeyAccount = "auth11"
+	XrpAccountKeyAccountAuth12  XrpAccountKeyAccount = "auth12"
+	XrpAccountKeyAccountAuth13  XrpAccountKeyAccount = "auth13"
+	XrpAccountKeyAccountAuth14  XrpAccountKeyAccount = "auth14"
+	XrpAccountKeyAccountAuth15  XrpAccountKeyAccount = "auth15"
 )
 
 func (e *XrpAccountKeyAccount) Scan(src interface{}) error {
@@ -621,8 +881,6 @@ func (ns NullXrpAccountKeyCoin) Value() (driver.Value, error) {
 type AccountKey struct {
 	// ID
 	ID int64
-	// coin type code
-	Coin AccountKeyCoin
 	// key type (bip44, bip49, bip84, bip86, musig2)
 	KeyType string
 	// account type
@@ -649,14 +907,14 @@ type AccountKey struct {
 	AddrStatus int8
 	// updated date
 	UpdatedAt sql.NullTime
+	// coin type code
+	Coin AccountKeyCoin
 }
 
 // table for account pubkey
 type Address struct {
 	// ID
 	ID int64
-	// coin type code
-	Coin AddressCoin
 	// account type
 	Account AddressAccount
 	// wallet address
@@ -665,14 +923,14 @@ type Address struct {
 	IsAllocated bool
 	// updated date
 	UpdatedAt sql.NullTime
+	// coin type code
+	Coin AddressCoin
 }
 
 // table for keys for auth account
 type AuthAccountKey struct {
 	// ID
 	ID int16
-	// coin type code
-	Coin AuthAccountKeyCoin
 	// key type (bip44, bip49, bip84, bip86, musig2)
 	KeyType string
 	// auth type
@@ -699,28 +957,28 @@ type AuthAccountKey struct {
 	AddrStatus int8
 	// updated date
 	UpdatedAt sql.NullTime
+	// coin type code
+	Coin AuthAccountKeyCoin
 }
 
 // table for auth key exported from sign db
 type AuthFullpubkey struct {
 	// ID
 	ID int16
-	// coin type code
-	Coin AuthFullpubkeyCoin
 	// auth type
 	AuthAccount string
 	// full public key
 	FullPublicKey string
 	// updated date
 	UpdatedAt sql.NullTime
+	// coin type code
+	Coin AuthFullpubkeyCoin
 }
 
 // table for btc transaction info
 type BtcTx struct {
 	// transaction ID
 	ID int64
-	// coin type code
-	Coin BtcTxCoin
 	// action type
 	Action BtcTxAction
 	// HEX string for unsigned transaction
@@ -741,6 +999,12 @@ type BtcTx struct {
 	UnsignedUpdatedAt sql.NullTime
 	// updated date for signed transaction sent
 	SentUpdatedAt sql.NullTime
+	// hash of block including confirmed transaction
+	BlockHash string
+	// height of block including confirmed transaction
+	BlockHeight int64
+	// coin type code
+	Coin BtcTxCoin
 }
 
 // table for input transaction
@@ -783,6 +1047,60 @@ type BtcTxOutput struct {
 	UpdatedAt sql.NullTime
 }
 
+// table for last run status of watch daemon jobs
+type DaemonJob struct {
+	// ID
+	ID int64
+	// job name
+	Name string
+	// status of last run
+	LastStatus DaemonJobLastStatus
+	// error message of last run
+	LastError string
+	// started date of last run
+	LastStartedAt sql.NullTime
+	// finished date of last run
+	LastFinishedAt sql.NullTime
+	// updated date
+	UpdatedAt sql.NullTime
+	// coin type code
+	Coin DaemonJobCoin
+}
+
+// table for deposit detected on EVM chain
+type EthDeposit struct {
+	// ID
+	ID int64
+	// coin type code
+	Coin EthDepositCoin
+	// ERC-20 token contract address, empty for native coin
+	TokenContract string
+	// transaction hash
+	TxHash string
+	// log index in block for token transfer
+	LogIndex uint32
+	// block number
+	BlockNumber uint64
+	// block hash to detect reorg
+	BlockHash string
+	// block timestamp
+	BlockTime sql.NullTime
+	// sender address
+	SenderAddress string
+	// receiver account
+	ReceiverAccount string
+	// receiver address
+	ReceiverAddress string
+	// amount in wei or token base units
+	Amount string
+	// true: block reaches confirmation depth
+	IsConfirmed bool
+	// created date
+	CreatedAt sql.NullTime
+	// confirmed date
+	ConfirmedAt sql.NullTime
+}
+
 // table for eth transaction detail
 type EthDetailTx struct {
 	// ID
@@ -825,8 +1143,6 @@ type EthDetailTx struct {
 type PaymentRequest struct {
 	// ID
 	ID int64
-	// coin type code
-	Coin PaymentRequestCoin
 	// tx table ID for payment action
 	PaymentID sql.NullInt64
 	// sender address
@@ -841,30 +1157,130 @@ type PaymentRequest struct {
 	IsDone bool
 	// updated date
 	UpdatedAt sql.NullTime
+	// coin type code
+	Coin PaymentRequestCoin
 }
 
 // table for seed
 type Seed struct {
 	// ID
 	ID int8
-	// coin type code
-	Coin SeedCoin
 	// seed
 	Seed string
 	// updated date
 	UpdatedAt sql.NullTime
+	// coin type code
+	Coin SeedCoin
+}
+
+// table for sol transaction detail
+type SolDetailTx struct {
+	// ID
+	ID int64
+	// tx table ID
+	TxID int64
+	// UUID
+	Uuid string
+	// current transaction type
+	CurrentTxType int8
+	// sender account
+	SenderAccount string
+	// sender address
+	SenderAddress string
+	// receiver account
+	ReceiverAccount string
+	// receiver address
+	ReceiverAddress string
+	// amount of lamports or token base units to receive
+	Amount uint64
+	// fee in lamports
+	Fee uint64
+	// SPL token mint address, empty for SOL
+	TokenMint string
+	// durable nonce account
+	NonceAccount string
+	// durable nonce used as recent blockhash
+	Nonce string
+	// base64 string for unsigned transaction
+	UnsignedTx string
+	// base64 string for signed transaction
+	SignedTx string
+	// signature of sent transaction
+	SentSignature string
+	// updated date for unsigned transaction created
+	UnsignedUpdatedAt sql.NullTime
+	// updated date for signed transaction sent
+	SentUpdatedAt sql.NullTime
+}
+
+// table for last processed position of stream monitor
+type StreamCursor struct {
+	// ID
+	ID int64
+	// stream name
+	Name string
+	// last processed ledger index or block height
+	Position uint64
+	// updated date
+	UpdatedAt sql.NullTime
+	// coin type code
+	Coin StreamCursorCoin
+	// hash of last processed block to detect reorg
+	BlockHash string
+}
+
+// table for trx transaction detail
+type TrxDetailTx struct {
+	// ID
+	ID int64
+	// tx table ID
+	TxID int64
+	// UUID
+	Uuid string
+	// current transaction type
+	CurrentTxType int8
+	// sender account
+	SenderAccount string
+	// sender address
+	SenderAddress string
+	// receiver account
+	ReceiverAccount string
+	// receiver address
+	ReceiverAddress string
+	// amount of sun or token base units to receive
+	Amount uint64
+	// estimated fee in sun
+	Fee uint64
+	// max fee in sun for energy of TRC-20 transfer
+	FeeLimit uint64
+	// TRC-20 contract address, empty for TRX
+	ContractAddress string
+	// reference block number
+	RefBlockNum uint64
+	// expiration of transaction in unix milliseconds
+	Expiration int64
+	// HEX string for unsigned transaction
+	UnsignedHexTx string
+	// HEX string for signed transaction
+	SignedHexTx string
+	// Hash for sent transaction
+	SentHashTx string
+	// updated date for unsigned transaction created
+	UnsignedUpdatedAt sql.NullTime
+	// updated date for signed transaction sent
+	SentUpdatedAt sql.NullTime
 }
 
 // table for eth/xrp transaction info
 type Tx struct {
 	// transaction ID
 	ID int64
-	// coin type code
-	Coin TxCoin
 	// action type
 	Action TxAction
 	// updated date
 	UpdatedAt sql.NullTime
+	// coin type code
+	Coin TxCoin
 }
 
 // table for xrp keys for any account
@@ -873,8 +1289,6 @@ type XrpAccountKey struct {
 	ID int64
 	// coin type code
 	Coin XrpAccountKeyCoin
-	// account type
-	Account XrpAccountKeyAccount
 	// account_id
 	AccountID string
 	// key_type
@@ -897,6 +1311,46 @@ type XrpAccountKey struct {
 	AddrStatus int8
 	// updated date
 	UpdatedAt sql.NullTime
+	// account type
+	Account XrpAccountKeyAccount
+	// key to sign transaction, 0: master key, 1: regular key
+	ActiveKey int8
+	// account_id of regular key pair
+	RegularKeyAccountID string
+	// seed of regular key pair
+	RegularKeySeed string
+	// account_id of regular key pair waiting for SetRegularKey
+	PendingRegularKeyAccountID string
+	// seed of regular key pair waiting for SetRegularKey
+	PendingRegularKeySeed string
+}
+
+// table for deposit into shared deposit address of XRP
+type XrpDeposit struct {
+	// ID
+	ID int64
+	// transaction hash
+	TxHash string
+	// ledger index in which transaction is validated
+	LedgerIndex uint64
+	// sender address
+	SenderAddress string
+	// shared deposit address
+	ReceiverAddress string
+	// destination tag, NULL if it is not given
+	DestinationTag sql.NullInt64
+	// X-address of client identified by destination tag, empty if quarantined
+	ClientAddress string
+	// amount in drops
+	Amount string
+	// true: client is not identified, it must be handled manually
+	IsQuarantined bool
+	// created date
+	CreatedAt sql.NullTime
+	// currency code of issued currency, empty for XRP
+	Currency string
+	// issuer of issued currency, empty for XRP
+	Issuer string
 }
 
 // table for xrp transaction detail
@@ -943,4 +1397,42 @@ type XrpDetailTx struct {
 	TxBlob string
 	// updated date for signed transaction sent
 	SentUpdatedAt sql.NullTime
+	// currency code of issued currency, empty for XRP
+	Currency string
+	// issuer of issued currency, empty for XRP
+	Issuer string
+	// tx TicketSequence, 0 if sequence is used
+	TicketSequence uint64
+	// number of times transaction is resubmitted after expiration
+	RetryCount uint32
+	// payment_request table ID for payment action, 0 for other actions
+	PaymentRequestID int64
+}
+
+// table for escrow of XRP
+type XrpEscrow struct {
+	// ID
+	ID int64
+	// address which creates escrow
+	OwnerAddress string
+	// address which receives escrowed XRP
+	DestinationAddress string
+	// escrowed amount in drops
+	Amount string
+	// sequence or ticket of EscrowCreate, escrow is identified by owner and it
+	OfferSequence uint64
+	// escrow can be finished after this time
+	FinishAfter time.Time
+	// escrow can be canceled after this time, NULL if it never expires
+	CancelAfter sql.NullTime
+	// UUID of EscrowCreate in xrp_detail_tx
+	CreateUuid string
+	// UUID of EscrowFinish or EscrowCancel in xrp_detail_tx, empty until it is created
+	FinishUuid string
+	// 0: created, 1: finishing, 2: finished, 3: canceled, 4: failed
+	Status int8
+	// created date
+	CreatedAt sql.NullTime
+	// updated date
+	UpdatedAt sql.NullTime
 }
@@ -10,6 +10,18 @@ import (
 	"database/sql"
 )
 
+const countPendingPaymentRequests = `-- name: CountPendingPaymentRequests :one
+SELECT COUNT(*) as count FROM payment_request
+WHERE coin = ? AND is_done = false
+`
+
+func (q *Queries) CountPendingPaymentRequests(ctx context.Context, coin PaymentRequestCoin) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPendingPaymentRequests, coin)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const deleteAllPaymentRequests = `-- name: DeleteAllPaymentRequests :execresult
 DELETE FROM payment_request
 WHERE coin = ?
@@ -20,7 +32,7 @@ func (q *Queries) DeleteAllPaymentRequests(ctx context.Context, coin PaymentRequ
 }
 
 const getAllPaymentRequests = `-- name: GetAllPaymentRequests :many
-SELECT id, coin, payment_id, sender_address, sender_account, receiver_address, amount, is_done, updated_at FROM payment_request
+SELECT id, payment_id, sender_address, sender_account, receiver_address, amount, is_done, updated_at, coin FROM payment_request
 WHERE coin = ? AND payment_id IS NULL
 `
 
@@ -35,7 +47,6 @@ func (q *Queries) GetAllPaymentRequests(ctx context.Context, coin PaymentRequest
 		var i PaymentRequest
 		if err := rows.Scan(
 			&i.ID,
-			&i.Coin,
 			&i.PaymentID,
 			&i.SenderAddress,
 			&i.SenderAccount,
@@ -43,6 +54,7 @@ func (q *Queries) GetAllPaymentRequests(ctx context.Context, coin PaymentRequest
 			&i.Amount,
 			&i.IsDone,
 			&i.UpdatedAt,
+			&i.Coin,
 		); err != nil {
 			return nil, err
 		}
@@ -58,7 +70,7 @@ func (q *Queries) GetAllPaymentRequests(ctx context.Context, coin PaymentRequest
 }
 
 const getPaymentRequestsByPaymentID = `-- name: GetPaymentRequestsByPaymentID :many
-SELECT id, coin, payment_id, sender_address, sender_account, receiver_address, amount, is_done, updated_at FROM payment_request
+SELECT id, payment_id, sender_address, sender_account, receiver_address, amount, is_done, updated_at, coin FROM payment_request
 WHERE coin = ? AND payment_id = ?
 `
 
@@ -78,7 +90,6 @@ func (q *Queries) GetPaymentRequestsByPaymentID(ctx context.Context, arg GetPaym
 		var i PaymentRequest
 		if err := rows.Scan(
 			&i.ID,
-			&i.Coin,
 			&i.PaymentID,
 			&i.SenderAddress,
 			&i.SenderAccount,
@@ -86,6 +97,7 @@ func (q *Queries) GetPaymentRequestsByPaymentID(ctx context.Context, arg GetPaym
 			&i.Amount,
 			&i.IsDone,
 			&i.UpdatedAt,
+			&i.Coin,
 		); err != nil {
 			return nil, err
 		}
@@ -129,6 +141,21 @@ func (q *Queries) InsertPaymentRequest(ctx context.Context, arg InsertPaymentReq
 	)
 }
 
+const resetPaymentRequestPaymentID = `-- name: ResetPaymentRequestPaymentID :execresult
+UPDATE payment_request
+SET payment_id = NULL, is_done = false
+WHERE coin = ? AND id = ?
+`
+
+type ResetPaymentRequestPaymentIDParams struct {
+	Coin PaymentRequestCoin
+	ID   int64
+}
+
+func (q *Queries) ResetPaymentRequestPaymentID(ctx context.Context, arg ResetPaymentRequestPaymentIDParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, resetPaymentRequestPaymentID, arg.Coin, arg.ID)
+}
+
 const updatePaymentRequestIsDone = `-- name: UpdatePaymentRequestIsDone :execresult
 UPDATE payment_request
 SET is_done = ?
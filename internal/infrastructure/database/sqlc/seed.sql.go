@@ -11,7 +11,7 @@ import (
 )
 
 const getSeed = `-- name: GetSeed :one
-SELECT id, coin, seed, updated_at FROM seed WHERE coin = ? LIMIT 1
+SELECT id, seed, updated_at, coin FROM seed WHERE coin = ? LIMIT 1
 `
 
 func (q *Queries) GetSeed(ctx context.Context, coin SeedCoin) (Seed, error) {
@@ -19,9 +19,9 @@ func (q *Queries) GetSeed(ctx context.Context, coin SeedCoin) (Seed, error) {
 	var i Seed
 	err := row.Scan(
 		&i.ID,
-		&i.Coin,
 		&i.Seed,
 		&i.UpdatedAt,
+		&i.Coin,
 	)
 	return i, err
 }
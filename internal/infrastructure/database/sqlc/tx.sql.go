@@ -19,7 +19,7 @@ func (q *Queries) DeleteAllTx(ctx context.Context) (sql.Result, error) {
 }
 
 const getAllTx = `-- name: GetAllTx :many
-SELECT id, coin, action, updated_at FROM tx
+SELECT id, action, updated_at, coin FROM tx
 `
 
 func (q *Queries) GetAllTx(ctx context.Context) ([]Tx, error) {
@@ -33,9 +33,9 @@ func (q *Queries) GetAllTx(ctx context.Context) ([]Tx, error) {
 		var i Tx
 		if err := rows.Scan(
 			&i.ID,
-			&i.Coin,
 			&i.Action,
 			&i.UpdatedAt,
+			&i.Coin,
 		); err != nil {
 			return nil, err
 		}
@@ -68,7 +68,7 @@ func (q *Queries) GetMaxTxID(ctx context.Context, arg GetMaxTxIDParams) (interfa
 }
 
 const getTxByID = `-- name: GetTxByID :one
-SELECT id, coin, action, updated_at FROM tx
+SELECT id, action, updated_at, coin FROM tx
 WHERE id = ?
 `
 
@@ -77,9 +77,9 @@ func (q *Queries) GetTxByID(ctx context.Context, id int64) (Tx, error) {
 	var i Tx
 	err := row.Scan(
 		&i.ID,
-		&i.Coin,
 		&i.Action,
 		&i.UpdatedAt,
+		&i.Coin,
 	)
 	return i, err
 }
@@ -10,25 +10,70 @@ import (
 	"database/sql"
 )
 
-const getXRPAccountKeySecret = `-- name: GetXRPAccountKeySecret :one
-SELECT master_seed FROM xrp_account_key WHERE coin = ? AND account = ? AND account_id = ? LIMIT 1
+const activateXRPAccountKeyRegularKey = `-- name: ActivateXRPAccountKeyRegularKey :execresult
+UPDATE xrp_account_key
+SET active_key = ?, regular_key_account_id = pending_regular_key_account_id, regular_key_seed = pending_regular_key_seed,
+  pending_regular_key_account_id = '', pending_regular_key_seed = '', updated_at = ?
+WHERE coin = ? AND account_id = ? AND pending_regular_key_account_id = ?
 `
 
-type GetXRPAccountKeySecretParams struct {
+type ActivateXRPAccountKeyRegularKeyParams struct {
+	ActiveKey                  int8
+	UpdatedAt                  sql.NullTime
+	Coin                       XrpAccountKeyCoin
+	AccountID                  string
+	PendingRegularKeyAccountID string
+}
+
+func (q *Queries) ActivateXRPAccountKeyRegularKey(ctx context.Context, arg ActivateXRPAccountKeyRegularKeyParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, activateXRPAccountKeyRegularKey,
+		arg.ActiveKey,
+		arg.UpdatedAt,
+		arg.Coin,
+		arg.AccountID,
+		arg.PendingRegularKeyAccountID,
+	)
+}
+
+const getXRPAccountKeyByAccountID = `-- name: GetXRPAccountKeyByAccountID :one
+SELECT id, coin, account_id, key_type, master_key, master_seed, master_seed_hex, public_key, public_key_hex, is_regular_key_pair, allocated_id, addr_status, updated_at, account, active_key, regular_key_account_id, regular_key_seed, pending_regular_key_account_id, pending_regular_key_seed FROM xrp_account_key WHERE coin = ? AND account = ? AND account_id = ? LIMIT 1
+`
+
+type GetXRPAccountKeyByAccountIDParams struct {
 	Coin      XrpAccountKeyCoin
 	Account   XrpAccountKeyAccount
 	AccountID string
 }
 
-func (q *Queries) GetXRPAccountKeySecret(ctx context.Context, arg GetXRPAccountKeySecretParams) (string, error) {
-	row := q.db.QueryRowContext(ctx, getXRPAccountKeySecret, arg.Coin, arg.Account, arg.AccountID)
-	var master_seed string
-	err := row.Scan(&master_seed)
-	return master_seed, err
+func (q *Queries) GetXRPAccountKeyByAccountID(ctx context.Context, arg GetXRPAccountKeyByAccountIDParams) (XrpAccountKey, error) {
+	row := q.db.QueryRowContext(ctx, getXRPAccountKeyByAccountID, arg.Coin, arg.Account, arg.AccountID)
+	var i XrpAccountKey
+	err := row.Scan(
+		&i.ID,
+		&i.Coin,
+		&i.AccountID,
+		&i.KeyType,
+		&i.MasterKey,
+		&i.MasterSeed,
+		&i.MasterSeedHex,
+		&i.PublicKey,
+		&i.PublicKeyHex,
+		&i.IsRegularKeyPair,
+		&i.AllocatedID,
+		&i.AddrStatus,
+		&i.UpdatedAt,
+		&i.Account,
+		&i.ActiveKey,
+		&i.RegularKeyAccountID,
+		&i.RegularKeySeed,
+		&i.PendingRegularKeyAccountID,
+		&i.PendingRegularKeySeed,
+	)
+	return i, err
 }
 
 const getXRPAccountKeysByAddrStatus = `-- name: GetXRPAccountKeysByAddrStatus :many
-SELECT id, coin, account, account_id, key_type, master_key, master_seed, master_seed_hex, public_key, public_key_hex, is_regular_key_pair, allocated_id, addr_status, updated_at FROM xrp_account_key WHERE coin = ? AND account = ? AND addr_status = ?
+SELECT id, coin, account_id, key_type, master_key, master_seed, master_seed_hex, public_key, public_key_hex, is_regular_key_pair, allocated_id, addr_status, updated_at, account, active_key, regular_key_account_id, regular_key_seed, pending_regular_key_account_id, pending_regular_key_seed FROM xrp_account_key WHERE coin = ? AND account = ? AND addr_status = ?
 `
 
 type GetXRPAccountKeysByAddrStatusParams struct {
@@ -49,7 +94,6 @@ func (q *Queries) GetXRPAccountKeysByAddrStatus(ctx context.Context, arg GetXRPA
 		if err := rows.Scan(
 			&i.ID,
 			&i.Coin,
-			&i.Account,
 			&i.AccountID,
 			&i.KeyType,
 			&i.MasterKey,
@@ -61,6 +105,12 @@ func (q *Queries) GetXRPAccountKeysByAddrStatus(ctx context.Context, arg GetXRPA
 			&i.AllocatedID,
 			&i.AddrStatus,
 			&i.UpdatedAt,
+			&i.Account,
+			&i.ActiveKey,
+			&i.RegularKeyAccountID,
+			&i.RegularKeySeed,
+			&i.PendingRegularKeyAccountID,
+			&i.PendingRegularKeySeed,
 		); err != nil {
 			return nil, err
 		}
@@ -136,3 +186,26 @@ func (q *Queries) UpdateXRPAccountKeyAddrStatus(ctx context.Context, arg UpdateX
 		arg.AccountID,
 	)
 }
+
+const updateXRPAccountKeyPendingRegularKey = `-- name: UpdateXRPAccountKeyPendingRegularKey :execresult
+UPDATE xrp_account_key SET pending_regular_key_account_id = ?, pending_regular_key_seed = ?, updated_at = ?
+WHERE coin = ? AND account_id = ?
+`
+
+type UpdateXRPAccountKeyPendingRegularKeyParams struct {
+	PendingRegularKeyAccountID string
+	PendingRegularKeySeed      string
+	UpdatedAt                  sql.NullTime
+	Coin                       XrpAccountKeyCoin
+	AccountID                  string
+}
+
+func (q *Queries) UpdateXRPAccountKeyPendingRegularKey(ctx context.Context, arg UpdateXRPAccountKeyPendingRegularKeyParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateXRPAccountKeyPendingRegularKey,
+		arg.PendingRegularKeyAccountID,
+		arg.PendingRegularKeySeed,
+		arg.UpdatedAt,
+		arg.Coin,
+		arg.AccountID,
+	)
+}
@@ -10,6 +10,21 @@ import (
 	"database/sql"
 )
 
+const getXrpDetailTxActionBySignedTxID = `-- name: GetXrpDetailTxActionBySignedTxID :one
+SELECT tx.action
+FROM xrp_detail_tx
+INNER JOIN tx ON tx.id = xrp_detail_tx.tx_id
+WHERE xrp_detail_tx.signed_tx_id = ?
+LIMIT 1
+`
+
+func (q *Queries) GetXrpDetailTxActionBySignedTxID(ctx context.Context, signedTxID string) (TxAction, error) {
+	row := q.db.QueryRowContext(ctx, getXrpDetailTxActionBySignedTxID, signedTxID)
+	var action TxAction
+	err := row.Scan(&action)
+	return action, err
+}
+
 const getXrpDetailTxBlobList = `-- name: GetXrpDetailTxBlobList :many
 SELECT xrp_detail_tx.tx_blob
 FROM xrp_detail_tx
@@ -46,7 +61,7 @@ func (q *Queries) GetXrpDetailTxBlobList(ctx context.Context, arg GetXrpDetailTx
 }
 
 const getXrpDetailTxByID = `-- name: GetXrpDetailTxByID :one
-SELECT id, tx_id, uuid, current_tx_type, sender_account, sender_address, receiver_account, receiver_address, amount, xrp_tx_type, fee, flags, last_ledger_sequence, sequence, signing_pubkey, txn_signature, hash, earliest_ledger_version, signed_tx_id, tx_blob, sent_updated_at FROM xrp_detail_tx
+SELECT id, tx_id, uuid, current_tx_type, sender_account, sender_address, receiver_account, receiver_address, amount, xrp_tx_type, fee, flags, last_ledger_sequence, sequence, signing_pubkey, txn_signature, hash, earliest_ledger_version, signed_tx_id, tx_blob, sent_updated_at, currency, issuer, ticket_sequence, retry_count, payment_request_id FROM xrp_detail_tx
 WHERE id = ?
 `
 
@@ -75,12 +90,112 @@ func (q *Queries) GetXrpDetailTxByID(ctx context.Context, id int64) (XrpDetailTx
 		&i.SignedTxID,
 		&i.TxBlob,
 		&i.SentUpdatedAt,
+		&i.Currency,
+		&i.Issuer,
+		&i.TicketSequence,
+		&i.RetryCount,
+		&i.PaymentRequestID,
+	)
+	return i, err
+}
+
+const getXrpDetailTxByUUID = `-- name: GetXrpDetailTxByUUID :one
+SELECT id, tx_id, uuid, current_tx_type, sender_account, sender_address, receiver_account, receiver_address, amount, xrp_tx_type, fee, flags, last_ledger_sequence, sequence, signing_pubkey, txn_signature, hash, earliest_ledger_version, signed_tx_id, tx_blob, sent_updated_at, currency, issuer, ticket_sequence, retry_count, payment_request_id FROM xrp_detail_tx
+WHERE uuid = ?
+`
+
+func (q *Queries) GetXrpDetailTxByUUID(ctx context.Context, uuid string) (XrpDetailTx, error) {
+	row := q.db.QueryRowContext(ctx, getXrpDetailTxByUUID, uuid)
+	var i XrpDetailTx
+	err := row.Scan(
+		&i.ID,
+		&i.TxID,
+		&i.Uuid,
+		&i.CurrentTxType,
+		&i.SenderAccount,
+		&i.SenderAddress,
+		&i.ReceiverAccount,
+		&i.ReceiverAddress,
+		&i.Amount,
+		&i.XrpTxType,
+		&i.Fee,
+		&i.Flags,
+		&i.LastLedgerSequence,
+		&i.Sequence,
+		&i.SigningPubkey,
+		&i.TxnSignature,
+		&i.Hash,
+		&i.EarliestLedgerVersion,
+		&i.SignedTxID,
+		&i.TxBlob,
+		&i.SentUpdatedAt,
+		&i.Currency,
+		&i.Issuer,
+		&i.TicketSequence,
+		&i.RetryCount,
+		&i.PaymentRequestID,
 	)
 	return i, err
 }
 
+const getXrpDetailTxOldestUnsignedUpdatedAt = `-- name: GetXrpDetailTxOldestUnsignedUpdatedAt :one
+SELECT tx.updated_at
+FROM xrp_detail_tx
+INNER JOIN tx ON tx.id = xrp_detail_tx.tx_id
+WHERE tx.coin = ? AND xrp_detail_tx.current_tx_type = ?
+ORDER BY tx.updated_at
+LIMIT 1
+`
+
+type GetXrpDetailTxOldestUnsignedUpdatedAtParams struct {
+	Coin          TxCoin
+	CurrentTxType int8
+}
+
+func (q *Queries) GetXrpDetailTxOldestUnsignedUpdatedAt(ctx context.Context, arg GetXrpDetailTxOldestUnsignedUpdatedAtParams) (sql.NullTime, error) {
+	row := q.db.QueryRowContext(ctx, getXrpDetailTxOldestUnsignedUpdatedAt, arg.Coin, arg.CurrentTxType)
+	var updated_at sql.NullTime
+	err := row.Scan(&updated_at)
+	return updated_at, err
+}
+
+const getXrpDetailTxTicketSequences = `-- name: GetXrpDetailTxTicketSequences :many
+SELECT ticket_sequence
+FROM xrp_detail_tx
+WHERE sender_address = ? AND ticket_sequence != 0 AND current_tx_type NOT IN (?, ?)
+`
+
+type GetXrpDetailTxTicketSequencesParams struct {
+	SenderAddress   string
+	CurrentTxType   int8
+	CurrentTxType_2 int8
+}
+
+func (q *Queries) GetXrpDetailTxTicketSequences(ctx context.Context, arg GetXrpDetailTxTicketSequencesParams) ([]uint64, error) {
+	rows, err := q.db.QueryContext(ctx, getXrpDetailTxTicketSequences, arg.SenderAddress, arg.CurrentTxType, arg.CurrentTxType_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uint64
+	for rows.Next() {
+		var ticket_sequence uint64
+		if err := rows.Scan(&ticket_sequence); err != nil {
+			return nil, err
+		}
+		items = append(items, ticket_sequence)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getXrpDetailTxsByTxID = `-- name: GetXrpDetailTxsByTxID :many
-SELECT id, tx_id, uuid, current_tx_type, sender_account, sender_address, receiver_account, receiver_address, amount, xrp_tx_type, fee, flags, last_ledger_sequence, sequence, signing_pubkey, txn_signature, hash, earliest_ledger_version, signed_tx_id, tx_blob, sent_updated_at FROM xrp_detail_tx
+SELECT id, tx_id, uuid, current_tx_type, sender_account, sender_address, receiver_account, receiver_address, amount, xrp_tx_type, fee, flags, last_ledger_sequence, sequence, signing_pubkey, txn_signature, hash, earliest_ledger_version, signed_tx_id, tx_blob, sent_updated_at, currency, issuer, ticket_sequence, retry_count, payment_request_id FROM xrp_detail_tx
 WHERE tx_id = ?
 `
 
@@ -115,6 +230,72 @@ func (q *Queries) GetXrpDetailTxsByTxID(ctx context.Context, txID int64) ([]XrpD
 			&i.SignedTxID,
 			&i.TxBlob,
 			&i.SentUpdatedAt,
+			&i.Currency,
+			&i.Issuer,
+			&i.TicketSequence,
+			&i.RetryCount,
+			&i.PaymentRequestID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getXrpDetailTxsExpired = `-- name: GetXrpDetailTxsExpired :many
+SELECT id, tx_id, uuid, current_tx_type, sender_account, sender_address, receiver_account, receiver_address, amount, xrp_tx_type, fee, flags, last_ledger_sequence, sequence, signing_pubkey, txn_signature, hash, earliest_ledger_version, signed_tx_id, tx_blob, sent_updated_at, currency, issuer, ticket_sequence, retry_count, payment_request_id FROM xrp_detail_tx
+WHERE current_tx_type = ? AND last_ledger_sequence != 0 AND last_ledger_sequence < ?
+ORDER BY id
+`
+
+type GetXrpDetailTxsExpiredParams struct {
+	CurrentTxType      int8
+	LastLedgerSequence uint64
+}
+
+func (q *Queries) GetXrpDetailTxsExpired(ctx context.Context, arg GetXrpDetailTxsExpiredParams) ([]XrpDetailTx, error) {
+	rows, err := q.db.QueryContext(ctx, getXrpDetailTxsExpired, arg.CurrentTxType, arg.LastLedgerSequence)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []XrpDetailTx
+	for rows.Next() {
+		var i XrpDetailTx
+		if err := rows.Scan(
+			&i.ID,
+			&i.TxID,
+			&i.Uuid,
+			&i.CurrentTxType,
+			&i.SenderAccount,
+			&i.SenderAddress,
+			&i.ReceiverAccount,
+			&i.ReceiverAddress,
+			&i.Amount,
+			&i.XrpTxType,
+			&i.Fee,
+			&i.Flags,
+			&i.LastLedgerSequence,
+			&i.Sequence,
+			&i.SigningPubkey,
+			&i.TxnSignature,
+			&i.Hash,
+			&i.EarliestLedgerVersion,
+			&i.SignedTxID,
+			&i.TxBlob,
+			&i.SentUpdatedAt,
+			&i.Currency,
+			&i.Issuer,
+			&i.TicketSequence,
+			&i.RetryCount,
+			&i.PaymentRequestID,
 		); err != nil {
 			return nil, err
 		}
@@ -132,10 +313,11 @@ func (q *Queries) GetXrpDetailTxsByTxID(ctx context.Context, txID int64) ([]XrpD
 const insertXrpDetailTx = `-- name: InsertXrpDetailTx :execresult
 INSERT INTO xrp_detail_tx (
   tx_id, uuid, current_tx_type, sender_account, sender_address,
-  receiver_account, receiver_address, amount, xrp_tx_type, fee,
-  flags, last_ledger_sequence, sequence, signing_pubkey, txn_signature,
-  hash, earliest_ledger_version, signed_tx_id, tx_blob, sent_updated_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+  receiver_account, receiver_address, amount, currency, issuer,
+  xrp_tx_type, fee, flags, last_ledger_sequence, sequence, ticket_sequence,
+  signing_pubkey, txn_signature, hash, earliest_ledger_version, signed_tx_id,
+  tx_blob, sent_updated_at, retry_count, payment_request_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type InsertXrpDetailTxParams struct {
@@ -147,11 +329,14 @@ type InsertXrpDetailTxParams struct {
 	ReceiverAccount       string
 	ReceiverAddress       string
 	Amount                string
+	Currency              string
+	Issuer                string
 	XrpTxType             string
 	Fee                   string
 	Flags                 uint64
 	LastLedgerSequence    uint64
 	Sequence              uint64
+	TicketSequence        uint64
 	SigningPubkey         string
 	TxnSignature          string
 	Hash                  string
@@ -159,6 +344,8 @@ type InsertXrpDetailTxParams struct {
 	SignedTxID            string
 	TxBlob                string
 	SentUpdatedAt         sql.NullTime
+	RetryCount            uint32
+	PaymentRequestID      int64
 }
 
 func (q *Queries) InsertXrpDetailTx(ctx context.Context, arg InsertXrpDetailTxParams) (sql.Result, error) {
@@ -171,11 +358,14 @@ func (q *Queries) InsertXrpDetailTx(ctx context.Context, arg InsertXrpDetailTxPa
 		arg.ReceiverAccount,
 		arg.ReceiverAddress,
 		arg.Amount,
+		arg.Currency,
+		arg.Issuer,
 		arg.XrpTxType,
 		arg.Fee,
 		arg.Flags,
 		arg.LastLedgerSequence,
 		arg.Sequence,
+		arg.TicketSequence,
 		arg.SigningPubkey,
 		arg.TxnSignature,
 		arg.Hash,
@@ -183,6 +373,8 @@ func (q *Queries) InsertXrpDetailTx(ctx context.Context, arg InsertXrpDetailTxPa
 		arg.SignedTxID,
 		arg.TxBlob,
 		arg.SentUpdatedAt,
+		arg.RetryCount,
+		arg.PaymentRequestID,
 	)
 }
 
@@ -242,3 +434,35 @@ type UpdateXrpDetailTxTypeBySentHashParams struct {
 func (q *Queries) UpdateXrpDetailTxTypeBySentHash(ctx context.Context, arg UpdateXrpDetailTxTypeBySentHashParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, updateXrpDetailTxTypeBySentHash, arg.CurrentTxType, arg.TxBlob)
 }
+
+const updateXrpDetailTxTypeBySignedTxID = `-- name: UpdateXrpDetailTxTypeBySignedTxID :execresult
+UPDATE xrp_detail_tx
+SET current_tx_type = ?
+WHERE signed_tx_id = ? AND current_tx_type = ?
+`
+
+type UpdateXrpDetailTxTypeBySignedTxIDParams struct {
+	CurrentTxType   int8
+	SignedTxID      string
+	CurrentTxType_2 int8
+}
+
+func (q *Queries) UpdateXrpDetailTxTypeBySignedTxID(ctx context.Context, arg UpdateXrpDetailTxTypeBySignedTxIDParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateXrpDetailTxTypeBySignedTxID, arg.CurrentTxType, arg.SignedTxID, arg.CurrentTxType_2)
+}
+
+const updateXrpDetailTxTypeFrom = `-- name: UpdateXrpDetailTxTypeFrom :execresult
+UPDATE xrp_detail_tx
+SET current_tx_type = ?
+WHERE id = ? AND current_tx_type = ?
+`
+
+type UpdateXrpDetailTxTypeFromParams struct {
+	CurrentTxType   int8
+	ID              int64
+	CurrentTxType_2 int8
+}
+
+func (q *Queries) UpdateXrpDetailTxTypeFrom(ctx context.Context, arg UpdateXrpDetailTxTypeFromParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateXrpDetailTxTypeFrom, arg.CurrentTxType, arg.ID, arg.CurrentTxType_2)
+}
@@ -0,0 +1,10 @@
+package sqlcpg
+
+import (
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database"
+)
+
+// NewTraced returns Queries which records span per query
+func NewTraced(db DBTX) *Queries {
+	return New(database.NewTracedDB(db))
+}
@@ -0,0 +1,75 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/ncruces/go-sqlite3"
+	"github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"        // SQLite compiled to wasm, no cgo is required
+	_ "github.com/ncruces/go-sqlite3/vfs/adiantum" // VFS to encrypt database file
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+// PassphraseEnv is environment variable for passphrase when it's not in config file
+const PassphraseEnv = "SQLITE_PASSPHRASE"
+
+// NewSQLite opens encrypted SQLite database file
+//
+// whole file including journal is encrypted with key derived from passphrase,
+// so file can't be opened without passphrase even by sqlite3 command
+func NewSQLite(conf *config.SQLite) (*sql.DB, error) {
+	passphrase := conf.Passphrase
+	if passphrase == "" {
+		passphrase = os.Getenv(PassphraseEnv)
+	}
+	if passphrase == "" {
+		return nil, fmt.Errorf("passphrase is required in config or %s env", PassphraseEnv)
+	}
+
+	if dir := filepath.Dir(conf.Path); dir != "" {
+		if err := os.MkdirAll(dir, 0o700); err != nil {
+			return nil, fmt.Errorf("fail to create directory %s: %w", dir, err)
+		}
+	}
+
+	// key is given by PRAGMA instead of URI parameter not to be exposed
+	dsn := "file:" + (&url.URL{Path: conf.Path}).EscapedPath() + "?vfs=adiantum"
+	db, err := driver.Open(dsn, func(conn *sqlite3.Conn) error {
+		if err := conn.Exec("PRAGMA textkey=" + sqlite3.Quote(passphrase)); err != nil {
+			return err
+		}
+		return conn.Exec("PRAGMA temp_store=memory")
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fail to open sqlite %s: %w", conf.Path, err)
+	}
+	// key derivation runs per connection and cold wallet doesn't need concurrency
+	db.SetMaxOpenConns(1)
+
+	if err = verifyKey(context.Background(), db); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// verifyKey reads schema to make sure database file is decrypted by passphrase
+//
+// tables are created by migrations of cold wallet
+func verifyKey(ctx context.Context, db *sql.DB) error {
+	var count int
+	if err := db.QueryRowContext(ctx, "SELECT count(*) FROM sqlite_master").Scan(&count); err != nil {
+		if errors.Is(err, sqlite3.NOTADB) {
+			return errors.New("fail to decrypt database file, passphrase may be wrong")
+		}
+		return fmt.Errorf("fail to read database file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/migration"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+// migrate creates tables of cold wallet
+func migrate(t *testing.T, db *sql.DB) {
+	t.Helper()
+	m, err := migration.NewMigrator(db, config.DriverSQLite, migration.SetCold)
+	require.NoError(t, err)
+	_, err = m.Up(context.Background())
+	require.NoError(t, err)
+}
+
+func TestNewSQLite(t *testing.T) {
+	ctx := context.Background()
+	conf := &config.SQLite{
+		Path:       filepath.Join(t.TempDir(), "db", "keygen.db"),
+		Passphrase: "test-passphrase",
+	}
+	const seed = "plain-seed-must-not-be-stored-as-is"
+
+	db, err := NewSQLite(conf)
+	require.NoError(t, err)
+	migrate(t, db)
+	_, err = db.ExecContext(ctx, "INSERT INTO seed (coin, seed) VALUES (?, ?)", "btc", seed)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	t.Run("file is encrypted", func(t *testing.T) {
+		data, err := os.ReadFile(conf.Path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "SQLite format 3")
+		assert.NotContains(t, string(data), seed)
+	})
+
+	t.Run("reopen with same passphrase", func(t *testing.T) {
+		db, err := NewSQLite(conf)
+		require.NoError(t, err)
+		defer func() { _ = db.Close() }()
+
+		var got string
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT seed FROM seed WHERE coin = ?", "btc").Scan(&got))
+		assert.Equal(t, seed, got)
+	})
+
+	t.Run("wrong passphrase", func(t *testing.T) {
+		_, err := NewSQLite(&config.SQLite{Path: conf.Path, Passphrase: "wrong"})
+		require.Error(t, err)
+	})
+
+	t.Run("passphrase from env", func(t *testing.T) {
+		t.Setenv(PassphraseEnv, conf.Passphrase)
+		db, err := NewSQLite(&config.SQLite{Path: conf.Path})
+		require.NoError(t, err)
+		require.NoError(t, db.Close())
+	})
+
+	t.Run("passphrase is required", func(t *testing.T) {
+		t.Setenv(PassphraseEnv, "")
+		_, err := NewSQLite(&config.SQLite{Path: conf.Path})
+		require.Error(t, err)
+		assert.True(t, strings.Contains(err.Error(), PassphraseEnv))
+	})
+}
@@ -0,0 +1,384 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/ncruces/go-sqlite3"
+)
+
+// importTables is tables of keygen and sign database which can be imported
+var importTables = map[string]bool{
+	"seed":             true,
+	"account_key":      true,
+	"xrp_account_key":  true,
+	"auth_fullpubkey":  true,
+	"auth_account_key": true,
+}
+
+// DumpImporter imports rows of MySQL dump created by mysqldump into SQLite
+//
+// only `INSERT INTO` statements of keygen and sign tables are imported,
+// others like `CREATE TABLE`, `LOCK TABLES` and conditional comments are skipped
+// because tables are created by migrations which keep the same column order as MySQL
+type DumpImporter struct {
+	db *sql.DB
+}
+
+// NewDumpImporter returns DumpImporter
+func NewDumpImporter(db *sql.DB) *DumpImporter {
+	return &DumpImporter{db: db}
+}
+
+// Import imports dump in one transaction and returns imported row count per table
+func (d *DumpImporter) Import(ctx context.Context, r io.Reader) (_ map[string]int64, err error) {
+	dump, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read dump: %w", err)
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("fail to call db.BeginTx(): %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	counts := make(map[string]int64)
+	p := &dumpParser{src: string(dump)}
+	for {
+		stmt, ok, err := p.nextInsert()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if !importTables[stmt.table] {
+			continue
+		}
+		for _, row := range stmt.rows {
+			if _, err = tx.ExecContext(ctx, stmt.query(len(row)), row...); err != nil {
+				return nil, fmt.Errorf("fail to insert into %s: %w", stmt.table, err)
+			}
+			counts[stmt.table]++
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("fail to call tx.Commit(): %w", err)
+	}
+	return counts, nil
+}
+
+// insertStmt is parsed `INSERT INTO table [(columns)] VALUES (...), (...)`
+type insertStmt struct {
+	table   string
+	columns []string
+	rows    [][]any
+}
+
+func (s *insertStmt) query(n int) string {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+	if len(s.columns) == 0 {
+		return fmt.Sprintf("INSERT INTO %s VALUES (%s)", s.table, placeholders)
+	}
+	columns := make([]string, len(s.columns))
+	for i, column := range s.columns {
+		columns[i] = sqlite3.QuoteIdentifier(column)
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", s.table, strings.Join(columns, ", "), placeholders)
+}
+
+// dumpParser reads statements of mysqldump output
+type dumpParser struct {
+	src string
+	pos int
+}
+
+// nextInsert returns next INSERT statement, false is returned at the end of dump
+func (p *dumpParser) nextInsert() (*insertStmt, bool, error) {
+	for {
+		p.skipSpaceAndComments()
+		if p.pos >= len(p.src) {
+			return nil, false, nil
+		}
+		if p.consumeKeyword("INSERT") {
+			p.skipSpaceAndComments()
+			if !p.consumeKeyword("INTO") {
+				return nil, false, p.errorf("INTO is expected")
+			}
+			stmt, err := p.parseInsert()
+			if err != nil {
+				return nil, false, err
+			}
+			return stmt, true, nil
+		}
+		if err := p.skipStatement(); err != nil {
+			return nil, false, err
+		}
+	}
+}
+
+func (p *dumpParser) parseInsert() (*insertStmt, error) {
+	stmt := &insertStmt{}
+
+	p.skipSpaceAndComments()
+	table, err := p.parseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	stmt.table = table
+
+	p.skipSpaceAndComments()
+	if p.peek() == '(' {
+		p.pos++
+		for {
+			p.skipSpaceAndComments()
+			column, err := p.parseIdentifier()
+			if err != nil {
+				return nil, err
+			}
+			stmt.columns = append(stmt.columns, column)
+			p.skipSpaceAndComments()
+			if p.consume(')') {
+				break
+			}
+			if !p.consume(',') {
+				return nil, p.errorf("',' or ')' is expected in column list")
+			}
+		}
+		p.skipSpaceAndComments()
+	}
+
+	if !p.consumeKeyword("VALUES") {
+		return nil, p.errorf("VALUES is expected")
+	}
+
+	for {
+		p.skipSpaceAndComments()
+		row, err := p.parseRow()
+		if err != nil {
+			return nil, err
+		}
+		if len(stmt.columns) != 0 && len(row) != len(stmt.columns) {
+			return nil, p.errorf("%d values are given for %d columns", len(row), len(stmt.columns))
+		}
+		stmt.rows = append(stmt.rows, row)
+
+		p.skipSpaceAndComments()
+		if p.consume(';') || p.pos >= len(p.src) {
+			return stmt, nil
+		}
+		if !p.consume(',') {
+			return nil, p.errorf("',' or ';' is expected after row")
+		}
+	}
+}
+
+func (p *dumpParser) parseRow() ([]any, error) {
+	if !p.consume('(') {
+		return nil, p.errorf("'(' is expected")
+	}
+	var row []any
+	for {
+		p.skipSpaceAndComments()
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, value)
+		p.skipSpaceAndComments()
+		if p.consume(')') {
+			return row, nil
+		}
+		if !p.consume(',') {
+			return nil, p.errorf("',' or ')' is expected in row")
+		}
+	}
+}
+
+func (p *dumpParser) parseValue() (any, error) {
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		return p.parseString()
+	case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+		start := p.pos
+		for p.pos < len(p.src) && strings.IndexByte("+-.eE0123456789", p.src[p.pos]) >= 0 {
+			p.pos++
+		}
+		literal := p.src[start:p.pos]
+		if i, err := strconv.ParseInt(literal, 10, 64); err == nil {
+			return i, nil
+		}
+		if f, err := strconv.ParseFloat(literal, 64); err == nil {
+			return f, nil
+		}
+		return nil, p.errorf("invalid number %s", literal)
+	case p.consumeKeyword("NULL"):
+		return nil, nil
+	case p.consumeKeyword("TRUE"):
+		return int64(1), nil
+	case p.consumeKeyword("FALSE"):
+		return int64(0), nil
+	default:
+		return nil, p.errorf("unsupported value")
+	}
+}
+
+// parseString parses quoted string with MySQL backslash escapes
+func (p *dumpParser) parseString() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		p.pos++
+		switch {
+		case c == '\\' && p.pos < len(p.src):
+			e := p.src[p.pos]
+			p.pos++
+			switch e {
+			case '0':
+				b.WriteByte(0)
+			case 'b':
+				b.WriteByte('\b')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'Z':
+				b.WriteByte(0x1a)
+			default:
+				b.WriteByte(e)
+			}
+		case c == quote:
+			// doubled quote is escaped quote
+			if p.peek() == quote {
+				p.pos++
+				b.WriteByte(quote)
+				continue
+			}
+			return b.String(), nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", errors.New("unterminated string in dump")
+}
+
+func (p *dumpParser) parseIdentifier() (string, error) {
+	if p.consume('`') {
+		end := strings.IndexByte(p.src[p.pos:], '`')
+		if end < 0 {
+			return "", p.errorf("unterminated identifier")
+		}
+		id := p.src[p.pos : p.pos+end]
+		p.pos += end + 1
+		return id, nil
+	}
+	start := p.pos
+	for p.pos < len(p.src) && isIdentChar(p.src[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", p.errorf("identifier is expected")
+	}
+	return p.src[start:p.pos], nil
+}
+
+// skipStatement skips until `;` outside of quotes
+func (p *dumpParser) skipStatement() error {
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case '\'', '"':
+			if _, err := p.parseString(); err != nil {
+				return err
+			}
+		case '`':
+			if _, err := p.parseIdentifier(); err != nil {
+				return err
+			}
+		case ';':
+			p.pos++
+			return nil
+		default:
+			p.pos++
+		}
+	}
+	return nil
+}
+
+// skipSpaceAndComments skips white spaces and `-- `, `#`, `/* */` comments
+// MySQL conditional comment `/*!40101 SET ... */` is skipped as well
+func (p *dumpParser) skipSpaceAndComments() {
+	for p.pos < len(p.src) {
+		rest := p.src[p.pos:]
+		switch {
+		case strings.IndexByte(" \t\r\n", rest[0]) >= 0:
+			p.pos++
+		case strings.HasPrefix(rest, "--") || rest[0] == '#':
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				p.pos = len(p.src)
+				return
+			}
+			p.pos += end + 1
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest, "*/")
+			if end < 0 {
+				p.pos = len(p.src)
+				return
+			}
+			p.pos += end + 2
+		default:
+			return
+		}
+	}
+}
+
+func (p *dumpParser) consumeKeyword(keyword string) bool {
+	end := p.pos + len(keyword)
+	if end > len(p.src) || !strings.EqualFold(p.src[p.pos:end], keyword) {
+		return false
+	}
+	if end < len(p.src) && isIdentChar(p.src[end]) {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+func (p *dumpParser) consume(c byte) bool {
+	if p.peek() != c {
+		return false
+	}
+	p.pos++
+	return true
+}
+
+func (p *dumpParser) peek() byte {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *dumpParser) errorf(format string, args ...any) error {
+	line := strings.Count(p.src[:p.pos], "\n") + 1
+	return fmt.Errorf("invalid dump at line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,94 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+// dump is part of output of `mysqldump keygen`
+const dump = `-- MySQL dump 10.13  Distrib 8.4.0, for Linux (x86_64)
+--
+-- Host: localhost    Database: keygen
+/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;
+/*!40101 SET NAMES utf8mb4 */;
+
+DROP TABLE IF EXISTS ` + "`seed`" + `;
+CREATE TABLE ` + "`seed`" + ` (
+  ` + "`id`" + ` tinyint NOT NULL AUTO_INCREMENT COMMENT 'ID',
+  ` + "`coin`" + ` enum('btc','bch','eth','xrp','hyt') NOT NULL COMMENT 'coin type code; not ''statement'' end',
+  PRIMARY KEY (` + "`id`" + `)
+) ENGINE=InnoDB AUTO_INCREMENT=2 DEFAULT CHARSET=utf8mb4 COMMENT='table for seed';
+
+LOCK TABLES ` + "`seed`" + ` WRITE;
+/*!40000 ALTER TABLE ` + "`seed`" + ` DISABLE KEYS */;
+INSERT INTO ` + "`seed`" + ` VALUES (1,'btc','c2VlZA==','2024-12-27 10:00:00');
+/*!40000 ALTER TABLE ` + "`seed`" + ` ENABLE KEYS */;
+UNLOCK TABLES;
+
+LOCK TABLES ` + "`account_key`" + ` WRITE;
+INSERT INTO ` + "`account_key`" + ` VALUES (1,'btc','bip44','client','p2pkh1','p2sh1','bc1',NULL,'pub1','','','wif1',0,1,'2024-12-27 10:00:00'),(2,'btc','bip44','client','p2pkh2','p2sh2','bc2','bc1p2','pub2','multi\'2','script\\2','wif2',1,2,NULL);
+UNLOCK TABLES;
+
+LOCK TABLES ` + "`xrp_account_key`" + ` WRITE;
+INSERT INTO ` + "`xrp_account_key`" + ` (` + "`id`, `coin`, `account`, `account_id`, `key_type`, `master_key`, `master_seed`, `master_seed_hex`, `public_key`, `public_key_hex`, `is_regular_key_pair`, `allocated_id`, `addr_status`, `updated_at`" + `) VALUES (1,'xrp','deposit','rAccount','0','mkey','mseed','mseedhex','pub','pubhex',1,0,0,'2024-12-27 10:00:00');
+UNLOCK TABLES;
+
+LOCK TABLES ` + "`payment_request`" + ` WRITE;
+INSERT INTO ` + "`payment_request`" + ` VALUES (1,'btc');
+UNLOCK TABLES;
+`
+
+func TestDumpImporter(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewSQLite(&config.SQLite{
+		Path:       filepath.Join(t.TempDir(), "keygen.db"),
+		Passphrase: "test-passphrase",
+	})
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+	migrate(t, db)
+
+	counts, err := NewDumpImporter(db).Import(ctx, strings.NewReader(dump))
+	require.NoError(t, err)
+	// payment_request is not table of cold wallet
+	assert.Equal(t, map[string]int64{"seed": 1, "account_key": 2, "xrp_account_key": 1}, counts)
+
+	var (
+		multisig, script string
+		taproot          *string
+	)
+	err = db.QueryRowContext(ctx,
+		"SELECT multisig_address, redeem_script, taproot_address FROM account_key WHERE id = 2",
+	).Scan(&multisig, &script, &taproot)
+	require.NoError(t, err)
+	assert.Equal(t, "multi'2", multisig)
+	assert.Equal(t, `script\2`, script)
+	require.NotNil(t, taproot)
+	assert.Equal(t, "bc1p2", *taproot)
+
+	var isRegular bool
+	err = db.QueryRowContext(ctx, "SELECT is_regular_key_pair FROM xrp_account_key WHERE id = 1").Scan(&isRegular)
+	require.NoError(t, err)
+	assert.True(t, isRegular)
+
+	t.Run("duplicated rows are rolled back", func(t *testing.T) {
+		_, err := NewDumpImporter(db).Import(ctx, strings.NewReader(dump))
+		require.Error(t, err)
+
+		var count int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM account_key").Scan(&count))
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("invalid dump", func(t *testing.T) {
+		_, err := NewDumpImporter(db).Import(ctx, strings.NewReader("INSERT INTO `seed` VALUES (1,'btc"))
+		require.Error(t, err)
+	})
+}
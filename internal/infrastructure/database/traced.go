@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// DBTX is interface of database/sql implemented by *sql.DB and *sql.Tx, same as DBTX generated by sqlc
+type DBTX interface {
+	ExecContext(context.Context, string, ...any) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...any) *sql.Row
+}
+
+// NewTracedDB returns DBTX which records span per query
+func NewTracedDB(db DBTX) DBTX {
+	return &tracedDB{db: db}
+}
+
+// tracedDB is DBTX recording span named by sqlc query name like `sql.GetAllAddresses`
+type tracedDB struct {
+	db DBTX
+}
+
+func (t *tracedDB) ExecContext(ctx context.Context, query string, args ...any) (_ sql.Result, err error) {
+	ctx, span := startQuery(ctx, query)
+	defer tracer.End(span, &err)
+	return t.db.ExecContext(ctx, query, args...)
+}
+
+func (t *tracedDB) PrepareContext(ctx context.Context, query string) (_ *sql.Stmt, err error) {
+	ctx, span := startQuery(ctx, query)
+	defer tracer.End(span, &err)
+	return t.db.PrepareContext(ctx, query)
+}
+
+func (t *tracedDB) QueryContext(ctx context.Context, query string, args ...any) (_ *sql.Rows, err error) {
+	ctx, span := startQuery(ctx, query)
+	defer tracer.End(span, &err)
+	return t.db.QueryContext(ctx, query, args...)
+}
+
+func (t *tracedDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	ctx, span := startQuery(ctx, query)
+	row := t.db.QueryRowContext(ctx, query, args...)
+	err := row.Err()
+	tracer.End(span, &err)
+	return row
+}
+
+func startQuery(ctx context.Context, query string) (context.Context, trace.Span) {
+	name := queryName(query)
+	return tracer.Start(ctx, "sql."+name,
+		semconv.DBQuerySummary(name),
+		semconv.DBQueryText(query),
+	)
+}
+
+// queryName returns name from `-- name: GetAllAddresses :many` header of sqlc query
+func queryName(query string) string {
+	header, _, _ := strings.Cut(query, "\n")
+	fields := strings.Fields(strings.TrimPrefix(header, "-- name:"))
+	if !strings.HasPrefix(header, "-- name:") || len(fields) == 0 {
+		return "query"
+	}
+	return fields[0]
+}
@@ -38,6 +38,23 @@ func (w *WS) Call(ctx context.Context, req, res any) error {
 	return nil
 }
 
+// Write writes request without waiting for response
+// e.g. subscription whose messages are read by Read
+func (w *WS) Write(ctx context.Context, req any) error {
+	if err := wsjson.Write(ctx, w.conn, req); err != nil {
+		return fmt.Errorf("fail to call wsjson.Write(): %w", err)
+	}
+	return nil
+}
+
+// Read reads next message
+func (w *WS) Read(ctx context.Context, res any) error {
+	if err := wsjson.Read(ctx, w.conn, res); err != nil {
+		return fmt.Errorf("fail to call wsjson.Read(): %w", err)
+	}
+	return nil
+}
+
 // Close disconnects
 func (w *WS) Close() error {
 	return w.conn.Close(websocket.StatusNormalClosure, "")
@@ -27,16 +27,16 @@ func NewAccountKeyRepositorySqlc(
 	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
 ) *AccountKeyRepositorySqlc {
 	return &AccountKeyRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		dbConn:       dbConn,
 		coinTypeCode: coinTypeCode,
 	}
 }
 
 // GetMaxIndex returns max idx
-func (r *AccountKeyRepositorySqlc) GetMaxIndex(accountType domainAccount.AccountType) (int64, error) {
-	ctx := context.Background()
-
+func (r *AccountKeyRepositorySqlc) GetMaxIndex(
+	ctx context.Context, accountType domainAccount.AccountType,
+) (int64, error) {
 	result, err := r.queries.GetMaxAccountKeyIndex(ctx, sqlc.GetMaxAccountKeyIndexParams{
 		Coin:    sqlc.AccountKeyCoin(r.coinTypeCode.String()),
 		Account: sqlc.AccountKeyAccount(accountType.String()),
@@ -54,9 +54,9 @@ func (r *AccountKeyRepositorySqlc) GetMaxIndex(accountType domainAccount.Account
 }
 
 // GetOneMaxID returns one record by max id
-func (r *AccountKeyRepositorySqlc) GetOneMaxID(accountType domainAccount.AccountType) (*models.AccountKey, error) {
-	ctx := context.Background()
-
+func (r *AccountKeyRepositorySqlc) GetOneMaxID(
+	ctx context.Context, accountType domainAccount.AccountType,
+) (*models.AccountKey, error) {
 	accountKey, err := r.queries.GetOneAccountKeyByMaxID(ctx, sqlc.GetOneAccountKeyByMaxIDParams{
 		Coin:    sqlc.AccountKeyCoin(r.coinTypeCode.String()),
 		Account: sqlc.AccountKeyAccount(accountType.String()),
@@ -70,10 +70,8 @@ func (r *AccountKeyRepositorySqlc) GetOneMaxID(accountType domainAccount.Account
 
 // GetAllAddrStatus returns all AccountKey by addr_status
 func (r *AccountKeyRepositorySqlc) GetAllAddrStatus(
-	accountType domainAccount.AccountType, addrStatus address.AddrStatus,
+	ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus,
 ) ([]*models.AccountKey, error) {
-	ctx := context.Background()
-
 	accountKeys, err := r.queries.GetAccountKeysByAddrStatus(ctx, sqlc.GetAccountKeysByAddrStatusParams{
 		Coin:       sqlc.AccountKeyCoin(r.coinTypeCode.String()),
 		Account:    sqlc.AccountKeyAccount(accountType.String()),
@@ -93,10 +91,8 @@ func (r *AccountKeyRepositorySqlc) GetAllAddrStatus(
 
 // GetAllMultiAddr returns all AccountKey by multisig_address
 func (r *AccountKeyRepositorySqlc) GetAllMultiAddr(
-	accountType domainAccount.AccountType, addrs []string,
+	ctx context.Context, accountType domainAccount.AccountType, addrs []string,
 ) ([]*models.AccountKey, error) {
-	ctx := context.Background()
-
 	accountKeys, err := r.queries.GetAccountKeysByMultisigAddresses(
 		ctx,
 		sqlc.GetAccountKeysByMultisigAddressesParams{
@@ -118,9 +114,7 @@ func (r *AccountKeyRepositorySqlc) GetAllMultiAddr(
 }
 
 // InsertBulk inserts multiple records
-func (r *AccountKeyRepositorySqlc) InsertBulk(items []*models.AccountKey) error {
-	ctx := context.Background()
-
+func (r *AccountKeyRepositorySqlc) InsertBulk(ctx context.Context, items []*models.AccountKey) error {
 	for _, item := range items {
 		_, err := r.queries.InsertAccountKey(ctx, sqlc.InsertAccountKeyParams{
 			Coin:               sqlc.AccountKeyCoin(item.Coin),
@@ -147,10 +141,8 @@ func (r *AccountKeyRepositorySqlc) InsertBulk(items []*models.AccountKey) error
 
 // UpdateAddr updates address by P2SHSegWitAddr
 func (r *AccountKeyRepositorySqlc) UpdateAddr(
-	accountType domainAccount.AccountType, addr, keyAddress string,
+	ctx context.Context, accountType domainAccount.AccountType, addr, keyAddress string,
 ) (int64, error) {
-	ctx := context.Background()
-
 	result, err := r.queries.UpdateAccountKeyAddress(ctx, sqlc.UpdateAccountKeyAddressParams{
 		P2pkhAddress:      addr,
 		UpdatedAt:         sql.NullTime{Time: time.Now(), Valid: true},
@@ -172,9 +164,8 @@ func (r *AccountKeyRepositorySqlc) UpdateAddr(
 
 // UpdateAddrStatus updates addr_status
 func (r *AccountKeyRepositorySqlc) UpdateAddrStatus(
-	accountType domainAccount.AccountType, addrStatus address.AddrStatus, strWIFs []string,
+	ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus, strWIFs []string,
 ) (int64, error) {
-	ctx := context.Background()
 	var totalAffected int64
 
 	// sqlc doesn't support IN clauses with variable arguments, so update one at a time
@@ -202,10 +193,8 @@ func (r *AccountKeyRepositorySqlc) UpdateAddrStatus(
 
 // UpdateMultisigAddr updates multisig_address
 func (r *AccountKeyRepositorySqlc) UpdateMultisigAddr(
-	accountType domainAccount.AccountType, item *models.AccountKey,
+	ctx context.Context, accountType domainAccount.AccountType, item *models.AccountKey,
 ) (int64, error) {
-	ctx := context.Background()
-
 	result, err := r.queries.UpdateAccountKeyMultisigAddr(ctx, sqlc.UpdateAccountKeyMultisigAddrParams{
 		MultisigAddress: item.MultisigAddress,
 		RedeemScript:    item.RedeemScript,
@@ -229,10 +218,8 @@ func (r *AccountKeyRepositorySqlc) UpdateMultisigAddr(
 
 // UpdateMultisigAddrs updates all multisig_address with transaction
 func (r *AccountKeyRepositorySqlc) UpdateMultisigAddrs(
-	accountType domainAccount.AccountType, items []*models.AccountKey,
+	ctx context.Context, accountType domainAccount.AccountType, items []*models.AccountKey,
 ) (int64, error) {
-	ctx := context.Background()
-
 	// transaction
 	dtx, err := r.dbConn.Begin()
 	if err != nil {
@@ -246,7 +233,7 @@ func (r *AccountKeyRepositorySqlc) UpdateMultisigAddrs(
 		}
 	}()
 
-	qtx := r.queries.WithTx(dtx)
+	qtx := sqlc.NewTraced(dtx)
 	var totalAffected int64
 
 	for _, item := range items {
@@ -0,0 +1,281 @@
+package cold
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlclite"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+)
+
+// AccountKeyRepositorySQLite is repository for account_key table using sqlc for SQLite
+type AccountKeyRepositorySQLite struct {
+	queries      *sqlclite.Queries
+	dbConn       *sql.DB
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewAccountKeyRepositorySQLite returns AccountKeyRepositorySQLite object
+func NewAccountKeyRepositorySQLite(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *AccountKeyRepositorySQLite {
+	return &AccountKeyRepositorySQLite{
+		queries:      sqlclite.NewTraced(dbConn),
+		dbConn:       dbConn,
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetMaxIndex returns max idx
+func (r *AccountKeyRepositorySQLite) GetMaxIndex(
+	ctx context.Context, accountType domainAccount.AccountType,
+) (int64, error) {
+	result, err := r.queries.GetMaxAccountKeyIndex(ctx, sqlclite.GetMaxAccountKeyIndexParams{
+		Coin:    r.coinTypeCode.String(),
+		Account: accountType.String(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call GetMaxAccountKeyIndex(): %w", err)
+	}
+
+	// Type assert interface{} to int64
+	if maxIdx, ok := result.(int64); ok {
+		return maxIdx, nil
+	}
+
+	return 0, nil
+}
+
+// GetOneMaxID returns one record by max id
+func (r *AccountKeyRepositorySQLite) GetOneMaxID(
+	ctx context.Context, accountType domainAccount.AccountType,
+) (*models.AccountKey, error) {
+	accountKey, err := r.queries.GetOneAccountKeyByMaxID(ctx, sqlclite.GetOneAccountKeyByMaxIDParams{
+		Coin:    r.coinTypeCode.String(),
+		Account: accountType.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetOneAccountKeyByMaxID(): %w", err)
+	}
+
+	return convertSQLiteAccountKeyToModel(&accountKey), nil
+}
+
+// GetAllAddrStatus returns all AccountKey by addr_status
+func (r *AccountKeyRepositorySQLite) GetAllAddrStatus(
+	ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus,
+) ([]*models.AccountKey, error) {
+	accountKeys, err := r.queries.GetAccountKeysByAddrStatus(ctx, sqlclite.GetAccountKeysByAddrStatusParams{
+		Coin:       r.coinTypeCode.String(),
+		Account:    accountType.String(),
+		AddrStatus: addrStatus.Int8(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetAccountKeysByAddrStatus(): %w", err)
+	}
+
+	result := make([]*models.AccountKey, len(accountKeys))
+	for i, accountKey := range accountKeys {
+		result[i] = convertSQLiteAccountKeyToModel(&accountKey)
+	}
+
+	return result, nil
+}
+
+// GetAllMultiAddr returns all AccountKey by multisig_address
+func (r *AccountKeyRepositorySQLite) GetAllMultiAddr(
+	ctx context.Context, accountType domainAccount.AccountType, addrs []string,
+) ([]*models.AccountKey, error) {
+	accountKeys, err := r.queries.GetAccountKeysByMultisigAddresses(
+		ctx,
+		sqlclite.GetAccountKeysByMultisigAddressesParams{
+			Coin:    r.coinTypeCode.String(),
+			Account: accountType.String(),
+			Addrs:   addrs,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetAccountKeysByMultisigAddresses(): %w", err)
+	}
+
+	result := make([]*models.AccountKey, len(accountKeys))
+	for i, accountKey := range accountKeys {
+		result[i] = convertSQLiteAccountKeyToModel(&accountKey)
+	}
+
+	return result, nil
+}
+
+// InsertBulk inserts multiple records
+func (r *AccountKeyRepositorySQLite) InsertBulk(ctx context.Context, items []*models.AccountKey) error {
+	for _, item := range items {
+		_, err := r.queries.InsertAccountKey(ctx, sqlclite.InsertAccountKeyParams{
+			Coin:               item.Coin,
+			KeyType:            item.KeyType,
+			Account:            item.Account,
+			P2pkhAddress:       item.P2PKHAddress,
+			P2shSegwitAddress:  item.P2SHSegwitAddress,
+			Bech32Address:      item.Bech32Address,
+			TaprootAddress:     sql.NullString{String: item.TaprootAddress, Valid: item.TaprootAddress != ""},
+			FullPublicKey:      item.FullPublicKey,
+			MultisigAddress:    item.MultisigAddress,
+			RedeemScript:       item.RedeemScript,
+			WalletImportFormat: item.WalletImportFormat,
+			Idx:                item.Idx,
+			AddrStatus:         item.AddrStatus,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to call InsertAccountKey(): %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateAddr updates address by P2SHSegWitAddr
+func (r *AccountKeyRepositorySQLite) UpdateAddr(
+	ctx context.Context, accountType domainAccount.AccountType, addr, keyAddress string,
+) (int64, error) {
+	result, err := r.queries.UpdateAccountKeyAddress(ctx, sqlclite.UpdateAccountKeyAddressParams{
+		P2pkhAddress:      addr,
+		UpdatedAt:         sql.NullTime{Time: time.Now(), Valid: true},
+		Coin:              r.coinTypeCode.String(),
+		Account:           accountType.String(),
+		P2shSegwitAddress: keyAddress,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateAccountKeyAddress(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateAddrStatus updates addr_status
+func (r *AccountKeyRepositorySQLite) UpdateAddrStatus(
+	ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus, strWIFs []string,
+) (int64, error) {
+	var totalAffected int64
+
+	// sqlc doesn't support IN clauses with variable arguments, so update one at a time
+	for _, wif := range strWIFs {
+		result, err := r.queries.UpdateAccountKeyAddrStatus(ctx, sqlclite.UpdateAccountKeyAddrStatusParams{
+			AddrStatus:         addrStatus.Int8(),
+			UpdatedAt:          sql.NullTime{Time: time.Now(), Valid: true},
+			Coin:               r.coinTypeCode.String(),
+			Account:            accountType.String(),
+			WalletImportFormat: wif,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to call UpdateAccountKeyAddrStatus(): %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+		}
+		totalAffected += affected
+	}
+
+	return totalAffected, nil
+}
+
+// UpdateMultisigAddr updates multisig_address
+func (r *AccountKeyRepositorySQLite) UpdateMultisigAddr(
+	ctx context.Context, accountType domainAccount.AccountType, item *models.AccountKey,
+) (int64, error) {
+	result, err := r.queries.UpdateAccountKeyMultisigAddr(ctx, sqlclite.UpdateAccountKeyMultisigAddrParams{
+		MultisigAddress: item.MultisigAddress,
+		RedeemScript:    item.RedeemScript,
+		AddrStatus:      item.AddrStatus,
+		UpdatedAt:       sql.NullTime{Time: time.Now(), Valid: true},
+		Coin:            r.coinTypeCode.String(),
+		Account:         accountType.String(),
+		FullPublicKey:   item.FullPublicKey,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateAccountKeyMultisigAddr(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateMultisigAddrs updates all multisig_address with transaction
+func (r *AccountKeyRepositorySQLite) UpdateMultisigAddrs(
+	ctx context.Context, accountType domainAccount.AccountType, items []*models.AccountKey,
+) (int64, error) {
+	// transaction
+	dtx, err := r.dbConn.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to call db.Begin(): %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = dtx.Rollback() // Error already being handled
+		} else {
+			_ = dtx.Commit() // Error already being handled
+		}
+	}()
+
+	qtx := sqlclite.NewTraced(dtx)
+	var totalAffected int64
+
+	for _, item := range items {
+		result, updateErr := qtx.UpdateAccountKeyMultisigAddr(ctx, sqlclite.UpdateAccountKeyMultisigAddrParams{
+			MultisigAddress: item.MultisigAddress,
+			RedeemScript:    item.RedeemScript,
+			AddrStatus:      item.AddrStatus,
+			UpdatedAt:       sql.NullTime{Time: time.Now(), Valid: true},
+			Coin:            r.coinTypeCode.String(),
+			Account:         accountType.String(),
+			FullPublicKey:   item.FullPublicKey,
+		})
+		if updateErr != nil {
+			return 0, fmt.Errorf("failed to call UpdateAccountKeyMultisigAddr(): %w", updateErr)
+		}
+
+		affected, affectedErr := result.RowsAffected()
+		if affectedErr != nil {
+			return 0, fmt.Errorf("failed to get RowsAffected(): %w", affectedErr)
+		}
+		totalAffected += affected
+	}
+
+	return totalAffected, nil
+}
+
+// Helper functions
+
+func convertSQLiteAccountKeyToModel(accountKey *sqlclite.AccountKey) *models.AccountKey {
+	return &models.AccountKey{
+		ID:                 accountKey.ID,
+		Coin:               accountKey.Coin,
+		KeyType:            accountKey.KeyType,
+		Account:            accountKey.Account,
+		P2PKHAddress:       accountKey.P2pkhAddress,
+		P2SHSegwitAddress:  accountKey.P2shSegwitAddress,
+		Bech32Address:      accountKey.Bech32Address,
+		TaprootAddress:     accountKey.TaprootAddress.String,
+		FullPublicKey:      accountKey.FullPublicKey,
+		MultisigAddress:    accountKey.MultisigAddress,
+		RedeemScript:       accountKey.RedeemScript,
+		WalletImportFormat: accountKey.WalletImportFormat,
+		Idx:                accountKey.Idx,
+		AddrStatus:         accountKey.AddrStatus,
+		UpdatedAt:          convertSQLNullTimeToNullTime(accountKey.UpdatedAt),
+	}
+}
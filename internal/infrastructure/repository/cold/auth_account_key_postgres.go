@@ -0,0 +1,113 @@
+package cold
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+)
+
+// AuthAccountKeyRepositoryPostgres is repository for auth_account_key table using sqlc for PostgreSQL
+type AuthAccountKeyRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewAuthAccountKeyRepositoryPostgres returns AuthAccountKeyRepositoryPostgres object
+func NewAuthAccountKeyRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *AuthAccountKeyRepositoryPostgres {
+	return &AuthAccountKeyRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetOne returns one record by authType
+func (r *AuthAccountKeyRepositoryPostgres) GetOne(
+	ctx context.Context, authType domainAccount.AuthType,
+) (*models.AuthAccountKey, error) {
+	authKey, err := r.queries.GetAuthAccountKey(ctx, sqlcpg.GetAuthAccountKeyParams{
+		Coin:        sqlcpg.AuthAccountKeyCoin(r.coinTypeCode.String()),
+		AuthAccount: authType.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetAuthAccountKey(): %w", err)
+	}
+
+	return convertPostgresAuthAccountKeyToModel(&authKey), nil
+}
+
+// Insert inserts record
+func (r *AuthAccountKeyRepositoryPostgres) Insert(ctx context.Context, item *models.AuthAccountKey) error {
+	_, err := r.queries.InsertAuthAccountKey(ctx, sqlcpg.InsertAuthAccountKeyParams{
+		Coin:               sqlcpg.AuthAccountKeyCoin(item.Coin),
+		KeyType:            item.KeyType,
+		AuthAccount:        item.AuthAccount,
+		P2pkhAddress:       item.P2PKHAddress,
+		P2shSegwitAddress:  item.P2SHSegwitAddress,
+		Bech32Address:      item.Bech32Address,
+		TaprootAddress:     sql.NullString{String: item.TaprootAddress, Valid: item.TaprootAddress != ""},
+		FullPublicKey:      item.FullPublicKey,
+		MultisigAddress:    item.MultisigAddress,
+		RedeemScript:       item.RedeemScript,
+		WalletImportFormat: item.WalletImportFormat,
+		Idx:                item.Idx,
+		AddrStatus:         item.AddrStatus,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertAuthAccountKey(): %w", err)
+	}
+
+	return nil
+}
+
+// UpdateAddrStatus updates addr_status
+func (r *AuthAccountKeyRepositoryPostgres) UpdateAddrStatus(
+	ctx context.Context, addrStatus address.AddrStatus, strWIF string,
+) (int64, error) {
+	result, err := r.queries.UpdateAuthAccountKeyAddrStatus(ctx, sqlcpg.UpdateAuthAccountKeyAddrStatusParams{
+		AddrStatus:         addrStatus.Int8(),
+		UpdatedAt:          sql.NullTime{Time: time.Now(), Valid: true},
+		Coin:               sqlcpg.AuthAccountKeyCoin(r.coinTypeCode.String()),
+		WalletImportFormat: strWIF,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateAuthAccountKeyAddrStatus(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions
+
+func convertPostgresAuthAccountKeyToModel(authKey *sqlcpg.AuthAccountKey) *models.AuthAccountKey {
+	return &models.AuthAccountKey{
+		ID:                 authKey.ID,
+		Coin:               string(authKey.Coin),
+		KeyType:            authKey.KeyType,
+		AuthAccount:        authKey.AuthAccount,
+		P2PKHAddress:       authKey.P2pkhAddress,
+		P2SHSegwitAddress:  authKey.P2shSegwitAddress,
+		Bech32Address:      authKey.Bech32Address,
+		TaprootAddress:     authKey.TaprootAddress.String,
+		FullPublicKey:      authKey.FullPublicKey,
+		MultisigAddress:    authKey.MultisigAddress,
+		RedeemScript:       authKey.RedeemScript,
+		WalletImportFormat: authKey.WalletImportFormat,
+		Idx:                authKey.Idx,
+		AddrStatus:         authKey.AddrStatus,
+		UpdatedAt:          convertSQLNullTimeToNullTime(authKey.UpdatedAt),
+	}
+}
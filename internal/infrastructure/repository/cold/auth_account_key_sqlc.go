@@ -24,15 +24,15 @@ func NewAuthAccountKeyRepositorySqlc(
 	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
 ) *AuthAccountKeyRepositorySqlc {
 	return &AuthAccountKeyRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
 // GetOne returns one record by authType
-func (r *AuthAccountKeyRepositorySqlc) GetOne(authType domainAccount.AuthType) (*models.AuthAccountKey, error) {
-	ctx := context.Background()
-
+func (r *AuthAccountKeyRepositorySqlc) GetOne(
+	ctx context.Context, authType domainAccount.AuthType,
+) (*models.AuthAccountKey, error) {
 	authKey, err := r.queries.GetAuthAccountKey(ctx, sqlc.GetAuthAccountKeyParams{
 		Coin:        sqlc.AuthAccountKeyCoin(r.coinTypeCode.String()),
 		AuthAccount: authType.String(),
@@ -45,9 +45,7 @@ func (r *AuthAccountKeyRepositorySqlc) GetOne(authType domainAccount.AuthType) (
 }
 
 // Insert inserts record
-func (r *AuthAccountKeyRepositorySqlc) Insert(item *models.AuthAccountKey) error {
-	ctx := context.Background()
-
+func (r *AuthAccountKeyRepositorySqlc) Insert(ctx context.Context, item *models.AuthAccountKey) error {
 	_, err := r.queries.InsertAuthAccountKey(ctx, sqlc.InsertAuthAccountKeyParams{
 		Coin:               sqlc.AuthAccountKeyCoin(item.Coin),
 		KeyType:            item.KeyType,
@@ -71,9 +69,9 @@ func (r *AuthAccountKeyRepositorySqlc) Insert(item *models.AuthAccountKey) error
 }
 
 // UpdateAddrStatus updates addr_status
-func (r *AuthAccountKeyRepositorySqlc) UpdateAddrStatus(addrStatus address.AddrStatus, strWIF string) (int64, error) {
-	ctx := context.Background()
-
+func (r *AuthAccountKeyRepositorySqlc) UpdateAddrStatus(
+	ctx context.Context, addrStatus address.AddrStatus, strWIF string,
+) (int64, error) {
 	result, err := r.queries.UpdateAuthAccountKeyAddrStatus(ctx, sqlc.UpdateAuthAccountKeyAddrStatusParams{
 		AddrStatus:         addrStatus.Int8(),
 		UpdatedAt:          sql.NullTime{Time: time.Now(), Valid: true},
@@ -0,0 +1,113 @@
+package cold
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlclite"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+)
+
+// AuthAccountKeyRepositorySQLite is repository for auth_account_key table using sqlc for SQLite
+type AuthAccountKeyRepositorySQLite struct {
+	queries      *sqlclite.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewAuthAccountKeyRepositorySQLite returns AuthAccountKeyRepositorySQLite object
+func NewAuthAccountKeyRepositorySQLite(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *AuthAccountKeyRepositorySQLite {
+	return &AuthAccountKeyRepositorySQLite{
+		queries:      sqlclite.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetOne returns one record by authType
+func (r *AuthAccountKeyRepositorySQLite) GetOne(
+	ctx context.Context, authType domainAccount.AuthType,
+) (*models.AuthAccountKey, error) {
+	authKey, err := r.queries.GetAuthAccountKey(ctx, sqlclite.GetAuthAccountKeyParams{
+		Coin:        r.coinTypeCode.String(),
+		AuthAccount: authType.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetAuthAccountKey(): %w", err)
+	}
+
+	return convertSQLiteAuthAccountKeyToModel(&authKey), nil
+}
+
+// Insert inserts record
+func (r *AuthAccountKeyRepositorySQLite) Insert(ctx context.Context, item *models.AuthAccountKey) error {
+	_, err := r.queries.InsertAuthAccountKey(ctx, sqlclite.InsertAuthAccountKeyParams{
+		Coin:               item.Coin,
+		KeyType:            item.KeyType,
+		AuthAccount:        item.AuthAccount,
+		P2pkhAddress:       item.P2PKHAddress,
+		P2shSegwitAddress:  item.P2SHSegwitAddress,
+		Bech32Address:      item.Bech32Address,
+		TaprootAddress:     sql.NullString{String: item.TaprootAddress, Valid: item.TaprootAddress != ""},
+		FullPublicKey:      item.FullPublicKey,
+		MultisigAddress:    item.MultisigAddress,
+		RedeemScript:       item.RedeemScript,
+		WalletImportFormat: item.WalletImportFormat,
+		Idx:                item.Idx,
+		AddrStatus:         item.AddrStatus,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertAuthAccountKey(): %w", err)
+	}
+
+	return nil
+}
+
+// UpdateAddrStatus updates addr_status
+func (r *AuthAccountKeyRepositorySQLite) UpdateAddrStatus(
+	ctx context.Context, addrStatus address.AddrStatus, strWIF string,
+) (int64, error) {
+	result, err := r.queries.UpdateAuthAccountKeyAddrStatus(ctx, sqlclite.UpdateAuthAccountKeyAddrStatusParams{
+		AddrStatus:         addrStatus.Int8(),
+		UpdatedAt:          sql.NullTime{Time: time.Now(), Valid: true},
+		Coin:               r.coinTypeCode.String(),
+		WalletImportFormat: strWIF,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateAuthAccountKeyAddrStatus(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions
+
+func convertSQLiteAuthAccountKeyToModel(authKey *sqlclite.AuthAccountKey) *models.AuthAccountKey {
+	return &models.AuthAccountKey{
+		ID:                 authKey.ID,
+		Coin:               authKey.Coin,
+		KeyType:            authKey.KeyType,
+		AuthAccount:        authKey.AuthAccount,
+		P2PKHAddress:       authKey.P2pkhAddress,
+		P2SHSegwitAddress:  authKey.P2shSegwitAddress,
+		Bech32Address:      authKey.Bech32Address,
+		TaprootAddress:     authKey.TaprootAddress.String,
+		FullPublicKey:      authKey.FullPublicKey,
+		MultisigAddress:    authKey.MultisigAddress,
+		RedeemScript:       authKey.RedeemScript,
+		WalletImportFormat: authKey.WalletImportFormat,
+		Idx:                authKey.Idx,
+		AddrStatus:         authKey.AddrStatus,
+		UpdatedAt:          convertSQLNullTimeToNullTime(authKey.UpdatedAt),
+	}
+}
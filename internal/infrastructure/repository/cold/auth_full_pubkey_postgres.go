@@ -0,0 +1,87 @@
+package cold
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// AuthFullPubkeyRepositoryPostgres is repository for auth_fullpubkey table using sqlc for PostgreSQL
+type AuthFullPubkeyRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewAuthFullPubkeyRepositoryPostgres returns AuthFullPubkeyRepositoryPostgres object
+func NewAuthFullPubkeyRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *AuthFullPubkeyRepositoryPostgres {
+	return &AuthFullPubkeyRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetOne returns one record by authType
+func (r *AuthFullPubkeyRepositoryPostgres) GetOne(
+	ctx context.Context, authType domainAccount.AuthType,
+) (*models.AuthFullpubkey, error) {
+	authPubkey, err := r.queries.GetAuthFullPubkey(ctx, sqlcpg.GetAuthFullPubkeyParams{
+		Coin:        sqlcpg.AuthFullpubkeyCoin(r.coinTypeCode.String()),
+		AuthAccount: authType.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetAuthFullPubkey(): %w", err)
+	}
+
+	return convertPostgresAuthFullPubkeyToModel(&authPubkey), nil
+}
+
+// Insert inserts record
+func (r *AuthFullPubkeyRepositoryPostgres) Insert(
+	ctx context.Context, authType domainAccount.AuthType, fullPubKey string,
+) error {
+	_, err := r.queries.InsertAuthFullPubkey(ctx, sqlcpg.InsertAuthFullPubkeyParams{
+		Coin:          sqlcpg.AuthFullpubkeyCoin(r.coinTypeCode.String()),
+		AuthAccount:   authType.String(),
+		FullPublicKey: fullPubKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertAuthFullPubkey(): %w", err)
+	}
+
+	return nil
+}
+
+// InsertBulk inserts multiple records
+func (r *AuthFullPubkeyRepositoryPostgres) InsertBulk(ctx context.Context, items []*models.AuthFullpubkey) error {
+	for _, item := range items {
+		_, err := r.queries.InsertAuthFullPubkey(ctx, sqlcpg.InsertAuthFullPubkeyParams{
+			Coin:          sqlcpg.AuthFullpubkeyCoin(item.Coin),
+			AuthAccount:   item.AuthAccount,
+			FullPublicKey: item.FullPublicKey,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to call InsertAuthFullPubkey(): %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Helper functions
+
+func convertPostgresAuthFullPubkeyToModel(authPubkey *sqlcpg.AuthFullpubkey) *models.AuthFullpubkey {
+	return &models.AuthFullpubkey{
+		ID:            authPubkey.ID,
+		Coin:          string(authPubkey.Coin),
+		AuthAccount:   authPubkey.AuthAccount,
+		FullPublicKey: authPubkey.FullPublicKey,
+		UpdatedAt:     convertSQLNullTimeToNullTime(authPubkey.UpdatedAt),
+	}
+}
@@ -22,15 +22,15 @@ func NewAuthFullPubkeyRepositorySqlc(
 	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
 ) *AuthFullPubkeyRepositorySqlc {
 	return &AuthFullPubkeyRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
 // GetOne returns one record by authType
-func (r *AuthFullPubkeyRepositorySqlc) GetOne(authType domainAccount.AuthType) (*models.AuthFullpubkey, error) {
-	ctx := context.Background()
-
+func (r *AuthFullPubkeyRepositorySqlc) GetOne(
+	ctx context.Context, authType domainAccount.AuthType,
+) (*models.AuthFullpubkey, error) {
 	authPubkey, err := r.queries.GetAuthFullPubkey(ctx, sqlc.GetAuthFullPubkeyParams{
 		Coin:        sqlc.AuthFullpubkeyCoin(r.coinTypeCode.String()),
 		AuthAccount: authType.String(),
@@ -43,9 +43,9 @@ func (r *AuthFullPubkeyRepositorySqlc) GetOne(authType domainAccount.AuthType) (
 }
 
 // Insert inserts record
-func (r *AuthFullPubkeyRepositorySqlc) Insert(authType domainAccount.AuthType, fullPubKey string) error {
-	ctx := context.Background()
-
+func (r *AuthFullPubkeyRepositorySqlc) Insert(
+	ctx context.Context, authType domainAccount.AuthType, fullPubKey string,
+) error {
 	_, err := r.queries.InsertAuthFullPubkey(ctx, sqlc.InsertAuthFullPubkeyParams{
 		Coin:          sqlc.AuthFullpubkeyCoin(r.coinTypeCode.String()),
 		AuthAccount:   authType.String(),
@@ -59,9 +59,7 @@ func (r *AuthFullPubkeyRepositorySqlc) Insert(authType domainAccount.AuthType, f
 }
 
 // InsertBulk inserts multiple records
-func (r *AuthFullPubkeyRepositorySqlc) InsertBulk(items []*models.AuthFullpubkey) error {
-	ctx := context.Background()
-
+func (r *AuthFullPubkeyRepositorySqlc) InsertBulk(ctx context.Context, items []*models.AuthFullpubkey) error {
 	for _, item := range items {
 		_, err := r.queries.InsertAuthFullPubkey(ctx, sqlc.InsertAuthFullPubkeyParams{
 			Coin:          sqlc.AuthFullpubkeyCoin(item.Coin),
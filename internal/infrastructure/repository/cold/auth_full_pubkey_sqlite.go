@@ -0,0 +1,87 @@
+package cold
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlclite"
+)
+
+// AuthFullPubkeyRepositorySQLite is repository for auth_fullpubkey table using sqlc for SQLite
+type AuthFullPubkeyRepositorySQLite struct {
+	queries      *sqlclite.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewAuthFullPubkeyRepositorySQLite returns AuthFullPubkeyRepositorySQLite object
+func NewAuthFullPubkeyRepositorySQLite(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *AuthFullPubkeyRepositorySQLite {
+	return &AuthFullPubkeyRepositorySQLite{
+		queries:      sqlclite.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetOne returns one record by authType
+func (r *AuthFullPubkeyRepositorySQLite) GetOne(
+	ctx context.Context, authType domainAccount.AuthType,
+) (*models.AuthFullpubkey, error) {
+	authPubkey, err := r.queries.GetAuthFullPubkey(ctx, sqlclite.GetAuthFullPubkeyParams{
+		Coin:        r.coinTypeCode.String(),
+		AuthAccount: authType.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetAuthFullPubkey(): %w", err)
+	}
+
+	return convertSQLiteAuthFullPubkeyToModel(&authPubkey), nil
+}
+
+// Insert inserts record
+func (r *AuthFullPubkeyRepositorySQLite) Insert(
+	ctx context.Context, authType domainAccount.AuthType, fullPubKey string,
+) error {
+	_, err := r.queries.InsertAuthFullPubkey(ctx, sqlclite.InsertAuthFullPubkeyParams{
+		Coin:          r.coinTypeCode.String(),
+		AuthAccount:   authType.String(),
+		FullPublicKey: fullPubKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertAuthFullPubkey(): %w", err)
+	}
+
+	return nil
+}
+
+// InsertBulk inserts multiple records
+func (r *AuthFullPubkeyRepositorySQLite) InsertBulk(ctx context.Context, items []*models.AuthFullpubkey) error {
+	for _, item := range items {
+		_, err := r.queries.InsertAuthFullPubkey(ctx, sqlclite.InsertAuthFullPubkeyParams{
+			Coin:          item.Coin,
+			AuthAccount:   item.AuthAccount,
+			FullPublicKey: item.FullPublicKey,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to call InsertAuthFullPubkey(): %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Helper functions
+
+func convertSQLiteAuthFullPubkeyToModel(authPubkey *sqlclite.AuthFullpubkey) *models.AuthFullpubkey {
+	return &models.AuthFullpubkey{
+		ID:            authPubkey.ID,
+		Coin:          authPubkey.Coin,
+		AuthAccount:   authPubkey.AuthAccount,
+		FullPublicKey: authPubkey.FullPublicKey,
+		UpdatedAt:     convertSQLNullTimeToNullTime(authPubkey.UpdatedAt),
+	}
+}
@@ -1,6 +1,7 @@
 package cold
 
 import (
+	"context"
 	"errors"
 
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
@@ -17,8 +18,9 @@ import (
 // It abstracts over both AccountKeyRepository and AuthAccountKeyRepository
 // to allow the same use case code to work with either account or auth keys.
 type HDWalletRepo interface {
-	GetMaxIndex(accountType domainAccount.AccountType) (int64, error)
+	GetMaxIndex(ctx context.Context, accountType domainAccount.AccountType) (int64, error)
 	Insert(
+		ctx context.Context,
 		keys []domainKey.WalletKey,
 		idx int64,
 		coinTypeCode domainCoin.CoinTypeCode,
@@ -49,8 +51,8 @@ func NewAuthHDWalletRepo(
 }
 
 // GetMaxIndex returns index for auth keys (always 0 since only one auth key is allowed)
-func (w *AuthHDWalletRepo) GetMaxIndex(_ domainAccount.AccountType) (int64, error) {
-	_, err := w.authKeyRepo.GetOne(w.authType)
+func (w *AuthHDWalletRepo) GetMaxIndex(ctx context.Context, _ domainAccount.AccountType) (int64, error) {
+	_, err := w.authKeyRepo.GetOne(ctx, w.authType)
 	if err != nil {
 		return 0, nil
 	}
@@ -59,6 +61,7 @@ func (w *AuthHDWalletRepo) GetMaxIndex(_ domainAccount.AccountType) (int64, erro
 
 // Insert inserts key to auth_account_key table
 func (w *AuthHDWalletRepo) Insert(
+	ctx context.Context,
 	keys []domainKey.WalletKey,
 	idx int64,
 	coinTypeCode domainCoin.CoinTypeCode,
@@ -84,7 +87,7 @@ func (w *AuthHDWalletRepo) Insert(
 		Idx:                idx,
 	}
 
-	return w.authKeyRepo.Insert(item)
+	return w.authKeyRepo.Insert(ctx, item)
 }
 
 //-----------------------------------------------------------------------------
@@ -104,8 +107,8 @@ func NewAccountHDWalletRepo(accountKeyRepo AccountKeyRepositorier) HDWalletRepo
 }
 
 // GetMaxIndex returns the next available index for account keys
-func (w *AccountHDWalletRepo) GetMaxIndex(accountType domainAccount.AccountType) (int64, error) {
-	idx, err := w.accountKeyRepo.GetMaxIndex(accountType)
+func (w *AccountHDWalletRepo) GetMaxIndex(ctx context.Context, accountType domainAccount.AccountType) (int64, error) {
+	idx, err := w.accountKeyRepo.GetMaxIndex(ctx, accountType)
 	if err != nil {
 		return 0, nil
 	}
@@ -115,6 +118,7 @@ func (w *AccountHDWalletRepo) GetMaxIndex(accountType domainAccount.AccountType)
 
 // Insert inserts keys to account_key_table
 func (w *AccountHDWalletRepo) Insert(
+	ctx context.Context,
 	keys []domainKey.WalletKey,
 	idxFrom int64,
 	coinTypeCode domainCoin.CoinTypeCode,
@@ -140,5 +144,5 @@ func (w *AccountHDWalletRepo) Insert(
 		}
 		idxFrom++
 	}
-	return w.accountKeyRepo.InsertBulk(accountKeyItems)
+	return w.accountKeyRepo.InsertBulk(ctx, accountKeyItems)
 }
@@ -0,0 +1,61 @@
+package cold
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// SeedRepositoryPostgres is repository for seed table using sqlc for PostgreSQL
+type SeedRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewSeedRepositoryPostgres returns SeedRepositoryPostgres object
+func NewSeedRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *SeedRepositoryPostgres {
+	return &SeedRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetOne returns one record
+func (r *SeedRepositoryPostgres) GetOne(ctx context.Context) (*models.Seed, error) {
+	seed, err := r.queries.GetSeed(ctx, sqlcpg.SeedCoin(r.coinTypeCode.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetSeed(): %w", err)
+	}
+
+	return convertPostgresSeedToModel(&seed), nil
+}
+
+// Insert inserts record
+func (r *SeedRepositoryPostgres) Insert(ctx context.Context, strSeed string) error {
+	_, err := r.queries.InsertSeed(ctx, sqlcpg.InsertSeedParams{
+		Coin: sqlcpg.SeedCoin(r.coinTypeCode.String()),
+		Seed: strSeed,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertSeed(): %w", err)
+	}
+
+	return nil
+}
+
+// Helper functions
+
+func convertPostgresSeedToModel(seed *sqlcpg.Seed) *models.Seed {
+	return &models.Seed{
+		ID:        seed.ID,
+		Coin:      string(seed.Coin),
+		Seed:      seed.Seed,
+		UpdatedAt: convertSQLNullTimeToNullTime(seed.UpdatedAt),
+	}
+}
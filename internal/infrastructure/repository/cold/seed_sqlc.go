@@ -21,15 +21,13 @@ func NewSeedRepositorySqlc(
 	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
 ) *SeedRepositorySqlc {
 	return &SeedRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
 // GetOne returns one record
-func (r *SeedRepositorySqlc) GetOne() (*models.Seed, error) {
-	ctx := context.Background()
-
+func (r *SeedRepositorySqlc) GetOne(ctx context.Context) (*models.Seed, error) {
 	seed, err := r.queries.GetSeed(ctx, sqlc.SeedCoin(r.coinTypeCode.String()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetSeed(): %w", err)
@@ -39,9 +37,7 @@ func (r *SeedRepositorySqlc) GetOne() (*models.Seed, error) {
 }
 
 // Insert inserts record
-func (r *SeedRepositorySqlc) Insert(strSeed string) error {
-	ctx := context.Background()
-
+func (r *SeedRepositorySqlc) Insert(ctx context.Context, strSeed string) error {
 	_, err := r.queries.InsertSeed(ctx, sqlc.InsertSeedParams{
 		Coin: sqlc.SeedCoin(r.coinTypeCode.String()),
 		Seed: strSeed,
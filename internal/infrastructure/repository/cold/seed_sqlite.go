@@ -0,0 +1,61 @@
+package cold
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlclite"
+)
+
+// SeedRepositorySQLite is repository for seed table using sqlc for SQLite
+type SeedRepositorySQLite struct {
+	queries      *sqlclite.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewSeedRepositorySQLite returns SeedRepositorySQLite object
+func NewSeedRepositorySQLite(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *SeedRepositorySQLite {
+	return &SeedRepositorySQLite{
+		queries:      sqlclite.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetOne returns one record
+func (r *SeedRepositorySQLite) GetOne(ctx context.Context) (*models.Seed, error) {
+	seed, err := r.queries.GetSeed(ctx, r.coinTypeCode.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetSeed(): %w", err)
+	}
+
+	return convertSQLiteSeedToModel(&seed), nil
+}
+
+// Insert inserts record
+func (r *SeedRepositorySQLite) Insert(ctx context.Context, strSeed string) error {
+	_, err := r.queries.InsertSeed(ctx, sqlclite.InsertSeedParams{
+		Coin: r.coinTypeCode.String(),
+		Seed: strSeed,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertSeed(): %w", err)
+	}
+
+	return nil
+}
+
+// Helper functions
+
+func convertSQLiteSeedToModel(seed *sqlclite.Seed) *models.Seed {
+	return &models.Seed{
+		ID:        seed.ID,
+		Coin:      seed.Coin,
+		Seed:      seed.Seed,
+		UpdatedAt: convertSQLNullTimeToNullTime(seed.UpdatedAt),
+	}
+}
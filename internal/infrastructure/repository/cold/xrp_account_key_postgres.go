@@ -0,0 +1,195 @@
+package cold
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+)
+
+// XRPAccountKeyRepositoryPostgres is repository for xrp_account_key table using sqlc for PostgreSQL
+type XRPAccountKeyRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewXRPAccountKeyRepositoryPostgres returns XRPAccountKeyRepositoryPostgres object
+func NewXRPAccountKeyRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *XRPAccountKeyRepositoryPostgres {
+	return &XRPAccountKeyRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetAllAddrStatus returns all XRPAccountKey by addr_status
+func (r *XRPAccountKeyRepositoryPostgres) GetAllAddrStatus(
+	ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus,
+) ([]*models.XRPAccountKey, error) {
+	xrpKeys, err := r.queries.GetXRPAccountKeysByAddrStatus(ctx, sqlcpg.GetXRPAccountKeysByAddrStatusParams{
+		Coin:       sqlcpg.XrpAccountKeyCoin(r.coinTypeCode.String()),
+		Account:    sqlcpg.XrpAccountKeyAccount(accountType.String()),
+		AddrStatus: addrStatus.Int8(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXRPAccountKeysByAddrStatus(): %w", err)
+	}
+
+	result := make([]*models.XRPAccountKey, len(xrpKeys))
+	for i, xrpKey := range xrpKeys {
+		result[i] = convertPostgresXRPAccountKeyToModel(&xrpKey)
+	}
+
+	return result, nil
+}
+
+// GetOne returns one XRPAccountKey by account_id
+func (r *XRPAccountKeyRepositoryPostgres) GetOne(
+	ctx context.Context, accountType domainAccount.AccountType, accountID string,
+) (*models.XRPAccountKey, error) {
+	xrpKey, err := r.queries.GetXRPAccountKeyByAccountID(ctx, sqlcpg.GetXRPAccountKeyByAccountIDParams{
+		Coin:      sqlcpg.XrpAccountKeyCoin(r.coinTypeCode.String()),
+		Account:   sqlcpg.XrpAccountKeyAccount(accountType.String()),
+		AccountID: accountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXRPAccountKeyByAccountID(): %w", err)
+	}
+
+	return convertPostgresXRPAccountKeyToModel(&xrpKey), nil
+}
+
+// InsertBulk inserts multiple records
+func (r *XRPAccountKeyRepositoryPostgres) InsertBulk(ctx context.Context, items []*models.XRPAccountKey) error {
+	for _, item := range items {
+		_, err := r.queries.InsertXRPAccountKey(ctx, sqlcpg.InsertXRPAccountKeyParams{
+			Coin:             sqlcpg.XrpAccountKeyCoin(item.Coin),
+			Account:          sqlcpg.XrpAccountKeyAccount(item.Account),
+			AccountID:        item.AccountID,
+			KeyType:          item.KeyType,
+			MasterKey:        item.MasterKey,
+			MasterSeed:       item.MasterSeed,
+			MasterSeedHex:    item.MasterSeedHex,
+			PublicKey:        item.PublicKey,
+			PublicKeyHex:     item.PublicKeyHex,
+			IsRegularKeyPair: item.IsRegularKeyPair,
+			AllocatedID:      item.AllocatedID,
+			AddrStatus:       item.AddrStatus,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to call InsertXRPAccountKey(): %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateAddrStatus updates addr_status
+func (r *XRPAccountKeyRepositoryPostgres) UpdateAddrStatus(
+	ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus, accountIDs []string,
+) (int64, error) {
+	var totalAffected int64
+
+	// Update one at a time since IN clause not supported for multiple updates
+	for _, accountID := range accountIDs {
+		result, err := r.queries.UpdateXRPAccountKeyAddrStatus(ctx, sqlcpg.UpdateXRPAccountKeyAddrStatusParams{
+			AddrStatus: addrStatus.Int8(),
+			UpdatedAt:  sql.NullTime{Time: time.Now(), Valid: true},
+			Coin:       sqlcpg.XrpAccountKeyCoin(r.coinTypeCode.String()),
+			Account:    sqlcpg.XrpAccountKeyAccount(accountType.String()),
+			AccountID:  accountID,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to call UpdateXRPAccountKeyAddrStatus(): %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+		}
+		totalAffected += affected
+	}
+
+	return totalAffected, nil
+}
+
+// UpdatePendingRegularKey stores regular key waiting for SetRegularKey transaction to be validated
+func (r *XRPAccountKeyRepositoryPostgres) UpdatePendingRegularKey(
+	ctx context.Context, accountID, regularKeyAccountID, regularKeySeed string,
+) (int64, error) {
+	result, err := r.queries.UpdateXRPAccountKeyPendingRegularKey(ctx, sqlcpg.UpdateXRPAccountKeyPendingRegularKeyParams{
+		PendingRegularKeyAccountID: regularKeyAccountID,
+		PendingRegularKeySeed:      regularKeySeed,
+		UpdatedAt:                  sql.NullTime{Time: time.Now(), Valid: true},
+		Coin:                       sqlcpg.XrpAccountKeyCoin(r.coinTypeCode.String()),
+		AccountID:                  accountID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXRPAccountKeyPendingRegularKey(): %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return affected, nil
+}
+
+// ActivateRegularKey promotes pending regular key to active signing key
+//   - pendingAccountID must match stored pending regular key, otherwise nothing is updated
+func (r *XRPAccountKeyRepositoryPostgres) ActivateRegularKey(
+	ctx context.Context, accountID, pendingAccountID string,
+) (int64, error) {
+	result, err := r.queries.ActivateXRPAccountKeyRegularKey(ctx, sqlcpg.ActivateXRPAccountKeyRegularKeyParams{
+		ActiveKey:                  domainKey.XRPActiveKeyRegular.Int8(),
+		UpdatedAt:                  sql.NullTime{Time: time.Now(), Valid: true},
+		Coin:                       sqlcpg.XrpAccountKeyCoin(r.coinTypeCode.String()),
+		AccountID:                  accountID,
+		PendingRegularKeyAccountID: pendingAccountID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call ActivateXRPAccountKeyRegularKey(): %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return affected, nil
+}
+
+// Helper functions
+
+func convertPostgresXRPAccountKeyToModel(xrpKey *sqlcpg.XrpAccountKey) *models.XRPAccountKey {
+	return &models.XRPAccountKey{
+		ID:                         xrpKey.ID,
+		Coin:                       string(xrpKey.Coin),
+		Account:                    string(xrpKey.Account),
+		AccountID:                  xrpKey.AccountID,
+		KeyType:                    xrpKey.KeyType,
+		MasterKey:                  xrpKey.MasterKey,
+		MasterSeed:                 xrpKey.MasterSeed,
+		MasterSeedHex:              xrpKey.MasterSeedHex,
+		PublicKey:                  xrpKey.PublicKey,
+		PublicKeyHex:               xrpKey.PublicKeyHex,
+		IsRegularKeyPair:           xrpKey.IsRegularKeyPair,
+		AllocatedID:                xrpKey.AllocatedID,
+		AddrStatus:                 xrpKey.AddrStatus,
+		ActiveKey:                  xrpKey.ActiveKey,
+		RegularKeyAccountID:        xrpKey.RegularKeyAccountID,
+		RegularKeySeed:             xrpKey.RegularKeySeed,
+		PendingRegularKeyAccountID: xrpKey.PendingRegularKeyAccountID,
+		PendingRegularKeySeed:      xrpKey.PendingRegularKeySeed,
+		UpdatedAt:                  convertSQLNullTimeToNullTime(xrpKey.UpdatedAt),
+	}
+}
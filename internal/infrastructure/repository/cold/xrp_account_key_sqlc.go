@@ -8,6 +8,7 @@ import (
 
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
 	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
 	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlc"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
@@ -24,17 +25,15 @@ func NewXRPAccountKeyRepositorySqlc(
 	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
 ) *XRPAccountKeyRepositorySqlc {
 	return &XRPAccountKeyRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
 // GetAllAddrStatus returns all XRPAccountKey by addr_status
 func (r *XRPAccountKeyRepositorySqlc) GetAllAddrStatus(
-	accountType domainAccount.AccountType, addrStatus address.AddrStatus,
+	ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus,
 ) ([]*models.XRPAccountKey, error) {
-	ctx := context.Background()
-
 	xrpKeys, err := r.queries.GetXRPAccountKeysByAddrStatus(ctx, sqlc.GetXRPAccountKeysByAddrStatusParams{
 		Coin:       sqlc.XrpAccountKeyCoin(r.coinTypeCode.String()),
 		Account:    sqlc.XrpAccountKeyAccount(accountType.String()),
@@ -52,26 +51,24 @@ func (r *XRPAccountKeyRepositorySqlc) GetAllAddrStatus(
 	return result, nil
 }
 
-// GetSecret returns secret (master_seed)
-func (r *XRPAccountKeyRepositorySqlc) GetSecret(accountType domainAccount.AccountType, addr string) (string, error) {
-	ctx := context.Background()
-
-	secret, err := r.queries.GetXRPAccountKeySecret(ctx, sqlc.GetXRPAccountKeySecretParams{
+// GetOne returns one XRPAccountKey by account_id
+func (r *XRPAccountKeyRepositorySqlc) GetOne(
+	ctx context.Context, accountType domainAccount.AccountType, accountID string,
+) (*models.XRPAccountKey, error) {
+	xrpKey, err := r.queries.GetXRPAccountKeyByAccountID(ctx, sqlc.GetXRPAccountKeyByAccountIDParams{
 		Coin:      sqlc.XrpAccountKeyCoin(r.coinTypeCode.String()),
 		Account:   sqlc.XrpAccountKeyAccount(accountType.String()),
-		AccountID: addr,
+		AccountID: accountID,
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to call GetXRPAccountKeySecret(): %w", err)
+		return nil, fmt.Errorf("failed to call GetXRPAccountKeyByAccountID(): %w", err)
 	}
 
-	return secret, nil
+	return convertSqlcXRPAccountKeyToModel(&xrpKey), nil
 }
 
 // InsertBulk inserts multiple records
-func (r *XRPAccountKeyRepositorySqlc) InsertBulk(items []*models.XRPAccountKey) error {
-	ctx := context.Background()
-
+func (r *XRPAccountKeyRepositorySqlc) InsertBulk(ctx context.Context, items []*models.XRPAccountKey) error {
 	for _, item := range items {
 		_, err := r.queries.InsertXRPAccountKey(ctx, sqlc.InsertXRPAccountKeyParams{
 			Coin:             sqlc.XrpAccountKeyCoin(item.Coin),
@@ -97,9 +94,8 @@ func (r *XRPAccountKeyRepositorySqlc) InsertBulk(items []*models.XRPAccountKey)
 
 // UpdateAddrStatus updates addr_status
 func (r *XRPAccountKeyRepositorySqlc) UpdateAddrStatus(
-	accountType domainAccount.AccountType, addrStatus address.AddrStatus, accountIDs []string,
+	ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus, accountIDs []string,
 ) (int64, error) {
-	ctx := context.Background()
 	var totalAffected int64
 
 	// Update one at a time since IN clause not supported for multiple updates
@@ -125,23 +121,75 @@ func (r *XRPAccountKeyRepositorySqlc) UpdateAddrStatus(
 	return totalAffected, nil
 }
 
+// UpdatePendingRegularKey stores regular key waiting for SetRegularKey transaction to be validated
+func (r *XRPAccountKeyRepositorySqlc) UpdatePendingRegularKey(
+	ctx context.Context, accountID, regularKeyAccountID, regularKeySeed string,
+) (int64, error) {
+	result, err := r.queries.UpdateXRPAccountKeyPendingRegularKey(ctx, sqlc.UpdateXRPAccountKeyPendingRegularKeyParams{
+		PendingRegularKeyAccountID: regularKeyAccountID,
+		PendingRegularKeySeed:      regularKeySeed,
+		UpdatedAt:                  sql.NullTime{Time: time.Now(), Valid: true},
+		Coin:                       sqlc.XrpAccountKeyCoin(r.coinTypeCode.String()),
+		AccountID:                  accountID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXRPAccountKeyPendingRegularKey(): %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return affected, nil
+}
+
+// ActivateRegularKey promotes pending regular key to active signing key
+//   - pendingAccountID must match stored pending regular key, otherwise nothing is updated
+func (r *XRPAccountKeyRepositorySqlc) ActivateRegularKey(
+	ctx context.Context, accountID, pendingAccountID string,
+) (int64, error) {
+	result, err := r.queries.ActivateXRPAccountKeyRegularKey(ctx, sqlc.ActivateXRPAccountKeyRegularKeyParams{
+		ActiveKey:                  domainKey.XRPActiveKeyRegular.Int8(),
+		UpdatedAt:                  sql.NullTime{Time: time.Now(), Valid: true},
+		Coin:                       sqlc.XrpAccountKeyCoin(r.coinTypeCode.String()),
+		AccountID:                  accountID,
+		PendingRegularKeyAccountID: pendingAccountID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call ActivateXRPAccountKeyRegularKey(): %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return affected, nil
+}
+
 // Helper functions
 
 func convertSqlcXRPAccountKeyToModel(xrpKey *sqlc.XrpAccountKey) *models.XRPAccountKey {
 	return &models.XRPAccountKey{
-		ID:               xrpKey.ID,
-		Coin:             string(xrpKey.Coin),
-		Account:          string(xrpKey.Account),
-		AccountID:        xrpKey.AccountID,
-		KeyType:          xrpKey.KeyType,
-		MasterKey:        xrpKey.MasterKey,
-		MasterSeed:       xrpKey.MasterSeed,
-		MasterSeedHex:    xrpKey.MasterSeedHex,
-		PublicKey:        xrpKey.PublicKey,
-		PublicKeyHex:     xrpKey.PublicKeyHex,
-		IsRegularKeyPair: xrpKey.IsRegularKeyPair,
-		AllocatedID:      xrpKey.AllocatedID,
-		AddrStatus:       xrpKey.AddrStatus,
-		UpdatedAt:        convertSQLNullTimeToNullTime(xrpKey.UpdatedAt),
+		ID:                         xrpKey.ID,
+		Coin:                       string(xrpKey.Coin),
+		Account:                    string(xrpKey.Account),
+		AccountID:                  xrpKey.AccountID,
+		KeyType:                    xrpKey.KeyType,
+		MasterKey:                  xrpKey.MasterKey,
+		MasterSeed:                 xrpKey.MasterSeed,
+		MasterSeedHex:              xrpKey.MasterSeedHex,
+		PublicKey:                  xrpKey.PublicKey,
+		PublicKeyHex:               xrpKey.PublicKeyHex,
+		IsRegularKeyPair:           xrpKey.IsRegularKeyPair,
+		AllocatedID:                xrpKey.AllocatedID,
+		AddrStatus:                 xrpKey.AddrStatus,
+		ActiveKey:                  xrpKey.ActiveKey,
+		RegularKeyAccountID:        xrpKey.RegularKeyAccountID,
+		RegularKeySeed:             xrpKey.RegularKeySeed,
+		PendingRegularKeyAccountID: xrpKey.PendingRegularKeyAccountID,
+		PendingRegularKeySeed:      xrpKey.PendingRegularKeySeed,
+		UpdatedAt:                  convertSQLNullTimeToNullTime(xrpKey.UpdatedAt),
 	}
 }
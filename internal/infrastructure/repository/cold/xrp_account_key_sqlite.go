@@ -0,0 +1,195 @@
+package cold
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlclite"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+)
+
+// XRPAccountKeyRepositorySQLite is repository for xrp_account_key table using sqlc for SQLite
+type XRPAccountKeyRepositorySQLite struct {
+	queries      *sqlclite.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewXRPAccountKeyRepositorySQLite returns XRPAccountKeyRepositorySQLite object
+func NewXRPAccountKeyRepositorySQLite(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *XRPAccountKeyRepositorySQLite {
+	return &XRPAccountKeyRepositorySQLite{
+		queries:      sqlclite.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetAllAddrStatus returns all XRPAccountKey by addr_status
+func (r *XRPAccountKeyRepositorySQLite) GetAllAddrStatus(
+	ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus,
+) ([]*models.XRPAccountKey, error) {
+	xrpKeys, err := r.queries.GetXRPAccountKeysByAddrStatus(ctx, sqlclite.GetXRPAccountKeysByAddrStatusParams{
+		Coin:       r.coinTypeCode.String(),
+		Account:    accountType.String(),
+		AddrStatus: addrStatus.Int8(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXRPAccountKeysByAddrStatus(): %w", err)
+	}
+
+	result := make([]*models.XRPAccountKey, len(xrpKeys))
+	for i, xrpKey := range xrpKeys {
+		result[i] = convertSQLiteXRPAccountKeyToModel(&xrpKey)
+	}
+
+	return result, nil
+}
+
+// GetOne returns one XRPAccountKey by account_id
+func (r *XRPAccountKeyRepositorySQLite) GetOne(
+	ctx context.Context, accountType domainAccount.AccountType, accountID string,
+) (*models.XRPAccountKey, error) {
+	xrpKey, err := r.queries.GetXRPAccountKeyByAccountID(ctx, sqlclite.GetXRPAccountKeyByAccountIDParams{
+		Coin:      r.coinTypeCode.String(),
+		Account:   accountType.String(),
+		AccountID: accountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXRPAccountKeyByAccountID(): %w", err)
+	}
+
+	return convertSQLiteXRPAccountKeyToModel(&xrpKey), nil
+}
+
+// InsertBulk inserts multiple records
+func (r *XRPAccountKeyRepositorySQLite) InsertBulk(ctx context.Context, items []*models.XRPAccountKey) error {
+	for _, item := range items {
+		_, err := r.queries.InsertXRPAccountKey(ctx, sqlclite.InsertXRPAccountKeyParams{
+			Coin:             item.Coin,
+			Account:          item.Account,
+			AccountID:        item.AccountID,
+			KeyType:          item.KeyType,
+			MasterKey:        item.MasterKey,
+			MasterSeed:       item.MasterSeed,
+			MasterSeedHex:    item.MasterSeedHex,
+			PublicKey:        item.PublicKey,
+			PublicKeyHex:     item.PublicKeyHex,
+			IsRegularKeyPair: item.IsRegularKeyPair,
+			AllocatedID:      item.AllocatedID,
+			AddrStatus:       item.AddrStatus,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to call InsertXRPAccountKey(): %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateAddrStatus updates addr_status
+func (r *XRPAccountKeyRepositorySQLite) UpdateAddrStatus(
+	ctx context.Context, accountType domainAccount.AccountType, addrStatus address.AddrStatus, accountIDs []string,
+) (int64, error) {
+	var totalAffected int64
+
+	// Update one at a time since IN clause not supported for multiple updates
+	for _, accountID := range accountIDs {
+		result, err := r.queries.UpdateXRPAccountKeyAddrStatus(ctx, sqlclite.UpdateXRPAccountKeyAddrStatusParams{
+			AddrStatus: addrStatus.Int8(),
+			UpdatedAt:  sql.NullTime{Time: time.Now(), Valid: true},
+			Coin:       r.coinTypeCode.String(),
+			Account:    accountType.String(),
+			AccountID:  accountID,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to call UpdateXRPAccountKeyAddrStatus(): %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+		}
+		totalAffected += affected
+	}
+
+	return totalAffected, nil
+}
+
+// UpdatePendingRegularKey stores regular key waiting for SetRegularKey transaction to be validated
+func (r *XRPAccountKeyRepositorySQLite) UpdatePendingRegularKey(
+	ctx context.Context, accountID, regularKeyAccountID, regularKeySeed string,
+) (int64, error) {
+	result, err := r.queries.UpdateXRPAccountKeyPendingRegularKey(ctx, sqlclite.UpdateXRPAccountKeyPendingRegularKeyParams{
+		PendingRegularKeyAccountID: regularKeyAccountID,
+		PendingRegularKeySeed:      regularKeySeed,
+		UpdatedAt:                  sql.NullTime{Time: time.Now(), Valid: true},
+		Coin:                       r.coinTypeCode.String(),
+		AccountID:                  accountID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXRPAccountKeyPendingRegularKey(): %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return affected, nil
+}
+
+// ActivateRegularKey promotes pending regular key to active signing key
+//   - pendingAccountID must match stored pending regular key, otherwise nothing is updated
+func (r *XRPAccountKeyRepositorySQLite) ActivateRegularKey(
+	ctx context.Context, accountID, pendingAccountID string,
+) (int64, error) {
+	result, err := r.queries.ActivateXRPAccountKeyRegularKey(ctx, sqlclite.ActivateXRPAccountKeyRegularKeyParams{
+		ActiveKey:                  domainKey.XRPActiveKeyRegular.Int8(),
+		UpdatedAt:                  sql.NullTime{Time: time.Now(), Valid: true},
+		Coin:                       r.coinTypeCode.String(),
+		AccountID:                  accountID,
+		PendingRegularKeyAccountID: pendingAccountID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call ActivateXRPAccountKeyRegularKey(): %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return affected, nil
+}
+
+// Helper functions
+
+func convertSQLiteXRPAccountKeyToModel(xrpKey *sqlclite.XrpAccountKey) *models.XRPAccountKey {
+	return &models.XRPAccountKey{
+		ID:                         xrpKey.ID,
+		Coin:                       xrpKey.Coin,
+		Account:                    xrpKey.Account,
+		AccountID:                  xrpKey.AccountID,
+		KeyType:                    xrpKey.KeyType,
+		MasterKey:                  xrpKey.MasterKey,
+		MasterSeed:                 xrpKey.MasterSeed,
+		MasterSeedHex:              xrpKey.MasterSeedHex,
+		PublicKey:                  xrpKey.PublicKey,
+		PublicKeyHex:               xrpKey.PublicKeyHex,
+		IsRegularKeyPair:           xrpKey.IsRegularKeyPair,
+		AllocatedID:                xrpKey.AllocatedID,
+		AddrStatus:                 xrpKey.AddrStatus,
+		ActiveKey:                  xrpKey.ActiveKey,
+		RegularKeyAccountID:        xrpKey.RegularKeyAccountID,
+		RegularKeySeed:             xrpKey.RegularKeySeed,
+		PendingRegularKeyAccountID: xrpKey.PendingRegularKeyAccountID,
+		PendingRegularKeySeed:      xrpKey.PendingRegularKeySeed,
+		UpdatedAt:                  convertSQLNullTimeToNullTime(xrpKey.UpdatedAt),
+	}
+}
@@ -0,0 +1,148 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// AddressRepositoryPostgres is repository for address table using sqlc for PostgreSQL
+type AddressRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewAddressRepositoryPostgres returns AddressRepositoryPostgres object
+func NewAddressRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *AddressRepositoryPostgres {
+	return &AddressRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetAll returns all records by account
+func (r *AddressRepositoryPostgres) GetAll(
+	ctx context.Context, accountType domainAccount.AccountType,
+) ([]*models.Address, error) {
+	addresses, err := r.queries.GetAllAddresses(ctx, sqlcpg.GetAllAddressesParams{
+		Coin:    sqlcpg.AddressCoin(r.coinTypeCode.String()),
+		Account: sqlcpg.AddressAccount(accountType.String()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetAllAddresses(): %w", err)
+	}
+
+	// Convert sqlc types to sqlboiler types for backward compatibility
+	result := make([]*models.Address, len(addresses))
+	for i, addr := range addresses {
+		result[i] = convertPostgresAddressToModel(&addr)
+	}
+
+	return result, nil
+}
+
+// GetAllAddress returns all addresses by account
+func (r *AddressRepositoryPostgres) GetAllAddress(
+	ctx context.Context, accountType domainAccount.AccountType,
+) ([]string, error) {
+	addresses, err := r.queries.GetAllAddressStrings(ctx, sqlcpg.GetAllAddressStringsParams{
+		Coin:    sqlcpg.AddressCoin(r.coinTypeCode.String()),
+		Account: sqlcpg.AddressAccount(accountType.String()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetAllAddressStrings(): %w", err)
+	}
+
+	return addresses, nil
+}
+
+// GetOneUnAllocated returns one records by is_allocated=false
+func (r *AddressRepositoryPostgres) GetOneUnAllocated(
+	ctx context.Context, accountType domainAccount.AccountType,
+) (*models.Address, error) {
+	addr, err := r.queries.GetOneUnallocatedAddress(ctx, sqlcpg.GetOneUnallocatedAddressParams{
+		Coin:    sqlcpg.AddressCoin(r.coinTypeCode.String()),
+		Account: sqlcpg.AddressAccount(accountType.String()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetOneUnallocatedAddress(): %w", err)
+	}
+
+	return convertPostgresAddressToModel(&addr), nil
+}
+
+// CountUnAllocated returns number of records by is_allocated=false
+func (r *AddressRepositoryPostgres) CountUnAllocated(
+	ctx context.Context, accountType domainAccount.AccountType,
+) (int64, error) {
+	count, err := r.queries.CountUnallocatedAddresses(ctx, sqlcpg.CountUnallocatedAddressesParams{
+		Coin:    sqlcpg.AddressCoin(r.coinTypeCode.String()),
+		Account: sqlcpg.AddressAccount(accountType.String()),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call CountUnallocatedAddresses(): %w", err)
+	}
+
+	return count, nil
+}
+
+// InsertBulk inserts multiple records
+func (r *AddressRepositoryPostgres) InsertBulk(ctx context.Context, items []*models.Address) error {
+	for _, item := range items {
+		_, err := r.queries.InsertAddress(ctx, sqlcpg.InsertAddressParams{
+			Coin:          sqlcpg.AddressCoin(item.Coin),
+			Account:       sqlcpg.AddressAccount(item.Account),
+			WalletAddress: item.WalletAddress,
+			IsAllocated:   item.IsAllocated,
+			UpdatedAt:     convertNullTimeToSQLNullTime(item.UpdatedAt),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to call InsertAddress(): %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateIsAllocated updates is_allocated
+func (r *AddressRepositoryPostgres) UpdateIsAllocated(
+	ctx context.Context, isAllocated bool, address string,
+) (int64, error) {
+	result, err := r.queries.UpdateAddressIsAllocated(ctx, sqlcpg.UpdateAddressIsAllocatedParams{
+		IsAllocated:   isAllocated,
+		UpdatedAt:     sql.NullTime{Time: time.Now(), Valid: true},
+		Coin:          sqlcpg.AddressCoin(r.coinTypeCode.String()),
+		WalletAddress: address,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateAddressIsAllocated(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions for type conversion
+
+func convertPostgresAddressToModel(addr *sqlcpg.Address) *models.Address {
+	return &models.Address{
+		ID:            addr.ID,
+		Coin:          string(addr.Coin),
+		Account:       string(addr.Account),
+		WalletAddress: addr.WalletAddress,
+		IsAllocated:   addr.IsAllocated,
+		UpdatedAt:     convertSQLNullTimeToNullTime(addr.UpdatedAt),
+	}
+}
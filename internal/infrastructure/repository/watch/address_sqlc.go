@@ -25,15 +25,15 @@ func NewAddressRepositorySqlc(
 	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
 ) *AddressRepositorySqlc {
 	return &AddressRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
 // GetAll returns all records by account
-func (r *AddressRepositorySqlc) GetAll(accountType domainAccount.AccountType) ([]*models.Address, error) {
-	ctx := context.Background()
-
+func (r *AddressRepositorySqlc) GetAll(
+	ctx context.Context, accountType domainAccount.AccountType,
+) ([]*models.Address, error) {
 	addresses, err := r.queries.GetAllAddresses(ctx, sqlc.GetAllAddressesParams{
 		Coin:    sqlc.AddressCoin(r.coinTypeCode.String()),
 		Account: sqlc.AddressAccount(accountType.String()),
@@ -52,9 +52,9 @@ func (r *AddressRepositorySqlc) GetAll(accountType domainAccount.AccountType) ([
 }
 
 // GetAllAddress returns all addresses by account
-func (r *AddressRepositorySqlc) GetAllAddress(accountType domainAccount.AccountType) ([]string, error) {
-	ctx := context.Background()
-
+func (r *AddressRepositorySqlc) GetAllAddress(
+	ctx context.Context, accountType domainAccount.AccountType,
+) ([]string, error) {
 	addresses, err := r.queries.GetAllAddressStrings(ctx, sqlc.GetAllAddressStringsParams{
 		Coin:    sqlc.AddressCoin(r.coinTypeCode.String()),
 		Account: sqlc.AddressAccount(accountType.String()),
@@ -67,9 +67,9 @@ func (r *AddressRepositorySqlc) GetAllAddress(accountType domainAccount.AccountT
 }
 
 // GetOneUnAllocated returns one records by is_allocated=false
-func (r *AddressRepositorySqlc) GetOneUnAllocated(accountType domainAccount.AccountType) (*models.Address, error) {
-	ctx := context.Background()
-
+func (r *AddressRepositorySqlc) GetOneUnAllocated(
+	ctx context.Context, accountType domainAccount.AccountType,
+) (*models.Address, error) {
 	addr, err := r.queries.GetOneUnallocatedAddress(ctx, sqlc.GetOneUnallocatedAddressParams{
 		Coin:    sqlc.AddressCoin(r.coinTypeCode.String()),
 		Account: sqlc.AddressAccount(accountType.String()),
@@ -81,10 +81,23 @@ func (r *AddressRepositorySqlc) GetOneUnAllocated(accountType domainAccount.Acco
 	return convertSqlcAddressToModel(&addr), nil
 }
 
-// InsertBulk inserts multiple records
-func (r *AddressRepositorySqlc) InsertBulk(items []*models.Address) error {
-	ctx := context.Background()
+// CountUnAllocated returns number of records by is_allocated=false
+func (r *AddressRepositorySqlc) CountUnAllocated(
+	ctx context.Context, accountType domainAccount.AccountType,
+) (int64, error) {
+	count, err := r.queries.CountUnallocatedAddresses(ctx, sqlc.CountUnallocatedAddressesParams{
+		Coin:    sqlc.AddressCoin(r.coinTypeCode.String()),
+		Account: sqlc.AddressAccount(accountType.String()),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call CountUnallocatedAddresses(): %w", err)
+	}
+
+	return count, nil
+}
 
+// InsertBulk inserts multiple records
+func (r *AddressRepositorySqlc) InsertBulk(ctx context.Context, items []*models.Address) error {
 	for _, item := range items {
 		_, err := r.queries.InsertAddress(ctx, sqlc.InsertAddressParams{
 			Coin:          sqlc.AddressCoin(item.Coin),
@@ -102,9 +115,9 @@ func (r *AddressRepositorySqlc) InsertBulk(items []*models.Address) error {
 }
 
 // UpdateIsAllocated updates is_allocated
-func (r *AddressRepositorySqlc) UpdateIsAllocated(isAllocated bool, address string) (int64, error) {
-	ctx := context.Background()
-
+func (r *AddressRepositorySqlc) UpdateIsAllocated(
+	ctx context.Context, isAllocated bool, address string,
+) (int64, error) {
 	result, err := r.queries.UpdateAddressIsAllocated(ctx, sqlc.UpdateAddressIsAllocatedParams{
 		IsAllocated:   isAllocated,
 		UpdatedAt:     sql.NullTime{Time: time.Now(), Valid: true},
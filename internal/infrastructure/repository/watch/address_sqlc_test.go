@@ -4,9 +4,11 @@
 package watchrepo_test
 
 import (
+	"context"
 	"testing"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -17,6 +19,8 @@ import (
 
 // TestAddressSqlc is integration test for AddressRepositorySqlc
 func TestAddressSqlc(t *testing.T) {
+	ctx := context.Background()
+
 	// Get db connection for cleanup
 	db := testutil.GetDB()
 	// Clean up any existing test data
@@ -47,32 +51,32 @@ func TestAddressSqlc(t *testing.T) {
 		},
 	}
 
-	err := addressRepo.InsertBulk(addresses)
+	err := addressRepo.InsertBulk(ctx, addresses)
 	require.NoError(t, err, "fail to call InsertBulk()")
 
 	// Get all addresses
-	allAddrs, err := addressRepo.GetAll(accountType)
+	allAddrs, err := addressRepo.GetAll(ctx, accountType)
 	require.NoError(t, err, "fail to call GetAll()")
 	require.GreaterOrEqual(t, len(allAddrs), 3, "GetAll() should return at least 3 addresses")
 
 	// Get all address strings
-	addrStrings, err := addressRepo.GetAllAddress(accountType)
+	addrStrings, err := addressRepo.GetAllAddress(ctx, accountType)
 	require.NoError(t, err, "fail to call GetAllAddress()")
 	require.GreaterOrEqual(t, len(addrStrings), 3, "GetAllAddress() should return at least 3 addresses")
 
 	// Get one unallocated address
-	unallocAddr, err := addressRepo.GetOneUnAllocated(accountType)
+	unallocAddr, err := addressRepo.GetOneUnAllocated(ctx, accountType)
 	require.NoError(t, err, "fail to call GetOneUnAllocated()")
 	require.NotNil(t, unallocAddr, "GetOneUnAllocated() returned nil")
 	require.False(t, unallocAddr.IsAllocated, "GetOneUnAllocated() returned allocated address")
 
 	// Update is_allocated
-	rowsAffected, err := addressRepo.UpdateIsAllocated(true, unallocAddr.WalletAddress)
+	rowsAffected, err := addressRepo.UpdateIsAllocated(ctx, true, unallocAddr.WalletAddress)
 	require.NoError(t, err, "fail to call UpdateIsAllocated()")
 	require.Equal(t, int64(1), rowsAffected, "UpdateIsAllocated() should affect 1 row")
 
 	// Verify the address is now allocated
-	verifyAddr, err := addressRepo.GetOneUnAllocated(accountType)
+	verifyAddr, err := addressRepo.GetOneUnAllocated(ctx, accountType)
 	require.NoError(t, err, "fail to call GetOneUnAllocated() after update")
 	if verifyAddr != nil {
 		assert.NotEqual(t, unallocAddr.WalletAddress, verifyAddr.WalletAddress,
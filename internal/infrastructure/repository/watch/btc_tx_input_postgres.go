@@ -0,0 +1,101 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// TxInputRepositoryPostgres is repository for btc_tx_input table using sqlc for PostgreSQL
+type TxInputRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewBTCTxInputRepositoryPostgres returns TxInputRepositoryPostgres object
+func NewBTCTxInputRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *TxInputRepositoryPostgres {
+	return &TxInputRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetOne get one record by ID
+func (r *TxInputRepositoryPostgres) GetOne(ctx context.Context, id int64) (*models.BTCTXInput, error) {
+	input, err := r.queries.GetBtcTxInputByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetBtcTxInputByID(): %w", err)
+	}
+
+	return convertPostgresBtcTxInputToModel(&input), nil
+}
+
+// GetAllByTxID returns all records searched by tx_id
+func (r *TxInputRepositoryPostgres) GetAllByTxID(ctx context.Context, id int64) ([]*models.BTCTXInput, error) {
+	inputs, err := r.queries.GetBtcTxInputsByTxID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetBtcTxInputsByTxID(): %w", err)
+	}
+
+	result := make([]*models.BTCTXInput, len(inputs))
+	for i, input := range inputs {
+		result[i] = convertPostgresBtcTxInputToModel(&input)
+	}
+
+	return result, nil
+}
+
+// Insert inserts one record
+func (r *TxInputRepositoryPostgres) Insert(ctx context.Context, txItem *models.BTCTXInput) error {
+	_, err := r.queries.InsertBtcTxInput(ctx, sqlcpg.InsertBtcTxInputParams{
+		TxID:               txItem.TXID,
+		InputTxid:          txItem.InputTxid,
+		InputVout:          txItem.InputVout,
+		InputAddress:       txItem.InputAddress,
+		InputAccount:       txItem.InputAccount,
+		InputAmount:        txItem.InputAmount.String(),
+		InputConfirmations: txItem.InputConfirmations,
+		UpdatedAt:          convertNullTimeToSQLNullTime(txItem.UpdatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertBtcTxInput(): %w", err)
+	}
+
+	return nil
+}
+
+// InsertBulk inserts multiple records
+func (r *TxInputRepositoryPostgres) InsertBulk(ctx context.Context, txItems []*models.BTCTXInput) error {
+	for _, item := range txItems {
+		if err := r.Insert(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Helper functions
+
+func convertPostgresBtcTxInputToModel(input *sqlcpg.BtcTxInput) *models.BTCTXInput {
+	amount, _ := udecimal.Parse(input.InputAmount)
+
+	return &models.BTCTXInput{
+		ID:                 input.ID,
+		TXID:               input.TxID,
+		InputTxid:          input.InputTxid,
+		InputVout:          input.InputVout,
+		InputAddress:       input.InputAddress,
+		InputAccount:       input.InputAccount,
+		InputAmount:        amount,
+		InputConfirmations: input.InputConfirmations,
+		UpdatedAt:          convertSQLNullTimeToNullTime(input.UpdatedAt),
+	}
+}
@@ -23,15 +23,13 @@ func NewBTCTxInputRepositorySqlc(
 	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
 ) *TxInputRepositorySqlc {
 	return &TxInputRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
 // GetOne get one record by ID
-func (r *TxInputRepositorySqlc) GetOne(id int64) (*models.BTCTXInput, error) {
-	ctx := context.Background()
-
+func (r *TxInputRepositorySqlc) GetOne(ctx context.Context, id int64) (*models.BTCTXInput, error) {
 	input, err := r.queries.GetBtcTxInputByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetBtcTxInputByID(): %w", err)
@@ -41,9 +39,7 @@ func (r *TxInputRepositorySqlc) GetOne(id int64) (*models.BTCTXInput, error) {
 }
 
 // GetAllByTxID returns all records searched by tx_id
-func (r *TxInputRepositorySqlc) GetAllByTxID(id int64) ([]*models.BTCTXInput, error) {
-	ctx := context.Background()
-
+func (r *TxInputRepositorySqlc) GetAllByTxID(ctx context.Context, id int64) ([]*models.BTCTXInput, error) {
 	inputs, err := r.queries.GetBtcTxInputsByTxID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetBtcTxInputsByTxID(): %w", err)
@@ -58,9 +54,7 @@ func (r *TxInputRepositorySqlc) GetAllByTxID(id int64) ([]*models.BTCTXInput, er
 }
 
 // Insert inserts one record
-func (r *TxInputRepositorySqlc) Insert(txItem *models.BTCTXInput) error {
-	ctx := context.Background()
-
+func (r *TxInputRepositorySqlc) Insert(ctx context.Context, txItem *models.BTCTXInput) error {
 	_, err := r.queries.InsertBtcTxInput(ctx, sqlc.InsertBtcTxInputParams{
 		TxID:               txItem.TXID,
 		InputTxid:          txItem.InputTxid,
@@ -79,9 +73,9 @@ func (r *TxInputRepositorySqlc) Insert(txItem *models.BTCTXInput) error {
 }
 
 // InsertBulk inserts multiple records
-func (r *TxInputRepositorySqlc) InsertBulk(txItems []*models.BTCTXInput) error {
+func (r *TxInputRepositorySqlc) InsertBulk(ctx context.Context, txItems []*models.BTCTXInput) error {
 	for _, item := range txItems {
-		if err := r.Insert(item); err != nil {
+		if err := r.Insert(ctx, item); err != nil {
 			return err
 		}
 	}
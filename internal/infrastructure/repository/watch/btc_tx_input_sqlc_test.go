@@ -4,9 +4,11 @@
 package watchrepo_test
 
 import (
+	"context"
 	"testing"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	"github.com/quagmt/udecimal"
 	"github.com/stretchr/testify/require"
 
@@ -17,6 +19,8 @@ import (
 
 // TestBTCTxInputSqlc is integration test for TxInputRepositorySqlc
 func TestBTCTxInputSqlc(t *testing.T) {
+	ctx := context.Background()
+
 	// Need to create a btc_tx first
 	btcTxRepo := testutil.NewBTCTxRepositorySqlc()
 	btcTxInputRepo := testutil.NewBTCTxInputRepositorySqlc()
@@ -33,7 +37,7 @@ func TestBTCTxInputSqlc(t *testing.T) {
 		TotalOutputAmount: outputAmt,
 		Fee:               feeAmt,
 	}
-	txID, err := btcTxRepo.InsertUnsignedTx(domainTx.ActionTypePayment, txItem)
+	txID, err := btcTxRepo.InsertUnsignedTx(ctx, domainTx.ActionTypePayment, txItem)
 	require.NoError(t, err, "fail to create parent tx")
 
 	// Create test inputs
@@ -64,16 +68,16 @@ func TestBTCTxInputSqlc(t *testing.T) {
 	}
 
 	// Insert bulk
-	err = btcTxInputRepo.InsertBulk(inputs)
+	err = btcTxInputRepo.InsertBulk(ctx, inputs)
 	require.NoError(t, err, "fail to call InsertBulk()")
 
 	// Get all by tx ID
-	retrievedInputs, err := btcTxInputRepo.GetAllByTxID(txID)
+	retrievedInputs, err := btcTxInputRepo.GetAllByTxID(ctx, txID)
 	require.NoError(t, err, "fail to call GetAllByTxID()")
 	require.Equal(t, 2, len(retrievedInputs), "GetAllByTxID() should return 2 inputs")
 
 	// Get one
-	oneInput, err := btcTxInputRepo.GetOne(retrievedInputs[0].ID)
+	oneInput, err := btcTxInputRepo.GetOne(ctx, retrievedInputs[0].ID)
 	require.NoError(t, err, "fail to call GetOne()")
 	require.Equal(t, "input-txid-sqlc-1", oneInput.InputTxid, "GetOne() InputTxid mismatch")
 
@@ -89,11 +93,11 @@ func TestBTCTxInputSqlc(t *testing.T) {
 		InputAmount:        amount3,
 		InputConfirmations: 6,
 	}
-	err = btcTxInputRepo.Insert(singleInput)
+	err = btcTxInputRepo.Insert(ctx, singleInput)
 	require.NoError(t, err, "fail to call Insert()")
 
 	// Verify count increased
-	allInputs, err := btcTxInputRepo.GetAllByTxID(txID)
+	allInputs, err := btcTxInputRepo.GetAllByTxID(ctx, txID)
 	require.NoError(t, err, "fail to call GetAllByTxID() after Insert()")
 	require.Equal(t, 3, len(allInputs), "GetAllByTxID() should return 3 inputs")
 }
@@ -0,0 +1,97 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// TxOutputRepositoryPostgres is repository for btc_tx_output table using sqlc for PostgreSQL
+type TxOutputRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewBTCTxOutputRepositoryPostgres returns TxOutputRepositoryPostgres object
+func NewBTCTxOutputRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *TxOutputRepositoryPostgres {
+	return &TxOutputRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetOne get one record by ID
+func (r *TxOutputRepositoryPostgres) GetOne(ctx context.Context, id int64) (*models.BTCTXOutput, error) {
+	output, err := r.queries.GetBtcTxOutputByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetBtcTxOutputByID(): %w", err)
+	}
+
+	return convertPostgresBtcTxOutputToModel(&output), nil
+}
+
+// GetAllByTxID returns all records searched by tx_id
+func (r *TxOutputRepositoryPostgres) GetAllByTxID(ctx context.Context, id int64) ([]*models.BTCTXOutput, error) {
+	outputs, err := r.queries.GetBtcTxOutputsByTxID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetBtcTxOutputsByTxID(): %w", err)
+	}
+
+	result := make([]*models.BTCTXOutput, len(outputs))
+	for i, output := range outputs {
+		result[i] = convertPostgresBtcTxOutputToModel(&output)
+	}
+
+	return result, nil
+}
+
+// Insert inserts one record
+func (r *TxOutputRepositoryPostgres) Insert(ctx context.Context, txItem *models.BTCTXOutput) error {
+	_, err := r.queries.InsertBtcTxOutput(ctx, sqlcpg.InsertBtcTxOutputParams{
+		TxID:          txItem.TXID,
+		OutputAddress: txItem.OutputAddress,
+		OutputAccount: txItem.OutputAccount,
+		OutputAmount:  txItem.OutputAmount.String(),
+		IsChange:      txItem.IsChange,
+		UpdatedAt:     convertNullTimeToSQLNullTime(txItem.UpdatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertBtcTxOutput(): %w", err)
+	}
+
+	return nil
+}
+
+// InsertBulk inserts multiple records
+func (r *TxOutputRepositoryPostgres) InsertBulk(ctx context.Context, txItems []*models.BTCTXOutput) error {
+	for _, item := range txItems {
+		if err := r.Insert(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Helper functions
+
+func convertPostgresBtcTxOutputToModel(output *sqlcpg.BtcTxOutput) *models.BTCTXOutput {
+	amount, _ := udecimal.Parse(output.OutputAmount)
+
+	return &models.BTCTXOutput{
+		ID:            output.ID,
+		TXID:          output.TxID,
+		OutputAddress: output.OutputAddress,
+		OutputAccount: output.OutputAccount,
+		OutputAmount:  amount,
+		IsChange:      output.IsChange,
+		UpdatedAt:     convertSQLNullTimeToNullTime(output.UpdatedAt),
+	}
+}
@@ -23,15 +23,13 @@ func NewBTCTxOutputRepositorySqlc(
 	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
 ) *TxOutputRepositorySqlc {
 	return &TxOutputRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
 // GetOne get one record by ID
-func (r *TxOutputRepositorySqlc) GetOne(id int64) (*models.BTCTXOutput, error) {
-	ctx := context.Background()
-
+func (r *TxOutputRepositorySqlc) GetOne(ctx context.Context, id int64) (*models.BTCTXOutput, error) {
 	output, err := r.queries.GetBtcTxOutputByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetBtcTxOutputByID(): %w", err)
@@ -41,9 +39,7 @@ func (r *TxOutputRepositorySqlc) GetOne(id int64) (*models.BTCTXOutput, error) {
 }
 
 // GetAllByTxID returns all records searched by tx_id
-func (r *TxOutputRepositorySqlc) GetAllByTxID(id int64) ([]*models.BTCTXOutput, error) {
-	ctx := context.Background()
-
+func (r *TxOutputRepositorySqlc) GetAllByTxID(ctx context.Context, id int64) ([]*models.BTCTXOutput, error) {
 	outputs, err := r.queries.GetBtcTxOutputsByTxID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetBtcTxOutputsByTxID(): %w", err)
@@ -58,9 +54,7 @@ func (r *TxOutputRepositorySqlc) GetAllByTxID(id int64) ([]*models.BTCTXOutput,
 }
 
 // Insert inserts one record
-func (r *TxOutputRepositorySqlc) Insert(txItem *models.BTCTXOutput) error {
-	ctx := context.Background()
-
+func (r *TxOutputRepositorySqlc) Insert(ctx context.Context, txItem *models.BTCTXOutput) error {
 	_, err := r.queries.InsertBtcTxOutput(ctx, sqlc.InsertBtcTxOutputParams{
 		TxID:          txItem.TXID,
 		OutputAddress: txItem.OutputAddress,
@@ -77,9 +71,9 @@ func (r *TxOutputRepositorySqlc) Insert(txItem *models.BTCTXOutput) error {
 }
 
 // InsertBulk inserts multiple records
-func (r *TxOutputRepositorySqlc) InsertBulk(txItems []*models.BTCTXOutput) error {
+func (r *TxOutputRepositorySqlc) InsertBulk(ctx context.Context, txItems []*models.BTCTXOutput) error {
 	for _, item := range txItems {
-		if err := r.Insert(item); err != nil {
+		if err := r.Insert(ctx, item); err != nil {
 			return err
 		}
 	}
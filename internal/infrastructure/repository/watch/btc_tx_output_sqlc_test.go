@@ -4,9 +4,11 @@
 package watchrepo_test
 
 import (
+	"context"
 	"testing"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	"github.com/quagmt/udecimal"
 	"github.com/stretchr/testify/require"
 
@@ -17,6 +19,8 @@ import (
 
 // TestBTCTxOutputSqlc is integration test for TxOutputRepositorySqlc
 func TestBTCTxOutputSqlc(t *testing.T) {
+	ctx := context.Background()
+
 	// Need to create a btc_tx first
 	btcTxRepo := testutil.NewBTCTxRepositorySqlc()
 	btcTxOutputRepo := testutil.NewBTCTxOutputRepositorySqlc()
@@ -33,7 +37,7 @@ func TestBTCTxOutputSqlc(t *testing.T) {
 		TotalOutputAmount: outputAmt,
 		Fee:               feeAmt,
 	}
-	txID, err := btcTxRepo.InsertUnsignedTx(domainTx.ActionTypePayment, txItem)
+	txID, err := btcTxRepo.InsertUnsignedTx(ctx, domainTx.ActionTypePayment, txItem)
 	require.NoError(t, err, "fail to create parent tx")
 
 	// Create test outputs
@@ -60,11 +64,11 @@ func TestBTCTxOutputSqlc(t *testing.T) {
 	}
 
 	// Insert bulk
-	err = btcTxOutputRepo.InsertBulk(outputs)
+	err = btcTxOutputRepo.InsertBulk(ctx, outputs)
 	require.NoError(t, err, "fail to call InsertBulk()")
 
 	// Get all by tx ID
-	retrievedOutputs, err := btcTxOutputRepo.GetAllByTxID(txID)
+	retrievedOutputs, err := btcTxOutputRepo.GetAllByTxID(ctx, txID)
 	require.NoError(t, err, "fail to call GetAllByTxID()")
 	require.Len(t, retrievedOutputs, 2, "GetAllByTxID() should return 2 outputs")
 
@@ -82,7 +86,7 @@ func TestBTCTxOutputSqlc(t *testing.T) {
 	require.True(t, hasNonChange, "GetAllByTxID() should return at least one non-change output")
 
 	// Get one
-	oneOutput, err := btcTxOutputRepo.GetOne(retrievedOutputs[0].ID)
+	oneOutput, err := btcTxOutputRepo.GetOne(ctx, retrievedOutputs[0].ID)
 	require.NoError(t, err, "fail to call GetOne()")
 	require.Equal(t, txID, oneOutput.TXID, "GetOne() should return output with correct TXID")
 
@@ -96,11 +100,11 @@ func TestBTCTxOutputSqlc(t *testing.T) {
 		OutputAmount:  amount3,
 		IsChange:      false,
 	}
-	err = btcTxOutputRepo.Insert(singleOutput)
+	err = btcTxOutputRepo.Insert(ctx, singleOutput)
 	require.NoError(t, err, "fail to call Insert()")
 
 	// Verify count increased
-	allOutputs, err := btcTxOutputRepo.GetAllByTxID(txID)
+	allOutputs, err := btcTxOutputRepo.GetAllByTxID(ctx, txID)
 	require.NoError(t, err, "fail to call GetAllByTxID() after Insert()")
 	require.Len(t, allOutputs, 3, "GetAllByTxID() should return 3 outputs after Insert()")
 }
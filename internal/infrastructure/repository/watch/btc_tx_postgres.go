@@ -0,0 +1,331 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null/v6"
+	"github.com/quagmt/udecimal"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// BTCTxRepositoryPostgres is repository for btc_tx table using sqlc for PostgreSQL
+type BTCTxRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewBTCTxRepositoryPostgres returns BTCTxRepositoryPostgres object
+func NewBTCTxRepositoryPostgres(dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode) *BTCTxRepositoryPostgres {
+	return &BTCTxRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// WithTx returns BTCTxRepositoryPostgres which runs queries in database transaction
+func (r *BTCTxRepositoryPostgres) WithTx(dtx *sql.Tx) BTCTxRepositorier {
+	return &BTCTxRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dtx),
+		coinTypeCode: r.coinTypeCode,
+	}
+}
+
+// GetOne returns one record by ID
+func (r *BTCTxRepositoryPostgres) GetOne(ctx context.Context, id int64) (*models.BTCTX, error) {
+	btcTx, err := r.queries.GetBtcTxByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetBtcTxByID(): %w", err)
+	}
+
+	return convertPostgresBtcTxToModel(&btcTx), nil
+}
+
+// GetCountByUnsignedHex returns count by hex string
+func (r *BTCTxRepositoryPostgres) GetCountByUnsignedHex(
+	ctx context.Context, actionType domainTx.ActionType, hex string,
+) (int64, error) {
+	count, err := r.queries.GetBtcTxCountByUnsignedHex(ctx, sqlcpg.GetBtcTxCountByUnsignedHexParams{
+		Coin:          sqlcpg.BtcTxCoin(r.coinTypeCode.String()),
+		Action:        sqlcpg.BtcTxAction(actionType.String()),
+		UnsignedHexTx: hex,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call GetBtcTxCountByUnsignedHex(): %w", err)
+	}
+
+	return count, nil
+}
+
+// GetTxIDBySentHash returns txID by sentHashTx
+func (r *BTCTxRepositoryPostgres) GetTxIDBySentHash(
+	ctx context.Context, actionType domainTx.ActionType, hash string,
+) (int64, error) {
+	id, err := r.queries.GetBtcTxIDBySentHash(ctx, sqlcpg.GetBtcTxIDBySentHashParams{
+		Coin:       sqlcpg.BtcTxCoin(r.coinTypeCode.String()),
+		Action:     sqlcpg.BtcTxAction(actionType.String()),
+		SentHashTx: hash,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call GetBtcTxIDBySentHash(): %w", err)
+	}
+
+	return id, nil
+}
+
+// GetSentHashTx returns list of sent_hash_tx by txType
+func (r *BTCTxRepositoryPostgres) GetSentHashTx(
+	ctx context.Context, actionType domainTx.ActionType, txType domainTx.TxType,
+) ([]string, error) {
+	hashes, err := r.queries.GetBtcTxSentHashList(ctx, sqlcpg.GetBtcTxSentHashListParams{
+		Coin:          sqlcpg.BtcTxCoin(r.coinTypeCode.String()),
+		Action:        sqlcpg.BtcTxAction(actionType.String()),
+		CurrentTxType: txType.Int8(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetBtcTxSentHashList(): %w", err)
+	}
+
+	return hashes, nil
+}
+
+// GetOldestUnsignedTime returns updated date of the oldest unsigned transaction
+// it's invalid if there is no unsigned transaction
+func (r *BTCTxRepositoryPostgres) GetOldestUnsignedTime(ctx context.Context) (null.Time, error) {
+	updatedAt, err := r.queries.GetBtcTxOldestUnsignedUpdatedAt(ctx, sqlcpg.GetBtcTxOldestUnsignedUpdatedAtParams{
+		Coin:          sqlcpg.BtcTxCoin(r.coinTypeCode.String()),
+		CurrentTxType: domainTx.TxTypeUnsigned.Int8(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return null.Time{}, nil
+		}
+		return null.Time{}, fmt.Errorf("failed to call GetBtcTxOldestUnsignedUpdatedAt(): %w", err)
+	}
+
+	return convertSQLNullTimeToNullTime(updatedAt), nil
+}
+
+// GetConfirmedFromHeight returns confirmed (done or notified) transactions
+// included in blocks at or above blockHeight
+func (r *BTCTxRepositoryPostgres) GetConfirmedFromHeight(
+	ctx context.Context, actionType domainTx.ActionType, blockHeight int64,
+) ([]*models.BTCTX, error) {
+	btcTxs, err := r.queries.GetBtcTxConfirmedListFromHeight(ctx, sqlcpg.GetBtcTxConfirmedListFromHeightParams{
+		Coin:            sqlcpg.BtcTxCoin(r.coinTypeCode.String()),
+		Action:          sqlcpg.BtcTxAction(actionType.String()),
+		CurrentTxType:   domainTx.TxTypeDone.Int8(),
+		CurrentTxType_2: domainTx.TxTypeNotified.Int8(),
+		BlockHeight:     blockHeight,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetBtcTxConfirmedListFromHeight(): %w", err)
+	}
+
+	result := make([]*models.BTCTX, len(btcTxs))
+	for i := range btcTxs {
+		result[i] = convertPostgresBtcTxToModel(&btcTxs[i])
+	}
+
+	return result, nil
+}
+
+// InsertUnsignedTx inserts records
+func (r *BTCTxRepositoryPostgres) InsertUnsignedTx(
+	ctx context.Context, actionType domainTx.ActionType, txItem *models.BTCTX,
+) (int64, error) {
+	id, err := r.queries.InsertBtcTx(ctx, sqlcpg.InsertBtcTxParams{
+		Coin:              sqlcpg.BtcTxCoin(r.coinTypeCode.String()),
+		Action:            sqlcpg.BtcTxAction(actionType.String()),
+		UnsignedHexTx:     txItem.UnsignedHexTX,
+		SignedHexTx:       txItem.SignedHexTX,
+		SentHashTx:        txItem.SentHashTX,
+		TotalInputAmount:  txItem.TotalInputAmount.String(),
+		TotalOutputAmount: txItem.TotalOutputAmount.String(),
+		Fee:               txItem.Fee.String(),
+		CurrentTxType:     txItem.CurrentTXType,
+		UnsignedUpdatedAt: convertNullTimeToSQLNullTime(txItem.UnsignedUpdatedAt),
+		SentUpdatedAt:     convertNullTimeToSQLNullTime(txItem.SentUpdatedAt),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call InsertBtcTx(): %w", err)
+	}
+
+	return id, nil
+}
+
+// Update updates by models.BTCTX (entire update)
+func (r *BTCTxRepositoryPostgres) Update(ctx context.Context, txItem *models.BTCTX) (int64, error) {
+	err := r.queries.UpdateBtcTx(ctx, sqlcpg.UpdateBtcTxParams{
+		Coin:              sqlcpg.BtcTxCoin(txItem.Coin),
+		Action:            sqlcpg.BtcTxAction(txItem.Action),
+		UnsignedHexTx:     txItem.UnsignedHexTX,
+		SignedHexTx:       txItem.SignedHexTX,
+		SentHashTx:        txItem.SentHashTX,
+		TotalInputAmount:  txItem.TotalInputAmount.String(),
+		TotalOutputAmount: txItem.TotalOutputAmount.String(),
+		Fee:               txItem.Fee.String(),
+		CurrentTxType:     txItem.CurrentTXType,
+		UnsignedUpdatedAt: convertNullTimeToSQLNullTime(txItem.UnsignedUpdatedAt),
+		SentUpdatedAt:     convertNullTimeToSQLNullTime(txItem.SentUpdatedAt),
+		ID:                txItem.ID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateBtcTx(): %w", err)
+	}
+
+	return 1, nil
+}
+
+// UpdateAfterTxSent updates when tx sent
+func (r *BTCTxRepositoryPostgres) UpdateAfterTxSent(
+	ctx context.Context, txID int64,
+	txType domainTx.TxType,
+	signedHex,
+	sentHashTx string,
+) (int64, error) {
+	result, err := r.queries.UpdateBtcTxAfterSent(ctx, sqlcpg.UpdateBtcTxAfterSentParams{
+		CurrentTxType: txType.Int8(),
+		SignedHexTx:   signedHex,
+		SentHashTx:    sentHashTx,
+		SentUpdatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:            txID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateBtcTxAfterSent(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateBlock updates block hash and height where transaction is included
+func (r *BTCTxRepositoryPostgres) UpdateBlock(
+	ctx context.Context, id int64, blockHash string, blockHeight int64,
+) (int64, error) {
+	result, err := r.queries.UpdateBtcTxBlock(ctx, sqlcpg.UpdateBtcTxBlockParams{
+		BlockHash:   blockHash,
+		BlockHeight: blockHeight,
+		ID:          id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateBtcTxBlock(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// RollbackToSent resets txType to sent and clears block information
+// when the block including the transaction is no longer on the best chain
+func (r *BTCTxRepositoryPostgres) RollbackToSent(ctx context.Context, id int64) (int64, error) {
+	result, err := r.queries.RollbackBtcTxToSent(ctx, sqlcpg.RollbackBtcTxToSentParams{
+		CurrentTxType: domainTx.TxTypeSent.Int8(),
+		ID:            id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call RollbackBtcTxToSent(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxType updates txType
+func (r *BTCTxRepositoryPostgres) UpdateTxType(ctx context.Context, id int64, txType domainTx.TxType) (int64, error) {
+	result, err := r.queries.UpdateBtcTxType(ctx, sqlcpg.UpdateBtcTxTypeParams{
+		CurrentTxType: txType.Int8(),
+		ID:            id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateBtcTxType(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxTypeBySentHashTx updates txType
+func (r *BTCTxRepositoryPostgres) UpdateTxTypeBySentHashTx(
+	ctx context.Context, actionType domainTx.ActionType, txType domainTx.TxType, sentHashTx string,
+) (int64, error) {
+	result, err := r.queries.UpdateBtcTxTypeBySentHash(ctx, sqlcpg.UpdateBtcTxTypeBySentHashParams{
+		CurrentTxType: txType.Int8(),
+		Coin:          sqlcpg.BtcTxCoin(r.coinTypeCode.String()),
+		Action:        sqlcpg.BtcTxAction(actionType.String()),
+		SentHashTx:    sentHashTx,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateBtcTxTypeBySentHash(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// DeleteAll deletes all records
+func (r *BTCTxRepositoryPostgres) DeleteAll(ctx context.Context) (int64, error) {
+	result, err := r.queries.DeleteAllBtcTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to call DeleteAllBtcTx(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions
+
+func convertPostgresBtcTxToModel(btcTx *sqlcpg.BtcTx) *models.BTCTX {
+	totalInputAmount, _ := udecimal.Parse(btcTx.TotalInputAmount)
+	totalOutputAmount, _ := udecimal.Parse(btcTx.TotalOutputAmount)
+	fee, _ := udecimal.Parse(btcTx.Fee)
+
+	return &models.BTCTX{
+		ID:                btcTx.ID,
+		Coin:              string(btcTx.Coin),
+		Action:            string(btcTx.Action),
+		UnsignedHexTX:     btcTx.UnsignedHexTx,
+		SignedHexTX:       btcTx.SignedHexTx,
+		SentHashTX:        btcTx.SentHashTx,
+		TotalInputAmount:  totalInputAmount,
+		TotalOutputAmount: totalOutputAmount,
+		Fee:               fee,
+		CurrentTXType:     btcTx.CurrentTxType,
+		UnsignedUpdatedAt: convertSQLNullTimeToNullTime(btcTx.UnsignedUpdatedAt),
+		SentUpdatedAt:     convertSQLNullTimeToNullTime(btcTx.SentUpdatedAt),
+		BlockHash:         btcTx.BlockHash,
+		BlockHeight:       btcTx.BlockHeight,
+	}
+}
@@ -3,9 +3,11 @@ package watch
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/guregu/null/v6"
 	"github.com/quagmt/udecimal"
 
 	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
@@ -23,15 +25,21 @@ type BTCTxRepositorySqlc struct {
 // NewBTCTxRepositorySqlc returns BTCTxRepositorySqlc object
 func NewBTCTxRepositorySqlc(dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode) *BTCTxRepositorySqlc {
 	return &BTCTxRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
-// GetOne returns one record by ID
-func (r *BTCTxRepositorySqlc) GetOne(id int64) (*models.BTCTX, error) {
-	ctx := context.Background()
+// WithTx returns BTCTxRepositorySqlc which runs queries in database transaction
+func (r *BTCTxRepositorySqlc) WithTx(dtx *sql.Tx) BTCTxRepositorier {
+	return &BTCTxRepositorySqlc{
+		queries:      sqlc.NewTraced(dtx),
+		coinTypeCode: r.coinTypeCode,
+	}
+}
 
+// GetOne returns one record by ID
+func (r *BTCTxRepositorySqlc) GetOne(ctx context.Context, id int64) (*models.BTCTX, error) {
 	btcTx, err := r.queries.GetBtcTxByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetBtcTxByID(): %w", err)
@@ -41,9 +49,9 @@ func (r *BTCTxRepositorySqlc) GetOne(id int64) (*models.BTCTX, error) {
 }
 
 // GetCountByUnsignedHex returns count by hex string
-func (r *BTCTxRepositorySqlc) GetCountByUnsignedHex(actionType domainTx.ActionType, hex string) (int64, error) {
-	ctx := context.Background()
-
+func (r *BTCTxRepositorySqlc) GetCountByUnsignedHex(
+	ctx context.Context, actionType domainTx.ActionType, hex string,
+) (int64, error) {
 	count, err := r.queries.GetBtcTxCountByUnsignedHex(ctx, sqlc.GetBtcTxCountByUnsignedHexParams{
 		Coin:          sqlc.BtcTxCoin(r.coinTypeCode.String()),
 		Action:        sqlc.BtcTxAction(actionType.String()),
@@ -57,9 +65,9 @@ func (r *BTCTxRepositorySqlc) GetCountByUnsignedHex(actionType domainTx.ActionTy
 }
 
 // GetTxIDBySentHash returns txID by sentHashTx
-func (r *BTCTxRepositorySqlc) GetTxIDBySentHash(actionType domainTx.ActionType, hash string) (int64, error) {
-	ctx := context.Background()
-
+func (r *BTCTxRepositorySqlc) GetTxIDBySentHash(
+	ctx context.Context, actionType domainTx.ActionType, hash string,
+) (int64, error) {
 	id, err := r.queries.GetBtcTxIDBySentHash(ctx, sqlc.GetBtcTxIDBySentHashParams{
 		Coin:       sqlc.BtcTxCoin(r.coinTypeCode.String()),
 		Action:     sqlc.BtcTxAction(actionType.String()),
@@ -73,9 +81,9 @@ func (r *BTCTxRepositorySqlc) GetTxIDBySentHash(actionType domainTx.ActionType,
 }
 
 // GetSentHashTx returns list of sent_hash_tx by txType
-func (r *BTCTxRepositorySqlc) GetSentHashTx(actionType domainTx.ActionType, txType domainTx.TxType) ([]string, error) {
-	ctx := context.Background()
-
+func (r *BTCTxRepositorySqlc) GetSentHashTx(
+	ctx context.Context, actionType domainTx.ActionType, txType domainTx.TxType,
+) ([]string, error) {
 	hashes, err := r.queries.GetBtcTxSentHashList(ctx, sqlc.GetBtcTxSentHashListParams{
 		Coin:          sqlc.BtcTxCoin(r.coinTypeCode.String()),
 		Action:        sqlc.BtcTxAction(actionType.String()),
@@ -88,10 +96,51 @@ func (r *BTCTxRepositorySqlc) GetSentHashTx(actionType domainTx.ActionType, txTy
 	return hashes, nil
 }
 
-// InsertUnsignedTx inserts records
-func (r *BTCTxRepositorySqlc) InsertUnsignedTx(actionType domainTx.ActionType, txItem *models.BTCTX) (int64, error) {
-	ctx := context.Background()
+// GetOldestUnsignedTime returns updated date of the oldest unsigned transaction
+// it's invalid if there is no unsigned transaction
+func (r *BTCTxRepositorySqlc) GetOldestUnsignedTime(ctx context.Context) (null.Time, error) {
+	updatedAt, err := r.queries.GetBtcTxOldestUnsignedUpdatedAt(ctx, sqlc.GetBtcTxOldestUnsignedUpdatedAtParams{
+		Coin:          sqlc.BtcTxCoin(r.coinTypeCode.String()),
+		CurrentTxType: domainTx.TxTypeUnsigned.Int8(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return null.Time{}, nil
+		}
+		return null.Time{}, fmt.Errorf("failed to call GetBtcTxOldestUnsignedUpdatedAt(): %w", err)
+	}
 
+	return convertSQLNullTimeToNullTime(updatedAt), nil
+}
+
+// GetConfirmedFromHeight returns confirmed (done or notified) transactions
+// included in blocks at or above blockHeight
+func (r *BTCTxRepositorySqlc) GetConfirmedFromHeight(
+	ctx context.Context, actionType domainTx.ActionType, blockHeight int64,
+) ([]*models.BTCTX, error) {
+	btcTxs, err := r.queries.GetBtcTxConfirmedListFromHeight(ctx, sqlc.GetBtcTxConfirmedListFromHeightParams{
+		Coin:            sqlc.BtcTxCoin(r.coinTypeCode.String()),
+		Action:          sqlc.BtcTxAction(actionType.String()),
+		CurrentTxType:   domainTx.TxTypeDone.Int8(),
+		CurrentTxType_2: domainTx.TxTypeNotified.Int8(),
+		BlockHeight:     blockHeight,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetBtcTxConfirmedListFromHeight(): %w", err)
+	}
+
+	result := make([]*models.BTCTX, len(btcTxs))
+	for i := range btcTxs {
+		result[i] = convertSqlcBtcTxToModel(&btcTxs[i])
+	}
+
+	return result, nil
+}
+
+// InsertUnsignedTx inserts records
+func (r *BTCTxRepositorySqlc) InsertUnsignedTx(
+	ctx context.Context, actionType domainTx.ActionType, txItem *models.BTCTX,
+) (int64, error) {
 	result, err := r.queries.InsertBtcTx(ctx, sqlc.InsertBtcTxParams{
 		Coin:              sqlc.BtcTxCoin(r.coinTypeCode.String()),
 		Action:            sqlc.BtcTxAction(actionType.String()),
@@ -118,9 +167,7 @@ func (r *BTCTxRepositorySqlc) InsertUnsignedTx(actionType domainTx.ActionType, t
 }
 
 // Update updates by models.BTCTX (entire update)
-func (r *BTCTxRepositorySqlc) Update(txItem *models.BTCTX) (int64, error) {
-	ctx := context.Background()
-
+func (r *BTCTxRepositorySqlc) Update(ctx context.Context, txItem *models.BTCTX) (int64, error) {
 	err := r.queries.UpdateBtcTx(ctx, sqlc.UpdateBtcTxParams{
 		Coin:              sqlc.BtcTxCoin(txItem.Coin),
 		Action:            sqlc.BtcTxAction(txItem.Action),
@@ -144,13 +191,11 @@ func (r *BTCTxRepositorySqlc) Update(txItem *models.BTCTX) (int64, error) {
 
 // UpdateAfterTxSent updates when tx sent
 func (r *BTCTxRepositorySqlc) UpdateAfterTxSent(
-	txID int64,
+	ctx context.Context, txID int64,
 	txType domainTx.TxType,
 	signedHex,
 	sentHashTx string,
 ) (int64, error) {
-	ctx := context.Background()
-
 	result, err := r.queries.UpdateBtcTxAfterSent(ctx, sqlc.UpdateBtcTxAfterSentParams{
 		CurrentTxType: txType.Int8(),
 		SignedHexTx:   signedHex,
@@ -170,10 +215,48 @@ func (r *BTCTxRepositorySqlc) UpdateAfterTxSent(
 	return rowsAffected, nil
 }
 
-// UpdateTxType updates txType
-func (r *BTCTxRepositorySqlc) UpdateTxType(id int64, txType domainTx.TxType) (int64, error) {
-	ctx := context.Background()
+// UpdateBlock updates block hash and height where transaction is included
+func (r *BTCTxRepositorySqlc) UpdateBlock(
+	ctx context.Context, id int64, blockHash string, blockHeight int64,
+) (int64, error) {
+	result, err := r.queries.UpdateBtcTxBlock(ctx, sqlc.UpdateBtcTxBlockParams{
+		BlockHash:   blockHash,
+		BlockHeight: blockHeight,
+		ID:          id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateBtcTxBlock(): %w", err)
+	}
 
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// RollbackToSent resets txType to sent and clears block information
+// when the block including the transaction is no longer on the best chain
+func (r *BTCTxRepositorySqlc) RollbackToSent(ctx context.Context, id int64) (int64, error) {
+	result, err := r.queries.RollbackBtcTxToSent(ctx, sqlc.RollbackBtcTxToSentParams{
+		CurrentTxType: domainTx.TxTypeSent.Int8(),
+		ID:            id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call RollbackBtcTxToSent(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxType updates txType
+func (r *BTCTxRepositorySqlc) UpdateTxType(ctx context.Context, id int64, txType domainTx.TxType) (int64, error) {
 	result, err := r.queries.UpdateBtcTxType(ctx, sqlc.UpdateBtcTxTypeParams{
 		CurrentTxType: txType.Int8(),
 		ID:            id,
@@ -192,10 +275,8 @@ func (r *BTCTxRepositorySqlc) UpdateTxType(id int64, txType domainTx.TxType) (in
 
 // UpdateTxTypeBySentHashTx updates txType
 func (r *BTCTxRepositorySqlc) UpdateTxTypeBySentHashTx(
-	actionType domainTx.ActionType, txType domainTx.TxType, sentHashTx string,
+	ctx context.Context, actionType domainTx.ActionType, txType domainTx.TxType, sentHashTx string,
 ) (int64, error) {
-	ctx := context.Background()
-
 	result, err := r.queries.UpdateBtcTxTypeBySentHash(ctx, sqlc.UpdateBtcTxTypeBySentHashParams{
 		CurrentTxType: txType.Int8(),
 		Coin:          sqlc.BtcTxCoin(r.coinTypeCode.String()),
@@ -215,9 +296,7 @@ func (r *BTCTxRepositorySqlc) UpdateTxTypeBySentHashTx(
 }
 
 // DeleteAll deletes all records
-func (r *BTCTxRepositorySqlc) DeleteAll() (int64, error) {
-	ctx := context.Background()
-
+func (r *BTCTxRepositorySqlc) DeleteAll(ctx context.Context) (int64, error) {
 	result, err := r.queries.DeleteAllBtcTx(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to call DeleteAllBtcTx(): %w", err)
@@ -251,5 +330,7 @@ func convertSqlcBtcTxToModel(btcTx *sqlc.BtcTx) *models.BTCTX {
 		CurrentTXType:     btcTx.CurrentTxType,
 		UnsignedUpdatedAt: convertSQLNullTimeToNullTime(btcTx.UnsignedUpdatedAt),
 		SentUpdatedAt:     convertSQLNullTimeToNullTime(btcTx.SentUpdatedAt),
+		BlockHash:         btcTx.BlockHash,
+		BlockHeight:       btcTx.BlockHeight,
 	}
 }
@@ -4,9 +4,11 @@
 package watchrepo_test
 
 import (
+	"context"
 	"testing"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	"github.com/quagmt/udecimal"
 	"github.com/stretchr/testify/require"
 
@@ -17,10 +19,12 @@ import (
 
 // TestBTCTxSqlc is integration test for BTCTxRepositorySqlc
 func TestBTCTxSqlc(t *testing.T) {
+	ctx := context.Background()
+
 	txRepo := testutil.NewBTCTxRepositorySqlc()
 
 	// Delete records
-	_, err := txRepo.DeleteAll()
+	_, err := txRepo.DeleteAll(ctx)
 	require.NoError(t, err, "fail to call DeleteAll()")
 
 	// Insert
@@ -38,55 +42,90 @@ func TestBTCTxSqlc(t *testing.T) {
 		TotalOutputAmount: outputAmt,
 		Fee:               feeAmt,
 	}
-	id, err := txRepo.InsertUnsignedTx(actionType, txItem)
+	id, err := txRepo.InsertUnsignedTx(ctx, actionType, txItem)
 	require.NoError(t, err, "fail to call InsertUnsignedTx()")
 	txItem.ID = id // Set the ID for later operations
 	// check inserted record
-	tmpTx, err := txRepo.GetOne(id)
+	tmpTx, err := txRepo.GetOne(ctx, id)
 	require.NoError(t, err, "fail to call GetOne()")
 	require.Equal(t, hex, tmpTx.UnsignedHexTX, "InsertUnsignedTx() should insert correct hex")
 	// check Count
-	cnt, err := txRepo.GetCountByUnsignedHex(actionType, hex)
+	cnt, err := txRepo.GetCountByUnsignedHex(ctx, actionType, hex)
 	require.NoError(t, err, "fail to call GetCount()")
 	require.Equal(t, int64(1), cnt, "GetCount() should return 1")
 
 	// Update only UnsignedHexTX
 	hex2 := "unsigned-hex2-sqlc"
 	txItem.UnsignedHexTX = hex2
-	_, err = txRepo.Update(txItem)
+	_, err = txRepo.Update(ctx, txItem)
 	require.NoError(t, err, "fail to call UpdateTx()")
 	// check updated unsigned hex tx
-	tmpTx, err = txRepo.GetOne(txItem.ID)
+	tmpTx, err = txRepo.GetOne(ctx, txItem.ID)
 	require.NoError(t, err, "fail to call GetOne()")
 	require.Equal(t, hex2, tmpTx.UnsignedHexTX, "Update() should update UnsignedHexTX")
 
 	// Update like after tx sent
 	signedHex := "signed-hex-sqlc"
 	sentHashTx := "sent-hash-tx-sqlc"
-	_, err = txRepo.UpdateAfterTxSent(txItem.ID, domainTx.TxTypeSent, signedHex, sentHashTx)
+	_, err = txRepo.UpdateAfterTxSent(ctx, txItem.ID, domainTx.TxTypeSent, signedHex, sentHashTx)
 	require.NoError(t, err, "fail to call UpdateTx()")
 	// check updated record
-	tmpTx, err = txRepo.GetOne(txItem.ID)
+	tmpTx, err = txRepo.GetOne(ctx, txItem.ID)
 	require.NoError(t, err, "fail to call GetOne()")
 	require.Equal(t, signedHex, tmpTx.SignedHexTX, "Update() should update SignedHexTX")
 	// sent_hash_tx should be retrieved
-	hashes, err := txRepo.GetSentHashTx(actionType, domainTx.TxTypeSent)
+	hashes, err := txRepo.GetSentHashTx(ctx, actionType, domainTx.TxTypeSent)
 	require.NoError(t, err, "fail to call GetSentHashTx()")
 	require.Len(t, hashes, 1, "GetSentHashTx() should return 1 hash")
 
 	// update txType
-	_, err = txRepo.UpdateTxTypeBySentHashTx(actionType, domainTx.TxTypeDone, sentHashTx)
+	_, err = txRepo.UpdateTxTypeBySentHashTx(ctx, actionType, domainTx.TxTypeDone, sentHashTx)
 	require.NoError(t, err, "fail to call UpdateTxTypeBySentHashTx()")
 	// check updated record
-	tmpTx, err = txRepo.GetOne(txItem.ID)
+	tmpTx, err = txRepo.GetOne(ctx, txItem.ID)
 	require.NoError(t, err, "fail to call GetOne()")
-	require.Equal(t, domainTx.TxTypeDone.Int8(), tmpTx.CurrentTXType, "UpdateTxTypeBySentHashTx() should update CurrentTXType to TxTypeDone")
+	require.Equal(
+		t, domainTx.TxTypeDone.Int8(), tmpTx.CurrentTXType, "UpdateTxTypeBySentHashTx() should update CurrentTXType to TxTypeDone",
+	)
 
 	// update txType
-	_, err = txRepo.UpdateTxType(txItem.ID, domainTx.TxTypeNotified)
+	_, err = txRepo.UpdateTxType(ctx, txItem.ID, domainTx.TxTypeNotified)
 	require.NoError(t, err, "fail to call UpdateTxType()")
 	// check updated record
-	tmpTx, err = txRepo.GetOne(txItem.ID)
+	tmpTx, err = txRepo.GetOne(ctx, txItem.ID)
+	require.NoError(t, err, "fail to call GetOne()")
+	require.Equal(
+		t, domainTx.TxTypeNotified.Int8(), tmpTx.CurrentTXType, "UpdateTxType() should update CurrentTXType to TxTypeNotified",
+	)
+
+	// record block including tx
+	blockHash := "block-hash-sqlc"
+	blockHeight := int64(1000)
+	_, err = txRepo.UpdateBlock(ctx, txItem.ID, blockHash, blockHeight)
+	require.NoError(t, err, "fail to call UpdateBlock()")
+	// confirmed tx in recent blocks should be retrieved for reorg check
+	confirmedTxs, err := txRepo.GetConfirmedFromHeight(ctx, actionType, blockHeight)
+	require.NoError(t, err, "fail to call GetConfirmedFromHeight()")
+	require.Len(t, confirmedTxs, 1, "GetConfirmedFromHeight() should return tx included in block at blockHeight")
+	require.Equal(t, blockHash, confirmedTxs[0].BlockHash, "GetConfirmedFromHeight() should return block hash")
+	confirmedTxs, err = txRepo.GetConfirmedFromHeight(ctx, actionType, blockHeight+1)
+	require.NoError(t, err, "fail to call GetConfirmedFromHeight()")
+	require.Empty(t, confirmedTxs, "GetConfirmedFromHeight() should not return tx included in older block")
+
+	// roll back by chain reorganization
+	rowsAffected, err := txRepo.RollbackToSent(ctx, txItem.ID)
+	require.NoError(t, err, "fail to call RollbackToSent()")
+	require.Equal(t, int64(1), rowsAffected, "RollbackToSent() should affect 1 row")
+	// check updated record
+	tmpTx, err = txRepo.GetOne(ctx, txItem.ID)
 	require.NoError(t, err, "fail to call GetOne()")
-	require.Equal(t, domainTx.TxTypeNotified.Int8(), tmpTx.CurrentTXType, "UpdateTxType() should update CurrentTXType to TxTypeNotified")
+	require.Equal(
+		t, domainTx.TxTypeSent.Int8(), tmpTx.CurrentTXType, "RollbackToSent() should update CurrentTXType to TxTypeSent",
+	)
+	require.Empty(t, tmpTx.BlockHash, "RollbackToSent() should clear BlockHash")
+	require.Zero(t, tmpTx.BlockHeight, "RollbackToSent() should clear BlockHeight")
+	// rolled back tx is no longer confirmed
+	confirmedTxs, err = txRepo.GetConfirmedFromHeight(ctx, actionType, 0)
+	require.NoError(t, err, "fail to call GetConfirmedFromHeight()")
+	require.Empty(t, confirmedTxs, "GetConfirmedFromHeight() should not return rolled back tx")
 }
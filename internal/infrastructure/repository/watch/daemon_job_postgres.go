@@ -0,0 +1,75 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+	"github.com/hiromaily/go-crypto-wallet/pkg/scheduler"
+)
+
+// DaemonJobRepositoryPostgres is repository for daemon_job table using sqlc for PostgreSQL
+type DaemonJobRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewDaemonJobRepositoryPostgres returns DaemonJobRepositoryPostgres object
+func NewDaemonJobRepositoryPostgres(dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode) *DaemonJobRepositoryPostgres {
+	return &DaemonJobRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetAll returns last run status of all jobs
+func (r *DaemonJobRepositoryPostgres) GetAll(ctx context.Context) ([]*models.DaemonJob, error) {
+	jobs, err := r.queries.GetAllDaemonJobs(ctx, sqlcpg.DaemonJobCoin(r.coinTypeCode.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetAllDaemonJobs(): %w", err)
+	}
+
+	result := make([]*models.DaemonJob, len(jobs))
+	for i := range jobs {
+		result[i] = convertPostgresDaemonJobToModel(&jobs[i])
+	}
+
+	return result, nil
+}
+
+// SaveStatus inserts or updates last run status of job
+func (r *DaemonJobRepositoryPostgres) SaveStatus(ctx context.Context, status *scheduler.JobStatus) error {
+	_, err := r.queries.UpsertDaemonJob(ctx, sqlcpg.UpsertDaemonJobParams{
+		Coin:           sqlcpg.DaemonJobCoin(r.coinTypeCode.String()),
+		Name:           status.Name,
+		LastStatus:     sqlcpg.DaemonJobLastStatus(status.Status.String()),
+		LastError:      status.Error,
+		LastStartedAt:  convertTimeToSQLNullTime(status.StartedAt),
+		LastFinishedAt: convertTimeToSQLNullTime(status.FinishedAt),
+		UpdatedAt:      sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call UpsertDaemonJob(): %w", err)
+	}
+
+	return nil
+}
+
+// Helper functions
+
+func convertPostgresDaemonJobToModel(job *sqlcpg.DaemonJob) *models.DaemonJob {
+	return &models.DaemonJob{
+		ID:             job.ID,
+		Coin:           string(job.Coin),
+		Name:           job.Name,
+		LastStatus:     string(job.LastStatus),
+		LastError:      job.LastError,
+		LastStartedAt:  convertSQLNullTimeToNullTime(job.LastStartedAt),
+		LastFinishedAt: convertSQLNullTimeToNullTime(job.LastFinishedAt),
+		UpdatedAt:      convertSQLNullTimeToNullTime(job.UpdatedAt),
+	}
+}
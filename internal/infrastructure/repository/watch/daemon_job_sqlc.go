@@ -0,0 +1,82 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlc"
+	"github.com/hiromaily/go-crypto-wallet/pkg/scheduler"
+)
+
+// DaemonJobRepositorySqlc is repository for daemon_job table using sqlc
+type DaemonJobRepositorySqlc struct {
+	queries      *sqlc.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewDaemonJobRepositorySqlc returns DaemonJobRepositorySqlc object
+func NewDaemonJobRepositorySqlc(dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode) *DaemonJobRepositorySqlc {
+	return &DaemonJobRepositorySqlc{
+		queries:      sqlc.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetAll returns last run status of all jobs
+func (r *DaemonJobRepositorySqlc) GetAll(ctx context.Context) ([]*models.DaemonJob, error) {
+	jobs, err := r.queries.GetAllDaemonJobs(ctx, sqlc.DaemonJobCoin(r.coinTypeCode.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetAllDaemonJobs(): %w", err)
+	}
+
+	result := make([]*models.DaemonJob, len(jobs))
+	for i := range jobs {
+		result[i] = convertSqlcDaemonJobToModel(&jobs[i])
+	}
+
+	return result, nil
+}
+
+// SaveStatus inserts or updates last run status of job
+func (r *DaemonJobRepositorySqlc) SaveStatus(ctx context.Context, status *scheduler.JobStatus) error {
+	_, err := r.queries.UpsertDaemonJob(ctx, sqlc.UpsertDaemonJobParams{
+		Coin:           sqlc.DaemonJobCoin(r.coinTypeCode.String()),
+		Name:           status.Name,
+		LastStatus:     sqlc.DaemonJobLastStatus(status.Status.String()),
+		LastError:      status.Error,
+		LastStartedAt:  convertTimeToSQLNullTime(status.StartedAt),
+		LastFinishedAt: convertTimeToSQLNullTime(status.FinishedAt),
+		UpdatedAt:      sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call UpsertDaemonJob(): %w", err)
+	}
+
+	return nil
+}
+
+// Helper functions
+
+func convertSqlcDaemonJobToModel(job *sqlc.DaemonJob) *models.DaemonJob {
+	return &models.DaemonJob{
+		ID:             job.ID,
+		Coin:           string(job.Coin),
+		Name:           job.Name,
+		LastStatus:     string(job.LastStatus),
+		LastError:      job.LastError,
+		LastStartedAt:  convertSQLNullTimeToNullTime(job.LastStartedAt),
+		LastFinishedAt: convertSQLNullTimeToNullTime(job.LastFinishedAt),
+		UpdatedAt:      convertSQLNullTimeToNullTime(job.UpdatedAt),
+	}
+}
+
+func convertTimeToSQLNullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t, Valid: true}
+}
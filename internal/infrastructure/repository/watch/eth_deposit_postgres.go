@@ -0,0 +1,141 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// EthDepositRepositoryPostgres is repository for eth_deposit table using sqlc for PostgreSQL
+type EthDepositRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewEthDepositRepositoryPostgres returns EthDepositRepositoryPostgres object
+func NewEthDepositRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *EthDepositRepositoryPostgres {
+	return &EthDepositRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetAllUnconfirmed returns unconfirmed deposits of token contracts in order of block number
+func (r *EthDepositRepositoryPostgres) GetAllUnconfirmed(
+	ctx context.Context, tokenContracts []string,
+) ([]*models.EthDeposit, error) {
+	deposits, err := r.queries.GetEthDepositsUnconfirmed(ctx, sqlcpg.GetEthDepositsUnconfirmedParams{
+		Coin:           sqlcpg.EthDepositCoin(r.coinTypeCode.String()),
+		TokenContracts: tokenContracts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetEthDepositsUnconfirmed(): %w", err)
+	}
+
+	result := make([]*models.EthDeposit, len(deposits))
+	for i, deposit := range deposits {
+		result[i] = convertPostgresEthDepositToModel(&deposit)
+	}
+
+	return result, nil
+}
+
+// InsertBulk inserts multiple records, deposit which is already stored is ignored
+//   - number of inserted records is returned
+func (r *EthDepositRepositoryPostgres) InsertBulk(ctx context.Context, items []*models.EthDeposit) (int64, error) {
+	var inserted int64
+	for _, item := range items {
+		result, err := r.queries.InsertEthDeposit(ctx, sqlcpg.InsertEthDepositParams{
+			Coin:            sqlcpg.EthDepositCoin(r.coinTypeCode.String()),
+			TokenContract:   item.TokenContract,
+			TxHash:          item.TXHash,
+			LogIndex:        item.LogIndex,
+			BlockNumber:     item.BlockNumber,
+			BlockHash:       item.BlockHash,
+			BlockTime:       convertNullTimeToSQLNullTime(item.BlockTime),
+			SenderAddress:   item.SenderAddress,
+			ReceiverAccount: item.ReceiverAccount,
+			ReceiverAddress: item.ReceiverAddress,
+			Amount:          item.Amount,
+		})
+		if err != nil {
+			return inserted, fmt.Errorf("failed to call InsertEthDeposit(): %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return inserted, fmt.Errorf("failed to get RowsAffected(): %w", err)
+		}
+		inserted += rowsAffected
+	}
+
+	return inserted, nil
+}
+
+// UpdateConfirmed updates deposit to confirmed
+func (r *EthDepositRepositoryPostgres) UpdateConfirmed(ctx context.Context, id int64) (int64, error) {
+	result, err := r.queries.UpdateEthDepositConfirmed(ctx, sqlcpg.UpdateEthDepositConfirmedParams{
+		ConfirmedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:          id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateEthDepositConfirmed(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// DeleteUnconfirmedFromBlock deletes unconfirmed deposits of token contracts from block number
+// because those blocks are reorganized
+func (r *EthDepositRepositoryPostgres) DeleteUnconfirmedFromBlock(
+	ctx context.Context, tokenContracts []string, blockNumber uint64,
+) (int64, error) {
+	result, err := r.queries.DeleteEthDepositsUnconfirmedFromBlock(ctx, sqlcpg.DeleteEthDepositsUnconfirmedFromBlockParams{
+		Coin:           sqlcpg.EthDepositCoin(r.coinTypeCode.String()),
+		BlockNumber:    blockNumber,
+		TokenContracts: tokenContracts,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call DeleteEthDepositsUnconfirmedFromBlock(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions
+
+func convertPostgresEthDepositToModel(deposit *sqlcpg.EthDeposit) *models.EthDeposit {
+	return &models.EthDeposit{
+		ID:              deposit.ID,
+		Coin:            string(deposit.Coin),
+		TokenContract:   deposit.TokenContract,
+		TXHash:          deposit.TxHash,
+		LogIndex:        deposit.LogIndex,
+		BlockNumber:     deposit.BlockNumber,
+		BlockHash:       deposit.BlockHash,
+		BlockTime:       convertSQLNullTimeToNullTime(deposit.BlockTime),
+		SenderAddress:   deposit.SenderAddress,
+		ReceiverAccount: deposit.ReceiverAccount,
+		ReceiverAddress: deposit.ReceiverAddress,
+		Amount:          deposit.Amount,
+		IsConfirmed:     deposit.IsConfirmed,
+		CreatedAt:       convertSQLNullTimeToNullTime(deposit.CreatedAt),
+		ConfirmedAt:     convertSQLNullTimeToNullTime(deposit.ConfirmedAt),
+	}
+}
@@ -0,0 +1,224 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null/v6"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// EthDetailTxInputRepositoryPostgres is repository for eth_detail_tx table using sqlc for PostgreSQL
+type EthDetailTxInputRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewEthDetailTxInputRepositoryPostgres returns EthDetailTxInputRepositoryPostgres object
+func NewEthDetailTxInputRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *EthDetailTxInputRepositoryPostgres {
+	return &EthDetailTxInputRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetOne get one record by ID
+func (r *EthDetailTxInputRepositoryPostgres) GetOne(ctx context.Context, id int64) (*models.EthDetailTX, error) {
+	ethTx, err := r.queries.GetEthDetailTxByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetEthDetailTxByID(): %w", err)
+	}
+
+	return convertPostgresEthDetailTxToModel(&ethTx), nil
+}
+
+// GetActionBySentHashTx returns action of tx which the record belongs to
+func (r *EthDetailTxInputRepositoryPostgres) GetActionBySentHashTx(ctx context.Context, sentHashTx string) (domainTx.ActionType, error) {
+	action, err := r.queries.GetEthDetailTxActionBySentHash(ctx, sentHashTx)
+	if err != nil {
+		return "", fmt.Errorf("failed to call GetEthDetailTxActionBySentHash(): %w", err)
+	}
+
+	return domainTx.ActionType(action), nil
+}
+
+// GetAllByTxID returns all records searched by tx_id
+func (r *EthDetailTxInputRepositoryPostgres) GetAllByTxID(ctx context.Context, id int64) ([]*models.EthDetailTX, error) {
+	ethTxs, err := r.queries.GetEthDetailTxsByTxID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetEthDetailTxsByTxID(): %w", err)
+	}
+
+	result := make([]*models.EthDetailTX, len(ethTxs))
+	for i, ethTx := range ethTxs {
+		result[i] = convertPostgresEthDetailTxToModel(&ethTx)
+	}
+
+	return result, nil
+}
+
+// GetSentHashTx returns list of sent_hash_tx by txType
+func (r *EthDetailTxInputRepositoryPostgres) GetSentHashTx(ctx context.Context, txType domainTx.TxType) ([]string, error) {
+	hashes, err := r.queries.GetEthDetailTxSentHashList(ctx, sqlcpg.GetEthDetailTxSentHashListParams{
+		Coin:          sqlcpg.TxCoin(r.coinTypeCode.String()),
+		CurrentTxType: txType.Int8(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetEthDetailTxSentHashList(): %w", err)
+	}
+
+	return hashes, nil
+}
+
+// GetOldestUnsignedTime returns updated date of the oldest unsigned transaction
+// it's invalid if there is no unsigned transaction
+func (r *EthDetailTxInputRepositoryPostgres) GetOldestUnsignedTime(ctx context.Context) (null.Time, error) {
+	updatedAt, err := r.queries.GetEthDetailTxOldestUnsignedUpdatedAt(ctx, sqlcpg.GetEthDetailTxOldestUnsignedUpdatedAtParams{
+		Coin:          sqlcpg.TxCoin(r.coinTypeCode.String()),
+		CurrentTxType: domainTx.TxTypeUnsigned.Int8(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return null.Time{}, nil
+		}
+		return null.Time{}, fmt.Errorf("failed to call GetEthDetailTxOldestUnsignedUpdatedAt(): %w", err)
+	}
+
+	return convertSQLNullTimeToNullTime(updatedAt), nil
+}
+
+// Insert inserts one record
+func (r *EthDetailTxInputRepositoryPostgres) Insert(ctx context.Context, txItem *models.EthDetailTX) error {
+	_, err := r.queries.InsertEthDetailTx(ctx, sqlcpg.InsertEthDetailTxParams{
+		TxID:              txItem.TXID,
+		Uuid:              txItem.UUID,
+		CurrentTxType:     txItem.CurrentTXType,
+		SenderAccount:     txItem.SenderAccount,
+		SenderAddress:     txItem.SenderAddress,
+		ReceiverAccount:   txItem.ReceiverAccount,
+		ReceiverAddress:   txItem.ReceiverAddress,
+		Amount:            txItem.Amount,
+		Fee:               txItem.Fee,
+		GasLimit:          txItem.GasLimit,
+		Nonce:             txItem.Nonce,
+		UnsignedHexTx:     txItem.UnsignedHexTX,
+		SignedHexTx:       txItem.SignedHexTX,
+		SentHashTx:        txItem.SentHashTX,
+		UnsignedUpdatedAt: convertNullTimeToSQLNullTime(txItem.UnsignedUpdatedAt),
+		SentUpdatedAt:     convertNullTimeToSQLNullTime(txItem.SentUpdatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertEthDetailTx(): %w", err)
+	}
+
+	return nil
+}
+
+// InsertBulk inserts multiple records
+func (r *EthDetailTxInputRepositoryPostgres) InsertBulk(ctx context.Context, txItems []*models.EthDetailTX) error {
+	for _, item := range txItems {
+		if err := r.Insert(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateAfterTxSent updates when tx sent
+func (r *EthDetailTxInputRepositoryPostgres) UpdateAfterTxSent(
+	ctx context.Context, uuid string,
+	txType domainTx.TxType,
+	signedHex,
+	sentHashTx string,
+) (int64, error) {
+	result, err := r.queries.UpdateEthDetailTxAfterSent(ctx, sqlcpg.UpdateEthDetailTxAfterSentParams{
+		CurrentTxType: txType.Int8(),
+		SignedHexTx:   signedHex,
+		SentHashTx:    sentHashTx,
+		SentUpdatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		Uuid:          uuid,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateEthDetailTxAfterSent(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxType updates txType
+func (r *EthDetailTxInputRepositoryPostgres) UpdateTxType(
+	ctx context.Context, id int64, txType domainTx.TxType,
+) (int64, error) {
+	result, err := r.queries.UpdateEthDetailTxType(ctx, sqlcpg.UpdateEthDetailTxTypeParams{
+		CurrentTxType: txType.Int8(),
+		ID:            id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateEthDetailTxType(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxTypeBySentHashTx updates txType
+func (r *EthDetailTxInputRepositoryPostgres) UpdateTxTypeBySentHashTx(
+	ctx context.Context, txType domainTx.TxType, sentHashTx string,
+) (int64, error) {
+	result, err := r.queries.UpdateEthDetailTxTypeBySentHash(ctx, sqlcpg.UpdateEthDetailTxTypeBySentHashParams{
+		CurrentTxType: txType.Int8(),
+		SentHashTx:    sentHashTx,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateEthDetailTxTypeBySentHash(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions
+
+func convertPostgresEthDetailTxToModel(ethTx *sqlcpg.EthDetailTx) *models.EthDetailTX {
+	return &models.EthDetailTX{
+		ID:                ethTx.ID,
+		TXID:              ethTx.TxID,
+		UUID:              ethTx.Uuid,
+		CurrentTXType:     ethTx.CurrentTxType,
+		SenderAccount:     ethTx.SenderAccount,
+		SenderAddress:     ethTx.SenderAddress,
+		ReceiverAccount:   ethTx.ReceiverAccount,
+		ReceiverAddress:   ethTx.ReceiverAddress,
+		Amount:            ethTx.Amount,
+		Fee:               ethTx.Fee,
+		GasLimit:          ethTx.GasLimit,
+		Nonce:             ethTx.Nonce,
+		UnsignedHexTX:     ethTx.UnsignedHexTx,
+		SignedHexTX:       ethTx.SignedHexTx,
+		SentHashTX:        ethTx.SentHashTx,
+		UnsignedUpdatedAt: convertSQLNullTimeToNullTime(ethTx.UnsignedUpdatedAt),
+		SentUpdatedAt:     convertSQLNullTimeToNullTime(ethTx.SentUpdatedAt),
+	}
+}
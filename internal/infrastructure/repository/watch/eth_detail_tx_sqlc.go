@@ -3,9 +3,12 @@ package watch
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/guregu/null/v6"
+
 	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
 	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
 	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
@@ -23,15 +26,13 @@ func NewEthDetailTxInputRepositorySqlc(
 	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
 ) *EthDetailTxInputRepositorySqlc {
 	return &EthDetailTxInputRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
 // GetOne get one record by ID
-func (r *EthDetailTxInputRepositorySqlc) GetOne(id int64) (*models.EthDetailTX, error) {
-	ctx := context.Background()
-
+func (r *EthDetailTxInputRepositorySqlc) GetOne(ctx context.Context, id int64) (*models.EthDetailTX, error) {
 	ethTx, err := r.queries.GetEthDetailTxByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetEthDetailTxByID(): %w", err)
@@ -40,10 +41,18 @@ func (r *EthDetailTxInputRepositorySqlc) GetOne(id int64) (*models.EthDetailTX,
 	return convertSqlcEthDetailTxToModel(&ethTx), nil
 }
 
-// GetAllByTxID returns all records searched by tx_id
-func (r *EthDetailTxInputRepositorySqlc) GetAllByTxID(id int64) ([]*models.EthDetailTX, error) {
-	ctx := context.Background()
+// GetActionBySentHashTx returns action of tx which the record belongs to
+func (r *EthDetailTxInputRepositorySqlc) GetActionBySentHashTx(ctx context.Context, sentHashTx string) (domainTx.ActionType, error) {
+	action, err := r.queries.GetEthDetailTxActionBySentHash(ctx, sentHashTx)
+	if err != nil {
+		return "", fmt.Errorf("failed to call GetEthDetailTxActionBySentHash(): %w", err)
+	}
 
+	return domainTx.ActionType(action), nil
+}
+
+// GetAllByTxID returns all records searched by tx_id
+func (r *EthDetailTxInputRepositorySqlc) GetAllByTxID(ctx context.Context, id int64) ([]*models.EthDetailTX, error) {
 	ethTxs, err := r.queries.GetEthDetailTxsByTxID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetEthDetailTxsByTxID(): %w", err)
@@ -58,9 +67,7 @@ func (r *EthDetailTxInputRepositorySqlc) GetAllByTxID(id int64) ([]*models.EthDe
 }
 
 // GetSentHashTx returns list of sent_hash_tx by txType
-func (r *EthDetailTxInputRepositorySqlc) GetSentHashTx(txType domainTx.TxType) ([]string, error) {
-	ctx := context.Background()
-
+func (r *EthDetailTxInputRepositorySqlc) GetSentHashTx(ctx context.Context, txType domainTx.TxType) ([]string, error) {
 	hashes, err := r.queries.GetEthDetailTxSentHashList(ctx, sqlc.GetEthDetailTxSentHashListParams{
 		Coin:          sqlc.TxCoin(r.coinTypeCode.String()),
 		CurrentTxType: txType.Int8(),
@@ -72,10 +79,25 @@ func (r *EthDetailTxInputRepositorySqlc) GetSentHashTx(txType domainTx.TxType) (
 	return hashes, nil
 }
 
-// Insert inserts one record
-func (r *EthDetailTxInputRepositorySqlc) Insert(txItem *models.EthDetailTX) error {
-	ctx := context.Background()
+// GetOldestUnsignedTime returns updated date of the oldest unsigned transaction
+// it's invalid if there is no unsigned transaction
+func (r *EthDetailTxInputRepositorySqlc) GetOldestUnsignedTime(ctx context.Context) (null.Time, error) {
+	updatedAt, err := r.queries.GetEthDetailTxOldestUnsignedUpdatedAt(ctx, sqlc.GetEthDetailTxOldestUnsignedUpdatedAtParams{
+		Coin:          sqlc.TxCoin(r.coinTypeCode.String()),
+		CurrentTxType: domainTx.TxTypeUnsigned.Int8(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return null.Time{}, nil
+		}
+		return null.Time{}, fmt.Errorf("failed to call GetEthDetailTxOldestUnsignedUpdatedAt(): %w", err)
+	}
+
+	return convertSQLNullTimeToNullTime(updatedAt), nil
+}
 
+// Insert inserts one record
+func (r *EthDetailTxInputRepositorySqlc) Insert(ctx context.Context, txItem *models.EthDetailTX) error {
 	_, err := r.queries.InsertEthDetailTx(ctx, sqlc.InsertEthDetailTxParams{
 		TxID:              txItem.TXID,
 		Uuid:              txItem.UUID,
@@ -102,9 +124,9 @@ func (r *EthDetailTxInputRepositorySqlc) Insert(txItem *models.EthDetailTX) erro
 }
 
 // InsertBulk inserts multiple records
-func (r *EthDetailTxInputRepositorySqlc) InsertBulk(txItems []*models.EthDetailTX) error {
+func (r *EthDetailTxInputRepositorySqlc) InsertBulk(ctx context.Context, txItems []*models.EthDetailTX) error {
 	for _, item := range txItems {
-		if err := r.Insert(item); err != nil {
+		if err := r.Insert(ctx, item); err != nil {
 			return err
 		}
 	}
@@ -113,13 +135,11 @@ func (r *EthDetailTxInputRepositorySqlc) InsertBulk(txItems []*models.EthDetailT
 
 // UpdateAfterTxSent updates when tx sent
 func (r *EthDetailTxInputRepositorySqlc) UpdateAfterTxSent(
-	uuid string,
+	ctx context.Context, uuid string,
 	txType domainTx.TxType,
 	signedHex,
 	sentHashTx string,
 ) (int64, error) {
-	ctx := context.Background()
-
 	result, err := r.queries.UpdateEthDetailTxAfterSent(ctx, sqlc.UpdateEthDetailTxAfterSentParams{
 		CurrentTxType: txType.Int8(),
 		SignedHexTx:   signedHex,
@@ -140,9 +160,9 @@ func (r *EthDetailTxInputRepositorySqlc) UpdateAfterTxSent(
 }
 
 // UpdateTxType updates txType
-func (r *EthDetailTxInputRepositorySqlc) UpdateTxType(id int64, txType domainTx.TxType) (int64, error) {
-	ctx := context.Background()
-
+func (r *EthDetailTxInputRepositorySqlc) UpdateTxType(
+	ctx context.Context, id int64, txType domainTx.TxType,
+) (int64, error) {
 	result, err := r.queries.UpdateEthDetailTxType(ctx, sqlc.UpdateEthDetailTxTypeParams{
 		CurrentTxType: txType.Int8(),
 		ID:            id,
@@ -161,10 +181,8 @@ func (r *EthDetailTxInputRepositorySqlc) UpdateTxType(id int64, txType domainTx.
 
 // UpdateTxTypeBySentHashTx updates txType
 func (r *EthDetailTxInputRepositorySqlc) UpdateTxTypeBySentHashTx(
-	txType domainTx.TxType, sentHashTx string,
+	ctx context.Context, txType domainTx.TxType, sentHashTx string,
 ) (int64, error) {
-	ctx := context.Background()
-
 	result, err := r.queries.UpdateEthDetailTxTypeBySentHash(ctx, sqlc.UpdateEthDetailTxTypeBySentHashParams{
 		CurrentTxType: txType.Int8(),
 		SentHashTx:    sentHashTx,
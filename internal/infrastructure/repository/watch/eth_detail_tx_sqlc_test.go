@@ -4,11 +4,13 @@
 package watchrepo_test
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -24,6 +26,8 @@ import (
 
 // TestEthDetailTxSqlc is integration test for EthDetailTxInputRepositorySqlc
 func TestEthDetailTxSqlc(t *testing.T) {
+	ctx := context.Background()
+
 	// Create ETH repositories
 	projPath := os.Getenv("GOPATH") + "/src/github.com/hiromaily/go-crypto-wallet"
 	confPath := projPath + "/data/config/eth_watch.toml"
@@ -45,7 +49,7 @@ func TestEthDetailTxSqlc(t *testing.T) {
 	_, _ = db.Exec("DELETE FROM tx WHERE coin = 'eth'")
 
 	// Create a tx record first (eth_detail_tx joins with tx table)
-	txID, err := txRepo.InsertUnsignedTx(domainTx.ActionTypePayment)
+	txID, err := txRepo.InsertUnsignedTx(ctx, domainTx.ActionTypePayment)
 	require.NoError(t, err, "fail to create parent tx")
 
 	// Create test eth detail tx
@@ -66,61 +70,61 @@ func TestEthDetailTxSqlc(t *testing.T) {
 	}
 
 	// Insert
-	err = ethDetailTxRepo.Insert(ethTx)
+	err = ethDetailTxRepo.Insert(ctx, ethTx)
 	require.NoError(t, err, "fail to call Insert()")
 
 	// Get all by tx ID
-	ethTxs, err := ethDetailTxRepo.GetAllByTxID(txID)
+	ethTxs, err := ethDetailTxRepo.GetAllByTxID(ctx, txID)
 	require.NoError(t, err, "fail to call GetAllByTxID()")
 	require.GreaterOrEqual(t, len(ethTxs), 1, "GetAllByTxID() should return at least 1 record")
 
 	// Get one
-	retrievedTx, err := ethDetailTxRepo.GetOne(ethTxs[0].ID)
+	retrievedTx, err := ethDetailTxRepo.GetOne(ctx, ethTxs[0].ID)
 	require.NoError(t, err, "fail to call GetOne()")
 	require.Equal(t, uuid, retrievedTx.UUID, "GetOne() should return correct UUID")
 
 	// Update after tx sent
 	signedHex := "0xsigned-hex-sqlc"
 	sentHashTx := "0xsent-hash-sqlc"
-	rowsAffected, err := ethDetailTxRepo.UpdateAfterTxSent(uuid, domainTx.TxTypeSent, signedHex, sentHashTx)
+	rowsAffected, err := ethDetailTxRepo.UpdateAfterTxSent(ctx, uuid, domainTx.TxTypeSent, signedHex, sentHashTx)
 	require.NoError(t, err, "fail to call UpdateAfterTxSent()")
 	require.GreaterOrEqual(t, rowsAffected, int64(1), "UpdateAfterTxSent() should affect at least 1 row")
 
 	// Verify update
-	updatedTx, err := ethDetailTxRepo.GetOne(retrievedTx.ID)
+	updatedTx, err := ethDetailTxRepo.GetOne(ctx, retrievedTx.ID)
 	require.NoError(t, err, "fail to call GetOne() after update")
 	require.Equal(t, signedHex, updatedTx.SignedHexTX, "UpdateAfterTxSent() should update SignedHexTX")
 	require.Equal(t, sentHashTx, updatedTx.SentHashTX, "UpdateAfterTxSent() should update SentHashTX")
 	require.Equal(t, domainTx.TxTypeSent.Int8(), updatedTx.CurrentTXType, "UpdateAfterTxSent() should update CurrentTXType")
 
 	// Get sent hash tx
-	hashes, err := ethDetailTxRepo.GetSentHashTx(domainTx.TxTypeSent)
+	hashes, err := ethDetailTxRepo.GetSentHashTx(ctx, domainTx.TxTypeSent)
 	require.NoError(t, err, "fail to call GetSentHashTx()")
 	require.GreaterOrEqual(t, len(hashes), 1, "GetSentHashTx() should return at least 1 hash")
 
 	// Update tx type by sent hash
-	rowsAffected, err = ethDetailTxRepo.UpdateTxTypeBySentHashTx(domainTx.TxTypeDone, sentHashTx)
+	rowsAffected, err = ethDetailTxRepo.UpdateTxTypeBySentHashTx(ctx, domainTx.TxTypeDone, sentHashTx)
 	require.NoError(t, err, "fail to call UpdateTxTypeBySentHashTx()")
 	require.GreaterOrEqual(t, rowsAffected, int64(1), "UpdateTxTypeBySentHashTx() should affect at least 1 row")
 
 	// Verify tx type update
-	verifyTx, err := ethDetailTxRepo.GetOne(retrievedTx.ID)
+	verifyTx, err := ethDetailTxRepo.GetOne(ctx, retrievedTx.ID)
 	require.NoError(t, err, "fail to call GetOne() after UpdateTxTypeBySentHashTx()")
 	require.Equal(t, domainTx.TxTypeDone.Int8(), verifyTx.CurrentTXType, "UpdateTxTypeBySentHashTx() should update CurrentTXType to TxTypeDone")
 
 	// Update tx type by ID
-	rowsAffected, err = ethDetailTxRepo.UpdateTxType(retrievedTx.ID, domainTx.TxTypeNotified)
+	rowsAffected, err = ethDetailTxRepo.UpdateTxType(ctx, retrievedTx.ID, domainTx.TxTypeNotified)
 	require.NoError(t, err, "fail to call UpdateTxType()")
 	require.Equal(t, int64(1), rowsAffected, "UpdateTxType() should affect 1 row")
 
 	// Verify final tx type
-	finalTx, err := ethDetailTxRepo.GetOne(retrievedTx.ID)
+	finalTx, err := ethDetailTxRepo.GetOne(ctx, retrievedTx.ID)
 	require.NoError(t, err, "fail to call GetOne() after UpdateTxType()")
 	require.Equal(t, domainTx.TxTypeNotified.Int8(), finalTx.CurrentTXType, "UpdateTxType() should update CurrentTXType to TxTypeNotified")
 
 	// Test InsertBulk
 	// Create another tx record for bulk insert
-	txID2, err := txRepo.InsertUnsignedTx(domainTx.ActionTypePayment)
+	txID2, err := txRepo.InsertUnsignedTx(ctx, domainTx.ActionTypePayment)
 	require.NoError(t, err, "fail to create second parent tx")
 
 	bulkTxs := []*models.EthDetailTX{
@@ -154,11 +158,11 @@ func TestEthDetailTxSqlc(t *testing.T) {
 		},
 	}
 
-	err = ethDetailTxRepo.InsertBulk(bulkTxs)
+	err = ethDetailTxRepo.InsertBulk(ctx, bulkTxs)
 	require.NoError(t, err, "fail to call InsertBulk()")
 
 	// Verify bulk insert
-	bulkRetrieved, err := ethDetailTxRepo.GetAllByTxID(txID2)
+	bulkRetrieved, err := ethDetailTxRepo.GetAllByTxID(ctx, txID2)
 	require.NoError(t, err, "fail to call GetAllByTxID() after InsertBulk()")
 	assert.Len(t, bulkRetrieved, 2, "InsertBulk() should insert 2 records")
 }
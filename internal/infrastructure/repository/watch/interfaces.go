@@ -28,5 +28,29 @@ type PaymentRequestRepositorier = persistence.PaymentRequestRepositorier
 // EthDetailTxRepositorier is EthDetailTxRepository interface
 type EthDetailTxRepositorier = persistence.EthDetailTxRepositorier
 
+// EthDepositRepositorier is EthDepositRepository interface
+type EthDepositRepositorier = persistence.EthDepositRepositorier
+
+// XrpDepositRepositorier is XrpDepositRepository interface
+type XrpDepositRepositorier = persistence.XrpDepositRepositorier
+
+// XrpEscrowRepositorier is XrpEscrowRepository interface
+type XrpEscrowRepositorier = persistence.XrpEscrowRepositorier
+
 // XrpDetailTxRepositorier is XrpDetailTxRepository interface
 type XrpDetailTxRepositorier = persistence.XrpDetailTxRepositorier
+
+// SolDetailTxRepositorier is SolDetailTxRepository interface
+type SolDetailTxRepositorier = persistence.SolDetailTxRepositorier
+
+// TrxDetailTxRepositorier is TrxDetailTxRepository interface
+type TrxDetailTxRepositorier = persistence.TrxDetailTxRepositorier
+
+// UnsignedTxRepositorier is implemented by transaction repository of each coin
+type UnsignedTxRepositorier = persistence.UnsignedTxRepositorier
+
+// DaemonJobRepositorier is DaemonJobRepository interface
+type DaemonJobRepositorier = persistence.DaemonJobRepositorier
+
+// StreamCursorRepositorier is StreamCursorRepository interface
+type StreamCursorRepositorier = persistence.StreamCursorRepositorier
@@ -0,0 +1,229 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/quagmt/udecimal"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// PaymentRequestRepositoryPostgres is repository for payment_request table using sqlc for PostgreSQL
+type PaymentRequestRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewPaymentRequestRepositoryPostgres returns PaymentRequestRepositoryPostgres object
+func NewPaymentRequestRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *PaymentRequestRepositoryPostgres {
+	return &PaymentRequestRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// WithTx returns PaymentRequestRepositoryPostgres which runs queries in database transaction
+func (r *PaymentRequestRepositoryPostgres) WithTx(dtx *sql.Tx) PaymentRequestRepositorier {
+	return &PaymentRequestRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dtx),
+		coinTypeCode: r.coinTypeCode,
+	}
+}
+
+// GetAll returns all records whose payment_id is null
+func (r *PaymentRequestRepositoryPostgres) GetAll(ctx context.Context) ([]*models.PaymentRequest, error) {
+	requests, err := r.queries.GetAllPaymentRequests(ctx, sqlcpg.PaymentRequestCoin(r.coinTypeCode.String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetAllPaymentRequests(): %w", err)
+	}
+
+	// Convert sqlc types to sqlboiler types
+	result := make([]*models.PaymentRequest, len(requests))
+	for i, req := range requests {
+		result[i] = convertPostgresPaymentRequestToModel(&req)
+	}
+
+	return result, nil
+}
+
+// CountPending returns number of payment requests which are not done
+func (r *PaymentRequestRepositoryPostgres) CountPending(ctx context.Context) (int64, error) {
+	count, err := r.queries.CountPendingPaymentRequests(ctx, sqlcpg.PaymentRequestCoin(r.coinTypeCode.String()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to call CountPendingPaymentRequests(): %w", err)
+	}
+
+	return count, nil
+}
+
+// GetAllByPaymentID returns all records searched by payment_id
+func (r *PaymentRequestRepositoryPostgres) GetAllByPaymentID(
+	ctx context.Context, paymentID int64,
+) ([]*models.PaymentRequest, error) {
+	requests, err := r.queries.GetPaymentRequestsByPaymentID(ctx, sqlcpg.GetPaymentRequestsByPaymentIDParams{
+		Coin:      sqlcpg.PaymentRequestCoin(r.coinTypeCode.String()),
+		PaymentID: sql.NullInt64{Int64: paymentID, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetPaymentRequestsByPaymentID(): %w", err)
+	}
+
+	// Convert sqlc types to sqlboiler types
+	result := make([]*models.PaymentRequest, len(requests))
+	for i, req := range requests {
+		result[i] = convertPostgresPaymentRequestToModel(&req)
+	}
+
+	return result, nil
+}
+
+// InsertBulk inserts multiple records
+func (r *PaymentRequestRepositoryPostgres) InsertBulk(ctx context.Context, items []*models.PaymentRequest) error {
+	for _, item := range items {
+		_, err := r.queries.InsertPaymentRequest(ctx, sqlcpg.InsertPaymentRequestParams{
+			Coin:            sqlcpg.PaymentRequestCoin(item.Coin),
+			PaymentID:       convertNullInt64ToSQLNullInt64(item.PaymentID),
+			SenderAddress:   item.SenderAddress,
+			SenderAccount:   item.SenderAccount,
+			ReceiverAddress: item.ReceiverAddress,
+			Amount:          item.Amount.String(),
+			IsDone:          item.IsDone,
+			UpdatedAt:       convertNullTimeToSQLNullTime(item.UpdatedAt),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to call InsertPaymentRequest(): %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UpdatePaymentID updates payment_id for multiple IDs
+func (r *PaymentRequestRepositoryPostgres) UpdatePaymentID(
+	ctx context.Context, paymentID int64, ids []int64,
+) (int64, error) {
+	var totalAffected int64
+
+	// sqlc doesn't support IN clauses with variable arguments,
+	// so we update one at a time
+	for _, id := range ids {
+		result, err := r.queries.UpdatePaymentRequestPaymentID(ctx, sqlcpg.UpdatePaymentRequestPaymentIDParams{
+			PaymentID: sql.NullInt64{Int64: paymentID, Valid: true},
+			ID:        id,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to call UpdatePaymentRequestPaymentID(): %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+		}
+		totalAffected += affected
+	}
+
+	return totalAffected, nil
+}
+
+// UpdateIsDone updates isDone
+func (r *PaymentRequestRepositoryPostgres) UpdateIsDone(ctx context.Context, paymentID int64) (int64, error) {
+	result, err := r.queries.UpdatePaymentRequestIsDone(ctx, sqlcpg.UpdatePaymentRequestIsDoneParams{
+		IsDone:    true,
+		Coin:      sqlcpg.PaymentRequestCoin(r.coinTypeCode.String()),
+		PaymentID: sql.NullInt64{Int64: paymentID, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdatePaymentRequestIsDone(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// ResetIsDone resets isDone to false when confirmed payment is rolled back
+func (r *PaymentRequestRepositoryPostgres) ResetIsDone(ctx context.Context, paymentID int64) (int64, error) {
+	result, err := r.queries.UpdatePaymentRequestIsDone(ctx, sqlcpg.UpdatePaymentRequestIsDoneParams{
+		IsDone:    false,
+		Coin:      sqlcpg.PaymentRequestCoin(r.coinTypeCode.String()),
+		PaymentID: sql.NullInt64{Int64: paymentID, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdatePaymentRequestIsDone(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// ResetPaymentID releases payment_request from transaction
+//   - released payment_request is picked up again by next payment transaction
+func (r *PaymentRequestRepositoryPostgres) ResetPaymentID(ctx context.Context, ids []int64) (int64, error) {
+	var totalAffected int64
+
+	// sqlc doesn't support IN clauses with variable arguments,
+	// so we update one at a time
+	for _, id := range ids {
+		result, err := r.queries.ResetPaymentRequestPaymentID(ctx, sqlcpg.ResetPaymentRequestPaymentIDParams{
+			Coin: sqlcpg.PaymentRequestCoin(r.coinTypeCode.String()),
+			ID:   id,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to call ResetPaymentRequestPaymentID(): %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+		}
+		totalAffected += affected
+	}
+
+	return totalAffected, nil
+}
+
+// DeleteAll deletes all records
+func (r *PaymentRequestRepositoryPostgres) DeleteAll(ctx context.Context) (int64, error) {
+	result, err := r.queries.DeleteAllPaymentRequests(ctx, sqlcpg.PaymentRequestCoin(r.coinTypeCode.String()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to call DeleteAllPaymentRequests(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions
+
+func convertPostgresPaymentRequestToModel(req *sqlcpg.PaymentRequest) *models.PaymentRequest {
+	amount, _ := udecimal.Parse(req.Amount)
+
+	return &models.PaymentRequest{
+		ID:              req.ID,
+		Coin:            string(req.Coin),
+		PaymentID:       convertSQLNullInt64ToNullInt64(req.PaymentID),
+		SenderAddress:   req.SenderAddress,
+		SenderAccount:   req.SenderAccount,
+		ReceiverAddress: req.ReceiverAddress,
+		Amount:          amount,
+		IsDone:          req.IsDone,
+		UpdatedAt:       convertSQLNullTimeToNullTime(req.UpdatedAt),
+	}
+}
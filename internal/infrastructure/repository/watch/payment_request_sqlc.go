@@ -24,15 +24,21 @@ func NewPaymentRequestRepositorySqlc(
 	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
 ) *PaymentRequestRepositorySqlc {
 	return &PaymentRequestRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
-// GetAll returns all records whose payment_id is null
-func (r *PaymentRequestRepositorySqlc) GetAll() ([]*models.PaymentRequest, error) {
-	ctx := context.Background()
+// WithTx returns PaymentRequestRepositorySqlc which runs queries in database transaction
+func (r *PaymentRequestRepositorySqlc) WithTx(dtx *sql.Tx) PaymentRequestRepositorier {
+	return &PaymentRequestRepositorySqlc{
+		queries:      sqlc.NewTraced(dtx),
+		coinTypeCode: r.coinTypeCode,
+	}
+}
 
+// GetAll returns all records whose payment_id is null
+func (r *PaymentRequestRepositorySqlc) GetAll(ctx context.Context) ([]*models.PaymentRequest, error) {
 	requests, err := r.queries.GetAllPaymentRequests(ctx, sqlc.PaymentRequestCoin(r.coinTypeCode.String()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetAllPaymentRequests(): %w", err)
@@ -47,10 +53,20 @@ func (r *PaymentRequestRepositorySqlc) GetAll() ([]*models.PaymentRequest, error
 	return result, nil
 }
 
-// GetAllByPaymentID returns all records searched by payment_id
-func (r *PaymentRequestRepositorySqlc) GetAllByPaymentID(paymentID int64) ([]*models.PaymentRequest, error) {
-	ctx := context.Background()
+// CountPending returns number of payment requests which are not done
+func (r *PaymentRequestRepositorySqlc) CountPending(ctx context.Context) (int64, error) {
+	count, err := r.queries.CountPendingPaymentRequests(ctx, sqlc.PaymentRequestCoin(r.coinTypeCode.String()))
+	if err != nil {
+		return 0, fmt.Errorf("failed to call CountPendingPaymentRequests(): %w", err)
+	}
+
+	return count, nil
+}
 
+// GetAllByPaymentID returns all records searched by payment_id
+func (r *PaymentRequestRepositorySqlc) GetAllByPaymentID(
+	ctx context.Context, paymentID int64,
+) ([]*models.PaymentRequest, error) {
 	requests, err := r.queries.GetPaymentRequestsByPaymentID(ctx, sqlc.GetPaymentRequestsByPaymentIDParams{
 		Coin:      sqlc.PaymentRequestCoin(r.coinTypeCode.String()),
 		PaymentID: sql.NullInt64{Int64: paymentID, Valid: true},
@@ -69,9 +85,7 @@ func (r *PaymentRequestRepositorySqlc) GetAllByPaymentID(paymentID int64) ([]*mo
 }
 
 // InsertBulk inserts multiple records
-func (r *PaymentRequestRepositorySqlc) InsertBulk(items []*models.PaymentRequest) error {
-	ctx := context.Background()
-
+func (r *PaymentRequestRepositorySqlc) InsertBulk(ctx context.Context, items []*models.PaymentRequest) error {
 	for _, item := range items {
 		_, err := r.queries.InsertPaymentRequest(ctx, sqlc.InsertPaymentRequestParams{
 			Coin:            sqlc.PaymentRequestCoin(item.Coin),
@@ -92,8 +106,9 @@ func (r *PaymentRequestRepositorySqlc) InsertBulk(items []*models.PaymentRequest
 }
 
 // UpdatePaymentID updates payment_id for multiple IDs
-func (r *PaymentRequestRepositorySqlc) UpdatePaymentID(paymentID int64, ids []int64) (int64, error) {
-	ctx := context.Background()
+func (r *PaymentRequestRepositorySqlc) UpdatePaymentID(
+	ctx context.Context, paymentID int64, ids []int64,
+) (int64, error) {
 	var totalAffected int64
 
 	// sqlc doesn't support IN clauses with variable arguments,
@@ -118,9 +133,7 @@ func (r *PaymentRequestRepositorySqlc) UpdatePaymentID(paymentID int64, ids []in
 }
 
 // UpdateIsDone updates isDone
-func (r *PaymentRequestRepositorySqlc) UpdateIsDone(paymentID int64) (int64, error) {
-	ctx := context.Background()
-
+func (r *PaymentRequestRepositorySqlc) UpdateIsDone(ctx context.Context, paymentID int64) (int64, error) {
 	result, err := r.queries.UpdatePaymentRequestIsDone(ctx, sqlc.UpdatePaymentRequestIsDoneParams{
 		IsDone:    true,
 		Coin:      sqlc.PaymentRequestCoin(r.coinTypeCode.String()),
@@ -138,10 +151,53 @@ func (r *PaymentRequestRepositorySqlc) UpdateIsDone(paymentID int64) (int64, err
 	return rowsAffected, nil
 }
 
-// DeleteAll deletes all records
-func (r *PaymentRequestRepositorySqlc) DeleteAll() (int64, error) {
-	ctx := context.Background()
+// ResetIsDone resets isDone to false when confirmed payment is rolled back
+func (r *PaymentRequestRepositorySqlc) ResetIsDone(ctx context.Context, paymentID int64) (int64, error) {
+	result, err := r.queries.UpdatePaymentRequestIsDone(ctx, sqlc.UpdatePaymentRequestIsDoneParams{
+		IsDone:    false,
+		Coin:      sqlc.PaymentRequestCoin(r.coinTypeCode.String()),
+		PaymentID: sql.NullInt64{Int64: paymentID, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdatePaymentRequestIsDone(): %w", err)
+	}
 
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// ResetPaymentID releases payment_request from transaction
+//   - released payment_request is picked up again by next payment transaction
+func (r *PaymentRequestRepositorySqlc) ResetPaymentID(ctx context.Context, ids []int64) (int64, error) {
+	var totalAffected int64
+
+	// sqlc doesn't support IN clauses with variable arguments,
+	// so we update one at a time
+	for _, id := range ids {
+		result, err := r.queries.ResetPaymentRequestPaymentID(ctx, sqlc.ResetPaymentRequestPaymentIDParams{
+			Coin: sqlc.PaymentRequestCoin(r.coinTypeCode.String()),
+			ID:   id,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to call ResetPaymentRequestPaymentID(): %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+		}
+		totalAffected += affected
+	}
+
+	return totalAffected, nil
+}
+
+// DeleteAll deletes all records
+func (r *PaymentRequestRepositorySqlc) DeleteAll(ctx context.Context) (int64, error) {
 	result, err := r.queries.DeleteAllPaymentRequests(ctx, sqlc.PaymentRequestCoin(r.coinTypeCode.String()))
 	if err != nil {
 		return 0, fmt.Errorf("failed to call DeleteAllPaymentRequests(): %w", err)
@@ -4,9 +4,12 @@
 package watchrepo_test
 
 import (
+	"context"
+	"slices"
 	"testing"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	"github.com/quagmt/udecimal"
 	"github.com/stretchr/testify/require"
 
@@ -16,10 +19,12 @@ import (
 
 // TestPaymentRequestSqlc is integration test for PaymentRequestRepositorySqlc
 func TestPaymentRequestSqlc(t *testing.T) {
+	ctx := context.Background()
+
 	paymentRepo := testutil.NewPaymentRequestRepositorySqlc()
 
 	// Delete all records
-	_, err := paymentRepo.DeleteAll()
+	_, err := paymentRepo.DeleteAll(ctx)
 	require.NoError(t, err, "fail to call DeleteAll()")
 
 	// Create test payment requests
@@ -44,35 +49,66 @@ func TestPaymentRequestSqlc(t *testing.T) {
 	}
 
 	// Insert bulk
-	err = paymentRepo.InsertBulk(requests)
+	err = paymentRepo.InsertBulk(ctx, requests)
 	require.NoError(t, err, "fail to call InsertBulk()")
 
 	// Get all
-	allRequests, err := paymentRepo.GetAll()
+	allRequests, err := paymentRepo.GetAll(ctx)
 	require.NoError(t, err, "fail to call GetAll()")
 	require.GreaterOrEqual(t, len(allRequests), 2, "GetAll() should return at least 2 requests")
 
 	// Update payment ID
 	paymentID := int64(12345)
 	ids := []int64{allRequests[0].ID, allRequests[1].ID}
-	rowsAffected, err := paymentRepo.UpdatePaymentID(paymentID, ids)
+	rowsAffected, err := paymentRepo.UpdatePaymentID(ctx, paymentID, ids)
 	require.NoError(t, err, "fail to call UpdatePaymentID()")
 	require.Equal(t, int64(2), rowsAffected, "UpdatePaymentID() should affect 2 rows")
 
 	// Get all by payment ID
-	requestsByPaymentID, err := paymentRepo.GetAllByPaymentID(paymentID)
+	requestsByPaymentID, err := paymentRepo.GetAllByPaymentID(ctx, paymentID)
 	require.NoError(t, err, "fail to call GetAllByPaymentID()")
 	require.Len(t, requestsByPaymentID, 2, "GetAllByPaymentID() should return 2 requests")
 
 	// Update is_done
-	rowsAffected, err = paymentRepo.UpdateIsDone(paymentID)
+	rowsAffected, err = paymentRepo.UpdateIsDone(ctx, paymentID)
 	require.NoError(t, err, "fail to call UpdateIsDone()")
 	require.Equal(t, int64(2), rowsAffected, "UpdateIsDone() should affect 2 rows")
 
 	// Verify is_done is true
-	verifyRequests, err := paymentRepo.GetAllByPaymentID(paymentID)
+	verifyRequests, err := paymentRepo.GetAllByPaymentID(ctx, paymentID)
 	require.NoError(t, err, "fail to call GetAllByPaymentID() after UpdateIsDone()")
 	for _, req := range verifyRequests {
 		require.True(t, req.IsDone, "UpdateIsDone() should set is_done to true for request ID %d", req.ID)
 	}
+
+	// Reset is_done when confirmed payment is rolled back by chain reorganization
+	rowsAffected, err = paymentRepo.ResetIsDone(ctx, paymentID)
+	require.NoError(t, err, "fail to call ResetIsDone()")
+	require.Equal(t, int64(2), rowsAffected, "ResetIsDone() should affect 2 rows")
+
+	// Verify is_done is false and payment ID is kept
+	verifyRequests, err = paymentRepo.GetAllByPaymentID(ctx, paymentID)
+	require.NoError(t, err, "fail to call GetAllByPaymentID() after ResetIsDone()")
+	require.Len(t, verifyRequests, 2, "ResetIsDone() should keep payment ID")
+	for _, req := range verifyRequests {
+		require.False(t, req.IsDone, "ResetIsDone() should set is_done to false for request ID %d", req.ID)
+	}
+
+	// Release payment_request from transaction which isn't sent
+	_, err = paymentRepo.UpdateIsDone(ctx, paymentID)
+	require.NoError(t, err, "fail to call UpdateIsDone() before ResetPaymentID()")
+	releasedID := verifyRequests[0].ID
+	rowsAffected, err = paymentRepo.ResetPaymentID(ctx, []int64{releasedID})
+	require.NoError(t, err, "fail to call ResetPaymentID()")
+	require.Equal(t, int64(1), rowsAffected, "ResetPaymentID() should affect 1 row")
+
+	// Verify released payment_request is pending again
+	verifyRequests, err = paymentRepo.GetAllByPaymentID(ctx, paymentID)
+	require.NoError(t, err, "fail to call GetAllByPaymentID() after ResetPaymentID()")
+	require.Len(t, verifyRequests, 1, "ResetPaymentID() should clear payment ID")
+	pendingRequests, err := paymentRepo.GetAll(ctx)
+	require.NoError(t, err, "fail to call GetAll() after ResetPaymentID()")
+	idx := slices.IndexFunc(pendingRequests, func(req *models.PaymentRequest) bool { return req.ID == releasedID })
+	require.NotEqual(t, -1, idx, "GetAll() should return released payment_request")
+	require.False(t, pendingRequests[idx].IsDone, "ResetPaymentID() should set is_done to false")
 }
@@ -0,0 +1,226 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null/v6"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// SolDetailTxRepositoryPostgres is repository for sol_detail_tx table using sqlc for PostgreSQL
+type SolDetailTxRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewSolDetailTxRepositoryPostgres returns SolDetailTxRepositoryPostgres object
+func NewSolDetailTxRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *SolDetailTxRepositoryPostgres {
+	return &SolDetailTxRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetOne get one record by ID
+func (r *SolDetailTxRepositoryPostgres) GetOne(ctx context.Context, id int64) (*models.SOLDetailTX, error) {
+	solTx, err := r.queries.GetSolDetailTxByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetSolDetailTxByID(): %w", err)
+	}
+
+	return convertPostgresSolDetailTxToModel(&solTx), nil
+}
+
+// GetActionBySentSignature returns action of tx which the record belongs to
+func (r *SolDetailTxRepositoryPostgres) GetActionBySentSignature(ctx context.Context, sentSignature string) (domainTx.ActionType, error) {
+	action, err := r.queries.GetSolDetailTxActionBySentSignature(ctx, sentSignature)
+	if err != nil {
+		return "", fmt.Errorf("failed to call GetSolDetailTxActionBySentSignature(): %w", err)
+	}
+
+	return domainTx.ActionType(action), nil
+}
+
+// GetAllByTxID returns all records searched by tx_id
+func (r *SolDetailTxRepositoryPostgres) GetAllByTxID(ctx context.Context, id int64) ([]*models.SOLDetailTX, error) {
+	solTxs, err := r.queries.GetSolDetailTxsByTxID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetSolDetailTxsByTxID(): %w", err)
+	}
+
+	result := make([]*models.SOLDetailTX, len(solTxs))
+	for i, solTx := range solTxs {
+		result[i] = convertPostgresSolDetailTxToModel(&solTx)
+	}
+
+	return result, nil
+}
+
+// GetSentSignatures returns list of sent_signature by txType
+func (r *SolDetailTxRepositoryPostgres) GetSentSignatures(ctx context.Context, txType domainTx.TxType) ([]string, error) {
+	signatures, err := r.queries.GetSolDetailTxSentSignatureList(ctx, sqlcpg.GetSolDetailTxSentSignatureListParams{
+		Coin:          sqlcpg.TxCoin(r.coinTypeCode.String()),
+		CurrentTxType: txType.Int8(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetSolDetailTxSentSignatureList(): %w", err)
+	}
+
+	return signatures, nil
+}
+
+// GetOldestUnsignedTime returns updated date of the oldest unsigned transaction
+// it's invalid if there is no unsigned transaction
+func (r *SolDetailTxRepositoryPostgres) GetOldestUnsignedTime(ctx context.Context) (null.Time, error) {
+	updatedAt, err := r.queries.GetSolDetailTxOldestUnsignedUpdatedAt(ctx, sqlcpg.GetSolDetailTxOldestUnsignedUpdatedAtParams{
+		Coin:          sqlcpg.TxCoin(r.coinTypeCode.String()),
+		CurrentTxType: domainTx.TxTypeUnsigned.Int8(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return null.Time{}, nil
+		}
+		return null.Time{}, fmt.Errorf("failed to call GetSolDetailTxOldestUnsignedUpdatedAt(): %w", err)
+	}
+
+	return convertSQLNullTimeToNullTime(updatedAt), nil
+}
+
+// Insert inserts one record
+func (r *SolDetailTxRepositoryPostgres) Insert(ctx context.Context, txItem *models.SOLDetailTX) error {
+	_, err := r.queries.InsertSolDetailTx(ctx, sqlcpg.InsertSolDetailTxParams{
+		TxID:              txItem.TXID,
+		Uuid:              txItem.UUID,
+		CurrentTxType:     txItem.CurrentTXType,
+		SenderAccount:     txItem.SenderAccount,
+		SenderAddress:     txItem.SenderAddress,
+		ReceiverAccount:   txItem.ReceiverAccount,
+		ReceiverAddress:   txItem.ReceiverAddress,
+		Amount:            txItem.Amount,
+		Fee:               txItem.Fee,
+		TokenMint:         txItem.TokenMint,
+		NonceAccount:      txItem.NonceAccount,
+		Nonce:             txItem.Nonce,
+		UnsignedTx:        txItem.UnsignedTX,
+		SignedTx:          txItem.SignedTX,
+		SentSignature:     txItem.SentSignature,
+		UnsignedUpdatedAt: convertNullTimeToSQLNullTime(txItem.UnsignedUpdatedAt),
+		SentUpdatedAt:     convertNullTimeToSQLNullTime(txItem.SentUpdatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertSolDetailTx(): %w", err)
+	}
+
+	return nil
+}
+
+// InsertBulk inserts multiple records
+func (r *SolDetailTxRepositoryPostgres) InsertBulk(ctx context.Context, txItems []*models.SOLDetailTX) error {
+	for _, item := range txItems {
+		if err := r.Insert(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateAfterTxSent updates when tx sent
+func (r *SolDetailTxRepositoryPostgres) UpdateAfterTxSent(
+	ctx context.Context, uuid string,
+	txType domainTx.TxType,
+	signedTx,
+	sentSignature string,
+) (int64, error) {
+	result, err := r.queries.UpdateSolDetailTxAfterSent(ctx, sqlcpg.UpdateSolDetailTxAfterSentParams{
+		CurrentTxType: txType.Int8(),
+		SignedTx:      signedTx,
+		SentSignature: sentSignature,
+		SentUpdatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		Uuid:          uuid,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateSolDetailTxAfterSent(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxType updates txType
+func (r *SolDetailTxRepositoryPostgres) UpdateTxType(
+	ctx context.Context, id int64, txType domainTx.TxType,
+) (int64, error) {
+	result, err := r.queries.UpdateSolDetailTxType(ctx, sqlcpg.UpdateSolDetailTxTypeParams{
+		CurrentTxType: txType.Int8(),
+		ID:            id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateSolDetailTxType(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxTypeBySentSignature updates txType
+func (r *SolDetailTxRepositoryPostgres) UpdateTxTypeBySentSignature(
+	ctx context.Context, txType domainTx.TxType, sentSignature string,
+) (int64, error) {
+	result, err := r.queries.UpdateSolDetailTxTypeBySentSignature(ctx, sqlcpg.UpdateSolDetailTxTypeBySentSignatureParams{
+		CurrentTxType: txType.Int8(),
+		SentSignature: sentSignature,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateSolDetailTxTypeBySentSignature(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions
+
+func convertPostgresSolDetailTxToModel(solTx *sqlcpg.SolDetailTx) *models.SOLDetailTX {
+	return &models.SOLDetailTX{
+		ID:                solTx.ID,
+		TXID:              solTx.TxID,
+		UUID:              solTx.Uuid,
+		CurrentTXType:     solTx.CurrentTxType,
+		SenderAccount:     solTx.SenderAccount,
+		SenderAddress:     solTx.SenderAddress,
+		ReceiverAccount:   solTx.ReceiverAccount,
+		ReceiverAddress:   solTx.ReceiverAddress,
+		Amount:            solTx.Amount,
+		Fee:               solTx.Fee,
+		TokenMint:         solTx.TokenMint,
+		NonceAccount:      solTx.NonceAccount,
+		Nonce:             solTx.Nonce,
+		UnsignedTX:        solTx.UnsignedTx,
+		SignedTX:          solTx.SignedTx,
+		SentSignature:     solTx.SentSignature,
+		UnsignedUpdatedAt: convertSQLNullTimeToNullTime(solTx.UnsignedUpdatedAt),
+		SentUpdatedAt:     convertSQLNullTimeToNullTime(solTx.SentUpdatedAt),
+	}
+}
@@ -0,0 +1,91 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlc"
+)
+
+// StreamCursorRepositorySqlc is repository for stream_cursor table using sqlc
+type StreamCursorRepositorySqlc struct {
+	queries      *sqlc.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewStreamCursorRepositorySqlc returns StreamCursorRepositorySqlc object
+func NewStreamCursorRepositorySqlc(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *StreamCursorRepositorySqlc {
+	return &StreamCursorRepositorySqlc{
+		queries:      sqlc.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetPosition returns last processed position of stream, 0 is returned if it's not stored yet
+func (r *StreamCursorRepositorySqlc) GetPosition(ctx context.Context, name string) (uint64, error) {
+	cursor, err := r.queries.GetStreamCursor(ctx, sqlc.GetStreamCursorParams{
+		Coin: sqlc.StreamCursorCoin(r.coinTypeCode.String()),
+		Name: name,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to call GetStreamCursor(): %w", err)
+	}
+
+	return cursor.Position, nil
+}
+
+// UpdatePosition inserts or updates last processed position of stream
+func (r *StreamCursorRepositorySqlc) UpdatePosition(ctx context.Context, name string, position uint64) error {
+	_, err := r.queries.UpsertStreamCursor(ctx, sqlc.UpsertStreamCursorParams{
+		Coin:      sqlc.StreamCursorCoin(r.coinTypeCode.String()),
+		Name:      name,
+		Position:  position,
+		UpdatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call UpsertStreamCursor(): %w", err)
+	}
+
+	return nil
+}
+
+// GetBlock returns last processed block number and hash of stream, 0 is returned if it's not stored yet
+func (r *StreamCursorRepositorySqlc) GetBlock(ctx context.Context, name string) (uint64, string, error) {
+	cursor, err := r.queries.GetStreamCursor(ctx, sqlc.GetStreamCursorParams{
+		Coin: sqlc.StreamCursorCoin(r.coinTypeCode.String()),
+		Name: name,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, "", nil
+		}
+		return 0, "", fmt.Errorf("failed to call GetStreamCursor(): %w", err)
+	}
+
+	return cursor.Position, cursor.BlockHash, nil
+}
+
+// UpdateBlock inserts or updates last processed block number and hash of stream
+func (r *StreamCursorRepositorySqlc) UpdateBlock(ctx context.Context, name string, position uint64, blockHash string) error {
+	_, err := r.queries.UpsertStreamCursorBlock(ctx, sqlc.UpsertStreamCursorBlockParams{
+		Coin:      sqlc.StreamCursorCoin(r.coinTypeCode.String()),
+		Name:      name,
+		Position:  position,
+		BlockHash: blockHash,
+		UpdatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call UpsertStreamCursorBlock(): %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null/v6"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlc"
+)
+
+// TrxDetailTxRepositorySqlc is repository for trx_detail_tx table using sqlc
+type TrxDetailTxRepositorySqlc struct {
+	queries      *sqlc.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewTrxDetailTxRepositorySqlc returns TrxDetailTxRepositorySqlc object
+func NewTrxDetailTxRepositorySqlc(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *TrxDetailTxRepositorySqlc {
+	return &TrxDetailTxRepositorySqlc{
+		queries:      sqlc.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// GetOne get one record by ID
+func (r *TrxDetailTxRepositorySqlc) GetOne(ctx context.Context, id int64) (*models.TRXDetailTX, error) {
+	trxTx, err := r.queries.GetTrxDetailTxByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetTrxDetailTxByID(): %w", err)
+	}
+
+	return convertSqlcTrxDetailTxToModel(&trxTx), nil
+}
+
+// GetActionBySentHashTx returns action of tx which the record belongs to
+func (r *TrxDetailTxRepositorySqlc) GetActionBySentHashTx(ctx context.Context, sentHashTx string) (domainTx.ActionType, error) {
+	action, err := r.queries.GetTrxDetailTxActionBySentHash(ctx, sentHashTx)
+	if err != nil {
+		return "", fmt.Errorf("failed to call GetTrxDetailTxActionBySentHash(): %w", err)
+	}
+
+	return domainTx.ActionType(action), nil
+}
+
+// GetAllByTxID returns all records searched by tx_id
+func (r *TrxDetailTxRepositorySqlc) GetAllByTxID(ctx context.Context, id int64) ([]*models.TRXDetailTX, error) {
+	trxTxs, err := r.queries.GetTrxDetailTxsByTxID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetTrxDetailTxsByTxID(): %w", err)
+	}
+
+	result := make([]*models.TRXDetailTX, len(trxTxs))
+	for i, trxTx := range trxTxs {
+		result[i] = convertSqlcTrxDetailTxToModel(&trxTx)
+	}
+
+	return result, nil
+}
+
+// GetSentHashTx returns list of sent_hash_tx by txType
+func (r *TrxDetailTxRepositorySqlc) GetSentHashTx(ctx context.Context, txType domainTx.TxType) ([]string, error) {
+	hashes, err := r.queries.GetTrxDetailTxSentHashList(ctx, sqlc.GetTrxDetailTxSentHashListParams{
+		Coin:          sqlc.TxCoin(r.coinTypeCode.String()),
+		CurrentTxType: txType.Int8(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetTrxDetailTxSentHashList(): %w", err)
+	}
+
+	return hashes, nil
+}
+
+// GetOldestUnsignedTime returns updated date of the oldest unsigned transaction
+// it's invalid if there is no unsigned transaction
+func (r *TrxDetailTxRepositorySqlc) GetOldestUnsignedTime(ctx context.Context) (null.Time, error) {
+	params := sqlc.GetTrxDetailTxOldestUnsignedUpdatedAtParams{
+		Coin:          sqlc.TxCoin(r.coinTypeCode.String()),
+		CurrentTxType: domainTx.TxTypeUnsigned.Int8(),
+	}
+	updatedAt, err := r.queries.GetTrxDetailTxOldestUnsignedUpdatedAt(ctx, params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return null.Time{}, nil
+		}
+		return null.Time{}, fmt.Errorf("failed to call GetTrxDetailTxOldestUnsignedUpdatedAt(): %w", err)
+	}
+
+	return convertSQLNullTimeToNullTime(updatedAt), nil
+}
+
+// Insert inserts one record
+func (r *TrxDetailTxRepositorySqlc) Insert(ctx context.Context, txItem *models.TRXDetailTX) error {
+	_, err := r.queries.InsertTrxDetailTx(ctx, sqlc.InsertTrxDetailTxParams{
+		TxID:              txItem.TXID,
+		Uuid:              txItem.UUID,
+		CurrentTxType:     txItem.CurrentTXType,
+		SenderAccount:     txItem.SenderAccount,
+		SenderAddress:     txItem.SenderAddress,
+		ReceiverAccount:   txItem.ReceiverAccount,
+		ReceiverAddress:   txItem.ReceiverAddress,
+		Amount:            txItem.Amount,
+		Fee:               txItem.Fee,
+		FeeLimit:          txItem.FeeLimit,
+		ContractAddress:   txItem.ContractAddress,
+		RefBlockNum:       txItem.RefBlockNum,
+		Expiration:        txItem.Expiration,
+		UnsignedHexTx:     txItem.UnsignedHexTX,
+		SignedHexTx:       txItem.SignedHexTX,
+		SentHashTx:        txItem.SentHashTX,
+		UnsignedUpdatedAt: convertNullTimeToSQLNullTime(txItem.UnsignedUpdatedAt),
+		SentUpdatedAt:     convertNullTimeToSQLNullTime(txItem.SentUpdatedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertTrxDetailTx(): %w", err)
+	}
+
+	return nil
+}
+
+// InsertBulk inserts multiple records
+func (r *TrxDetailTxRepositorySqlc) InsertBulk(ctx context.Context, txItems []*models.TRXDetailTX) error {
+	for _, item := range txItems {
+		if err := r.Insert(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateAfterTxSent updates when tx sent
+func (r *TrxDetailTxRepositorySqlc) UpdateAfterTxSent(
+	ctx context.Context, uuid string,
+	txType domainTx.TxType,
+	signedHex,
+	sentHashTx string,
+) (int64, error) {
+	result, err := r.queries.UpdateTrxDetailTxAfterSent(ctx, sqlc.UpdateTrxDetailTxAfterSentParams{
+		CurrentTxType: txType.Int8(),
+		SignedHexTx:   signedHex,
+		SentHashTx:    sentHashTx,
+		SentUpdatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		Uuid:          uuid,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateTrxDetailTxAfterSent(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxType updates txType
+func (r *TrxDetailTxRepositorySqlc) UpdateTxType(
+	ctx context.Context, id int64, txType domainTx.TxType,
+) (int64, error) {
+	result, err := r.queries.UpdateTrxDetailTxType(ctx, sqlc.UpdateTrxDetailTxTypeParams{
+		CurrentTxType: txType.Int8(),
+		ID:            id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateTrxDetailTxType(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxTypeBySentHashTx updates txType
+func (r *TrxDetailTxRepositorySqlc) UpdateTxTypeBySentHashTx(
+	ctx context.Context, txType domainTx.TxType, sentHashTx string,
+) (int64, error) {
+	result, err := r.queries.UpdateTrxDetailTxTypeBySentHash(ctx, sqlc.UpdateTrxDetailTxTypeBySentHashParams{
+		CurrentTxType: txType.Int8(),
+		SentHashTx:    sentHashTx,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateTrxDetailTxTypeBySentHash(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions
+
+func convertSqlcTrxDetailTxToModel(trxTx *sqlc.TrxDetailTx) *models.TRXDetailTX {
+	return &models.TRXDetailTX{
+		ID:                trxTx.ID,
+		TXID:              trxTx.TxID,
+		UUID:              trxTx.Uuid,
+		CurrentTXType:     trxTx.CurrentTxType,
+		SenderAccount:     trxTx.SenderAccount,
+		SenderAddress:     trxTx.SenderAddress,
+		ReceiverAccount:   trxTx.ReceiverAccount,
+		ReceiverAddress:   trxTx.ReceiverAddress,
+		Amount:            trxTx.Amount,
+		Fee:               trxTx.Fee,
+		FeeLimit:          trxTx.FeeLimit,
+		ContractAddress:   trxTx.ContractAddress,
+		RefBlockNum:       trxTx.RefBlockNum,
+		Expiration:        trxTx.Expiration,
+		UnsignedHexTX:     trxTx.UnsignedHexTx,
+		SignedHexTX:       trxTx.SignedHexTx,
+		SentHashTX:        trxTx.SentHashTx,
+		UnsignedUpdatedAt: convertSQLNullTimeToNullTime(trxTx.UnsignedUpdatedAt),
+		SentUpdatedAt:     convertSQLNullTimeToNullTime(trxTx.SentUpdatedAt),
+	}
+}
@@ -0,0 +1,120 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// TxRepositoryPostgres is repository for tx table using sqlc for PostgreSQL
+type TxRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewTxRepositoryPostgres returns TxRepositoryPostgres object
+func NewTxRepositoryPostgres(dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode) *TxRepositoryPostgres {
+	return &TxRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// WithTx returns TxRepositoryPostgres which runs queries in database transaction
+func (r *TxRepositoryPostgres) WithTx(dtx *sql.Tx) TxRepositorier {
+	return &TxRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dtx),
+		coinTypeCode: r.coinTypeCode,
+	}
+}
+
+// GetOne returns one record by ID
+func (r *TxRepositoryPostgres) GetOne(ctx context.Context, id int64) (*models.TX, error) {
+	tx, err := r.queries.GetTxByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetTxByID(): %w", err)
+	}
+
+	return convertPostgresTxToModel(&tx), nil
+}
+
+// GetMaxID returns max id
+func (r *TxRepositoryPostgres) GetMaxID(ctx context.Context, actionType domainTx.ActionType) (int64, error) {
+	result, err := r.queries.GetMaxTxID(ctx, sqlcpg.GetMaxTxIDParams{
+		Coin:   sqlcpg.TxCoin(r.coinTypeCode.String()),
+		Action: sqlcpg.TxAction(actionType.String()),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call GetMaxTxID(): %w", err)
+	}
+
+	if result == nil {
+		return 0, nil
+	}
+
+	// Convert interface{} to int64
+	if maxID, ok := result.(int64); ok {
+		return maxID, nil
+	}
+
+	return 0, nil
+}
+
+// InsertUnsignedTx inserts records
+func (r *TxRepositoryPostgres) InsertUnsignedTx(ctx context.Context, actionType domainTx.ActionType) (int64, error) {
+	id, err := r.queries.InsertTx(ctx, sqlcpg.InsertTxParams{
+		Coin:   sqlcpg.TxCoin(r.coinTypeCode.String()),
+		Action: sqlcpg.TxAction(actionType.String()),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call InsertTx(): %w", err)
+	}
+
+	return id, nil
+}
+
+// Update updates by models.Tx (entire update)
+func (r *TxRepositoryPostgres) Update(ctx context.Context, txItem *models.TX) (int64, error) {
+	err := r.queries.UpdateTx(ctx, sqlcpg.UpdateTxParams{
+		Coin:      sqlcpg.TxCoin(txItem.Coin),
+		Action:    sqlcpg.TxAction(txItem.Action),
+		UpdatedAt: convertNullTimeToSQLNullTime(txItem.UpdatedAt),
+		ID:        txItem.ID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateTx(): %w", err)
+	}
+
+	return 1, nil // sqlc Update doesn't return rows affected for :exec queries
+}
+
+// DeleteAll deletes all records
+func (r *TxRepositoryPostgres) DeleteAll(ctx context.Context) (int64, error) {
+	result, err := r.queries.DeleteAllTx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to call DeleteAllTx(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions
+
+func convertPostgresTxToModel(tx *sqlcpg.Tx) *models.TX {
+	return &models.TX{
+		ID:        tx.ID,
+		Coin:      string(tx.Coin),
+		Action:    string(tx.Action),
+		UpdatedAt: convertSQLNullTimeToNullTime(tx.UpdatedAt),
+	}
+}
@@ -20,15 +20,21 @@ type TxRepositorySqlc struct {
 // NewTxRepositorySqlc returns TxRepositorySqlc object
 func NewTxRepositorySqlc(dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode) *TxRepositorySqlc {
 	return &TxRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
-// GetOne returns one record by ID
-func (r *TxRepositorySqlc) GetOne(id int64) (*models.TX, error) {
-	ctx := context.Background()
+// WithTx returns TxRepositorySqlc which runs queries in database transaction
+func (r *TxRepositorySqlc) WithTx(dtx *sql.Tx) TxRepositorier {
+	return &TxRepositorySqlc{
+		queries:      sqlc.NewTraced(dtx),
+		coinTypeCode: r.coinTypeCode,
+	}
+}
 
+// GetOne returns one record by ID
+func (r *TxRepositorySqlc) GetOne(ctx context.Context, id int64) (*models.TX, error) {
 	tx, err := r.queries.GetTxByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetTxByID(): %w", err)
@@ -38,9 +44,7 @@ func (r *TxRepositorySqlc) GetOne(id int64) (*models.TX, error) {
 }
 
 // GetMaxID returns max id
-func (r *TxRepositorySqlc) GetMaxID(actionType domainTx.ActionType) (int64, error) {
-	ctx := context.Background()
-
+func (r *TxRepositorySqlc) GetMaxID(ctx context.Context, actionType domainTx.ActionType) (int64, error) {
 	result, err := r.queries.GetMaxTxID(ctx, sqlc.GetMaxTxIDParams{
 		Coin:   sqlc.TxCoin(r.coinTypeCode.String()),
 		Action: sqlc.TxAction(actionType.String()),
@@ -62,9 +66,7 @@ func (r *TxRepositorySqlc) GetMaxID(actionType domainTx.ActionType) (int64, erro
 }
 
 // InsertUnsignedTx inserts records
-func (r *TxRepositorySqlc) InsertUnsignedTx(actionType domainTx.ActionType) (int64, error) {
-	ctx := context.Background()
-
+func (r *TxRepositorySqlc) InsertUnsignedTx(ctx context.Context, actionType domainTx.ActionType) (int64, error) {
 	result, err := r.queries.InsertTx(ctx, sqlc.InsertTxParams{
 		Coin:   sqlc.TxCoin(r.coinTypeCode.String()),
 		Action: sqlc.TxAction(actionType.String()),
@@ -82,9 +84,7 @@ func (r *TxRepositorySqlc) InsertUnsignedTx(actionType domainTx.ActionType) (int
 }
 
 // Update updates by models.Tx (entire update)
-func (r *TxRepositorySqlc) Update(txItem *models.TX) (int64, error) {
-	ctx := context.Background()
-
+func (r *TxRepositorySqlc) Update(ctx context.Context, txItem *models.TX) (int64, error) {
 	err := r.queries.UpdateTx(ctx, sqlc.UpdateTxParams{
 		Coin:      sqlc.TxCoin(txItem.Coin),
 		Action:    sqlc.TxAction(txItem.Action),
@@ -99,9 +99,7 @@ func (r *TxRepositorySqlc) Update(txItem *models.TX) (int64, error) {
 }
 
 // DeleteAll deletes all records
-func (r *TxRepositorySqlc) DeleteAll() (int64, error) {
-	ctx := context.Background()
-
+func (r *TxRepositorySqlc) DeleteAll(ctx context.Context) (int64, error) {
 	result, err := r.queries.DeleteAllTx(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("failed to call DeleteAllTx(): %w", err)
@@ -0,0 +1,112 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// XrpDepositRepositoryPostgres is repository for xrp_deposit table using sqlc for PostgreSQL
+type XrpDepositRepositoryPostgres struct {
+	queries *sqlcpg.Queries
+}
+
+// NewXrpDepositRepositoryPostgres returns XrpDepositRepositoryPostgres object
+func NewXrpDepositRepositoryPostgres(dbConn *sql.DB) *XrpDepositRepositoryPostgres {
+	return &XrpDepositRepositoryPostgres{
+		queries: sqlcpg.NewTraced(dbConn),
+	}
+}
+
+// Insert inserts record, deposit which is already stored is ignored
+//   - number of inserted records is returned
+func (r *XrpDepositRepositoryPostgres) Insert(ctx context.Context, item *models.XRPDeposit) (int64, error) {
+	result, err := r.queries.InsertXrpDeposit(ctx, sqlcpg.InsertXrpDepositParams{
+		TxHash:          item.TXHash,
+		LedgerIndex:     item.LedgerIndex,
+		SenderAddress:   item.SenderAddress,
+		ReceiverAddress: item.ReceiverAddress,
+		DestinationTag:  convertNullInt64ToSQLNullInt64(item.DestinationTag),
+		ClientAddress:   item.ClientAddress,
+		Amount:          item.Amount,
+		Currency:        item.Currency,
+		Issuer:          item.Issuer,
+		IsQuarantined:   item.IsQuarantined,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call InsertXrpDeposit(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// GetOne returns one record by id
+func (r *XrpDepositRepositoryPostgres) GetOne(ctx context.Context, id int64) (*models.XRPDeposit, error) {
+	deposit, err := r.queries.GetXrpDepositByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpDepositByID(): %w", err)
+	}
+
+	return convertPostgresXrpDepositToModel(&deposit), nil
+}
+
+// GetAllQuarantined returns all deposits whose client is not identified
+func (r *XrpDepositRepositoryPostgres) GetAllQuarantined(ctx context.Context) ([]*models.XRPDeposit, error) {
+	deposits, err := r.queries.GetXrpDepositsQuarantined(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpDepositsQuarantined(): %w", err)
+	}
+
+	result := make([]*models.XRPDeposit, len(deposits))
+	for i := range deposits {
+		result[i] = convertPostgresXrpDepositToModel(&deposits[i])
+	}
+
+	return result, nil
+}
+
+// ResolveQuarantine attributes quarantined deposit to client
+//   - number of updated records is returned, 0 if deposit isn't quarantined
+func (r *XrpDepositRepositoryPostgres) ResolveQuarantine(
+	ctx context.Context, id int64, clientAddress string,
+) (int64, error) {
+	result, err := r.queries.ResolveXrpDepositQuarantine(ctx, sqlcpg.ResolveXrpDepositQuarantineParams{
+		ClientAddress: clientAddress,
+		ID:            id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call ResolveXrpDepositQuarantine(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+func convertPostgresXrpDepositToModel(deposit *sqlcpg.XrpDeposit) *models.XRPDeposit {
+	return &models.XRPDeposit{
+		ID:              deposit.ID,
+		TXHash:          deposit.TxHash,
+		LedgerIndex:     deposit.LedgerIndex,
+		SenderAddress:   deposit.SenderAddress,
+		ReceiverAddress: deposit.ReceiverAddress,
+		DestinationTag:  convertSQLNullInt64ToNullInt64(deposit.DestinationTag),
+		ClientAddress:   deposit.ClientAddress,
+		Amount:          deposit.Amount,
+		Currency:        deposit.Currency,
+		Issuer:          deposit.Issuer,
+		IsQuarantined:   deposit.IsQuarantined,
+		CreatedAt:       convertSQLNullTimeToNullTime(deposit.CreatedAt),
+	}
+}
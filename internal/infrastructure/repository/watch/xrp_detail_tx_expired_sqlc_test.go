@@ -0,0 +1,95 @@
+//go:build integration
+// +build integration
+
+package watchrepo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/pkg/testutil"
+)
+
+// TestXrpDetailTxExpiredSqlc is integration test for unsigned xrp_detail_tx expired by LastLedgerSequence
+func TestXrpDetailTxExpiredSqlc(t *testing.T) {
+	ctx := context.Background()
+
+	txRepo := testutil.NewTxRepositorySqlc()
+	xrpDetailTxRepo := testutil.NewXrpDetailTxRepositorySqlc()
+
+	txID, err := txRepo.InsertUnsignedTx(ctx, domainTx.ActionTypePayment)
+	require.NoError(t, err, "fail to call InsertUnsignedTx()")
+
+	// sender is unique per run because records are not deleted
+	suffix := time.Now().UnixNano()
+	sender := fmt.Sprintf("rExpiredSender-%d", suffix)
+	rows := []struct {
+		lastLedgerSequence uint64
+		txType             domainTx.TxType
+	}{
+		{lastLedgerSequence: 100, txType: domainTx.TxTypeUnsigned}, // expired
+		{lastLedgerSequence: 150, txType: domainTx.TxTypeUnsigned}, // validated ledger doesn't pass it yet
+		{lastLedgerSequence: 200, txType: domainTx.TxTypeUnsigned},
+		{lastLedgerSequence: 0, txType: domainTx.TxTypeUnsigned}, // never expires
+		{lastLedgerSequence: 100, txType: domainTx.TxTypeSent},
+		{lastLedgerSequence: 100, txType: domainTx.TxTypeExpired},
+	}
+	for i, row := range rows {
+		err = xrpDetailTxRepo.Insert(ctx, &models.XRPDetailTX{
+			TXID:               txID,
+			UUID:               fmt.Sprintf("xrp-uuid-expired-%d-%d", suffix, i),
+			CurrentTXType:      row.txType.Int8(),
+			SenderAccount:      "payment",
+			SenderAddress:      sender,
+			ReceiverAccount:    "client",
+			ReceiverAddress:    "rExpiredReceiver",
+			Amount:             "1000000",
+			XRPTXType:          "Payment",
+			Fee:                "12",
+			LastLedgerSequence: row.lastLedgerSequence,
+			Sequence:           100 + uint64(i),
+			RetryCount:         1,
+			PaymentRequestID:   int64(i + 1),
+		})
+		require.NoError(t, err, "fail to call Insert()")
+	}
+
+	getExpired := func(ledgerIndex uint64) []*models.XRPDetailTX {
+		xrpTxs, err := xrpDetailTxRepo.GetExpired(ctx, ledgerIndex)
+		require.NoError(t, err, "fail to call GetExpired()")
+		var result []*models.XRPDetailTX
+		for _, xrpTx := range xrpTxs {
+			if xrpTx.SenderAddress == sender {
+				result = append(result, xrpTx)
+			}
+		}
+		return result
+	}
+
+	expired := getExpired(150)
+	require.Len(t, expired, 1, "GetExpired() should return only unsigned tx whose LastLedgerSequence is older")
+	require.Equal(t, uint64(100), expired[0].LastLedgerSequence)
+	require.Equal(t, uint32(1), expired[0].RetryCount, "GetExpired() should return RetryCount")
+	require.Equal(t, int64(1), expired[0].PaymentRequestID, "GetExpired() should return PaymentRequestID")
+
+	// expired transaction is updated only once
+	affected, err := xrpDetailTxRepo.UpdateTxTypeFrom(ctx, expired[0].ID, domainTx.TxTypeUnsigned, domainTx.TxTypeExpired)
+	require.NoError(t, err, "fail to call UpdateTxTypeFrom()")
+	require.Equal(t, int64(1), affected, "UpdateTxTypeFrom() should update unsigned tx")
+	affected, err = xrpDetailTxRepo.UpdateTxTypeFrom(ctx, expired[0].ID, domainTx.TxTypeUnsigned, domainTx.TxTypeExpired)
+	require.NoError(t, err, "fail to call UpdateTxTypeFrom() twice")
+	require.Equal(t, int64(0), affected, "UpdateTxTypeFrom() should not update expired tx")
+
+	expired = getExpired(201)
+	require.Len(t, expired, 2, "GetExpired() should return unsigned tx passed by validated ledger")
+	require.Equal(t, uint64(150), expired[0].LastLedgerSequence)
+	require.Equal(t, uint64(200), expired[1].LastLedgerSequence)
+}
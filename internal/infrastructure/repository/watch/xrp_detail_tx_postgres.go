@@ -0,0 +1,341 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/guregu/null/v6"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlcpg"
+)
+
+// XrpDetailTxInputRepositoryPostgres is repository for xrp_detail_tx table using sqlc for PostgreSQL
+type XrpDetailTxInputRepositoryPostgres struct {
+	queries      *sqlcpg.Queries
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewXrpDetailTxInputRepositoryPostgres returns XrpDetailTxInputRepositoryPostgres object
+func NewXrpDetailTxInputRepositoryPostgres(
+	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
+) *XrpDetailTxInputRepositoryPostgres {
+	return &XrpDetailTxInputRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dbConn),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// WithTx returns XrpDetailTxInputRepositoryPostgres which runs queries in database transaction
+func (r *XrpDetailTxInputRepositoryPostgres) WithTx(dtx *sql.Tx) XrpDetailTxRepositorier {
+	return &XrpDetailTxInputRepositoryPostgres{
+		queries:      sqlcpg.NewTraced(dtx),
+		coinTypeCode: r.coinTypeCode,
+	}
+}
+
+// GetOne get one record by ID
+func (r *XrpDetailTxInputRepositoryPostgres) GetOne(ctx context.Context, id int64) (*models.XRPDetailTX, error) {
+	xrpTx, err := r.queries.GetXrpDetailTxByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpDetailTxByID(): %w", err)
+	}
+
+	return convertPostgresXrpDetailTxToModel(&xrpTx), nil
+}
+
+// GetOneByUUID returns one record by uuid
+func (r *XrpDetailTxInputRepositoryPostgres) GetOneByUUID(ctx context.Context, uuid string) (*models.XRPDetailTX, error) {
+	xrpTx, err := r.queries.GetXrpDetailTxByUUID(ctx, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpDetailTxByUUID(): %w", err)
+	}
+
+	return convertPostgresXrpDetailTxToModel(&xrpTx), nil
+}
+
+// GetActionBySignedTxID returns action of tx which the record belongs to
+func (r *XrpDetailTxInputRepositoryPostgres) GetActionBySignedTxID(ctx context.Context, signedTxID string) (domainTx.ActionType, error) {
+	action, err := r.queries.GetXrpDetailTxActionBySignedTxID(ctx, signedTxID)
+	if err != nil {
+		return "", fmt.Errorf("failed to call GetXrpDetailTxActionBySignedTxID(): %w", err)
+	}
+
+	return domainTx.ActionType(action), nil
+}
+
+// GetAllByTxID returns all records searched by tx_id
+func (r *XrpDetailTxInputRepositoryPostgres) GetAllByTxID(ctx context.Context, id int64) ([]*models.XRPDetailTX, error) {
+	xrpTxs, err := r.queries.GetXrpDetailTxsByTxID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpDetailTxsByTxID(): %w", err)
+	}
+
+	result := make([]*models.XRPDetailTX, len(xrpTxs))
+	for i, xrpTx := range xrpTxs {
+		result[i] = convertPostgresXrpDetailTxToModel(&xrpTx)
+	}
+
+	return result, nil
+}
+
+// GetSentHashTx returns list of tx_blob by txType
+func (r *XrpDetailTxInputRepositoryPostgres) GetSentHashTx(ctx context.Context, txType domainTx.TxType) ([]string, error) {
+	blobs, err := r.queries.GetXrpDetailTxBlobList(ctx, sqlcpg.GetXrpDetailTxBlobListParams{
+		Coin:          sqlcpg.TxCoin(r.coinTypeCode.String()),
+		CurrentTxType: txType.Int8(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpDetailTxBlobList(): %w", err)
+	}
+
+	return blobs, nil
+}
+
+// GetTicketSequences returns ticket sequences assigned to transactions of sender address
+//   - canceled or expired transaction doesn't hold ticket
+func (r *XrpDetailTxInputRepositoryPostgres) GetTicketSequences(ctx context.Context, senderAddress string) ([]uint64, error) {
+	tickets, err := r.queries.GetXrpDetailTxTicketSequences(ctx, sqlcpg.GetXrpDetailTxTicketSequencesParams{
+		SenderAddress:   senderAddress,
+		CurrentTxType:   domainTx.TxTypeCancel.Int8(),
+		CurrentTxType_2: domainTx.TxTypeExpired.Int8(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpDetailTxTicketSequences(): %w", err)
+	}
+
+	return tickets, nil
+}
+
+// GetExpired returns unsigned transactions whose LastLedgerSequence is older than validated ledger index
+//   - transaction is never validated once validated ledger passes LastLedgerSequence
+func (r *XrpDetailTxInputRepositoryPostgres) GetExpired(
+	ctx context.Context, ledgerIndex uint64,
+) ([]*models.XRPDetailTX, error) {
+	xrpTxs, err := r.queries.GetXrpDetailTxsExpired(ctx, sqlcpg.GetXrpDetailTxsExpiredParams{
+		CurrentTxType:      domainTx.TxTypeUnsigned.Int8(),
+		LastLedgerSequence: ledgerIndex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpDetailTxsExpired(): %w", err)
+	}
+
+	result := make([]*models.XRPDetailTX, len(xrpTxs))
+	for i, xrpTx := range xrpTxs {
+		result[i] = convertPostgresXrpDetailTxToModel(&xrpTx)
+	}
+
+	return result, nil
+}
+
+// GetOldestUnsignedTime returns updated date of the oldest unsigned transaction
+// it's invalid if there is no unsigned transaction
+func (r *XrpDetailTxInputRepositoryPostgres) GetOldestUnsignedTime(ctx context.Context) (null.Time, error) {
+	updatedAt, err := r.queries.GetXrpDetailTxOldestUnsignedUpdatedAt(ctx, sqlcpg.GetXrpDetailTxOldestUnsignedUpdatedAtParams{
+		Coin:          sqlcpg.TxCoin(r.coinTypeCode.String()),
+		CurrentTxType: domainTx.TxTypeUnsigned.Int8(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return null.Time{}, nil
+		}
+		return null.Time{}, fmt.Errorf("failed to call GetXrpDetailTxOldestUnsignedUpdatedAt(): %w", err)
+	}
+
+	return convertSQLNullTimeToNullTime(updatedAt), nil
+}
+
+// Insert inserts one record
+func (r *XrpDetailTxInputRepositoryPostgres) Insert(ctx context.Context, txItem *models.XRPDetailTX) error {
+	_, err := r.queries.InsertXrpDetailTx(ctx, sqlcpg.InsertXrpDetailTxParams{
+		TxID:                  txItem.TXID,
+		Uuid:                  txItem.UUID,
+		CurrentTxType:         txItem.CurrentTXType,
+		SenderAccount:         txItem.SenderAccount,
+		SenderAddress:         txItem.SenderAddress,
+		ReceiverAccount:       txItem.ReceiverAccount,
+		ReceiverAddress:       txItem.ReceiverAddress,
+		Amount:                txItem.Amount,
+		Currency:              txItem.Currency,
+		Issuer:                txItem.Issuer,
+		XrpTxType:             txItem.XRPTXType,
+		Fee:                   txItem.Fee,
+		Flags:                 txItem.Flags,
+		LastLedgerSequence:    txItem.LastLedgerSequence,
+		Sequence:              txItem.Sequence,
+		TicketSequence:        txItem.TicketSequence,
+		SigningPubkey:         txItem.SigningPubkey,
+		TxnSignature:          txItem.TXNSignature,
+		Hash:                  txItem.Hash,
+		EarliestLedgerVersion: txItem.EarliestLedgerVersion,
+		SignedTxID:            txItem.SignedTXID,
+		TxBlob:                txItem.TXBlob,
+		SentUpdatedAt:         convertNullTimeToSQLNullTime(txItem.SentUpdatedAt),
+		RetryCount:            txItem.RetryCount,
+		PaymentRequestID:      txItem.PaymentRequestID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertXrpDetailTx(): %w", err)
+	}
+
+	return nil
+}
+
+// InsertBulk inserts multiple records
+func (r *XrpDetailTxInputRepositoryPostgres) InsertBulk(ctx context.Context, txItems []*models.XRPDetailTX) error {
+	for _, item := range txItems {
+		if err := r.Insert(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateAfterTxSent updates when tx sent
+func (r *XrpDetailTxInputRepositoryPostgres) UpdateAfterTxSent(
+	ctx context.Context, uuid string,
+	txType domainTx.TxType,
+	signedTxID,
+	txBlob string,
+	earlistLedgerVersion uint64,
+) (int64, error) {
+	result, err := r.queries.UpdateXrpDetailTxAfterSent(ctx, sqlcpg.UpdateXrpDetailTxAfterSentParams{
+		CurrentTxType:         txType.Int8(),
+		SignedTxID:            signedTxID,
+		TxBlob:                txBlob,
+		EarliestLedgerVersion: earlistLedgerVersion,
+		SentUpdatedAt:         sql.NullTime{Time: time.Now(), Valid: true},
+		Uuid:                  uuid,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXrpDetailTxAfterSent(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxType updates txType
+func (r *XrpDetailTxInputRepositoryPostgres) UpdateTxType(
+	ctx context.Context, id int64, txType domainTx.TxType,
+) (int64, error) {
+	result, err := r.queries.UpdateXrpDetailTxType(ctx, sqlcpg.UpdateXrpDetailTxTypeParams{
+		CurrentTxType: txType.Int8(),
+		ID:            id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXrpDetailTxType(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxTypeFrom updates txType only if current txType is `from`
+//   - 0 is returned when txType was already changed by another process
+func (r *XrpDetailTxInputRepositoryPostgres) UpdateTxTypeFrom(
+	ctx context.Context, id int64, from, to domainTx.TxType,
+) (int64, error) {
+	result, err := r.queries.UpdateXrpDetailTxTypeFrom(ctx, sqlcpg.UpdateXrpDetailTxTypeFromParams{
+		CurrentTxType:   to.Int8(),
+		ID:              id,
+		CurrentTxType_2: from.Int8(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXrpDetailTxTypeFrom(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateTxTypeBySentHashTx updates txType by tx_blob
+func (r *XrpDetailTxInputRepositoryPostgres) UpdateTxTypeBySentHashTx(
+	ctx context.Context, txType domainTx.TxType, sentHashTx string,
+) (int64, error) {
+	result, err := r.queries.UpdateXrpDetailTxTypeBySentHash(ctx, sqlcpg.UpdateXrpDetailTxTypeBySentHashParams{
+		CurrentTxType: txType.Int8(),
+		TxBlob:        sentHashTx, // sentHashTx is actually tx_blob for XRP
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXrpDetailTxTypeBySentHash(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateSentTxTypeBySignedTxID updates txType of sent transaction by signed_tx_id (transaction hash)
+func (r *XrpDetailTxInputRepositoryPostgres) UpdateSentTxTypeBySignedTxID(
+	ctx context.Context, txType domainTx.TxType, signedTxID string,
+) (int64, error) {
+	result, err := r.queries.UpdateXrpDetailTxTypeBySignedTxID(ctx, sqlcpg.UpdateXrpDetailTxTypeBySignedTxIDParams{
+		CurrentTxType:   txType.Int8(),
+		SignedTxID:      signedTxID,
+		CurrentTxType_2: domainTx.TxTypeSent.Int8(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXrpDetailTxTypeBySignedTxID(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions
+
+func convertPostgresXrpDetailTxToModel(xrpTx *sqlcpg.XrpDetailTx) *models.XRPDetailTX {
+	return &models.XRPDetailTX{
+		ID:                    xrpTx.ID,
+		TXID:                  xrpTx.TxID,
+		UUID:                  xrpTx.Uuid,
+		CurrentTXType:         xrpTx.CurrentTxType,
+		SenderAccount:         xrpTx.SenderAccount,
+		SenderAddress:         xrpTx.SenderAddress,
+		ReceiverAccount:       xrpTx.ReceiverAccount,
+		ReceiverAddress:       xrpTx.ReceiverAddress,
+		Amount:                xrpTx.Amount,
+		Currency:              xrpTx.Currency,
+		Issuer:                xrpTx.Issuer,
+		XRPTXType:             xrpTx.XrpTxType,
+		Fee:                   xrpTx.Fee,
+		Flags:                 xrpTx.Flags,
+		LastLedgerSequence:    xrpTx.LastLedgerSequence,
+		Sequence:              xrpTx.Sequence,
+		TicketSequence:        xrpTx.TicketSequence,
+		SigningPubkey:         xrpTx.SigningPubkey,
+		TXNSignature:          xrpTx.TxnSignature,
+		Hash:                  xrpTx.Hash,
+		EarliestLedgerVersion: xrpTx.EarliestLedgerVersion,
+		SignedTXID:            xrpTx.SignedTxID,
+		TXBlob:                xrpTx.TxBlob,
+		SentUpdatedAt:         convertSQLNullTimeToNullTime(xrpTx.SentUpdatedAt),
+		RetryCount:            xrpTx.RetryCount,
+		PaymentRequestID:      xrpTx.PaymentRequestID,
+	}
+}
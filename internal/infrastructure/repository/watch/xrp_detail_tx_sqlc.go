@@ -3,9 +3,12 @@ package watch
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/guregu/null/v6"
+
 	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
 	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
 	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
@@ -23,15 +26,21 @@ func NewXrpDetailTxInputRepositorySqlc(
 	dbConn *sql.DB, coinTypeCode domainCoin.CoinTypeCode,
 ) *XrpDetailTxInputRepositorySqlc {
 	return &XrpDetailTxInputRepositorySqlc{
-		queries:      sqlc.New(dbConn),
+		queries:      sqlc.NewTraced(dbConn),
 		coinTypeCode: coinTypeCode,
 	}
 }
 
-// GetOne get one record by ID
-func (r *XrpDetailTxInputRepositorySqlc) GetOne(id int64) (*models.XRPDetailTX, error) {
-	ctx := context.Background()
+// WithTx returns XrpDetailTxInputRepositorySqlc which runs queries in database transaction
+func (r *XrpDetailTxInputRepositorySqlc) WithTx(dtx *sql.Tx) XrpDetailTxRepositorier {
+	return &XrpDetailTxInputRepositorySqlc{
+		queries:      sqlc.NewTraced(dtx),
+		coinTypeCode: r.coinTypeCode,
+	}
+}
 
+// GetOne get one record by ID
+func (r *XrpDetailTxInputRepositorySqlc) GetOne(ctx context.Context, id int64) (*models.XRPDetailTX, error) {
 	xrpTx, err := r.queries.GetXrpDetailTxByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetXrpDetailTxByID(): %w", err)
@@ -40,10 +49,28 @@ func (r *XrpDetailTxInputRepositorySqlc) GetOne(id int64) (*models.XRPDetailTX,
 	return convertSqlcXrpDetailTxToModel(&xrpTx), nil
 }
 
-// GetAllByTxID returns all records searched by tx_id
-func (r *XrpDetailTxInputRepositorySqlc) GetAllByTxID(id int64) ([]*models.XRPDetailTX, error) {
-	ctx := context.Background()
+// GetOneByUUID returns one record by uuid
+func (r *XrpDetailTxInputRepositorySqlc) GetOneByUUID(ctx context.Context, uuid string) (*models.XRPDetailTX, error) {
+	xrpTx, err := r.queries.GetXrpDetailTxByUUID(ctx, uuid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpDetailTxByUUID(): %w", err)
+	}
 
+	return convertSqlcXrpDetailTxToModel(&xrpTx), nil
+}
+
+// GetActionBySignedTxID returns action of tx which the record belongs to
+func (r *XrpDetailTxInputRepositorySqlc) GetActionBySignedTxID(ctx context.Context, signedTxID string) (domainTx.ActionType, error) {
+	action, err := r.queries.GetXrpDetailTxActionBySignedTxID(ctx, signedTxID)
+	if err != nil {
+		return "", fmt.Errorf("failed to call GetXrpDetailTxActionBySignedTxID(): %w", err)
+	}
+
+	return domainTx.ActionType(action), nil
+}
+
+// GetAllByTxID returns all records searched by tx_id
+func (r *XrpDetailTxInputRepositorySqlc) GetAllByTxID(ctx context.Context, id int64) ([]*models.XRPDetailTX, error) {
 	xrpTxs, err := r.queries.GetXrpDetailTxsByTxID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call GetXrpDetailTxsByTxID(): %w", err)
@@ -58,9 +85,7 @@ func (r *XrpDetailTxInputRepositorySqlc) GetAllByTxID(id int64) ([]*models.XRPDe
 }
 
 // GetSentHashTx returns list of tx_blob by txType
-func (r *XrpDetailTxInputRepositorySqlc) GetSentHashTx(txType domainTx.TxType) ([]string, error) {
-	ctx := context.Background()
-
+func (r *XrpDetailTxInputRepositorySqlc) GetSentHashTx(ctx context.Context, txType domainTx.TxType) ([]string, error) {
 	blobs, err := r.queries.GetXrpDetailTxBlobList(ctx, sqlc.GetXrpDetailTxBlobListParams{
 		Coin:          sqlc.TxCoin(r.coinTypeCode.String()),
 		CurrentTxType: txType.Int8(),
@@ -72,10 +97,61 @@ func (r *XrpDetailTxInputRepositorySqlc) GetSentHashTx(txType domainTx.TxType) (
 	return blobs, nil
 }
 
-// Insert inserts one record
-func (r *XrpDetailTxInputRepositorySqlc) Insert(txItem *models.XRPDetailTX) error {
-	ctx := context.Background()
+// GetTicketSequences returns ticket sequences assigned to transactions of sender address
+//   - canceled or expired transaction doesn't hold ticket
+func (r *XrpDetailTxInputRepositorySqlc) GetTicketSequences(ctx context.Context, senderAddress string) ([]uint64, error) {
+	tickets, err := r.queries.GetXrpDetailTxTicketSequences(ctx, sqlc.GetXrpDetailTxTicketSequencesParams{
+		SenderAddress:   senderAddress,
+		CurrentTxType:   domainTx.TxTypeCancel.Int8(),
+		CurrentTxType_2: domainTx.TxTypeExpired.Int8(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpDetailTxTicketSequences(): %w", err)
+	}
+
+	return tickets, nil
+}
+
+// GetExpired returns unsigned transactions whose LastLedgerSequence is older than validated ledger index
+//   - transaction is never validated once validated ledger passes LastLedgerSequence
+func (r *XrpDetailTxInputRepositorySqlc) GetExpired(
+	ctx context.Context, ledgerIndex uint64,
+) ([]*models.XRPDetailTX, error) {
+	xrpTxs, err := r.queries.GetXrpDetailTxsExpired(ctx, sqlc.GetXrpDetailTxsExpiredParams{
+		CurrentTxType:      domainTx.TxTypeUnsigned.Int8(),
+		LastLedgerSequence: ledgerIndex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpDetailTxsExpired(): %w", err)
+	}
+
+	result := make([]*models.XRPDetailTX, len(xrpTxs))
+	for i, xrpTx := range xrpTxs {
+		result[i] = convertSqlcXrpDetailTxToModel(&xrpTx)
+	}
+
+	return result, nil
+}
+
+// GetOldestUnsignedTime returns updated date of the oldest unsigned transaction
+// it's invalid if there is no unsigned transaction
+func (r *XrpDetailTxInputRepositorySqlc) GetOldestUnsignedTime(ctx context.Context) (null.Time, error) {
+	updatedAt, err := r.queries.GetXrpDetailTxOldestUnsignedUpdatedAt(ctx, sqlc.GetXrpDetailTxOldestUnsignedUpdatedAtParams{
+		Coin:          sqlc.TxCoin(r.coinTypeCode.String()),
+		CurrentTxType: domainTx.TxTypeUnsigned.Int8(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return null.Time{}, nil
+		}
+		return null.Time{}, fmt.Errorf("failed to call GetXrpDetailTxOldestUnsignedUpdatedAt(): %w", err)
+	}
 
+	return convertSQLNullTimeToNullTime(updatedAt), nil
+}
+
+// Insert inserts one record
+func (r *XrpDetailTxInputRepositorySqlc) Insert(ctx context.Context, txItem *models.XRPDetailTX) error {
 	_, err := r.queries.InsertXrpDetailTx(ctx, sqlc.InsertXrpDetailTxParams{
 		TxID:                  txItem.TXID,
 		Uuid:                  txItem.UUID,
@@ -85,11 +161,14 @@ func (r *XrpDetailTxInputRepositorySqlc) Insert(txItem *models.XRPDetailTX) erro
 		ReceiverAccount:       txItem.ReceiverAccount,
 		ReceiverAddress:       txItem.ReceiverAddress,
 		Amount:                txItem.Amount,
+		Currency:              txItem.Currency,
+		Issuer:                txItem.Issuer,
 		XrpTxType:             txItem.XRPTXType,
 		Fee:                   txItem.Fee,
 		Flags:                 txItem.Flags,
 		LastLedgerSequence:    txItem.LastLedgerSequence,
 		Sequence:              txItem.Sequence,
+		TicketSequence:        txItem.TicketSequence,
 		SigningPubkey:         txItem.SigningPubkey,
 		TxnSignature:          txItem.TXNSignature,
 		Hash:                  txItem.Hash,
@@ -97,6 +176,8 @@ func (r *XrpDetailTxInputRepositorySqlc) Insert(txItem *models.XRPDetailTX) erro
 		SignedTxID:            txItem.SignedTXID,
 		TxBlob:                txItem.TXBlob,
 		SentUpdatedAt:         convertNullTimeToSQLNullTime(txItem.SentUpdatedAt),
+		RetryCount:            txItem.RetryCount,
+		PaymentRequestID:      txItem.PaymentRequestID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to call InsertXrpDetailTx(): %w", err)
@@ -106,9 +187,9 @@ func (r *XrpDetailTxInputRepositorySqlc) Insert(txItem *models.XRPDetailTX) erro
 }
 
 // InsertBulk inserts multiple records
-func (r *XrpDetailTxInputRepositorySqlc) InsertBulk(txItems []*models.XRPDetailTX) error {
+func (r *XrpDetailTxInputRepositorySqlc) InsertBulk(ctx context.Context, txItems []*models.XRPDetailTX) error {
 	for _, item := range txItems {
-		if err := r.Insert(item); err != nil {
+		if err := r.Insert(ctx, item); err != nil {
 			return err
 		}
 	}
@@ -117,14 +198,12 @@ func (r *XrpDetailTxInputRepositorySqlc) InsertBulk(txItems []*models.XRPDetailT
 
 // UpdateAfterTxSent updates when tx sent
 func (r *XrpDetailTxInputRepositorySqlc) UpdateAfterTxSent(
-	uuid string,
+	ctx context.Context, uuid string,
 	txType domainTx.TxType,
 	signedTxID,
 	txBlob string,
 	earlistLedgerVersion uint64,
 ) (int64, error) {
-	ctx := context.Background()
-
 	result, err := r.queries.UpdateXrpDetailTxAfterSent(ctx, sqlc.UpdateXrpDetailTxAfterSentParams{
 		CurrentTxType:         txType.Int8(),
 		SignedTxID:            signedTxID,
@@ -146,9 +225,9 @@ func (r *XrpDetailTxInputRepositorySqlc) UpdateAfterTxSent(
 }
 
 // UpdateTxType updates txType
-func (r *XrpDetailTxInputRepositorySqlc) UpdateTxType(id int64, txType domainTx.TxType) (int64, error) {
-	ctx := context.Background()
-
+func (r *XrpDetailTxInputRepositorySqlc) UpdateTxType(
+	ctx context.Context, id int64, txType domainTx.TxType,
+) (int64, error) {
 	result, err := r.queries.UpdateXrpDetailTxType(ctx, sqlc.UpdateXrpDetailTxTypeParams{
 		CurrentTxType: txType.Int8(),
 		ID:            id,
@@ -165,12 +244,32 @@ func (r *XrpDetailTxInputRepositorySqlc) UpdateTxType(id int64, txType domainTx.
 	return rowsAffected, nil
 }
 
+// UpdateTxTypeFrom updates txType only if current txType is `from`
+//   - 0 is returned when txType was already changed by another process
+func (r *XrpDetailTxInputRepositorySqlc) UpdateTxTypeFrom(
+	ctx context.Context, id int64, from, to domainTx.TxType,
+) (int64, error) {
+	result, err := r.queries.UpdateXrpDetailTxTypeFrom(ctx, sqlc.UpdateXrpDetailTxTypeFromParams{
+		CurrentTxType:   to.Int8(),
+		ID:              id,
+		CurrentTxType_2: from.Int8(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXrpDetailTxTypeFrom(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
 // UpdateTxTypeBySentHashTx updates txType by tx_blob
 func (r *XrpDetailTxInputRepositorySqlc) UpdateTxTypeBySentHashTx(
-	txType domainTx.TxType, sentHashTx string,
+	ctx context.Context, txType domainTx.TxType, sentHashTx string,
 ) (int64, error) {
-	ctx := context.Background()
-
 	result, err := r.queries.UpdateXrpDetailTxTypeBySentHash(ctx, sqlc.UpdateXrpDetailTxTypeBySentHashParams{
 		CurrentTxType: txType.Int8(),
 		TxBlob:        sentHashTx, // sentHashTx is actually tx_blob for XRP
@@ -187,6 +286,27 @@ func (r *XrpDetailTxInputRepositorySqlc) UpdateTxTypeBySentHashTx(
 	return rowsAffected, nil
 }
 
+// UpdateSentTxTypeBySignedTxID updates txType of sent transaction by signed_tx_id (transaction hash)
+func (r *XrpDetailTxInputRepositorySqlc) UpdateSentTxTypeBySignedTxID(
+	ctx context.Context, txType domainTx.TxType, signedTxID string,
+) (int64, error) {
+	result, err := r.queries.UpdateXrpDetailTxTypeBySignedTxID(ctx, sqlc.UpdateXrpDetailTxTypeBySignedTxIDParams{
+		CurrentTxType:   txType.Int8(),
+		SignedTxID:      signedTxID,
+		CurrentTxType_2: domainTx.TxTypeSent.Int8(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXrpDetailTxTypeBySignedTxID(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
 // Helper functions
 
 func convertSqlcXrpDetailTxToModel(xrpTx *sqlc.XrpDetailTx) *models.XRPDetailTX {
@@ -200,11 +320,14 @@ func convertSqlcXrpDetailTxToModel(xrpTx *sqlc.XrpDetailTx) *models.XRPDetailTX
 		ReceiverAccount:       xrpTx.ReceiverAccount,
 		ReceiverAddress:       xrpTx.ReceiverAddress,
 		Amount:                xrpTx.Amount,
+		Currency:              xrpTx.Currency,
+		Issuer:                xrpTx.Issuer,
 		XRPTXType:             xrpTx.XrpTxType,
 		Fee:                   xrpTx.Fee,
 		Flags:                 xrpTx.Flags,
 		LastLedgerSequence:    xrpTx.LastLedgerSequence,
 		Sequence:              xrpTx.Sequence,
+		TicketSequence:        xrpTx.TicketSequence,
 		SigningPubkey:         xrpTx.SigningPubkey,
 		TXNSignature:          xrpTx.TxnSignature,
 		Hash:                  xrpTx.Hash,
@@ -212,5 +335,7 @@ func convertSqlcXrpDetailTxToModel(xrpTx *sqlc.XrpDetailTx) *models.XRPDetailTX
 		SignedTXID:            xrpTx.SignedTxID,
 		TXBlob:                xrpTx.TxBlob,
 		SentUpdatedAt:         convertSQLNullTimeToNullTime(xrpTx.SentUpdatedAt),
+		RetryCount:            xrpTx.RetryCount,
+		PaymentRequestID:      xrpTx.PaymentRequestID,
 	}
 }
@@ -4,11 +4,13 @@
 package watchrepo_test
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
 
 	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -24,6 +26,8 @@ import (
 
 // TestXrpDetailTxSqlc is integration test for XrpDetailTxInputRepositorySqlc
 func TestXrpDetailTxSqlc(t *testing.T) {
+	ctx := context.Background()
+
 	// Create XRP repositories
 	projPath := os.Getenv("GOPATH") + "/src/github.com/hiromaily/go-crypto-wallet"
 	confPath := projPath + "/data/config/xrp_watch.toml"
@@ -45,7 +49,7 @@ func TestXrpDetailTxSqlc(t *testing.T) {
 	_, _ = db.Exec("DELETE FROM tx WHERE coin = 'xrp'")
 
 	// Create a tx record first (xrp_detail_tx joins with tx table)
-	txID, err := txRepo.InsertUnsignedTx(domainTx.ActionTypePayment)
+	txID, err := txRepo.InsertUnsignedTx(ctx, domainTx.ActionTypePayment)
 	require.NoError(t, err, "fail to create parent tx")
 
 	// Create test xrp detail tx
@@ -73,16 +77,16 @@ func TestXrpDetailTxSqlc(t *testing.T) {
 	}
 
 	// Insert
-	err = xrpDetailTxRepo.Insert(xrpTx)
+	err = xrpDetailTxRepo.Insert(ctx, xrpTx)
 	require.NoError(t, err, "fail to call Insert()")
 
 	// Get all by tx ID
-	xrpTxs, err := xrpDetailTxRepo.GetAllByTxID(txID)
+	xrpTxs, err := xrpDetailTxRepo.GetAllByTxID(ctx, txID)
 	require.NoError(t, err, "fail to call GetAllByTxID()")
 	require.GreaterOrEqual(t, len(xrpTxs), 1, "GetAllByTxID() should return at least 1 record")
 
 	// Get one
-	retrievedTx, err := xrpDetailTxRepo.GetOne(xrpTxs[0].ID)
+	retrievedTx, err := xrpDetailTxRepo.GetOne(ctx, xrpTxs[0].ID)
 	require.NoError(t, err, "fail to call GetOne()")
 	require.Equal(t, uuid, retrievedTx.UUID, "GetOne() should return correct UUID")
 
@@ -90,12 +94,12 @@ func TestXrpDetailTxSqlc(t *testing.T) {
 	signedTxID := "signed-txid-sqlc"
 	txBlob := "tx-blob-sqlc"
 	earliestLedgerVersion := uint64(12340)
-	rowsAffected, err := xrpDetailTxRepo.UpdateAfterTxSent(uuid, domainTx.TxTypeSent, signedTxID, txBlob, earliestLedgerVersion)
+	rowsAffected, err := xrpDetailTxRepo.UpdateAfterTxSent(ctx, uuid, domainTx.TxTypeSent, signedTxID, txBlob, earliestLedgerVersion)
 	require.NoError(t, err, "fail to call UpdateAfterTxSent()")
 	require.GreaterOrEqual(t, rowsAffected, int64(1), "UpdateAfterTxSent() should affect at least 1 row")
 
 	// Verify update
-	updatedTx, err := xrpDetailTxRepo.GetOne(retrievedTx.ID)
+	updatedTx, err := xrpDetailTxRepo.GetOne(ctx, retrievedTx.ID)
 	require.NoError(t, err, "fail to call GetOne() after update")
 	require.Equal(t, signedTxID, updatedTx.SignedTXID, "UpdateAfterTxSent() should update SignedTXID")
 	require.Equal(t, txBlob, updatedTx.TXBlob, "UpdateAfterTxSent() should update TXBlob")
@@ -103,33 +107,33 @@ func TestXrpDetailTxSqlc(t *testing.T) {
 	require.Equal(t, earliestLedgerVersion, updatedTx.EarliestLedgerVersion, "UpdateAfterTxSent() should update EarliestLedgerVersion")
 
 	// Get sent hash tx (for XRP, this is tx_blob)
-	blobs, err := xrpDetailTxRepo.GetSentHashTx(domainTx.TxTypeSent)
+	blobs, err := xrpDetailTxRepo.GetSentHashTx(ctx, domainTx.TxTypeSent)
 	require.NoError(t, err, "fail to call GetSentHashTx()")
 	require.GreaterOrEqual(t, len(blobs), 1, "GetSentHashTx() should return at least 1 blob")
 
 	// Update tx type by sent hash tx (tx_blob)
-	rowsAffected, err = xrpDetailTxRepo.UpdateTxTypeBySentHashTx(domainTx.TxTypeDone, txBlob)
+	rowsAffected, err = xrpDetailTxRepo.UpdateTxTypeBySentHashTx(ctx, domainTx.TxTypeDone, txBlob)
 	require.NoError(t, err, "fail to call UpdateTxTypeBySentHashTx()")
 	require.GreaterOrEqual(t, rowsAffected, int64(1), "UpdateTxTypeBySentHashTx() should affect at least 1 row")
 
 	// Verify tx type update
-	verifyTx, err := xrpDetailTxRepo.GetOne(retrievedTx.ID)
+	verifyTx, err := xrpDetailTxRepo.GetOne(ctx, retrievedTx.ID)
 	require.NoError(t, err, "fail to call GetOne() after UpdateTxTypeBySentHashTx()")
 	require.Equal(t, domainTx.TxTypeDone.Int8(), verifyTx.CurrentTXType, "UpdateTxTypeBySentHashTx() should update CurrentTXType to TxTypeDone")
 
 	// Update tx type by ID
-	rowsAffected, err = xrpDetailTxRepo.UpdateTxType(retrievedTx.ID, domainTx.TxTypeNotified)
+	rowsAffected, err = xrpDetailTxRepo.UpdateTxType(ctx, retrievedTx.ID, domainTx.TxTypeNotified)
 	require.NoError(t, err, "fail to call UpdateTxType()")
 	require.Equal(t, int64(1), rowsAffected, "UpdateTxType() should affect 1 row")
 
 	// Verify final tx type
-	finalTx, err := xrpDetailTxRepo.GetOne(retrievedTx.ID)
+	finalTx, err := xrpDetailTxRepo.GetOne(ctx, retrievedTx.ID)
 	require.NoError(t, err, "fail to call GetOne() after UpdateTxType()")
 	require.Equal(t, domainTx.TxTypeNotified.Int8(), finalTx.CurrentTXType, "UpdateTxType() should update CurrentTXType to TxTypeNotified")
 
 	// Test InsertBulk
 	// Create another tx record for bulk insert
-	txID2, err := txRepo.InsertUnsignedTx(domainTx.ActionTypePayment)
+	txID2, err := txRepo.InsertUnsignedTx(ctx, domainTx.ActionTypePayment)
 	require.NoError(t, err, "fail to create second parent tx")
 
 	bulkTxs := []*models.XRPDetailTX{
@@ -177,11 +181,11 @@ func TestXrpDetailTxSqlc(t *testing.T) {
 		},
 	}
 
-	err = xrpDetailTxRepo.InsertBulk(bulkTxs)
+	err = xrpDetailTxRepo.InsertBulk(ctx, bulkTxs)
 	require.NoError(t, err, "fail to call InsertBulk()")
 
 	// Verify bulk insert
-	bulkRetrieved, err := xrpDetailTxRepo.GetAllByTxID(txID2)
+	bulkRetrieved, err := xrpDetailTxRepo.GetAllByTxID(ctx, txID2)
 	require.NoError(t, err, "fail to call GetAllByTxID() after InsertBulk()")
 	assert.Len(t, bulkRetrieved, 2, "InsertBulk() should insert 2 records")
 }
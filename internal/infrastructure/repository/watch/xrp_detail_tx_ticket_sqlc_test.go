@@ -0,0 +1,79 @@
+//go:build integration
+// +build integration
+
+package watchrepo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/pkg/testutil"
+)
+
+// TestXrpDetailTxTicketSqlc is integration test for tickets assigned to xrp_detail_tx
+//   - tickets of canceled or expired transactions are not regarded as assigned
+func TestXrpDetailTxTicketSqlc(t *testing.T) {
+	ctx := context.Background()
+
+	txRepo := testutil.NewTxRepositorySqlc()
+	xrpDetailTxRepo := testutil.NewXrpDetailTxRepositorySqlc()
+
+	txID, err := txRepo.InsertUnsignedTx(ctx, domainTx.ActionTypePayment)
+	require.NoError(t, err, "fail to call InsertUnsignedTx()")
+
+	// sender is unique per run because records are not deleted
+	suffix := time.Now().UnixNano()
+	sender := fmt.Sprintf("rTicketSender-%d", suffix)
+	rows := []struct {
+		ticket uint64
+		txType domainTx.TxType
+	}{
+		{ticket: 10, txType: domainTx.TxTypeUnsigned},
+		{ticket: 11, txType: domainTx.TxTypeSent},
+		{ticket: 12, txType: domainTx.TxTypeCancel},
+		{ticket: 13, txType: domainTx.TxTypeExpired},
+		{ticket: 0, txType: domainTx.TxTypeUnsigned}, // sequence is used
+	}
+	for i, row := range rows {
+		err = xrpDetailTxRepo.Insert(ctx, &models.XRPDetailTX{
+			TXID:            txID,
+			UUID:            fmt.Sprintf("xrp-uuid-ticket-%d-%d", suffix, i),
+			CurrentTXType:   row.txType.Int8(),
+			SenderAccount:   "payment",
+			SenderAddress:   sender,
+			ReceiverAccount: "client",
+			ReceiverAddress: "rTicketReceiver",
+			Amount:          "1000000",
+			XRPTXType:       "Payment",
+			Fee:             "12",
+			Sequence:        100 + uint64(i),
+			TicketSequence:  row.ticket,
+		})
+		require.NoError(t, err, "fail to call Insert()")
+	}
+
+	tickets, err := xrpDetailTxRepo.GetTicketSequences(ctx, sender)
+	require.NoError(t, err, "fail to call GetTicketSequences()")
+	require.ElementsMatch(t, []uint64{10, 11}, tickets, "GetTicketSequences() should exclude canceled or expired tx")
+
+	// ticket is released once transaction is canceled
+	xrpTxs, err := xrpDetailTxRepo.GetAllByTxID(ctx, txID)
+	require.NoError(t, err, "fail to call GetAllByTxID()")
+	for _, xrpTx := range xrpTxs {
+		if xrpTx.SenderAddress == sender && xrpTx.TicketSequence == 10 {
+			_, err = xrpDetailTxRepo.UpdateTxType(ctx, xrpTx.ID, domainTx.TxTypeCancel)
+			require.NoError(t, err, "fail to call UpdateTxType()")
+		}
+	}
+	tickets, err = xrpDetailTxRepo.GetTicketSequences(ctx, sender)
+	require.NoError(t, err, "fail to call GetTicketSequences() after UpdateTxType()")
+	require.Equal(t, []uint64{11}, tickets, "GetTicketSequences() should exclude canceled tx")
+}
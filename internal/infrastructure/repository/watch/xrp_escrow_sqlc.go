@@ -0,0 +1,128 @@
+package watch
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	models "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/models/rdb"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/sqlc"
+)
+
+// XrpEscrowRepositorySqlc is repository for xrp_escrow table using sqlc
+type XrpEscrowRepositorySqlc struct {
+	queries *sqlc.Queries
+}
+
+// NewXrpEscrowRepositorySqlc returns XrpEscrowRepositorySqlc object
+func NewXrpEscrowRepositorySqlc(dbConn *sql.DB) *XrpEscrowRepositorySqlc {
+	return &XrpEscrowRepositorySqlc{
+		queries: sqlc.NewTraced(dbConn),
+	}
+}
+
+// WithTx returns XrpEscrowRepositorySqlc which runs queries in database transaction
+func (r *XrpEscrowRepositorySqlc) WithTx(dtx *sql.Tx) XrpEscrowRepositorier {
+	return &XrpEscrowRepositorySqlc{
+		queries: sqlc.NewTraced(dtx),
+	}
+}
+
+// GetAllOpen returns escrows which are created or finishing ordered by finish_after
+func (r *XrpEscrowRepositorySqlc) GetAllOpen(ctx context.Context) ([]*models.XRPEscrow, error) {
+	escrows, err := r.queries.GetXrpEscrowsByStatus(ctx, sqlc.GetXrpEscrowsByStatusParams{
+		Status:   domainTx.EscrowStatusCreated.Int8(),
+		Status_2: domainTx.EscrowStatusFinishing.Int8(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call GetXrpEscrowsByStatus(): %w", err)
+	}
+
+	result := make([]*models.XRPEscrow, len(escrows))
+	for i, escrow := range escrows {
+		result[i] = convertSqlcXrpEscrowToModel(&escrow)
+	}
+
+	return result, nil
+}
+
+// Insert inserts record
+func (r *XrpEscrowRepositorySqlc) Insert(ctx context.Context, item *models.XRPEscrow) error {
+	_, err := r.queries.InsertXrpEscrow(ctx, sqlc.InsertXrpEscrowParams{
+		OwnerAddress:       item.OwnerAddress,
+		DestinationAddress: item.DestinationAddress,
+		Amount:             item.Amount,
+		OfferSequence:      item.OfferSequence,
+		FinishAfter:        item.FinishAfter,
+		CancelAfter:        convertNullTimeToSQLNullTime(item.CancelAfter),
+		CreateUuid:         item.CreateUUID,
+		Status:             domainTx.EscrowStatusCreated.Int8(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to call InsertXrpEscrow(): %w", err)
+	}
+
+	return nil
+}
+
+// UpdateFinishUUID links EscrowFinish or EscrowCancel to escrow and updates status to finishing
+func (r *XrpEscrowRepositorySqlc) UpdateFinishUUID(ctx context.Context, id int64, finishUUID string) (int64, error) {
+	result, err := r.queries.UpdateXrpEscrowFinishUUID(ctx, sqlc.UpdateXrpEscrowFinishUUIDParams{
+		FinishUuid: finishUUID,
+		Status:     domainTx.EscrowStatusFinishing.Int8(),
+		UpdatedAt:  sql.NullTime{Time: time.Now(), Valid: true},
+		ID:         id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXrpEscrowFinishUUID(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// UpdateStatus updates status
+func (r *XrpEscrowRepositorySqlc) UpdateStatus(
+	ctx context.Context, id int64, status domainTx.EscrowStatus,
+) (int64, error) {
+	result, err := r.queries.UpdateXrpEscrowStatus(ctx, sqlc.UpdateXrpEscrowStatusParams{
+		Status:    status.Int8(),
+		UpdatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:        id,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to call UpdateXrpEscrowStatus(): %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get RowsAffected(): %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Helper functions
+
+func convertSqlcXrpEscrowToModel(escrow *sqlc.XrpEscrow) *models.XRPEscrow {
+	return &models.XRPEscrow{
+		ID:                 escrow.ID,
+		OwnerAddress:       escrow.OwnerAddress,
+		DestinationAddress: escrow.DestinationAddress,
+		Amount:             escrow.Amount,
+		OfferSequence:      escrow.OfferSequence,
+		FinishAfter:        escrow.FinishAfter,
+		CancelAfter:        convertSQLNullTimeToNullTime(escrow.CancelAfter),
+		CreateUUID:         escrow.CreateUuid,
+		FinishUUID:         escrow.FinishUuid,
+		Status:             escrow.Status,
+		CreatedAt:          convertSQLNullTimeToNullTime(escrow.CreatedAt),
+		UpdatedAt:          convertSQLNullTimeToNullTime(escrow.UpdatedAt),
+	}
+}
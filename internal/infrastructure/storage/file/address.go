@@ -2,20 +2,24 @@ package file
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 // AddressFileRepositorier is address storage interface
 type AddressFileRepositorier interface {
 	CreateFilePath(accountType domainAccount.AccountType) string
 	ValidateFilePath(fileName string, accountType domainAccount.AccountType) error
-	ImportAddress(fileName string) ([]string, error)
+	ImportAddress(ctx context.Context, fileName string) ([]string, error)
 }
 
 // AddressFileRepository is repository to store pubkey as csv file
@@ -50,7 +54,10 @@ func (*AddressFileRepository) ValidateFilePath(fileName string, accountType doma
 }
 
 // ImportAddress import pubkey from csv file
-func (*AddressFileRepository) ImportAddress(fileName string) ([]string, error) {
+func (*AddressFileRepository) ImportAddress(ctx context.Context, fileName string) (_ []string, err error) {
+	_, span := tracer.Start(ctx, "file.ImportAddress", attribute.String("file.path", fileName))
+	defer tracer.End(span, &err)
+
 	file, err := os.Open(fileName) //nolint:gosec
 	if err != nil {
 		return nil, fmt.Errorf("os.Open(%s) error: %s", fileName, err)
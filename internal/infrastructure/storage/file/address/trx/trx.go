@@ -0,0 +1,96 @@
+package trx
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Tron address is 0x41 prefix + last 20 bytes of keccak256(public key) as same as ethereum address,
+// it's expressed as base58check which starts with `T`
+// https://developers.tron.network/docs/account#account-address-format
+
+// AddressPrefix is prefix byte of mainnet and testnet address
+const AddressPrefix byte = 0x41
+
+// AddressLength is length of address including prefix
+const AddressLength = 21
+
+// ErrInvalidAddress describes an error where address can't be decoded
+var ErrInvalidAddress = errors.New("invalid tron address")
+
+// Address is 21 bytes tron address
+type Address [AddressLength]byte
+
+// PubkeyToAddress returns address of public key
+func PubkeyToAddress(pubKey *ecdsa.PublicKey) Address {
+	var addr Address
+	addr[0] = AddressPrefix
+	copy(addr[1:], crypto.PubkeyToAddress(*pubKey).Bytes())
+	return addr
+}
+
+// AddressFromBase58 decodes base58check address
+func AddressFromBase58(s string) (Address, error) {
+	var addr Address
+	payload, version, err := base58.CheckDecode(s)
+	if err != nil {
+		return addr, fmt.Errorf("%w: %s: %w", ErrInvalidAddress, s, err)
+	}
+	if version != AddressPrefix || len(payload) != AddressLength-1 {
+		return addr, fmt.Errorf("%w: %s", ErrInvalidAddress, s)
+	}
+	addr[0] = version
+	copy(addr[1:], payload)
+	return addr, nil
+}
+
+// AddressFromHex decodes hex address which starts with `41`
+func AddressFromHex(s string) (Address, error) {
+	var addr Address
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return addr, fmt.Errorf("%w: %s: %w", ErrInvalidAddress, s, err)
+	}
+	return AddressFromBytes(b)
+}
+
+// AddressFromBytes converts 21 bytes to address
+func AddressFromBytes(b []byte) (Address, error) {
+	var addr Address
+	if len(b) != AddressLength || b[0] != AddressPrefix {
+		return addr, fmt.Errorf("%w: %x", ErrInvalidAddress, b)
+	}
+	copy(addr[:], b)
+	return addr, nil
+}
+
+// String returns base58check address
+func (a Address) String() string {
+	return base58.CheckEncode(a[1:], a[0])
+}
+
+// Hex returns hex address with `41` prefix
+func (a Address) Hex() string {
+	return hex.EncodeToString(a[:])
+}
+
+// Bytes returns 21 bytes of address
+func (a Address) Bytes() []byte {
+	return a[:]
+}
+
+// EVMBytes returns 20 bytes which is used as address type of smart contract
+func (a Address) EVMBytes() []byte {
+	return a[1:]
+}
+
+// IsZero returns true if address is not set
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
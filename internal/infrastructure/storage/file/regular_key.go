@@ -0,0 +1,102 @@
+package file
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
+)
+
+// RegularKeyFileRepositorier is storage interface of XRP regular key which is passed between keygen and watch wallet
+type RegularKeyFileRepositorier interface {
+	CreateFilePath(accountType domainAccount.AccountType, isValidated bool) string
+	WriteFile(ctx context.Context, fileName string, lines []string) error
+	ReadFile(ctx context.Context, fileName string) ([]string, error)
+}
+
+// RegularKeyFileRepository is repository to store regular key as csv file
+type RegularKeyFileRepository struct {
+	filePath string
+}
+
+// NewRegularKeyFileRepository returns RegularKeyFileRepository
+func NewRegularKeyFileRepository(filePath string) *RegularKeyFileRepository {
+	return &RegularKeyFileRepository{
+		filePath: filePath,
+	}
+}
+
+// CreateFilePath create file path for csv file
+// Format:
+//   - ./data/address/xrp/regularkey_payment_1534744535097796209.csv is pending key created by keygen wallet
+//   - ./data/address/xrp/regularkey_validated_payment_1534744535097796209.csv is key found in ledger by watch wallet
+func (r *RegularKeyFileRepository) CreateFilePath(accountType domainAccount.AccountType, isValidated bool) string {
+	ts := strconv.FormatInt(time.Now().UnixNano(), 10)
+	prefix := "regularkey"
+	if isValidated {
+		prefix = "regularkey_validated"
+	}
+
+	return fmt.Sprintf("%s%s_%s_%s.csv", r.filePath, prefix, accountType.String(), ts)
+}
+
+// WriteFile writes lines to csv file
+func (*RegularKeyFileRepository) WriteFile(ctx context.Context, fileName string, lines []string) (err error) {
+	_, span := tracer.Start(ctx, "file.WriteRegularKey", attribute.String("file.path", fileName))
+	defer tracer.End(span, &err)
+
+	file, err := os.Create(fileName) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("fail to call os.Create(%s): %w", fileName, err)
+	}
+
+	defer func() {
+		if cerr := file.Close(); cerr != nil {
+			err = fmt.Errorf("failed to close file: %w", cerr)
+		}
+	}()
+
+	writer := bufio.NewWriter(file)
+	for _, line := range lines {
+		if _, err = writer.WriteString(line + "\n"); err != nil {
+			return fmt.Errorf("fail to call writer.WriteString(%s): %w", fileName, err)
+		}
+	}
+	if err = writer.Flush(); err != nil {
+		return fmt.Errorf("fail to call writer.Flush(%s): %w", fileName, err)
+	}
+	return nil
+}
+
+// ReadFile reads lines from csv file
+func (*RegularKeyFileRepository) ReadFile(ctx context.Context, fileName string) (_ []string, err error) {
+	_, span := tracer.Start(ctx, "file.ReadRegularKey", attribute.String("file.path", fileName))
+	defer tracer.End(span, &err)
+
+	file, err := os.Open(fileName) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("os.Open(%s) error: %s", fileName, err)
+	}
+
+	defer func() {
+		if cerr := file.Close(); cerr != nil {
+			err = fmt.Errorf("failed to close file: %w", cerr)
+		}
+	}()
+
+	var lines []string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	return lines, nil
+}
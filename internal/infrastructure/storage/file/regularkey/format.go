@@ -0,0 +1,53 @@
+package regularkey
+
+import (
+	"errors"
+	"fmt"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+)
+
+// RegularKeyFormat is regular key csv format
+type RegularKeyFormat struct {
+	CoinTypeCode domainCoin.CoinTypeCode
+	AccountType  domainAccount.AccountType
+	Address      string
+	RegularKey   string
+}
+
+// CreateLine creates line for csv
+func CreateLine(
+	coinTypeCode domainCoin.CoinTypeCode, accountType domainAccount.AccountType, address, regularKey string,
+) string {
+	// 0: coinTypeCode
+	// 1: accountType
+	// 2: address
+	// 3: regularKey
+	return fmt.Sprintf("%s,%s,%s,%s", coinTypeCode.String(), accountType.String(), address, regularKey)
+}
+
+// ConvertLine converts line to RegularKeyFormat
+func ConvertLine(coinTypeCode domainCoin.CoinTypeCode, line []string) (*RegularKeyFormat, error) {
+	if len(line) != 4 {
+		return nil, errors.New("csv format is invalid")
+	}
+
+	// validate
+	if !domainCoin.IsCoinTypeCode(line[0]) || domainCoin.CoinTypeCode(line[0]) != coinTypeCode {
+		return nil, fmt.Errorf("coinTypeCode is invalid. got %s, want %s", line[0], coinTypeCode.String())
+	}
+	if !domainAccount.ValidateAccountType(line[1]) {
+		return nil, fmt.Errorf("account is invalid: %s", line[1])
+	}
+	if line[2] == "" || line[3] == "" {
+		return nil, errors.New("address and regular key are required")
+	}
+
+	return &RegularKeyFormat{
+		CoinTypeCode: domainCoin.CoinTypeCode(line[0]),
+		AccountType:  domainAccount.AccountType(line[1]),
+		Address:      line[2],
+		RegularKey:   line[3],
+	}, nil
+}
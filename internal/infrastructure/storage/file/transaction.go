@@ -2,6 +2,7 @@ package file
 
 import (
 	"bufio"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"os"
@@ -10,7 +11,10 @@ import (
 	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/pkg/tracer"
 )
 
 // TransactionFileRepositorier is file storager for tx info
@@ -21,14 +25,14 @@ type TransactionFileRepositorier interface {
 		filePath string,
 		expectedTxType domainTx.TxType,
 	) (domainTx.ActionType, domainTx.TxType, int64, int, error)
-	ReadFile(path string) (string, error)
-	ReadFileSlice(path string) ([]string, error)
-	WriteFile(path, hexTx string) (string, error)
-	WriteFileSlice(path string, data []string) (string, error)
+	ReadFile(ctx context.Context, path string) (string, error)
+	ReadFileSlice(ctx context.Context, path string) ([]string, error)
+	WriteFile(ctx context.Context, path, hexTx string) (string, error)
+	WriteFileSlice(ctx context.Context, path string, data []string) (string, error)
 
 	// PSBT-specific methods (BIP174)
-	ReadPSBTFile(path string) (string, error)
-	WritePSBTFile(path, psbtBase64 string) (string, error)
+	ReadPSBTFile(ctx context.Context, path string) (string, error)
+	WritePSBTFile(ctx context.Context, path, psbtBase64 string) (string, error)
 }
 
 // TransactionFileRepository is to store transaction info as csv file
@@ -138,7 +142,10 @@ func (r *TransactionFileRepository) ValidateFilePath(
 }
 
 // ReadFile read file
-func (*TransactionFileRepository) ReadFile(path string) (string, error) {
+func (*TransactionFileRepository) ReadFile(ctx context.Context, path string) (_ string, err error) {
+	_, span := tracer.Start(ctx, "file.ReadFile", attribute.String("file.path", path))
+	defer tracer.End(span, &err)
+
 	ret, err := os.ReadFile(path) //nolint:gosec
 	if err != nil {
 		return "", fmt.Errorf("fail to call os.ReadFile(%s): %w", path, err)
@@ -148,7 +155,10 @@ func (*TransactionFileRepository) ReadFile(path string) (string, error) {
 }
 
 // ReadFileSlice read file for slice
-func (*TransactionFileRepository) ReadFileSlice(path string) ([]string, error) {
+func (*TransactionFileRepository) ReadFileSlice(ctx context.Context, path string) (_ []string, err error) {
+	_, span := tracer.Start(ctx, "file.ReadFileSlice", attribute.String("file.path", path))
+	defer tracer.End(span, &err)
+
 	file, err := os.Open(path) //nolint:gosec
 	if err != nil {
 		return nil, fmt.Errorf("fail to open file: %s: %w", path, err)
@@ -173,7 +183,10 @@ func (*TransactionFileRepository) ReadFileSlice(path string) ([]string, error) {
 }
 
 // WriteFile write file
-func (r *TransactionFileRepository) WriteFile(path, hexTx string) (string, error) {
+func (r *TransactionFileRepository) WriteFile(ctx context.Context, path, hexTx string) (_ string, err error) {
+	_, span := tracer.Start(ctx, "file.WriteFile", attribute.String("file.path", path))
+	defer tracer.End(span, &err)
+
 	// crate directory if not exisiting
 	r.createDir(path)
 
@@ -181,7 +194,7 @@ func (r *TransactionFileRepository) WriteFile(path, hexTx string) (string, error
 	fileName := path + ts
 
 	byteTx := []byte(hexTx)
-	err := os.WriteFile(fileName, byteTx, 0o644)
+	err = os.WriteFile(fileName, byteTx, 0o644)
 	if err != nil {
 		return "", fmt.Errorf("fail to call os.WriteFile(%s): %w", fileName, err)
 	}
@@ -190,7 +203,12 @@ func (r *TransactionFileRepository) WriteFile(path, hexTx string) (string, error
 }
 
 // WriteFileSlice write slice to file
-func (r *TransactionFileRepository) WriteFileSlice(path string, data []string) (string, error) {
+func (r *TransactionFileRepository) WriteFileSlice(
+	ctx context.Context, path string, data []string,
+) (_ string, err error) {
+	_, span := tracer.Start(ctx, "file.WriteFileSlice", attribute.String("file.path", path))
+	defer tracer.End(span, &err)
+
 	// crate directory if not exisiting
 	r.createDir(path)
 
@@ -219,7 +237,10 @@ func (r *TransactionFileRepository) WriteFileSlice(path string, data []string) (
 }
 
 // ReadPSBTFile reads a base64-encoded PSBT from file
-func (r *TransactionFileRepository) ReadPSBTFile(path string) (string, error) {
+func (r *TransactionFileRepository) ReadPSBTFile(ctx context.Context, path string) (_ string, err error) {
+	_, span := tracer.Start(ctx, "file.ReadPSBTFile", attribute.String("file.path", path))
+	defer tracer.End(span, &err)
+
 	// Validate extension (case-insensitive)
 	if !strings.HasSuffix(strings.ToLower(path), ".psbt") {
 		return "", fmt.Errorf("invalid PSBT file extension: %s (expected .psbt)", path)
@@ -249,7 +270,10 @@ func (r *TransactionFileRepository) ReadPSBTFile(path string) (string, error) {
 }
 
 // WritePSBTFile writes a base64-encoded PSBT to file with .psbt extension
-func (*TransactionFileRepository) WritePSBTFile(path, psbtBase64 string) (string, error) {
+func (*TransactionFileRepository) WritePSBTFile(ctx context.Context, path, psbtBase64 string) (_ string, err error) {
+	_, span := tracer.Start(ctx, "file.WritePSBTFile", attribute.String("file.path", path))
+	defer tracer.End(span, &err)
+
 	// Validate base64 format
 	if _, err := base64.StdEncoding.DecodeString(psbtBase64); err != nil {
 		return "", fmt.Errorf("invalid base64 PSBT data: %w", err)
@@ -267,7 +291,7 @@ func (*TransactionFileRepository) WritePSBTFile(path, psbtBase64 string) (string
 
 	// Write base64 PSBT
 	bytePSBT := []byte(psbtBase64)
-	err := os.WriteFile(fileName, bytePSBT, 0o644)
+	err = os.WriteFile(fileName, bytePSBT, 0o644)
 	if err != nil {
 		return "", fmt.Errorf("failed to write PSBT file %s: %w", fileName, err)
 	}
@@ -1,6 +1,7 @@
 package file
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
@@ -273,7 +274,7 @@ func TestWritePSBTFile(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := NewTransactionFileRepository("")
-			fileName, err := repo.WritePSBTFile(tt.path, tt.psbtBase64)
+			fileName, err := repo.WritePSBTFile(context.Background(), tt.path, tt.psbtBase64)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("WritePSBTFile() error = %v, wantErr %v", err, tt.wantErr)
@@ -376,7 +377,7 @@ func TestReadPSBTFile(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := NewTransactionFileRepository("")
-			got, err := repo.ReadPSBTFile(tt.path)
+			got, err := repo.ReadPSBTFile(context.Background(), tt.path)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ReadPSBTFile() error = %v, wantErr %v", err, tt.wantErr)
@@ -443,7 +444,7 @@ func TestReadPSBTFilePathTraversal(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := NewTransactionFileRepository(tt.basePath)
-			got, err := repo.ReadPSBTFile(tt.readPath)
+			got, err := repo.ReadPSBTFile(context.Background(), tt.readPath)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ReadPSBTFile() error = %v, wantErr %v", err, tt.wantErr)
@@ -476,14 +477,14 @@ func TestPSBTRoundTrip(t *testing.T) {
 	path := repo.CreateFilePath(domainTx.ActionTypeDeposit, domainTx.TxTypeUnsigned, 8, 0)
 
 	// Write PSBT
-	fileName, err := repo.WritePSBTFile(path, psbtData)
+	fileName, err := repo.WritePSBTFile(context.Background(), path, psbtData)
 	if err != nil {
 		t.Fatalf("WritePSBTFile() error = %v", err)
 	}
 	defer func() { _ = os.Remove(fileName) }()
 
 	// Read PSBT back
-	gotData, err := repo.ReadPSBTFile(fileName)
+	gotData, err := repo.ReadPSBTFile(context.Background(), fileName)
 	if err != nil {
 		t.Fatalf("ReadPSBTFile() error = %v", err)
 	}
@@ -0,0 +1,81 @@
+package key
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/doge"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+)
+
+func TestBIP44GeneratorDogecoin(t *testing.T) {
+	t.Parallel()
+
+	// Test seed (for testing only, never use in production)
+	seed := []byte("test seed for bip44 key generation testing")
+
+	tests := []struct {
+		name           string
+		conf           *chaincfg.Params
+		accountType    domainAccount.AccountType
+		p2pkhPrefix    string
+		derivationPath string
+	}{
+		{
+			name:           "Dogecoin Mainnet Client",
+			conf:           &doge.MainNetParams,
+			accountType:    domainAccount.AccountTypeClient,
+			p2pkhPrefix:    "D",
+			derivationPath: "m/44'/3'/0'/0/0",
+		},
+		{
+			name:           "Dogecoin Testnet Deposit",
+			conf:           &doge.TestNet3Params,
+			accountType:    domainAccount.AccountTypeDeposit,
+			p2pkhPrefix:    "n",
+			derivationPath: "m/44'/1'/1'/0/0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			generator := NewBIP44Generator(domainCoin.DOGE, tt.conf)
+
+			assert.Equal(t, domainKey.KeyTypeBIP44, generator.KeyType(), "should return BIP44 key type")
+			assert.True(t, generator.SupportsAddressType(address.AddrTypeLegacy), "should support Legacy")
+
+			keys, err := generator.CreateKey(seed, tt.accountType, 0, 5)
+			require.NoError(t, err, "should generate keys without error")
+			require.Len(t, keys, 5, "should generate 5 keys")
+
+			for i, key := range keys {
+				assert.True(t, strings.HasPrefix(key.P2PKHAddr, tt.p2pkhPrefix),
+					"P2PKH address should start with %s, key %d: %s", tt.p2pkhPrefix, i, key.P2PKHAddr)
+				assert.Empty(t, key.P2SHSegWitAddr, "segwit address should be empty for key %d", i)
+				assert.Empty(t, key.Bech32Addr, "bech32 address should be empty for key %d", i)
+
+				// WIF and address are decoded as dogecoin
+				wif, err := btcutil.DecodeWIF(key.WIF)
+				require.NoError(t, err)
+				assert.True(t, wif.IsForNet(tt.conf), "WIF should be for dogecoin network, key %d", i)
+
+				addr, err := btcutil.DecodeAddress(key.P2PKHAddr, tt.conf)
+				require.NoError(t, err)
+				pkHash := btcutil.Hash160(wif.SerializePubKey())
+				assert.Equal(t, pkHash, addr.ScriptAddress(), "address should be derived from WIF, key %d", i)
+			}
+
+			assert.Equal(t, tt.derivationPath, generator.GetDerivationPath(tt.accountType, 0))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
 	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
 	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin/ltc"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
 )
 
@@ -37,6 +38,18 @@ func TestBIP84Generator(t *testing.T) {
 			conf:         &chaincfg.TestNet3Params,
 			accountType:  domainAccount.AccountTypeDeposit,
 		},
+		{
+			name:         "Litecoin Mainnet Client",
+			coinTypeCode: domainCoin.LTC,
+			conf:         &ltc.MainNetParams,
+			accountType:  domainAccount.AccountTypeClient,
+		},
+		{
+			name:         "Litecoin Regtest Deposit",
+			coinTypeCode: domainCoin.LTC,
+			conf:         &ltc.RegressionNetParams,
+			accountType:  domainAccount.AccountTypeDeposit,
+		},
 	}
 
 	for _, tt := range tests {
@@ -68,6 +81,10 @@ func TestBIP84Generator(t *testing.T) {
 					assert.Contains(t, key.Bech32Addr, "bc1", "Mainnet address should start with bc1, key %d", i)
 				} else if tt.conf == &chaincfg.TestNet3Params {
 					assert.Contains(t, key.Bech32Addr, "tb1", "Testnet address should start with tb1, key %d", i)
+				} else if tt.conf == &ltc.MainNetParams {
+					assert.Contains(t, key.Bech32Addr, "ltc1", "Litecoin address should start with ltc1, key %d", i)
+				} else if tt.conf == &ltc.RegressionNetParams {
+					assert.Contains(t, key.Bech32Addr, "rltc1", "Litecoin regtest address should start with rltc1, key %d", i)
 				}
 			}
 
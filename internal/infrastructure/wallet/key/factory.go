@@ -28,6 +28,14 @@ func (*Factory) CreateGenerator(
 		return nil, fmt.Errorf("invalid key type: %w", err)
 	}
 
+	// ed25519 key is derived by SLIP-0010 instead of BIP32
+	if coinTypeCode == domainCoin.SOL {
+		if keyType != domainKey.KeyTypeBIP44 {
+			return nil, fmt.Errorf("key type %s is not supported for %s", keyType, coinTypeCode)
+		}
+		return NewSLIP10Generator(coinTypeCode, conf), nil
+	}
+
 	switch keyType {
 	case domainKey.KeyTypeBIP44:
 		return NewBIP44Generator(coinTypeCode, conf), nil
@@ -20,6 +20,7 @@ import (
 	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
 	bchaddr "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address/bch"
+	trxaddr "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address/trx"
 	xrpaddr "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address/xrp"
 	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
 )
@@ -226,6 +227,12 @@ func (k *HDKey) createKeysWithIndex(
 		return nil, err
 	}
 
+	// EVM chain defined in config derives same key as Ethereum
+	coinTypeCode := k.coinTypeCode
+	if domainCoin.IsEVMChain(coinTypeCode) {
+		coinTypeCode = domainCoin.ETH
+	}
+
 	// Index
 	walletKeys := make([]domainKey.WalletKey, count)
 	for i := uint32(0); i < count; i++ {
@@ -243,8 +250,8 @@ func (k *HDKey) createKeysWithIndex(
 			return nil, loopErr
 		}
 
-		switch k.coinTypeCode {
-		case domainCoin.BTC, domainCoin.BCH:
+		switch coinTypeCode {
+		case domainCoin.BTC, domainCoin.BCH, domainCoin.LTC:
 			// WIF　(compressed: true) => bitcoin core expresses compressed address
 			var wif *btcutil.WIF
 			wif, loopErr = btcutil.NewWIF(privateKey, k.conf, true)
@@ -278,7 +285,30 @@ func (k *HDKey) createKeysWithIndex(
 				RedeemScript:   redeemScript,
 			}
 
-		case domainCoin.ETH:
+		case domainCoin.DOGE:
+			// segwit is not activated on dogecoin, only P2PKH address is generated
+			var wif *btcutil.WIF
+			wif, loopErr = btcutil.NewWIF(privateKey, k.conf, true)
+			if loopErr != nil {
+				return nil, loopErr
+			}
+
+			var strP2PKHAddr string
+			strP2PKHAddr, loopErr = k.getP2PKHAddr(privateKey)
+			if loopErr != nil {
+				return nil, loopErr
+			}
+
+			walletKeys[i] = domainKey.WalletKey{
+				WIF:            wif.String(),
+				P2PKHAddr:      strP2PKHAddr,
+				P2SHSegWitAddr: "",
+				Bech32Addr:     "",
+				TaprootAddr:    "",
+				FullPubKey:     getFullPubKey(privateKey, true),
+				RedeemScript:   "",
+			}
+		case domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE:
 			var ethAddr, ethPubKey, ethPrivKey string
 			ethAddr, ethPubKey, ethPrivKey, loopErr = k.ethAddrs(privateKey)
 			if loopErr != nil {
@@ -317,7 +347,23 @@ func (k *HDKey) createKeysWithIndex(
 				FullPubKey:     xrpPubKey,
 				RedeemScript:   "",
 			}
-		case domainCoin.LTC, domainCoin.ERC20, domainCoin.HYT:
+		case domainCoin.TRX:
+			var trxAddr, trxPubKey, trxPrivKey string
+			trxAddr, trxPubKey, trxPrivKey, loopErr = k.trxAddrs(privateKey)
+			if loopErr != nil {
+				return nil, loopErr
+			}
+
+			walletKeys[i] = domainKey.WalletKey{
+				WIF:            trxPrivKey,
+				P2PKHAddr:      trxAddr,
+				P2SHSegWitAddr: "",
+				Bech32Addr:     "",
+				TaprootAddr:    "",
+				FullPubKey:     trxPubKey,
+				RedeemScript:   "",
+			}
+		case domainCoin.SOL, domainCoin.ERC20, domainCoin.HYT:
 			return nil, fmt.Errorf("coinType[%s] is not implemented yet", k.coinTypeCode.String())
 		default:
 			return nil, fmt.Errorf("coinType[%s] is not implemented yet", k.coinTypeCode.String())
@@ -405,7 +451,19 @@ func (*HDKey) xrpAddrs(privKey *btcec.PrivateKey) (string, string, string, error
 	return address.String(), publicKey.String(), xrpHexPrivKey.String(), nil
 }
 
-// get Address(P2PKH) as string for BTC/BCH
+// trxAddrs returns base58check address, public key and private key as same format as ethereum
+// https://developers.tron.network/docs/account
+func (k *HDKey) trxAddrs(privKey *btcec.PrivateKey) (string, string, string, error) {
+	_, trxHexPubKey, trxHexPrivKey, err := k.ethAddrs(privKey)
+	if err != nil {
+		return "", "", "", err
+	}
+	address := trxaddr.PubkeyToAddress(privKey.PubKey().ToECDSA())
+
+	return address.String(), trxHexPubKey, trxHexPrivKey, nil
+}
+
+// get Address(P2PKH) as string for BTC/BCH/LTC/DOGE
 // P2PKH Address, Pay To PubKey Hash
 // https://bitcoin.org/en/glossary/p2pkh-address
 func (k *HDKey) getP2PKHAddr(privKey *btcec.PrivateKey) (string, error) {
@@ -419,11 +477,12 @@ func (k *HDKey) getP2PKHAddr(privKey *btcec.PrivateKey) (string, error) {
 	}
 
 	switch k.coinTypeCode {
-	case domainCoin.BTC:
+	case domainCoin.BTC, domainCoin.LTC, domainCoin.DOGE:
 		return p2PKHAddr.String(), nil
 	case domainCoin.BCH:
 		return k.getP2PKHAddrBCH(p2PKHAddr)
-	case domainCoin.LTC, domainCoin.ETH, domainCoin.XRP, domainCoin.ERC20, domainCoin.HYT:
+	case domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE, domainCoin.XRP,
+		domainCoin.SOL, domainCoin.TRX, domainCoin.ERC20, domainCoin.HYT:
 		return "", fmt.Errorf("getP2pkhAddr() is not implemented for %s", k.coinTypeCode)
 	default:
 		return "", fmt.Errorf("getP2pkhAddr() is not implemented for %s", k.coinTypeCode)
@@ -446,7 +505,7 @@ func (k *HDKey) getP2PKHAddrBCH(p2PKHAddr *btcutil.AddressPubKeyHash) (string, e
 }
 
 // getP2SHSegWitAddr get P2SH-SegWit address (P2SH nested SegWit) and redeemScript as string
-//   - it's for only BTC
+//   - it's for BTC and LTC
 //   - Though BCH would not require it, just in case
 //
 // FIXME: getting RedeemScript is not fixed yet
@@ -474,7 +533,7 @@ func (k *HDKey) getP2SHSegWitAddr(privKey *btcec.PrivateKey) (string, string, er
 
 	var strRedeemScript string // FIXME: not implemented yet
 	switch k.coinTypeCode {
-	case domainCoin.BTC:
+	case domainCoin.BTC, domainCoin.LTC:
 		btcAddress, addrErr := btcutil.NewAddressScriptHash(payToAddrScript, k.conf)
 		if addrErr != nil {
 			return "", "", fmt.Errorf("fail to call btcutil.NewAddressScriptHash(): %w", addrErr)
@@ -486,7 +545,8 @@ func (k *HDKey) getP2SHSegWitAddr(privKey *btcec.PrivateKey) (string, string, er
 			return "", "", fmt.Errorf("fail to call bchaddr.NewCashAddressScriptHash(): %w", addrErr)
 		}
 		return bchAddress.String(), strRedeemScript, nil
-	case domainCoin.LTC, domainCoin.ETH, domainCoin.XRP, domainCoin.ERC20, domainCoin.HYT:
+	case domainCoin.DOGE, domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE,
+		domainCoin.XRP, domainCoin.SOL, domainCoin.TRX, domainCoin.ERC20, domainCoin.HYT:
 		return "", "", fmt.Errorf("getP2shSegwitAddr() is not implemented yet for %s", k.coinTypeCode)
 	default:
 		return "", "", fmt.Errorf("getP2shSegwitAddr() is not implemented yet for %s", k.coinTypeCode)
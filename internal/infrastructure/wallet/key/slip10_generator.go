@@ -0,0 +1,128 @@
+package key
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
+)
+
+// slip10Ed25519Curve is HMAC key to generate master key of ed25519 curve
+const slip10Ed25519Curve = "ed25519 seed"
+
+// SLIP10Generator implements Generator interface for ed25519 keys of SLIP-0010
+//   - only hardened derivation is defined for ed25519, so every level of BIP44 path is hardened
+//   - path is m/44'/coinType'/account'/index'
+type SLIP10Generator struct {
+	coinType     domainCoin.CoinType
+	coinTypeCode domainCoin.CoinTypeCode
+}
+
+// NewSLIP10Generator returns SLIP10Generator
+func NewSLIP10Generator(coinTypeCode domainCoin.CoinTypeCode, conf *chaincfg.Params) *SLIP10Generator {
+	return &SLIP10Generator{
+		coinType:     domainCoin.GetCoinType(coinTypeCode, conf),
+		coinTypeCode: coinTypeCode,
+	}
+}
+
+// KeyType returns the key type this generator supports
+func (*SLIP10Generator) KeyType() domainKey.KeyType {
+	return domainKey.KeyTypeBIP44
+}
+
+// CreateKey creates ed25519 keys by SLIP-0010
+//   - WIF: base58 encoded 64 bytes secret key (private key + public key)
+//   - P2PKHAddr: base58 encoded public key as address
+//   - FullPubKey: hex encoded public key
+func (g *SLIP10Generator) CreateKey(
+	seed []byte,
+	accountType domainAccount.AccountType,
+	idxFrom, count uint32,
+) ([]domainKey.WalletKey, error) {
+	if g.coinTypeCode != domainCoin.SOL {
+		return nil, fmt.Errorf("coinType[%s] is not implemented yet", g.coinTypeCode.String())
+	}
+
+	// m/44'/coinType'/account'
+	accountKey := slip10DerivePath(seed, PurposeTypeBIP44.Uint32(), g.coinType.Uint32(), accountType.Uint32())
+
+	walletKeys := make([]domainKey.WalletKey, count)
+	for i := uint32(0); i < count; i++ {
+		child := accountKey.derive(idxFrom + i)
+		privKey := ed25519.NewKeyFromSeed(child.key)
+		pubKey, _ := privKey.Public().(ed25519.PublicKey)
+
+		walletKeys[i] = domainKey.WalletKey{
+			WIF:            base58.Encode(privKey),
+			P2PKHAddr:      base58.Encode(pubKey),
+			P2SHSegWitAddr: "",
+			Bech32Addr:     "",
+			TaprootAddr:    "",
+			FullPubKey:     hex.EncodeToString(pubKey),
+			RedeemScript:   "",
+		}
+	}
+	return walletKeys, nil
+}
+
+// SupportsAddressType returns false because address of ed25519 key is not bitcoin address
+func (*SLIP10Generator) SupportsAddressType(_ address.AddrType) bool {
+	return false
+}
+
+// GetDerivationPath returns the derivation path whose levels are all hardened
+func (g *SLIP10Generator) GetDerivationPath(accountType domainAccount.AccountType, index uint32) string {
+	return fmt.Sprintf("m/%d'/%d'/%d'/%d'",
+		PurposeTypeBIP44.Uint32(), g.coinType.Uint32(), accountType.Uint32(), index)
+}
+
+// slip10Key is extended private key of ed25519 curve
+type slip10Key struct {
+	key       []byte
+	chainCode []byte
+}
+
+// slip10Master returns master key from seed
+func slip10Master(seed []byte) *slip10Key {
+	mac := hmac.New(sha512.New, []byte(slip10Ed25519Curve))
+	_, _ = mac.Write(seed)
+	sum := mac.Sum(nil)
+	return &slip10Key{key: sum[:32], chainCode: sum[32:]}
+}
+
+// derive returns hardened child key, index is hardened even if HardenedKeyStart is not added
+func (k *slip10Key) derive(index uint32) *slip10Key {
+	if index < hdkeychain.HardenedKeyStart {
+		index += hdkeychain.HardenedKeyStart
+	}
+	data := make([]byte, 0, 1+32+4)
+	data = append(data, 0x00)
+	data = append(data, k.key...)
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	_, _ = mac.Write(data)
+	sum := mac.Sum(nil)
+	return &slip10Key{key: sum[:32], chainCode: sum[32:]}
+}
+
+// slip10DerivePath derives hardened keys of given path from master key
+func slip10DerivePath(seed []byte, path ...uint32) *slip10Key {
+	key := slip10Master(seed)
+	for _, index := range path {
+		key = key.derive(index)
+	}
+	return key
+}
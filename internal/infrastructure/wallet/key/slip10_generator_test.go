@@ -0,0 +1,124 @@
+package key
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
+)
+
+// TestSLIP10Derive is test vector 1 for ed25519 of SLIP-0010
+func TestSLIP10Derive(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		path      []uint32
+		chainCode string
+		privKey   string
+		pubKey    string
+	}{
+		{
+			name:      "m",
+			path:      nil,
+			chainCode: "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb",
+			privKey:   "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+			pubKey:    "a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed",
+		},
+		{
+			name:      "m/0H",
+			path:      []uint32{0},
+			chainCode: "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69",
+			privKey:   "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+			pubKey:    "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c",
+		},
+		{
+			name:      "m/0H/1H",
+			path:      []uint32{0, 1},
+			chainCode: "a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14",
+			privKey:   "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2",
+			pubKey:    "1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := slip10DerivePath(seed, tt.path...)
+			assert.Equal(t, tt.chainCode, hex.EncodeToString(key.chainCode))
+			assert.Equal(t, tt.privKey, hex.EncodeToString(key.key))
+			pubKey, _ := ed25519.NewKeyFromSeed(key.key).Public().(ed25519.PublicKey)
+			assert.Equal(t, tt.pubKey, hex.EncodeToString(pubKey))
+		})
+	}
+}
+
+func TestSLIP10GeneratorSolana(t *testing.T) {
+	t.Parallel()
+
+	// Test seed (for testing only, never use in production)
+	seed := []byte("test seed for slip10 key generation testing")
+
+	tests := []struct {
+		name           string
+		conf           *chaincfg.Params
+		accountType    domainAccount.AccountType
+		derivationPath string
+	}{
+		{
+			name:           "Solana Mainnet Client",
+			conf:           &chaincfg.MainNetParams,
+			accountType:    domainAccount.AccountTypeClient,
+			derivationPath: "m/44'/501'/0'/3'",
+		},
+		{
+			name:           "Solana Devnet Deposit",
+			conf:           &chaincfg.TestNet3Params,
+			accountType:    domainAccount.AccountTypeDeposit,
+			derivationPath: "m/44'/1'/1'/3'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			generator, err := NewFactory().CreateGenerator(domainKey.KeyTypeBIP44, domainCoin.SOL, tt.conf)
+			require.NoError(t, err)
+			assert.Equal(t, domainKey.KeyTypeBIP44, generator.KeyType())
+
+			keys, err := generator.CreateKey(seed, tt.accountType, 0, 5)
+			require.NoError(t, err)
+			require.Len(t, keys, 5)
+
+			// keys of continuous index are same as keys created one by one
+			next, err := generator.CreateKey(seed, tt.accountType, 3, 1)
+			require.NoError(t, err)
+			assert.Equal(t, keys[3], next[0])
+
+			for i, key := range keys {
+				secret := base58.Decode(key.WIF)
+				require.Len(t, secret, ed25519.PrivateKeySize, "key %d", i)
+				pubKey := base58.Decode(key.P2PKHAddr)
+				assert.Equal(t, []byte(ed25519.PrivateKey(secret).Public().(ed25519.PublicKey)), pubKey, "key %d", i)
+				assert.Equal(t, hex.EncodeToString(pubKey), key.FullPubKey)
+				assert.Empty(t, key.P2SHSegWitAddr)
+				assert.Empty(t, key.Bech32Addr)
+			}
+
+			assert.Equal(t, tt.derivationPath, generator.GetDerivationPath(tt.accountType, 3))
+		})
+	}
+
+	// only BIP44 path is available
+	_, err := NewFactory().CreateGenerator(domainKey.KeyTypeBIP84, domainCoin.SOL, &chaincfg.MainNetParams)
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -15,7 +16,7 @@ func runEncryptWallet(btc bitcoin.Bitcoiner, passphrase string) error {
 		return errors.New("passphrase option [-passphrase] is required")
 	}
 
-	err := btc.EncryptWallet(passphrase)
+	err := btc.EncryptWallet(context.Background(), passphrase)
 	if err != nil {
 		return fmt.Errorf("fail to call btc.EncryptWallet() %w", err)
 	}
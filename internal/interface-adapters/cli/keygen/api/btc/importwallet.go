@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -15,7 +16,7 @@ func runImportWallet(btc bitcoin.Bitcoiner, fileName string) error {
 		return errors.New("filename option [-file] is required")
 	}
 
-	err := btc.ImportWallet(fileName)
+	err := btc.ImportWallet(context.Background(), fileName)
 	if err != nil {
 		return fmt.Errorf("fail to call btc.ImportWallet() %w", err)
 	}
@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin"
@@ -9,7 +10,7 @@ import (
 func runWalletLock(btc bitcoin.Bitcoiner) error {
 	fmt.Println("removes the wallet encryption key from memory, locking the wallet")
 
-	err := btc.WalletLock()
+	err := btc.WalletLock(context.Background())
 	if err != nil {
 		return fmt.Errorf("fail to call WalletLock() %w", err)
 	}
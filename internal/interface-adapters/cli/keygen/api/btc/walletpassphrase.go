@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -15,7 +16,7 @@ func runWalletPassphrase(btc bitcoin.Bitcoiner, passphrase string) error {
 		return errors.New("passphrase option [-passphrase] is required")
 	}
 
-	err := btc.WalletPassphrase(passphrase, 10)
+	err := btc.WalletPassphrase(context.Background(), passphrase, 10)
 	if err != nil {
 		return fmt.Errorf("fail to call btc.WalletPassphrase() %w", err)
 	}
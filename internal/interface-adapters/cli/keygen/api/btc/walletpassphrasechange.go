@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -18,7 +19,7 @@ func runWalletPassphraseChange(btc bitcoin.Bitcoiner, old, newPass string) error
 		return errors.New("new passphrase option [-new] is required")
 	}
 
-	err := btc.WalletPassphraseChange(old, newPass)
+	err := btc.WalletPassphraseChange(context.Background(), old, newPass)
 	if err != nil {
 		return fmt.Errorf("fail to call btc.WalletPassphraseChange() %w", err)
 	}
@@ -62,4 +62,20 @@ func AddCommands(parentCmd *cobra.Command, wallet *wallets.Keygener, container d
 	}
 	multisigCmd.Flags().StringVar(&multisigAccount, "account", "", "target account")
 	parentCmd.AddCommand(multisigCmd)
+
+	// regularkey command
+	var (
+		regularKeyAccount string
+		regularKeyRotate  bool
+	)
+	regularKeyCmd := &cobra.Command{
+		Use:   "regularkey",
+		Short: "create pending regular key and export it to create SetRegularKey by watch wallet (only XRP)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRegularKey(container, regularKeyAccount, regularKeyRotate)
+		},
+	}
+	regularKeyCmd.Flags().StringVar(&regularKeyAccount, "account", "", "target account")
+	regularKeyCmd.Flags().BoolVar(&regularKeyRotate, "rotate", false, "replace regular key which is already active")
+	parentCmd.AddCommand(regularKeyCmd)
 }
@@ -0,0 +1,36 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	keygenusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+)
+
+// runRegularKey is the actual implementation that accepts parsed flags
+func runRegularKey(container di.Container, acnt string, isRotate bool) error {
+	fmt.Println("create pending regular key to set regular key")
+
+	// validator
+	if !domainAccount.ValidateAccountType(acnt) {
+		return errors.New("account option [-account] is invalid")
+	}
+
+	// create regular key file
+	useCase := container.NewKeygenCreateRegularKeyUseCase()
+	output, err := useCase.Create(context.Background(), keygenusecase.CreateRegularKeyInput{
+		AccountType: domainAccount.AccountType(acnt),
+		Rotate:      isRotate,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to create regular key: %w", err)
+	}
+
+	// TODO: output should be json if json option is true
+	fmt.Printf("[fileName]: %s\n", output.FileName)
+
+	return nil
+}
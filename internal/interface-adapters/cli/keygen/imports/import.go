@@ -32,4 +32,28 @@ func AddCommands(parentCmd *cobra.Command, wallet *wallets.Keygener, container d
 	}
 	fullpubkeyCmd.Flags().StringVar(&fullpubkeyFile, "file", "", "full-pubkey file path")
 	parentCmd.AddCommand(fullpubkeyCmd)
+
+	// regularkey command
+	var regularKeyFile string
+	regularKeyCmd := &cobra.Command{
+		Use:   "regularkey",
+		Short: "import regular key found in ledger by watch wallet to sign by regular key (only XRP)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRegularKey(container, regularKeyFile)
+		},
+	}
+	regularKeyCmd.Flags().StringVar(&regularKeyFile, "file", "", "validated regular key file path")
+	parentCmd.AddCommand(regularKeyCmd)
+
+	// mysqldump command
+	var dumpFile string
+	mysqldumpCmd := &cobra.Command{
+		Use:   "mysqldump",
+		Short: "import MySQL dump of keygen database into SQLite database file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMySQLDump(container, dumpFile)
+		},
+	}
+	mysqldumpCmd.Flags().StringVar(&dumpFile, "file", "", "file path created by mysqldump")
+	parentCmd.AddCommand(mysqldumpCmd)
 }
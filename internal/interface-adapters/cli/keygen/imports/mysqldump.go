@@ -0,0 +1,48 @@
+package imports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+)
+
+func runMySQLDump(container di.Container, filePath string) error {
+	fmt.Println("import MySQL dump into SQLite database file")
+
+	// validator
+	if filePath == "" {
+		return errors.New("file path option [--file] is required")
+	}
+
+	importer, err := container.NewSQLiteDumpImporter()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(filePath) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("fail to open %s: %w", filePath, err)
+	}
+	defer func() { _ = file.Close() }()
+
+	counts, err := importer.Import(context.Background(), file)
+	if err != nil {
+		return fmt.Errorf("fail to import MySQL dump: %w", err)
+	}
+
+	tables := make([]string, 0, len(counts))
+	for table := range counts {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		fmt.Printf("%s: %d rows\n", table, counts[table])
+	}
+	fmt.Println("Done!")
+
+	return nil
+}
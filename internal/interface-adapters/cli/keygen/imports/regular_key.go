@@ -0,0 +1,31 @@
+package imports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	keygenusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+)
+
+func runRegularKey(container di.Container, fileName string) error {
+	fmt.Println("import regular key found in ledger by watch wallet")
+
+	// validator
+	if fileName == "" {
+		return errors.New("file option [-file] is required")
+	}
+
+	// activate pending regular key in keygen wallet
+	useCase := container.NewKeygenImportRegularKeyUseCase()
+	err := useCase.Import(context.Background(), keygenusecase.ImportRegularKeyInput{
+		FileName: fileName,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to import regular key: %w", err)
+	}
+	fmt.Println("Done!")
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+)
+
+const cmdName = "migrate"
+
+// AddCommand creates and returns the migrate command
+//
+// container is given as pointer because it's created after flags are parsed
+func AddCommand(container *di.Container) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   cmdName,
+		Short: "apply versioned schema migrations to database",
+	}
+
+	upCmd := &cobra.Command{
+		Use:   "up",
+		Short: "apply pending migrations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUp(*container)
+		},
+	}
+	cmd.AddCommand(upCmd)
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "show schema version of database and pending migrations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatus(*container)
+		},
+	}
+	cmd.AddCommand(statusCmd)
+
+	return cmd
+}
+
+// IsCommand returns true when cmd is migrate command or its subcommand,
+// wallet must not be created for them because it requires up to date schema
+func IsCommand(cmd *cobra.Command) bool {
+	for ; cmd != nil; cmd = cmd.Parent() {
+		if cmd.Name() == cmdName {
+			return true
+		}
+	}
+	return false
+}
+
+func runUp(container di.Container) error {
+	migrator, err := container.NewMigrator()
+	if err != nil {
+		return err
+	}
+	applied, err := migrator.Up(context.Background())
+	for _, migration := range applied {
+		fmt.Printf("applied: %04d_%s\n", migration.Version, migration.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("fail to migrate: %w", err)
+	}
+	if len(applied) == 0 {
+		fmt.Println("No migration to apply")
+	}
+
+	fmt.Println("Done!")
+	return nil
+}
+
+func runStatus(container di.Container) error {
+	migrator, err := container.NewMigrator()
+	if err != nil {
+		return err
+	}
+	status, err := migrator.Status(context.Background())
+	if err != nil {
+		return fmt.Errorf("fail to get schema version: %w", err)
+	}
+
+	fmt.Printf("version: %d, latest: %d, dirty: %t\n", status.Current, status.Latest, status.Dirty)
+	for _, migration := range status.Pending {
+		fmt.Printf("pending: %04d_%s\n", migration.Version, migration.Name)
+	}
+	return nil
+}
@@ -18,4 +18,16 @@ func AddCommands(parentCmd *cobra.Command, wallet *wallets.Signer, container di.
 		},
 	}
 	parentCmd.AddCommand(privkeyCmd)
+
+	// mysqldump command
+	var dumpFile string
+	mysqldumpCmd := &cobra.Command{
+		Use:   "mysqldump",
+		Short: "import MySQL dump of sign database into SQLite database file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMySQLDump(container, dumpFile)
+		},
+	}
+	mysqldumpCmd.Flags().StringVar(&dumpFile, "file", "", "file path created by mysqldump")
+	parentCmd.AddCommand(mysqldumpCmd)
 }
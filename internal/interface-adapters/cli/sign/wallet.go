@@ -11,10 +11,8 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/sign/export"
 	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/sign/imports"
 	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/sign/sign"
-	ethapi "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/watch/api/eth"
 	wallets "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet"
 	btcwallet "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet/btc"
-	ethwallet "github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/wallet/eth"
 )
 
 // AddCommands adds all sign subcommands to the root command
@@ -61,11 +59,9 @@ func AddCommands(rootCmd *cobra.Command, wallet *wallets.Signer, container di.Co
 			}
 			// Clear existing subcommands to handle multiple runs in tests
 			cmd.ResetCommands()
-			switch v := (*wallet).(type) {
-			case *btcwallet.BTCSign:
+			// ETH sign wallet is offline, so API commands are only for BTC
+			if v, ok := (*wallet).(*btcwallet.BTCSign); ok {
 				btc.AddCommands(cmd, v.BTC)
-			case *ethwallet.ETHSign:
-				ethapi.AddCommands(cmd, v.ETH)
 			}
 			return nil
 		},
@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -21,13 +22,13 @@ func runBalance(btc bitcoin.Bitcoiner, acnt string) error {
 		err     error
 	)
 	if acnt == "" {
-		balance, err = btc.GetBalance()
+		balance, err = btc.GetBalance(context.Background())
 		if err != nil {
 			return fmt.Errorf("fail to call btc.GetBalance() %w", err)
 		}
 	} else {
 		// get received by account
-		balance, err = btc.GetBalanceByAccount(domainAccount.AccountType(acnt), btc.ConfirmationBlock())
+		balance, err = btc.GetBalanceByAccount(context.Background(), domainAccount.AccountType(acnt), btc.ConfirmationBlock())
 		if err != nil {
 			return fmt.Errorf("fail to call btc.GetBalanceByAccount() %w", err)
 		}
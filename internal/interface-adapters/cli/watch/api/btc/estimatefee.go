@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin"
@@ -8,7 +9,7 @@ import (
 
 func runEstimateFee(btc bitcoin.Bitcoiner) error {
 	// estimate fee
-	feePerKb, err := btc.EstimateSmartFee()
+	feePerKb, err := btc.EstimateSmartFee(context.Background())
 	if err != nil {
 		return fmt.Errorf("fail to call BTC.EstimateSmartFee() %w", err)
 	}
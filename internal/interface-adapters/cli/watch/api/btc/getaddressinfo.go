@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -16,7 +17,7 @@ func runGetAddressInfo(btc bitcoin.Bitcoiner, addr string) error {
 	}
 
 	// call getaddressinfo
-	addrData, err := btc.GetAddressInfo(addr)
+	addrData, err := btc.GetAddressInfo(context.Background(), addr)
 	if err != nil {
 		return fmt.Errorf("fail to call BTC.GetAddressInfo() %w", err)
 	}
@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/bookerzzz/grok"
@@ -10,7 +11,7 @@ import (
 
 func runGetNetworkInfo(btc bitcoin.Bitcoiner) error {
 	// call getnetworkinfo
-	infoData, err := btc.GetNetworkInfo()
+	infoData, err := btc.GetNetworkInfo(context.Background())
 	if err != nil {
 		return fmt.Errorf("fail to call BTC.GetNetworkInfo() %w", err)
 	}
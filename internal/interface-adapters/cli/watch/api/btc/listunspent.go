@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -25,7 +26,7 @@ func runListUnspent(btc bitcoin.Bitcoiner, acnt string, argsNum int64) error {
 
 	if acnt != "" {
 		// call listunspent
-		unspentList, err := btc.ListUnspentByAccount(domainAccount.AccountType(acnt), confirmationNum)
+		unspentList, err := btc.ListUnspentByAccount(context.Background(), domainAccount.AccountType(acnt), confirmationNum)
 		if err != nil {
 			return fmt.Errorf("fail to call btc.ListUnspentByAccount() %w", err)
 		}
@@ -39,7 +40,7 @@ func runListUnspent(btc bitcoin.Bitcoiner, acnt string, argsNum int64) error {
 		// call listunspent
 		// ListUnspentMin doesn't have proper response, label can't be retrieved
 
-		unspentList, err := btc.ListUnspent(confirmationNum)
+		unspentList, err := btc.ListUnspent(context.Background(), confirmationNum)
 		if err != nil {
 			return fmt.Errorf("fail to call btc.ListUnspent() %w", err)
 		}
@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/bookerzzz/grok"
@@ -10,7 +11,7 @@ import (
 
 func runLogging(btc bitcoin.Bitcoiner) error {
 	// logging
-	logData, err := btc.Logging()
+	logData, err := btc.Logging(context.Background())
 	if err != nil {
 		return fmt.Errorf("fail to call BTC.Logging() %w", err)
 	}
@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/bitcoin"
@@ -8,7 +9,7 @@ import (
 
 func runUnlockTx(btc bitcoin.Bitcoiner) error {
 	// unlock locked transaction for unspent transaction
-	err := btc.UnlockUnspent()
+	err := btc.UnlockUnspent(context.Background())
 	if err != nil {
 		return fmt.Errorf("fail to call BTC.UnlockUnspent() %w", err)
 	}
@@ -1,6 +1,7 @@
 package btc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -14,7 +15,7 @@ func runValidateAddress(btc bitcoin.Bitcoiner, address string) error {
 	}
 
 	// validate address
-	_, err := btc.ValidateAddress(address)
+	_, err := btc.ValidateAddress(context.Background(), address)
 	if err != nil {
 		return fmt.Errorf("fail to call BTC.ValidateAddress() %w", err)
 	}
@@ -7,7 +7,9 @@ import (
 )
 
 // AddCommands adds all Ethereum API subcommands
-func AddCommands(parentCmd *cobra.Command, eth ethereum.Ethereumer) {
+func AddCommands(
+	parentCmd *cobra.Command, eth ethereum.Ethereumer, safe ethereum.Safer, forwarder ethereum.Forwarder,
+) {
 	// clientversion command
 	clientversionCmd := &cobra.Command{
 		Use:   "clientversion",
@@ -47,4 +49,41 @@ func AddCommands(parentCmd *cobra.Command, eth ethereum.Ethereumer) {
 		},
 	}
 	parentCmd.AddCommand(netversionCmd)
+
+	// deploysafe command
+	var (
+		deploysafeFiles     string
+		deploysafeThreshold uint64
+		deploysafeSalt      uint64
+	)
+	deploysafeCmd := &cobra.Command{
+		Use:   "deploysafe",
+		Short: "deploy Safe multisig contract whose owners are auth accounts of sign wallets",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDeploySafe(eth, safe, deploysafeFiles, deploysafeThreshold, deploysafeSalt)
+		},
+	}
+	deploysafeCmd.Flags().StringVar(
+		&deploysafeFiles, "files", "", "full-pubkey files exported by sign wallets, separated by comma")
+	deploysafeCmd.Flags().Uint64Var(&deploysafeThreshold, "threshold", 0, "the number of required signatures")
+	deploysafeCmd.Flags().Uint64Var(&deploysafeSalt, "salt", 0, "salt nonce which decides Safe address")
+	parentCmd.AddCommand(deploysafeCmd)
+
+	// deployfactory command
+	var (
+		deployfactoryDestination string
+		deployfactoryDeployer    string
+	)
+	deployfactoryCmd := &cobra.Command{
+		Use:   "deployfactory",
+		Short: "deploy ForwarderFactory which sweeps forwarders of client account to destination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDeployFactory(eth, forwarder, deployfactoryDestination, deployfactoryDeployer)
+		},
+	}
+	deployfactoryCmd.Flags().StringVar(
+		&deployfactoryDestination, "destination", "", "destination address, it should be address of deposit account")
+	deployfactoryCmd.Flags().StringVar(
+		&deployfactoryDeployer, "deployer", "", "address which sends transaction, its key must be in keydir")
+	parentCmd.AddCommand(deployfactoryCmd)
 }
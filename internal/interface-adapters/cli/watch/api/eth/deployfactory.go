@@ -0,0 +1,33 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
+)
+
+func runDeployFactory(ethAPI ethereum.Ethereumer, forwarder ethereum.Forwarder, destination, deployer string) error {
+	if destination == "" {
+		return errors.New("destination option [--destination] is required")
+	}
+	if deployer == "" {
+		return errors.New("deployer option [--deployer] is required")
+	}
+
+	deployerKey, err := ethAPI.GetPrivKey(deployer, eth.Password)
+	if err != nil {
+		return fmt.Errorf("fail to call eth.GetPrivKey(deployer): %w", err)
+	}
+	factory, txHash, err := forwarder.Deploy(context.Background(), destination, deployerKey.PrivateKey)
+	if err != nil {
+		return fmt.Errorf("fail to call forwarder.Deploy(): %w", err)
+	}
+
+	fmt.Printf("destination: %s\n[factory]: %s\n[txHash]: %s\n", destination, factory, txHash)
+	fmt.Println("add factory address to [ethereum.forwarder] in toml file after transaction is confirmed")
+
+	return nil
+}
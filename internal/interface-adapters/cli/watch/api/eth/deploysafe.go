@@ -0,0 +1,76 @@
+package eth
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum/eth"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/fullpubkey"
+)
+
+func runDeploySafe(ethAPI ethereum.Ethereumer, safe ethereum.Safer, files string, threshold, salt uint64) error {
+	if files == "" {
+		return errors.New("files option [--files] is required")
+	}
+	if safe.Executor() == "" {
+		return errors.New("executor of ethereum.safe is required in toml file")
+	}
+
+	// owners are addresses of auth accounts of sign wallets
+	var owners []string
+	for _, fileName := range strings.Split(files, ",") {
+		addrs, err := readOwners(ethAPI.CoinTypeCode(), fileName)
+		if err != nil {
+			return err
+		}
+		owners = append(owners, addrs...)
+	}
+
+	executorKey, err := ethAPI.GetPrivKey(safe.Executor(), eth.Password)
+	if err != nil {
+		return fmt.Errorf("fail to call eth.GetPrivKey(executor): %w", err)
+	}
+	safeAddr, txHash, err := safe.Deploy(context.Background(), owners, threshold, salt, executorKey.PrivateKey)
+	if err != nil {
+		return fmt.Errorf("fail to call safe.Deploy(): %w", err)
+	}
+
+	fmt.Printf("owners: %v\nthreshold: %d\n[safe]: %s\n[txHash]: %s\n", owners, threshold, safeAddr, txHash)
+	fmt.Println("add Safe address to [ethereum.safe.accounts] in toml file after transaction is confirmed")
+
+	return nil
+}
+
+// readOwners returns addresses from full-pubkey file, full-pubkey of ETH is uncompressed key without 04 prefix
+func readOwners(coinTypeCode domainCoin.CoinTypeCode, fileName string) ([]string, error) {
+	data, err := os.ReadFile(fileName) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("fail to call os.ReadFile(%s): %w", fileName, err)
+	}
+
+	var owners []string
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		fpk, err := fullpubkey.ConvertLine(coinTypeCode, strings.Split(line, ","))
+		if err != nil {
+			return nil, fmt.Errorf("fail to call fullpubkey.ConvertLine(): %w", err)
+		}
+		pubKeyBytes, err := hex.DecodeString("04" + fpk.FullPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("fail to decode full-pubkey of %s: %w", fpk.AuthType.String(), err)
+		}
+		pubKey, err := crypto.UnmarshalPubkey(pubKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("fail to call crypto.UnmarshalPubkey(): %w", err)
+		}
+		owners = append(owners, crypto.PubkeyToAddress(*pubKey).Hex())
+	}
+	return owners, nil
+}
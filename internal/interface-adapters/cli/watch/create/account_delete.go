@@ -0,0 +1,33 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+)
+
+func runAccountDelete(container di.Container, addresses string) error {
+	// validator
+	if addresses == "" {
+		return errors.New("address option [-address] is required")
+	}
+
+	// Get use case from container
+	useCase := container.NewWatchCreateAccountDeleteUseCase()
+
+	output, err := useCase.Execute(context.Background(), watchusecase.CreateAccountDeleteInput{
+		Addresses: strings.Split(addresses, ","),
+	})
+	if err != nil {
+		return fmt.Errorf("fail to create AccountDelete transaction: %w", err)
+	}
+
+	// TODO: output should be json if json option is true
+	fmt.Printf("[fileName]: %s\n", output.FileName)
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package create
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/hiromaily/go-crypto-wallet/internal/di"
@@ -54,12 +56,132 @@ func AddCommands(parentCmd *cobra.Command, wallet *wallets.Watcher, container di
 	transferCmd.Flags().Float64Var(&transferFee, "fee", 0, "adjustment fee")
 	parentCmd.AddCommand(transferCmd)
 
+	// ticket command
+	var (
+		ticketAccount string
+		ticketCount   uint32
+	)
+	ticketCmd := &cobra.Command{
+		Use:   "ticket",
+		Short: "create unsigned TicketCreate transaction to reserve tickets for payment (XRP only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTicket(container, ticketAccount, ticketCount)
+		},
+	}
+	ticketCmd.Flags().StringVar(&ticketAccount, "account", "payment", "account which holds tickets")
+	ticketCmd.Flags().Uint32Var(&ticketCount, "count", 10, "number of tickets, up to 250")
+	parentCmd.AddCommand(ticketCmd)
+
+	// multisig command
+	var (
+		multisigAccount string
+		multisigFile    string
+	)
+	multisigCmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "create unsigned SignerListSet transaction to make account multisig account (XRP only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMultisig(container, multisigAccount, multisigFile)
+		},
+	}
+	multisigCmd.Flags().StringVar(&multisigAccount, "account", "", "target account")
+	multisigCmd.Flags().StringVar(&multisigFile, "file", "", "comma separated full-pubkey files of sign wallets")
+	parentCmd.AddCommand(multisigCmd)
+
+	// requiredest command
+	var requireDestAccount string
+	requireDestCmd := &cobra.Command{
+		Use:   "requiredest",
+		Short: "create unsigned AccountSet transaction to require destination tag (XRP only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRequireDest(container, requireDestAccount)
+		},
+	}
+	requireDestCmd.Flags().StringVar(&requireDestAccount, "account", "deposit", "target account")
+	parentCmd.AddCommand(requireDestCmd)
+
+	// trustline command
+	var trustLineAccount string
+	trustLineCmd := &cobra.Command{
+		Use:   "trustline",
+		Short: "create unsigned TrustSet transaction to hold issued currency in config (XRP only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTrustLine(container, trustLineAccount)
+		},
+	}
+	trustLineCmd.Flags().StringVar(&trustLineAccount, "account", "", "target account")
+	parentCmd.AddCommand(trustLineCmd)
+
+	// regularkey command
+	var regularKeyFile string
+	regularKeyCmd := &cobra.Command{
+		Use:   "regularkey",
+		Short: "create unsigned SetRegularKey transaction for pending regular key of keygen wallet (XRP only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRegularKey(container, regularKeyFile)
+		},
+	}
+	regularKeyCmd.Flags().StringVar(&regularKeyFile, "file", "", "regular key file exported by keygen wallet")
+	parentCmd.AddCommand(regularKeyCmd)
+
+	// disablemaster command
+	var disableMasterAccount string
+	disableMasterCmd := &cobra.Command{
+		Use:   "disablemaster",
+		Short: "create unsigned AccountSet transaction to disable master key (XRP only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDisableMaster(container, disableMasterAccount)
+		},
+	}
+	disableMasterCmd.Flags().StringVar(&disableMasterAccount, "account", "", "target account")
+	parentCmd.AddCommand(disableMasterCmd)
+
+	// accountdelete command
+	var accountDeleteAddress string
+	accountDeleteCmd := &cobra.Command{
+		Use:   "accountdelete",
+		Short: "create unsigned AccountDelete transaction to reclaim reserve of retired client accounts (XRP only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAccountDelete(container, accountDeleteAddress)
+		},
+	}
+	accountDeleteCmd.Flags().StringVar(&accountDeleteAddress, "address", "", "comma separated client addresses")
+	parentCmd.AddCommand(accountDeleteCmd)
+
+	// escrow command
+	var (
+		escrowAmount      float64
+		escrowFinishAfter time.Duration
+		escrowCancelAfter time.Duration
+	)
+	escrowCmd := &cobra.Command{
+		Use:   "escrow",
+		Short: "create unsigned EscrowCreate transaction to lock funds of stored account (XRP only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEscrow(container, escrowAmount, escrowFinishAfter, escrowCancelAfter)
+		},
+	}
+	escrowCmd.Flags().Float64Var(&escrowAmount, "amount", 0, "amount of XRP to escrow")
+	escrowCmd.Flags().DurationVar(&escrowFinishAfter, "finish-after", 0, "duration until escrow can be finished")
+	escrowCmd.Flags().DurationVar(&escrowCancelAfter, "cancel-after", 0,
+		"duration until escrow can be canceled, 0 means escrow never expires")
+	parentCmd.AddCommand(escrowCmd)
+
+	// escrowfinish command
+	escrowFinishCmd := &cobra.Command{
+		Use:   "escrowfinish",
+		Short: "create unsigned EscrowFinish transaction for matured escrows (XRP only)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEscrowFinish(container)
+		},
+	}
+	parentCmd.AddCommand(escrowFinishCmd)
+
 	// db command
 	var dbTable string
 	dbCmd := &cobra.Command{
-		Use:        "db",
-		Short:      "create payment_request table with dummy data for development use",
-		Deprecated: "Use query with shell script instead of go code",
+		Use:   "db",
+		Short: "apply migrations and insert payment_request dummy data for development use",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runDB(container, dbTable)
 		},
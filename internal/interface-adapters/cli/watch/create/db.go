@@ -8,12 +8,19 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/di"
 )
 
-// runDB creates payment_request table with dummy data
-//
-// Deprecated: Use query with shell script instead of go code.
+// runDB applies pending migrations and inserts dummy data into payment_request table
 func runDB(container di.Container, tableName string) error {
 	fmt.Println("-table: " + tableName)
 
+	// tables are created by migrations
+	migrator, err := container.NewMigrator()
+	if err != nil {
+		return err
+	}
+	if _, err = migrator.Up(context.Background()); err != nil {
+		return fmt.Errorf("fail to migrate: %w", err)
+	}
+
 	// validator
 	if tableName == "" {
 		tableName = "payment_request"
@@ -0,0 +1,46 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+)
+
+func runEscrow(container di.Container, amount float64, finishAfter, cancelAfter time.Duration) error {
+	// validator
+	if amount <= 0 {
+		return errors.New("amount option [-amount] is required")
+	}
+	if finishAfter <= 0 {
+		return errors.New("finish-after option [-finish-after] is required")
+	}
+	if cancelAfter != 0 && cancelAfter <= finishAfter {
+		return errors.New("cancel-after option [-cancel-after] must be longer than finish-after")
+	}
+
+	// Get use case from container
+	useCase := container.NewWatchCreateEscrowUseCase()
+
+	now := time.Now()
+	input := watchusecase.CreateEscrowInput{
+		Amount:      amount,
+		FinishAfter: now.Add(finishAfter),
+	}
+	if cancelAfter != 0 {
+		input.CancelAfter = now.Add(cancelAfter)
+	}
+
+	output, err := useCase.Execute(context.Background(), input)
+	if err != nil {
+		return fmt.Errorf("fail to create EscrowCreate transaction: %w", err)
+	}
+
+	// TODO: output should be json if json option is true
+	fmt.Printf("[fileName]: %s\n", output.FileName)
+
+	return nil
+}
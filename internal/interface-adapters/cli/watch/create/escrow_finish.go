@@ -0,0 +1,27 @@
+package create
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+)
+
+func runEscrowFinish(container di.Container) error {
+	// Get use case from container
+	useCase := container.NewWatchFinishEscrowUseCase()
+
+	output, err := useCase.Execute(context.Background())
+	if err != nil {
+		return fmt.Errorf("fail to create EscrowFinish transaction: %w", err)
+	}
+
+	// TODO: output should be json if json option is true
+	if output.FileName == "" {
+		fmt.Println("no matured escrow to finish")
+		return nil
+	}
+	fmt.Printf("[fileName]: %s\n", output.FileName)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+)
+
+func runMultisig(container di.Container, account, fileNames string) error {
+	// validator
+	if !domainAccount.ValidateAccountType(account) {
+		return errors.New("account option [-account] is invalid")
+	}
+	if fileNames == "" {
+		return errors.New("file option [-file] is required")
+	}
+
+	// Get use case from container
+	useCase := container.NewWatchCreateSignerListUseCase()
+
+	output, err := useCase.Execute(context.Background(), watchusecase.CreateSignerListInput{
+		AccountType: domainAccount.AccountType(account),
+		FileNames:   strings.Split(fileNames, ","),
+	})
+	if err != nil {
+		return fmt.Errorf("fail to create SignerListSet transaction: %w", err)
+	}
+
+	// TODO: output should be json if json option is true
+	fmt.Printf("[fileName]: %s\n", output.FileName)
+
+	return nil
+}
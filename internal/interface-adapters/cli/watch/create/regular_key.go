@@ -0,0 +1,33 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+)
+
+func runRegularKey(container di.Container, fileName string) error {
+	// validator
+	if fileName == "" {
+		return errors.New("file option [-file] is required")
+	}
+
+	// Get use case from container
+	useCase := container.NewWatchCreateRegularKeyUseCase()
+
+	output, err := useCase.Execute(context.Background(), watchusecase.CreateRegularKeyInput{
+		FileName: fileName,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to create SetRegularKey transaction: %w", err)
+	}
+
+	// TODO: output should be json if json option is true
+	fmt.Printf("[fileName]: %s\n", output.FileName)
+	fmt.Printf("[validatedFileName]: %s\n", output.ValidatedFileName)
+
+	return nil
+}
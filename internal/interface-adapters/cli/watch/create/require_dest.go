@@ -0,0 +1,33 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+)
+
+func runRequireDest(container di.Container, account string) error {
+	// validator
+	if !domainAccount.ValidateAccountType(account) {
+		return errors.New("account option [-account] is invalid")
+	}
+
+	// Get use case from container
+	useCase := container.NewWatchCreateRequireDestUseCase()
+
+	output, err := useCase.Execute(context.Background(), watchusecase.CreateRequireDestInput{
+		AccountType: domainAccount.AccountType(account),
+	})
+	if err != nil {
+		return fmt.Errorf("fail to create AccountSet transaction: %w", err)
+	}
+
+	// TODO: output should be json if json option is true
+	fmt.Printf("[fileName]: %s\n", output.FileName)
+
+	return nil
+}
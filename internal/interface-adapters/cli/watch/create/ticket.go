@@ -0,0 +1,34 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+)
+
+func runTicket(container di.Container, account string, count uint32) error {
+	// validator
+	if !domainAccount.ValidateAccountType(account) {
+		return errors.New("account option [-account] is invalid")
+	}
+
+	// Get use case from container
+	useCase := container.NewWatchCreateTicketUseCase()
+
+	output, err := useCase.Execute(context.Background(), watchusecase.CreateTicketInput{
+		AccountType: domainAccount.AccountType(account),
+		Count:       count,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to create ticket transaction: %w", err)
+	}
+
+	// TODO: output should be json if json option is true
+	fmt.Printf("[fileName]: %s\n", output.FileName)
+
+	return nil
+}
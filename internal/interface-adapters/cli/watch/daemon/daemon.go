@@ -0,0 +1,199 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/guregu/null/v6"
+
+	"github.com/spf13/cobra"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/scheduler"
+)
+
+// Job names
+const (
+	jobMonitorSentTx  = "monitor_senttx"
+	jobMonitorBalance = "monitor_balance"
+	jobCreateDeposit  = "create_deposit"
+	jobCreatePayment  = "create_payment"
+	jobScanDeposit    = "scan_deposit"
+)
+
+// AddCommand creates and returns the daemon command
+func AddCommand(container di.Container, confPtr *config.WalletRoot) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "run watch wallet as long-running daemon with scheduled jobs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDaemon(container, confPtr)
+		},
+	}
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "show last run status of scheduled jobs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStatus(container)
+		},
+	}
+	cmd.AddCommand(statusCmd)
+
+	return cmd
+}
+
+func runDaemon(container di.Container, conf *config.WalletRoot) error {
+	if conf == nil {
+		return errors.New("config not initialized")
+	}
+
+	sched := container.NewWatchScheduler()
+	if err := registerJobs(sched, container, &conf.Daemon); err != nil {
+		return err
+	}
+
+	// graceful shutdown, running jobs are waited for
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if server := container.NewWatchMetricsServer(); server != nil {
+		go func() {
+			if err := server.Serve(ctx); err != nil {
+				logger.Error("metrics server stopped", "error", err)
+			}
+		}()
+	}
+
+	if err := sched.Start(ctx); err != nil {
+		return fmt.Errorf("fail to start scheduler: %w", err)
+	}
+	return nil
+}
+
+func registerJobs(sched *scheduler.Scheduler, container di.Container, conf *config.Daemon) error {
+	jobs := []struct {
+		name       string
+		conf       config.DaemonJob
+		leaderOnly bool
+		run        func(ctx context.Context) error
+	}{
+		{
+			// status of transactions is updated and notified, payment requests are reset by reorganization
+			name:       jobMonitorSentTx,
+			conf:       conf.MonitorSentTx,
+			leaderOnly: true,
+			run: func(ctx context.Context) error {
+				useCase := container.NewWatchMonitorTransactionUseCase().(watchusecase.MonitorTransactionUseCase)
+				return useCase.UpdateTxStatus(ctx)
+			},
+		},
+		{
+			// balance is only read and exported as metrics of each replica
+			name: jobMonitorBalance,
+			conf: conf.MonitorBalance,
+			run: func(ctx context.Context) error {
+				useCase := container.NewWatchMonitorTransactionUseCase().(watchusecase.MonitorTransactionUseCase)
+				return useCase.MonitorBalance(ctx, watchusecase.MonitorBalanceInput{
+					ConfirmationNum: conf.MonitorBalance.ConfirmationNum,
+				})
+			},
+		},
+		{
+			// creating transaction must not be run by multiple replicas at the same time
+			name:       jobCreateDeposit,
+			conf:       conf.CreateDeposit,
+			leaderOnly: true,
+			run:        createTransaction(container, domainTx.ActionTypeDeposit, conf.CreateDeposit.AdjustmentFee),
+		},
+		{
+			name:       jobCreatePayment,
+			conf:       conf.CreatePayment,
+			leaderOnly: true,
+			run:        createTransaction(container, domainTx.ActionTypePayment, conf.CreatePayment.AdjustmentFee),
+		},
+		{
+			// cursor of scanner is shared by replicas
+			name:       jobScanDeposit,
+			conf:       conf.ScanDeposit,
+			leaderOnly: true,
+			run: func(ctx context.Context) error {
+				return container.NewWatchScanDepositUseCase().Execute(ctx, watchusecase.ScanDepositInput{
+					ConfirmationNum: conf.ScanDeposit.ConfirmationNum,
+				})
+			},
+		},
+	}
+
+	for _, job := range jobs {
+		if !job.conf.Enabled {
+			continue
+		}
+		if err := sched.Register(&scheduler.Job{
+			Name:       job.name,
+			Interval:   job.conf.Interval,
+			Jitter:     job.conf.Jitter,
+			LeaderOnly: job.leaderOnly,
+			Run:        job.run,
+		}); err != nil {
+			return fmt.Errorf("fail to register job: %w", err)
+		}
+	}
+	return nil
+}
+
+func createTransaction(
+	container di.Container, actionType domainTx.ActionType, fee float64,
+) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		useCase := container.NewWatchCreateTransactionUseCase().(watchusecase.CreateTransactionUseCase)
+		output, err := useCase.Execute(ctx, watchusecase.CreateTransactionInput{
+			ActionType:    actionType.String(),
+			AdjustmentFee: fee,
+		})
+		if err != nil {
+			return fmt.Errorf("fail to create %s transaction: %w", actionType, err)
+		}
+		if output.FileName != "" {
+			logger.Info("unsigned transaction file is created", "action", actionType.String(), "file", output.FileName)
+		}
+		return nil
+	}
+}
+
+func runStatus(container di.Container) error {
+	jobs, err := container.NewWatchDaemonJobRepo().GetAll(context.Background())
+	if err != nil {
+		return fmt.Errorf("fail to get job status: %w", err)
+	}
+	if len(jobs) == 0 {
+		fmt.Println("No job has run yet")
+		return nil
+	}
+
+	for _, job := range jobs {
+		fmt.Printf("[%s] status: %s, started: %s, finished: %s",
+			job.Name, job.LastStatus, formatTime(job.LastStartedAt), formatTime(job.LastFinishedAt))
+		if job.LastError != "" {
+			fmt.Printf(", error: %s", job.LastError)
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+func formatTime(t null.Time) string {
+	if !t.Valid {
+		return "-"
+	}
+	return t.Time.Format(time.RFC3339)
+}
@@ -0,0 +1,28 @@
+package imports
+
+import (
+	"context"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+)
+
+func runForwarder(container di.Container, count uint32) error {
+	// Get use case from container
+	useCase := container.NewWatchGenerateForwarderAddressUseCase()
+
+	// generate forwarder addresses
+	output, err := useCase.Execute(context.Background(), watchusecase.GenerateForwarderAddressInput{
+		Count: count,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to generate forwarder address: %w", err)
+	}
+	for _, addr := range output.Addresses {
+		fmt.Println(addr)
+	}
+	fmt.Println("Done!")
+
+	return nil
+}
@@ -24,4 +24,28 @@ func AddCommands(parentCmd *cobra.Command, wallet *wallets.Watcher, container di
 	addressCmd.Flags().StringVar(&addressFilePath, "file", "", "import file path for generated addresses")
 	addressCmd.Flags().BoolVar(&addressIsRescan, "rescan", false, "run rescan when importing addresses or not")
 	parentCmd.AddCommand(addressCmd)
+
+	// forwarder command
+	var forwarderCount uint32
+	forwarderCmd := &cobra.Command{
+		Use:   "forwarder",
+		Short: "generate CREATE2 forwarder addresses for client account without keygen wallet (only EVM chain)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runForwarder(container, forwarderCount)
+		},
+	}
+	forwarderCmd.Flags().Uint32Var(&forwarderCount, "count", 10, "the number of generated addresses")
+	parentCmd.AddCommand(forwarderCmd)
+
+	// tag command
+	var tagCount uint32
+	tagCmd := &cobra.Command{
+		Use:   "tag",
+		Short: "generate X-addresses with destination tag of shared deposit address for client account (only XRP)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTag(container, tagCount)
+		},
+	}
+	tagCmd.Flags().Uint32Var(&tagCount, "count", 10, "the number of generated addresses")
+	parentCmd.AddCommand(tagCmd)
 }
@@ -0,0 +1,28 @@
+package imports
+
+import (
+	"context"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+)
+
+func runTag(container di.Container, count uint32) error {
+	// Get use case from container
+	useCase := container.NewWatchGenerateDepositTagAddressUseCase()
+
+	// generate X-addresses with destination tag
+	output, err := useCase.Execute(context.Background(), watchusecase.GenerateDepositTagAddressInput{
+		Count: count,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to generate X-address with destination tag: %w", err)
+	}
+	for _, addr := range output.Addresses {
+		fmt.Println(addr)
+	}
+	fmt.Println("Done!")
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+)
+
+func runDeposit(container di.Container, confirmationNum uint64) error {
+	// Get use case from container
+	useCase := container.NewWatchScanDepositUseCase()
+
+	if err := useCase.Execute(context.Background(), watchusecase.ScanDepositInput{
+		ConfirmationNum: confirmationNum,
+	}); err != nil {
+		return fmt.Errorf("fail to scan deposit: %w", err)
+	}
+
+	return nil
+}
@@ -32,4 +32,42 @@ func AddCommands(parentCmd *cobra.Command, wallet *wallets.Watcher, container di
 	}
 	balanceCmd.Flags().Uint64Var(&balanceConfirmationNum, "num", 6, "confirmation number")
 	parentCmd.AddCommand(balanceCmd)
+
+	// deposit command
+	var depositConfirmationNum uint64
+	depositCmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "scan blocks for deposits of coin and tokens into client addresses (only ETH group)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDeposit(container, depositConfirmationNum)
+		},
+	}
+	depositCmd.Flags().Uint64Var(&depositConfirmationNum, "num", 12, "confirmation number")
+	parentCmd.AddCommand(depositCmd)
+
+	// stream command
+	streamCmd := &cobra.Command{
+		Use:   "stream",
+		Short: "monitor transactions by notifications from node until interrupted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runStream(container)
+		},
+	}
+	parentCmd.AddCommand(streamCmd)
+
+	// quarantine command
+	var (
+		quarantineID     int64
+		quarantineClient string
+	)
+	quarantineCmd := &cobra.Command{
+		Use:   "quarantine",
+		Short: "list quarantined deposits, or attribute one to client by --id and --client (only XRP)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runQuarantine(container, quarantineID, quarantineClient)
+		},
+	}
+	quarantineCmd.Flags().Int64Var(&quarantineID, "id", 0, "id of quarantined deposit to resolve")
+	quarantineCmd.Flags().StringVar(&quarantineClient, "client", "", "X-address of client to attribute deposit to")
+	parentCmd.AddCommand(quarantineCmd)
 }
@@ -0,0 +1,54 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+)
+
+func runQuarantine(container di.Container, id int64, clientAddress string) error {
+	// Get use case from container
+	useCase := container.NewWatchQuarantinedDepositUseCase()
+
+	// resolve
+	if id != 0 || clientAddress != "" {
+		if id == 0 || clientAddress == "" {
+			return errors.New("both of id option [-id] and client option [-client] are required to resolve")
+		}
+		if err := useCase.Resolve(context.Background(), watchusecase.ResolveQuarantinedDepositInput{
+			ID:            id,
+			ClientAddress: clientAddress,
+		}); err != nil {
+			return fmt.Errorf("fail to resolve quarantined deposit: %w", err)
+		}
+		fmt.Println("Done!")
+		return nil
+	}
+
+	// list
+	output, err := useCase.List(context.Background())
+	if err != nil {
+		return fmt.Errorf("fail to list quarantined deposits: %w", err)
+	}
+	if len(output.Deposits) == 0 {
+		fmt.Println("No quarantined deposit")
+		return nil
+	}
+	// TODO: output should be json if json option is true
+	for _, deposit := range output.Deposits {
+		tag := "-"
+		if deposit.DestinationTag != nil {
+			tag = fmt.Sprint(*deposit.DestinationTag)
+		}
+		currency := "XRP(drops)"
+		if deposit.Currency != "" {
+			currency = fmt.Sprintf("%s.%s", deposit.Currency, deposit.Issuer)
+		}
+		fmt.Printf("[%d] hash: %s, ledger: %d, from: %s, tag: %s, amount: %s %s\n",
+			deposit.ID, deposit.TxHash, deposit.LedgerIndex, deposit.Sender, tag, deposit.Amount, currency)
+	}
+	return nil
+}
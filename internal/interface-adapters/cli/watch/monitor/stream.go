@@ -0,0 +1,35 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/hiromaily/go-crypto-wallet/internal/di"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+func runStream(container di.Container) error {
+	// Get use case from container
+	useCase := container.NewWatchStreamMonitorUseCase()
+
+	// run until SIGINT/SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if server := container.NewWatchMetricsServer(); server != nil {
+		go func() {
+			if err := server.Serve(ctx); err != nil {
+				logger.Error("metrics server stopped", "error", err)
+			}
+		}()
+	}
+
+	if err := useCase.Run(ctx); err != nil {
+		return fmt.Errorf("fail to monitor stream: %w", err)
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/watch/api/eth"
 	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/watch/api/xrp"
 	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/watch/create"
+	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/watch/daemon"
 	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/watch/imports"
 	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/watch/monitor"
 	"github.com/hiromaily/go-crypto-wallet/internal/interface-adapters/cli/watch/send"
@@ -56,6 +57,10 @@ func AddCommands(
 	rootCmd.AddCommand(monitorCmd)
 	monitor.AddCommands(monitorCmd, wallet, container)
 
+	// Daemon command
+	daemonCmd := daemon.AddCommand(container, confPtr)
+	rootCmd.AddCommand(daemonCmd)
+
 	// API command - wallet-type specific, dynamically configured
 	apiCmd := &cobra.Command{
 		Use:   "api",
@@ -73,7 +78,7 @@ func AddCommands(
 			case *btcwallet.BTCWatch:
 				btc.AddCommands(cmd, v.BTC)
 			case *ethwallet.ETHWatch:
-				eth.AddCommands(cmd, v.ETH)
+				eth.AddCommands(cmd, v.ETH, v.Safe, v.Forwarder)
 			case *xrpwallet.XRPWatch:
 				xrp.AddCommands(cmd, v.XRP, &confPtr.Ripple.API.TxData)
 			}
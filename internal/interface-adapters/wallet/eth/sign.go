@@ -1,72 +1,115 @@
 package eth
 
 import (
+	"context"
 	"database/sql"
 
+	signusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/sign"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
 	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
 	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
-	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ethereum"
-	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
 )
 
-// ETHSign keygen wallet object
+// ETHSign is sign wallet object
+//   - auth key is owner of Safe multisig contract, it signs on safeTxHash offline
 type ETHSign struct {
-	ETH    ethereum.Ethereumer
-	dbConn *sql.DB
-	wtype  domainWallet.WalletType
+	dbConn                  *sql.DB
+	authAccount             domainAccount.AuthType
+	wtype                   domainWallet.WalletType
+	generateSeedUseCase     signusecase.GenerateSeedUseCase
+	storeSeedUseCase        signusecase.StoreSeedUseCase
+	generateAuthKeyUseCase  signusecase.GenerateAuthKeyUseCase
+	importPrivKeyUseCase    signusecase.ImportPrivateKeyUseCase
+	exportFullPubkeyUseCase signusecase.ExportFullPubkeyUseCase
+	signTxUseCase           signusecase.SignTransactionUseCase
 }
 
 // NewETHSign returns ETHSign object
 func NewETHSign(
-	eth ethereum.Ethereumer,
 	dbConn *sql.DB,
+	authAccount domainAccount.AuthType,
+	generateSeedUseCase signusecase.GenerateSeedUseCase,
+	storeSeedUseCase signusecase.StoreSeedUseCase,
+	generateAuthKeyUseCase signusecase.GenerateAuthKeyUseCase,
+	importPrivKeyUseCase signusecase.ImportPrivateKeyUseCase,
+	exportFullPubkeyUseCase signusecase.ExportFullPubkeyUseCase,
+	signTxUseCase signusecase.SignTransactionUseCase,
 	walletType domainWallet.WalletType,
 ) *ETHSign {
 	return &ETHSign{
-		ETH:    eth,
-		dbConn: dbConn,
-		wtype:  walletType,
+		dbConn:                  dbConn,
+		authAccount:             authAccount,
+		wtype:                   walletType,
+		generateSeedUseCase:     generateSeedUseCase,
+		storeSeedUseCase:        storeSeedUseCase,
+		generateAuthKeyUseCase:  generateAuthKeyUseCase,
+		importPrivKeyUseCase:    importPrivKeyUseCase,
+		exportFullPubkeyUseCase: exportFullPubkeyUseCase,
+		signTxUseCase:           signTxUseCase,
 	}
 }
 
 // GenerateSeed generates seed
-func (*ETHSign) GenerateSeed() ([]byte, error) {
-	logger.Info("no functionality for CreateMultisigAddress() in ETH")
-	return nil, nil
+func (s *ETHSign) GenerateSeed() ([]byte, error) {
+	output, err := s.generateSeedUseCase.Generate(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return output.Seed, nil
 }
 
 // StoreSeed stores seed
-func (*ETHSign) StoreSeed(_ string) ([]byte, error) {
-	logger.Info("no functionality for CreateMultisigAddress() in ETH")
-	return nil, nil
+func (s *ETHSign) StoreSeed(strSeed string) ([]byte, error) {
+	output, err := s.storeSeedUseCase.Store(context.Background(), signusecase.StoreSeedInput{
+		Seed: strSeed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Seed, nil
 }
 
 // GenerateAuthKey generates account keys
-func (*ETHSign) GenerateAuthKey(_ []byte, _ uint32) ([]domainKey.WalletKey, error) {
-	logger.Info("no functionality for CreateMultisigAddress() in ETH")
+func (s *ETHSign) GenerateAuthKey(seed []byte, count uint32) ([]domainKey.WalletKey, error) {
+	_, err := s.generateAuthKeyUseCase.Generate(context.Background(), signusecase.GenerateAuthKeyInput{
+		AuthType: s.authAccount,
+		Seed:     seed,
+		Count:    count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Note: Use case returns count, not keys. Keys are stored in database.
 	return nil, nil
 }
 
-// ImportPrivKey imports privKey
-func (*ETHSign) ImportPrivKey() error {
-	logger.Info("no functionality for CreateMultisigAddress() in ETH")
-	return nil
+// ImportPrivKey validates privKey
+func (s *ETHSign) ImportPrivKey() error {
+	return s.importPrivKeyUseCase.Import(context.Background(), signusecase.ImportPrivateKeyInput{})
 }
 
 // ExportFullPubkey exports full-pubkey
-func (*ETHSign) ExportFullPubkey() (string, error) {
-	logger.Info("no functionality for CreateMultisigAddress() in ETH")
-	return "", nil
+func (s *ETHSign) ExportFullPubkey() (string, error) {
+	output, err := s.exportFullPubkeyUseCase.Export(context.Background())
+	if err != nil {
+		return "", err
+	}
+	return output.FileName, nil
 }
 
-// SignTx signs on transaction
-func (*ETHSign) SignTx(_ string) (string, bool, string, error) {
-	logger.Info("no functionality for CreateMultisigAddress() in ETH")
-	return "", false, "", nil
+// SignTx signs on safeTxHash of transaction
+func (s *ETHSign) SignTx(filePath string) (string, bool, string, error) {
+	output, err := s.signTxUseCase.Sign(context.Background(), signusecase.SignTransactionInput{
+		FilePath: filePath,
+	})
+	if err != nil {
+		return "", false, "", err
+	}
+
+	return output.SignedData, output.IsComplete, output.NextFilePath, nil
 }
 
 // Done should be called before exit
 func (s *ETHSign) Done() {
 	_ = s.dbConn.Close() // Best effort cleanup
-	s.ETH.Close()
 }
@@ -15,6 +15,8 @@ import (
 // ETHWatch watch only wallet object
 type ETHWatch struct {
 	ETH                     ethereum.Ethereumer
+	Safe                    ethereum.Safer
+	Forwarder               ethereum.Forwarder
 	dbConn                  *sql.DB
 	wtype                   domainWallet.WalletType
 	createTxUseCase         watchusecase.CreateTransactionUseCase
@@ -27,6 +29,8 @@ type ETHWatch struct {
 // NewETHWatch returns ETHWatch object
 func NewETHWatch(
 	eth ethereum.Ethereumer,
+	safe ethereum.Safer,
+	forwarder ethereum.Forwarder,
 	dbConn *sql.DB,
 	createTxUseCase watchusecase.CreateTransactionUseCase,
 	monitorTxUseCase watchusecase.MonitorTransactionUseCase,
@@ -37,6 +41,8 @@ func NewETHWatch(
 ) *ETHWatch {
 	return &ETHWatch{
 		ETH:                     eth,
+		Safe:                    safe,
+		Forwarder:               forwarder,
 		dbConn:                  dbConn,
 		wtype:                   walletType,
 		createTxUseCase:         createTxUseCase,
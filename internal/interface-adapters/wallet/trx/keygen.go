@@ -0,0 +1,134 @@
+package trx
+
+import (
+	"context"
+	"database/sql"
+
+	keygenusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/keygen"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
+	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron"
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// TRXKeygen keygen wallet object
+type TRXKeygen struct {
+	TRX                     tron.Troner
+	dbConn                  *sql.DB
+	wtype                   domainWallet.WalletType
+	generateSeedUseCase     keygenusecase.GenerateSeedUseCase
+	generateHDWalletUseCase keygenusecase.GenerateHDWalletUseCase
+	exportAddressUseCase    keygenusecase.ExportAddressUseCase
+	signTxUseCase           keygenusecase.SignTransactionUseCase
+}
+
+// NewTRXKeygen returns TRXKeygen object
+func NewTRXKeygen(
+	trx tron.Troner,
+	dbConn *sql.DB,
+	walletType domainWallet.WalletType,
+	generateSeedUseCase keygenusecase.GenerateSeedUseCase,
+	generateHDWalletUseCase keygenusecase.GenerateHDWalletUseCase,
+	exportAddressUseCase keygenusecase.ExportAddressUseCase,
+	signTxUseCase keygenusecase.SignTransactionUseCase,
+) *TRXKeygen {
+	return &TRXKeygen{
+		TRX:                     trx,
+		dbConn:                  dbConn,
+		wtype:                   walletType,
+		generateSeedUseCase:     generateSeedUseCase,
+		generateHDWalletUseCase: generateHDWalletUseCase,
+		exportAddressUseCase:    exportAddressUseCase,
+		signTxUseCase:           signTxUseCase,
+	}
+}
+
+// GenerateSeed generates seed
+func (k *TRXKeygen) GenerateSeed() ([]byte, error) {
+	output, err := k.generateSeedUseCase.Generate(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return output.Seed, nil
+}
+
+// StoreSeed stores seed
+func (k *TRXKeygen) StoreSeed(strSeed string) ([]byte, error) {
+	output, err := k.generateSeedUseCase.Store(context.Background(), keygenusecase.StoreSeedInput{
+		Seed: strSeed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Seed, nil
+}
+
+// GenerateAccountKey generates account keys
+func (k *TRXKeygen) GenerateAccountKey(
+	accountType domainAccount.AccountType, seed []byte, count uint32, _ bool,
+) ([]domainKey.WalletKey, error) {
+	_, err := k.generateHDWalletUseCase.Generate(context.Background(), keygenusecase.GenerateHDWalletInput{
+		AccountType: accountType,
+		Seed:        seed,
+		Count:       count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Note: The original implementation returns keys but use case returns count
+	// For now, return nil as the keys are stored in the database
+	return nil, nil
+}
+
+// ImportPrivKey imports privKey
+func (*TRXKeygen) ImportPrivKey(_ domainAccount.AccountType) error {
+	// private key is kept in account_key table and used to sign offline
+	logger.Info("no functionality for ImportPrivKey() in TRX")
+	return nil
+}
+
+// ImportFullPubKey imports full-pubkey
+func (*TRXKeygen) ImportFullPubKey(_ string) error {
+	// return k.FullPubKeyImporter.ImportFullPubKey(fileName)
+	logger.Info("no functionality for ImportFullPubKey() in TRX")
+	return nil
+}
+
+// CreateMultisigAddress creates multi sig address returns Multisiger interface
+func (*TRXKeygen) CreateMultisigAddress(_ domainAccount.AccountType) error {
+	logger.Info("no functionality for CreateMultisigAddress() in TRX")
+	return nil
+}
+
+// ExportAddress exports address
+func (k *TRXKeygen) ExportAddress(accountType domainAccount.AccountType) (string, error) {
+	output, err := k.exportAddressUseCase.Export(context.Background(), keygenusecase.ExportAddressInput{
+		AccountType: accountType,
+	})
+	if err != nil {
+		return "", err
+	}
+	return output.FileName, nil
+}
+
+// SignTx signs on transaction
+func (k *TRXKeygen) SignTx(filePath string) (string, bool, string, error) {
+	output, err := k.signTxUseCase.Sign(context.Background(), keygenusecase.SignTransactionInput{
+		FilePath: filePath,
+	})
+	if err != nil {
+		return "", false, "", err
+	}
+
+	// Determine if signing is done based on signed vs unsigned count
+	isDone := output.UnsignedCount == 0
+
+	return output.FilePath, isDone, "", nil
+}
+
+// Done should be called before exit
+func (k *TRXKeygen) Done() {
+	_ = k.dbConn.Close() // Best effort cleanup
+	k.TRX.Close()
+}
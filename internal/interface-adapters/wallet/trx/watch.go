@@ -0,0 +1,139 @@
+package trx
+
+import (
+	"context"
+	"database/sql"
+
+	watchusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/watch"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
+	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/tron"
+)
+
+// TRXWatch watch only wallet object
+type TRXWatch struct {
+	TRX                     tron.Troner
+	dbConn                  *sql.DB
+	wtype                   domainWallet.WalletType
+	createTxUseCase         watchusecase.CreateTransactionUseCase
+	monitorTxUseCase        watchusecase.MonitorTransactionUseCase
+	sendTxUseCase           watchusecase.SendTransactionUseCase
+	importAddrUseCase       watchusecase.ImportAddressUseCase
+	createPaymentReqUseCase watchusecase.CreatePaymentRequestUseCase
+}
+
+// NewTRXWatch returns TRXWatch object
+func NewTRXWatch(
+	trx tron.Troner,
+	dbConn *sql.DB,
+	createTxUseCase watchusecase.CreateTransactionUseCase,
+	monitorTxUseCase watchusecase.MonitorTransactionUseCase,
+	sendTxUseCase watchusecase.SendTransactionUseCase,
+	importAddrUseCase watchusecase.ImportAddressUseCase,
+	createPaymentReqUseCase watchusecase.CreatePaymentRequestUseCase,
+	walletType domainWallet.WalletType,
+) *TRXWatch {
+	return &TRXWatch{
+		TRX:                     trx,
+		dbConn:                  dbConn,
+		wtype:                   walletType,
+		createTxUseCase:         createTxUseCase,
+		monitorTxUseCase:        monitorTxUseCase,
+		sendTxUseCase:           sendTxUseCase,
+		importAddrUseCase:       importAddrUseCase,
+		createPaymentReqUseCase: createPaymentReqUseCase,
+	}
+}
+
+// ImportAddress imports address
+func (w *TRXWatch) ImportAddress(fileName string, _ bool) error {
+	return w.importAddrUseCase.Execute(context.Background(), watchusecase.ImportAddressInput{
+		FileName: fileName,
+		Rescan:   false, // TRX doesn't support rescan
+	})
+}
+
+// createTx is a helper method to reduce code duplication across transaction creation methods
+func (w *TRXWatch) createTx(input watchusecase.CreateTransactionInput) (string, string, error) {
+	output, err := w.createTxUseCase.Execute(context.Background(), input)
+	if err != nil {
+		return "", "", err
+	}
+	return output.TransactionHex, output.FileName, nil
+}
+
+// CreateDepositTx creates deposit unsigned transaction
+func (w *TRXWatch) CreateDepositTx(_ float64) (string, string, error) {
+	return w.createTx(watchusecase.CreateTransactionInput{
+		ActionType: domainTx.ActionTypeDeposit.String(),
+	})
+}
+
+// CreatePaymentTx creates payment unsigned transaction
+func (w *TRXWatch) CreatePaymentTx(_ float64) (string, string, error) {
+	return w.createTx(watchusecase.CreateTransactionInput{
+		ActionType: domainTx.ActionTypePayment.String(),
+	})
+}
+
+// CreateTransferTx creates transfer unsigned transaction
+func (w *TRXWatch) CreateTransferTx(
+	sender, receiver domainAccount.AccountType, floatAmount, _ float64,
+) (string, string, error) {
+	return w.createTx(watchusecase.CreateTransactionInput{
+		ActionType:      domainTx.ActionTypeTransfer.String(),
+		SenderAccount:   sender,
+		ReceiverAccount: receiver,
+		Amount:          floatAmount,
+	})
+}
+
+// UpdateTxStatus updates transaction status
+func (w *TRXWatch) UpdateTxStatus() error {
+	return w.monitorTxUseCase.UpdateTxStatus(context.Background())
+}
+
+// MonitorBalance monitors balance
+func (w *TRXWatch) MonitorBalance(confirmationNum uint64) error {
+	return w.monitorTxUseCase.MonitorBalance(context.Background(), watchusecase.MonitorBalanceInput{
+		ConfirmationNum: confirmationNum,
+	})
+}
+
+// SendTx sends signed transaction
+func (w *TRXWatch) SendTx(filePath string) (string, error) {
+	output, err := w.sendTxUseCase.Execute(context.Background(), watchusecase.SendTransactionInput{
+		FilePath: filePath,
+	})
+	if err != nil {
+		return "", err
+	}
+	return output.TxID, nil
+}
+
+// CreatePaymentRequest creates payment_request dummy data for development
+func (w *TRXWatch) CreatePaymentRequest() error {
+	amtList := []float64{
+		0.001,
+		0.002,
+		0.0025,
+		0.0015,
+		0.003,
+	}
+	return w.createPaymentReqUseCase.Execute(context.Background(), watchusecase.CreatePaymentRequestInput{
+		AmountList: amtList,
+	})
+}
+
+// Done should be called before exit
+func (w *TRXWatch) Done() {
+	_ = w.dbConn.Close() // Best effort cleanup
+	w.TRX.Close()
+}
+
+// CoinTypeCode returns domainCoin.CoinTypeCode
+func (w *TRXWatch) CoinTypeCode() domainCoin.CoinTypeCode {
+	return w.TRX.CoinTypeCode()
+}
@@ -1 +1,121 @@
 package xrp
+
+import (
+	"context"
+	"database/sql"
+
+	signusecase "github.com/hiromaily/go-crypto-wallet/internal/application/usecase/sign"
+	domainAccount "github.com/hiromaily/go-crypto-wallet/internal/domain/account"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
+	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
+)
+
+// XRPSign is sign wallet object
+//   - XRP key of auth account is one of signers in SignerList of multisig account
+type XRPSign struct {
+	XRP                     ripple.Rippler
+	dbConn                  *sql.DB
+	authAccount             domainAccount.AuthType
+	wtype                   domainWallet.WalletType
+	generateSeedUseCase     signusecase.GenerateSeedUseCase
+	storeSeedUseCase        signusecase.StoreSeedUseCase
+	generateAuthKeyUseCase  signusecase.GenerateAuthKeyUseCase
+	importPrivKeyUseCase    signusecase.ImportPrivateKeyUseCase
+	exportFullPubkeyUseCase signusecase.ExportFullPubkeyUseCase
+	signTxUseCase           signusecase.SignTransactionUseCase
+}
+
+// NewXRPSign returns XRPSign object
+func NewXRPSign(
+	xrp ripple.Rippler,
+	dbConn *sql.DB,
+	authAccount domainAccount.AuthType,
+	generateSeedUseCase signusecase.GenerateSeedUseCase,
+	storeSeedUseCase signusecase.StoreSeedUseCase,
+	generateAuthKeyUseCase signusecase.GenerateAuthKeyUseCase,
+	importPrivKeyUseCase signusecase.ImportPrivateKeyUseCase,
+	exportFullPubkeyUseCase signusecase.ExportFullPubkeyUseCase,
+	signTxUseCase signusecase.SignTransactionUseCase,
+	walletType domainWallet.WalletType,
+) *XRPSign {
+	return &XRPSign{
+		XRP:                     xrp,
+		dbConn:                  dbConn,
+		authAccount:             authAccount,
+		wtype:                   walletType,
+		generateSeedUseCase:     generateSeedUseCase,
+		storeSeedUseCase:        storeSeedUseCase,
+		generateAuthKeyUseCase:  generateAuthKeyUseCase,
+		importPrivKeyUseCase:    importPrivKeyUseCase,
+		exportFullPubkeyUseCase: exportFullPubkeyUseCase,
+		signTxUseCase:           signTxUseCase,
+	}
+}
+
+// GenerateSeed generates seed
+func (s *XRPSign) GenerateSeed() ([]byte, error) {
+	output, err := s.generateSeedUseCase.Generate(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return output.Seed, nil
+}
+
+// StoreSeed stores seed
+func (s *XRPSign) StoreSeed(strSeed string) ([]byte, error) {
+	output, err := s.storeSeedUseCase.Store(context.Background(), signusecase.StoreSeedInput{
+		Seed: strSeed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.Seed, nil
+}
+
+// GenerateAuthKey generates account keys
+func (s *XRPSign) GenerateAuthKey(seed []byte, count uint32) ([]domainKey.WalletKey, error) {
+	_, err := s.generateAuthKeyUseCase.Generate(context.Background(), signusecase.GenerateAuthKeyInput{
+		AuthType: s.authAccount,
+		Seed:     seed,
+		Count:    count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Note: Use case returns count, not keys. Keys are stored in database.
+	return nil, nil
+}
+
+// ImportPrivKey generates XRP key of signer from auth key
+func (s *XRPSign) ImportPrivKey() error {
+	return s.importPrivKeyUseCase.Import(context.Background(), signusecase.ImportPrivateKeyInput{})
+}
+
+// ExportFullPubkey exports XRP account of signer
+func (s *XRPSign) ExportFullPubkey() (string, error) {
+	output, err := s.exportFullPubkeyUseCase.Export(context.Background())
+	if err != nil {
+		return "", err
+	}
+	return output.FileName, nil
+}
+
+// SignTx multisigns on transaction
+func (s *XRPSign) SignTx(filePath string) (string, bool, string, error) {
+	output, err := s.signTxUseCase.Sign(context.Background(), signusecase.SignTransactionInput{
+		FilePath: filePath,
+	})
+	if err != nil {
+		return "", false, "", err
+	}
+
+	return output.SignedData, output.IsComplete, output.NextFilePath, nil
+}
+
+// Done should be called before exit
+func (s *XRPSign) Done() {
+	_ = s.dbConn.Close() // Best effort cleanup
+
+	_ = s.XRP.Close() // Best effort cleanup
+}
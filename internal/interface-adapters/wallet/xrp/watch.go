@@ -10,7 +10,6 @@ import (
 	domainTx "github.com/hiromaily/go-crypto-wallet/internal/domain/transaction"
 	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/api/ripple"
-	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
 )
 
 // XRPWatch watch only wallet object
@@ -92,9 +91,8 @@ func (w *XRPWatch) CreateTransferTx(
 }
 
 // UpdateTxStatus updates transaction status
-func (*XRPWatch) UpdateTxStatus() error {
-	logger.Info("no functionality for XRP")
-	return nil
+func (w *XRPWatch) UpdateTxStatus() error {
+	return w.monitorTxUseCase.UpdateTxStatus(context.Background())
 }
 
 // MonitorBalance monitors balance
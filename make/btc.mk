@@ -29,6 +29,16 @@ up-docker-btc:
 up-docker-bch:
 	docker compose -f compose.bch.yaml up bch-watch
 
+# run litecoin core server
+.PHONY: up-docker-ltc
+up-docker-ltc:
+	docker compose -f compose.ltc.yaml up ltc-watch ltc-keygen ltc-sign
+
+# run dogecoin core server
+.PHONY: up-docker-doge
+up-docker-doge:
+	docker compose -f compose.doge.yaml up doge-watch doge-keygen doge-sign
+
 ###############################################################################
 # auto key generator
 ###############################################################################
@@ -39,3 +49,11 @@ generate-btc-key-local:
 .PHONY: generate-bch-key-local
 generate-bch-key-local:
 	./scripts/operation/generate-btc-key.sh bch false 5
+
+.PHONY: generate-ltc-key-local
+generate-ltc-key-local:
+	./scripts/operation/generate-btc-key.sh ltc false 5
+
+.PHONY: generate-doge-key-local
+generate-doge-key-local:
+	./scripts/operation/generate-btc-key.sh doge false 5
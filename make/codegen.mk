@@ -6,7 +6,7 @@
 # sqlc
 #------------------------------------------------------------------------------
 # Generate Go code from SQL queries using sqlc
-# Schemas: tools/sqlc/schemas/*.sql
+# Schemas: internal/infrastructure/database/migration/{driver}/{set}/*.up.sql
 # Queries: tools/sqlc/queries/*.sql
 # Output: pkg/db/rdb/sqlcgen/
 #------------------------------------------------------------------------------
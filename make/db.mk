@@ -11,10 +11,20 @@
 up-docker-db:
 	docker compose up wallet-db
 
+# run consolidated PostgreSQL database, used when `[database] driver = "postgres"`
+.PHONY: up-docker-pg
+up-docker-pg:
+	docker compose --profile postgres up wallet-pg
+
 # remove database volume
 .PHONY: rm-db-volumes
 rm-db-volumes:
-	docker volume rm -f go-crypto-wallet_wallet-db
+	docker volume rm -f go-crypto-wallet_wallet-db go-crypto-wallet_wallet-pg
+
+# run repository integration tests against PostgreSQL
+.PHONY: test-repository-pg
+test-repository-pg:
+	DB_DRIVER=postgres go test -v -tags=integration ./internal/infrastructure/repository/watch/...
 
 ###############################################################################
 # Schema Export Targets
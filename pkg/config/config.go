@@ -3,12 +3,15 @@ package config
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/spf13/viper"
 
 	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
+	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
 	domainWallet "github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
 )
 
 // NewWallet creates wallet config
@@ -26,6 +29,11 @@ func NewWallet(file string, wtype domainWallet.WalletType, coinTypeCode domainCo
 	// debug
 	// debug.Debug(conf)
 
+	// coin of EVM chain in config is available as coin type code
+	if err = conf.Ethereum.registerNetworks(); err != nil {
+		return nil, err
+	}
+
 	// validate
 	if err = conf.validate(wtype, coinTypeCode); err != nil {
 		return nil, err
@@ -52,15 +60,42 @@ func loadWallet(path string) (*WalletRoot, error) {
 	return &config, nil
 }
 
+// maxTronExpiration is max expiration of transaction which Tron accepts
+const maxTronExpiration = 24 * time.Hour
+
 // validate config
 func (c *WalletRoot) validate(wtype domainWallet.WalletType, coinTypeCode domainCoin.CoinTypeCode) error {
 	validate := validator.New()
 
+	// only section of selected database is validated
+	var dbExcept []string
+	switch c.Database.Driver {
+	case DriverPostgres:
+		dbExcept = []string{"MySQL", "SQLite"}
+	case DriverSQLite:
+		if wtype == domainWallet.WalletTypeWatchOnly {
+			return errors.New("sqlite is available only for keygen and sign wallet")
+		}
+		dbExcept = []string{"MySQL", "Postgres"}
+	default:
+		dbExcept = []string{"Postgres", "SQLite"}
+	}
+
 	switch coinTypeCode {
-	case domainCoin.BTC, domainCoin.BCH:
-		if err := validate.StructExcept(c, "Ethereum", "Ripple"); err != nil {
+	case domainCoin.BTC, domainCoin.BCH, domainCoin.LTC, domainCoin.DOGE:
+		except := []string{"Ethereum", "Ripple", "Solana", "Tron"}
+		if err := validate.StructExcept(c, append(except, dbExcept...)...); err != nil {
 			return err
 		}
+		// segwit is not activated on dogecoin
+		if coinTypeCode == domainCoin.DOGE {
+			if c.AddressType != address.AddrTypeLegacy {
+				return errors.New("only legacy address_type is available for doge")
+			}
+			if c.KeyType != "" && c.KeyType != domainKey.KeyTypeBIP44 {
+				return errors.New("only bip44 key_type is available for doge")
+			}
+		}
 		switch wtype {
 		case domainWallet.WalletTypeWatchOnly:
 			if c.Bitcoin.Block.ConfirmationNum == 0 {
@@ -70,19 +105,102 @@ func (c *WalletRoot) validate(wtype domainWallet.WalletType, coinTypeCode domain
 			// No additional validation needed
 		default:
 		}
-	case domainCoin.ETH, domainCoin.ERC20:
-		if err := validate.StructExcept(c, "AddressType", "Bitcoin", "Ripple"); err != nil {
+	case domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE, domainCoin.ERC20:
+		except := []string{"AddressType", "Bitcoin", "Ripple", "Solana", "Tron"}
+		if err := validate.StructExcept(c, append(except, dbExcept...)...); err != nil {
 			return err
 		}
 	case domainCoin.XRP:
-		if err := validate.StructExcept(c, "AddressType", "Bitcoin", "Ethereum"); err != nil {
+		except := []string{"AddressType", "Bitcoin", "Ethereum", "Solana", "Tron"}
+		if err := validate.StructExcept(c, append(except, dbExcept...)...); err != nil {
+			return err
+		}
+		if c.Ripple.IssuedCurrency != "" {
+			if err := c.ValidateIssuedCurrency(c.Ripple.IssuedCurrency); err != nil {
+				return err
+			}
+		}
+	case domainCoin.SOL:
+		except := []string{"AddressType", "Bitcoin", "Ethereum", "Ripple", "Tron"}
+		if err := validate.StructExcept(c, append(except, dbExcept...)...); err != nil {
 			return err
 		}
-	case domainCoin.LTC, domainCoin.HYT:
+		if wtype == domainWallet.WalletTypeWatchOnly && c.Solana.RPCURL == "" {
+			return errors.New("solana rpc_url is required in toml file")
+		}
+		if c.Solana.SPLToken != "" {
+			if err := c.ValidateSPLToken(c.Solana.SPLToken); err != nil {
+				return err
+			}
+		}
+	case domainCoin.TRX:
+		except := []string{"AddressType", "Bitcoin", "Ethereum", "Ripple", "Solana"}
+		if err := validate.StructExcept(c, append(except, dbExcept...)...); err != nil {
+			return err
+		}
+		if wtype == domainWallet.WalletTypeWatchOnly {
+			if c.Tron.FullNodeURL == "" {
+				return errors.New("tron full_node_url is required in toml file")
+			}
+			if c.Tron.ConfirmationNum == 0 {
+				return errors.New("tron confirmation_num is required in toml file")
+			}
+		}
+		if c.Tron.Expiration > maxTronExpiration {
+			return fmt.Errorf("tron expiration must be less than or equal to %s", maxTronExpiration)
+		}
+		if c.Tron.TRC20Token != "" {
+			if err := c.ValidateTRC20Token(c.Tron.TRC20Token); err != nil {
+				return err
+			}
+		}
+	case domainCoin.HYT:
 		// Not implemented yet
 	default:
+		// EVM chain defined in ethereum.networks
+		if domainCoin.IsEVMChain(coinTypeCode) {
+			except := []string{"AddressType", "Bitcoin", "Ripple", "Solana", "Tron"}
+			if err := validate.StructExcept(c, append(except, dbExcept...)...); err != nil {
+				return err
+			}
+		}
+	}
+
+	// ERC-20 token is also sent on EVM chain
+	if domainCoin.IsETHGroup(coinTypeCode) {
+		if err := c.Ethereum.fillNetwork(coinTypeCode); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateSPLToken validates SPL token information is defined
+func (c *WalletRoot) ValidateSPLToken(token domainCoin.SPLToken) error {
+	if _, ok := c.Solana.SPLTokens[token]; !ok {
+		return fmt.Errorf("spl token information for [%s] is required", token.String())
 	}
+	return nil
+}
+
+// ValidateTRC20Token validates TRC-20 token information is defined
+func (c *WalletRoot) ValidateTRC20Token(token domainCoin.TRC20Token) error {
+	if _, ok := c.Tron.TRC20s[token]; !ok {
+		return fmt.Errorf("trc20 token information for [%s] is required", token.String())
+	}
+	return nil
+}
 
+// ValidateIssuedCurrency validates issued currency information of XRP Ledger is defined
+func (c *WalletRoot) ValidateIssuedCurrency(currency domainCoin.IssuedCurrency) error {
+	info, ok := c.Ripple.IssuedCurrencies[currency]
+	if !ok {
+		return fmt.Errorf("issued currency information for [%s] is required", currency.String())
+	}
+	if info.Currency == "" || info.Issuer == "" {
+		return fmt.Errorf("currency and issuer of issued currency [%s] are required", currency.String())
+	}
 	return nil
 }
 
@@ -92,3 +210,81 @@ func (c *WalletRoot) ValidateERC20(token domainCoin.ERC20Token) error {
 	}
 	return nil
 }
+
+// registerNetworks validates networks in config and registers coin of each network as EVM chain
+func (e *Ethereum) registerNetworks() error {
+	for name, network := range e.Networks {
+		if network.ChainID == 0 || network.Symbol == "" || network.CoinTypeCode == "" {
+			return fmt.Errorf("chain_id, symbol and coin are required in ethereum.networks.%s", name)
+		}
+		if (domainCoin.IsCoinTypeCode(network.CoinTypeCode.String()) && !domainCoin.IsEVMChain(network.CoinTypeCode)) ||
+			domainCoin.IsERC20Token(network.CoinTypeCode.String()) {
+			return fmt.Errorf("coin %s of ethereum.networks.%s is not EVM chain", network.CoinTypeCode, name)
+		}
+		domainCoin.RegisterEVMChain(network.CoinTypeCode)
+	}
+	return nil
+}
+
+// network returns network selected by network_type, or by chain_id when network_type is empty
+//   - network in config takes precedence over built-in network
+func (e *Ethereum) network() (EVMNetwork, error) {
+	if e.NetworkType != "" {
+		if network, ok := e.Networks[e.NetworkType]; ok {
+			return network, nil
+		}
+		if network, ok := EVMNetworks[e.NetworkType]; ok {
+			return network, nil
+		}
+		return EVMNetwork{}, fmt.Errorf("ethereum network_type %s is unknown, define it in ethereum.networks",
+			e.NetworkType)
+	}
+	if e.ChainID == 0 {
+		return EVMNetwork{}, errors.New("ethereum network_type or chain_id is required in toml file")
+	}
+	for _, networks := range []map[string]EVMNetwork{e.Networks, EVMNetworks} {
+		for _, network := range networks {
+			if network.ChainID == e.ChainID {
+				return network, nil
+			}
+		}
+	}
+	return EVMNetwork{}, fmt.Errorf("ethereum chain_id %d is unknown, define it in ethereum.networks", e.ChainID)
+}
+
+// fillNetwork fills chain_id and symbol by network and default values of EVM chain
+//   - coin type code must be same as network to keep records of each chain apart in same database
+func (e *Ethereum) fillNetwork(coinTypeCode domainCoin.CoinTypeCode) error {
+	network, err := e.network()
+	if err != nil {
+		return err
+	}
+	if e.ChainID == 0 {
+		e.ChainID = network.ChainID
+	} else if e.ChainID != network.ChainID {
+		return fmt.Errorf("ethereum chain_id %d doesn't match network_type %s", e.ChainID, e.NetworkType)
+	}
+	if e.Symbol == "" {
+		e.Symbol = network.Symbol
+	}
+	if domainCoin.IsEVMChain(coinTypeCode) && coinTypeCode != network.CoinTypeCode {
+		return fmt.Errorf("coin %s is not available on chain_id %d, use %s", coinTypeCode, e.ChainID,
+			network.CoinTypeCode)
+	}
+	if e.Decimals == 0 {
+		e.Decimals = 18
+	}
+	if e.FeeModel == "" {
+		e.FeeModel = FeeModelLegacy
+	}
+	return nil
+}
+
+// IsMainnet returns true if network of chain_id is mainnet
+func (e *Ethereum) IsMainnet() bool {
+	network, err := e.network()
+	if err != nil {
+		return false
+	}
+	return network.IsMainnet
+}
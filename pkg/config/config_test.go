@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -49,6 +50,55 @@ func TestNewWallet(t *testing.T) {
 			coinTypeCode: domainCoin.BTC,
 			wantErr:      false,
 		},
+		{
+			name:         "LTC Watch Wallet",
+			configFile:   filepath.Join(projPath, "data/config/ltc_watch.toml"),
+			walletType:   domainWallet.WalletTypeWatchOnly,
+			coinTypeCode: domainCoin.LTC,
+			wantErr:      false,
+		},
+		{
+			name:         "LTC Keygen Wallet",
+			configFile:   filepath.Join(projPath, "data/config/ltc_keygen.toml"),
+			walletType:   domainWallet.WalletTypeKeyGen,
+			coinTypeCode: domainCoin.LTC,
+			wantErr:      false,
+		},
+		{
+			name:         "LTC Sign Wallet",
+			configFile:   filepath.Join(projPath, "data/config/ltc_sign.toml"),
+			walletType:   domainWallet.WalletTypeSign,
+			coinTypeCode: domainCoin.LTC,
+			wantErr:      false,
+		},
+		{
+			name:         "DOGE Watch Wallet",
+			configFile:   filepath.Join(projPath, "data/config/doge_watch.toml"),
+			walletType:   domainWallet.WalletTypeWatchOnly,
+			coinTypeCode: domainCoin.DOGE,
+			wantErr:      false,
+		},
+		{
+			name:         "DOGE Keygen Wallet",
+			configFile:   filepath.Join(projPath, "data/config/doge_keygen.toml"),
+			walletType:   domainWallet.WalletTypeKeyGen,
+			coinTypeCode: domainCoin.DOGE,
+			wantErr:      false,
+		},
+		{
+			name:         "DOGE Sign Wallet",
+			configFile:   filepath.Join(projPath, "data/config/doge_sign.toml"),
+			walletType:   domainWallet.WalletTypeSign,
+			coinTypeCode: domainCoin.DOGE,
+			wantErr:      false,
+		},
+		{
+			name:         "DOGE with bech32 address type",
+			configFile:   filepath.Join(projPath, "data/config/ltc_watch.toml"),
+			walletType:   domainWallet.WalletTypeWatchOnly,
+			coinTypeCode: domainCoin.DOGE,
+			wantErr:      true,
+		},
 		{
 			name:         "ETH Watch Wallet",
 			configFile:   filepath.Join(projPath, "data/config/eth_watch.toml"),
@@ -70,6 +120,69 @@ func TestNewWallet(t *testing.T) {
 			coinTypeCode: domainCoin.ETH,
 			wantErr:      false,
 		},
+		{
+			name:         "POL Watch Wallet",
+			configFile:   filepath.Join(projPath, "data/config/pol_watch.toml"),
+			walletType:   domainWallet.WalletTypeWatchOnly,
+			coinTypeCode: domainCoin.POL,
+			wantErr:      false,
+		},
+		{
+			name:         "POL Keygen Wallet",
+			configFile:   filepath.Join(projPath, "data/config/pol_keygen.toml"),
+			walletType:   domainWallet.WalletTypeKeyGen,
+			coinTypeCode: domainCoin.POL,
+			wantErr:      false,
+		},
+		{
+			name:         "POL with network of ETH",
+			configFile:   filepath.Join(projPath, "data/config/eth_watch.toml"),
+			walletType:   domainWallet.WalletTypeWatchOnly,
+			coinTypeCode: domainCoin.POL,
+			wantErr:      true,
+		},
+		{
+			name:         "SOL Watch Wallet",
+			configFile:   filepath.Join(projPath, "data/config/sol_watch.toml"),
+			walletType:   domainWallet.WalletTypeWatchOnly,
+			coinTypeCode: domainCoin.SOL,
+			wantErr:      false,
+		},
+		{
+			name:         "SOL Keygen Wallet",
+			configFile:   filepath.Join(projPath, "data/config/sol_keygen.toml"),
+			walletType:   domainWallet.WalletTypeKeyGen,
+			coinTypeCode: domainCoin.SOL,
+			wantErr:      false,
+		},
+		{
+			name:         "SOL Watch Wallet without rpc_url",
+			configFile:   filepath.Join(projPath, "data/config/sol_keygen.toml"),
+			walletType:   domainWallet.WalletTypeWatchOnly,
+			coinTypeCode: domainCoin.SOL,
+			wantErr:      true,
+		},
+		{
+			name:         "TRX Watch Wallet",
+			configFile:   filepath.Join(projPath, "data/config/trx_watch.toml"),
+			walletType:   domainWallet.WalletTypeWatchOnly,
+			coinTypeCode: domainCoin.TRX,
+			wantErr:      false,
+		},
+		{
+			name:         "TRX Keygen Wallet",
+			configFile:   filepath.Join(projPath, "data/config/trx_keygen.toml"),
+			walletType:   domainWallet.WalletTypeKeyGen,
+			coinTypeCode: domainCoin.TRX,
+			wantErr:      false,
+		},
+		{
+			name:         "TRX Watch Wallet without full_node_url",
+			configFile:   filepath.Join(projPath, "data/config/trx_keygen.toml"),
+			walletType:   domainWallet.WalletTypeWatchOnly,
+			coinTypeCode: domainCoin.TRX,
+			wantErr:      true,
+		},
 		{
 			name:         "Empty file path",
 			configFile:   "",
@@ -126,7 +239,7 @@ func validateConfig(t *testing.T, conf *WalletRoot, coinTypeCode domainCoin.Coin
 	switch coinTypeCode {
 	case domainCoin.BTC, domainCoin.BCH:
 		validateBitcoinConfig(t, conf)
-	case domainCoin.ETH, domainCoin.ERC20:
+	case domainCoin.ETH, domainCoin.POL, domainCoin.BNB, domainCoin.ARB, domainCoin.BASE, domainCoin.ERC20:
 		validateEthereumConfig(t, conf)
 	case domainCoin.XRP:
 		validateRippleConfig(t, conf)
@@ -148,7 +261,11 @@ func validateBitcoinConfig(t *testing.T, conf *WalletRoot) {
 
 func validateEthereumConfig(t *testing.T, conf *WalletRoot) {
 	t.Helper()
-	assert.NotEmpty(t, conf.Ethereum.Host, "Ethereum.Host should not be empty")
+	if conf.Ethereum.RPCURL == "" {
+		assert.NotEmpty(t, conf.Ethereum.Host, "Ethereum.Host should not be empty")
+	}
+	assert.NotZero(t, conf.Ethereum.ChainID, "Ethereum.ChainID should be filled")
+	assert.NotEmpty(t, conf.Ethereum.Symbol, "Ethereum.Symbol should be filled")
 }
 
 func validateRippleConfig(t *testing.T, conf *WalletRoot) {
@@ -195,3 +312,203 @@ func TestLoadWallet(t *testing.T) {
 		}
 	}
 }
+
+func TestNewWalletDatabaseDriver(t *testing.T) {
+	base, err := os.ReadFile("../../data/config/xrp_watch.toml")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		replace func(string) string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "mysql by default",
+			replace: func(s string) string { return strings.Replace(s, "driver = \"mysql\"", "", 1) },
+			want:    "",
+		},
+		{
+			name: "postgres without mysql section",
+			replace: func(s string) string {
+				s = strings.Replace(s, "driver = \"mysql\"", "driver = \"postgres\"", 1)
+				return strings.Replace(s, "[mysql]", "[unused]", 1)
+			},
+			want: DriverPostgres,
+		},
+		{
+			name: "mysql without mysql section",
+			replace: func(s string) string {
+				return strings.Replace(s, "[mysql]", "[unused]", 1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown driver",
+			replace: func(s string) string {
+				return strings.Replace(s, "driver = \"mysql\"", "driver = \"oracle\"", 1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "sqlite is not available for watch wallet",
+			replace: func(s string) string {
+				s = strings.Replace(s, "driver = \"mysql\"", "driver = \"sqlite\"", 1)
+				return s + "\n[sqlite]\npath = \"./data/db/watch.db\"\n"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "xrp_watch.toml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.replace(string(base))), 0o600))
+
+			conf, err := NewWallet(path, domainWallet.WalletTypeWatchOnly, domainCoin.XRP)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, conf.Database.Driver)
+		})
+	}
+}
+
+func TestEthereumFillNetwork(t *testing.T) {
+	tests := []struct {
+		name         string
+		conf         Ethereum
+		coinTypeCode domainCoin.CoinTypeCode
+		want         Ethereum
+		wantErr      bool
+	}{
+		{
+			name:         "known network",
+			conf:         Ethereum{NetworkType: "sepolia"},
+			coinTypeCode: domainCoin.ETH,
+			want: Ethereum{
+				NetworkType: "sepolia", ChainID: 11155111, Symbol: "ETH", Decimals: 18, FeeModel: FeeModelLegacy,
+			},
+		},
+		{
+			name:         "erc20 token on known network",
+			conf:         Ethereum{NetworkType: "polygon", FeeModel: FeeModelEIP1559},
+			coinTypeCode: domainCoin.HYT,
+			want: Ethereum{
+				NetworkType: "polygon", ChainID: 137, Symbol: "POL", Decimals: 18, FeeModel: FeeModelEIP1559,
+			},
+		},
+		{
+			name:         "known network by chain_id",
+			conf:         Ethereum{ChainID: 56},
+			coinTypeCode: domainCoin.BNB,
+			want: Ethereum{
+				ChainID: 56, Symbol: "BNB", Decimals: 18, FeeModel: FeeModelLegacy,
+			},
+		},
+		{
+			name: "network in config",
+			conf: Ethereum{NetworkType: "devnet", Networks: map[string]EVMNetwork{
+				"devnet": {ChainID: 1337, Symbol: "DEV", CoinTypeCode: domainCoin.ETH},
+			}},
+			coinTypeCode: domainCoin.ETH,
+			want: Ethereum{
+				NetworkType: "devnet", ChainID: 1337, Symbol: "DEV", Decimals: 18, FeeModel: FeeModelLegacy,
+				Networks: map[string]EVMNetwork{
+					"devnet": {ChainID: 1337, Symbol: "DEV", CoinTypeCode: domainCoin.ETH},
+				},
+			},
+		},
+		{
+			name:         "unknown network",
+			conf:         Ethereum{NetworkType: "devnet", ChainID: 1337, Symbol: "DEV"},
+			coinTypeCode: domainCoin.ETH,
+			wantErr:      true,
+		},
+		{
+			name:         "unknown chain_id",
+			conf:         Ethereum{ChainID: 1337},
+			coinTypeCode: domainCoin.ETH,
+			wantErr:      true,
+		},
+		{
+			name:         "neither network_type nor chain_id",
+			conf:         Ethereum{},
+			coinTypeCode: domainCoin.ETH,
+			wantErr:      true,
+		},
+		{
+			name:         "chain_id doesn't match network",
+			conf:         Ethereum{NetworkType: "mainnet", ChainID: 5},
+			coinTypeCode: domainCoin.ETH,
+			wantErr:      true,
+		},
+		{
+			name:         "coin doesn't match network",
+			conf:         Ethereum{NetworkType: "bsc"},
+			coinTypeCode: domainCoin.ARB,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := tt.conf
+			err := conf.fillNetwork(tt.coinTypeCode)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, conf)
+		})
+	}
+}
+
+func TestEthereumRegisterNetworks(t *testing.T) {
+	tests := []struct {
+		name     string
+		networks map[string]EVMNetwork
+		wantErr  bool
+	}{
+		{
+			name: "coin of EVM chain is registered",
+			networks: map[string]EVMNetwork{
+				"optimism": {ChainID: 10, Symbol: "ETH", CoinTypeCode: "op", IsMainnet: true},
+			},
+		},
+		{
+			name: "coin isn't EVM chain",
+			networks: map[string]EVMNetwork{
+				"bitcoin": {ChainID: 1000, Symbol: "BTC", CoinTypeCode: domainCoin.BTC},
+			},
+			wantErr: true,
+		},
+		{
+			name: "coin is missing",
+			networks: map[string]EVMNetwork{
+				"optimism": {ChainID: 10, Symbol: "ETH"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := Ethereum{Networks: tt.networks}
+			err := conf.registerNetworks()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			for _, network := range tt.networks {
+				assert.True(t, domainCoin.IsEVMChain(network.CoinTypeCode))
+				assert.True(t, domainCoin.IsETHGroup(network.CoinTypeCode))
+				assert.True(t, domainCoin.IsCoinTypeCode(network.CoinTypeCode.String()))
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
 	domainKey "github.com/hiromaily/go-crypto-wallet/internal/domain/key"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/storage/file/address"
@@ -16,10 +18,17 @@ type WalletRoot struct {
 	Bitcoin      Bitcoin                 `toml:"bitcoin" mapstructure:"bitcoin"`
 	Ethereum     Ethereum                `toml:"ethereum" mapstructure:"ethereum"`
 	Ripple       Ripple                  `toml:"ripple" mapstructure:"ripple"`
+	Solana       Solana                  `toml:"solana" mapstructure:"solana"`
+	Tron         Tron                    `toml:"tron" mapstructure:"tron"`
 	Logger       Logger                  `toml:"logger" mapstructure:"logger"`
 	Tracer       Tracer                  `toml:"tracer" mapstructure:"tracer"`
+	Database     Database                `toml:"database" mapstructure:"database"`
 	MySQL        MySQL                   `toml:"mysql" mapstructure:"mysql"`
+	Postgres     Postgres                `toml:"postgres" mapstructure:"postgres"`
+	SQLite       SQLite                  `toml:"sqlite" mapstructure:"sqlite"`
 	FilePath     FilePath                `toml:"file_path" mapstructure:"file_path"`
+	Daemon       Daemon                  `toml:"daemon" mapstructure:"daemon"`
+	Metrics      Metrics                 `toml:"metrics" mapstructure:"metrics"`
 }
 
 // Bitcoin information
@@ -34,6 +43,7 @@ type Bitcoin struct {
 
 	Block BitcoinBlock `toml:"block" mapstructure:"block"`
 	Fee   BitcoinFee   `toml:"fee" mapstructure:"fee"`
+	ZMQ   BitcoinZMQ   `toml:"zmq" mapstructure:"zmq"`
 }
 
 // BitcoinBlock block information of Bitcoin
@@ -42,6 +52,18 @@ type Bitcoin struct {
 //	so validation can not be used
 type BitcoinBlock struct {
 	ConfirmationNum uint64 `toml:"confirmation_num" mapstructure:"confirmation_num"`
+	ReorgDepth      uint64 `toml:"reorg_depth" mapstructure:"reorg_depth"`
+}
+
+// BitcoinZMQ is endpoints of ZMQ notifications published by bitcoind
+// only available for watch only wallet
+type BitcoinZMQ struct {
+	Enabled   bool   `toml:"enabled" mapstructure:"enabled"`
+	HashBlock string `toml:"hashblock" mapstructure:"hashblock"`
+	RawBlock  string `toml:"rawblock" mapstructure:"rawblock"`
+	RawTx     string `toml:"rawtx" mapstructure:"rawtx"`
+	// transactions are polled when no block is notified within this interval
+	PollInterval time.Duration `toml:"poll_interval" mapstructure:"poll_interval"`
 }
 
 // BitcoinFee range of adjustment calculated fee when sending coin
@@ -50,18 +72,79 @@ type BitcoinFee struct {
 	AdjustmentMax float64 `toml:"adjustment_max" mapstructure:"adjustment_max"`
 }
 
-// Ethereum information
+// Ethereum information of EVM chain
+//   - network_type or chain_id selects network of EVMNetworks or networks, unknown network is error
 type Ethereum struct {
-	Host       string `toml:"host" mapstructure:"host" validate:"required"`
-	IPCPath    string `toml:"ipc_path" mapstructure:"ipc_path"`
-	Port       int    `toml:"port" mapstructure:"port" validate:"required"`
-	DisableTLS bool   `toml:"disable_tls" mapstructure:"disable_tls"`
-	//nolint:lll,revive
-	NetworkType     string                          `toml:"network_type" mapstructure:"network_type" validate:"oneof=mainnet goerli rinkeby ropsten"`
+	Host        string `toml:"host" mapstructure:"host" validate:"required_without=RPCURL"`
+	IPCPath     string `toml:"ipc_path" mapstructure:"ipc_path"`
+	Port        int    `toml:"port" mapstructure:"port" validate:"required_without=RPCURL"`
+	DisableTLS  bool   `toml:"disable_tls" mapstructure:"disable_tls"`
+	RPCURL      string `toml:"rpc_url" mapstructure:"rpc_url"`
+	NetworkType string `toml:"network_type" mapstructure:"network_type"`
+	ChainID     uint64 `toml:"chain_id" mapstructure:"chain_id"`
+	Symbol      string `toml:"symbol" mapstructure:"symbol"`
+	Decimals    int    `toml:"decimals" mapstructure:"decimals" validate:"gte=0,lte=18"`
+	//nolint:lll
+	FeeModel        string                          `toml:"fee_model" mapstructure:"fee_model" validate:"omitempty,oneof=legacy eip1559"`
 	KeyDirName      string                          `toml:"keydir" mapstructure:"keydir"`
 	ConfirmationNum uint64                          `toml:"confirmation_num" mapstructure:"confirmation_num"`
+	ScanBlockRange  uint64                          `toml:"scan_block_range" mapstructure:"scan_block_range"`
 	ERC20Token      domainCoin.ERC20Token           `toml:"erc20_token" mapstructure:"erc20_token"`
 	ERC20s          map[domainCoin.ERC20Token]ERC20 `toml:"erc20s" mapstructure:"erc20s"`
+	Safe            Safe                            `toml:"safe" mapstructure:"safe"`
+	Forwarder       Forwarder                       `toml:"forwarder" mapstructure:"forwarder"`
+	// Networks is EVM networks by network_type, which are added to or override EVMNetworks
+	Networks map[string]EVMNetwork `toml:"networks" mapstructure:"networks"`
+}
+
+// Fee models of EVM chain
+const (
+	FeeModelLegacy  = "legacy"
+	FeeModelEIP1559 = "eip1559"
+)
+
+// EVMNetwork is EVM network which fills chain_id and symbol of config
+//   - coin is coin type code of native coin, records of each chain are kept apart by it in same database
+type EVMNetwork struct {
+	ChainID      uint64                  `toml:"chain_id" mapstructure:"chain_id"`
+	Symbol       string                  `toml:"symbol" mapstructure:"symbol"`
+	CoinTypeCode domainCoin.CoinTypeCode `toml:"coin" mapstructure:"coin"`
+	IsMainnet    bool                    `toml:"mainnet" mapstructure:"mainnet"`
+}
+
+// EVMNetworks is built-in EVM networks by network_type
+var EVMNetworks = map[string]EVMNetwork{
+	"mainnet":          {ChainID: 1, Symbol: "ETH", CoinTypeCode: domainCoin.ETH, IsMainnet: true},
+	"sepolia":          {ChainID: 11155111, Symbol: "ETH", CoinTypeCode: domainCoin.ETH},
+	"holesky":          {ChainID: 17000, Symbol: "ETH", CoinTypeCode: domainCoin.ETH},
+	"goerli":           {ChainID: 5, Symbol: "ETH", CoinTypeCode: domainCoin.ETH},
+	"polygon":          {ChainID: 137, Symbol: "POL", CoinTypeCode: domainCoin.POL, IsMainnet: true},
+	"amoy":             {ChainID: 80002, Symbol: "POL", CoinTypeCode: domainCoin.POL},
+	"bsc":              {ChainID: 56, Symbol: "BNB", CoinTypeCode: domainCoin.BNB, IsMainnet: true},
+	"bsc-testnet":      {ChainID: 97, Symbol: "tBNB", CoinTypeCode: domainCoin.BNB},
+	"arbitrum":         {ChainID: 42161, Symbol: "ETH", CoinTypeCode: domainCoin.ARB, IsMainnet: true},
+	"arbitrum-sepolia": {ChainID: 421614, Symbol: "ETH", CoinTypeCode: domainCoin.ARB},
+	"base":             {ChainID: 8453, Symbol: "ETH", CoinTypeCode: domainCoin.BASE, IsMainnet: true},
+	"base-sepolia":     {ChainID: 84532, Symbol: "ETH", CoinTypeCode: domainCoin.BASE},
+}
+
+// Safe is Safe multisig contract which holds deposit, payment and stored accounts
+//   - executor sends execTransaction and deploys Safe, it pays gas by the key in keydir
+//   - accounts is Safe address of each account type
+type Safe struct {
+	Executor        string `toml:"executor" mapstructure:"executor" validate:"required_with=Accounts,omitempty,eth_addr"`
+	ProxyFactory    string `toml:"proxy_factory" mapstructure:"proxy_factory" validate:"omitempty,eth_addr"`
+	Singleton       string `toml:"singleton" mapstructure:"singleton" validate:"omitempty,eth_addr"`
+	FallbackHandler string `toml:"fallback_handler" mapstructure:"fallback_handler" validate:"omitempty,eth_addr"`
+	//nolint:lll
+	Accounts map[string]string `toml:"accounts" mapstructure:"accounts" validate:"omitempty,dive,keys,oneof=deposit payment stored,endkeys,eth_addr"`
+}
+
+// Forwarder is CREATE2 forwarder which is used as deposit address of client account
+//   - factory is ForwarderFactory whose destination is address of deposit account
+//   - coin and ERC-20 tokens of erc20s are swept from forwarders by a transaction of deposit account
+type Forwarder struct {
+	Factory string `toml:"factory" mapstructure:"factory" validate:"omitempty,eth_addr"`
 }
 
 // ERC20 information
@@ -78,8 +161,38 @@ type Ripple struct {
 	WebsocketPublicURL string `toml:"websocket_public_url" mapstructure:"websocket_public_url"`
 	WebsocketAdminURL  string `toml:"websocket_admin_url" mapstructure:"websocket_admin_url"`
 	//nolint:lll
-	NetworkType string    `toml:"network_type" mapstructure:"network_type" validate:"oneof=mainnet testnet devnet"`
-	API         RippleAPI `toml:"api" mapstructure:"api"`
+	NetworkType string           `toml:"network_type" mapstructure:"network_type" validate:"oneof=mainnet testnet devnet"`
+	API         RippleAPI        `toml:"api" mapstructure:"api"`
+	DepositTag  RippleDepositTag `toml:"deposit_tag" mapstructure:"deposit_tag"`
+	Resubmit    RippleResubmit   `toml:"resubmit" mapstructure:"resubmit"`
+	// IssuedCurrency is sent instead of XRP when it's set
+	IssuedCurrency domainCoin.IssuedCurrency `toml:"issued_currency" mapstructure:"issued_currency"`
+	//nolint:lll
+	IssuedCurrencies map[domainCoin.IssuedCurrency]RippleIssuedCurrency `toml:"issued_currencies" mapstructure:"issued_currencies"`
+}
+
+// RippleIssuedCurrency information
+//   - currency is 3 characters code or 40 characters hex code
+//   - trust_limit is limit of trust line created by `keygen create trustline`
+type RippleIssuedCurrency struct {
+	Symbol     string `toml:"symbol" mapstructure:"symbol"`
+	Name       string `toml:"name" mapstructure:"name"`
+	Currency   string `toml:"currency" mapstructure:"currency"`
+	Issuer     string `toml:"issuer" mapstructure:"issuer"`
+	TrustLimit string `toml:"trust_limit" mapstructure:"trust_limit"`
+}
+
+// RippleDepositTag is shared deposit address whose destination tag identifies client
+//   - address must be address of deposit account which requires destination tag
+//   - X-address of client encodes address and tag, so client address isn't funded nor swept
+type RippleDepositTag struct {
+	Address string `toml:"address" mapstructure:"address"`
+}
+
+// RippleResubmit is resubmission of unsigned transaction which expired by LastLedgerSequence
+//   - expired payment is replaced by new unsigned transaction up to max_retry times, 0 disables replacement
+type RippleResubmit struct {
+	MaxRetry uint32 `toml:"max_retry" mapstructure:"max_retry"`
 }
 
 // RippleAPI is ripple-lib server info
@@ -95,6 +208,51 @@ type RippleTxData struct {
 	Secret  string `toml:"sender_secret" mapstructure:"sender_secret"`
 }
 
+// Solana information
+type Solana struct {
+	// RPCURL is JSON-RPC endpoint, only watch only wallet connects to it
+	RPCURL string `toml:"rpc_url" mapstructure:"rpc_url"`
+	//nolint:lll
+	NetworkType string `toml:"network_type" mapstructure:"network_type" validate:"oneof=mainnet testnet devnet localnet"`
+	//nolint:lll
+	Commitment string                           `toml:"commitment" mapstructure:"commitment" validate:"omitempty,oneof=processed confirmed finalized"`
+	SPLToken   domainCoin.SPLToken              `toml:"spl_token" mapstructure:"spl_token"`
+	SPLTokens  map[domainCoin.SPLToken]SPLToken `toml:"spl_tokens" mapstructure:"spl_tokens"`
+}
+
+// SPLToken information
+type SPLToken struct {
+	Symbol      string `toml:"symbol" mapstructure:"symbol"`
+	Name        string `toml:"name" mapstructure:"name"`
+	MintAddress string `toml:"mint_address" mapstructure:"mint_address"`
+	Decimals    uint8  `toml:"decimals" mapstructure:"decimals"`
+}
+
+// Tron information
+type Tron struct {
+	// FullNodeURL is HTTP API endpoint of full node, only watch only wallet connects to it
+	FullNodeURL string `toml:"full_node_url" mapstructure:"full_node_url"`
+	// APIKey is sent as TRON-PRO-API-KEY header for TronGrid
+	APIKey string `toml:"api_key" mapstructure:"api_key"`
+	//nolint:lll
+	NetworkType     string `toml:"network_type" mapstructure:"network_type" validate:"oneof=mainnet shasta nile"`
+	ConfirmationNum uint64 `toml:"confirmation_num" mapstructure:"confirmation_num"`
+	// Expiration of unsigned transaction, Tron accepts up to 24h after reference block
+	Expiration time.Duration `toml:"expiration" mapstructure:"expiration"`
+	// FeeLimit is max TRX burned for energy of TRC-20 transfer
+	FeeLimit   float64                              `toml:"fee_limit" mapstructure:"fee_limit"`
+	TRC20Token domainCoin.TRC20Token                `toml:"trc20_token" mapstructure:"trc20_token"`
+	TRC20s     map[domainCoin.TRC20Token]TRC20Token `toml:"trc20s" mapstructure:"trc20s"`
+}
+
+// TRC20Token information
+type TRC20Token struct {
+	Symbol          string `toml:"symbol" mapstructure:"symbol"`
+	Name            string `toml:"name" mapstructure:"name"`
+	ContractAddress string `toml:"contract_address" mapstructure:"contract_address"`
+	Decimals        uint8  `toml:"decimals" mapstructure:"decimals"`
+}
+
 // Logger logger info
 type Logger struct {
 	Service  string `toml:"service" mapstructure:"service" validate:"required"`
@@ -103,6 +261,35 @@ type Logger struct {
 	IsLogger bool   `toml:"is_logger" mapstructure:"is_logger"`
 }
 
+// Daemon is scheduled jobs of watch daemon
+// only available for watch only wallet
+type Daemon struct {
+	// named lock in MySQL to prevent replicas from running leader only job at the same time
+	LeaderLock     string    `toml:"leader_lock" mapstructure:"leader_lock"`
+	MonitorSentTx  DaemonJob `toml:"monitor_senttx" mapstructure:"monitor_senttx"`
+	MonitorBalance DaemonJob `toml:"monitor_balance" mapstructure:"monitor_balance"`
+	CreateDeposit  DaemonJob `toml:"create_deposit" mapstructure:"create_deposit"`
+	CreatePayment  DaemonJob `toml:"create_payment" mapstructure:"create_payment"`
+	ScanDeposit    DaemonJob `toml:"scan_deposit" mapstructure:"scan_deposit"`
+}
+
+// DaemonJob is setting of each scheduled job
+type DaemonJob struct {
+	Enabled         bool          `toml:"enabled" mapstructure:"enabled"`
+	Interval        time.Duration `toml:"interval" mapstructure:"interval"`
+	Jitter          time.Duration `toml:"jitter" mapstructure:"jitter"`
+	ConfirmationNum uint64        `toml:"confirmation_num" mapstructure:"confirmation_num"`
+	AdjustmentFee   float64       `toml:"fee" mapstructure:"fee"`
+}
+
+// Metrics is prometheus metrics served by watch process
+// only available for watch only wallet
+type Metrics struct {
+	Enabled bool `toml:"enabled" mapstructure:"enabled"`
+	// listen address of metrics server like ":9100"
+	Address string `toml:"address" mapstructure:"address"`
+}
+
 // Tracer is open tracing
 type Tracer struct {
 	Type    string       `toml:"type" mapstructure:"type" validate:"oneof=none jaeger datadog"`
@@ -118,6 +305,20 @@ type TracerDetail struct {
 	IsDebug             bool    `toml:"is_debug" mapstructure:"is_debug"`
 }
 
+// Database drivers
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// Database selects database backend, MySQL is used when driver is empty
+type Database struct {
+	Driver string `toml:"driver" mapstructure:"driver" validate:"omitempty,oneof=mysql postgres sqlite"`
+	// pending migrations are applied on start, otherwise `migrate up` command is required
+	AutoMigrate bool `toml:"auto_migrate" mapstructure:"auto_migrate"`
+}
+
 // MySQL info
 type MySQL struct {
 	Host  string `toml:"host" mapstructure:"host" validate:"required"`
@@ -127,6 +328,23 @@ type MySQL struct {
 	Debug bool   `toml:"debug" mapstructure:"debug"`
 }
 
+// Postgres info
+type Postgres struct {
+	Host    string `toml:"host" mapstructure:"host" validate:"required"`
+	DB      string `toml:"dbname" mapstructure:"dbname" validate:"required"`
+	User    string `toml:"user" mapstructure:"user" validate:"required"`
+	Pass    string `toml:"pass" mapstructure:"pass" validate:"required"`
+	SSLMode string `toml:"sslmode" mapstructure:"sslmode"` // disable when empty
+	Debug   bool   `toml:"debug" mapstructure:"debug"`
+}
+
+// SQLite is encrypted database file embedded in keygen and sign wallet
+type SQLite struct {
+	Path string `toml:"path" mapstructure:"path" validate:"required"`
+	// key to encrypt database file, SQLITE_PASSPHRASE env is used when empty
+	Passphrase string `toml:"passphrase" mapstructure:"passphrase"`
+}
+
 // FilePath if file path group
 type FilePath struct {
 	Tx         string `toml:"tx" mapstructure:"tx" validate:"required"`
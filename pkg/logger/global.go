@@ -1,7 +1,10 @@
 package logger
 
 import (
+	"context"
 	"sync"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -52,3 +55,33 @@ func Warn(msg string, args ...any) {
 func Error(msg string, args ...any) {
 	getGlobalLogger().Error(msg, args...)
 }
+
+// DebugContext logs a debug message with trace_id and span_id of span in ctx.
+func DebugContext(ctx context.Context, msg string, args ...any) {
+	getGlobalLogger().Debug(msg, withTrace(ctx, args)...)
+}
+
+// InfoContext logs an info message with trace_id and span_id of span in ctx.
+func InfoContext(ctx context.Context, msg string, args ...any) {
+	getGlobalLogger().Info(msg, withTrace(ctx, args)...)
+}
+
+// WarnContext logs a warning message with trace_id and span_id of span in ctx.
+func WarnContext(ctx context.Context, msg string, args ...any) {
+	getGlobalLogger().Warn(msg, withTrace(ctx, args)...)
+}
+
+// ErrorContext logs an error message with trace_id and span_id of span in ctx.
+func ErrorContext(ctx context.Context, msg string, args ...any) {
+	getGlobalLogger().Error(msg, withTrace(ctx, args)...)
+}
+
+// withTrace appends trace_id and span_id to args so that logs can be correlated with traces.
+// args are returned as is when ctx has no valid span.
+func withTrace(ctx context.Context, args []any) []any {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return args
+	}
+	return append(args[:len(args):len(args)], "trace_id", sc.TraceID().String(), "span_id", sc.SpanID().String())
+}
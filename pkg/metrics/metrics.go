@@ -0,0 +1,121 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const namespace = "wallet"
+
+// TxStatus is status of transaction counted by IncTx
+type TxStatus string
+
+// TxStatus constants
+const (
+	TxStatusCreated   TxStatus = "created"
+	TxStatusSent      TxStatus = "sent"
+	TxStatusConfirmed TxStatus = "confirmed"
+	TxStatusExpired   TxStatus = "expired"
+)
+
+var (
+	// registry is registry served on /metrics
+	registry = prometheus.NewRegistry()
+
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "rpc_duration_seconds",
+		Help:      "Latency of RPC call to node per method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"coin", "method"})
+
+	rpcErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rpc_errors_total",
+		Help:      "Number of failed RPC calls to node per method.",
+	}, []string{"coin", "method"})
+
+	transactions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transactions_total",
+		Help:      "Number of transactions created, sent and confirmed per action.",
+	}, []string{"coin", "action", "status"})
+
+	balance = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "account_balance",
+		Help:      "Balance of account checked by monitor balance, in unit of coin like BTC, ETH and XRP.",
+	}, []string{"coin", "account"})
+
+	pendingPaymentRequests = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "pending_payment_requests",
+		Help:      "Number of payment requests which are not done yet.",
+	}, []string{"coin"})
+
+	unallocatedAddresses = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "unallocated_addresses",
+		Help:      "Number of addresses in pool which are not allocated yet.",
+	}, []string{"coin", "account"})
+
+	oldestUnsignedTxAge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "oldest_unsigned_tx_age_seconds",
+		Help:      "Age of the oldest transaction waiting for signature, 0 when there is none.",
+	}, []string{"coin"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcDuration,
+		rpcErrors,
+		transactions,
+		balance,
+		pendingPaymentRequests,
+		unallocatedAddresses,
+		oldestUnsignedTxAge,
+	)
+}
+
+// Registry returns registry of wallet metrics
+func Registry() *prometheus.Registry {
+	return registry
+}
+
+// ObserveRPC records latency of RPC call started at `started` and counts it as error if err is not nil
+func ObserveRPC(coin, method string, started time.Time, err error) {
+	rpcDuration.WithLabelValues(coin, method).Observe(time.Since(started).Seconds())
+	if err != nil {
+		rpcErrors.WithLabelValues(coin, method).Inc()
+	}
+}
+
+// IncTx counts transaction of action by status, action is empty when it's not known
+func IncTx(coin, action string, status TxStatus) {
+	transactions.WithLabelValues(coin, action, string(status)).Inc()
+}
+
+// SetBalance sets balance of account
+func SetBalance(coin, account string, amount float64) {
+	balance.WithLabelValues(coin, account).Set(amount)
+}
+
+// SetPendingPaymentRequests sets number of payment requests which are not done
+func SetPendingPaymentRequests(coin string, count int64) {
+	pendingPaymentRequests.WithLabelValues(coin).Set(float64(count))
+}
+
+// SetUnallocatedAddresses sets number of unallocated addresses of account
+func SetUnallocatedAddresses(coin, account string, count int64) {
+	unallocatedAddresses.WithLabelValues(coin, account).Set(float64(count))
+}
+
+// SetOldestUnsignedTxAge sets age of the oldest unsigned transaction
+func SetOldestUnsignedTxAge(coin string, age time.Duration) {
+	oldestUnsignedTxAge.WithLabelValues(coin).Set(age.Seconds())
+}
@@ -0,0 +1,69 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+	"github.com/hiromaily/go-crypto-wallet/pkg/metrics"
+)
+
+func scrape(t *testing.T, handler http.Handler) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, metrics.Path, http.NoBody))
+	require.Equal(t, http.StatusOK, rec.Code)
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+// TestHandler tests recorded values are exposed
+func TestHandler(t *testing.T) {
+	metrics.ObserveRPC("btc", "GetBlockCount", time.Now(), nil)
+	metrics.ObserveRPC("btc", "GetBlockCount", time.Now(), errors.New("connection refused"))
+	metrics.IncTx("btc", "deposit", metrics.TxStatusCreated)
+	metrics.SetBalance("btc", "client", 1.5)
+
+	body := scrape(t, metrics.Handler(nil))
+	assert.Contains(t, body, `wallet_rpc_duration_seconds_count{coin="btc",method="GetBlockCount"} 2`)
+	assert.Contains(t, body, `wallet_rpc_errors_total{coin="btc",method="GetBlockCount"} 1`)
+	assert.Contains(t, body, `wallet_transactions_total{action="deposit",coin="btc",status="created"} 1`)
+	assert.Contains(t, body, `wallet_account_balance{account="client",coin="btc"} 1.5`)
+	assert.Contains(t, body, "go_goroutines")
+}
+
+// TestHandlerRefresh tests gauges are refreshed on scrape
+func TestHandlerRefresh(t *testing.T) {
+	logger.SetGlobal(logger.NewNoopLogger())
+
+	count := int64(0)
+	handler := metrics.Handler(func(_ context.Context) error {
+		count++
+		if count > 1 {
+			return errors.New("database is down")
+		}
+		metrics.SetPendingPaymentRequests("xrp", 3)
+		metrics.SetUnallocatedAddresses("xrp", "client", 10)
+		metrics.SetOldestUnsignedTxAge("xrp", time.Minute)
+		return nil
+	})
+
+	body := scrape(t, handler)
+	assert.Contains(t, body, `wallet_pending_payment_requests{coin="xrp"} 3`)
+	assert.Contains(t, body, `wallet_unallocated_addresses{account="client",coin="xrp"} 10`)
+	assert.Contains(t, body, `wallet_oldest_unsigned_tx_age_seconds{coin="xrp"} 60`)
+
+	// previous values are served when refresh fails
+	body = scrape(t, handler)
+	assert.Contains(t, body, `wallet_pending_payment_requests{coin="xrp"} 3`)
+	assert.Equal(t, int64(2), count)
+}
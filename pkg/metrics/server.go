@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// Path is path to serve metrics
+const Path = "/metrics"
+
+// refreshTimeout is time limit of RefreshFunc per scrape
+const refreshTimeout = 10 * time.Second
+
+// RefreshFunc updates gauges which are read from database right before metrics are scraped
+type RefreshFunc func(ctx context.Context) error
+
+// Handler returns handler of metrics, refresh is called on every scrape if not nil
+// metrics are still served with previous values when refresh fails
+func Handler(refresh RefreshFunc) http.Handler {
+	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+	if refresh == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), refreshTimeout)
+		defer cancel()
+		if err := refresh(ctx); err != nil {
+			logger.Warn("failed to refresh metrics", "error", err)
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// Server serves metrics on Path
+type Server struct {
+	addr    string
+	refresh RefreshFunc
+}
+
+// NewServer creates Server listening on `addr`, refresh can be nil
+func NewServer(addr string, refresh RefreshFunc) *Server {
+	return &Server{
+		addr:    addr,
+		refresh: refresh,
+	}
+}
+
+// Serve serves metrics until ctx is canceled
+func (s *Server) Serve(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle(Path, Handler(s.refresh))
+	server := &http.Server{
+		Addr:              s.addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.ListenAndServe()
+	}()
+	logger.Info("metrics server started", "addr", s.addr, "path", Path)
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("fail to serve metrics: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("fail to shutdown metrics server: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+// Package scheduler runs jobs periodically for long-running processes like watch daemon.
+//
+// Each job runs on its own interval with optional jitter, and never runs concurrently
+// with itself (single-flight). Jobs flagged as LeaderOnly run only while the
+// scheduler holds the leader lock, so that replicas never run them at the same time.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/logger"
+)
+
+// Status is result of job run
+type Status string
+
+// Status constants
+const (
+	StatusRunning Status = "running"
+	StatusSuccess Status = "success"
+	StatusFailure Status = "failure"
+	StatusSkipped Status = "skipped"
+)
+
+// String returns the string representation of the status.
+func (s Status) String() string {
+	return string(s)
+}
+
+// Job is periodic task
+type Job struct {
+	Name       string
+	Interval   time.Duration
+	Jitter     time.Duration
+	LeaderOnly bool
+	Run        func(ctx context.Context) error
+}
+
+// JobStatus is last run status of job
+type JobStatus struct {
+	Name       string
+	Status     Status
+	Error      string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// Locker is leader lock to prevent multiple replicas from running the same job at the same time
+type Locker interface {
+	// TryLock acquires lock without waiting, returned unlock func must be called when acquired
+	TryLock(ctx context.Context, name string) (unlock func(), acquired bool, err error)
+}
+
+// StatusStorer persists last run status of jobs
+type StatusStorer interface {
+	SaveStatus(ctx context.Context, status *JobStatus) error
+}
+
+// Scheduler runs registered jobs
+type Scheduler struct {
+	jobs        []*Job
+	locker      Locker
+	lockName    string
+	statusStore StatusStorer
+	running     sync.Map // job name -> *atomic.Bool
+	wg          sync.WaitGroup
+}
+
+// Option configures Scheduler
+type Option func(*Scheduler)
+
+// WithLeaderLock sets leader lock used by LeaderOnly jobs
+func WithLeaderLock(locker Locker, lockName string) Option {
+	return func(s *Scheduler) {
+		s.locker = locker
+		s.lockName = lockName
+	}
+}
+
+// WithStatusStore sets store to persist last run status
+func WithStatusStore(store StatusStorer) Option {
+	return func(s *Scheduler) {
+		s.statusStore = store
+	}
+}
+
+// New returns Scheduler
+func New(opts ...Option) *Scheduler {
+	s := &Scheduler{}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Register registers job
+func (s *Scheduler) Register(job *Job) error {
+	if job.Name == "" {
+		return errors.New("job name is required")
+	}
+	if job.Interval <= 0 {
+		return fmt.Errorf("interval of job [%s] must be positive", job.Name)
+	}
+	if job.Jitter < 0 {
+		return fmt.Errorf("jitter of job [%s] must not be negative", job.Name)
+	}
+	if job.Run == nil {
+		return fmt.Errorf("run func of job [%s] is required", job.Name)
+	}
+	if job.LeaderOnly && s.locker == nil {
+		return fmt.Errorf("job [%s] requires leader lock, but lock is not configured", job.Name)
+	}
+	for _, j := range s.jobs {
+		if j.Name == job.Name {
+			return fmt.Errorf("job [%s] is already registered", job.Name)
+		}
+	}
+	s.jobs = append(s.jobs, job)
+	s.running.Store(job.Name, &atomic.Bool{})
+	return nil
+}
+
+// Jobs returns registered jobs
+func (s *Scheduler) Jobs() []*Job {
+	return s.jobs
+}
+
+// Start runs all jobs until ctx is canceled, then waits for running jobs to finish
+func (s *Scheduler) Start(ctx context.Context) error {
+	if len(s.jobs) == 0 {
+		return errors.New("no job is registered")
+	}
+
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}()
+	}
+	logger.Info("scheduler started", "jobs", len(s.jobs))
+
+	<-ctx.Done()
+	logger.Info("scheduler is shutting down, waiting for running jobs")
+	s.wg.Wait()
+	logger.Info("scheduler stopped")
+
+	return nil
+}
+
+func (s *Scheduler) loop(ctx context.Context, job *Job) {
+	timer := time.NewTimer(nextDelay(job))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			// running job is not canceled by shutdown so that it can finish cleanly
+			s.RunOnce(context.WithoutCancel(ctx), job)
+			timer.Reset(nextDelay(job))
+		}
+	}
+}
+
+// RunOnce runs job once unless the same job is already running
+func (s *Scheduler) RunOnce(ctx context.Context, job *Job) Status {
+	flag, ok := s.running.Load(job.Name)
+	if !ok {
+		logger.Error("job is not registered", "job", job.Name)
+		return StatusSkipped
+	}
+	running, _ := flag.(*atomic.Bool)
+	if !running.CompareAndSwap(false, true) {
+		logger.Debug("job is still running, skipped", "job", job.Name)
+		return StatusSkipped
+	}
+	defer running.Store(false)
+
+	if job.LeaderOnly {
+		unlock, acquired, err := s.locker.TryLock(ctx, s.lockName)
+		if err != nil {
+			return s.saveStatus(ctx, job, time.Now(), fmt.Errorf("fail to acquire leader lock: %w", err))
+		}
+		if !acquired {
+			logger.Debug("leader lock is held by another replica, skipped", "job", job.Name)
+			return StatusSkipped
+		}
+		defer unlock()
+	}
+
+	startedAt := time.Now()
+	if s.statusStore != nil {
+		if err := s.statusStore.SaveStatus(ctx, &JobStatus{
+			Name:      job.Name,
+			Status:    StatusRunning,
+			StartedAt: startedAt,
+		}); err != nil {
+			logger.Warn("fail to save job status", "job", job.Name, "error", err)
+		}
+	}
+	logger.Debug("job started", "job", job.Name)
+
+	return s.saveStatus(ctx, job, startedAt, job.Run(ctx))
+}
+
+func (s *Scheduler) saveStatus(ctx context.Context, job *Job, startedAt time.Time, runErr error) Status {
+	status := &JobStatus{
+		Name:       job.Name,
+		Status:     StatusSuccess,
+		StartedAt:  startedAt,
+		FinishedAt: time.Now(),
+	}
+	if runErr != nil {
+		status.Status = StatusFailure
+		status.Error = runErr.Error()
+		logger.Error("job failed", "job", job.Name, "error", runErr)
+	} else {
+		logger.Info("job finished", "job", job.Name, "elapsed", status.FinishedAt.Sub(startedAt).String())
+	}
+
+	if s.statusStore != nil {
+		if err := s.statusStore.SaveStatus(ctx, status); err != nil {
+			logger.Warn("fail to save job status", "job", job.Name, "error", err)
+		}
+	}
+	return status.Status
+}
+
+// nextDelay returns interval with random jitter in range [0, jitter]
+func nextDelay(job *Job) time.Duration {
+	if job.Jitter <= 0 {
+		return job.Interval
+	}
+	//nolint:gosec // jitter doesn't require cryptographically secure random
+	return job.Interval + time.Duration(rand.Int64N(int64(job.Jitter)+1))
+}
@@ -0,0 +1,160 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/scheduler"
+)
+
+type fakeLocker struct {
+	mu     sync.Mutex
+	locked bool
+}
+
+func (l *fakeLocker) TryLock(_ context.Context, _ string) (func(), bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.locked {
+		return nil, false, nil
+	}
+	l.locked = true
+	return func() {
+		l.mu.Lock()
+		l.locked = false
+		l.mu.Unlock()
+	}, true, nil
+}
+
+type fakeStatusStore struct {
+	mu       sync.Mutex
+	statuses []scheduler.JobStatus
+}
+
+func (s *fakeStatusStore) SaveStatus(_ context.Context, status *scheduler.JobStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses = append(s.statuses, *status)
+	return nil
+}
+
+func (s *fakeStatusStore) last() scheduler.JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.statuses[len(s.statuses)-1]
+}
+
+// TestRegister tests job validation
+func TestRegister(t *testing.T) {
+	run := func(context.Context) error { return nil }
+
+	s := scheduler.New()
+	require.NoError(t, s.Register(&scheduler.Job{Name: "job", Interval: time.Second, Run: run}))
+
+	tests := []struct {
+		name string
+		job  *scheduler.Job
+	}{
+		{"empty name", &scheduler.Job{Interval: time.Second, Run: run}},
+		{"zero interval", &scheduler.Job{Name: "a", Run: run}},
+		{"negative jitter", &scheduler.Job{Name: "a", Interval: time.Second, Jitter: -1, Run: run}},
+		{"nil run", &scheduler.Job{Name: "a", Interval: time.Second}},
+		{"duplicated", &scheduler.Job{Name: "job", Interval: time.Second, Run: run}},
+		{"leader only without lock", &scheduler.Job{Name: "a", Interval: time.Second, LeaderOnly: true, Run: run}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, s.Register(tt.job))
+		})
+	}
+}
+
+// TestRunOnce tests single-flight, leader lock and persisted status
+func TestRunOnce(t *testing.T) {
+	t.Run("persists success and failure", func(t *testing.T) {
+		store := &fakeStatusStore{}
+		s := scheduler.New(scheduler.WithStatusStore(store))
+		var runErr error
+		job := &scheduler.Job{Name: "job", Interval: time.Second, Run: func(context.Context) error { return runErr }}
+		require.NoError(t, s.Register(job))
+
+		assert.Equal(t, scheduler.StatusSuccess, s.RunOnce(context.Background(), job))
+		assert.Equal(t, scheduler.StatusSuccess, store.last().Status)
+
+		runErr = errors.New("rpc error")
+		assert.Equal(t, scheduler.StatusFailure, s.RunOnce(context.Background(), job))
+		assert.Equal(t, scheduler.StatusFailure, store.last().Status)
+		assert.Equal(t, "rpc error", store.last().Error)
+	})
+
+	t.Run("skips while the same job is running", func(t *testing.T) {
+		s := scheduler.New()
+		started := make(chan struct{})
+		release := make(chan struct{})
+		job := &scheduler.Job{Name: "job", Interval: time.Second, Run: func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		}}
+		require.NoError(t, s.Register(job))
+
+		done := make(chan scheduler.Status)
+		go func() { done <- s.RunOnce(context.Background(), job) }()
+		<-started
+		assert.Equal(t, scheduler.StatusSkipped, s.RunOnce(context.Background(), job))
+		close(release)
+		assert.Equal(t, scheduler.StatusSuccess, <-done)
+	})
+
+	t.Run("skips leader only job when lock is held by another replica", func(t *testing.T) {
+		locker := &fakeLocker{}
+		s := scheduler.New(scheduler.WithLeaderLock(locker, "watch"))
+		job := &scheduler.Job{Name: "job", Interval: time.Second, LeaderOnly: true, Run: func(context.Context) error {
+			return nil
+		}}
+		require.NoError(t, s.Register(job))
+
+		unlock, acquired, err := locker.TryLock(context.Background(), "watch")
+		require.NoError(t, err)
+		require.True(t, acquired)
+		assert.Equal(t, scheduler.StatusSkipped, s.RunOnce(context.Background(), job))
+
+		unlock()
+		assert.Equal(t, scheduler.StatusSuccess, s.RunOnce(context.Background(), job))
+	})
+}
+
+// TestStart tests jobs run periodically and shutdown waits for running job
+func TestStart(t *testing.T) {
+	s := scheduler.New()
+	var count atomic.Int32
+	var finished atomic.Bool
+	require.NoError(t, s.Register(&scheduler.Job{
+		Name:     "job",
+		Interval: 10 * time.Millisecond,
+		Jitter:   5 * time.Millisecond,
+		Run: func(context.Context) error {
+			count.Add(1)
+			time.Sleep(20 * time.Millisecond)
+			finished.Store(true)
+			return nil
+		},
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	require.NoError(t, s.Start(ctx))
+
+	assert.GreaterOrEqual(t, count.Load(), int32(1))
+	assert.True(t, finished.Load(), "running job should finish before Start returns")
+}
@@ -1,13 +1,16 @@
 package testutil
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"os"
 
 	domainCoin "github.com/hiromaily/go-crypto-wallet/internal/domain/coin"
 	"github.com/hiromaily/go-crypto-wallet/internal/domain/wallet"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/migration"
 	mysql "github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/mysql"
+	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/database/postgres"
 	"github.com/hiromaily/go-crypto-wallet/internal/infrastructure/repository/watch"
 	"github.com/hiromaily/go-crypto-wallet/pkg/config"
 )
@@ -15,17 +18,53 @@ import (
 var (
 	// shared database connection
 	dbConn *sql.DB
-	// sqlc repositories
-	btcTxRepoSqlc          *watch.BTCTxRepositorySqlc
-	txRepoSqlc             *watch.TxRepositorySqlc
-	addressRepoSqlc        *watch.AddressRepositorySqlc
-	paymentRequestRepoSqlc *watch.PaymentRequestRepositorySqlc
-	btcTxInputRepoSqlc     *watch.TxInputRepositorySqlc
-	btcTxOutputRepoSqlc    *watch.TxOutputRepositorySqlc
-	ethDetailTxRepoSqlc    *watch.EthDetailTxInputRepositorySqlc
-	xrpDetailTxRepoSqlc    *watch.XrpDetailTxInputRepositorySqlc
+	// sqlc repositories, backend is selected by `[database] driver` or DB_DRIVER env
+	btcTxRepoSqlc          watch.BTCTxRepositorier
+	txRepoSqlc             watch.TxRepositorier
+	addressRepoSqlc        watch.AddressRepositorier
+	paymentRequestRepoSqlc watch.PaymentRequestRepositorier
+	btcTxInputRepoSqlc     watch.TxInputRepositorier
+	btcTxOutputRepoSqlc    watch.TxOutputRepositorier
+	ethDetailTxRepoSqlc    watch.EthDetailTxRepositorier
+	xrpDetailTxRepoSqlc    watch.XrpDetailTxRepositorier
 )
 
+// openDB connects to database of `[database] driver` in config
+// DB_DRIVER env overrides it so that same tests run against both MySQL and PostgreSQL
+// e.g. DB_DRIVER=postgres go test -tags=integration ./internal/infrastructure/repository/...
+func openDB(conf *config.WalletRoot) *sql.DB {
+	if driver := os.Getenv("DB_DRIVER"); driver != "" {
+		conf.Database.Driver = driver
+	}
+
+	var (
+		db  *sql.DB
+		err error
+	)
+	if isPostgres(conf) {
+		db, err = postgres.NewPostgres(&conf.Postgres)
+	} else {
+		db, err = mysql.NewMySQL(&conf.MySQL)
+	}
+	if err != nil {
+		log.Fatalf("fail to create db: %v", err)
+	}
+
+	// tables are created by migrations of watch wallet
+	migrator, err := migration.NewMigrator(db, conf.Database.Driver, migration.SetWatch)
+	if err != nil {
+		log.Fatalf("fail to create migrator: %v", err)
+	}
+	if _, err = migrator.Up(context.Background()); err != nil {
+		log.Fatalf("fail to migrate db: %v", err)
+	}
+	return db
+}
+
+func isPostgres(conf *config.WalletRoot) bool {
+	return conf.Database.Driver == config.DriverPostgres
+}
+
 // GetDB returns shared database connection for tests
 func GetDB() *sql.DB {
 	if dbConn != nil {
@@ -39,11 +78,7 @@ func GetDB() *sql.DB {
 		log.Fatalf("fail to create config: %v", err)
 	}
 
-	dbConn, err = mysql.NewMySQL(&conf.MySQL)
-	if err != nil {
-		log.Fatalf("fail to create db: %v", err)
-	}
-
+	dbConn = openDB(conf)
 	return dbConn
 }
 
@@ -60,12 +95,12 @@ func NewBTCTxRepositorySqlc() watch.BTCTxRepositorier {
 		log.Fatalf("fail to create config: %v", err)
 	}
 
-	db, err := mysql.NewMySQL(&conf.MySQL)
-	if err != nil {
-		log.Fatalf("fail to create db: %v", err)
+	db := openDB(conf)
+	if isPostgres(conf) {
+		btcTxRepoSqlc = watch.NewBTCTxRepositoryPostgres(db, domainCoin.BTC)
+	} else {
+		btcTxRepoSqlc = watch.NewBTCTxRepositorySqlc(db, domainCoin.BTC)
 	}
-
-	btcTxRepoSqlc = watch.NewBTCTxRepositorySqlc(db, domainCoin.BTC)
 	return btcTxRepoSqlc
 }
 
@@ -82,12 +117,12 @@ func NewTxRepositorySqlc() watch.TxRepositorier {
 		log.Fatalf("fail to create config: %v", err)
 	}
 
-	db, err := mysql.NewMySQL(&conf.MySQL)
-	if err != nil {
-		log.Fatalf("fail to create db: %v", err)
+	db := openDB(conf)
+	if isPostgres(conf) {
+		txRepoSqlc = watch.NewTxRepositoryPostgres(db, domainCoin.BTC)
+	} else {
+		txRepoSqlc = watch.NewTxRepositorySqlc(db, domainCoin.BTC)
 	}
-
-	txRepoSqlc = watch.NewTxRepositorySqlc(db, domainCoin.BTC)
 	return txRepoSqlc
 }
 
@@ -104,12 +139,12 @@ func NewAddressRepositorySqlc() watch.AddressRepositorier {
 		log.Fatalf("fail to create config: %v", err)
 	}
 
-	db, err := mysql.NewMySQL(&conf.MySQL)
-	if err != nil {
-		log.Fatalf("fail to create db: %v", err)
+	db := openDB(conf)
+	if isPostgres(conf) {
+		addressRepoSqlc = watch.NewAddressRepositoryPostgres(db, domainCoin.BTC)
+	} else {
+		addressRepoSqlc = watch.NewAddressRepositorySqlc(db, domainCoin.BTC)
 	}
-
-	addressRepoSqlc = watch.NewAddressRepositorySqlc(db, domainCoin.BTC)
 	return addressRepoSqlc
 }
 
@@ -126,12 +161,12 @@ func NewPaymentRequestRepositorySqlc() watch.PaymentRequestRepositorier {
 		log.Fatalf("fail to create config: %v", err)
 	}
 
-	db, err := mysql.NewMySQL(&conf.MySQL)
-	if err != nil {
-		log.Fatalf("fail to create db: %v", err)
+	db := openDB(conf)
+	if isPostgres(conf) {
+		paymentRequestRepoSqlc = watch.NewPaymentRequestRepositoryPostgres(db, domainCoin.BTC)
+	} else {
+		paymentRequestRepoSqlc = watch.NewPaymentRequestRepositorySqlc(db, domainCoin.BTC)
 	}
-
-	paymentRequestRepoSqlc = watch.NewPaymentRequestRepositorySqlc(db, domainCoin.BTC)
 	return paymentRequestRepoSqlc
 }
 
@@ -148,12 +183,12 @@ func NewBTCTxInputRepositorySqlc() watch.TxInputRepositorier {
 		log.Fatalf("fail to create config: %v", err)
 	}
 
-	db, err := mysql.NewMySQL(&conf.MySQL)
-	if err != nil {
-		log.Fatalf("fail to create db: %v", err)
+	db := openDB(conf)
+	if isPostgres(conf) {
+		btcTxInputRepoSqlc = watch.NewBTCTxInputRepositoryPostgres(db, domainCoin.BTC)
+	} else {
+		btcTxInputRepoSqlc = watch.NewBTCTxInputRepositorySqlc(db, domainCoin.BTC)
 	}
-
-	btcTxInputRepoSqlc = watch.NewBTCTxInputRepositorySqlc(db, domainCoin.BTC)
 	return btcTxInputRepoSqlc
 }
 
@@ -170,12 +205,12 @@ func NewBTCTxOutputRepositorySqlc() watch.TxOutputRepositorier {
 		log.Fatalf("fail to create config: %v", err)
 	}
 
-	db, err := mysql.NewMySQL(&conf.MySQL)
-	if err != nil {
-		log.Fatalf("fail to create db: %v", err)
+	db := openDB(conf)
+	if isPostgres(conf) {
+		btcTxOutputRepoSqlc = watch.NewBTCTxOutputRepositoryPostgres(db, domainCoin.BTC)
+	} else {
+		btcTxOutputRepoSqlc = watch.NewBTCTxOutputRepositorySqlc(db, domainCoin.BTC)
 	}
-
-	btcTxOutputRepoSqlc = watch.NewBTCTxOutputRepositorySqlc(db, domainCoin.BTC)
 	return btcTxOutputRepoSqlc
 }
 
@@ -192,12 +227,12 @@ func NewEthDetailTxRepositorySqlc() watch.EthDetailTxRepositorier {
 		log.Fatalf("fail to create config: %v", err)
 	}
 
-	db, err := mysql.NewMySQL(&conf.MySQL)
-	if err != nil {
-		log.Fatalf("fail to create db: %v", err)
+	db := openDB(conf)
+	if isPostgres(conf) {
+		ethDetailTxRepoSqlc = watch.NewEthDetailTxInputRepositoryPostgres(db, domainCoin.ETH)
+	} else {
+		ethDetailTxRepoSqlc = watch.NewEthDetailTxInputRepositorySqlc(db, domainCoin.ETH)
 	}
-
-	ethDetailTxRepoSqlc = watch.NewEthDetailTxInputRepositorySqlc(db, domainCoin.ETH)
 	return ethDetailTxRepoSqlc
 }
 
@@ -214,11 +249,11 @@ func NewXrpDetailTxRepositorySqlc() watch.XrpDetailTxRepositorier {
 		log.Fatalf("fail to create config: %v", err)
 	}
 
-	db, err := mysql.NewMySQL(&conf.MySQL)
-	if err != nil {
-		log.Fatalf("fail to create db: %v", err)
+	db := openDB(conf)
+	if isPostgres(conf) {
+		xrpDetailTxRepoSqlc = watch.NewXrpDetailTxInputRepositoryPostgres(db, domainCoin.XRP)
+	} else {
+		xrpDetailTxRepoSqlc = watch.NewXrpDetailTxInputRepositorySqlc(db, domainCoin.XRP)
 	}
-
-	xrpDetailTxRepoSqlc = watch.NewXrpDetailTxInputRepositorySqlc(db, domainCoin.XRP)
 	return xrpDetailTxRepoSqlc
 }
@@ -0,0 +1,108 @@
+package tracer
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hiromaily/go-crypto-wallet/pkg/config"
+)
+
+// instrumentationName is name of tracer
+const instrumentationName = "github.com/hiromaily/go-crypto-wallet"
+
+// Tracer types
+const (
+	TypeNone    = "none"
+	TypeJaeger  = "jaeger"
+	TypeDatadog = "datadog"
+)
+
+// provider is set by Setup, nil when tracing is disabled
+var provider *sdktrace.TracerProvider
+
+// Setup registers global tracer provider exporting spans by OTLP/HTTP
+// - both Jaeger and Datadog Agent accept OTLP, so only the detail config differs by type
+// - tracing is disabled when type is none
+func Setup(conf *config.Tracer) error {
+	var detail config.TracerDetail
+	switch conf.Type {
+	case "", TypeNone:
+		return nil
+	case TypeJaeger:
+		detail = conf.Jaeger
+	case TypeDatadog:
+		detail = conf.Datadog
+	default:
+		return fmt.Errorf("tracer type %s is not supported", conf.Type)
+	}
+	if detail.CollectorEndpoint == "" {
+		return fmt.Errorf("collector_endpoint of %s is required", conf.Type)
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(),
+		otlptracehttp.WithEndpointURL(detail.CollectorEndpoint))
+	if err != nil {
+		return fmt.Errorf("fail to call otlptracehttp.New(): %w", err)
+	}
+
+	// debug mode exports every span synchronously
+	processor := sdktrace.NewBatchSpanProcessor(exporter)
+	if detail.IsDebug {
+		processor = sdktrace.NewSimpleSpanProcessor(exporter)
+	}
+
+	provider = sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(detail.SamplingProbability))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(detail.ServiceName),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return nil
+}
+
+// Enabled returns true when tracer provider is set by Setup
+func Enabled() bool {
+	return provider != nil
+}
+
+// Shutdown flushes remaining spans, it should be called before process exits
+func Shutdown(ctx context.Context) error {
+	if provider == nil {
+		return nil
+	}
+	if err := provider.Shutdown(ctx); err != nil {
+		return fmt.Errorf("fail to shutdown tracer provider: %w", err)
+	}
+	return nil
+}
+
+// Start starts span as child of span in ctx
+// - it's noop until Setup enables tracing
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End ends span, err is recorded if not nil
+// - err is pointer so that named error result can be deferred like `defer tracer.End(span, &err)`
+func End(span trace.Span, err *error) {
+	if err != nil && *err != nil {
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+	span.End()
+}
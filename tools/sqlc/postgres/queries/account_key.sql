@@ -0,0 +1,31 @@
+-- name: GetMaxAccountKeyIndex :one
+SELECT COALESCE(MAX(idx), 0) as max_idx FROM account_key WHERE coin = $1 AND account = $2;
+
+-- name: GetOneAccountKeyByMaxID :one
+SELECT * FROM account_key WHERE coin = $1 AND account = $2 ORDER BY id DESC LIMIT 1;
+
+-- name: GetAccountKeysByAddrStatus :many
+SELECT * FROM account_key WHERE coin = $1 AND account = $2 AND addr_status = $3;
+
+-- name: GetAccountKeysByMultisigAddresses :many
+SELECT * FROM account_key
+WHERE coin = sqlc.arg('coin') AND account = sqlc.arg('account') AND multisig_address = ANY(sqlc.arg('addrs')::text[]);
+
+-- name: InsertAccountKey :execresult
+INSERT INTO account_key (
+  coin, key_type, account, p2pkh_address, p2sh_segwit_address, bech32_address, taproot_address,
+  full_public_key, multisig_address, redeem_script, wallet_import_format, idx, addr_status
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
+
+-- name: UpdateAccountKeyAddress :execresult
+UPDATE account_key SET p2pkh_address = $1, updated_at = $2
+WHERE coin = $3 AND account = $4 AND p2sh_segwit_address = $5;
+
+-- name: UpdateAccountKeyAddrStatus :execresult
+UPDATE account_key SET addr_status = $1, updated_at = $2
+WHERE coin = $3 AND account = $4 AND wallet_import_format = $5;
+
+-- name: UpdateAccountKeyMultisigAddr :execresult
+UPDATE account_key
+SET multisig_address = $1, redeem_script = $2, addr_status = $3, updated_at = $4
+WHERE coin = $5 AND account = $6 AND full_public_key = $7;
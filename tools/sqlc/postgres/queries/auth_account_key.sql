@@ -0,0 +1,12 @@
+-- name: GetAuthAccountKey :one
+SELECT * FROM auth_account_key WHERE coin = $1 AND auth_account = $2 LIMIT 1;
+
+-- name: InsertAuthAccountKey :execresult
+INSERT INTO auth_account_key (
+  coin, key_type, auth_account, p2pkh_address, p2sh_segwit_address, bech32_address, taproot_address,
+  full_public_key, multisig_address, redeem_script, wallet_import_format, idx, addr_status
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);
+
+-- name: UpdateAuthAccountKeyAddrStatus :execresult
+UPDATE auth_account_key SET addr_status = $1, updated_at = $2
+WHERE coin = $3 AND wallet_import_format = $4;
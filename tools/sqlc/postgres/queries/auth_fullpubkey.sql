@@ -0,0 +1,5 @@
+-- name: GetAuthFullPubkey :one
+SELECT * FROM auth_fullpubkey WHERE coin = $1 AND auth_account = $2 LIMIT 1;
+
+-- name: InsertAuthFullPubkey :execresult
+INSERT INTO auth_fullpubkey (coin, auth_account, full_public_key) VALUES ($1, $2, $3);
@@ -0,0 +1,14 @@
+-- name: GetAllDaemonJobs :many
+SELECT * FROM daemon_job
+WHERE coin = $1
+ORDER BY name;
+
+-- name: UpsertDaemonJob :execresult
+INSERT INTO daemon_job (coin, name, last_status, last_error, last_started_at, last_finished_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (coin, name) DO UPDATE SET
+  last_status = EXCLUDED.last_status,
+  last_error = EXCLUDED.last_error,
+  last_started_at = EXCLUDED.last_started_at,
+  last_finished_at = EXCLUDED.last_finished_at,
+  updated_at = EXCLUDED.updated_at;
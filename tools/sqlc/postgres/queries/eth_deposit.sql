@@ -0,0 +1,22 @@
+-- name: GetEthDepositsUnconfirmed :many
+SELECT * FROM eth_deposit
+WHERE coin = sqlc.arg('coin') AND is_confirmed = false AND token_contract = ANY(sqlc.arg('token_contracts')::text[])
+ORDER BY block_number, id;
+
+-- name: InsertEthDeposit :execresult
+INSERT INTO eth_deposit (
+  coin, token_contract, tx_hash, log_index, block_number, block_hash, block_time,
+  sender_address, receiver_account, receiver_address, amount
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT (coin, token_contract, tx_hash, log_index) DO NOTHING;
+
+-- name: UpdateEthDepositConfirmed :execresult
+UPDATE eth_deposit SET
+  is_confirmed = true,
+  confirmed_at = $1
+WHERE id = $2;
+
+-- name: DeleteEthDepositsUnconfirmedFromBlock :execresult
+DELETE FROM eth_deposit
+WHERE coin = sqlc.arg('coin') AND is_confirmed = false AND block_number >= sqlc.arg('block_number')
+  AND token_contract = ANY(sqlc.arg('token_contracts')::text[]);
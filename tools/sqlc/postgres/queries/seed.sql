@@ -0,0 +1,5 @@
+-- name: GetSeed :one
+SELECT * FROM seed WHERE coin = $1 LIMIT 1;
+
+-- name: InsertSeed :execresult
+INSERT INTO seed (coin, seed) VALUES ($1, $2);
@@ -0,0 +1,50 @@
+-- name: GetSolDetailTxByID :one
+SELECT * FROM sol_detail_tx
+WHERE id = $1;
+
+-- name: GetSolDetailTxActionBySentSignature :one
+SELECT tx.action
+FROM sol_detail_tx
+INNER JOIN tx ON tx.id = sol_detail_tx.tx_id
+WHERE sol_detail_tx.sent_signature = $1
+LIMIT 1;
+
+-- name: GetSolDetailTxsByTxID :many
+SELECT * FROM sol_detail_tx
+WHERE tx_id = $1;
+
+-- name: GetSolDetailTxOldestUnsignedUpdatedAt :one
+SELECT sol_detail_tx.unsigned_updated_at
+FROM sol_detail_tx
+INNER JOIN tx ON tx.id = sol_detail_tx.tx_id
+WHERE tx.coin = $1 AND sol_detail_tx.current_tx_type = $2
+ORDER BY sol_detail_tx.unsigned_updated_at
+LIMIT 1;
+
+-- name: GetSolDetailTxSentSignatureList :many
+SELECT sol_detail_tx.sent_signature
+FROM sol_detail_tx
+INNER JOIN tx ON tx.id = sol_detail_tx.tx_id
+WHERE tx.coin = $1 AND sol_detail_tx.current_tx_type = $2;
+
+-- name: InsertSolDetailTx :execresult
+INSERT INTO sol_detail_tx (
+  tx_id, uuid, current_tx_type, sender_account, sender_address,
+  receiver_account, receiver_address, amount, fee, token_mint, nonce_account, nonce,
+  unsigned_tx, signed_tx, sent_signature, unsigned_updated_at, sent_updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17);
+
+-- name: UpdateSolDetailTxAfterSent :execresult
+UPDATE sol_detail_tx
+SET current_tx_type = $1, signed_tx = $2, sent_signature = $3, sent_updated_at = $4
+WHERE uuid = $5;
+
+-- name: UpdateSolDetailTxType :execresult
+UPDATE sol_detail_tx
+SET current_tx_type = $1
+WHERE id = $2;
+
+-- name: UpdateSolDetailTxTypeBySentSignature :execresult
+UPDATE sol_detail_tx
+SET current_tx_type = $1
+WHERE sent_signature = $2;
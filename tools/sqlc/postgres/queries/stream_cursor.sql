@@ -0,0 +1,18 @@
+-- name: GetStreamCursor :one
+SELECT * FROM stream_cursor
+WHERE coin = $1 AND name = $2;
+
+-- name: UpsertStreamCursor :execresult
+INSERT INTO stream_cursor (coin, name, position, updated_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (coin, name) DO UPDATE SET
+  position = EXCLUDED.position,
+  updated_at = EXCLUDED.updated_at;
+
+-- name: UpsertStreamCursorBlock :execresult
+INSERT INTO stream_cursor (coin, name, position, block_hash, updated_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (coin, name) DO UPDATE SET
+  position = EXCLUDED.position,
+  block_hash = EXCLUDED.block_hash,
+  updated_at = EXCLUDED.updated_at;
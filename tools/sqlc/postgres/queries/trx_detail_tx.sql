@@ -0,0 +1,50 @@
+-- name: GetTrxDetailTxByID :one
+SELECT * FROM trx_detail_tx
+WHERE id = $1;
+
+-- name: GetTrxDetailTxActionBySentHash :one
+SELECT tx.action
+FROM trx_detail_tx
+INNER JOIN tx ON tx.id = trx_detail_tx.tx_id
+WHERE trx_detail_tx.sent_hash_tx = $1
+LIMIT 1;
+
+-- name: GetTrxDetailTxsByTxID :many
+SELECT * FROM trx_detail_tx
+WHERE tx_id = $1;
+
+-- name: GetTrxDetailTxOldestUnsignedUpdatedAt :one
+SELECT trx_detail_tx.unsigned_updated_at
+FROM trx_detail_tx
+INNER JOIN tx ON tx.id = trx_detail_tx.tx_id
+WHERE tx.coin = $1 AND trx_detail_tx.current_tx_type = $2
+ORDER BY trx_detail_tx.unsigned_updated_at
+LIMIT 1;
+
+-- name: GetTrxDetailTxSentHashList :many
+SELECT trx_detail_tx.sent_hash_tx
+FROM trx_detail_tx
+INNER JOIN tx ON tx.id = trx_detail_tx.tx_id
+WHERE tx.coin = $1 AND trx_detail_tx.current_tx_type = $2;
+
+-- name: InsertTrxDetailTx :execresult
+INSERT INTO trx_detail_tx (
+  tx_id, uuid, current_tx_type, sender_account, sender_address,
+  receiver_account, receiver_address, amount, fee, fee_limit, contract_address, ref_block_num, expiration,
+  unsigned_hex_tx, signed_hex_tx, sent_hash_tx, unsigned_updated_at, sent_updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18);
+
+-- name: UpdateTrxDetailTxAfterSent :execresult
+UPDATE trx_detail_tx
+SET current_tx_type = $1, signed_hex_tx = $2, sent_hash_tx = $3, sent_updated_at = $4
+WHERE uuid = $5;
+
+-- name: UpdateTrxDetailTxType :execresult
+UPDATE trx_detail_tx
+SET current_tx_type = $1
+WHERE id = $2;
+
+-- name: UpdateTrxDetailTxTypeBySentHash :execresult
+UPDATE trx_detail_tx
+SET current_tx_type = $1
+WHERE sent_hash_tx = $2;
@@ -0,0 +1,25 @@
+-- name: GetXRPAccountKeysByAddrStatus :many
+SELECT * FROM xrp_account_key WHERE coin = $1 AND account = $2 AND addr_status = $3;
+
+-- name: GetXRPAccountKeyByAccountID :one
+SELECT * FROM xrp_account_key WHERE coin = $1 AND account = $2 AND account_id = $3 LIMIT 1;
+
+-- name: InsertXRPAccountKey :execresult
+INSERT INTO xrp_account_key (
+  coin, account, account_id, key_type, master_key, master_seed, master_seed_hex,
+  public_key, public_key_hex, is_regular_key_pair, allocated_id, addr_status
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+
+-- name: UpdateXRPAccountKeyAddrStatus :execresult
+UPDATE xrp_account_key SET addr_status = $1, updated_at = $2
+WHERE coin = $3 AND account = $4 AND account_id = $5;
+
+-- name: UpdateXRPAccountKeyPendingRegularKey :execresult
+UPDATE xrp_account_key SET pending_regular_key_account_id = $1, pending_regular_key_seed = $2, updated_at = $3
+WHERE coin = $4 AND account_id = $5;
+
+-- name: ActivateXRPAccountKeyRegularKey :execresult
+UPDATE xrp_account_key
+SET active_key = $1, regular_key_account_id = pending_regular_key_account_id, regular_key_seed = pending_regular_key_seed,
+  pending_regular_key_account_id = '', pending_regular_key_seed = '', updated_at = $2
+WHERE coin = $3 AND account_id = $4 AND pending_regular_key_account_id = $5;
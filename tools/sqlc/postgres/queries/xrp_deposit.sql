@@ -0,0 +1,20 @@
+-- name: InsertXrpDeposit :execresult
+INSERT INTO xrp_deposit (
+  tx_hash, ledger_index, sender_address, receiver_address, destination_tag,
+  client_address, amount, currency, issuer, is_quarantined
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (tx_hash) DO NOTHING;
+
+-- name: GetXrpDepositsQuarantined :many
+SELECT * FROM xrp_deposit
+WHERE is_quarantined = true
+ORDER BY id;
+
+-- name: GetXrpDepositByID :one
+SELECT * FROM xrp_deposit
+WHERE id = $1;
+
+-- name: ResolveXrpDepositQuarantine :execresult
+UPDATE xrp_deposit
+SET client_address = $1, is_quarantined = false
+WHERE id = $2 AND is_quarantined = true;
@@ -0,0 +1,77 @@
+-- name: GetXrpDetailTxByID :one
+SELECT * FROM xrp_detail_tx
+WHERE id = $1;
+
+-- name: GetXrpDetailTxByUUID :one
+SELECT * FROM xrp_detail_tx
+WHERE uuid = $1;
+
+-- name: GetXrpDetailTxOldestUnsignedUpdatedAt :one
+SELECT tx.updated_at
+FROM xrp_detail_tx
+INNER JOIN tx ON tx.id = xrp_detail_tx.tx_id
+WHERE tx.coin = $1 AND xrp_detail_tx.current_tx_type = $2
+ORDER BY tx.updated_at
+LIMIT 1;
+
+-- name: GetXrpDetailTxActionBySignedTxID :one
+SELECT tx.action
+FROM xrp_detail_tx
+INNER JOIN tx ON tx.id = xrp_detail_tx.tx_id
+WHERE xrp_detail_tx.signed_tx_id = $1
+LIMIT 1;
+
+-- name: GetXrpDetailTxsByTxID :many
+SELECT * FROM xrp_detail_tx
+WHERE tx_id = $1;
+
+-- name: GetXrpDetailTxsExpired :many
+SELECT * FROM xrp_detail_tx
+WHERE current_tx_type = $1 AND last_ledger_sequence != 0 AND last_ledger_sequence < $2
+ORDER BY id;
+
+-- name: GetXrpDetailTxBlobList :many
+SELECT xrp_detail_tx.tx_blob
+FROM xrp_detail_tx
+INNER JOIN tx ON tx.id = xrp_detail_tx.tx_id
+WHERE tx.coin = $1 AND xrp_detail_tx.current_tx_type = $2;
+
+-- name: GetXrpDetailTxTicketSequences :many
+SELECT ticket_sequence
+FROM xrp_detail_tx
+WHERE sender_address = $1 AND ticket_sequence != 0 AND current_tx_type NOT IN ($2, $3);
+
+-- name: InsertXrpDetailTx :execresult
+INSERT INTO xrp_detail_tx (
+  tx_id, uuid, current_tx_type, sender_account, sender_address,
+  receiver_account, receiver_address, amount, currency, issuer,
+  xrp_tx_type, fee, flags, last_ledger_sequence, sequence, ticket_sequence,
+  signing_pubkey, txn_signature, hash, earliest_ledger_version, signed_tx_id,
+  tx_blob, sent_updated_at, retry_count, payment_request_id
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25);
+
+-- name: UpdateXrpDetailTxAfterSent :execresult
+UPDATE xrp_detail_tx
+SET current_tx_type = $1, signed_tx_id = $2, tx_blob = $3,
+    earliest_ledger_version = $4, sent_updated_at = $5
+WHERE uuid = $6;
+
+-- name: UpdateXrpDetailTxType :execresult
+UPDATE xrp_detail_tx
+SET current_tx_type = $1
+WHERE id = $2;
+
+-- name: UpdateXrpDetailTxTypeFrom :execresult
+UPDATE xrp_detail_tx
+SET current_tx_type = $1
+WHERE id = $2 AND current_tx_type = $3;
+
+-- name: UpdateXrpDetailTxTypeBySentHash :execresult
+UPDATE xrp_detail_tx
+SET current_tx_type = $1
+WHERE tx_blob = $2;
+
+-- name: UpdateXrpDetailTxTypeBySignedTxID :execresult
+UPDATE xrp_detail_tx
+SET current_tx_type = $1
+WHERE signed_tx_id = $2 AND current_tx_type = $3;
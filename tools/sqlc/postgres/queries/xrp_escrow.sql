@@ -0,0 +1,20 @@
+-- name: GetXrpEscrowsByStatus :many
+SELECT * FROM xrp_escrow
+WHERE status IN ($1, $2)
+ORDER BY finish_after;
+
+-- name: InsertXrpEscrow :execresult
+INSERT INTO xrp_escrow (
+  owner_address, destination_address, amount, offer_sequence,
+  finish_after, cancel_after, create_uuid, status
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: UpdateXrpEscrowFinishUUID :execresult
+UPDATE xrp_escrow
+SET finish_uuid = $1, status = $2, updated_at = $3
+WHERE id = $4;
+
+-- name: UpdateXrpEscrowStatus :execresult
+UPDATE xrp_escrow
+SET status = $1, updated_at = $2
+WHERE id = $3;
@@ -1,3 +1,7 @@
+-- name: CountUnallocatedAddresses :one
+SELECT COUNT(*) as count FROM address
+WHERE coin = ? AND account = ? AND is_allocated = false;
+
 -- name: GetAllAddresses :many
 SELECT * FROM address
 WHERE coin = ? AND account = ?;
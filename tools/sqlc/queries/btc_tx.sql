@@ -14,10 +14,20 @@ WHERE coin = ? AND action = ? AND sent_hash_tx = ?;
 SELECT id FROM btc_tx
 WHERE coin = ? AND action = ? AND unsigned_hex_tx = ?;
 
+-- name: GetBtcTxOldestUnsignedUpdatedAt :one
+SELECT unsigned_updated_at FROM btc_tx
+WHERE coin = ? AND current_tx_type = ?
+ORDER BY unsigned_updated_at
+LIMIT 1;
+
 -- name: GetBtcTxSentHashList :many
 SELECT sent_hash_tx FROM btc_tx
 WHERE coin = ? AND action = ? AND current_tx_type = ?;
 
+-- name: GetBtcTxConfirmedListFromHeight :many
+SELECT * FROM btc_tx
+WHERE coin = ? AND action = ? AND current_tx_type IN (?, ?) AND block_height >= ?;
+
 -- name: InsertBtcTx :execresult
 INSERT INTO btc_tx (
   coin, action, unsigned_hex_tx, signed_hex_tx, sent_hash_tx,
@@ -37,6 +47,11 @@ UPDATE btc_tx
 SET current_tx_type = ?, signed_hex_tx = ?, sent_hash_tx = ?, sent_updated_at = ?
 WHERE id = ?;
 
+-- name: UpdateBtcTxBlock :execresult
+UPDATE btc_tx
+SET block_hash = ?, block_height = ?
+WHERE id = ?;
+
 -- name: UpdateBtcTxType :execresult
 UPDATE btc_tx
 SET current_tx_type = ?
@@ -47,5 +62,10 @@ UPDATE btc_tx
 SET current_tx_type = ?
 WHERE coin = ? AND action = ? AND sent_hash_tx = ?;
 
+-- name: RollbackBtcTxToSent :execresult
+UPDATE btc_tx
+SET current_tx_type = ?, block_hash = '', block_height = 0
+WHERE id = ?;
+
 -- name: DeleteAllBtcTx :execresult
 DELETE FROM btc_tx;
@@ -0,0 +1,14 @@
+-- name: GetAllDaemonJobs :many
+SELECT * FROM daemon_job
+WHERE coin = ?
+ORDER BY name;
+
+-- name: UpsertDaemonJob :execresult
+INSERT INTO daemon_job (coin, name, last_status, last_error, last_started_at, last_finished_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  last_status = VALUES(last_status),
+  last_error = VALUES(last_error),
+  last_started_at = VALUES(last_started_at),
+  last_finished_at = VALUES(last_finished_at),
+  updated_at = VALUES(updated_at);
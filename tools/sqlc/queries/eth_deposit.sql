@@ -0,0 +1,20 @@
+-- name: GetEthDepositsUnconfirmed :many
+SELECT * FROM eth_deposit
+WHERE coin = ? AND is_confirmed = false AND token_contract IN (sqlc.slice('token_contracts'))
+ORDER BY block_number, id;
+
+-- name: InsertEthDeposit :execresult
+INSERT IGNORE INTO eth_deposit (
+  coin, token_contract, tx_hash, log_index, block_number, block_hash, block_time,
+  sender_address, receiver_account, receiver_address, amount
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: UpdateEthDepositConfirmed :execresult
+UPDATE eth_deposit SET
+  is_confirmed = true,
+  confirmed_at = ?
+WHERE id = ?;
+
+-- name: DeleteEthDepositsUnconfirmedFromBlock :execresult
+DELETE FROM eth_deposit
+WHERE coin = ? AND is_confirmed = false AND block_number >= ? AND token_contract IN (sqlc.slice('token_contracts'));
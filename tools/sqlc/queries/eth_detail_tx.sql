@@ -2,10 +2,25 @@
 SELECT * FROM eth_detail_tx
 WHERE id = ?;
 
+-- name: GetEthDetailTxActionBySentHash :one
+SELECT tx.action
+FROM eth_detail_tx
+INNER JOIN tx ON tx.id = eth_detail_tx.tx_id
+WHERE eth_detail_tx.sent_hash_tx = ?
+LIMIT 1;
+
 -- name: GetEthDetailTxsByTxID :many
 SELECT * FROM eth_detail_tx
 WHERE tx_id = ?;
 
+-- name: GetEthDetailTxOldestUnsignedUpdatedAt :one
+SELECT eth_detail_tx.unsigned_updated_at
+FROM eth_detail_tx
+INNER JOIN tx ON tx.id = eth_detail_tx.tx_id
+WHERE tx.coin = ? AND eth_detail_tx.current_tx_type = ?
+ORDER BY eth_detail_tx.unsigned_updated_at
+LIMIT 1;
+
 -- name: GetEthDetailTxSentHashList :many
 SELECT eth_detail_tx.sent_hash_tx
 FROM eth_detail_tx
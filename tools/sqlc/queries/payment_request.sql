@@ -1,3 +1,7 @@
+-- name: CountPendingPaymentRequests :one
+SELECT COUNT(*) as count FROM payment_request
+WHERE coin = ? AND is_done = false;
+
 -- name: GetAllPaymentRequests :many
 SELECT * FROM payment_request
 WHERE coin = ? AND payment_id IS NULL;
@@ -20,6 +24,11 @@ UPDATE payment_request
 SET is_done = ?
 WHERE coin = ? AND payment_id = ?;
 
+-- name: ResetPaymentRequestPaymentID :execresult
+UPDATE payment_request
+SET payment_id = NULL, is_done = false
+WHERE coin = ? AND id = ?;
+
 -- name: DeleteAllPaymentRequests :execresult
 DELETE FROM payment_request
 WHERE coin = ?;
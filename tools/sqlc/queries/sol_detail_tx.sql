@@ -0,0 +1,50 @@
+-- name: GetSolDetailTxByID :one
+SELECT * FROM sol_detail_tx
+WHERE id = ?;
+
+-- name: GetSolDetailTxActionBySentSignature :one
+SELECT tx.action
+FROM sol_detail_tx
+INNER JOIN tx ON tx.id = sol_detail_tx.tx_id
+WHERE sol_detail_tx.sent_signature = ?
+LIMIT 1;
+
+-- name: GetSolDetailTxsByTxID :many
+SELECT * FROM sol_detail_tx
+WHERE tx_id = ?;
+
+-- name: GetSolDetailTxOldestUnsignedUpdatedAt :one
+SELECT sol_detail_tx.unsigned_updated_at
+FROM sol_detail_tx
+INNER JOIN tx ON tx.id = sol_detail_tx.tx_id
+WHERE tx.coin = ? AND sol_detail_tx.current_tx_type = ?
+ORDER BY sol_detail_tx.unsigned_updated_at
+LIMIT 1;
+
+-- name: GetSolDetailTxSentSignatureList :many
+SELECT sol_detail_tx.sent_signature
+FROM sol_detail_tx
+INNER JOIN tx ON tx.id = sol_detail_tx.tx_id
+WHERE tx.coin = ? AND sol_detail_tx.current_tx_type = ?;
+
+-- name: InsertSolDetailTx :execresult
+INSERT INTO sol_detail_tx (
+  tx_id, uuid, current_tx_type, sender_account, sender_address,
+  receiver_account, receiver_address, amount, fee, token_mint, nonce_account, nonce,
+  unsigned_tx, signed_tx, sent_signature, unsigned_updated_at, sent_updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: UpdateSolDetailTxAfterSent :execresult
+UPDATE sol_detail_tx
+SET current_tx_type = ?, signed_tx = ?, sent_signature = ?, sent_updated_at = ?
+WHERE uuid = ?;
+
+-- name: UpdateSolDetailTxType :execresult
+UPDATE sol_detail_tx
+SET current_tx_type = ?
+WHERE id = ?;
+
+-- name: UpdateSolDetailTxTypeBySentSignature :execresult
+UPDATE sol_detail_tx
+SET current_tx_type = ?
+WHERE sent_signature = ?;
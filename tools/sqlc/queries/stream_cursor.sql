@@ -0,0 +1,18 @@
+-- name: GetStreamCursor :one
+SELECT * FROM stream_cursor
+WHERE coin = ? AND name = ?;
+
+-- name: UpsertStreamCursor :execresult
+INSERT INTO stream_cursor (coin, name, position, updated_at)
+VALUES (?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  position = VALUES(position),
+  updated_at = VALUES(updated_at);
+
+-- name: UpsertStreamCursorBlock :execresult
+INSERT INTO stream_cursor (coin, name, position, block_hash, updated_at)
+VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+  position = VALUES(position),
+  block_hash = VALUES(block_hash),
+  updated_at = VALUES(updated_at);
@@ -0,0 +1,50 @@
+-- name: GetTrxDetailTxByID :one
+SELECT * FROM trx_detail_tx
+WHERE id = ?;
+
+-- name: GetTrxDetailTxActionBySentHash :one
+SELECT tx.action
+FROM trx_detail_tx
+INNER JOIN tx ON tx.id = trx_detail_tx.tx_id
+WHERE trx_detail_tx.sent_hash_tx = ?
+LIMIT 1;
+
+-- name: GetTrxDetailTxsByTxID :many
+SELECT * FROM trx_detail_tx
+WHERE tx_id = ?;
+
+-- name: GetTrxDetailTxOldestUnsignedUpdatedAt :one
+SELECT trx_detail_tx.unsigned_updated_at
+FROM trx_detail_tx
+INNER JOIN tx ON tx.id = trx_detail_tx.tx_id
+WHERE tx.coin = ? AND trx_detail_tx.current_tx_type = ?
+ORDER BY trx_detail_tx.unsigned_updated_at
+LIMIT 1;
+
+-- name: GetTrxDetailTxSentHashList :many
+SELECT trx_detail_tx.sent_hash_tx
+FROM trx_detail_tx
+INNER JOIN tx ON tx.id = trx_detail_tx.tx_id
+WHERE tx.coin = ? AND trx_detail_tx.current_tx_type = ?;
+
+-- name: InsertTrxDetailTx :execresult
+INSERT INTO trx_detail_tx (
+  tx_id, uuid, current_tx_type, sender_account, sender_address,
+  receiver_account, receiver_address, amount, fee, fee_limit, contract_address, ref_block_num, expiration,
+  unsigned_hex_tx, signed_hex_tx, sent_hash_tx, unsigned_updated_at, sent_updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: UpdateTrxDetailTxAfterSent :execresult
+UPDATE trx_detail_tx
+SET current_tx_type = ?, signed_hex_tx = ?, sent_hash_tx = ?, sent_updated_at = ?
+WHERE uuid = ?;
+
+-- name: UpdateTrxDetailTxType :execresult
+UPDATE trx_detail_tx
+SET current_tx_type = ?
+WHERE id = ?;
+
+-- name: UpdateTrxDetailTxTypeBySentHash :execresult
+UPDATE trx_detail_tx
+SET current_tx_type = ?
+WHERE sent_hash_tx = ?;
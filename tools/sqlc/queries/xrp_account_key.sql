@@ -1,8 +1,8 @@
 -- name: GetXRPAccountKeysByAddrStatus :many
 SELECT * FROM xrp_account_key WHERE coin = ? AND account = ? AND addr_status = ?;
 
--- name: GetXRPAccountKeySecret :one
-SELECT master_seed FROM xrp_account_key WHERE coin = ? AND account = ? AND account_id = ? LIMIT 1;
+-- name: GetXRPAccountKeyByAccountID :one
+SELECT * FROM xrp_account_key WHERE coin = ? AND account = ? AND account_id = ? LIMIT 1;
 
 -- name: InsertXRPAccountKey :execresult
 INSERT INTO xrp_account_key (
@@ -13,3 +13,13 @@ INSERT INTO xrp_account_key (
 -- name: UpdateXRPAccountKeyAddrStatus :execresult
 UPDATE xrp_account_key SET addr_status = ?, updated_at = ?
 WHERE coin = ? AND account = ? AND account_id = ?;
+
+-- name: UpdateXRPAccountKeyPendingRegularKey :execresult
+UPDATE xrp_account_key SET pending_regular_key_account_id = ?, pending_regular_key_seed = ?, updated_at = ?
+WHERE coin = ? AND account_id = ?;
+
+-- name: ActivateXRPAccountKeyRegularKey :execresult
+UPDATE xrp_account_key
+SET active_key = ?, regular_key_account_id = pending_regular_key_account_id, regular_key_seed = pending_regular_key_seed,
+  pending_regular_key_account_id = '', pending_regular_key_seed = '', updated_at = ?
+WHERE coin = ? AND account_id = ? AND pending_regular_key_account_id = ?;
@@ -0,0 +1,19 @@
+-- name: InsertXrpDeposit :execresult
+INSERT IGNORE INTO xrp_deposit (
+  tx_hash, ledger_index, sender_address, receiver_address, destination_tag,
+  client_address, amount, currency, issuer, is_quarantined
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetXrpDepositsQuarantined :many
+SELECT * FROM xrp_deposit
+WHERE is_quarantined = true
+ORDER BY id;
+
+-- name: GetXrpDepositByID :one
+SELECT * FROM xrp_deposit
+WHERE id = ?;
+
+-- name: ResolveXrpDepositQuarantine :execresult
+UPDATE xrp_deposit
+SET client_address = ?, is_quarantined = false
+WHERE id = ? AND is_quarantined = true;
@@ -2,23 +2,53 @@
 SELECT * FROM xrp_detail_tx
 WHERE id = ?;
 
+-- name: GetXrpDetailTxByUUID :one
+SELECT * FROM xrp_detail_tx
+WHERE uuid = ?;
+
+-- name: GetXrpDetailTxOldestUnsignedUpdatedAt :one
+SELECT tx.updated_at
+FROM xrp_detail_tx
+INNER JOIN tx ON tx.id = xrp_detail_tx.tx_id
+WHERE tx.coin = ? AND xrp_detail_tx.current_tx_type = ?
+ORDER BY tx.updated_at
+LIMIT 1;
+
+-- name: GetXrpDetailTxActionBySignedTxID :one
+SELECT tx.action
+FROM xrp_detail_tx
+INNER JOIN tx ON tx.id = xrp_detail_tx.tx_id
+WHERE xrp_detail_tx.signed_tx_id = ?
+LIMIT 1;
+
 -- name: GetXrpDetailTxsByTxID :many
 SELECT * FROM xrp_detail_tx
 WHERE tx_id = ?;
 
+-- name: GetXrpDetailTxsExpired :many
+SELECT * FROM xrp_detail_tx
+WHERE current_tx_type = ? AND last_ledger_sequence != 0 AND last_ledger_sequence < ?
+ORDER BY id;
+
 -- name: GetXrpDetailTxBlobList :many
 SELECT xrp_detail_tx.tx_blob
 FROM xrp_detail_tx
 INNER JOIN tx ON tx.id = xrp_detail_tx.tx_id
 WHERE tx.coin = ? AND xrp_detail_tx.current_tx_type = ?;
 
+-- name: GetXrpDetailTxTicketSequences :many
+SELECT ticket_sequence
+FROM xrp_detail_tx
+WHERE sender_address = ? AND ticket_sequence != 0 AND current_tx_type NOT IN (?, ?);
+
 -- name: InsertXrpDetailTx :execresult
 INSERT INTO xrp_detail_tx (
   tx_id, uuid, current_tx_type, sender_account, sender_address,
-  receiver_account, receiver_address, amount, xrp_tx_type, fee,
-  flags, last_ledger_sequence, sequence, signing_pubkey, txn_signature,
-  hash, earliest_ledger_version, signed_tx_id, tx_blob, sent_updated_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+  receiver_account, receiver_address, amount, currency, issuer,
+  xrp_tx_type, fee, flags, last_ledger_sequence, sequence, ticket_sequence,
+  signing_pubkey, txn_signature, hash, earliest_ledger_version, signed_tx_id,
+  tx_blob, sent_updated_at, retry_count, payment_request_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: UpdateXrpDetailTxAfterSent :execresult
 UPDATE xrp_detail_tx
@@ -31,7 +61,17 @@ UPDATE xrp_detail_tx
 SET current_tx_type = ?
 WHERE id = ?;
 
+-- name: UpdateXrpDetailTxTypeFrom :execresult
+UPDATE xrp_detail_tx
+SET current_tx_type = ?
+WHERE id = ? AND current_tx_type = ?;
+
 -- name: UpdateXrpDetailTxTypeBySentHash :execresult
 UPDATE xrp_detail_tx
 SET current_tx_type = ?
 WHERE tx_blob = ?;
+
+-- name: UpdateXrpDetailTxTypeBySignedTxID :execresult
+UPDATE xrp_detail_tx
+SET current_tx_type = ?
+WHERE signed_tx_id = ? AND current_tx_type = ?;
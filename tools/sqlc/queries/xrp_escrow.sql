@@ -0,0 +1,20 @@
+-- name: GetXrpEscrowsByStatus :many
+SELECT * FROM xrp_escrow
+WHERE status IN (?, ?)
+ORDER BY finish_after;
+
+-- name: InsertXrpEscrow :execresult
+INSERT INTO xrp_escrow (
+  owner_address, destination_address, amount, offer_sequence,
+  finish_after, cancel_after, create_uuid, status
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: UpdateXrpEscrowFinishUUID :execresult
+UPDATE xrp_escrow
+SET finish_uuid = ?, status = ?, updated_at = ?
+WHERE id = ?;
+
+-- name: UpdateXrpEscrowStatus :execresult
+UPDATE xrp_escrow
+SET status = ?, updated_at = ?
+WHERE id = ?;
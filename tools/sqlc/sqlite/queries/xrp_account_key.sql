@@ -0,0 +1,25 @@
+-- name: GetXRPAccountKeysByAddrStatus :many
+SELECT * FROM xrp_account_key WHERE coin = ? AND account = ? AND addr_status = ?;
+
+-- name: GetXRPAccountKeyByAccountID :one
+SELECT * FROM xrp_account_key WHERE coin = ? AND account = ? AND account_id = ? LIMIT 1;
+
+-- name: InsertXRPAccountKey :execresult
+INSERT INTO xrp_account_key (
+  coin, account, account_id, key_type, master_key, master_seed, master_seed_hex,
+  public_key, public_key_hex, is_regular_key_pair, allocated_id, addr_status
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: UpdateXRPAccountKeyAddrStatus :execresult
+UPDATE xrp_account_key SET addr_status = ?, updated_at = ?
+WHERE coin = ? AND account = ? AND account_id = ?;
+
+-- name: UpdateXRPAccountKeyPendingRegularKey :execresult
+UPDATE xrp_account_key SET pending_regular_key_account_id = ?, pending_regular_key_seed = ?, updated_at = ?
+WHERE coin = ? AND account_id = ?;
+
+-- name: ActivateXRPAccountKeyRegularKey :execresult
+UPDATE xrp_account_key
+SET active_key = ?, regular_key_account_id = pending_regular_key_account_id, regular_key_seed = pending_regular_key_seed,
+  pending_regular_key_account_id = '', pending_regular_key_seed = '', updated_at = ?
+WHERE coin = ? AND account_id = ? AND pending_regular_key_account_id = ?;
@@ -21,6 +21,8 @@ export class Instructions extends jspb.Message {
     setSequence(value: number): Instructions;
     getSignerscount(): number;
     setSignerscount(value: number): Instructions;
+    getTicketsequence(): number;
+    setTicketsequence(value: number): Instructions;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): Instructions.AsObject;
@@ -40,6 +42,7 @@ export namespace Instructions {
         maxledgerversionoffset: number,
         sequence: number,
         signerscount: number,
+        ticketsequence: number,
     }
 }
 
@@ -57,6 +60,8 @@ export class RequestPrepareTransaction extends jspb.Message {
     clearInstructions(): void;
     getInstructions(): Instructions | undefined;
     setInstructions(value?: Instructions): RequestPrepareTransaction;
+    getTxjson(): string;
+    setTxjson(value: string): RequestPrepareTransaction;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): RequestPrepareTransaction.AsObject;
@@ -75,6 +80,7 @@ export namespace RequestPrepareTransaction {
         amount: number,
         receiveraccount: string,
         instructions?: Instructions.AsObject,
+        txjson: string,
     }
 }
 
@@ -109,6 +115,8 @@ export class RequestSignTransaction extends jspb.Message {
     setTxjson(value: string): RequestSignTransaction;
     getSecret(): string;
     setSecret(value: string): RequestSignTransaction;
+    getSignas(): string;
+    setSignas(value: string): RequestSignTransaction;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): RequestSignTransaction.AsObject;
@@ -124,6 +132,7 @@ export namespace RequestSignTransaction {
     export type AsObject = {
         txjson: string,
         secret: string,
+        signas: string,
     }
 }
 
@@ -320,4 +329,5 @@ export enum EnumTransactionType {
     TX_SET_REGULAR_KEY = 15,
     TX_SINGER_LIST_SET = 16,
     TX_TRUST_SET = 17,
+    TX_TICKET_CREATE = 18,
 }
@@ -327,7 +327,8 @@ proto.rippleapi.transaction.Instructions.toObject = function(includeInstance, ms
     maxledgerversion: jspb.Message.getFieldWithDefault(msg, 3, 0),
     maxledgerversionoffset: jspb.Message.getFieldWithDefault(msg, 4, 0),
     sequence: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    signerscount: jspb.Message.getFieldWithDefault(msg, 6, 0)
+    signerscount: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    ticketsequence: jspb.Message.getFieldWithDefault(msg, 7, 0)
   };
 
   if (includeInstance) {
@@ -388,6 +389,10 @@ proto.rippleapi.transaction.Instructions.deserializeBinaryFromReader = function(
       var value = /** @type {number} */ (reader.readUint64());
       msg.setSignerscount(value);
       break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTicketsequence(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -459,6 +464,13 @@ proto.rippleapi.transaction.Instructions.serializeBinaryToWriter = function(mess
       f
     );
   }
+  f = message.getTicketsequence();
+  if (f !== 0) {
+    writer.writeUint64(
+      7,
+      f
+    );
+  }
 };
 
 
@@ -570,6 +582,24 @@ proto.rippleapi.transaction.Instructions.prototype.setSignerscount = function(va
 };
 
 
+/**
+ * optional uint64 ticketSequence = 7;
+ * @return {number}
+ */
+proto.rippleapi.transaction.Instructions.prototype.getTicketsequence = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.rippleapi.transaction.Instructions} returns this
+ */
+proto.rippleapi.transaction.Instructions.prototype.setTicketsequence = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
 
 
 
@@ -606,7 +636,8 @@ proto.rippleapi.transaction.RequestPrepareTransaction.toObject = function(includ
     senderaccount: jspb.Message.getFieldWithDefault(msg, 2, ""),
     amount: jspb.Message.getFloatingPointFieldWithDefault(msg, 3, 0.0),
     receiveraccount: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    instructions: (f = msg.getInstructions()) && proto.rippleapi.transaction.Instructions.toObject(includeInstance, f)
+    instructions: (f = msg.getInstructions()) && proto.rippleapi.transaction.Instructions.toObject(includeInstance, f),
+    txjson: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -664,6 +695,10 @@ proto.rippleapi.transaction.RequestPrepareTransaction.deserializeBinaryFromReade
       reader.readMessage(value,proto.rippleapi.transaction.Instructions.deserializeBinaryFromReader);
       msg.setInstructions(value);
       break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTxjson(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -729,6 +764,13 @@ proto.rippleapi.transaction.RequestPrepareTransaction.serializeBinaryToWriter =
       proto.rippleapi.transaction.Instructions.serializeBinaryToWriter
     );
   }
+  f = message.getTxjson();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
 };
 
 
@@ -843,6 +885,25 @@ proto.rippleapi.transaction.RequestPrepareTransaction.prototype.hasInstructions
 
 
 
+/**
+ * optional string txJSON = 6;
+ * @return {string}
+ */
+proto.rippleapi.transaction.RequestPrepareTransaction.prototype.getTxjson = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.rippleapi.transaction.RequestPrepareTransaction} returns this
+ */
+proto.rippleapi.transaction.RequestPrepareTransaction.prototype.setTxjson = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
@@ -1055,7 +1116,8 @@ proto.rippleapi.transaction.RequestSignTransaction.prototype.toObject = function
 proto.rippleapi.transaction.RequestSignTransaction.toObject = function(includeInstance, msg) {
   var f, obj = {
     txjson: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    secret: jspb.Message.getFieldWithDefault(msg, 2, "")
+    secret: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    signas: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -1100,6 +1162,10 @@ proto.rippleapi.transaction.RequestSignTransaction.deserializeBinaryFromReader =
       var value = /** @type {string} */ (reader.readString());
       msg.setSecret(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSignas(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1143,6 +1209,13 @@ proto.rippleapi.transaction.RequestSignTransaction.serializeBinaryToWriter = fun
       f
     );
   }
+  f = message.getSignas();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -1184,6 +1257,25 @@ proto.rippleapi.transaction.RequestSignTransaction.prototype.setSecret = functio
 
 
 
+/**
+ * optional string signAs = 3;
+ * @return {string}
+ */
+proto.rippleapi.transaction.RequestSignTransaction.prototype.getSignas = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.rippleapi.transaction.RequestSignTransaction} returns this
+ */
+proto.rippleapi.transaction.RequestSignTransaction.prototype.setSignas = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
@@ -2389,7 +2481,8 @@ proto.rippleapi.transaction.EnumTransactionType = {
   TX_PAYMENT_CHANNEL_FUND: 14,
   TX_SET_REGULAR_KEY: 15,
   TX_SINGER_LIST_SET: 16,
-  TX_TRUST_SET: 17
+  TX_TRUST_SET: 17,
+  TX_TICKET_CREATE: 18
 };
 
 goog.object.extend(exports, proto.rippleapi.transaction);
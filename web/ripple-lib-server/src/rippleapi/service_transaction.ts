@@ -28,7 +28,7 @@ interface resGetTransaction {
 
 interface resCombineTransaction {
   signedTransaction: string;
-  txJSON: string;
+  id: string;
 }
 
 interface rippleInstructions {
@@ -38,6 +38,7 @@ interface rippleInstructions {
   maxLedgerVersion?: number;
   maxLedgerVersionOffset?: number;
   signersCount?: number;
+  ticketSequence?: number;
 }
 
 export class RippleTransactionAPIService implements grpc_pb.IRippleTransactionAPIServer {
@@ -79,8 +80,17 @@ export class RippleTransactionAPIService implements grpc_pb.IRippleTransactionAP
     if (instructions?.getSignerscount()) {
       paramInst.signersCount = instructions?.getSignerscount();
     }
+    if (instructions?.getTicketsequence()) {
+      paramInst.ticketSequence = instructions?.getTicketsequence();
+    }
     console.log('paramInst:', paramInst);
 
+    // transaction other than Payment such as SignerListSet is passed as JSON
+    if (call.request.getTxjson()) {
+      const preparedTx = await this.rippleAPI.prepareTransaction(JSON.parse(call.request.getTxjson()), paramInst);
+      return preparedTx.txJSON;
+    }
+
     // prepareTransaction()
     const preparedTx = await this.rippleAPI.prepareTransaction({
       "TransactionType": enumTransactionTypeString[txType],
@@ -140,7 +150,11 @@ export class RippleTransactionAPIService implements grpc_pb.IRippleTransactionAP
     console.log("[signTransaction] is called");
   
     // call API
-    const signed = this.rippleAPI.sign(call.request.getTxjson(), call.request.getSecret());
+    // signAs is set for multisigning
+    const signAs = call.request.getSignas();
+    const signed = signAs
+      ? this.rippleAPI.sign(call.request.getTxjson(), call.request.getSecret(), {signAs: signAs})
+      : this.rippleAPI.sign(call.request.getTxjson(), call.request.getSecret());
     console.log("txID: Identifying hash:", signed.id);
     console.log("txBlob: Signed blob:", signed.signedTransaction);
   
@@ -266,14 +280,14 @@ export class RippleTransactionAPIService implements grpc_pb.IRippleTransactionAP
     if (isResCombineTransaction(signedObj)){
       const signed = signedObj as resCombineTransaction;
       res.setSignedtransaction(<string>signed.signedTransaction);
-      res.setTxid(signed.txJSON);  
+      res.setTxid(signed.id);
     }
     callback(null, res);
   }
 };
 
 const isResCombineTransaction = (obj: any): obj is resCombineTransaction =>
-  obj.signedTransaction && obj.txJSON;
+  obj.signedTransaction && obj.id;
 
 // export default {
 //   service: transaction_grpc_pb.RippleTransactionAPIService,  // Service interface